@@ -2,15 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto"
+	"crypto/tls"
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -21,12 +26,25 @@ import (
 	"lms-go/internal/course"
 	"lms-go/internal/enrollment"
 	"lms-go/internal/ent"
+	"lms-go/internal/ent/schema"
+	"lms-go/internal/graph"
 	httpapi "lms-go/internal/http/api"
 	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/magiclink"
 	"lms-go/internal/organization"
+	"lms-go/internal/passkey"
 	"lms-go/internal/platform/database"
+	"lms-go/internal/platform/idempotency"
+	"lms-go/internal/platform/logging"
+	"lms-go/internal/platform/mail"
+	"lms-go/internal/platform/ratelimit"
 	"lms-go/internal/platform/storage"
+	"lms-go/internal/privacy"
 	"lms-go/internal/progress"
+	"lms-go/internal/scim"
+	"lms-go/internal/search"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/sso"
 	"lms-go/internal/user"
 )
 
@@ -34,52 +52,183 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	logger, logLevel, err := logging.New(logging.Config{SensitiveKeys: schema.SensitiveFields()})
+	if err != nil {
+		fatal("api: logger init", err)
+	}
+	slog.SetDefault(logger)
+
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("api: load config: %v", err)
+		fatal("api: load config", err)
+	}
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		fatal("api: log level", err)
 	}
+	logLevel.Set(level)
 
-	dbClient, err := database.NewClient(ctx, database.Config{URL: cfg.DatabaseURL})
+	db, err := database.Open(ctx, database.Config{URL: cfg.DatabaseURL})
 	if err != nil {
-		log.Fatalf("api: db connection: %v", err)
+		fatal("api: db connection", err)
 	}
+	dbClient := database.NewClientFromDB(db)
 	defer func() {
 		if err := dbClient.Close(); err != nil {
-			log.Printf("api: closing db: %v", err)
+			slog.Error("api: closing db", "error", err)
 		}
 	}()
 
-	if err := database.Migrate(ctx, dbClient); err != nil {
-		log.Fatalf("api: migrate: %v", err)
+	applied, err := database.Migrate(ctx, db, cfg.DatabaseMigrate)
+	if err != nil {
+		fatal("api: migrate", err)
+	}
+	for _, m := range applied {
+		slog.Info("api: migration applied", "version", m.Version, "name", m.Name)
 	}
 
-	storageClient, err := storage.NewMinioClient(ctx, storage.Config{
-		Endpoint:       cfg.StorageEndpoint,
-		AccessKey:      cfg.StorageAccessKey,
-		SecretKey:      cfg.StorageSecretKey,
-		Bucket:         cfg.StorageBucket,
-		UseSSL:         cfg.StorageUseSSL,
-		PublicEndpoint: cfg.StoragePublicEndpoint,
-	})
-	if err != nil {
-		log.Fatalf("api: storage init: %v", err)
+	var storageClient objectStorage
+	var localStorage *storage.Local
+	switch cfg.StorageBackend {
+	case "local":
+		localStorage, err = storage.NewLocal(storage.LocalConfig{
+			Root:    cfg.StorageLocalPath,
+			BaseURL: strings.TrimSuffix(cfg.PublicURL, "/") + "/storage",
+			Secret:  []byte(cfg.StorageSigningSecret),
+		})
+		if err != nil {
+			fatal("api: storage init", err)
+		}
+		defer localStorage.Close()
+		storageClient = localStorage
+	default:
+		minioClient, err := storage.NewMinioClient(ctx, storage.Config{
+			Endpoint:       cfg.StorageEndpoint,
+			AccessKey:      cfg.StorageAccessKey,
+			SecretKey:      cfg.StorageSecretKey,
+			Bucket:         cfg.StorageBucket,
+			UseSSL:         cfg.StorageUseSSL,
+			PublicEndpoint: cfg.StoragePublicEndpoint,
+		})
+		if err != nil {
+			fatal("api: storage init", err)
+		}
+		storageClient = minioClient
 	}
 
 	orgService := organization.NewService(dbClient)
 
 	authService := auth.NewService(dbClient, auth.Config{
-		JWTSecret:       cfg.JWTSecret,
-		AccessTokenTTL:  cfg.AccessTokenTTL,
-		RefreshTokenTTL: cfg.RefreshTokenTTL,
+		JWTSecret:          cfg.JWTSecret,
+		AccessTokenTTL:     cfg.AccessTokenTTL,
+		RefreshTokenTTL:    cfg.RefreshTokenTTL,
+		MaxFailedAttempts:  cfg.LoginMaxFailedAttempts,
+		LockoutDuration:    cfg.LoginLockoutDuration,
+		MaxLockoutDuration: cfg.LoginMaxLockoutDuration,
+		MFAEncryptionKey:   cfg.MFAEncryptionKey,
+		MFAChallengeTTL:    cfg.MFAChallengeTTL,
 	})
 
+	var limiter ratelimit.Limiter
+	switch cfg.RateLimitBackend {
+	case "redis":
+		redisLimiter, err := ratelimit.NewRedisLimiter(ctx, ratelimit.RedisConfig{
+			Addr:     cfg.RedisAddr,
+			Password: cfg.RedisPassword,
+		})
+		if err != nil {
+			fatal("api: rate limiter init", err)
+		}
+		defer func() {
+			if err := redisLimiter.Close(); err != nil {
+				slog.Error("api: closing redis", "error", err)
+			}
+		}()
+		limiter = redisLimiter
+	default:
+		limiter = ratelimit.NewMemoryLimiter()
+	}
+	limits := rateLimits{
+		limiter: limiter,
+		auth:    ratelimit.PerMinute(cfg.AuthRateLimitPerMinute, cfg.AuthRateLimitBurst),
+		api:     ratelimit.PerMinute(cfg.APIRateLimitPerMinute, cfg.APIRateLimitBurst),
+		magic:   ratelimit.PerHour(cfg.MagicLinkPerHour, cfg.MagicLinkPerHour),
+	}
+
+	var mailSender mail.Sender = mail.LogSender{Logger: logger}
+	if cfg.SMTPAddr != "" {
+		mailSender = mail.NewSMTPSender(mail.SMTPConfig{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		})
+	}
+
 	userService := user.NewService(dbClient)
-	contentService := content.NewService(dbClient, storageClient, content.Config{})
+	contentConfig := content.Config{Mailer: mailSender}
+	if cfg.ContentDownloadMode == "proxy" {
+		contentConfig.Stream = content.StreamConfig{
+			BaseURL: strings.TrimSuffix(cfg.PublicURL, "/") + "/downloads",
+			Secret:  []byte(cfg.StorageSigningSecret),
+		}
+	}
+	contentService := content.NewService(dbClient, storageClient, contentConfig)
 	courseService := course.NewService(dbClient)
 	enrollmentService := enrollment.NewService(dbClient)
 	progressService := progress.NewService(dbClient)
+	privacyService := privacy.NewService(dbClient, storageClient, privacy.Config{})
+	searchService := search.NewService(dbClient, search.Config{Dialect: dialect.Postgres})
+	// Les URLs de callback ne sont jamais construites à partir de la requête : sans URL publique,
+	// le SSO est désactivé.
+	var ssoService *sso.Service
+	if cfg.PublicURL != "" {
+		ssoConfig := sso.Config{StateSecret: cfg.JWTSecret}
+		if cfg.SAMLCertFile != "" && cfg.SAMLKeyFile != "" {
+			keyPair, err := tls.LoadX509KeyPair(cfg.SAMLCertFile, cfg.SAMLKeyFile)
+			if err != nil {
+				fatal("api: saml key pair", err)
+			}
+			signer, ok := keyPair.PrivateKey.(crypto.Signer)
+			if !ok {
+				fatal("api: saml key pair", errors.New("private key cannot sign"))
+			}
+			ssoConfig.SAMLKey = signer
+			ssoConfig.SAMLCertificate = keyPair.Leaf
+		}
+		ssoService = sso.NewService(dbClient, orgService, userService, authService, ssoConfig)
+	} else {
+		logger.Warn("sso disabled: set API_PUBLIC_URL")
+	}
+	scimService := scim.NewService(dbClient, userService, enrollmentService)
+	serviceAccountService := serviceaccount.NewService(dbClient)
+	passkeyService, err := passkey.NewService(dbClient, authService, passkey.Config{
+		RPID:          cfg.WebAuthnRPID,
+		RPDisplayName: cfg.WebAuthnRPName,
+		RPOrigins:     cfg.WebAuthnRPOrigins,
+		SessionSecret: cfg.JWTSecret,
+	})
+	if err != nil {
+		fatal("api: webauthn init", err)
+	}
+	// Le lien envoyé par email n'est jamais construit à partir de la requête : sans URL configurée,
+	// la connexion par lien magique est désactivée.
+	var magicLinkService *magiclink.Service
+	if cfg.MagicLinkURL != "" || cfg.PublicURL != "" {
+		magicLinkService = magiclink.NewService(dbClient, authService, mailSender, magiclink.Config{
+			Secret:  cfg.JWTSecret,
+			TTL:     cfg.MagicLinkTTL,
+			LinkURL: cfg.MagicLinkURL,
+		})
+	} else {
+		logger.Warn("magic links disabled: set MAGIC_LINK_URL or API_PUBLIC_URL")
+	}
 
-	router := newRouter(dbClient, orgService, userService, contentService, courseService, enrollmentService, progressService, authService)
+	// Les réponses rejouables sont partagées entre instances via la base.
+	idempotent := httpmiddleware.Idempotency(idempotency.NewDBStore(dbClient), cfg.IdempotencyTTL)
+
+	graphQLLimits := graph.Options{MaxDepth: cfg.GraphQLMaxDepth, MaxComplexity: cfg.GraphQLMaxComplexity}
+	router := newRouter(logger, limits, idempotent, cfg.TrustedProxies, cfg.PublicURL, cfg.ValidateRequests, graphQLLimits, cfg.ContentDownloadMode == "proxy", dbClient, orgService, userService, contentService, courseService, enrollmentService, progressService, searchService, privacyService, authService, ssoService, scimService, passkeyService, magicLinkService, serviceAccountService, localStorage)
 	server := &http.Server{
 		Addr:              cfg.APIAddr,
 		Handler:           router,
@@ -87,27 +236,82 @@ func main() {
 	}
 
 	go func() {
-		log.Printf("api: listening on %s", cfg.APIAddr)
+		slog.Info("api: listening", "addr", cfg.APIAddr)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("api: server error: %v", err)
+			fatal("api: server error", err)
 		}
 	}()
 
+	// Les routes d'exploitation ne sont servies que sur un listener interne, désactivé par défaut.
+	var debugServer *http.Server
+	if cfg.DebugAddr != "" {
+		debugServer = &http.Server{
+			Addr:              cfg.DebugAddr,
+			Handler:           newDebugRouter(logLevel),
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		}
+		go func() {
+			slog.Info("api: debug listener", "addr", cfg.DebugAddr)
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fatal("api: debug server error", err)
+			}
+		}()
+	}
+
 	<-ctx.Done()
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
-	log.Println("api: shutting down gracefully")
+	slog.Info("api: shutting down gracefully")
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Printf("api: shutdown error: %v", err)
+		slog.Error("api: shutdown error", "error", err)
+	}
+	if debugServer != nil {
+		if err := debugServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("api: debug shutdown error", "error", err)
+		}
 	}
 }
 
-func newRouter(client *ent.Client, orgService *organization.Service, userService *user.Service, contentService *content.Service, courseService *course.Service, enrollmentService *enrollment.Service, progressService *progress.Service, authService *auth.Service) http.Handler {
+// newDebugRouter sert les routes d'exploitation (niveau de log) sur le listener interne.
+func newDebugRouter(logLevel *slog.LevelVar) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.Recoverer)
+	r.Method(http.MethodGet, "/debug/log-level", logging.LevelHandler(logLevel))
+	r.Method(http.MethodPut, "/debug/log-level", logging.LevelHandler(logLevel))
+	return r
+}
+
+// objectStorage couvre les besoins des contenus et des archives d'export RGPD.
+type objectStorage interface {
+	content.Storage
+	privacy.Storage
+}
+
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// rateLimits regroupe le limiteur partagé et les règles appliquées aux routes.
+type rateLimits struct {
+	limiter ratelimit.Limiter
+	auth    ratelimit.Rule
+	api     ratelimit.Rule
+	magic   ratelimit.Rule
+}
+
+func newRouter(logger *slog.Logger, limits rateLimits, idempotent func(http.Handler) http.Handler, trustedProxies []netip.Prefix, publicURL string, validateRequests bool, graphQLLimits graph.Options, downloadProxy bool, client *ent.Client, orgService *organization.Service, userService *user.Service, contentService *content.Service, courseService *course.Service, enrollmentService *enrollment.Service, progressService *progress.Service, searchService *search.Service, privacyService *privacy.Service, authService *auth.Service, ssoService *sso.Service, scimService *scim.Service, passkeyService *passkey.Service, magicLinkService *magiclink.Service, serviceAccountService *serviceaccount.Service, localStorage *storage.Local) http.Handler {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
-	r.Use(middleware.Logger)
+	r.Use(httpmiddleware.RealIP(trustedProxies))
+	r.Use(httpmiddleware.RequestLogger(logger, func(token string) (string, string, bool) {
+		userID, orgID, err := authService.Identify(token)
+		if err != nil {
+			return "", "", false
+		}
+		return userID.String(), orgID.String(), true
+	}))
 	r.Use(middleware.Recoverer)
 
 	// ---------- CORS ----------
@@ -129,12 +333,18 @@ func newRouter(client *ent.Client, orgService *organization.Service, userService
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   allowed,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Org-ID"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Org-ID", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposedHeaders:   []string{"Link", "ETag", "Idempotent-Replayed"},
 		AllowCredentials: true, // tu utilises des cookies
 		MaxAge:           300,
 	}))
 
+	// La description OpenAPI sert aussi, sur option, à refuser les corps non conformes.
+	openAPIHandler := httpapi.NewOpenAPIHandler(publicURL)
+	if validateRequests {
+		r.Use(openAPIHandler.Validate)
+	}
+
 	// ---------- Routes ----------
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) {
 		writeJSON(w, http.StatusOK, map[string]string{
@@ -144,41 +354,140 @@ func newRouter(client *ent.Client, orgService *organization.Service, userService
 	})
 	r.Get("/healthz", healthHandler)
 	r.Get("/readyz", readinessHandler(client))
+	openAPIHandler.Mount(r)
 
 	authHandler := httpapi.NewAuthHandler(authService)
-	r.Route("/auth", authHandler.Mount)
+	authHandler.LimitCredentialRoutes(
+		httpmiddleware.RateLimit(limits.limiter, limits.auth,
+			httpmiddleware.ByIP("auth"),
+			httpmiddleware.ByJSONField("auth", "email"),
+			httpmiddleware.ByJSONField("auth", "organization_id"),
+		),
+	)
+	var ssoHandler *httpapi.SSOHandler
+	if ssoService != nil {
+		ssoHandler = httpapi.NewSSOHandler(ssoService, publicURL)
+	}
+	passkeyHandler := httpapi.NewPasskeyHandler(passkeyService, authService)
+	var magicLinkHandler *httpapi.MagicLinkHandler
+	if magicLinkService != nil {
+		magicLinkHandler = httpapi.NewMagicLinkHandler(magicLinkService, publicURL)
+		magicLinkHandler.LimitRequests(
+			httpmiddleware.RateLimit(limits.limiter, limits.magic,
+				httpmiddleware.ByIP("magic-link"),
+				httpmiddleware.ByJSONField("magic-link", "email"),
+			),
+		)
+	}
+	r.Route("/auth", func(ar chi.Router) {
+		authHandler.Mount(ar)
+		if ssoHandler != nil {
+			ar.Group(func(sr chi.Router) {
+				sr.Use(httpmiddleware.RateLimit(limits.limiter, limits.auth, httpmiddleware.ByIP("sso")))
+				ssoHandler.Mount(sr)
+			})
+		}
+		if magicLinkHandler != nil {
+			magicLinkHandler.Mount(ar)
+		}
+		ar.Route("/webauthn", func(wr chi.Router) {
+			wr.Use(httpmiddleware.RateLimit(limits.limiter, limits.auth, httpmiddleware.ByIP("webauthn")))
+			passkeyHandler.Mount(wr)
+		})
+	})
+
+	// Quota global par organisation sur les routes multi-tenant.
+	apiQuota := httpmiddleware.RateLimit(limits.limiter, limits.api, httpmiddleware.ByOrganization("api"))
+
+	// Stockage local : les URL signées pointent vers l'API, qui sert et reçoit les fichiers.
+	if localStorage != nil {
+		r.Route("/storage", httpapi.NewStorageHandler(localStorage).Mount)
+	}
+	// Mode proxy : les contenus sont servis par l'API sur présentation d'un jeton court.
+	if downloadProxy {
+		r.Route("/downloads", httpapi.NewDownloadHandler(contentService).Mount)
+	}
 
+	scimHandler := httpapi.NewSCIMHandler(scimService, publicURL)
+	r.Route("/scim/v2", func(sr chi.Router) {
+		sr.Use(scimHandler.Authenticate, apiQuota)
+		scimHandler.Mount(sr)
+	})
+
+	serviceAccountHandler := httpapi.NewServiceAccountHandler(serviceAccountService, authService)
+	contentHandler := httpapi.NewContentHandler(contentService)
+	contentHandler.Idempotent(idempotent)
 	orgHandler := httpapi.NewOrgHandler(orgService)
-	r.Route("/orgs", orgHandler.Mount)
+	r.Route("/orgs", func(or chi.Router) {
+		orgHandler.Mount(or)
+		// Les routes d'administration de l'organisation exigent un access token d'administrateur.
+		or.Group(func(ar chi.Router) {
+			ar.Use(serviceAccountHandler.Authenticate)
+			if ssoHandler != nil {
+				ssoHandler.MountOrganization(ar)
+			}
+			scimHandler.MountOrganization(ar)
+			serviceAccountHandler.MountOrganization(ar)
+		})
+		contentHandler.MountOrganization(or)
+	})
 
+	// Les routes multi-tenant acceptent un access token ou une clé d'API de compte de service ;
+	// les clés sont limitées à leurs scopes.
 	userHandler := httpapi.NewUserHandler(userService)
+	privacyHandler := httpapi.NewPrivacyHandler(privacyService)
 	r.Route("/users", func(cr chi.Router) {
-		cr.Use(httpmiddleware.TenantFromHeader)
+		cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+		cr.Use(httpmiddleware.RequireScope(serviceaccount.ScopeUsersRead, serviceaccount.ScopeUsersWrite))
 		userHandler.Mount(cr)
+		privacyHandler.Mount(cr)
 	})
 
-	contentHandler := httpapi.NewContentHandler(contentService)
 	r.Route("/contents", func(cr chi.Router) {
-		cr.Use(httpmiddleware.TenantFromHeader)
+		cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+		cr.Use(httpmiddleware.RequireScope(serviceaccount.ScopeContentsRead, serviceaccount.ScopeContentsWrite))
 		contentHandler.Mount(cr)
 	})
 
 	courseHandler := httpapi.NewCourseHandler(courseService)
 	r.Route("/courses", func(cr chi.Router) {
-		cr.Use(httpmiddleware.TenantFromHeader)
+		cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+		cr.Use(httpmiddleware.RequireScope(serviceaccount.ScopeCoursesRead, serviceaccount.ScopeCoursesWrite))
 		courseHandler.Mount(cr)
 	})
 
 	enrollmentHandler := httpapi.NewEnrollmentHandler(enrollmentService)
+	enrollmentHandler.Idempotent(idempotent)
 	r.Route("/enrollments", func(cr chi.Router) {
-		cr.Use(httpmiddleware.TenantFromHeader)
-		enrollmentHandler.Mount(cr)
+		cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+		cr.With(httpmiddleware.RequireScope(serviceaccount.ScopeEnrollmentsRead, serviceaccount.ScopeEnrollmentsWrite)).
+			Group(enrollmentHandler.Mount)
 		progressHandler := httpapi.NewProgressHandler(progressService)
+		progressHandler.Idempotent(idempotent)
 		cr.Route("/{id}/progress", func(pr chi.Router) {
+			// La lecture de la progression relève du reporting.
+			pr.Use(httpmiddleware.RequireScope(serviceaccount.ScopeReportsRead, serviceaccount.ScopeEnrollmentsWrite))
 			progressHandler.Mount(pr)
 		})
 	})
 
+	// La recherche filtre elle-même les types selon les scopes des comptes de service.
+	searchHandler := httpapi.NewSearchHandler(searchService)
+	r.Route("/search", func(cr chi.Router) {
+		cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+		searchHandler.Mount(cr)
+	})
+
+	// GraphQL : les resolvers appliquent eux-mêmes le tenant, les rôles et les scopes.
+	graphQLHandler := httpapi.NewGraphQLHandler(graph.NewSchema(client, courseService, enrollmentService, progressService), graphQLLimits)
+	r.Route("/graphql", func(gr chi.Router) {
+		graphQLHandler.MountSchema(gr)
+		gr.Group(func(cr chi.Router) {
+			cr.Use(serviceAccountHandler.Authenticate, httpmiddleware.TenantFromHeader, apiQuota)
+			graphQLHandler.Mount(cr)
+		})
+	})
+
 	return r
 }
 
@@ -205,6 +514,6 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	if err := json.NewEncoder(w).Encode(payload); err != nil {
-		log.Printf("api: write json error: %v", err)
+		slog.Error("api: write json error", "error", err)
 	}
 }
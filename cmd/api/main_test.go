@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/graph"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/magiclink"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/sso"
+)
+
+// TestOpenAPICoversRoutes échoue dès qu'une route montée manque à la description OpenAPI, ou
+// qu'une opération décrite ne correspond plus à aucune route.
+func TestOpenAPICoversRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// Stockage local, mode proxy, SSO et lien magique montent toutes les routes optionnelles ; les
+	// services ne sont pas appelés par la construction du routeur, et le client ent n'a pas de
+	// base : le schéma GraphQL ne fait qu'y installer son filtre de visibilité.
+	router := newRouter(logger, rateLimits{}, httpmiddleware.Idempotency(nil, 0), nil, "", true, graph.Options{}, true, ent.NewClient(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &sso.Service{}, nil, nil, &magiclink.Service{}, nil, &storage.Local{})
+
+	mounted := map[string]bool{}
+	err := chi.Walk(router.(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		mounted[method+" "+specPath(route)] = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	described := map[string]bool{}
+	for path, item := range doc.Paths {
+		for method := range item {
+			described[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	var missing, stale []string
+	for route := range mounted {
+		if !described[route] {
+			missing = append(missing, route)
+		}
+	}
+	for route := range described {
+		if !mounted[route] {
+			stale = append(stale, route)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+	require.Empty(t, missing, "routes absentes de la description (internal/http/api/openapi.go)")
+	require.Empty(t, stale, "opérations décrites sans route")
+}
+
+// specPath ramène un motif chi à la forme OpenAPI : /courses/{id}/ → /courses/{id},
+// /storage/* → /storage/{key}.
+func specPath(route string) string {
+	if strings.HasSuffix(route, "/*") {
+		route = strings.TrimSuffix(route, "*") + "{key}"
+	}
+	if len(route) > 1 {
+		route = strings.TrimSuffix(route, "/")
+	}
+	return route
+}
+
+// TestDebugRoutesNotPublic vérifie que le niveau de log n'est modifiable que sur le listener interne.
+func TestDebugRoutesNotPublic(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	router := newRouter(logger, rateLimits{}, httpmiddleware.Idempotency(nil, 0), nil, "", false, graph.Options{}, false, ent.NewClient(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/log-level", strings.NewReader(`{"level":"debug"}`)))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	level := new(slog.LevelVar)
+	rec = httptest.NewRecorder()
+	newDebugRouter(level).ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/log-level", strings.NewReader(`{"level":"debug"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, slog.LevelDebug, level.Level())
+}
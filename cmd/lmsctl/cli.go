@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/content"
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/privacy"
+	"lms-go/internal/user"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// cli porte les services et les flux d'une exécution ; main le construit depuis la configuration,
+// les tests depuis une base SQLite.
+type cli struct {
+	in     io.Reader
+	out    io.Writer
+	errOut io.Writer
+	format string
+	org    string
+
+	db          *sql.DB
+	dialect     string
+	orgs        *organization.Service
+	users       *user.Service
+	courses     *course.Service
+	enrollments *enrollment.Service
+	client      *ent.Client
+	// openStorage n'ouvre le stockage que pour les commandes qui en ont besoin (gc, seed -upload).
+	openStorage func(context.Context) (objectStorage, func(), error)
+	gcDefaults  content.GCOptions
+	closers     []func()
+}
+
+// objectStorage couvre le nettoyage des contenus et le dépôt d'objets.
+type objectStorage interface {
+	content.Storage
+	privacy.Storage
+}
+
+func (c *cli) close() {
+	for i := len(c.closers) - 1; i >= 0; i-- {
+		c.closers[i]()
+	}
+}
+
+// parseGlobal lit les flags communs et renvoie la commande et ses arguments.
+func (c *cli) parseGlobal(args []string) ([]string, error) {
+	flags := flag.NewFlagSet("lmsctl", flag.ContinueOnError)
+	flags.SetOutput(c.errOut)
+	flags.StringVar(&c.format, "o", formatTable, "format de sortie : table ou json")
+	flags.StringVar(&c.org, "org", os.Getenv("LMSCTL_ORG"), "organisation visée (slug ou identifiant)")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if c.format != formatTable && c.format != formatJSON {
+		return nil, fmt.Errorf("format de sortie inconnu %q", c.format)
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return nil, errors.New("commande manquante")
+	}
+	return flags.Args(), nil
+}
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("commande manquante")
+	}
+	rest := args[1:]
+	switch args[0] {
+	case "org":
+		return c.orgCommand(ctx, rest)
+	case "user":
+		return c.userCommand(ctx, rest)
+	case "course":
+		return c.courseCommand(ctx, rest)
+	case "enrollment":
+		return c.enrollmentCommand(ctx, rest)
+	case "migrate":
+		return c.migrateCommand(ctx, rest)
+	case "gc":
+		return c.gcCommand(ctx, rest)
+	case "seed":
+		return c.seedCommand(ctx, rest)
+	default:
+		return fmt.Errorf("commande inconnue %q", args[0])
+	}
+}
+
+// subcommand sépare le nom de la sous-commande de ses arguments.
+func subcommand(group string, args []string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("%s : sous-commande manquante", group)
+	}
+	return args[0], args[1:], nil
+}
+
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.errOut)
+	return flags
+}
+
+// oneArg renvoie l'unique argument positionnel restant après les flags.
+func oneArg(flags *flag.FlagSet, what string) (string, error) {
+	if flags.NArg() != 1 {
+		return "", fmt.Errorf("%s attend %s", flags.Name(), what)
+	}
+	return flags.Arg(0), nil
+}
+
+// render écrit v en JSON, ou les lignes sous les en-têtes en table.
+func (c *cli) render(v any, header []string, rows [][]string) error {
+	if c.format == formatJSON {
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// organization résout l'organisation désignée par -org.
+func (c *cli) organization(ctx context.Context) (*ent.Organization, error) {
+	if strings.TrimSpace(c.org) == "" {
+		return nil, errors.New("organisation requise : -org <slug|id> ou LMSCTL_ORG")
+	}
+	return c.findOrganization(ctx, c.org)
+}
+
+func (c *cli) findOrganization(ctx context.Context, ref string) (*ent.Organization, error) {
+	var (
+		org *ent.Organization
+		err error
+	)
+	if id, perr := uuid.Parse(ref); perr == nil {
+		org, err = c.orgs.Get(ctx, id)
+	} else {
+		org, err = c.orgs.GetBySlug(ctx, strings.ToLower(strings.TrimSpace(ref)))
+	}
+	if errors.Is(err, organization.ErrNotFound) {
+		return nil, fmt.Errorf("organisation %q introuvable", ref)
+	}
+	return org, err
+}
+
+func (c *cli) findUser(ctx context.Context, orgID uuid.UUID, ref string) (*ent.User, error) {
+	var (
+		u   *ent.User
+		err error
+	)
+	if id, perr := uuid.Parse(ref); perr == nil {
+		u, err = c.users.Get(ctx, orgID, id)
+	} else {
+		u, err = c.users.GetByEmail(ctx, orgID, ref)
+	}
+	if errors.Is(err, user.ErrNotFound) {
+		return nil, fmt.Errorf("utilisateur %q introuvable", ref)
+	}
+	return u, err
+}
+
+func (c *cli) findCourse(ctx context.Context, orgID uuid.UUID, ref string) (*ent.Course, error) {
+	var (
+		crs *ent.Course
+		err error
+	)
+	if id, perr := uuid.Parse(ref); perr == nil {
+		crs, err = c.courses.Get(ctx, orgID, id)
+	} else {
+		crs, err = c.courses.GetBySlug(ctx, orgID, ref)
+	}
+	if errors.Is(err, course.ErrNotFound) {
+		return nil, fmt.Errorf("cours %q introuvable", ref)
+	}
+	return crs, err
+}
+
+// generatePassword produit un mot de passe aléatoire de 20 caractères.
+func generatePassword() (string, error) {
+	buf := make([]byte, 15)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/user"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func newTestCLI(t *testing.T) (*cli, *ent.Client, *storage.Memory) {
+	db, err := sql.Open("sqlite", "file:lmsctl?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	require.NoError(t, client.Schema.Create(context.Background()))
+
+	store := storage.NewMemory()
+	c := &cli{
+		db:          db,
+		dialect:     dialect.SQLite,
+		orgs:        organization.NewService(client),
+		users:       user.NewService(client),
+		courses:     course.NewService(client),
+		enrollments: enrollment.NewService(client),
+		client:      client,
+		openStorage: func(context.Context) (objectStorage, func(), error) {
+			return store, func() {}, nil
+		},
+	}
+	return c, client, store
+}
+
+// exec lance une commande comme depuis le shell et renvoie la sortie standard.
+func exec(t *testing.T, c *cli, stdin string, args ...string) (string, error) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	c.in, c.out, c.errOut = strings.NewReader(stdin), &out, &errOut
+	rest, err := c.parseGlobal(args)
+	require.NoError(t, err)
+	err = c.run(context.Background(), rest)
+	return out.String(), err
+}
+
+func TestCLI(t *testing.T) {
+	c, client, store := newTestCLI(t)
+	ctx := context.Background()
+
+	out, err := exec(t, c, "", "-o", "json", "org", "create", "-name", "Acme Corp")
+	require.NoError(t, err)
+	var org orgView
+	require.NoError(t, json.Unmarshal([]byte(out), &org))
+	require.Equal(t, "acme-corp", org.Slug)
+
+	out, err = exec(t, c, "", "org", "list")
+	require.NoError(t, err)
+	require.Contains(t, out, "SLUG")
+	require.Contains(t, out, "acme-corp")
+
+	_, err = exec(t, c, "", "user", "create", "-email", "a@example.com")
+	require.ErrorContains(t, err, "organisation requise")
+
+	out, err = exec(t, c, "", "-o", "json", "-org", "acme-corp", "user", "create", "-email", "admin@example.com", "-role", "admin")
+	require.NoError(t, err)
+	var admin userView
+	require.NoError(t, json.Unmarshal([]byte(out), &admin))
+	require.Equal(t, "admin", admin.Role)
+	require.NotEmpty(t, admin.Password, "le mot de passe généré est affiché")
+	stored, err := client.User.Get(ctx, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, auth.VerifyPassword(stored.PasswordHash, admin.Password))
+
+	out, err = exec(t, c, "", "-o", "json", "-org", org.ID.String(), "user", "reset-password", "-password", "nouveau-secret", "admin@example.com")
+	require.NoError(t, err)
+	var reset userView
+	require.NoError(t, json.Unmarshal([]byte(out), &reset))
+	require.Empty(t, reset.Password, "un mot de passe fourni n'est pas réaffiché")
+	stored, err = client.User.Get(ctx, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, auth.VerifyPassword(stored.PasswordHash, "nouveau-secret"))
+
+	for _, email := range []string{"l1@example.com", "l2@example.com"} {
+		_, err = exec(t, c, "", "-org", "acme-corp", "user", "create", "-email", email)
+		require.NoError(t, err)
+	}
+	out, err = exec(t, c, "", "-org", "acme-corp", "user", "deactivate", "l2@example.com")
+	require.NoError(t, err)
+	require.Contains(t, out, "inactive")
+
+	crs, err := c.courses.Create(ctx, course.CreateCourseInput{OrganizationID: org.ID, Title: "Sécurité", Slug: "securite"})
+	require.NoError(t, err)
+	_, err = c.courses.AddModule(ctx, org.ID, crs.ID, course.ModuleInput{Title: "Intro", ModuleType: "article"})
+	require.NoError(t, err)
+	out, err = exec(t, c, "", "-o", "json", "-org", "acme-corp", "course", "publish", "securite")
+	require.NoError(t, err)
+	var published courseView
+	require.NoError(t, json.Unmarshal([]byte(out), &published))
+	require.Equal(t, course.StatusPublished, published.Status)
+	require.Equal(t, 1, published.Modules)
+
+	doc, err := exec(t, c, "", "-org", "acme-corp", "course", "export", "securite")
+	require.NoError(t, err)
+	require.Contains(t, doc, course.ExportFormat)
+	out, err = exec(t, c, doc, "-o", "json", "-org", "acme-corp", "course", "import", "-slug", "securite-v2")
+	require.NoError(t, err)
+	var imported courseView
+	require.NoError(t, json.Unmarshal([]byte(out), &imported))
+	require.Equal(t, "securite-v2", imported.Slug)
+	require.Equal(t, course.StatusDraft, imported.Status)
+	require.Equal(t, 1, imported.Modules)
+
+	// Un fichier rejoué ne réinscrit personne ; les lignes en échec n'arrêtent pas le traitement.
+	csv := "email,nom\nl1@example.com,Un\n\nl2@example.com,Deux\ninconnu@example.com,X\n"
+	out, err = exec(t, c, csv, "-o", "json", "-org", "acme-corp", "enrollment", "bulk-add", "-course", "securite")
+	require.ErrorContains(t, err, "1 ligne(s) en échec sur 3")
+	var results []bulkResult
+	require.NoError(t, json.Unmarshal([]byte(out), &results))
+	require.Len(t, results, 3)
+	require.Equal(t, enrollment.StatusActive, results[0].Status)
+	require.NotNil(t, results[0].EnrollmentID)
+	require.Equal(t, enrollment.StatusActive, results[1].Status, "un compte désactivé reste inscriptible")
+	require.Equal(t, bulkFailed, results[2].Status)
+	require.Equal(t, 5, results[2].Line)
+
+	out, err = exec(t, c, "l1@example.com\n", "-org", "acme-corp", "enrollment", "bulk-add", "-course", crs.ID.String())
+	require.NoError(t, err)
+	require.Contains(t, out, bulkAlreadyEnrolled)
+
+	// gc conserve les archives d'export RGPD, qu'aucun contenu ne référence.
+	store.Put("privacy-exports/"+org.ID.String()+"/x.zip", []byte("zip"), "application/zip")
+	store.Put("orphelin.bin", []byte("x"), "application/octet-stream")
+	time.Sleep(5 * time.Millisecond)
+	out, err = exec(t, c, "", "-o", "json", "gc", "-dry-run", "-orphan-grace", "1ms")
+	require.NoError(t, err)
+	var report gcView
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.True(t, report.DryRun)
+	require.Equal(t, []string{"orphelin.bin"}, report.Orphans)
+
+	out, err = exec(t, c, "", "org", "archive", "acme-corp")
+	require.NoError(t, err)
+	require.Contains(t, out, "inactive")
+
+	out, err = exec(t, c, "", "-o", "json", "seed", "-seed", "3", "-users", "5", "-courses", "2", "-upload")
+	require.NoError(t, err)
+	var seeded seedView
+	require.NoError(t, json.Unmarshal([]byte(out), &seeded))
+	require.Len(t, seeded.Organizations, 1)
+	require.Equal(t, "demo-3-1", seeded.Organizations[0].Slug)
+	require.Equal(t, 5, seeded.Counts["users.admin"]+seeded.Counts["users.designer"]+seeded.Counts["users.tutor"]+seeded.Counts["users.learner"])
+
+	_, err = exec(t, c, "", "course", "unknown")
+	require.ErrorContains(t, err, "organisation requise")
+	_, err = exec(t, c, "", "-org", "nope", "course", "publish", "x")
+	require.ErrorContains(t, err, "introuvable")
+}
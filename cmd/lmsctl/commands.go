@@ -0,0 +1,481 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/user"
+)
+
+type orgView struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toOrgView(org *ent.Organization) orgView {
+	return orgView{ID: org.ID, Name: org.Name, Slug: org.Slug, Status: org.Status, CreatedAt: org.CreatedAt}
+}
+
+func (v orgView) row() []string {
+	return []string{v.ID.String(), v.Slug, v.Name, v.Status}
+}
+
+var orgHeader = []string{"ID", "SLUG", "NOM", "STATUT"}
+
+type userView struct {
+	ID     uuid.UUID `json:"id"`
+	Email  string    `json:"email"`
+	Role   string    `json:"role"`
+	Status string    `json:"status"`
+	// Password n'est renseigné que lorsque lmsctl l'a généré.
+	Password string `json:"password,omitempty"`
+}
+
+func toUserView(u *ent.User) userView {
+	return userView{ID: u.ID, Email: u.Email, Role: u.Role, Status: u.Status}
+}
+
+func (c *cli) renderUser(v userView) error {
+	header := []string{"ID", "EMAIL", "RÔLE", "STATUT"}
+	row := []string{v.ID.String(), v.Email, v.Role, v.Status}
+	if v.Password != "" {
+		header = append(header, "MOT DE PASSE")
+		row = append(row, v.Password)
+	}
+	return c.render(v, header, [][]string{row})
+}
+
+type courseView struct {
+	ID          uuid.UUID  `json:"id"`
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Modules     int        `json:"modules"`
+	// DetachedContents compte, après un import, les modules importés sans leur contenu.
+	DetachedContents int `json:"detached_contents,omitempty"`
+}
+
+func toCourseView(crs *ent.Course) courseView {
+	return courseView{
+		ID:          crs.ID,
+		Slug:        crs.Slug,
+		Title:       crs.Title,
+		Status:      crs.Status,
+		PublishedAt: crs.PublishedAt,
+		Modules:     len(crs.Edges.Modules),
+	}
+}
+
+func (c *cli) renderCourse(v courseView) error {
+	header := []string{"ID", "SLUG", "TITRE", "STATUT", "MODULES"}
+	row := []string{v.ID.String(), v.Slug, v.Title, v.Status, strconv.Itoa(v.Modules)}
+	if v.DetachedContents > 0 {
+		header = append(header, "SANS CONTENU")
+		row = append(row, strconv.Itoa(v.DetachedContents))
+	}
+	return c.render(v, header, [][]string{row})
+}
+
+func (c *cli) orgCommand(ctx context.Context, args []string) error {
+	name, args, err := subcommand("org", args)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case "create":
+		flags := c.flagSet("org create")
+		orgName := flags.String("name", "", "nom de l'organisation")
+		slug := flags.String("slug", "", "slug (dérivé du nom par défaut)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		org, err := c.orgs.Create(ctx, organization.CreateInput{Name: *orgName, Slug: *slug})
+		if err != nil {
+			return err
+		}
+		v := toOrgView(org)
+		return c.render(v, orgHeader, [][]string{v.row()})
+	case "list":
+		flags := c.flagSet("org list")
+		status := flags.String("status", "", "filtre sur le statut (active, inactive)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		orgs, err := c.orgs.List(ctx, *status)
+		if err != nil {
+			return err
+		}
+		views := make([]orgView, 0, len(orgs))
+		rows := make([][]string, 0, len(orgs))
+		for _, org := range orgs {
+			v := toOrgView(org)
+			views = append(views, v)
+			rows = append(rows, v.row())
+		}
+		return c.render(views, orgHeader, rows)
+	case "archive":
+		flags := c.flagSet("org archive")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		ref, err := oneArg(flags, "le slug ou l'identifiant de l'organisation")
+		if err != nil {
+			return err
+		}
+		org, err := c.findOrganization(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if err := c.orgs.Archive(ctx, org.ID, org.Version); err != nil {
+			return err
+		}
+		if org, err = c.orgs.Get(ctx, org.ID); err != nil {
+			return err
+		}
+		v := toOrgView(org)
+		return c.render(v, orgHeader, [][]string{v.row()})
+	default:
+		return fmt.Errorf("org : sous-commande inconnue %q", name)
+	}
+}
+
+func (c *cli) userCommand(ctx context.Context, args []string) error {
+	name, args, err := subcommand("user", args)
+	if err != nil {
+		return err
+	}
+	org, err := c.organization(ctx)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case "create":
+		flags := c.flagSet("user create")
+		email := flags.String("email", "", "adresse e-mail")
+		role := flags.String("role", "learner", "rôle (admin, instructor, learner…)")
+		password := flags.String("password", "", "mot de passe (généré si absent)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		generated, err := passwordOrGenerate(password)
+		if err != nil {
+			return err
+		}
+		u, err := c.users.Create(ctx, user.CreateInput{OrganizationID: org.ID, Email: *email, Password: *password, Role: *role})
+		if err != nil {
+			return err
+		}
+		v := toUserView(u)
+		v.Password = generated
+		return c.renderUser(v)
+	case "reset-password":
+		flags := c.flagSet("user reset-password")
+		password := flags.String("password", "", "nouveau mot de passe (généré si absent)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		ref, err := oneArg(flags, "l'e-mail ou l'identifiant de l'utilisateur")
+		if err != nil {
+			return err
+		}
+		u, err := c.findUser(ctx, org.ID, ref)
+		if err != nil {
+			return err
+		}
+		generated, err := passwordOrGenerate(password)
+		if err != nil {
+			return err
+		}
+		// La mise à jour du mot de passe révoque aussi le jeton de rafraîchissement en cours.
+		if u, err = c.users.Update(ctx, org.ID, u.ID, user.UpdateInput{Password: password, IfMatch: []int{u.Version}}); err != nil {
+			return err
+		}
+		v := toUserView(u)
+		v.Password = generated
+		return c.renderUser(v)
+	case "deactivate":
+		flags := c.flagSet("user deactivate")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		ref, err := oneArg(flags, "l'e-mail ou l'identifiant de l'utilisateur")
+		if err != nil {
+			return err
+		}
+		u, err := c.findUser(ctx, org.ID, ref)
+		if err != nil {
+			return err
+		}
+		if err := c.users.Deactivate(ctx, org.ID, u.ID, u.Version); err != nil {
+			return err
+		}
+		if u, err = c.users.Get(ctx, org.ID, u.ID); err != nil {
+			return err
+		}
+		return c.renderUser(toUserView(u))
+	default:
+		return fmt.Errorf("user : sous-commande inconnue %q", name)
+	}
+}
+
+// passwordOrGenerate complète un mot de passe vide et renvoie la valeur générée, à afficher.
+func passwordOrGenerate(password *string) (string, error) {
+	if *password != "" {
+		return "", nil
+	}
+	generated, err := generatePassword()
+	if err != nil {
+		return "", err
+	}
+	*password = generated
+	return generated, nil
+}
+
+func (c *cli) courseCommand(ctx context.Context, args []string) error {
+	name, args, err := subcommand("course", args)
+	if err != nil {
+		return err
+	}
+	org, err := c.organization(ctx)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case "publish":
+		flags := c.flagSet("course publish")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		ref, err := oneArg(flags, "le slug ou l'identifiant du cours")
+		if err != nil {
+			return err
+		}
+		crs, err := c.findCourse(ctx, org.ID, ref)
+		if err != nil {
+			return err
+		}
+		if _, err := c.courses.Publish(ctx, org.ID, crs.ID, crs.Version); err != nil {
+			return err
+		}
+		if crs, err = c.courses.Get(ctx, org.ID, crs.ID); err != nil {
+			return err
+		}
+		return c.renderCourse(toCourseView(crs))
+	case "export":
+		flags := c.flagSet("course export")
+		file := flags.String("file", "-", "fichier de destination (- pour la sortie standard)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		ref, err := oneArg(flags, "le slug ou l'identifiant du cours")
+		if err != nil {
+			return err
+		}
+		crs, err := c.findCourse(ctx, org.ID, ref)
+		if err != nil {
+			return err
+		}
+		doc, err := c.courses.Export(ctx, org.ID, crs.ID)
+		if err != nil {
+			return err
+		}
+		// Le document d'export est toujours du JSON, quel que soit -o.
+		return c.writeFile(*file, func(w io.Writer) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(doc)
+		})
+	case "import":
+		flags := c.flagSet("course import")
+		file := flags.String("file", "-", "document d'export (- pour l'entrée standard)")
+		slug := flags.String("slug", "", "slug du cours créé (celui du document par défaut)")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		var doc course.Export
+		err := c.readFile(*file, func(r io.Reader) error {
+			return json.NewDecoder(r).Decode(&doc)
+		})
+		if err != nil {
+			return fmt.Errorf("lecture du document : %w", err)
+		}
+		result, err := c.courses.Import(ctx, course.ImportInput{OrganizationID: org.ID, Export: &doc, Slug: *slug})
+		if err != nil {
+			return err
+		}
+		v := toCourseView(result.Course)
+		v.DetachedContents = result.DetachedContents
+		return c.renderCourse(v)
+	default:
+		return fmt.Errorf("course : sous-commande inconnue %q", name)
+	}
+}
+
+// bulkResult décrit le sort d'une ligne d'un ajout en masse.
+type bulkResult struct {
+	Line         int        `json:"line"`
+	Email        string     `json:"email"`
+	Status       string     `json:"status"`
+	EnrollmentID *uuid.UUID `json:"enrollment_id,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
+
+const (
+	bulkAlreadyEnrolled = "already_enrolled"
+	bulkFailed          = "failed"
+)
+
+func (c *cli) enrollmentCommand(ctx context.Context, args []string) error {
+	name, args, err := subcommand("enrollment", args)
+	if err != nil {
+		return err
+	}
+	if name != "bulk-add" {
+		return fmt.Errorf("enrollment : sous-commande inconnue %q", name)
+	}
+	org, err := c.organization(ctx)
+	if err != nil {
+		return err
+	}
+	flags := c.flagSet("enrollment bulk-add")
+	courseRef := flags.String("course", "", "slug ou identifiant du cours")
+	groupRef := flags.String("group", "", "identifiant du groupe (optionnel)")
+	file := flags.String("file", "-", "CSV dont la première colonne est l'e-mail (- pour l'entrée standard)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *courseRef == "" {
+		return errors.New("enrollment bulk-add attend -course")
+	}
+	crs, err := c.findCourse(ctx, org.ID, *courseRef)
+	if err != nil {
+		return err
+	}
+	var groupID *uuid.UUID
+	if *groupRef != "" {
+		id, err := uuid.Parse(*groupRef)
+		if err != nil {
+			return fmt.Errorf("groupe invalide %q", *groupRef)
+		}
+		groupID = &id
+	}
+
+	results := []bulkResult{}
+	failed := 0
+	err = c.readFile(*file, func(r io.Reader) error {
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		for first := true; ; first = false {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			email := strings.TrimSpace(record[0])
+			if email == "" || (first && strings.EqualFold(email, "email")) {
+				continue
+			}
+			res := c.enroll(ctx, org.ID, crs.ID, groupID, email)
+			res.Line, _ = reader.FieldPos(0)
+			if res.Status == bulkFailed {
+				failed++
+			}
+			results = append(results, res)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("lecture du CSV : %w", err)
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, res := range results {
+		id := ""
+		if res.EnrollmentID != nil {
+			id = res.EnrollmentID.String()
+		}
+		rows = append(rows, []string{strconv.Itoa(res.Line), res.Email, res.Status, id, res.Error})
+	}
+	if err := c.render(results, []string{"LIGNE", "EMAIL", "RÉSULTAT", "INSCRIPTION", "ERREUR"}, rows); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d ligne(s) en échec sur %d", failed, len(results))
+	}
+	return nil
+}
+
+// enroll inscrit un utilisateur ; une inscription existante n'est pas une erreur, pour qu'un
+// fichier puisse être rejoué.
+func (c *cli) enroll(ctx context.Context, orgID, courseID uuid.UUID, groupID *uuid.UUID, email string) bulkResult {
+	res := bulkResult{Email: email}
+	u, err := c.findUser(ctx, orgID, email)
+	if err != nil {
+		res.Status, res.Error = bulkFailed, err.Error()
+		return res
+	}
+	enr, err := c.enrollments.Enroll(ctx, enrollment.EnrollInput{
+		OrganizationID: orgID,
+		CourseID:       courseID,
+		UserID:         u.ID,
+		GroupID:        groupID,
+	})
+	switch {
+	case errors.Is(err, enrollment.ErrAlreadyEnrolled):
+		res.Status = bulkAlreadyEnrolled
+	case err != nil:
+		res.Status, res.Error = bulkFailed, err.Error()
+	default:
+		res.Status = enr.Status
+		res.EnrollmentID = &enr.ID
+	}
+	return res
+}
+
+func (c *cli) readFile(name string, fn func(io.Reader) error) error {
+	if name == "-" {
+		return fn(c.in)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return fn(f)
+}
+
+func (c *cli) writeFile(name string, fn func(io.Writer) error) (err error) {
+	if name == "-" {
+		return fn(c.out)
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	return fn(f)
+}
@@ -0,0 +1,144 @@
+// Commande lmsctl : administration de la plateforme sans passer par l'API. Elle lit la même
+// configuration que l'API (variables d'environnement) et appelle directement les services.
+//
+//	lmsctl [-o table|json] [-org <slug|id>] <commande>
+//
+//	org create -name <nom> [-slug <slug>]
+//	org list [-status <statut>]
+//	org archive <slug|id>
+//	user create -email <email> [-role <rôle>] [-password <mdp>]
+//	user reset-password [-password <mdp>] <email|id>
+//	user deactivate <email|id>
+//	course publish <slug|id>
+//	course export [-file <fichier>] <slug|id>
+//	course import [-file <fichier>] [-slug <slug>]
+//	enrollment bulk-add -course <slug|id> [-group <id>] [-file <fichier.csv>]
+//	migrate up | down [n] | status
+//	gc [-dry-run] [-orphan-grace <durée>]
+//	seed [-seed <n>] [-orgs <n>] [-users <n>] [-courses <n>] [-prefix <préfixe>] [-password <mdp>] [-upload]
+//
+// Les commandes user, course et enrollment agissent dans l'organisation désignée par -org
+// (ou LMSCTL_ORG). Sans -password, un mot de passe est généré et affiché une seule fois.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"entgo.io/ent/dialect"
+
+	"lms-go/internal/app/config"
+	"lms-go/internal/content"
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/organization"
+	"lms-go/internal/platform/database"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/user"
+)
+
+const usage = `usage: lmsctl [-o table|json] [-org <slug|id>] <commande>
+
+  org create -name <nom> [-slug <slug>]
+  org list [-status <statut>]
+  org archive <slug|id>
+  user create -email <email> [-role <rôle>] [-password <mdp>]
+  user reset-password [-password <mdp>] <email|id>
+  user deactivate <email|id>
+  course publish <slug|id>
+  course export [-file <fichier>] <slug|id>
+  course import [-file <fichier>] [-slug <slug>]
+  enrollment bulk-add -course <slug|id> [-group <id>] [-file <fichier.csv>]
+  migrate up | down [n] | status
+  gc [-dry-run] [-orphan-grace <durée>]
+  seed [-seed <n>] [-orgs <n>] [-users <n>] [-courses <n>] [-prefix <préfixe>] [-password <mdp>] [-upload]
+`
+
+func main() {
+	c := &cli{in: os.Stdin, out: os.Stdout, errOut: os.Stderr}
+	args, err := c.parseGlobal(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "lmsctl:", err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err := setup(ctx, c, args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, "lmsctl:", err)
+		os.Exit(1)
+	}
+	defer c.close()
+	if err := c.run(ctx, args); err != nil {
+		fmt.Fprintln(os.Stderr, "lmsctl:", err)
+		os.Exit(1)
+	}
+}
+
+// setup ouvre la base et les services. Hors migrate, un schéma en retard est refusé comme au
+// démarrage de l'API en mode check : lmsctl n'applique jamais de migration implicitement.
+func setup(ctx context.Context, c *cli, command string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	db, err := database.Open(ctx, database.Config{URL: cfg.DatabaseURL})
+	if err != nil {
+		return err
+	}
+	client := database.NewClientFromDB(db)
+	c.closers = append(c.closers, func() { _ = client.Close() })
+	if command != "migrate" && cfg.DatabaseMigrate != database.MigrateOff {
+		if _, err := database.Migrate(ctx, db, database.MigrateCheck); err != nil {
+			return err
+		}
+	}
+
+	c.db = db
+	c.dialect = dialect.Postgres
+	c.orgs = organization.NewService(client)
+	c.users = user.NewService(client)
+	c.courses = course.NewService(client)
+	c.enrollments = enrollment.NewService(client)
+	c.gcDefaults = content.GCOptions{
+		MultipartStaleAfter: cfg.MultipartStaleAfter,
+		ArchiveRetention:    cfg.ArchiveRetention,
+		DryRun:              cfg.GCDryRun,
+	}
+	c.client = client
+	c.openStorage = func(ctx context.Context) (objectStorage, func(), error) {
+		return newStorage(ctx, cfg)
+	}
+	return nil
+}
+
+// newStorage ouvre le même backend que l'API.
+func newStorage(ctx context.Context, cfg *config.Config) (objectStorage, func(), error) {
+	if cfg.StorageBackend == "local" {
+		local, err := storage.NewLocal(storage.LocalConfig{
+			Root:    cfg.StorageLocalPath,
+			BaseURL: strings.TrimSuffix(cfg.PublicURL, "/") + "/storage",
+			Secret:  []byte(cfg.StorageSigningSecret),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return local, func() { _ = local.Close() }, nil
+	}
+	client, err := storage.NewMinioClient(ctx, storage.Config{
+		Endpoint:       cfg.StorageEndpoint,
+		AccessKey:      cfg.StorageAccessKey,
+		SecretKey:      cfg.StorageSecretKey,
+		Bucket:         cfg.StorageBucket,
+		UseSSL:         cfg.StorageUseSSL,
+		PublicEndpoint: cfg.StoragePublicEndpoint,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, func() {}, nil
+}
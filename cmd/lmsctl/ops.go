@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/content"
+	"lms-go/internal/platform/database"
+	"lms-go/internal/privacy"
+	"lms-go/internal/seed"
+)
+
+type migrationView struct {
+	Version   string     `json:"version"`
+	Name      string     `json:"name"`
+	State     string     `json:"state"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+func (v migrationView) row() []string {
+	at := ""
+	if v.AppliedAt != nil {
+		at = v.AppliedAt.Local().Format("2006-01-02 15:04:05")
+	}
+	return []string{v.Version, v.Name, v.State, at}
+}
+
+var migrationHeader = []string{"VERSION", "NOM", "ÉTAT", "APPLIQUÉE LE"}
+
+func migrationState(st database.MigrationStatus) string {
+	switch {
+	case st.Unknown:
+		return "unknown"
+	case st.Modified:
+		return "modified"
+	case st.Applied:
+		return "applied"
+	default:
+		return "pending"
+	}
+}
+
+// migrateCommand reprend up, down et status de cmd/migrate ; diff et hash, qui travaillent sur
+// les sources, y restent.
+func (c *cli) migrateCommand(ctx context.Context, args []string) error {
+	name, args, err := subcommand("migrate", args)
+	if err != nil {
+		return err
+	}
+	m, err := database.NewMigrator(c.db, c.dialect, database.Migrations())
+	if err != nil {
+		return err
+	}
+	var (
+		done  []database.Migration
+		state string
+	)
+	switch name {
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		views := make([]migrationView, 0, len(statuses))
+		rows := make([][]string, 0, len(statuses))
+		for _, st := range statuses {
+			v := migrationView{Version: st.Version, Name: st.Name, State: migrationState(st), AppliedAt: st.AppliedAt}
+			views = append(views, v)
+			rows = append(rows, v.row())
+		}
+		return c.render(views, migrationHeader, rows)
+	case "up":
+		done, err = m.Up(ctx)
+		state = "applied"
+	case "down":
+		n := 1
+		if len(args) > 0 {
+			if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+				return fmt.Errorf("nombre de migrations invalide %q", args[0])
+			}
+		}
+		done, err = m.Down(ctx, n)
+		state = "reverted"
+	default:
+		return fmt.Errorf("migrate : sous-commande inconnue %q", name)
+	}
+	// Les migrations exécutées avant une erreur sont affichées avec celle-ci.
+	views := make([]migrationView, 0, len(done))
+	rows := make([][]string, 0, len(done))
+	for _, mig := range done {
+		v := migrationView{Version: mig.Version, Name: mig.Name, State: state}
+		views = append(views, v)
+		rows = append(rows, v.row())
+	}
+	if rerr := c.render(views, migrationHeader, rows); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
+
+type gcView struct {
+	DryRun           bool     `json:"dry_run"`
+	AbortedUploads   int      `json:"aborted_uploads"`
+	ExpiredContents  int      `json:"expired_contents"`
+	ExpiredRevisions int      `json:"expired_revisions"`
+	PurgedContents   int      `json:"purged_contents"`
+	RetainedContents int      `json:"retained_contents"`
+	RemovedObjects   int      `json:"removed_objects"`
+	FreedBytes       int64    `json:"freed_bytes"`
+	Orphans          []string `json:"orphans"`
+	OrphanBytes      int64    `json:"orphan_bytes"`
+	Reconciled       bool     `json:"reconciled"`
+}
+
+// gcCommand lance une passe de nettoyage du stockage avec les réglages du worker.
+func (c *cli) gcCommand(ctx context.Context, args []string) error {
+	opts := c.gcDefaults
+	flags := c.flagSet("gc")
+	flags.BoolVar(&opts.DryRun, "dry-run", opts.DryRun, "rapport seulement, rien n'est supprimé")
+	flags.DurationVar(&opts.OrphanGrace, "orphan-grace", opts.OrphanGrace, "âge minimal d'un objet orphelin supprimé (1h par défaut)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	// Les archives d'export RGPD ne sont référencées par aucun contenu.
+	opts.KeepPrefixes = []string{privacy.StoragePrefix}
+
+	store, closeStore, err := c.openStorage(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+	report, err := content.NewService(c.client, store, content.Config{}).CollectGarbage(ctx, opts)
+	if report == nil {
+		return err
+	}
+	v := gcView{
+		DryRun:           report.DryRun,
+		AbortedUploads:   report.AbortedUploads,
+		ExpiredContents:  report.ExpiredContents,
+		ExpiredRevisions: report.ExpiredRevisions,
+		PurgedContents:   report.PurgedContents,
+		RetainedContents: report.RetainedContents,
+		RemovedObjects:   report.RemovedObjects,
+		FreedBytes:       report.FreedBytes,
+		Orphans:          report.Orphans,
+		OrphanBytes:      report.OrphanBytes,
+		Reconciled:       report.Reconciled,
+	}
+	if v.Orphans == nil {
+		v.Orphans = []string{}
+	}
+	rows := [][]string{
+		{"dry_run", strconv.FormatBool(v.DryRun)},
+		{"aborted_uploads", strconv.Itoa(v.AbortedUploads)},
+		{"expired_contents", strconv.Itoa(v.ExpiredContents)},
+		{"expired_revisions", strconv.Itoa(v.ExpiredRevisions)},
+		{"purged_contents", strconv.Itoa(v.PurgedContents)},
+		{"retained_contents", strconv.Itoa(v.RetainedContents)},
+		{"removed_objects", strconv.Itoa(v.RemovedObjects)},
+		{"freed_bytes", strconv.FormatInt(v.FreedBytes, 10)},
+		{"orphans", strconv.Itoa(len(v.Orphans))},
+		{"orphan_bytes", strconv.FormatInt(v.OrphanBytes, 10)},
+		{"reconciled", strconv.FormatBool(v.Reconciled)},
+	}
+	if rerr := c.render(v, []string{"MESURE", "VALEUR"}, rows); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
+
+type seedView struct {
+	Organizations []seedOrgView  `json:"organizations"`
+	Counts        map[string]int `json:"counts"`
+}
+
+type seedOrgView struct {
+	ID         uuid.UUID `json:"id"`
+	Slug       string    `json:"slug"`
+	Name       string    `json:"name"`
+	AdminEmail string    `json:"admin_email"`
+}
+
+// seedCommand génère des données de démonstration déterministes (voir internal/seed).
+func (c *cli) seedCommand(ctx context.Context, args []string) error {
+	var opts seed.Options
+	flags := c.flagSet("seed")
+	flags.Uint64Var(&opts.Seed, "seed", 1, "graine : à graine égale, données identiques")
+	flags.IntVar(&opts.Organizations, "orgs", 1, "nombre d'organisations")
+	flags.IntVar(&opts.Users, "users", 40, "utilisateurs par organisation")
+	flags.IntVar(&opts.Courses, "courses", 6, "cours par organisation")
+	flags.StringVar(&opts.Prefix, "prefix", "demo", "préfixe des slugs d'organisation")
+	flags.StringVar(&opts.Password, "password", "demo-password", "mot de passe de tous les comptes")
+	flags.BoolVar(&opts.Upload, "upload", false, "dépose des contenus factices dans le stockage configuré")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var store seed.Storage
+	if opts.Upload {
+		s, closeStore, err := c.openStorage(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+		store = s
+	}
+	report, err := seed.NewGenerator(c.client, store).Run(ctx, opts)
+	if report == nil {
+		return err
+	}
+
+	v := seedView{Counts: map[string]int{}}
+	rows := make([][]string, 0, len(report.Organizations))
+	for _, org := range report.Organizations {
+		v.Organizations = append(v.Organizations, seedOrgView{ID: org.ID, Slug: org.Slug, Name: org.Name, AdminEmail: org.AdminEmail})
+		rows = append(rows, []string{"organization", org.Slug + " " + org.AdminEmail})
+	}
+	for _, count := range report.Summary() {
+		v.Counts[count.Name] = count.Value
+		rows = append(rows, []string{count.Name, strconv.Itoa(count.Value)})
+	}
+	if rerr := c.render(v, []string{"MESURE", "VALEUR"}, rows); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,181 @@
+// Commande migrate : applique, annule et génère les migrations versionnées du schéma.
+//
+//	migrate up                    applique les migrations en attente
+//	migrate down [n]              annule les n dernières migrations (1 par défaut)
+//	migrate status                liste les migrations et leur état
+//	migrate baseline <version>    adopte une base existante sans exécuter les migrations, après
+//	                              comparaison de son schéma aux migrations rejouées sur -dev-url
+//	migrate diff <nom>            génère la migration qui amène le schéma au niveau des schémas ent
+//	migrate hash                  recalcule atlas.sum après une modification manuelle
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+
+	"ariga.io/atlas/sql/migrate"
+	_ "ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+
+	entmigrate "lms-go/internal/ent/migrate"
+	"lms-go/internal/platform/database"
+)
+
+const defaultDir = "internal/platform/database/migrations"
+
+func main() {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	databaseURL := flags.String("database-url", os.Getenv("DATABASE_URL"), "base à migrer")
+	devURL := flags.String("dev-url", os.Getenv("MIGRATE_DEV_URL"), "base PostgreSQL vide utilisée par diff et baseline pour rejouer les migrations")
+	dir := flags.String("dir", defaultDir, "répertoire des migrations (diff et hash)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: migrate [flags] up | down [n] | status | baseline <version> | diff <nom> | hash")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(os.Args[1:])
+	args := flags.Args()
+	if len(args) == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err := run(ctx, args, *databaseURL, *devURL, *dir); err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, databaseURL, devURL, dir string) error {
+	switch args[0] {
+	case "diff":
+		if len(args) != 2 {
+			return errors.New("diff attend le nom de la migration")
+		}
+		return diff(ctx, dir, devURL, args[1])
+	case "hash":
+		return hash(dir)
+	}
+
+	db, err := database.Open(ctx, database.Config{URL: databaseURL})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	m, err := database.NewMigrator(db, dialect.Postgres, database.Migrations())
+	if err != nil {
+		return err
+	}
+
+	var done []database.Migration
+	switch args[0] {
+	case "up":
+		done, err = m.Up(ctx)
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("nombre de migrations invalide %q", args[1])
+			}
+		}
+		done, err = m.Down(ctx, n)
+	case "baseline":
+		if len(args) != 2 {
+			return errors.New("baseline attend une version")
+		}
+		if devURL == "" {
+			return errors.New("baseline exige -dev-url ou MIGRATE_DEV_URL (base PostgreSQL vide) pour vérifier le schéma")
+		}
+		dev, derr := database.Open(ctx, database.Config{URL: devURL})
+		if derr != nil {
+			return derr
+		}
+		defer dev.Close()
+		done, err = m.Baseline(ctx, args[1], dev)
+	case "status":
+		return status(ctx, m)
+	default:
+		return fmt.Errorf("commande inconnue %q", args[0])
+	}
+	for _, mig := range done {
+		fmt.Printf("%s %s_%s\n", args[0], mig.Version, mig.Name)
+	}
+	if err == nil && len(done) == 0 {
+		fmt.Println("rien à faire")
+	}
+	return err
+}
+
+func status(ctx context.Context, m *database.Migrator) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNOM\tÉTAT\tAPPLIQUÉE LE")
+	pending := 0
+	for _, st := range statuses {
+		state, at := "en attente", ""
+		switch {
+		case st.Unknown:
+			state = "inconnue de ce binaire"
+		case st.Modified:
+			state = "modifiée depuis l'application"
+		case st.Applied:
+			state = "appliquée"
+		default:
+			pending++
+		}
+		if st.AppliedAt != nil {
+			at = st.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", st.Version, st.Name, state, at)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("%d migration(s) en attente\n", pending)
+	return nil
+}
+
+// diff rejoue les migrations existantes sur la base de développement (vide), compare le résultat
+// aux schémas ent et écrit la différence en <version>_<nom>.up.sql / .down.sql. Les suppressions
+// de colonnes et d'index ne sont pas générées : elles s'écrivent à la main, puis `migrate hash`.
+func diff(ctx context.Context, dir, devURL, name string) error {
+	if devURL == "" {
+		return errors.New("diff exige -dev-url ou MIGRATE_DEV_URL (base PostgreSQL vide)")
+	}
+	d, err := sqltool.NewGolangMigrateDir(dir)
+	if err != nil {
+		return err
+	}
+	return schema.Diff(ctx, devURL, name, entmigrate.Tables,
+		schema.WithDir(d),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithIndent("  "),
+	)
+}
+
+func hash(dir string) error {
+	d, err := sqltool.NewGolangMigrateDir(dir)
+	if err != nil {
+		return err
+	}
+	sum, err := d.Checksum()
+	if err != nil {
+		return err
+	}
+	return migrate.WriteSumFile(d, sum)
+}
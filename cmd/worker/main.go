@@ -2,26 +2,73 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
+
+	"lms-go/internal/app/config"
+	"lms-go/internal/content"
+	"lms-go/internal/ent/schema"
+	"lms-go/internal/platform/database"
+	"lms-go/internal/platform/idempotency"
+	"lms-go/internal/platform/logging"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/privacy"
 )
 
 func main() {
-	log.Println("worker: starting background runner")
+	logger, _, err := logging.New(logging.Config{Level: os.Getenv("LOG_LEVEL"), SensitiveKeys: schema.SensitiveFields()})
+	if err != nil {
+		slog.Error("worker: logger init", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+
+	slog.Info("worker: starting background runner")
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
 	if err := run(ctx); err != nil {
-		log.Fatalf("worker: fatal error: %v", err)
+		slog.Error("worker: fatal error", "error", err)
+		os.Exit(1)
 	}
 
-	log.Println("worker: shutdown complete")
+	slog.Info("worker: shutdown complete")
 }
 
 func run(ctx context.Context) error {
-	ticker := time.NewTicker(30 * time.Second)
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	db, err := database.Open(ctx, database.Config{URL: cfg.DatabaseURL})
+	if err != nil {
+		return err
+	}
+	dbClient := database.NewClientFromDB(db)
+	defer dbClient.Close()
+	// Démarré avec l'API, le worker attend le verrou de migration puis ne trouve plus rien à appliquer.
+	applied, err := database.Migrate(ctx, db, cfg.DatabaseMigrate)
+	if err != nil {
+		return err
+	}
+	for _, m := range applied {
+		slog.Info("worker: migration applied", "version", m.Version, "name", m.Name)
+	}
+
+	store, closeStore, err := newStorage(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+	contentService := content.NewService(dbClient, store, content.Config{})
+	privacyService := privacy.NewService(dbClient, store, privacy.Config{})
+	idempotencyStore := idempotency.NewDBStore(dbClient)
+
+	ticker := time.NewTicker(cfg.WorkerInterval)
 	defer ticker.Stop()
 
 	for {
@@ -29,8 +76,103 @@ func run(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			// Placeholder task.
-			log.Println("worker: heartbeat tick")
+			collectGarbage(ctx, contentService, content.GCOptions{
+				MultipartStaleAfter: cfg.MultipartStaleAfter,
+				ArchiveRetention:    cfg.ArchiveRetention,
+				KeepPrefixes:        []string{privacy.StoragePrefix},
+				DryRun:              cfg.GCDryRun,
+			})
+			processPrivacyRequests(ctx, privacyService)
+			purgeIdempotencyKeys(ctx, idempotencyStore)
 		}
 	}
 }
+
+func collectGarbage(ctx context.Context, contentService *content.Service, opts content.GCOptions) {
+	report, err := contentService.CollectGarbage(ctx, opts)
+	if err != nil {
+		slog.Error("worker: garbage collection", "error", err)
+	}
+	if report == nil {
+		return
+	}
+	slog.Info("worker: garbage collection",
+		"dry_run", report.DryRun,
+		"aborted_uploads", report.AbortedUploads,
+		"expired_contents", report.ExpiredContents,
+		"expired_revisions", report.ExpiredRevisions,
+		"purged_contents", report.PurgedContents,
+		"retained_contents", report.RetainedContents,
+		"removed_objects", report.RemovedObjects,
+		"freed_bytes", report.FreedBytes,
+		"orphans", len(report.Orphans),
+		"orphan_bytes", report.OrphanBytes,
+		"reconciled", report.Reconciled,
+	)
+	for _, key := range report.Orphans {
+		slog.Debug("worker: orphan object", "key", key, "removed", !report.DryRun)
+	}
+}
+
+func processPrivacyRequests(ctx context.Context, privacyService *privacy.Service) {
+	report, err := privacyService.ProcessDue(ctx)
+	if err != nil {
+		slog.Error("worker: privacy requests", "error", err)
+	}
+	if report == nil {
+		return
+	}
+	if report.Exported+report.Erased+report.Failed+report.Expired > 0 {
+		slog.Info("worker: privacy requests",
+			"exported", report.Exported,
+			"erased", report.Erased,
+			"failed", report.Failed,
+			"expired_exports", report.Expired,
+		)
+	}
+}
+
+// purgeIdempotencyKeys supprime les réponses rejouables expirées.
+func purgeIdempotencyKeys(ctx context.Context, store *idempotency.DBStore) {
+	purged, err := store.Purge(ctx)
+	if err != nil {
+		slog.Error("worker: idempotency keys", "error", err)
+		return
+	}
+	if purged > 0 {
+		slog.Info("worker: idempotency keys", "purged", purged)
+	}
+}
+
+// objectStorage couvre les besoins du nettoyage des contenus et des exports RGPD.
+type objectStorage interface {
+	content.Storage
+	privacy.Storage
+}
+
+// newStorage ouvre le même backend que l'API.
+func newStorage(ctx context.Context, cfg *config.Config) (objectStorage, func(), error) {
+	if cfg.StorageBackend == "local" {
+		local, err := storage.NewLocal(storage.LocalConfig{
+			Root:    cfg.StorageLocalPath,
+			BaseURL: strings.TrimSuffix(cfg.PublicURL, "/") + "/storage",
+			Secret:  []byte(cfg.StorageSigningSecret),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return local, func() { _ = local.Close() }, nil
+	}
+	client, err := storage.NewMinioClient(ctx, storage.Config{
+		Endpoint:       cfg.StorageEndpoint,
+		AccessKey:      cfg.StorageAccessKey,
+		SecretKey:      cfg.StorageSecretKey,
+		Bucket:         cfg.StorageBucket,
+		UseSSL:         cfg.StorageUseSSL,
+		PublicEndpoint: cfg.StoragePublicEndpoint,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, func() {}, nil
+}
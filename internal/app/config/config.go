@@ -2,65 +2,228 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config regroupe la configuration applicative principale.
 type Config struct {
-	APIAddr               string
+	APIAddr string
+	// DebugAddr est l'adresse du listener interne servant /debug/log-level ; vide, il est désactivé.
+	DebugAddr string
+	LogLevel  string
+	PublicURL string
+	// TrustedProxies liste les réseaux des proxys dont les entêtes X-Forwarded-For et X-Real-IP
+	// sont crus ; vide, l'adresse de la connexion fait foi.
+	TrustedProxies []netip.Prefix
+	// ValidateRequests active la validation des corps JSON au regard de la description OpenAPI.
+	ValidateRequests bool
+	// GraphQLMaxDepth et GraphQLMaxComplexity bornent les requêtes acceptées par /graphql.
+	GraphQLMaxDepth       int
+	GraphQLMaxComplexity  int
 	DatabaseURL           string
+	DatabaseMigrate       string // auto (applique les migrations), check (refuse un schéma en retard) ou off
 	ShutdownTimeout       time.Duration
 	ReadHeaderTimeout     time.Duration
 	JWTSecret             string
 	AccessTokenTTL        time.Duration
 	RefreshTokenTTL       time.Duration
+	StorageBackend        string
+	StorageLocalPath      string
+	StorageSigningSecret  string
 	StorageEndpoint       string
 	StorageAccessKey      string
 	StorageSecretKey      string
 	StorageBucket         string
 	StorageUseSSL         bool
 	StoragePublicEndpoint string
+	// ContentDownloadMode vaut "presign" (URL du bucket) ou "proxy" (flux servi par l'API sous /downloads).
+	ContentDownloadMode string
+	// WorkerInterval espace les passes du worker ; MultipartStaleAfter est l'âge au-delà duquel
+	// un dépôt multipart non terminé est abandonné.
+	WorkerInterval      time.Duration
+	MultipartStaleAfter time.Duration
+	// ArchiveRetention précède la suppression définitive des contenus archivés ; GCDryRun
+	// limite le nettoyage à un rapport.
+	ArchiveRetention  time.Duration
+	GCDryRun          bool
+	SAMLCertFile      string
+	SAMLKeyFile       string
+	MFAEncryptionKey  string
+	MFAChallengeTTL   time.Duration
+	WebAuthnRPID      string
+	WebAuthnRPName    string
+	WebAuthnRPOrigins []string
+	SMTPAddr          string
+	SMTPFrom          string
+	SMTPUsername      string
+	SMTPPassword      string
+	MagicLinkURL      string
+	MagicLinkTTL      time.Duration
+	// IdempotencyTTL est la durée de conservation des réponses rejouables (Idempotency-Key).
+	IdempotencyTTL time.Duration
+
+	RateLimitBackend        string
+	RedisAddr               string
+	RedisPassword           string
+	AuthRateLimitPerMinute  int
+	AuthRateLimitBurst      int
+	APIRateLimitPerMinute   int
+	APIRateLimitBurst       int
+	LoginMaxFailedAttempts  int
+	LoginLockoutDuration    time.Duration
+	LoginMaxLockoutDuration time.Duration
+	MagicLinkPerHour        int
 }
 
 const (
 	defaultAPIAddr           = ":8080"
+	defaultLogLevel          = "info"
+	defaultDatabaseMigrate   = "auto"
 	defaultShutdownTimeout   = 10 * time.Second
 	defaultReadHeaderTimeout = 5 * time.Second
 	defaultAccessTokenTTL    = 15 * time.Minute
 	defaultRefreshTokenTTL   = 72 * time.Hour
+	defaultStorageBackend    = "minio"
+	defaultStorageLocalPath  = "data/storage"
 	defaultStorageBucket     = "lms-go"
+	defaultDownloadMode      = "presign"
+	defaultWorkerInterval    = 5 * time.Minute
+	defaultMultipartStale    = 24 * time.Hour
+	defaultArchiveRetention  = 30 * 24 * time.Hour
+	defaultMFAChallengeTTL   = 5 * time.Minute
+	defaultMagicLinkTTL      = 15 * time.Minute
+	defaultGraphQLMaxDepth   = 10
+	defaultGraphQLComplexity = 5000
+	defaultIdempotencyTTL    = 24 * time.Hour
+
+	defaultRateLimitBackend        = "memory"
+	defaultAuthRateLimitPerMinute  = 10
+	defaultAuthRateLimitBurst      = 5
+	defaultAPIRateLimitPerMinute   = 1200
+	defaultAPIRateLimitBurst       = 200
+	defaultLoginMaxFailedAttempts  = 5
+	defaultLoginLockoutDuration    = time.Minute
+	defaultLoginMaxLockoutDuration = time.Hour
+	defaultMagicLinkPerHour        = 5
 )
 
 // Load construit la configuration depuis les variables d'environnement.
 func Load() (*Config, error) {
 	cfg := &Config{
 		APIAddr:               getEnv("API_ADDR", defaultAPIAddr),
+		DebugAddr:             os.Getenv("DEBUG_ADDR"),
+		PublicURL:             os.Getenv("API_PUBLIC_URL"),
+		LogLevel:              getEnv("LOG_LEVEL", defaultLogLevel),
+		ValidateRequests:      boolEnv("API_VALIDATE_REQUESTS", false),
+		GraphQLMaxDepth:       intEnv("GRAPHQL_MAX_DEPTH", defaultGraphQLMaxDepth),
+		GraphQLMaxComplexity:  intEnv("GRAPHQL_MAX_COMPLEXITY", defaultGraphQLComplexity),
 		DatabaseURL:           os.Getenv("DATABASE_URL"),
+		DatabaseMigrate:       getEnv("DB_MIGRATE", defaultDatabaseMigrate),
 		ShutdownTimeout:       durationEnv("SHUTDOWN_TIMEOUT", defaultShutdownTimeout),
 		ReadHeaderTimeout:     durationEnv("READ_HEADER_TIMEOUT", defaultReadHeaderTimeout),
 		JWTSecret:             os.Getenv("JWT_SECRET"),
 		AccessTokenTTL:        durationEnv("ACCESS_TOKEN_TTL", defaultAccessTokenTTL),
 		RefreshTokenTTL:       durationEnv("REFRESH_TOKEN_TTL", defaultRefreshTokenTTL),
+		StorageBackend:        getEnv("STORAGE_BACKEND", defaultStorageBackend),
+		StorageLocalPath:      getEnv("STORAGE_LOCAL_PATH", defaultStorageLocalPath),
+		StorageSigningSecret:  os.Getenv("STORAGE_SIGNING_SECRET"),
 		StorageEndpoint:       os.Getenv("MINIO_ENDPOINT"),
 		StorageAccessKey:      os.Getenv("MINIO_ROOT_USER"),
 		StorageSecretKey:      os.Getenv("MINIO_ROOT_PASSWORD"),
 		StorageBucket:         getEnv("MINIO_BUCKET", defaultStorageBucket),
 		StorageUseSSL:         boolEnv("MINIO_USE_SSL", false),
 		StoragePublicEndpoint: os.Getenv("MINIO_PUBLIC_ENDPOINT"),
+		ContentDownloadMode:   getEnv("CONTENT_DOWNLOAD_MODE", defaultDownloadMode),
+		WorkerInterval:        durationEnv("WORKER_INTERVAL", defaultWorkerInterval),
+		MultipartStaleAfter:   durationEnv("MULTIPART_STALE_AFTER", defaultMultipartStale),
+		ArchiveRetention:      durationEnv("CONTENT_ARCHIVE_RETENTION", defaultArchiveRetention),
+		GCDryRun:              boolEnv("GC_DRY_RUN", false),
+		SAMLCertFile:          os.Getenv("SAML_SP_CERT_FILE"),
+		SAMLKeyFile:           os.Getenv("SAML_SP_KEY_FILE"),
+		MFAEncryptionKey:      os.Getenv("MFA_ENCRYPTION_KEY"),
+		MFAChallengeTTL:       durationEnv("MFA_CHALLENGE_TTL", defaultMFAChallengeTTL),
+		WebAuthnRPID:          os.Getenv("WEBAUTHN_RP_ID"),
+		WebAuthnRPName:        os.Getenv("WEBAUTHN_RP_NAME"),
+		WebAuthnRPOrigins:     listEnv("WEBAUTHN_RP_ORIGINS"),
+		SMTPAddr:              os.Getenv("SMTP_ADDR"),
+		SMTPFrom:              os.Getenv("SMTP_FROM"),
+		SMTPUsername:          os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:          os.Getenv("SMTP_PASSWORD"),
+		MagicLinkURL:          os.Getenv("MAGIC_LINK_URL"),
+		MagicLinkTTL:          durationEnv("MAGIC_LINK_TTL", defaultMagicLinkTTL),
+		IdempotencyTTL:        durationEnv("IDEMPOTENCY_TTL", defaultIdempotencyTTL),
+
+		RateLimitBackend:        getEnv("RATE_LIMIT_BACKEND", defaultRateLimitBackend),
+		RedisAddr:               os.Getenv("REDIS_ADDR"),
+		RedisPassword:           os.Getenv("REDIS_PASSWORD"),
+		AuthRateLimitPerMinute:  intEnv("AUTH_RATE_LIMIT_PER_MINUTE", defaultAuthRateLimitPerMinute),
+		AuthRateLimitBurst:      intEnv("AUTH_RATE_LIMIT_BURST", defaultAuthRateLimitBurst),
+		APIRateLimitPerMinute:   intEnv("API_RATE_LIMIT_PER_MINUTE", defaultAPIRateLimitPerMinute),
+		APIRateLimitBurst:       intEnv("API_RATE_LIMIT_BURST", defaultAPIRateLimitBurst),
+		LoginMaxFailedAttempts:  intEnv("LOGIN_MAX_FAILED_ATTEMPTS", defaultLoginMaxFailedAttempts),
+		LoginLockoutDuration:    durationEnv("LOGIN_LOCKOUT_DURATION", defaultLoginLockoutDuration),
+		LoginMaxLockoutDuration: durationEnv("LOGIN_MAX_LOCKOUT_DURATION", defaultLoginMaxLockoutDuration),
+		MagicLinkPerHour:        intEnv("MAGIC_LINK_RATE_LIMIT_PER_HOUR", defaultMagicLinkPerHour),
 	}
 	if cfg.DatabaseURL == "" {
 		return nil, fmt.Errorf("config: DATABASE_URL is required")
 	}
+	for _, raw := range listEnv("TRUSTED_PROXIES") {
+		prefix, err := parsePrefix(raw)
+		if err != nil {
+			return nil, fmt.Errorf("config: invalid TRUSTED_PROXIES entry %q", raw)
+		}
+		cfg.TrustedProxies = append(cfg.TrustedProxies, prefix)
+	}
+	switch cfg.DatabaseMigrate {
+	case "auto", "check", "off":
+	default:
+		return nil, fmt.Errorf("config: unknown DB_MIGRATE %q", cfg.DatabaseMigrate)
+	}
 	if cfg.JWTSecret == "" {
 		return nil, fmt.Errorf("config: JWT_SECRET is required")
 	}
-	if cfg.StorageEndpoint == "" {
-		return nil, fmt.Errorf("config: MINIO_ENDPOINT is required")
+	switch cfg.StorageBackend {
+	case "minio":
+		if cfg.StorageEndpoint == "" {
+			return nil, fmt.Errorf("config: MINIO_ENDPOINT is required")
+		}
+		if cfg.StorageAccessKey == "" || cfg.StorageSecretKey == "" {
+			return nil, fmt.Errorf("config: MINIO credentials required")
+		}
+	case "local":
+		// Les URL signées servies par l'API ne doivent pas dépendre de l'entête Host.
+		if cfg.PublicURL == "" {
+			return nil, fmt.Errorf("config: API_PUBLIC_URL is required for local storage")
+		}
+	default:
+		return nil, fmt.Errorf("config: unknown STORAGE_BACKEND %q", cfg.StorageBackend)
+	}
+	// Les URL du stockage local et les jetons du mode proxy sont, à défaut, signés avec le secret JWT.
+	if cfg.StorageSigningSecret == "" {
+		cfg.StorageSigningSecret = cfg.JWTSecret
+	}
+	if cfg.ContentDownloadMode != "presign" && cfg.ContentDownloadMode != "proxy" {
+		return nil, fmt.Errorf("config: unknown CONTENT_DOWNLOAD_MODE %q", cfg.ContentDownloadMode)
 	}
-	if cfg.StorageAccessKey == "" || cfg.StorageSecretKey == "" {
-		return nil, fmt.Errorf("config: MINIO credentials required")
+	if cfg.ContentDownloadMode == "proxy" && cfg.PublicURL == "" {
+		return nil, fmt.Errorf("config: API_PUBLIC_URL is required for proxy downloads")
+	}
+	if cfg.SMTPAddr != "" && cfg.SMTPFrom == "" {
+		return nil, fmt.Errorf("config: SMTP_FROM is required when SMTP_ADDR is set")
+	}
+	switch cfg.RateLimitBackend {
+	case "memory":
+	case "redis":
+		if cfg.RedisAddr == "" {
+			return nil, fmt.Errorf("config: REDIS_ADDR is required for redis rate limiting")
+		}
+	default:
+		return nil, fmt.Errorf("config: unknown RATE_LIMIT_BACKEND %q", cfg.RateLimitBackend)
 	}
 	return cfg, nil
 }
@@ -72,6 +235,29 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// listEnv lit une liste de valeurs séparées par des virgules.
+func listEnv(key string) []string {
+	var values []string
+	for _, part := range strings.Split(os.Getenv(key), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// parsePrefix lit un réseau CIDR ou une adresse seule.
+func parsePrefix(raw string) (netip.Prefix, error) {
+	if ip, err := netip.ParseAddr(raw); err == nil {
+		return netip.PrefixFrom(ip, ip.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
 func durationEnv(key string, fallback time.Duration) time.Duration {
 	val := os.Getenv(key)
 	if val == "" {
@@ -84,6 +270,18 @@ func durationEnv(key string, fallback time.Duration) time.Duration {
 	return d
 }
 
+func intEnv(key string, fallback int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return fallback
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		return fallback
+	}
+	return i
+}
+
 func boolEnv(key string, fallback bool) bool {
 	val := os.Getenv(key)
 	if val == "" {
@@ -1,6 +1,9 @@
 package auth
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrEmailAlreadyUsed   = errors.New("auth: email already used")
@@ -8,4 +11,40 @@ var (
 	ErrUserInactive       = errors.New("auth: user inactive")
 	ErrInvalidToken       = errors.New("auth: invalid token")
 	ErrAmbiguousIdentity  = errors.New("auth: ambiguous identity")
+	ErrAccountLocked      = errors.New("auth: account locked")
+	ErrMFARequired        = errors.New("auth: second factor required")
+	ErrInvalidMFACode     = errors.New("auth: invalid second factor code")
+	ErrMFAAlreadyEnabled  = errors.New("auth: second factor already enabled")
+	ErrMFANotEnabled      = errors.New("auth: second factor not enabled")
 )
+
+// LockoutError précise jusqu'à quand le compte reste verrouillé ; errors.Is(err, ErrAccountLocked) reste vrai.
+type LockoutError struct {
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return ErrAccountLocked.Error() + " until " + e.Until.UTC().Format(time.RFC3339)
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// MFAChallengeError est renvoyée par Login lorsque le second facteur est attendu ;
+// errors.Is(err, ErrMFARequired) reste vrai. Token s'échange via VerifyMFA.
+type MFAChallengeError struct {
+	Token     string
+	ExpiresAt time.Time
+	// EnrollmentRequired indique que la politique de l'organisation impose un second facteur
+	// que l'utilisateur n'a pas encore configuré : Token permet alors de l'enrôler.
+	EnrollmentRequired bool
+}
+
+func (e *MFAChallengeError) Error() string {
+	return ErrMFARequired.Error()
+}
+
+func (e *MFAChallengeError) Unwrap() error {
+	return ErrMFARequired
+}
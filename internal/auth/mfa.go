@@ -0,0 +1,442 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entuser "lms-go/internal/ent/user"
+)
+
+const (
+	recoveryCodeCount = 10
+	// mfaSettingsKey regroupe dans Organization.settings la politique MFA (required_roles).
+	mfaSettingsKey = "mfa"
+)
+
+var recoveryEncoding = base32.NewEncoding("abcdefghijkmnpqrstuvwxyz23456789").WithPadding(base32.NoPadding)
+
+// TOTPEnrollment contient le secret à saisir ou à scanner dans l'application d'authentification.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// MFAStatus décrit l'état du second facteur d'un utilisateur.
+type MFAStatus struct {
+	Enabled                bool
+	EnabledAt              *time.Time
+	Required               bool
+	RecoveryCodesRemaining int
+}
+
+// MFASubject renvoie l'utilisateur d'un access token ou d'un token de défi MFA : ce dernier
+// permet d'enrôler un second facteur imposé avant d'obtenir une session.
+func (s *Service) MFASubject(ctx context.Context, token string) (*ent.User, error) {
+	claims, err := s.tokens.ParseClaims(token)
+	if err != nil || (claims.TokenType != "access" && claims.TokenType != "mfa") {
+		return nil, ErrInvalidToken
+	}
+	return s.claimsUser(ctx, claims)
+}
+
+// AccessSubject renvoie l'utilisateur d'un access token ; contrairement à MFASubject, le token de
+// défi MFA est refusé.
+func (s *Service) AccessSubject(ctx context.Context, token string) (*ent.User, error) {
+	claims, err := s.tokens.ParseClaims(token)
+	if err != nil || claims.TokenType != "access" {
+		return nil, ErrInvalidToken
+	}
+	return s.claimsUser(ctx, claims)
+}
+
+// MFAStatus renvoie l'état du second facteur de l'utilisateur.
+func (s *Service) MFAStatus(ctx context.Context, userID uuid.UUID) (MFAStatus, error) {
+	user, err := s.mfaUser(ctx, userID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	required, err := s.mfaRequired(ctx, user)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	return MFAStatus{
+		Enabled:                user.MfaEnabledAt != nil,
+		EnabledAt:              user.MfaEnabledAt,
+		Required:               required,
+		RecoveryCodesRemaining: len(user.MfaRecoveryCodes),
+	}, nil
+}
+
+// BeginTOTPEnrollment génère un nouveau secret TOTP, stocké chiffré et inactif jusqu'à confirmation.
+func (s *Service) BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID) (TOTPEnrollment, error) {
+	user, err := s.client.User.Query().
+		Where(entuser.IDEQ(userID)).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TOTPEnrollment{}, ErrInvalidToken
+		}
+		return TOTPEnrollment{}, err
+	}
+	if user.MfaEnabledAt != nil {
+		return TOTPEnrollment{}, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	encrypted, err := s.encryptSecret(secret)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if err := s.client.User.UpdateOneID(user.ID).
+		SetMfaSecret(encrypted).
+		SetMfaLastStep(0).
+		Exec(ctx); err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	issuer := tokenIssuer
+	if user.Edges.Organization != nil {
+		issuer = user.Edges.Organization.Name
+	}
+	return TOTPEnrollment{Secret: secret, URI: totpURI(issuer, user.Email, secret)}, nil
+}
+
+// ConfirmTOTPEnrollment active le second facteur après vérification d'un premier code et
+// renvoie les codes de secours, affichés une seule fois.
+func (s *Service) ConfirmTOTPEnrollment(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.mfaUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.MfaEnabledAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if user.MfaSecret == nil {
+		return nil, ErrMFANotEnabled
+	}
+	secret, err := s.decryptSecret(*user.MfaSecret)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := validateTOTP(secret, code, s.now(), 0)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOneID(user.ID).
+		SetMfaEnabledAt(s.now()).
+		AddVersion(1).
+		SetMfaLastStep(step).
+		SetMfaRecoveryCodes(hashes).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyMFA échange un token de défi et un code (TOTP ou code de secours) contre un couple de tokens.
+// Les codes erronés alimentent le même verrouillage progressif que les mots de passe.
+func (s *Service) VerifyMFA(ctx context.Context, challengeToken, code string) (TokenPair, error) {
+	claims, err := s.tokens.ParseClaims(challengeToken)
+	if err != nil || claims.TokenType != "mfa" {
+		return TokenPair{}, ErrInvalidToken
+	}
+	user, err := s.claimsUser(ctx, claims)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if user.Status != "active" {
+		return TokenPair{}, ErrUserInactive
+	}
+	if user.LockedUntil != nil && s.now().Before(*user.LockedUntil) {
+		return TokenPair{}, &LockoutError{Until: *user.LockedUntil}
+	}
+	if user.MfaEnabledAt == nil {
+		return TokenPair{}, ErrMFANotEnabled
+	}
+
+	ok, err := s.checkSecondFactor(ctx, user, code)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if !ok {
+		err := s.registerFailedAttempt(ctx, user.ID)
+		if errors.Is(err, ErrInvalidCredentials) {
+			return TokenPair{}, ErrInvalidMFACode
+		}
+		return TokenPair{}, err
+	}
+
+	tokens, refreshID, err := s.tokens.IssuePair(user.ID, user.OrganizationID, user.Role)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if err := s.client.User.UpdateOneID(user.ID).
+		SetRefreshTokenID(refreshID).
+		SetLastLoginAt(s.now()).
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		Exec(ctx); err != nil {
+		return TokenPair{}, err
+	}
+	return tokens, nil
+}
+
+// RegenerateRecoveryCodes remplace les codes de secours après vérification d'un code valide.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.enabledMFAUser(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOneID(user.ID).
+		SetMfaRecoveryCodes(hashes).
+		AddVersion(1).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableMFA supprime le second facteur après vérification d'un code valide.
+// Refusé lorsque la politique de l'organisation l'impose pour le rôle de l'utilisateur.
+func (s *Service) DisableMFA(ctx context.Context, userID uuid.UUID, code string) error {
+	user, err := s.enabledMFAUser(ctx, userID, code)
+	if err != nil {
+		return err
+	}
+	required, err := s.mfaRequired(ctx, user)
+	if err != nil {
+		return err
+	}
+	if required {
+		return ErrMFARequired
+	}
+	return s.client.User.UpdateOneID(user.ID).
+		ClearMfaSecret().
+		ClearMfaEnabledAt().
+		AddVersion(1).
+		ClearMfaRecoveryCodes().
+		SetMfaLastStep(0).
+		Exec(ctx)
+}
+
+// enabledMFAUser vérifie le code d'un utilisateur dont le second facteur est actif. Comme pour
+// VerifyMFA, les codes erronés alimentent le verrouillage progressif.
+func (s *Service) enabledMFAUser(ctx context.Context, userID uuid.UUID, code string) (*ent.User, error) {
+	user, err := s.mfaUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != "active" {
+		return nil, ErrUserInactive
+	}
+	if user.LockedUntil != nil && s.now().Before(*user.LockedUntil) {
+		return nil, &LockoutError{Until: *user.LockedUntil}
+	}
+	if user.MfaEnabledAt == nil {
+		return nil, ErrMFANotEnabled
+	}
+	ok, err := s.checkSecondFactor(ctx, user, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		err := s.registerFailedAttempt(ctx, user.ID)
+		if errors.Is(err, ErrInvalidCredentials) {
+			return nil, ErrInvalidMFACode
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// checkSecondFactor valide un code TOTP, ou à défaut consomme un code de secours.
+func (s *Service) checkSecondFactor(ctx context.Context, user *ent.User, code string) (bool, error) {
+	if user.MfaSecret != nil {
+		secret, err := s.decryptSecret(*user.MfaSecret)
+		if err != nil {
+			return false, err
+		}
+		if step, ok := validateTOTP(secret, code, s.now(), user.MfaLastStep); ok {
+			// Mise à jour conditionnelle : deux requêtes concurrentes ne peuvent consommer le même pas.
+			affected, err := s.client.User.Update().
+				Where(entuser.IDEQ(user.ID), entuser.MfaLastStepLT(step)).
+				SetMfaLastStep(step).
+				Save(ctx)
+			if err != nil {
+				return false, err
+			}
+			return affected == 1, nil
+		}
+	}
+
+	hash := s.hashRecoveryCode(code)
+	index := slices.Index(user.MfaRecoveryCodes, hash)
+	if index < 0 {
+		return false, nil
+	}
+	remaining := slices.Delete(slices.Clone(user.MfaRecoveryCodes), index, index+1)
+	// Mise à jour conditionnelle sur la version lue : un code ne peut être consommé deux fois, et
+	// une régénération concurrente n'est pas écrasée par l'ancienne liste.
+	affected, err := s.client.User.Update().
+		Where(entuser.IDEQ(user.ID), entuser.VersionEQ(user.Version)).
+		SetMfaRecoveryCodes(remaining).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// mfaRequired indique si settings.mfa.required_roles de l'organisation inclut le rôle de l'utilisateur.
+func (s *Service) mfaRequired(ctx context.Context, user *ent.User) (bool, error) {
+	org, err := s.client.Organization.Get(ctx, user.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+	policy, _ := org.Settings[mfaSettingsKey].(map[string]any)
+	roles, _ := policy["required_roles"].([]any)
+	for _, role := range roles {
+		if value, ok := role.(string); ok && strings.EqualFold(strings.TrimSpace(value), user.Role) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Service) challenge(user *ent.User, enrollmentRequired bool) error {
+	token, expiresAt, err := s.tokens.IssueChallenge(user.ID, user.OrganizationID, user.Role, s.challengeTTL)
+	if err != nil {
+		return err
+	}
+	return &MFAChallengeError{Token: token, ExpiresAt: expiresAt, EnrollmentRequired: enrollmentRequired}
+}
+
+func (s *Service) claimsUser(ctx context.Context, claims *Claims) (*ent.User, error) {
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	orgID, err := uuid.Parse(claims.OrganizationID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	user, err := s.client.User.Query().
+		Where(entuser.IDEQ(userID), entuser.OrganizationIDEQ(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *Service) mfaUser(ctx context.Context, userID uuid.UUID) (*ent.User, error) {
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// generateRecoveryCodes renvoie les codes en clair (format xxxxx-xxxxx) et leurs empreintes.
+func (s *Service) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := recoveryEncoding.EncodeToString(buf)[:10]
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, s.hashRecoveryCode(raw))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode calcule un HMAC du code normalisé (tirets, espaces et casse ignorés).
+func (s *Service) hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	mac := hmac.New(sha256.New, s.mfaKey)
+	mac.Write([]byte("recovery:" + normalized))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) encryptSecret(secret string) (string, error) {
+	gcm, err := s.mfaCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *Service) decryptSecret(encrypted string) (string, error) {
+	gcm, err := s.mfaCipher()
+	if err != nil {
+		return "", err
+	}
+	raw, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(raw) < gcm.NonceSize() {
+		return "", errors.New("auth: malformed mfa secret")
+	}
+	plain, err := gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+func (s *Service) mfaCipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(s.mfaKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveMFAKey produit la clé AES-256 à partir de la clé configurée, ou du secret JWT à défaut.
+func deriveMFAKey(key, jwtSecret string) []byte {
+	if key == "" {
+		key = "mfa:" + jwtSecret
+	}
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/ent"
+)
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	// Vecteurs SHA1 de la RFC 6238 (annexe B), tronqués à 6 chiffres.
+	require.Equal(t, "287082", totpCode(secret, 59/totpPeriod))
+	require.Equal(t, "081804", totpCode(secret, 1111111109/totpPeriod))
+	require.Equal(t, "005924", totpCode(secret, 1234567890/totpPeriod))
+}
+
+func newMFATestService(t *testing.T, client *ent.Client, slug string, settings map[string]any) (*Service, *ent.User, *time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	org, err := client.Organization.Create().
+		SetName("MFA " + slug).
+		SetSlug(slug).
+		SetSettings(settings).
+		Save(ctx)
+	require.NoError(t, err)
+
+	svc := NewService(client, Config{
+		JWTSecret:         "secret",
+		AccessTokenTTL:    time.Minute,
+		RefreshTokenTTL:   time.Hour,
+		MaxFailedAttempts: 3,
+		MFAEncryptionKey:  "mfa-key",
+	})
+	now := time.Date(2024, 10, 6, 12, 0, 0, 0, time.UTC)
+	svc.withNow(func() time.Time { return now })
+
+	user, err := svc.Register(ctx, RegisterInput{
+		OrganizationID: org.ID,
+		Email:          "designer@" + slug + ".test",
+		Password:       "supersecret",
+		Role:           "designer",
+	})
+	require.NoError(t, err)
+	return svc, user, &now
+}
+
+func currentCode(t *testing.T, secret string, now time.Time) string {
+	t.Helper()
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	return totpCode(key, now.Unix()/totpPeriod)
+}
+
+func TestService_TOTPEnrollmentAndTwoStepLogin(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	svc, user, now := newMFATestService(t, client, "mfa-enroll", map[string]any{})
+
+	enrollment, err := svc.BeginTOTPEnrollment(ctx, user.ID)
+	require.NoError(t, err)
+	uri, err := url.Parse(enrollment.URI)
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, enrollment.Secret, uri.Query().Get("secret"))
+	require.Equal(t, "MFA mfa-enroll", uri.Query().Get("issuer"))
+
+	stored, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.MfaSecret)
+	require.NotContains(t, *stored.MfaSecret, enrollment.Secret)
+
+	// Tant que l'enrôlement n'est pas confirmé, la connexion reste en une étape.
+	_, err = svc.Login(ctx, user.Email, "supersecret")
+	require.NoError(t, err)
+
+	_, err = svc.ConfirmTOTPEnrollment(ctx, user.ID, "000000")
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+	codes, err := svc.ConfirmTOTPEnrollment(ctx, user.ID, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+
+	*now = now.Add(time.Minute)
+	_, err = svc.Login(ctx, user.Email, "supersecret")
+	require.ErrorIs(t, err, ErrMFARequired)
+	var challenge *MFAChallengeError
+	require.ErrorAs(t, err, &challenge)
+	require.False(t, challenge.EnrollmentRequired)
+	require.Equal(t, now.Add(defaultMFAChallengeTTL), challenge.ExpiresAt)
+
+	// Le token de défi n'ouvre pas de session.
+	_, _, err = svc.Profile(ctx, challenge.Token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	code := currentCode(t, enrollment.Secret, *now)
+	tokens, err := svc.VerifyMFA(ctx, challenge.Token, code)
+	require.NoError(t, err)
+	require.NotEmpty(t, tokens.AccessToken)
+
+	// Un code déjà utilisé est refusé.
+	_, err = svc.VerifyMFA(ctx, challenge.Token, code)
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+
+	// Code de secours : utilisable une seule fois, quelle que soit la casse.
+	tokens, err = svc.VerifyMFA(ctx, challenge.Token, codes[0])
+	require.NoError(t, err)
+	require.NotEmpty(t, tokens.AccessToken)
+	_, err = svc.VerifyMFA(ctx, challenge.Token, codes[0])
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+
+	// Les codes erronés alimentent le verrouillage progressif du compte.
+	_, err = svc.VerifyMFA(ctx, challenge.Token, "000000")
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+	_, err = svc.VerifyMFA(ctx, challenge.Token, "000000")
+	require.ErrorIs(t, err, ErrAccountLocked)
+	_, err = svc.VerifyMFA(ctx, challenge.Token, currentCode(t, enrollment.Secret, *now))
+	require.ErrorIs(t, err, ErrAccountLocked)
+
+	status, err := svc.MFAStatus(ctx, user.ID)
+	require.NoError(t, err)
+	require.True(t, status.Enabled)
+	require.Equal(t, recoveryCodeCount-1, status.RecoveryCodesRemaining)
+
+	// Le token de défi expire.
+	*now = now.Add(time.Hour)
+	_, err = svc.VerifyMFA(ctx, challenge.Token, currentCode(t, enrollment.Secret, *now))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestService_MFARequiredByOrganizationPolicy(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	svc, user, now := newMFATestService(t, client, "mfa-policy", map[string]any{
+		"mfa": map[string]any{"required_roles": []any{"admin", "designer"}},
+	})
+
+	_, err := svc.Login(ctx, user.Email, "supersecret")
+	var challenge *MFAChallengeError
+	require.ErrorAs(t, err, &challenge)
+	require.True(t, challenge.EnrollmentRequired)
+
+	// Le token de défi permet d'enrôler le second facteur mais pas de s'en passer.
+	_, err = svc.VerifyMFA(ctx, challenge.Token, "123456")
+	require.ErrorIs(t, err, ErrMFANotEnabled)
+
+	subject, err := svc.MFASubject(ctx, challenge.Token)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, subject.ID)
+	enrollment, err := svc.BeginTOTPEnrollment(ctx, subject.ID)
+	require.NoError(t, err)
+	codes, err := svc.ConfirmTOTPEnrollment(ctx, subject.ID, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+
+	*now = now.Add(time.Minute)
+	tokens, err := svc.VerifyMFA(ctx, challenge.Token, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+	require.NotEmpty(t, tokens.AccessToken)
+
+	// Une fois activé, le token de défi ne permet plus de ré-enrôler un secret.
+	_, err = svc.BeginTOTPEnrollment(ctx, subject.ID)
+	require.ErrorIs(t, err, ErrMFAAlreadyEnabled)
+
+	// La politique interdit la désactivation.
+	require.ErrorIs(t, svc.DisableMFA(ctx, user.ID, codes[0]), ErrMFARequired)
+
+	regenerated, err := svc.RegenerateRecoveryCodes(ctx, user.ID, codes[1])
+	require.NoError(t, err)
+	require.Len(t, regenerated, recoveryCodeCount)
+	_, err = svc.RegenerateRecoveryCodes(ctx, user.ID, codes[2])
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+}
+
+func TestService_DisableMFA(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	svc, user, now := newMFATestService(t, client, "mfa-disable", map[string]any{})
+
+	require.ErrorIs(t, svc.DisableMFA(ctx, user.ID, "123456"), ErrMFANotEnabled)
+
+	enrollment, err := svc.BeginTOTPEnrollment(ctx, user.ID)
+	require.NoError(t, err)
+	_, err = svc.ConfirmTOTPEnrollment(ctx, user.ID, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+
+	// Les codes erronés alimentent le verrouillage, comme pour VerifyMFA.
+	require.ErrorIs(t, svc.DisableMFA(ctx, user.ID, "000000"), ErrInvalidMFACode)
+	_, err = svc.RegenerateRecoveryCodes(ctx, user.ID, "000000")
+	require.ErrorIs(t, err, ErrInvalidMFACode)
+	require.ErrorIs(t, svc.DisableMFA(ctx, user.ID, "000000"), ErrAccountLocked)
+	require.ErrorIs(t, svc.DisableMFA(ctx, user.ID, currentCode(t, enrollment.Secret, *now)), ErrAccountLocked)
+
+	*now = now.Add(24 * time.Hour)
+	require.NoError(t, svc.DisableMFA(ctx, user.ID, currentCode(t, enrollment.Secret, *now)))
+
+	tokens, err := svc.Login(ctx, user.Email, "supersecret")
+	require.NoError(t, err)
+	require.NotEmpty(t, tokens.AccessToken)
+}
+
+func TestService_SecondFactorSingleUseUnderRace(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	svc, user, now := newMFATestService(t, client, "mfa-race", map[string]any{})
+
+	enrollment, err := svc.BeginTOTPEnrollment(ctx, user.ID)
+	require.NoError(t, err)
+	codes, err := svc.ConfirmTOTPEnrollment(ctx, user.ID, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+	*now = now.Add(time.Minute)
+
+	// Deux requêtes concurrentes lisent le même état avant de valider le même code.
+	first, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	second, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+
+	code := currentCode(t, enrollment.Secret, *now)
+	ok, err := svc.checkSecondFactor(ctx, first, code)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = svc.checkSecondFactor(ctx, second, code)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	first, err = client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	second, err = client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+
+	ok, err = svc.checkSecondFactor(ctx, first, codes[0])
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = svc.checkSecondFactor(ctx, second, codes[0])
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	status, err := svc.MFAStatus(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, recoveryCodeCount-1, status.RecoveryCodesRemaining)
+
+	// Un code consommé sur un état antérieur à la régénération n'écrase pas la nouvelle liste.
+	stale, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	*now = now.Add(time.Minute)
+	regenerated, err := svc.RegenerateRecoveryCodes(ctx, user.ID, currentCode(t, enrollment.Secret, *now))
+	require.NoError(t, err)
+	ok, err = svc.checkSecondFactor(ctx, stale, codes[1])
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	stored, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.MfaRecoveryCodes, recoveryCodeCount)
+	require.Contains(t, stored.MfaRecoveryCodes, svc.hashRecoveryCode(regenerated[0]))
+}
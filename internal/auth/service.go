@@ -9,13 +9,24 @@ import (
 
 	"lms-go/internal/ent"
 	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/principal"
+)
+
+const (
+	defaultMaxFailedAttempts  = 5
+	defaultLockoutDuration    = time.Minute
+	defaultMaxLockoutDuration = time.Hour
+	defaultMFAChallengeTTL    = 5 * time.Minute
 )
 
 // Service gère les opérations d'authentification (inscription, connexion, refresh).
 type Service struct {
-	client *ent.Client
-	tokens *Manager
-	now    func() time.Time
+	client       *ent.Client
+	tokens       *Manager
+	lockout      lockoutPolicy
+	mfaKey       []byte
+	challengeTTL time.Duration
+	now          func() time.Time
 }
 
 // Config configure le service d'authentification.
@@ -23,6 +34,37 @@ type Config struct {
 	JWTSecret       string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	// MaxFailedAttempts est le nombre d'échecs consécutifs avant verrouillage du compte.
+	MaxFailedAttempts int
+	// LockoutDuration est la durée du premier verrouillage ; elle double à chaque échec suivant.
+	LockoutDuration time.Duration
+	// MaxLockoutDuration plafonne la durée de verrouillage.
+	MaxLockoutDuration time.Duration
+	// MFAEncryptionKey chiffre les secrets TOTP ; dérivée de JWTSecret si vide.
+	MFAEncryptionKey string
+	// MFAChallengeTTL borne le délai entre le mot de passe et la saisie du second facteur.
+	MFAChallengeTTL time.Duration
+}
+
+type lockoutPolicy struct {
+	maxAttempts int
+	base        time.Duration
+	max         time.Duration
+}
+
+// duration renvoie la durée de verrouillage pour le nombre d'échecs donné (0 si aucun verrouillage).
+func (p lockoutPolicy) duration(attempts int) time.Duration {
+	if attempts < p.maxAttempts {
+		return 0
+	}
+	d := p.base
+	for i := p.maxAttempts; i < attempts && d < p.max; i++ {
+		d *= 2
+	}
+	if d > p.max {
+		d = p.max
+	}
+	return d
 }
 
 // NewService crée une instance de Service.
@@ -32,10 +74,34 @@ func NewService(client *ent.Client, cfg Config) *Service {
 		AccessTokenTTL:  cfg.AccessTokenTTL,
 		RefreshTokenTTL: cfg.RefreshTokenTTL,
 	})
+	policy := lockoutPolicy{
+		maxAttempts: cfg.MaxFailedAttempts,
+		base:        cfg.LockoutDuration,
+		max:         cfg.MaxLockoutDuration,
+	}
+	if policy.maxAttempts <= 0 {
+		policy.maxAttempts = defaultMaxFailedAttempts
+	}
+	if policy.base <= 0 {
+		policy.base = defaultLockoutDuration
+	}
+	if policy.max <= 0 {
+		policy.max = defaultMaxLockoutDuration
+	}
+	if policy.max < policy.base {
+		policy.max = policy.base
+	}
+	challengeTTL := cfg.MFAChallengeTTL
+	if challengeTTL <= 0 {
+		challengeTTL = defaultMFAChallengeTTL
+	}
 	return &Service{
-		client: client,
-		tokens: tokenManager,
-		now:    time.Now,
+		client:       client,
+		tokens:       tokenManager,
+		lockout:      policy,
+		mfaKey:       deriveMFAKey(cfg.MFAEncryptionKey, cfg.JWTSecret),
+		challengeTTL: challengeTTL,
+		now:          time.Now,
 	}
 }
 
@@ -99,7 +165,8 @@ func (s *Service) Register(ctx context.Context, input RegisterInput) (*ent.User,
 	return user, nil
 }
 
-// Login authentifie l'utilisateur et renvoie un couple de tokens.
+// Login authentifie l'utilisateur et renvoie un couple de tokens. Si un second facteur est
+// configuré ou exigé par l'organisation pour son rôle, Login renvoie une *MFAChallengeError.
 func (s *Service) Login(ctx context.Context, email, password string) (TokenPair, error) {
 	normalized := strings.TrimSpace(strings.ToLower(email))
 	if normalized == "" {
@@ -127,8 +194,56 @@ func (s *Service) Login(ctx context.Context, email, password string) (TokenPair,
 		return TokenPair{}, ErrUserInactive
 	}
 
+	if user.LockedUntil != nil && s.now().Before(*user.LockedUntil) {
+		return TokenPair{}, &LockoutError{Until: *user.LockedUntil}
+	}
+
 	if err := VerifyPassword(user.PasswordHash, password); err != nil {
-		return TokenPair{}, ErrInvalidCredentials
+		return TokenPair{}, s.registerFailedAttempt(ctx, user.ID)
+	}
+
+	// Le compteur d'échecs n'est remis à zéro qu'après le second facteur.
+	if user.MfaEnabledAt != nil {
+		return TokenPair{}, s.challenge(user, false)
+	}
+	required, err := s.mfaRequired(ctx, user)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if required {
+		return TokenPair{}, s.challenge(user, true)
+	}
+
+	tokens, refreshID, err := s.tokens.IssuePair(user.ID, user.OrganizationID, user.Role)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	_, err = s.client.User.UpdateOneID(user.ID).
+		SetRefreshTokenID(refreshID).
+		SetLastLoginAt(s.now()).
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return tokens, nil
+}
+
+// IssueFor renvoie un couple de tokens pour un utilisateur actif, sans vérification de verrouillage,
+// de mot de passe ni de second facteur : les connexions passent par StartSession ou StartVerifiedSession.
+func (s *Service) IssueFor(ctx context.Context, userID uuid.UUID) (TokenPair, error) {
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TokenPair{}, ErrInvalidCredentials
+		}
+		return TokenPair{}, err
+	}
+	if user.Status != "active" {
+		return TokenPair{}, ErrUserInactive
 	}
 
 	tokens, refreshID, err := s.tokens.IssuePair(user.ID, user.OrganizationID, user.Role)
@@ -147,6 +262,78 @@ func (s *Service) Login(ctx context.Context, email, password string) (TokenPair,
 	return tokens, nil
 }
 
+// StartSession ouvre une session après un premier facteur autre que le mot de passe (lien magique, SSO).
+// Le verrouillage et la politique de second facteur s'appliquent comme pour Login.
+func (s *Service) StartSession(ctx context.Context, userID uuid.UUID) (TokenPair, error) {
+	return s.startSession(ctx, userID, false)
+}
+
+// StartVerifiedSession ouvre une session après un facteur qui vaut second facteur (passkey avec
+// vérification de l'utilisateur) : le statut et le verrouillage du compte s'appliquent, pas le défi MFA.
+func (s *Service) StartVerifiedSession(ctx context.Context, userID uuid.UUID) (TokenPair, error) {
+	return s.startSession(ctx, userID, true)
+}
+
+func (s *Service) startSession(ctx context.Context, userID uuid.UUID, secondFactor bool) (TokenPair, error) {
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TokenPair{}, ErrInvalidCredentials
+		}
+		return TokenPair{}, err
+	}
+	if user.Status != "active" {
+		return TokenPair{}, ErrUserInactive
+	}
+	if user.LockedUntil != nil && s.now().Before(*user.LockedUntil) {
+		return TokenPair{}, &LockoutError{Until: *user.LockedUntil}
+	}
+	if secondFactor {
+		return s.IssueFor(ctx, user.ID)
+	}
+	if user.MfaEnabledAt != nil {
+		return TokenPair{}, s.challenge(user, false)
+	}
+	required, err := s.mfaRequired(ctx, user)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if required {
+		return TokenPair{}, s.challenge(user, true)
+	}
+	return s.IssueFor(ctx, user.ID)
+}
+
+// registerFailedAttempt incrémente le compteur d'échecs et verrouille le compte au-delà du seuil.
+// Renvoie l'erreur à remonter à l'appelant.
+func (s *Service) registerFailedAttempt(ctx context.Context, userID uuid.UUID) error {
+	updated, err := s.client.User.UpdateOneID(userID).
+		AddFailedLoginAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	lockFor := s.lockout.duration(updated.FailedLoginAttempts)
+	if lockFor == 0 {
+		return ErrInvalidCredentials
+	}
+	until := s.now().Add(lockFor)
+	if err := s.client.User.UpdateOneID(userID).
+		SetLockedUntil(until).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return &LockoutError{Until: until}
+}
+
+// Unlock réinitialise le compteur d'échecs et lève un éventuel verrouillage.
+func (s *Service) Unlock(ctx context.Context, userID uuid.UUID) error {
+	return s.client.User.UpdateOneID(userID).
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
 // Refresh consomme un refresh token et renvoie un nouveau couple token.
 func (s *Service) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
 	claims, err := s.tokens.ParseClaims(refreshToken)
@@ -238,6 +425,45 @@ func (s *Service) Profile(ctx context.Context, accessToken string) (*ent.User, *
 	return user, user.Edges.Organization, nil
 }
 
+// Identify valide un access token sans requête base et renvoie l'utilisateur et l'organisation de ses claims.
+func (s *Service) Identify(accessToken string) (uuid.UUID, uuid.UUID, error) {
+	claims, err := s.tokens.ParseClaims(accessToken)
+	if err != nil || claims.TokenType != "access" {
+		return uuid.Nil, uuid.Nil, ErrInvalidToken
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidToken
+	}
+	orgID, err := uuid.Parse(claims.OrganizationID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidToken
+	}
+	return userID, orgID, nil
+}
+
+// IdentifyPrincipal valide un access token et renvoie le principal utilisateur correspondant.
+func (s *Service) IdentifyPrincipal(accessToken string) (principal.Principal, error) {
+	claims, err := s.tokens.ParseClaims(accessToken)
+	if err != nil || claims.TokenType != "access" {
+		return principal.Principal{}, ErrInvalidToken
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return principal.Principal{}, ErrInvalidToken
+	}
+	orgID, err := uuid.Parse(claims.OrganizationID)
+	if err != nil {
+		return principal.Principal{}, ErrInvalidToken
+	}
+	return principal.Principal{
+		Kind:           principal.KindUser,
+		ID:             userID,
+		OrganizationID: orgID,
+		Role:           claims.Role,
+	}, nil
+}
+
 // ClearRefreshToken met à jour l'utilisateur pour invalider les tokens actifs (logout).
 func (s *Service) ClearRefreshToken(ctx context.Context, userID uuid.UUID) error {
 	return s.client.User.UpdateOneID(userID).
@@ -166,3 +166,68 @@ func TestService_ClearRefreshToken(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, updated.RefreshTokenID)
 }
+
+func TestService_LoginLockout(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	org, err := client.Organization.Create().
+		SetName("Lock Org").
+		SetSlug("lock-org").
+		Save(ctx)
+	require.NoError(t, err)
+
+	svc := NewService(client, Config{
+		JWTSecret:          "secret",
+		AccessTokenTTL:     time.Minute,
+		RefreshTokenTTL:    time.Hour,
+		MaxFailedAttempts:  3,
+		LockoutDuration:    time.Minute,
+		MaxLockoutDuration: 3 * time.Minute,
+	})
+	now := time.Date(2024, 10, 6, 12, 0, 0, 0, time.UTC)
+	svc.withNow(func() time.Time { return now })
+
+	user, err := svc.Register(ctx, RegisterInput{
+		OrganizationID: org.ID,
+		Email:          "locked@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = svc.Login(ctx, "locked@example.com", "wrong")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	}
+
+	// Le troisième échec verrouille le compte pour la durée de base.
+	_, err = svc.Login(ctx, "locked@example.com", "wrong")
+	require.ErrorIs(t, err, ErrAccountLocked)
+	var lockErr *LockoutError
+	require.ErrorAs(t, err, &lockErr)
+	require.Equal(t, now.Add(time.Minute), lockErr.Until)
+
+	// Même le bon mot de passe est refusé pendant le verrouillage.
+	_, err = svc.Login(ctx, "locked@example.com", "supersecret")
+	require.ErrorIs(t, err, ErrAccountLocked)
+
+	// Verrouillage progressif : la durée double puis plafonne.
+	now = now.Add(2 * time.Minute)
+	_, err = svc.Login(ctx, "locked@example.com", "wrong")
+	require.ErrorAs(t, err, &lockErr)
+	require.Equal(t, now.Add(2*time.Minute), lockErr.Until)
+
+	now = now.Add(5 * time.Minute)
+	_, err = svc.Login(ctx, "locked@example.com", "wrong")
+	require.ErrorAs(t, err, &lockErr)
+	require.Equal(t, now.Add(3*time.Minute), lockErr.Until)
+
+	// Un succès après expiration remet les compteurs à zéro.
+	now = now.Add(10 * time.Minute)
+	_, err = svc.Login(ctx, "locked@example.com", "supersecret")
+	require.NoError(t, err)
+	reloaded, err := client.User.Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.Zero(t, reloaded.FailedLoginAttempts)
+	require.Nil(t, reloaded.LockedUntil)
+}
@@ -110,6 +110,30 @@ func (m *Manager) IssuePair(userID uuid.UUID, orgID uuid.UUID, role string) (Tok
 	}, refreshID, nil
 }
 
+// IssueChallenge génère le token de défi MFA remis après validation du mot de passe.
+// Il ne donne accès qu'à la vérification (et à l'enrôlement) du second facteur.
+func (m *Manager) IssueChallenge(userID uuid.UUID, orgID uuid.UUID, role string, ttl time.Duration) (string, time.Time, error) {
+	issuedAt := m.now()
+	expiresAt := issuedAt.Add(ttl)
+	claims := &Claims{
+		OrganizationID: orgID.String(),
+		Role:           role,
+		TokenType:      "mfa",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			Issuer:    tokenIssuer,
+			Audience:  []string{tokenAudienceAPI},
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
 // ParseClaims vérifie et renvoie les claims depuis un token JWT.
 func (m *Manager) ParseClaims(token string) (*Claims, error) {
 	parsedToken, err := jwt.ParseWithClaims(token, &Claims{}, func(t *jwt.Token) (any, error) {
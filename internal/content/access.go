@@ -0,0 +1,100 @@
+package content
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcourse "lms-go/internal/ent/course"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entmodule "lms-go/internal/ent/module"
+	entmoduleprogress "lms-go/internal/ent/moduleprogress"
+)
+
+// Statuts lus sur les inscriptions et la progression (voir les packages enrollment et progress).
+const (
+	enrollmentActive  = "active"
+	progressCompleted = "completed"
+)
+
+// FullAccess indique si un rôle accède à tous les contenus de l'organisation, sans condition d'inscription.
+func FullAccess(role string) bool {
+	switch strings.ToLower(strings.TrimSpace(role)) {
+	case "admin", "designer":
+		return true
+	}
+	return false
+}
+
+// accessibleModules renvoie les modules qui référencent le contenu et que l'utilisateur peut ouvrir :
+// il est inscrit (inscription active) au cours du module et a terminé les modules qui le précèdent,
+// comme l'exige la progression linéaire.
+func (s *Service) accessibleModules(ctx context.Context, orgID, contentID, userID uuid.UUID) ([]*ent.Module, error) {
+	modules, err := s.client.Module.Query().
+		Where(
+			entmodule.ContentIDEQ(contentID),
+			entmodule.HasCourseWith(entcourse.OrganizationIDEQ(orgID)),
+		).
+		Order(ent.Asc(entmodule.FieldPosition)).
+		All(ctx)
+	if err != nil || len(modules) == 0 {
+		return nil, err
+	}
+
+	accessible := make([]*ent.Module, 0, len(modules))
+	enrollments := map[uuid.UUID]*ent.Enrollment{}
+	for _, m := range modules {
+		enrollmentEntity, seen := enrollments[m.CourseID]
+		if !seen {
+			enrollmentEntity, err = s.client.Enrollment.Query().
+				Where(
+					entenrollment.OrganizationIDEQ(orgID),
+					entenrollment.CourseIDEQ(m.CourseID),
+					entenrollment.UserIDEQ(userID),
+					entenrollment.StatusEQ(enrollmentActive),
+				).
+				First(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, err
+			}
+			enrollments[m.CourseID] = enrollmentEntity
+		}
+		if enrollmentEntity == nil {
+			continue
+		}
+		unlocked, err := s.moduleUnlocked(ctx, enrollmentEntity.ID, m)
+		if err != nil {
+			return nil, err
+		}
+		if unlocked {
+			accessible = append(accessible, m)
+		}
+	}
+	return accessible, nil
+}
+
+// moduleUnlocked vérifie que tous les modules de position inférieure sont terminés.
+func (s *Service) moduleUnlocked(ctx context.Context, enrollmentID uuid.UUID, m *ent.Module) (bool, error) {
+	if m.Position == 0 {
+		return true, nil
+	}
+	previous, err := s.client.Module.Query().
+		Where(entmodule.CourseIDEQ(m.CourseID), entmodule.PositionLT(m.Position)).
+		Count(ctx)
+	if err != nil || previous == 0 {
+		return err == nil, err
+	}
+	completed, err := s.client.ModuleProgress.Query().
+		Where(
+			entmoduleprogress.EnrollmentIDEQ(enrollmentID),
+			entmoduleprogress.StatusEQ(progressCompleted),
+			entmoduleprogress.HasModuleWith(entmodule.CourseIDEQ(m.CourseID), entmodule.PositionLT(m.Position)),
+		).
+		Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	return completed >= previous, nil
+}
@@ -0,0 +1,104 @@
+package content
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	entdownload "lms-go/internal/ent/contentdownload"
+)
+
+func TestService_DownloadAccess(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+
+	intro := uploadAndFinalize(t, svc, orgID, "intro.pdf", "application/pdf", 0, pdfData(10))
+	guide := uploadAndFinalize(t, svc, orgID, "guide.pdf", "application/pdf", 0, pdfData(20))
+	upload, err := svc.CreateRevision(ctx, orgID, guide.ID, CreateRevisionInput{})
+	require.NoError(t, err)
+	svc.storage.(*mockStorage).Put(upload.Revision.StorageKey, pdfData(30), "application/pdf")
+	_, err = svc.FinalizeRevision(ctx, orgID, guide.ID, 2, FinalizeInput{})
+	require.NoError(t, err)
+
+	learner, err := svc.client.User.Create().SetOrganizationID(orgID).SetEmail("learner@example.com").SetPasswordHash("x").Save(ctx)
+	require.NoError(t, err)
+	course, err := svc.client.Course.Create().SetOrganizationID(orgID).SetTitle("Cours").SetSlug("cours").Save(ctx)
+	require.NoError(t, err)
+	first, err := svc.client.Module.Create().SetCourseID(course.ID).SetContentID(intro.ID).SetTitle("Intro").SetModuleType("pdf").SetPosition(0).Save(ctx)
+	require.NoError(t, err)
+	second, err := svc.client.Module.Create().SetCourseID(course.ID).SetContentID(guide.ID).SetContentRevision(1).SetTitle("Guide").SetModuleType("pdf").SetPosition(1).Save(ctx)
+	require.NoError(t, err)
+
+	restricted := DownloadInput{UserID: &learner.ID, Restricted: true}
+	_, err = svc.Download(ctx, orgID, intro.ID, restricted)
+	require.ErrorIs(t, err, ErrForbidden, "sans inscription")
+
+	enrollment, err := svc.client.Enrollment.Create().SetOrganizationID(orgID).SetCourseID(course.ID).SetUserID(learner.ID).SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	link, err := svc.Download(ctx, orgID, intro.ID, restricted)
+	require.NoError(t, err)
+	require.Equal(t, 1, link.Revision)
+	_, err = svc.Download(ctx, orgID, guide.ID, restricted)
+	require.ErrorIs(t, err, ErrForbidden, "module verrouillé par le prérequis")
+
+	_, err = svc.client.ModuleProgress.Create().SetEnrollmentID(enrollment.ID).SetModuleID(first.ID).SetStatus("completed").Save(ctx)
+	require.NoError(t, err)
+	link, err = svc.Download(ctx, orgID, guide.ID, restricted)
+	require.NoError(t, err)
+	require.Equal(t, 1, link.Revision, "la révision épinglée par le module est servie")
+	current := 2
+	_, err = svc.Download(ctx, orgID, guide.ID, DownloadInput{UserID: &learner.ID, Restricted: true, Revision: &current})
+	require.ErrorIs(t, err, ErrForbidden, "seule la révision du module est accessible")
+	audited, err := svc.client.ContentDownload.Query().Where(entdownload.ContentIDEQ(guide.ID)).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, *audited.ModuleID)
+
+	// Concepteurs et administrateurs ne sont pas restreints.
+	link, err = svc.Download(ctx, orgID, guide.ID, DownloadInput{UserID: &learner.ID, Revision: &current})
+	require.NoError(t, err)
+	require.Equal(t, 2, link.Revision)
+	require.True(t, FullAccess("Designer"))
+	require.False(t, FullAccess("tutor"))
+
+	require.NoError(t, svc.client.Enrollment.UpdateOne(enrollment).SetStatus("cancelled").Exec(ctx))
+	_, err = svc.Download(ctx, orgID, intro.ID, restricted)
+	require.ErrorIs(t, err, ErrForbidden, "inscription annulée")
+}
+
+func TestService_DownloadStream(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+	svc.stream = StreamConfig{BaseURL: "https://lms.test/downloads/", Secret: []byte("secret"), Expiry: svc.stream.Expiry}
+
+	doc := uploadAndFinalize(t, svc, orgID, "doc.pdf", "application/pdf", 0, pdfData(64))
+	link, err := svc.Download(ctx, orgID, doc.ID, DownloadInput{})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(link.URL, "https://lms.test/downloads/"), link.URL)
+	count, err := svc.client.ContentDownload.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count, "le mode proxy trace au moment du flux")
+
+	parsed, err := url.Parse(link.URL)
+	require.NoError(t, err)
+	token := strings.TrimPrefix(parsed.Path, "/downloads/")
+	stream, err := svc.OpenStream(ctx, token, true)
+	require.NoError(t, err)
+	data, err := io.ReadAll(stream.Body)
+	require.NoError(t, err)
+	require.NoError(t, stream.Body.Close())
+	require.Len(t, data, 64)
+	require.Equal(t, "doc.pdf", stream.Name)
+	count, err = svc.client.ContentDownload.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = svc.OpenStream(ctx, token+"x", true)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	svc.stream.Secret = []byte("rotated")
+	_, err = svc.OpenStream(ctx, token, true)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -5,4 +5,21 @@ import "errors"
 var (
 	ErrInvalidInput = errors.New("content: invalid input")
 	ErrNotFound     = errors.New("content: not found")
+	ErrNotAvailable = errors.New("content: not available")
+	ErrConflict     = errors.New("content: concurrent revision")
+	ErrForbidden    = errors.New("content: access denied")
+	// ErrInvalidToken signale un jeton de téléchargement altéré ou expiré (mode proxy).
+	ErrInvalidToken = errors.New("content: invalid download token")
+	// Erreurs de vérification de l'objet déposé lors de la finalisation.
+	ErrObjectMissing    = errors.New("content: uploaded object not found")
+	ErrSizeMismatch     = errors.New("content: uploaded size does not match")
+	ErrChecksumMismatch = errors.New("content: uploaded checksum does not match")
+	ErrMimeMismatch     = errors.New("content: uploaded data does not match declared mime type")
+	ErrMimeNotAllowed   = errors.New("content: mime type not allowed by organization")
+	ErrQuotaExceeded    = errors.New("content: storage quota exceeded")
+	// Erreurs des dépôts multipart.
+	ErrMultipartUnsupported = errors.New("content: storage backend does not support multipart uploads")
+	ErrNotPending           = errors.New("content: content is not pending upload")
+	ErrNoUpload             = errors.New("content: no multipart upload in progress")
+	ErrInvalidPart          = errors.New("content: invalid multipart part")
 )
@@ -0,0 +1,360 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entdownload "lms-go/internal/ent/contentdownload"
+	entrevision "lms-go/internal/ent/contentrevision"
+	entmodule "lms-go/internal/ent/module"
+	"lms-go/internal/platform/storage"
+)
+
+// Valeurs par défaut du nettoyage.
+const (
+	defaultMultipartStale   = 24 * time.Hour
+	defaultArchiveRetention = 30 * 24 * time.Hour
+	defaultOrphanGrace      = time.Hour
+)
+
+// ObjectWalker est implémenté par les backends capables d'énumérer leurs objets ; il permet de
+// rapprocher le stockage de la table des contenus.
+type ObjectWalker interface {
+	Walk(ctx context.Context, fn func(storage.ObjectInfo) error) error
+}
+
+// GCOptions paramètre une passe de nettoyage ; les durées nulles prennent leur valeur par défaut.
+type GCOptions struct {
+	// PendingTTL est l'âge au-delà duquel un dépôt non finalisé expire. Par défaut, la durée
+	// des URL de dépôt plus une marge d'une heure pour les transferts commencés avant l'échéance.
+	PendingTTL time.Duration
+	// MultipartStaleAfter est l'âge au-delà duquel un dépôt multipart est abandonné (24 h).
+	MultipartStaleAfter time.Duration
+	// ArchiveRetention est la durée de conservation d'un contenu archivé avant suppression définitive (30 jours).
+	ArchiveRetention time.Duration
+	// OrphanGrace protège les objets récents lors du rapprochement (1 h).
+	OrphanGrace time.Duration
+	// KeepPrefixes liste les préfixes de clés gérés par d'autres services (archives d'export RGPD,
+	// par exemple) que le rapprochement ne doit jamais considérer comme orphelins.
+	KeepPrefixes []string
+	// DryRun rapporte ce qui serait nettoyé sans rien modifier.
+	DryRun bool
+}
+
+// GCReport résume une passe de nettoyage.
+type GCReport struct {
+	DryRun bool
+	// AbortedUploads compte les dépôts multipart abandonnés.
+	AbortedUploads int
+	// ExpiredContents et ExpiredRevisions comptent les dépôts simples expirés ; leur réservation de quota est libérée.
+	ExpiredContents  int
+	ExpiredRevisions int
+	// PurgedContents compte les contenus archivés supprimés définitivement ; RetainedContents ceux
+	// qu'un module référence encore.
+	PurgedContents   int
+	RetainedContents int
+	RemovedObjects   int
+	FreedBytes       int64
+	// Orphans liste les objets du stockage qu'aucun contenu ne référence. Reconciled est faux
+	// si le backend ne sait pas énumérer ses objets.
+	Orphans     []string
+	OrphanBytes int64
+	Reconciled  bool
+}
+
+// CollectGarbage abandonne les dépôts multipart périmés, fait expirer les dépôts jamais finalisés,
+// supprime définitivement les contenus archivés depuis plus que la rétention et non référencés,
+// puis supprime les objets orphelins du stockage.
+func (s *Service) CollectGarbage(ctx context.Context, opts GCOptions) (*GCReport, error) {
+	opts = s.gcDefaults(opts)
+	now := time.Now()
+	report := &GCReport{DryRun: opts.DryRun}
+
+	if err := s.gcMultipart(ctx, opts, report); err != nil {
+		return report, err
+	}
+	if err := s.expirePending(ctx, now.Add(-opts.PendingTTL), opts, report); err != nil {
+		return report, err
+	}
+	if err := s.purgeArchived(ctx, now.Add(-opts.ArchiveRetention), opts, report); err != nil {
+		return report, err
+	}
+	if err := s.reconcile(ctx, now.Add(-opts.OrphanGrace), opts, report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (s *Service) gcDefaults(opts GCOptions) GCOptions {
+	if opts.PendingTTL <= 0 {
+		opts.PendingTTL = s.uploadExpiry + time.Hour
+	}
+	if opts.MultipartStaleAfter <= 0 {
+		opts.MultipartStaleAfter = defaultMultipartStale
+	}
+	if opts.ArchiveRetention <= 0 {
+		opts.ArchiveRetention = defaultArchiveRetention
+	}
+	if opts.OrphanGrace <= 0 {
+		opts.OrphanGrace = defaultOrphanGrace
+	}
+	return opts
+}
+
+func (s *Service) gcMultipart(ctx context.Context, opts GCOptions, report *GCReport) error {
+	if !opts.DryRun {
+		aborted, err := s.AbortStaleUploads(ctx, opts.MultipartStaleAfter)
+		report.AbortedUploads = aborted
+		return err
+	}
+	if _, ok := s.storage.(MultipartStorage); !ok {
+		return nil
+	}
+	count, err := s.client.Content.Query().
+		Where(entcontent.UploadIDNotNil(), entcontent.UploadStartedAtLT(time.Now().Add(-opts.MultipartStaleAfter))).
+		Count(ctx)
+	report.AbortedUploads = count
+	return err
+}
+
+// expirePending archive les contenus jamais finalisés et supprime les révisions en attente
+// des contenus disponibles, en libérant leur réservation de quota.
+func (s *Service) expirePending(ctx context.Context, cutoff time.Time, opts GCOptions, report *GCReport) error {
+	contents, err := s.client.Content.Query().
+		Where(
+			entcontent.StatusEQ(StatusPending),
+			entcontent.UploadIDIsNil(),
+			entcontent.UpdatedAtLT(cutoff),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range contents {
+		report.ExpiredContents++
+		if opts.DryRun {
+			continue
+		}
+		if err := s.Archive(ctx, c.OrganizationID, c.ID); err != nil {
+			return fmt.Errorf("content: expire %s: %w", c.ID, err)
+		}
+		// Un dépôt partiel ou non finalisé n'a pas à attendre la fin de la rétention.
+		keys, _, err := s.objectKeys(ctx, c)
+		if err != nil {
+			return err
+		}
+		if err := s.removeObjects(ctx, keys, report); err != nil {
+			return err
+		}
+	}
+
+	revisions, err := s.client.ContentRevision.Query().
+		Where(
+			entrevision.StatusEQ(StatusPending),
+			entrevision.UpdatedAtLT(cutoff),
+			entrevision.HasContentWith(entcontent.StatusEQ(StatusAvailable)),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rev := range revisions {
+		report.ExpiredRevisions++
+		if opts.DryRun {
+			continue
+		}
+		if err := s.expireRevision(ctx, rev); err != nil {
+			return fmt.Errorf("content: expire revision %s/%d: %w", rev.ContentID, rev.Number, err)
+		}
+		if err := s.removeObjects(ctx, []string{rev.StorageKey}, report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) expireRevision(ctx context.Context, rev *ent.ContentRevision) (err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	n, err := tx.ContentRevision.Delete().
+		Where(entrevision.IDEQ(rev.ID), entrevision.StatusEQ(StatusPending)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	// Finalisée entre-temps : rien à libérer.
+	if n > 0 {
+		if err = release(ctx, tx, rev.OrganizationID, rev.SizeBytes, 1); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// purgeArchived supprime les objets puis les lignes des contenus archivés depuis avant cutoff.
+// Les objets sont supprimés d'abord : un échec laisse la ligne, et la passe suivante réessaie.
+func (s *Service) purgeArchived(ctx context.Context, cutoff time.Time, opts GCOptions, report *GCReport) error {
+	contents, err := s.client.Content.Query().
+		Where(entcontent.StatusEQ(StatusArchived), entcontent.UpdatedAtLT(cutoff)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range contents {
+		referenced, err := s.client.Module.Query().
+			Where(entmodule.ContentIDEQ(c.ID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if referenced {
+			report.RetainedContents++
+			continue
+		}
+		report.PurgedContents++
+		keys, bytes, err := s.objectKeys(ctx, c)
+		if err != nil {
+			return err
+		}
+		if opts.DryRun {
+			report.RemovedObjects += len(keys)
+			report.FreedBytes += bytes
+			continue
+		}
+		if store, ok := s.storage.(MultipartStorage); ok && c.UploadID != nil {
+			if err := store.AbortMultipart(ctx, c.StorageKey, *c.UploadID); err != nil {
+				return fmt.Errorf("content: purge %s: %w", c.ID, err)
+			}
+		}
+		if err := s.removeObjects(ctx, keys, report); err != nil {
+			return err
+		}
+		report.FreedBytes += bytes
+		if err := s.deleteContent(ctx, c); err != nil {
+			return fmt.Errorf("content: purge %s: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteContent(ctx context.Context, c *ent.Content) (err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ContentDownload.Delete().Where(entdownload.ContentIDEQ(c.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err = tx.ContentRevision.Delete().Where(entrevision.ContentIDEQ(c.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err = tx.Content.Delete().
+		Where(entcontent.IDEQ(c.ID), entcontent.StatusEQ(StatusArchived)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// reconcile supprime les objets du stockage antérieurs à cutoff qu'aucun contenu ni aucune
+// révision ne référence. Les clés connues sont relues après le parcours : un objet déposé
+// pendant celui-ci appartient à une ligne déjà créée.
+func (s *Service) reconcile(ctx context.Context, cutoff time.Time, opts GCOptions, report *GCReport) error {
+	walker, ok := s.storage.(ObjectWalker)
+	if !ok {
+		return nil
+	}
+	var candidates []storage.ObjectInfo
+	if err := walker.Walk(ctx, func(obj storage.ObjectInfo) error {
+		if obj.LastModified.Before(cutoff) && !hasAnyPrefix(obj.Key, opts.KeepPrefixes) {
+			candidates = append(candidates, obj)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	contentKeys, err := s.client.Content.Query().Select(entcontent.FieldStorageKey).Strings(ctx)
+	if err != nil {
+		return err
+	}
+	revisionKeys, err := s.client.ContentRevision.Query().Select(entrevision.FieldStorageKey).Strings(ctx)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(contentKeys)+len(revisionKeys))
+	for _, key := range append(contentKeys, revisionKeys...) {
+		known[key] = true
+	}
+
+	report.Reconciled = true
+	for _, obj := range candidates {
+		if known[obj.Key] {
+			continue
+		}
+		report.Orphans = append(report.Orphans, obj.Key)
+		report.OrphanBytes += obj.Size
+		if opts.DryRun {
+			continue
+		}
+		if err := s.storage.Remove(ctx, obj.Key); err != nil {
+			return fmt.Errorf("content: remove orphan %s: %w", obj.Key, err)
+		}
+	}
+	return nil
+}
+
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectKeys renvoie les clés de stockage de toutes les révisions d'un contenu et leur taille cumulée.
+func (s *Service) objectKeys(ctx context.Context, c *ent.Content) ([]string, int64, error) {
+	revisions, err := s.client.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(c.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(revisions) == 0 {
+		return []string{c.StorageKey}, c.SizeBytes, nil
+	}
+	keys := make([]string, 0, len(revisions))
+	var bytes int64
+	for _, rev := range revisions {
+		keys = append(keys, rev.StorageKey)
+		bytes += rev.SizeBytes
+	}
+	return keys, bytes, nil
+}
+
+func (s *Service) removeObjects(ctx context.Context, keys []string, report *GCReport) error {
+	for _, key := range keys {
+		if err := s.storage.Remove(ctx, key); err != nil {
+			return fmt.Errorf("content: remove object %s: %w", key, err)
+		}
+		report.RemovedObjects++
+	}
+	return nil
+}
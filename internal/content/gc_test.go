@@ -0,0 +1,103 @@
+package content
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/ent"
+)
+
+func TestService_CollectGarbage(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{"quota_bytes": float64(10000)})
+	ctx := context.Background()
+	store := svc.storage.(*mockStorage)
+	backdate := func(c *ent.Content, age time.Duration) {
+		require.NoError(t, svc.client.Content.UpdateOneID(c.ID).SetUpdatedAt(time.Now().Add(-age)).Exec(ctx))
+	}
+
+	// Dépôt abandonné par le navigateur, avec un objet partiel.
+	abandoned, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "abandon.pdf", MimeType: "application/pdf", SizeBytes: 500})
+	require.NoError(t, err)
+	store.Put(abandoned.Content.StorageKey, pdfData(10), "application/pdf")
+	backdate(abandoned.Content, 2*time.Hour)
+
+	// Contenu disponible dont la révision 2 n'a jamais été déposée.
+	kept := uploadAndFinalize(t, svc, orgID, "kept.pdf", "application/pdf", 100, pdfData(100))
+	revision, err := svc.CreateRevision(ctx, orgID, kept.ID, CreateRevisionInput{SizeBytes: 300})
+	require.NoError(t, err)
+	require.NoError(t, svc.client.ContentRevision.UpdateOne(revision.Revision).SetUpdatedAt(time.Now().Add(-2*time.Hour)).Exec(ctx))
+
+	// Contenu archivé depuis longtemps, téléchargé une fois.
+	purged := uploadAndFinalize(t, svc, orgID, "old.pdf", "application/pdf", 200, pdfData(200))
+	_, err = svc.Download(ctx, orgID, purged.ID, DownloadInput{})
+	require.NoError(t, err)
+	require.NoError(t, svc.Archive(ctx, orgID, purged.ID))
+	backdate(purged, 40*24*time.Hour)
+
+	// Contenu archivé mais encore référencé par un module.
+	referenced := uploadAndFinalize(t, svc, orgID, "ref.pdf", "application/pdf", 50, pdfData(50))
+	course, err := svc.client.Course.Create().SetOrganizationID(orgID).SetTitle("Cours").SetSlug("cours").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, svc.client.Module.Create().SetCourseID(course.ID).SetContentID(referenced.ID).SetTitle("M").SetModuleType("file").Exec(ctx))
+	require.NoError(t, svc.Archive(ctx, orgID, referenced.ID))
+	backdate(referenced, 40*24*time.Hour)
+
+	store.Put(orgID.String()+"/2020/01/01/"+uuid.NewString()+"-orphan.pdf", pdfData(70), "application/pdf")
+	store.Put("exports/"+orgID.String()+"/archive.zip", []byte("zip"), "application/zip")
+	time.Sleep(5 * time.Millisecond)
+	opts := GCOptions{OrphanGrace: time.Millisecond, KeepPrefixes: []string{"exports/"}}
+
+	opts.DryRun = true
+	report, err := svc.CollectGarbage(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.ExpiredContents)
+	require.Equal(t, 1, report.ExpiredRevisions)
+	require.Equal(t, 1, report.PurgedContents)
+	require.Equal(t, 1, report.RetainedContents)
+	require.EqualValues(t, 200, report.FreedBytes)
+	require.Len(t, report.Orphans, 1)
+	require.True(t, report.Reconciled)
+	_, err = svc.Get(ctx, orgID, purged.ID)
+	require.NoError(t, err, "le mode dry-run ne supprime rien")
+	_, err = store.Stat(ctx, report.Orphans[0])
+	require.NoError(t, err)
+
+	opts.DryRun = false
+	report, err = svc.CollectGarbage(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.PurgedContents)
+	require.Len(t, report.Orphans, 1)
+
+	_, err = svc.Get(ctx, orgID, purged.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Stat(ctx, purged.StorageKey)
+	require.Error(t, err)
+	_, err = store.Stat(ctx, report.Orphans[0])
+	require.Error(t, err)
+	_, err = store.Stat(ctx, abandoned.Content.StorageKey)
+	require.Error(t, err)
+	_, err = store.Stat(ctx, "exports/"+orgID.String()+"/archive.zip")
+	require.NoError(t, err, "les préfixes réservés ne sont jamais rapprochés")
+	expired, err := svc.Get(ctx, orgID, abandoned.Content.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusArchived, expired.Status)
+	_, err = svc.Get(ctx, orgID, referenced.ID)
+	require.NoError(t, err)
+	history, err := svc.Revisions(ctx, orgID, kept.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+
+	// Seule la révision finalisée du contenu conservé reste comptée.
+	usage, err := svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, usage.BytesUsed)
+	require.Equal(t, 1, usage.ObjectsUsed)
+
+	report, err = svc.CollectGarbage(ctx, opts)
+	require.NoError(t, err)
+	require.Zero(t, report.PurgedContents+report.ExpiredContents+report.ExpiredRevisions+len(report.Orphans))
+}
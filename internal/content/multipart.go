@@ -0,0 +1,266 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	"lms-go/internal/platform/storage"
+)
+
+// Bornes des parties, alignées sur S3 : 5 Mio minimum (hors dernière partie) et 10 000 parties.
+const (
+	minPartSize     int64 = 5 << 20
+	defaultPartSize int64 = 64 << 20
+	maxParts              = 10000
+)
+
+// MultipartStorage est implémenté par les backends qui acceptent les dépôts en plusieurs parties
+// (multipart MinIO/S3, fichiers de parties pour le stockage local).
+type MultipartStorage interface {
+	InitiateMultipart(ctx context.Context, object string, contentType string) (string, error)
+	PresignPart(ctx context.Context, object, uploadID string, number int, expires time.Duration) (string, error)
+	// ListParts et CompleteMultipart renvoient storage.ErrUploadNotFound pour un dépôt inconnu.
+	ListParts(ctx context.Context, object, uploadID string) ([]storage.Part, error)
+	CompleteMultipart(ctx context.Context, object, uploadID string, parts []storage.Part) error
+	AbortMultipart(ctx context.Context, object, uploadID string) error
+}
+
+// InitiateMultipartInput paramètre le découpage ; PartSize nul applique la taille par défaut.
+type InitiateMultipartInput struct {
+	PartSize int64
+}
+
+// PartURL est l'URL signée de dépôt d'une partie restant à envoyer.
+type PartURL struct {
+	Number int
+	URL    string
+}
+
+// MultipartUpload décrit l'état d'un dépôt multipart : parties déjà reçues et URL des parties manquantes.
+type MultipartUpload struct {
+	Content   *ent.Content
+	UploadID  string
+	PartSize  int64
+	PartCount int
+	Uploaded  []storage.Part
+	Parts     []PartURL
+	ExpiresAt time.Time
+}
+
+// InitiateMultipart ouvre un dépôt multipart pour un contenu en attente dont la taille est déclarée.
+// Si un dépôt est déjà en cours, son état est renvoyé pour reprise.
+func (s *Service) InitiateMultipart(ctx context.Context, orgID, contentID uuid.UUID, input InitiateMultipartInput) (*MultipartUpload, error) {
+	store, ok := s.storage.(MultipartStorage)
+	if !ok {
+		return nil, ErrMultipartUnsupported
+	}
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != StatusPending {
+		return nil, ErrNotPending
+	}
+	if current.UploadID != nil {
+		return s.multipartState(ctx, store, current)
+	}
+	if current.SizeBytes <= 0 || (input.PartSize != 0 && input.PartSize < minPartSize) {
+		return nil, ErrInvalidInput
+	}
+	partSize := input.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	// Au-delà de 10 000 parties, la taille des parties est augmentée (arrondie au Mio).
+	if min := ceilDiv(current.SizeBytes, maxParts); partSize < min {
+		partSize = ceilDiv(min, 1<<20) << 20
+	}
+
+	uploadID, err := store.InitiateMultipart(ctx, current.StorageKey, current.MimeType)
+	if err != nil {
+		return nil, fmt.Errorf("content: initiate multipart: %w", err)
+	}
+	n, err := s.client.Content.Update().
+		Where(
+			entcontent.IDEQ(current.ID),
+			entcontent.StatusEQ(StatusPending),
+			entcontent.UploadIDIsNil(),
+		).
+		SetUploadID(uploadID).
+		SetUploadPartSize(partSize).
+		SetUploadStartedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = store.AbortMultipart(ctx, current.StorageKey, uploadID)
+		return nil, err
+	}
+	if n == 0 {
+		// Une requête concurrente a ouvert un dépôt : on reprend le sien.
+		_ = store.AbortMultipart(ctx, current.StorageKey, uploadID)
+	}
+	return s.ResumeMultipart(ctx, orgID, contentID)
+}
+
+// ResumeMultipart renvoie l'état du dépôt en cours avec des URL fraîches pour les parties manquantes.
+func (s *Service) ResumeMultipart(ctx context.Context, orgID, contentID uuid.UUID) (*MultipartUpload, error) {
+	store, ok := s.storage.(MultipartStorage)
+	if !ok {
+		return nil, ErrMultipartUnsupported
+	}
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.UploadID == nil {
+		return nil, ErrNoUpload
+	}
+	return s.multipartState(ctx, store, current)
+}
+
+// CompleteMultipart assemble les parties listées (numéro et ETag) puis finalise le contenu avec
+// les mêmes vérifications qu'un dépôt simple : l'objet assemblé est relu et son empreinte SHA-256
+// doit correspondre à celle déclarée par le client (obligatoire). Une nouvelle tentative après un
+// assemblage réussi renvoie le contenu finalisé.
+func (s *Service) CompleteMultipart(ctx context.Context, orgID, contentID uuid.UUID, parts []storage.Part, input FinalizeInput) (*ent.Content, error) {
+	store, ok := s.storage.(MultipartStorage)
+	if !ok {
+		return nil, ErrMultipartUnsupported
+	}
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.UploadID == nil && current.Status == StatusAvailable {
+		return current, nil
+	}
+	if current.UploadID == nil || current.Status != StatusPending {
+		return nil, ErrNoUpload
+	}
+	if len(parts) == 0 {
+		return nil, ErrInvalidPart
+	}
+	if input.ChecksumSHA256 == nil || !validChecksum(*input.ChecksumSHA256) {
+		return nil, ErrInvalidInput
+	}
+	if err := store.CompleteMultipart(ctx, current.StorageKey, *current.UploadID, parts); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidPart):
+			return nil, ErrInvalidPart
+		case errors.Is(err, storage.ErrUploadNotFound):
+			// Une tentative interrompue avant la finalisation a pu assembler l'objet.
+			if _, statErr := s.storage.Stat(ctx, current.StorageKey); statErr != nil {
+				_ = s.clearUpload(ctx, current)
+				return nil, ErrNoUpload
+			}
+		default:
+			return nil, fmt.Errorf("content: complete multipart: %w", err)
+		}
+	}
+	return s.finalizeRevision(ctx, current, current.CurrentRevision, input, current.UploadID)
+}
+
+// AbortMultipart abandonne le dépôt en cours et supprime ses parties ; le contenu reste en attente.
+func (s *Service) AbortMultipart(ctx context.Context, orgID, contentID uuid.UUID) error {
+	store, ok := s.storage.(MultipartStorage)
+	if !ok {
+		return ErrMultipartUnsupported
+	}
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return err
+	}
+	if current.UploadID == nil {
+		return ErrNoUpload
+	}
+	if err := store.AbortMultipart(ctx, current.StorageKey, *current.UploadID); err != nil {
+		return fmt.Errorf("content: abort multipart: %w", err)
+	}
+	return s.clearUpload(ctx, current)
+}
+
+// AbortStaleUploads abandonne les dépôts multipart ouverts depuis plus de olderThan, toutes
+// organisations confondues, et renvoie le nombre de dépôts abandonnés.
+func (s *Service) AbortStaleUploads(ctx context.Context, olderThan time.Duration) (int, error) {
+	store, ok := s.storage.(MultipartStorage)
+	if !ok {
+		return 0, nil
+	}
+	stale, err := s.client.Content.Query().
+		Where(
+			entcontent.UploadIDNotNil(),
+			entcontent.UploadStartedAtLT(time.Now().Add(-olderThan)),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	aborted := 0
+	for _, c := range stale {
+		if err := store.AbortMultipart(ctx, c.StorageKey, *c.UploadID); err != nil {
+			return aborted, fmt.Errorf("content: abort multipart %s: %w", c.ID, err)
+		}
+		if err := s.clearUpload(ctx, c); err != nil {
+			return aborted, err
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+func (s *Service) multipartState(ctx context.Context, store MultipartStorage, current *ent.Content) (*MultipartUpload, error) {
+	uploadID := *current.UploadID
+	uploaded, err := store.ListParts(ctx, current.StorageKey, uploadID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUploadNotFound) {
+			_ = s.clearUpload(ctx, current)
+			return nil, ErrNoUpload
+		}
+		return nil, fmt.Errorf("content: list parts: %w", err)
+	}
+	received := make(map[int]bool, len(uploaded))
+	for _, p := range uploaded {
+		received[p.Number] = true
+	}
+
+	partCount := int(ceilDiv(current.SizeBytes, current.UploadPartSize))
+	expiresAt := time.Now().Add(s.uploadExpiry)
+	urls := make([]PartURL, 0, partCount-len(received))
+	for number := 1; number <= partCount; number++ {
+		if received[number] {
+			continue
+		}
+		url, err := store.PresignPart(ctx, current.StorageKey, uploadID, number, s.uploadExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("content: presign part: %w", err)
+		}
+		urls = append(urls, PartURL{Number: number, URL: url})
+	}
+	return &MultipartUpload{
+		Content:   current,
+		UploadID:  uploadID,
+		PartSize:  current.UploadPartSize,
+		PartCount: partCount,
+		Uploaded:  uploaded,
+		Parts:     urls,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *Service) clearUpload(ctx context.Context, c *ent.Content) error {
+	return s.client.Content.Update().
+		Where(entcontent.IDEQ(c.ID), entcontent.UploadIDEQ(*c.UploadID)).
+		ClearUploadID().
+		ClearUploadPartSize().
+		ClearUploadStartedAt().
+		Exec(ctx)
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,142 @@
+package content
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/platform/storage"
+)
+
+func TestService_Multipart(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+	store := svc.storage.(*mockStorage)
+
+	size := 2*minPartSize + 100
+	data := pdfData(int(size))
+	res, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "cours.pdf", MimeType: "application/pdf", SizeBytes: size})
+	require.NoError(t, err)
+
+	_, err = svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{PartSize: 1024})
+	require.ErrorIs(t, err, ErrInvalidInput, "parties inférieures à 5 Mio")
+	_, err = svc.ResumeMultipart(ctx, orgID, res.Content.ID)
+	require.ErrorIs(t, err, ErrNoUpload)
+
+	upload, err := svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{PartSize: minPartSize})
+	require.NoError(t, err)
+	require.Equal(t, 3, upload.PartCount)
+	require.Len(t, upload.Parts, 3)
+
+	key := res.Content.StorageKey
+	etag1, err := store.PutPart(key, upload.UploadID, 1, data[:minPartSize])
+	require.NoError(t, err)
+
+	// Reprise après interruption : seules les parties manquantes sont re-signées, et une
+	// nouvelle initiation renvoie le même dépôt.
+	resumed, err := svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{})
+	require.NoError(t, err)
+	require.Equal(t, upload.UploadID, resumed.UploadID)
+	require.Len(t, resumed.Uploaded, 1)
+	require.Equal(t, []int{2, 3}, []int{resumed.Parts[0].Number, resumed.Parts[1].Number})
+
+	etag2, err := store.PutPart(key, upload.UploadID, 2, data[minPartSize:2*minPartSize])
+	require.NoError(t, err)
+	etag3, err := store.PutPart(key, upload.UploadID, 3, data[2*minPartSize:])
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	_, err = svc.CompleteMultipart(ctx, orgID, res.Content.ID, []storage.Part{{Number: 1, ETag: etag1}, {Number: 2, ETag: etag3}}, FinalizeInput{ChecksumSHA256: &checksum})
+	require.ErrorIs(t, err, ErrInvalidPart)
+
+	parts := []storage.Part{{Number: 1, ETag: etag1}, {Number: 2, ETag: etag2}, {Number: 3, ETag: etag3}}
+	_, err = svc.CompleteMultipart(ctx, orgID, res.Content.ID, parts, FinalizeInput{SizeBytes: &size})
+	require.ErrorIs(t, err, ErrInvalidInput, "empreinte du client obligatoire")
+
+	// L'empreinte déclarée est comparée à celle de l'objet assemblé, jamais reprise telle quelle.
+	wrong := strings.Repeat("0", 64)
+	_, err = svc.CompleteMultipart(ctx, orgID, res.Content.ID, parts, FinalizeInput{SizeBytes: &size, ChecksumSHA256: &wrong})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	finalized, err := svc.CompleteMultipart(ctx, orgID, res.Content.ID, parts, FinalizeInput{SizeBytes: &size, ChecksumSHA256: &checksum})
+	require.NoError(t, err)
+	require.Equal(t, StatusAvailable, finalized.Status)
+	require.Equal(t, size, finalized.SizeBytes)
+	require.Equal(t, checksum, finalized.ChecksumSha256)
+	require.Nil(t, finalized.UploadID)
+
+	// Nouvelle tentative du client (réponse perdue) : le contenu finalisé est renvoyé.
+	retried, err := svc.CompleteMultipart(ctx, orgID, res.Content.ID, parts, FinalizeInput{SizeBytes: &size, ChecksumSHA256: &checksum})
+	require.NoError(t, err)
+	require.Equal(t, finalized.ID, retried.ID)
+	require.Equal(t, StatusAvailable, retried.Status)
+
+	_, err = svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{})
+	require.ErrorIs(t, err, ErrNotPending)
+}
+
+func TestService_AbortStaleUploads(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+
+	res, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "video.mp4", MimeType: "video/mp4", SizeBytes: 3 * defaultPartSize})
+	require.NoError(t, err)
+	upload, err := svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{})
+	require.NoError(t, err)
+	require.Equal(t, 3, upload.PartCount)
+
+	aborted, err := svc.AbortStaleUploads(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Zero(t, aborted)
+
+	aborted, err = svc.AbortStaleUploads(ctx, -time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 1, aborted)
+	_, err = svc.storage.(*mockStorage).ListParts(ctx, res.Content.StorageKey, upload.UploadID)
+	require.ErrorIs(t, err, storage.ErrUploadNotFound)
+	_, err = svc.ResumeMultipart(ctx, orgID, res.Content.ID)
+	require.ErrorIs(t, err, ErrNoUpload)
+
+	// Le contenu reste en attente : un nouveau dépôt peut être ouvert.
+	again, err := svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{})
+	require.NoError(t, err)
+	require.NotEqual(t, upload.UploadID, again.UploadID)
+	require.NoError(t, svc.AbortMultipart(ctx, orgID, res.Content.ID))
+	require.ErrorIs(t, svc.AbortMultipart(ctx, orgID, res.Content.ID), ErrNoUpload)
+}
+
+func TestService_CompleteMultipartAfterInterruptedAssembly(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+	store := svc.storage.(*mockStorage)
+
+	size := minPartSize + 100
+	data := pdfData(int(size))
+	res, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "cours.pdf", MimeType: "application/pdf", SizeBytes: size})
+	require.NoError(t, err)
+	upload, err := svc.InitiateMultipart(ctx, orgID, res.Content.ID, InitiateMultipartInput{PartSize: minPartSize})
+	require.NoError(t, err)
+	key := res.Content.StorageKey
+	etag1, err := store.PutPart(key, upload.UploadID, 1, data[:minPartSize])
+	require.NoError(t, err)
+	etag2, err := store.PutPart(key, upload.UploadID, 2, data[minPartSize:])
+	require.NoError(t, err)
+	parts := []storage.Part{{Number: 1, ETag: etag1}, {Number: 2, ETag: etag2}}
+
+	// L'assemblage a eu lieu mais la finalisation a été interrompue.
+	require.NoError(t, store.CompleteMultipart(ctx, key, upload.UploadID, parts))
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	finalized, err := svc.CompleteMultipart(ctx, orgID, res.Content.ID, parts, FinalizeInput{ChecksumSHA256: &checksum})
+	require.NoError(t, err)
+	require.Equal(t, StatusAvailable, finalized.Status)
+	require.Equal(t, size, finalized.SizeBytes)
+	require.Nil(t, finalized.UploadID)
+}
@@ -0,0 +1,353 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entrevision "lms-go/internal/ent/contentrevision"
+	entorg "lms-go/internal/ent/organization"
+	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/platform/mail"
+)
+
+// Seuils d'alerte de quota, en pourcentage.
+const (
+	alertWarning = 80
+	alertFull    = 100
+)
+
+// Quota est la limite de stockage d'une organisation, lue dans settings["storage"]
+// (quota_bytes, quota_objects). Une valeur nulle signifie « illimité ».
+type Quota struct {
+	Bytes   int64
+	Objects int
+}
+
+// QuotaFromSettings lit le quota de stockage dans les réglages d'une organisation.
+func QuotaFromSettings(settings map[string]any) Quota {
+	section, ok := settings["storage"].(map[string]any)
+	if !ok {
+		return Quota{}
+	}
+	return Quota{
+		Bytes:   int64(settingNumber(section["quota_bytes"])),
+		Objects: int(settingNumber(section["quota_objects"])),
+	}
+}
+
+func (q Quota) limited() bool {
+	return q.Bytes > 0 || q.Objects > 0
+}
+
+// percent renvoie le taux d'occupation le plus élevé entre octets et objets.
+func (q Quota) percent(bytes int64, objects int) float64 {
+	var pct float64
+	if q.Bytes > 0 {
+		pct = float64(bytes) * 100 / float64(q.Bytes)
+	}
+	if q.Objects > 0 {
+		if p := float64(objects) * 100 / float64(q.Objects); p > pct {
+			pct = p
+		}
+	}
+	return pct
+}
+
+func settingNumber(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case json.Number:
+		f, _ := n.Float64()
+		return f
+	}
+	return 0
+}
+
+// CategoryUsage est l'occupation d'une famille de types MIME.
+type CategoryUsage struct {
+	Category string
+	Bytes    int64
+	Objects  int
+}
+
+// Usage décrit l'occupation du stockage d'une organisation.
+// Les octets et objets utilisés incluent les dépôts en attente (réservations).
+type Usage struct {
+	Quota        Quota
+	BytesUsed    int64
+	ObjectsUsed  int
+	Percent      float64
+	Categories   []CategoryUsage
+	PendingBytes int64
+}
+
+// Usage renvoie les compteurs de l'organisation et la répartition par catégorie des révisions
+// stockées pour les contenus non archivés.
+func (s *Service) Usage(ctx context.Context, orgID uuid.UUID) (*Usage, error) {
+	org, err := s.client.Organization.Get(ctx, orgID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := s.storedObjects(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := QuotaFromSettings(org.Settings)
+	usage := &Usage{
+		Quota:       quota,
+		BytesUsed:   org.StorageBytesUsed,
+		ObjectsUsed: org.StorageObjectsUsed,
+		Percent:     quota.percent(org.StorageBytesUsed, org.StorageObjectsUsed),
+	}
+	byCategory := map[string]*CategoryUsage{}
+	for _, row := range rows {
+		if row.Status == StatusPending {
+			usage.PendingBytes += row.Sum
+		}
+		category := MimeCategory(row.MimeType)
+		entry, ok := byCategory[category]
+		if !ok {
+			entry = &CategoryUsage{Category: category}
+			byCategory[category] = entry
+		}
+		entry.Bytes += row.Sum
+		entry.Objects += row.Count
+	}
+	usage.Categories = make([]CategoryUsage, 0, len(byCategory))
+	for _, entry := range byCategory {
+		usage.Categories = append(usage.Categories, *entry)
+	}
+	sort.Slice(usage.Categories, func(i, j int) bool {
+		return usage.Categories[i].Category < usage.Categories[j].Category
+	})
+	return usage, nil
+}
+
+// MimeCategory classe un type MIME dans une famille de reporting :
+// video, audio, image, document, archive ou other.
+func MimeCategory(mimeType string) string {
+	media := baseMediaType(mimeType)
+	major, _, _ := strings.Cut(media, "/")
+	switch {
+	case major == "video", major == "audio", major == "image":
+		return major
+	case major == "text", media == "application/pdf", isTextual(media),
+		strings.HasPrefix(media, "application/vnd.openxmlformats-officedocument."),
+		strings.HasPrefix(media, "application/vnd.oasis.opendocument."),
+		strings.HasPrefix(media, "application/vnd.ms-"), media == "application/msword":
+		return "document"
+	case isZipContainer(media), media == "application/gzip", media == "application/x-tar":
+		return "archive"
+	}
+	return "other"
+}
+
+// Recalculate resynchronise les compteurs de l'organisation à partir des révisions des contenus non archivés,
+// par exemple pour des contenus antérieurs à la comptabilisation.
+func (s *Service) Recalculate(ctx context.Context, orgID uuid.UUID) error {
+	rows, err := s.storedObjects(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	var bytes int64
+	var objects int
+	for _, row := range rows {
+		bytes += row.Sum
+		objects += row.Count
+	}
+	if err := s.client.Organization.UpdateOneID(orgID).
+		SetStorageBytesUsed(bytes).
+		SetStorageObjectsUsed(objects).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+	s.checkQuotaAlert(ctx, orgID)
+	return nil
+}
+
+type objectGroup struct {
+	MimeType string `json:"mime_type"`
+	Status   string `json:"status"`
+	Count    int    `json:"count"`
+	Sum      int64  `json:"sum"`
+}
+
+// storedObjects regroupe par type et statut les objets stockés pour les contenus non archivés :
+// toutes leurs révisions, et la révision courante des contenus antérieurs aux révisions.
+func (s *Service) storedObjects(ctx context.Context, orgID uuid.UUID) ([]objectGroup, error) {
+	var revisions, legacy []objectGroup
+	err := s.client.ContentRevision.Query().
+		Where(
+			entrevision.OrganizationIDEQ(orgID),
+			entrevision.HasContentWith(entcontent.StatusNEQ(StatusArchived)),
+		).
+		GroupBy(entrevision.FieldMimeType, entrevision.FieldStatus).
+		Aggregate(ent.Count(), ent.Sum(entrevision.FieldSizeBytes)).
+		Scan(ctx, &revisions)
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.Content.Query().
+		Where(
+			entcontent.OrganizationIDEQ(orgID),
+			entcontent.StatusNEQ(StatusArchived),
+			entcontent.Not(entcontent.HasRevisions()),
+		).
+		GroupBy(entcontent.FieldMimeType, entcontent.FieldStatus).
+		Aggregate(ent.Count(), ent.Sum(entcontent.FieldSizeBytes)).
+		Scan(ctx, &legacy)
+	if err != nil {
+		return nil, err
+	}
+	return append(revisions, legacy...), nil
+}
+
+// reserve ajoute bytes et objects aux compteurs de l'organisation si le quota le permet.
+// La mise à jour conditionnelle rend la réservation atomique face aux dépôts concurrents.
+func reserve(ctx context.Context, tx *ent.Tx, orgID uuid.UUID, quota Quota, bytes int64, objects int) error {
+	update := tx.Organization.Update().Where(entorg.IDEQ(orgID))
+	if quota.Bytes > 0 && bytes > 0 {
+		if bytes > quota.Bytes {
+			return ErrQuotaExceeded
+		}
+		update.Where(entorg.StorageBytesUsedLTE(quota.Bytes - bytes))
+	}
+	if quota.Objects > 0 && objects > 0 {
+		if objects > quota.Objects {
+			return ErrQuotaExceeded
+		}
+		update.Where(entorg.StorageObjectsUsedLTE(quota.Objects - objects))
+	}
+	n, err := update.
+		AddStorageBytesUsed(bytes).
+		AddStorageObjectsUsed(objects).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// release retire bytes et objects des compteurs sans les rendre négatifs.
+func release(ctx context.Context, tx *ent.Tx, orgID uuid.UUID, bytes int64, objects int) error {
+	if err := tx.Organization.UpdateOneID(orgID).
+		AddStorageBytesUsed(-bytes).
+		AddStorageObjectsUsed(-objects).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Organization.Update().
+		Where(entorg.IDEQ(orgID), entorg.StorageBytesUsedLT(0)).
+		SetStorageBytesUsed(0).
+		Save(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Organization.Update().
+		Where(entorg.IDEQ(orgID), entorg.StorageObjectsUsedLT(0)).
+		SetStorageObjectsUsed(0).
+		Save(ctx)
+	return err
+}
+
+// checkQuotaAlert notifie les administrateurs lorsque l'occupation franchit 80 % ou 100 % du quota.
+// Le seuil notifié est mémorisé sur l'organisation : chaque seuil n'est signalé qu'une fois,
+// puis réarmé lorsque l'occupation redescend. Les échecs sont journalisés sans interrompre l'opération.
+func (s *Service) checkQuotaAlert(ctx context.Context, orgID uuid.UUID) {
+	org, err := s.client.Organization.Get(ctx, orgID)
+	if err != nil {
+		slog.ErrorContext(ctx, "content: quota alert lookup failed", "org_id", orgID, "error", err)
+		return
+	}
+	quota := QuotaFromSettings(org.Settings)
+	percent := quota.percent(org.StorageBytesUsed, org.StorageObjectsUsed)
+	level := 0
+	switch {
+	case !quota.limited():
+	case percent >= alertFull:
+		level = alertFull
+	case percent >= alertWarning:
+		level = alertWarning
+	}
+	if level == org.StorageAlertLevel {
+		return
+	}
+
+	predicate := entorg.StorageAlertLevelGT(level)
+	if level > org.StorageAlertLevel {
+		predicate = entorg.StorageAlertLevelLT(level)
+	}
+	n, err := s.client.Organization.Update().
+		Where(entorg.IDEQ(orgID), predicate).
+		SetStorageAlertLevel(level).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "content: quota alert update failed", "org_id", orgID, "error", err)
+		return
+	}
+	// Une autre requête a déjà notifié ce seuil, ou l'occupation redescend.
+	if n == 0 || level < org.StorageAlertLevel {
+		return
+	}
+
+	slog.WarnContext(ctx, "content: storage quota threshold reached",
+		"org_id", orgID, "threshold", level, "bytes_used", org.StorageBytesUsed, "objects_used", org.StorageObjectsUsed)
+	if s.mailer == nil {
+		return
+	}
+	admins, err := s.client.User.Query().
+		Where(
+			entuser.OrganizationIDEQ(orgID),
+			entuser.RoleEQ("admin"),
+			entuser.StatusEQ("active"),
+		).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "content: quota alert recipients lookup failed", "org_id", orgID, "error", err)
+		return
+	}
+	for _, admin := range admins {
+		if err := s.mailer.Send(ctx, quotaAlertMessage(org, admin.Email, level, quota)); err != nil {
+			slog.ErrorContext(ctx, "content: quota alert mail failed", "org_id", orgID, "error", err)
+		}
+	}
+}
+
+func quotaAlertMessage(org *ent.Organization, to string, level int, quota Quota) mail.Message {
+	subject := fmt.Sprintf("[%s] Stockage utilisé à %d %%", org.Name, level)
+	var body strings.Builder
+	fmt.Fprintf(&body, "Bonjour,\n\nL'espace de stockage de l'organisation %s atteint %d %% de son quota.\n\n", org.Name, level)
+	if quota.Bytes > 0 {
+		fmt.Fprintf(&body, "Volume : %d octets utilisés sur %d.\n", org.StorageBytesUsed, quota.Bytes)
+	}
+	if quota.Objects > 0 {
+		fmt.Fprintf(&body, "Fichiers : %d sur %d.\n", org.StorageObjectsUsed, quota.Objects)
+	}
+	if level >= alertFull {
+		body.WriteString("\nLes nouveaux dépôts sont refusés tant que de l'espace n'est pas libéré (archivage de contenus) ou que le quota n'est pas relevé.\n")
+	}
+	return mail.Message{To: to, Subject: subject, Text: body.String()}
+}
@@ -0,0 +1,166 @@
+package content
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/mail"
+)
+
+func newQuotaService(t *testing.T, quota map[string]any) (*Service, *mail.MemorySender, uuid.UUID) {
+	db, err := sql.Open("sqlite", "file:contentquota?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	org, err := client.Organization.Create().
+		SetName("Org").
+		SetSlug("org").
+		SetSettings(map[string]any{"storage": quota}).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.User.Create().
+		SetOrganizationID(org.ID).
+		SetEmail("admin@example.com").
+		SetPasswordHash("x").
+		SetRole("admin").
+		Save(ctx)
+	require.NoError(t, err)
+
+	mailer := mail.NewMemorySender()
+	return NewService(client, newMockStorage(), Config{Mailer: mailer}), mailer, org.ID
+}
+
+func uploadAndFinalize(t *testing.T, s *Service, orgID uuid.UUID, name, mimeType string, declared int64, data []byte) *ent.Content {
+	t.Helper()
+	ctx := context.Background()
+	res, err := s.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: name, MimeType: mimeType, SizeBytes: declared})
+	require.NoError(t, err)
+	s.storage.(*mockStorage).Put(res.Content.StorageKey, data, mimeType)
+	finalized, err := s.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{})
+	require.NoError(t, err)
+	return finalized
+}
+
+func TestService_QuotaReservation(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{"quota_bytes": float64(10000), "quota_objects": float64(3)})
+	ctx := context.Background()
+
+	_, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "a.mp4", MimeType: "video/mp4"})
+	require.ErrorIs(t, err, ErrInvalidInput, "la taille est obligatoire sous quota")
+
+	_, err = svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "big.mp4", MimeType: "video/mp4", SizeBytes: 20000})
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+
+	// Réservation de 6000 octets, réconciliée à 4000 au finalize.
+	first := uploadAndFinalize(t, svc, orgID, "a.mp4", "video/mp4", 6000, mp4Data(4000))
+	usage, err := svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.EqualValues(t, 4000, usage.BytesUsed)
+	require.Equal(t, 1, usage.ObjectsUsed)
+
+	_, err = svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "b.mp4", MimeType: "video/mp4", SizeBytes: 7000})
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+
+	// Un fichier plus gros que déclaré ne peut pas dépasser le quota au finalize.
+	res, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "c.mp4", MimeType: "video/mp4", SizeBytes: 1000})
+	require.NoError(t, err)
+	svc.storage.(*mockStorage).Put(res.Content.StorageKey, mp4Data(8000), "video/mp4")
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{})
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+
+	require.NoError(t, svc.Archive(ctx, orgID, res.Content.ID))
+	require.NoError(t, svc.Archive(ctx, orgID, first.ID))
+	require.NoError(t, svc.Archive(ctx, orgID, first.ID))
+	usage, err = svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.Zero(t, usage.BytesUsed)
+	require.Zero(t, usage.ObjectsUsed)
+
+	for i := 0; i < 3; i++ {
+		_, err = svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "n.txt", MimeType: "text/plain", SizeBytes: 10})
+		require.NoError(t, err)
+	}
+	_, err = svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "n.txt", MimeType: "text/plain", SizeBytes: 10})
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+}
+
+func TestService_QuotaAlerts(t *testing.T) {
+	svc, mailer, orgID := newQuotaService(t, map[string]any{"quota_bytes": float64(1000)})
+	ctx := context.Background()
+
+	uploadAndFinalize(t, svc, orgID, "a.mp4", "video/mp4", 500, mp4Data(500))
+	require.Empty(t, mailer.Messages())
+
+	second := uploadAndFinalize(t, svc, orgID, "b.mp4", "video/mp4", 300, mp4Data(300))
+	require.Len(t, mailer.Messages(), 1)
+	require.Equal(t, "admin@example.com", mailer.Messages()[0].To)
+	require.Contains(t, mailer.Messages()[0].Subject, "80 %")
+
+	uploadAndFinalize(t, svc, orgID, "c.mp4", "video/mp4", 100, mp4Data(100))
+	require.Len(t, mailer.Messages(), 1, "seuil déjà notifié")
+
+	uploadAndFinalize(t, svc, orgID, "d.mp4", "video/mp4", 100, mp4Data(100))
+	require.Len(t, mailer.Messages(), 2)
+	require.Contains(t, mailer.Messages()[1].Subject, "100 %")
+
+	// Le seuil est réarmé quand l'occupation redescend.
+	require.NoError(t, svc.Archive(ctx, orgID, second.ID))
+	uploadAndFinalize(t, svc, orgID, "e.mp4", "video/mp4", 300, mp4Data(300))
+	require.Len(t, mailer.Messages(), 3)
+}
+
+func TestService_UsageCategories(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+
+	uploadAndFinalize(t, svc, orgID, "a.mp4", "video/mp4", 0, mp4Data(400))
+	uploadAndFinalize(t, svc, orgID, "b.mp4", "video/mp4", 0, mp4Data(100))
+	_, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "c.pdf", MimeType: "application/pdf", SizeBytes: 50})
+	require.NoError(t, err)
+
+	usage, err := svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.EqualValues(t, 550, usage.BytesUsed)
+	require.Equal(t, 3, usage.ObjectsUsed)
+	require.EqualValues(t, 50, usage.PendingBytes)
+	require.Zero(t, usage.Percent)
+	require.Equal(t, []CategoryUsage{
+		{Category: "document", Bytes: 50, Objects: 1},
+		{Category: "video", Bytes: 500, Objects: 2},
+	}, usage.Categories)
+
+	_, err = svc.Usage(ctx, uuid.New())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMimeCategory(t *testing.T) {
+	cases := map[string]string{
+		"video/mp4":                "video",
+		"audio/mpeg":               "audio",
+		"image/png; charset=x":     "image",
+		"application/pdf":          "document",
+		"text/plain":               "document",
+		"application/zip":          "archive",
+		"application/octet-stream": "other",
+	}
+	for mimeType, want := range cases {
+		require.Equal(t, want, MimeCategory(mimeType), mimeType)
+	}
+}
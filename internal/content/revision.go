@@ -0,0 +1,358 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entrevision "lms-go/internal/ent/contentrevision"
+)
+
+// CreateRevisionInput décrit le nouveau binaire d'un contenu existant.
+type CreateRevisionInput struct {
+	// MimeType vide conserve le type du contenu.
+	MimeType  string
+	SizeBytes int64
+}
+
+// RevisionUpload est le lien de dépôt d'une nouvelle révision.
+type RevisionUpload struct {
+	Content   *ent.Content
+	Revision  *ent.ContentRevision
+	UploadURL string
+	ExpiresAt time.Time
+}
+
+// DownloadInput sélectionne la révision servie et identifie le lecteur.
+type DownloadInput struct {
+	// Revision épingle une révision ; nil sert la révision courante.
+	Revision *int
+	UserID   *uuid.UUID
+	ModuleID *uuid.UUID
+	// Restricted applique les règles d'accès des apprenants : UserID doit être inscrit à un cours
+	// dont un module déverrouillé référence le contenu, et seule la révision servie par ce module est accessible.
+	Restricted bool
+}
+
+// DownloadLink est une URL de téléchargement signée et la révision qu'elle sert.
+type DownloadLink struct {
+	URL       string
+	ExpiresAt time.Time
+	Revision  int
+}
+
+// Revisions renvoie l'historique des révisions du contenu, de la plus récente à la plus ancienne.
+func (s *Service) Revisions(ctx context.Context, orgID, contentID uuid.UUID) ([]*ent.ContentRevision, error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.client.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(current.ID)).
+		Order(ent.Desc(entrevision.FieldNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return []*ent.ContentRevision{legacyRevision(current)}, nil
+	}
+	return revisions, nil
+}
+
+// CreateRevision prépare le dépôt d'un nouveau binaire pour un contenu disponible. La révision N+1
+// reçoit sa propre clé de stockage et ne devient la révision courante qu'à sa finalisation.
+func (s *Service) CreateRevision(ctx context.Context, orgID, contentID uuid.UUID, input CreateRevisionInput) (upload *RevisionUpload, err error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+	mimeType := strings.TrimSpace(input.MimeType)
+	if mimeType == "" {
+		mimeType = current.MimeType
+	}
+	org, err := s.client.Organization.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !mimeAllowed(allowedMimeTypes(org.Settings), mimeType) {
+		return nil, ErrMimeNotAllowed
+	}
+	quota := QuotaFromSettings(org.Settings)
+	if input.SizeBytes < 0 || (quota.limited() && input.SizeBytes == 0) {
+		return nil, ErrInvalidInput
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = backfillRevision(ctx, tx, current); err != nil {
+		return nil, err
+	}
+	next := current.CurrentRevision + 1
+	last, err := tx.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(current.ID)).
+		Order(ent.Desc(entrevision.FieldNumber)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last.Number >= next {
+		next = last.Number + 1
+	}
+	revision, err := tx.ContentRevision.Create().
+		SetOrganizationID(orgID).
+		SetContentID(current.ID).
+		SetNumber(next).
+		SetStorageKey(buildStorageKey(orgID, current.Name)).
+		SetMimeType(mimeType).
+		SetSizeBytes(input.SizeBytes).
+		SetStatus(StatusPending).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			err = ErrConflict
+		}
+		return nil, err
+	}
+	if err = reserve(ctx, tx, orgID, quota, revision.SizeBytes, 1); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.checkQuotaAlert(ctx, orgID)
+
+	expiresAt := time.Now().Add(s.uploadExpiry)
+	uploadURL, err := s.storage.PresignUpload(ctx, revision.StorageKey, revision.MimeType, revision.SizeBytes, s.uploadExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("content: presign upload: %w", err)
+	}
+	return &RevisionUpload{
+		Content:   current,
+		Revision:  revision,
+		UploadURL: uploadURL,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// FinalizeRevision vérifie le binaire déposé pour la révision number. Une révision plus récente
+// que la révision courante devient la version servie aux modules qui suivent « latest ».
+func (s *Service) FinalizeRevision(ctx context.Context, orgID, contentID uuid.UUID, number int, input FinalizeInput) (*ent.Content, error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.finalizeRevision(ctx, current, number, input, nil)
+}
+
+// Rollback fait d'une révision disponible antérieure la révision courante. Les révisions
+// plus récentes sont conservées et peuvent être rétablies de la même façon.
+func (s *Service) Rollback(ctx context.Context, orgID, contentID uuid.UUID, number int) (*ent.Content, error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == StatusArchived {
+		return nil, ErrNotFound
+	}
+	revision, err := s.revisionOf(ctx, current, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+	content, err := s.client.Content.UpdateOneID(current.ID).
+		Where(entcontent.OrganizationIDEQ(orgID), entcontent.StatusNEQ(StatusArchived)).
+		SetCurrentRevision(revision.Number).
+		SetStatus(StatusAvailable).
+		SetStorageKey(revision.StorageKey).
+		SetMimeType(revision.MimeType).
+		SetSizeBytes(revision.SizeBytes).
+		SetEtag(revision.Etag).
+		SetChecksumSha256(revision.ChecksumSha256).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+// Download signe une URL de téléchargement pour la révision demandée et enregistre
+// la révision servie, le lecteur et le module d'origine.
+func (s *Service) Download(ctx context.Context, orgID, contentID uuid.UUID, input DownloadInput) (*DownloadLink, error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+	number := current.CurrentRevision
+	if input.Revision != nil {
+		number = *input.Revision
+	}
+	if input.Restricted {
+		if number, input.ModuleID, err = s.authorize(ctx, current, input); err != nil {
+			return nil, err
+		}
+	}
+	revision, err := s.revisionOf(ctx, current, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+
+	// En mode proxy, le téléchargement est tracé lorsque le flux est effectivement servi.
+	if s.stream.enabled() {
+		return s.streamLink(current, revision.Number, input)
+	}
+	expires := time.Now().Add(s.downloadExpiry)
+	url, err := s.storage.PresignDownload(ctx, revision.StorageKey, s.downloadExpiry)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordDownload(ctx, current, revision.Number, input.UserID, input.ModuleID); err != nil {
+		return nil, err
+	}
+	return &DownloadLink{URL: url, ExpiresAt: expires, Revision: revision.Number}, nil
+}
+
+// authorize choisit, parmi les modules accessibles à l'apprenant, celui qui sert la révision demandée
+// (ou, à défaut de demande, le premier) ; elle renvoie la révision à servir et le module retenu.
+func (s *Service) authorize(ctx context.Context, current *ent.Content, input DownloadInput) (int, *uuid.UUID, error) {
+	if input.UserID == nil {
+		return 0, nil, ErrForbidden
+	}
+	modules, err := s.accessibleModules(ctx, current.OrganizationID, current.ID, *input.UserID)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, m := range modules {
+		if input.ModuleID != nil && *input.ModuleID != m.ID {
+			continue
+		}
+		served := current.CurrentRevision
+		if m.ContentRevision != nil {
+			served = *m.ContentRevision
+		}
+		if input.Revision == nil || *input.Revision == served {
+			return served, &m.ID, nil
+		}
+	}
+	return 0, nil, ErrForbidden
+}
+
+func (s *Service) recordDownload(ctx context.Context, c *ent.Content, revision int, userID, moduleID *uuid.UUID) error {
+	return s.client.ContentDownload.Create().
+		SetOrganizationID(c.OrganizationID).
+		SetContentID(c.ID).
+		SetRevision(revision).
+		SetNillableUserID(userID).
+		SetNillableModuleID(moduleID).
+		Exec(ctx)
+}
+
+// revisionOf charge la révision number. Un contenu antérieur aux révisions n'a pas de ligne :
+// sa révision courante est alors reconstituée à partir du contenu.
+func (s *Service) revisionOf(ctx context.Context, current *ent.Content, number int) (*ent.ContentRevision, error) {
+	revision, err := s.client.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(current.ID), entrevision.NumberEQ(number)).
+		Only(ctx)
+	if err == nil {
+		return revision, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if number == current.CurrentRevision {
+		exists, err := s.client.ContentRevision.Query().
+			Where(entrevision.ContentIDEQ(current.ID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return legacyRevision(current), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func legacyRevision(c *ent.Content) *ent.ContentRevision {
+	return &ent.ContentRevision{
+		OrganizationID: c.OrganizationID,
+		ContentID:      c.ID,
+		Number:         c.CurrentRevision,
+		StorageKey:     c.StorageKey,
+		MimeType:       c.MimeType,
+		SizeBytes:      c.SizeBytes,
+		Etag:           c.Etag,
+		ChecksumSha256: c.ChecksumSha256,
+		Status:         c.Status,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
+
+// backfillRevision enregistre la révision courante d'un contenu qui n'en a encore aucune.
+func backfillRevision(ctx context.Context, tx *ent.Tx, c *ent.Content) error {
+	exists, err := tx.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(c.ID)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return tx.ContentRevision.Create().
+		SetOrganizationID(c.OrganizationID).
+		SetContentID(c.ID).
+		SetNumber(c.CurrentRevision).
+		SetStorageKey(c.StorageKey).
+		SetMimeType(c.MimeType).
+		SetSizeBytes(c.SizeBytes).
+		SetEtag(c.Etag).
+		SetChecksumSha256(c.ChecksumSha256).
+		SetStatus(c.Status).
+		Exec(ctx)
+}
+
+// footprint renvoie l'espace occupé par toutes les révisions d'un contenu.
+func footprint(ctx context.Context, tx *ent.Tx, c *ent.Content) (int64, int, error) {
+	var rows []struct {
+		Count int   `json:"count"`
+		Sum   int64 `json:"sum"`
+	}
+	err := tx.ContentRevision.Query().
+		Where(entrevision.ContentIDEQ(c.ID)).
+		Aggregate(ent.Count(), ent.Sum(entrevision.FieldSizeBytes)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(rows) == 0 || rows[0].Count == 0 {
+		return c.SizeBytes, 1, nil
+	}
+	return rows[0].Sum, rows[0].Count, nil
+}
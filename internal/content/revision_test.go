@@ -0,0 +1,120 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	entdownload "lms-go/internal/ent/contentdownload"
+)
+
+func TestService_Revisions(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{"quota_bytes": float64(10000)})
+	ctx := context.Background()
+
+	first := uploadAndFinalize(t, svc, orgID, "guide.pdf", "application/pdf", 100, pdfData(100))
+	require.Equal(t, 1, first.CurrentRevision)
+
+	upload, err := svc.CreateRevision(ctx, orgID, first.ID, CreateRevisionInput{SizeBytes: 200})
+	require.NoError(t, err)
+	require.Equal(t, 2, upload.Revision.Number)
+	require.NotEqual(t, first.StorageKey, upload.Revision.StorageKey)
+
+	// Tant que la révision 2 n'est pas finalisée, la révision 1 reste servie.
+	link, err := svc.Download(ctx, orgID, first.ID, DownloadInput{})
+	require.NoError(t, err)
+	require.Equal(t, 1, link.Revision)
+	pending := 2
+	_, err = svc.Download(ctx, orgID, first.ID, DownloadInput{Revision: &pending})
+	require.ErrorIs(t, err, ErrNotAvailable)
+
+	svc.storage.(*mockStorage).Put(upload.Revision.StorageKey, pdfData(150), "application/pdf")
+	second, err := svc.FinalizeRevision(ctx, orgID, first.ID, 2, FinalizeInput{})
+	require.NoError(t, err)
+	require.Equal(t, 2, second.CurrentRevision)
+	require.Equal(t, upload.Revision.StorageKey, second.StorageKey)
+	require.EqualValues(t, 150, second.SizeBytes)
+
+	history, err := svc.Revisions(ctx, orgID, first.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, 2, history[0].Number)
+	require.Equal(t, StatusAvailable, history[1].Status)
+
+	usage, err := svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.EqualValues(t, 250, usage.BytesUsed)
+	require.Equal(t, 2, usage.ObjectsUsed)
+
+	userID := uuid.New()
+	pinned := 1
+	link, err = svc.Download(ctx, orgID, first.ID, DownloadInput{Revision: &pinned, UserID: &userID})
+	require.NoError(t, err)
+	require.Contains(t, link.URL, first.StorageKey)
+	seen, err := svc.client.ContentDownload.Query().
+		Where(entdownload.UserIDEQ(userID)).
+		Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, seen.Revision)
+
+	rolled, err := svc.Rollback(ctx, orgID, first.ID, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, rolled.CurrentRevision)
+	require.Equal(t, first.StorageKey, rolled.StorageKey)
+	_, err = svc.Rollback(ctx, orgID, first.ID, 7)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Après un retour arrière, la révision suivante prend le numéro 3.
+	upload, err = svc.CreateRevision(ctx, orgID, first.ID, CreateRevisionInput{SizeBytes: 10})
+	require.NoError(t, err)
+	require.Equal(t, 3, upload.Revision.Number)
+
+	require.NoError(t, svc.Archive(ctx, orgID, first.ID))
+	usage, err = svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.Zero(t, usage.BytesUsed)
+	require.Zero(t, usage.ObjectsUsed)
+}
+
+func TestService_RevisionsLegacyContent(t *testing.T) {
+	svc, _, orgID := newQuotaService(t, map[string]any{})
+	ctx := context.Background()
+
+	// Contenu antérieur aux révisions : aucune ligne ContentRevision.
+	legacy, err := svc.client.Content.Create().
+		SetOrganizationID(orgID).
+		SetName("old.pdf").
+		SetMimeType("application/pdf").
+		SetSizeBytes(100).
+		SetStorageKey("legacy/old.pdf").
+		SetStatus(StatusAvailable).
+		Save(ctx)
+	require.NoError(t, err)
+
+	history, err := svc.Revisions(ctx, orgID, legacy.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "legacy/old.pdf", history[0].StorageKey)
+
+	require.NoError(t, svc.Recalculate(ctx, orgID))
+	upload, err := svc.CreateRevision(ctx, orgID, legacy.ID, CreateRevisionInput{SizeBytes: 50})
+	require.NoError(t, err)
+	require.Equal(t, 2, upload.Revision.Number)
+
+	history, err = svc.Revisions(ctx, orgID, legacy.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.NoError(t, svc.Recalculate(ctx, orgID))
+	usage, err := svc.Usage(ctx, orgID)
+	require.NoError(t, err)
+	require.EqualValues(t, 150, usage.BytesUsed)
+	require.Equal(t, 2, usage.ObjectsUsed)
+}
+
+func pdfData(size int) []byte {
+	data := make([]byte, size)
+	copy(data, "%PDF-1.7\n")
+	return data
+}
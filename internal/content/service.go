@@ -2,7 +2,10 @@ package content
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -11,7 +14,9 @@ import (
 
 	"lms-go/internal/ent"
 	entcontent "lms-go/internal/ent/content"
-	entorg "lms-go/internal/ent/organization"
+	entrevision "lms-go/internal/ent/contentrevision"
+	"lms-go/internal/platform/mail"
+	"lms-go/internal/platform/storage"
 )
 
 const (
@@ -21,14 +26,21 @@ const (
 )
 
 type Storage interface {
-	PresignUpload(ctx context.Context, object string, contentType string, expires time.Duration) (string, error)
+	PresignUpload(ctx context.Context, object string, contentType string, size int64, expires time.Duration) (string, error)
 	PresignDownload(ctx context.Context, object string, expires time.Duration) (string, error)
 	Remove(ctx context.Context, object string) error
+	// Stat renvoie storage.ErrObjectNotFound si l'objet n'a pas été déposé.
+	Stat(ctx context.Context, object string) (storage.ObjectInfo, error)
+	Open(ctx context.Context, object string) (io.ReadCloser, error)
 }
 
 type Config struct {
 	UploadExpiry   time.Duration
 	DownloadExpiry time.Duration
+	// Mailer reçoit les alertes de quota destinées aux administrateurs (optionnel).
+	Mailer mail.Sender
+	// Stream active le mode proxy des téléchargements (optionnel).
+	Stream StreamConfig
 }
 
 type Service struct {
@@ -36,6 +48,8 @@ type Service struct {
 	storage        Storage
 	uploadExpiry   time.Duration
 	downloadExpiry time.Duration
+	mailer         mail.Sender
+	stream         StreamConfig
 }
 
 func NewService(client *ent.Client, storage Storage, cfg Config) *Service {
@@ -47,11 +61,17 @@ func NewService(client *ent.Client, storage Storage, cfg Config) *Service {
 	if download == 0 {
 		download = 15 * time.Minute
 	}
+	stream := cfg.Stream
+	if stream.Expiry == 0 {
+		stream.Expiry = 5 * time.Minute
+	}
 	return &Service{
 		client:         client,
 		storage:        storage,
 		uploadExpiry:   upload,
 		downloadExpiry: download,
+		mailer:         cfg.Mailer,
+		stream:         stream,
 	}
 }
 
@@ -70,10 +90,12 @@ type UploadLink struct {
 }
 
 type FinalizeInput struct {
-	Name      *string
-	MimeType  *string
-	SizeBytes *int64
-	Metadata  map[string]any
+	Name     *string
+	MimeType *string
+	// SizeBytes et ChecksumSHA256, s'ils sont fournis, doivent correspondre à l'objet déposé.
+	SizeBytes      *int64
+	ChecksumSHA256 *string
+	Metadata       map[string]any
 }
 
 func (s *Service) CreateUpload(ctx context.Context, input CreateUploadInput) (*UploadLink, error) {
@@ -84,9 +106,21 @@ func (s *Service) CreateUpload(ctx context.Context, input CreateUploadInput) (*U
 	if name == "" || input.MimeType == "" {
 		return nil, ErrInvalidInput
 	}
-	if err := s.ensureOrg(ctx, input.OrganizationID); err != nil {
+	org, err := s.client.Organization.Get(ctx, input.OrganizationID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidInput
+		}
 		return nil, err
 	}
+	if !mimeAllowed(allowedMimeTypes(org.Settings), input.MimeType) {
+		return nil, ErrMimeNotAllowed
+	}
+	// Sous quota, la taille déclarée est réservée et imposée au dépôt : elle est donc requise.
+	quota := QuotaFromSettings(org.Settings)
+	if input.SizeBytes < 0 || (quota.limited() && input.SizeBytes == 0) {
+		return nil, ErrInvalidInput
+	}
 
 	objectKey := buildStorageKey(input.OrganizationID, name)
 	metadata := input.Metadata
@@ -94,21 +128,23 @@ func (s *Service) CreateUpload(ctx context.Context, input CreateUploadInput) (*U
 		metadata = map[string]any{}
 	}
 
-	content, err := s.client.Content.Create().
-		SetOrganizationID(input.OrganizationID).
-		SetName(name).
-		SetMimeType(input.MimeType).
-		SetSizeBytes(input.SizeBytes).
-		SetStorageKey(objectKey).
-		SetStatus(StatusPending).
-		SetMetadata(metadata).
-		Save(ctx)
+	content, err := s.createReserved(ctx, quota, func(tx *ent.Tx) *ent.ContentCreate {
+		return tx.Content.Create().
+			SetOrganizationID(input.OrganizationID).
+			SetName(name).
+			SetMimeType(input.MimeType).
+			SetSizeBytes(input.SizeBytes).
+			SetStorageKey(objectKey).
+			SetStatus(StatusPending).
+			SetMetadata(metadata)
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.checkQuotaAlert(ctx, input.OrganizationID)
 
 	expiresAt := time.Now().Add(s.uploadExpiry)
-	uploadURL, err := s.storage.PresignUpload(ctx, content.StorageKey, content.MimeType, s.uploadExpiry)
+	uploadURL, err := s.storage.PresignUpload(ctx, content.StorageKey, content.MimeType, content.SizeBytes, s.uploadExpiry)
 	if err != nil {
 		return nil, fmt.Errorf("content: presign upload: %w", err)
 	}
@@ -120,40 +156,154 @@ func (s *Service) CreateUpload(ctx context.Context, input CreateUploadInput) (*U
 	}, nil
 }
 
+// createReserved enregistre le contenu et sa première révision, et réserve sa taille déclarée
+// dans la même transaction.
+func (s *Service) createReserved(ctx context.Context, quota Quota, build func(tx *ent.Tx) *ent.ContentCreate) (content *ent.Content, err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	content, err = build(tx).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = backfillRevision(ctx, tx, content); err != nil {
+		return nil, err
+	}
+	if err = reserve(ctx, tx, content.OrganizationID, quota, content.SizeBytes, 1); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// Finalize vérifie l'objet déposé (présence, taille, empreinte, type réel) avant de rendre
+// le contenu disponible. La taille et l'empreinte enregistrées sont celles du stockage.
+// La réservation faite à la création est ajustée à la taille réelle.
 func (s *Service) Finalize(ctx context.Context, orgID, contentID uuid.UUID, input FinalizeInput) (*ent.Content, error) {
-	update := s.client.Content.UpdateOneID(contentID).
-		Where(entcontent.OrganizationIDEQ(orgID)).
-		SetStatus(StatusAvailable).
-		SetUpdatedAt(time.Now())
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.finalizeRevision(ctx, current, current.CurrentRevision, input, nil)
+}
 
+// finalizeRevision vérifie puis publie la révision number. uploadID désigne le dépôt multipart
+// dont l'objet est issu ; ce dépôt est clos dans la même transaction.
+func (s *Service) finalizeRevision(ctx context.Context, current *ent.Content, number int, input FinalizeInput, uploadID *string) (content *ent.Content, err error) {
+	if current.Status == StatusArchived {
+		return nil, ErrNotFound
+	}
+	revision, err := s.revisionOf(ctx, current, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var name *string
 	if input.Name != nil {
-		name := strings.TrimSpace(*input.Name)
-		if name == "" {
+		trimmed := strings.TrimSpace(*input.Name)
+		if trimmed == "" {
 			return nil, ErrInvalidInput
 		}
-		update.SetName(name)
+		name = &trimmed
 	}
+	mimeType := revision.MimeType
 	if input.MimeType != nil {
-		mime := strings.TrimSpace(*input.MimeType)
-		if mime == "" {
+		mimeType = strings.TrimSpace(*input.MimeType)
+		if mimeType == "" {
 			return nil, ErrInvalidInput
 		}
-		update.SetMimeType(mime)
 	}
-	if input.SizeBytes != nil {
-		update.SetSizeBytes(*input.SizeBytes)
+
+	// La vérification lit l'objet entier : elle a lieu avant d'ouvrir la transaction.
+	verified, err := s.verifyObject(ctx, current.OrganizationID, revision.StorageKey, mimeType)
+	if err != nil {
+		return nil, err
+	}
+	if input.SizeBytes != nil && *input.SizeBytes != verified.size {
+		return nil, fmt.Errorf("%w: declared %d, stored %d", ErrSizeMismatch, *input.SizeBytes, verified.size)
+	}
+	if input.ChecksumSHA256 != nil && !strings.EqualFold(strings.TrimSpace(*input.ChecksumSHA256), verified.checksum) {
+		return nil, ErrChecksumMismatch
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	switch delta := verified.size - revision.SizeBytes; {
+	case delta > 0:
+		err = reserve(ctx, tx, current.OrganizationID, verified.quota, delta, 0)
+	case delta < 0:
+		err = release(ctx, tx, current.OrganizationID, -delta, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = backfillRevision(ctx, tx, current); err != nil {
+		return nil, err
+	}
+	if err = tx.ContentRevision.Update().
+		Where(entrevision.ContentIDEQ(current.ID), entrevision.NumberEQ(number)).
+		SetStatus(StatusAvailable).
+		SetMimeType(mimeType).
+		SetSizeBytes(verified.size).
+		SetEtag(verified.etag).
+		SetChecksumSha256(verified.checksum).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	update := tx.Content.UpdateOneID(current.ID).
+		Where(entcontent.OrganizationIDEQ(current.OrganizationID), entcontent.StatusNEQ(StatusArchived)).
+		SetNillableName(name).
+		SetUpdatedAt(time.Now())
+	// Une révision au moins aussi récente que la révision courante devient la version servie.
+	if number >= current.CurrentRevision {
+		update.
+			SetCurrentRevision(number).
+			SetStatus(StatusAvailable).
+			SetStorageKey(revision.StorageKey).
+			SetMimeType(mimeType).
+			SetSizeBytes(verified.size).
+			SetEtag(verified.etag).
+			SetChecksumSha256(verified.checksum)
 	}
 	if input.Metadata != nil {
 		update.SetMetadata(input.Metadata)
 	}
-
-	content, err := update.Save(ctx)
+	if uploadID != nil {
+		update.
+			Where(entcontent.UploadIDEQ(*uploadID)).
+			ClearUploadID().
+			ClearUploadPartSize().
+			ClearUploadStartedAt()
+	}
+	content, err = update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			err = ErrNotFound
 		}
 		return nil, err
 	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.checkQuotaAlert(ctx, current.OrganizationID)
 	return content, nil
 }
 
@@ -177,48 +327,99 @@ func (s *Service) List(ctx context.Context, orgID uuid.UUID) ([]*ent.Content, er
 		All(ctx)
 }
 
-func (s *Service) Archive(ctx context.Context, orgID, contentID uuid.UUID) error {
-	_, err := s.client.Content.UpdateOneID(contentID).
-		Where(entcontent.OrganizationIDEQ(orgID)).
+// Archive retire le contenu et libère l'espace occupé par toutes ses révisions dans le quota.
+func (s *Service) Archive(ctx context.Context, orgID, contentID uuid.UUID) (err error) {
+	current, err := s.Get(ctx, orgID, contentID)
+	if err != nil {
+		return err
+	}
+	if current.Status == StatusArchived {
+		return nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	bytes, objects, err := footprint(ctx, tx, current)
+	if err != nil {
+		return err
+	}
+	n, err := tx.Content.Update().
+		Where(
+			entcontent.IDEQ(contentID),
+			entcontent.OrganizationIDEQ(orgID),
+			entcontent.StatusNEQ(StatusArchived),
+		).
 		SetStatus(StatusArchived).
 		SetUpdatedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ErrNotFound
+		return err
+	}
+	// Déjà archivé par une requête concurrente : l'espace a été libéré par celle-ci.
+	if n > 0 {
+		if err = release(ctx, tx, orgID, bytes, objects); err != nil {
+			return err
 		}
+	}
+	if err = tx.Commit(); err != nil {
 		return err
 	}
+	s.checkQuotaAlert(ctx, orgID)
 	return nil
 }
 
-func (s *Service) PresignDownload(ctx context.Context, orgID, contentID uuid.UUID) (string, time.Time, error) {
-	content, err := s.Get(ctx, orgID, contentID)
+type verifiedObject struct {
+	size     int64
+	etag     string
+	checksum string
+	quota    Quota
+}
+
+// verifyObject relève la taille et l'ETag de l'objet, calcule son empreinte SHA-256 et contrôle
+// le type détecté sur ses premiers octets contre le type déclaré et la liste blanche de l'organisation.
+func (s *Service) verifyObject(ctx context.Context, orgID uuid.UUID, object, mimeType string) (verifiedObject, error) {
+	org, err := s.client.Organization.Get(ctx, orgID)
 	if err != nil {
-		return "", time.Time{}, err
+		return verifiedObject{}, err
 	}
-	if content.Status != StatusAvailable {
-		return "", time.Time{}, fmt.Errorf("content: not available")
+	if !mimeAllowed(allowedMimeTypes(org.Settings), mimeType) {
+		return verifiedObject{}, ErrMimeNotAllowed
 	}
-	expires := time.Now().Add(s.downloadExpiry)
-	url, err := s.storage.PresignDownload(ctx, content.StorageKey, s.downloadExpiry)
+
+	info, err := s.storage.Stat(ctx, object)
 	if err != nil {
-		return "", time.Time{}, err
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return verifiedObject{}, ErrObjectMissing
+		}
+		return verifiedObject{}, fmt.Errorf("content: stat object: %w", err)
+	}
+	if info.Size == 0 {
+		return verifiedObject{}, ErrObjectMissing
 	}
-	return url, expires, nil
-}
 
-func (s *Service) ensureOrg(ctx context.Context, orgID uuid.UUID) error {
-	exists, err := s.client.Organization.Query().
-		Where(entorg.IDEQ(orgID)).
-		Exist(ctx)
+	reader, err := s.storage.Open(ctx, object)
 	if err != nil {
-		return err
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return verifiedObject{}, ErrObjectMissing
+		}
+		return verifiedObject{}, fmt.Errorf("content: open object: %w", err)
 	}
-	if !exists {
-		return ErrInvalidInput
+	defer reader.Close()
+	head, checksum, err := digest(reader)
+	if err != nil {
+		return verifiedObject{}, fmt.Errorf("content: read object: %w", err)
 	}
-	return nil
+	if sniffed := http.DetectContentType(head); !mimeCompatible(mimeType, sniffed) {
+		return verifiedObject{}, fmt.Errorf("%w: declared %s, detected %s", ErrMimeMismatch, baseMediaType(mimeType), baseMediaType(sniffed))
+	}
+	return verifiedObject{size: info.Size, etag: info.ETag, checksum: checksum, quota: QuotaFromSettings(org.Settings)}, nil
 }
 
 func buildStorageKey(orgID uuid.UUID, name string) string {
@@ -2,7 +2,9 @@ package content
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -13,20 +15,22 @@ import (
 	entsql "entgo.io/ent/dialect/sql"
 
 	"lms-go/internal/ent"
+	"lms-go/internal/platform/storage"
 
 	_ "github.com/glebarez/go-sqlite"
 )
 
 type mockStorage struct {
+	*storage.Memory
 	uploads   map[string]string
 	downloads map[string]string
 }
 
 func newMockStorage() *mockStorage {
-	return &mockStorage{uploads: map[string]string{}, downloads: map[string]string{}}
+	return &mockStorage{Memory: storage.NewMemory(), uploads: map[string]string{}, downloads: map[string]string{}}
 }
 
-func (m *mockStorage) PresignUpload(ctx context.Context, object string, contentType string, expires time.Duration) (string, error) {
+func (m *mockStorage) PresignUpload(ctx context.Context, object string, contentType string, size int64, expires time.Duration) (string, error) {
 	url := "https://example.com/upload/" + object
 	m.uploads[object] = url
 	return url, nil
@@ -41,7 +45,7 @@ func (m *mockStorage) PresignDownload(ctx context.Context, object string, expire
 func (m *mockStorage) Remove(ctx context.Context, object string) error {
 	delete(m.uploads, object)
 	delete(m.downloads, object)
-	return nil
+	return m.Memory.Remove(ctx, object)
 }
 
 func newContentService(t *testing.T) (*Service, uuid.UUID, func()) {
@@ -101,14 +105,89 @@ func TestService_FinalizeAndDownload(t *testing.T) {
 	require.NoError(t, err)
 
 	size := int64(2048)
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{SizeBytes: &size})
+	require.ErrorIs(t, err, ErrObjectMissing)
+
+	data := mp4Data(int(size))
+	svc.storage.(*mockStorage).Put(res.Content.StorageKey, data, "video/mp4")
 	finalized, err := svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{SizeBytes: &size})
 	require.NoError(t, err)
 	require.Equal(t, StatusAvailable, finalized.Status)
 	require.Equal(t, size, finalized.SizeBytes)
+	sum := sha256.Sum256(data)
+	require.Equal(t, hex.EncodeToString(sum[:]), finalized.ChecksumSha256)
+	require.NotEmpty(t, finalized.Etag)
 
-	url, _, err := svc.PresignDownload(ctx, orgID, finalized.ID)
+	link, err := svc.Download(ctx, orgID, finalized.ID, DownloadInput{})
 	require.NoError(t, err)
-	require.Contains(t, url, finalized.StorageKey)
+	require.Contains(t, link.URL, finalized.StorageKey)
+	require.Equal(t, 1, link.Revision)
+}
+
+func mp4Data(size int) []byte {
+	data := make([]byte, size)
+	copy(data, "\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+	return data
+}
+
+func TestService_FinalizeVerification(t *testing.T) {
+	svc, orgID, cleanup := newContentService(t)
+	t.Cleanup(cleanup)
+	ctx := context.Background()
+	store := svc.storage.(*mockStorage)
+
+	_, err := svc.client.Organization.UpdateOneID(orgID).
+		SetSettings(map[string]any{
+			"content": map[string]any{"allowed_mime_types": []any{"application/pdf", "video/*"}},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "run.exe", MimeType: "application/x-msdownload"})
+	require.ErrorIs(t, err, ErrMimeNotAllowed)
+
+	res, err := svc.CreateUpload(ctx, CreateUploadInput{OrganizationID: orgID, Name: "guide.pdf", MimeType: "application/pdf"})
+	require.NoError(t, err)
+
+	// Un exécutable déposé sous un type PDF déclaré est refusé et le contenu reste en attente.
+	store.Put(res.Content.StorageKey, []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff"), "application/pdf")
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{})
+	require.ErrorIs(t, err, ErrMimeMismatch)
+	pending, err := svc.Get(ctx, orgID, res.Content.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, pending.Status)
+
+	// Redéclarer un type hors liste blanche est refusé.
+	exe := "application/x-msdownload"
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{MimeType: &exe})
+	require.ErrorIs(t, err, ErrMimeNotAllowed)
+
+	pdf := []byte("%PDF-1.7\n%âãÏÓ\n1 0 obj\n<<>>\nendobj\n")
+	store.Put(res.Content.StorageKey, pdf, "application/pdf")
+	wrong := "00"
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{ChecksumSHA256: &wrong})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	declared := int64(len(pdf) + 1)
+	_, err = svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{SizeBytes: &declared})
+	require.ErrorIs(t, err, ErrSizeMismatch)
+
+	sum := sha256.Sum256(pdf)
+	checksum := hex.EncodeToString(sum[:])
+	finalized, err := svc.Finalize(ctx, orgID, res.Content.ID, FinalizeInput{ChecksumSHA256: &checksum})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(pdf)), finalized.SizeBytes)
+	require.Equal(t, checksum, finalized.ChecksumSha256)
+}
+
+func TestMimeCompatible(t *testing.T) {
+	require.True(t, mimeCompatible("text/csv", "text/plain; charset=utf-8"))
+	require.True(t, mimeCompatible("application/json", "text/plain; charset=utf-8"))
+	require.True(t, mimeCompatible("application/vnd.openxmlformats-officedocument.wordprocessingml.document", "application/zip"))
+	require.True(t, mimeCompatible("video/quicktime", "video/mp4"))
+	require.True(t, mimeCompatible("application/x-custom", "application/octet-stream"))
+	require.False(t, mimeCompatible("application/pdf", "application/octet-stream"))
+	require.False(t, mimeCompatible("image/png", "image/jpeg"))
+	require.False(t, mimeCompatible("application/pdf", "text/html; charset=utf-8"))
 }
 
 func TestService_ListArchive(t *testing.T) {
@@ -0,0 +1,158 @@
+package content
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/storage"
+)
+
+// StreamConfig active le mode proxy : les liens de téléchargement pointent vers l'API, qui sert
+// l'objet elle-même (requêtes Range comprises) au lieu d'exposer une URL du bucket.
+type StreamConfig struct {
+	// BaseURL est l'URL publique de la route de streaming (ex. https://lms.example.com/downloads).
+	BaseURL string
+	// Secret signe les jetons de téléchargement (HMAC-SHA256).
+	Secret []byte
+	// Expiry borne la validité d'un jeton (5 minutes par défaut).
+	Expiry time.Duration
+}
+
+func (c StreamConfig) enabled() bool {
+	return c.BaseURL != "" && len(c.Secret) > 0
+}
+
+// Stream est un objet servi par l'API en mode proxy.
+type Stream struct {
+	// Body implémente io.ReadSeeker lorsque le backend le permet (requêtes Range).
+	Body     io.ReadCloser
+	Name     string
+	MimeType string
+	Size     int64
+	ETag     string
+	ModTime  time.Time
+	Revision int
+}
+
+type streamClaims struct {
+	OrganizationID uuid.UUID  `json:"org"`
+	ContentID      uuid.UUID  `json:"cnt"`
+	Revision       int        `json:"rev"`
+	UserID         *uuid.UUID `json:"sub,omitempty"`
+	ModuleID       *uuid.UUID `json:"mod,omitempty"`
+	ExpiresAt      int64      `json:"exp"`
+}
+
+// OpenStream vérifie le jeton et ouvre l'objet de la révision qu'il désigne. Avec record,
+// le téléchargement est tracé ; le handler ne trace pas les requêtes Range de reprise.
+func (s *Service) OpenStream(ctx context.Context, token string, record bool) (*Stream, error) {
+	claims, err := s.parseStreamToken(token)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.Get(ctx, claims.OrganizationID, claims.ContentID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+	revision, err := s.revisionOf(ctx, current, claims.Revision)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Status != StatusAvailable {
+		return nil, ErrNotAvailable
+	}
+
+	info, err := s.storage.Stat(ctx, revision.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrObjectMissing
+		}
+		return nil, fmt.Errorf("content: stat object: %w", err)
+	}
+	body, err := s.storage.Open(ctx, revision.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrObjectMissing
+		}
+		return nil, fmt.Errorf("content: open object: %w", err)
+	}
+	if record {
+		if err := s.recordDownload(ctx, current, revision.Number, claims.UserID, claims.ModuleID); err != nil {
+			_ = body.Close()
+			return nil, err
+		}
+	}
+	return &Stream{
+		Body:     body,
+		Name:     current.Name,
+		MimeType: revision.MimeType,
+		Size:     info.Size,
+		ETag:     revision.Etag,
+		ModTime:  info.LastModified,
+		Revision: revision.Number,
+	}, nil
+}
+
+func (s *Service) streamLink(current *ent.Content, revision int, input DownloadInput) (*DownloadLink, error) {
+	expiresAt := time.Now().Add(s.stream.Expiry)
+	payload, err := json.Marshal(streamClaims{
+		OrganizationID: current.OrganizationID,
+		ContentID:      current.ID,
+		Revision:       revision,
+		UserID:         input.UserID,
+		ModuleID:       input.ModuleID,
+		ExpiresAt:      expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	token := encoded + "." + s.streamSignature(encoded)
+	return &DownloadLink{
+		URL:       strings.TrimSuffix(s.stream.BaseURL, "/") + "/" + token,
+		ExpiresAt: expiresAt,
+		Revision:  revision,
+	}, nil
+}
+
+func (s *Service) parseStreamToken(token string) (streamClaims, error) {
+	if !s.stream.enabled() {
+		return streamClaims{}, ErrInvalidToken
+	}
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.streamSignature(encoded))) {
+		return streamClaims{}, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return streamClaims{}, ErrInvalidToken
+	}
+	var claims streamClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return streamClaims{}, ErrInvalidToken
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return streamClaims{}, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (s *Service) streamSignature(encoded string) string {
+	mac := hmac.New(sha256.New, s.stream.Secret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
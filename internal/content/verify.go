@@ -0,0 +1,148 @@
+package content
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+)
+
+// sniffLen est le nombre d'octets examinés par http.DetectContentType.
+const sniffLen = 512
+
+// digest lit l'objet en entier : il renvoie ses premiers octets et son empreinte SHA-256.
+func digest(r io.Reader) ([]byte, string, error) {
+	hash := sha256.New()
+	head, err := readHead(r)
+	if err != nil {
+		return nil, "", err
+	}
+	hash.Write(head)
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, "", err
+	}
+	return head, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// readHead lit les premiers octets de l'objet, examinés par http.DetectContentType.
+func readHead(r io.Reader) ([]byte, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return head[:n], nil
+}
+
+// validChecksum indique si value est une empreinte SHA-256 hexadécimale.
+func validChecksum(value string) bool {
+	decoded, err := hex.DecodeString(strings.TrimSpace(value))
+	return err == nil && len(decoded) == sha256.Size
+}
+
+// baseMediaType normalise un type MIME en retirant ses paramètres.
+func baseMediaType(value string) string {
+	media, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(value))
+	}
+	return media
+}
+
+// mimeCompatible compare le type déclaré au type détecté sur les premiers octets.
+// La détection étant partielle, certains types détectés couvrent une famille de types déclarés.
+func mimeCompatible(declared, sniffed string) bool {
+	declared = baseMediaType(declared)
+	sniffed = baseMediaType(sniffed)
+	declaredMajor, _, _ := strings.Cut(declared, "/")
+	if declared == sniffed {
+		return true
+	}
+	if sniffed == "application/octet-stream" {
+		// Signature inconnue : acceptable sauf pour les types dont la signature est détectable.
+		return !recognizable(declared, declaredMajor)
+	}
+	sniffedMajor, _, _ := strings.Cut(sniffed, "/")
+	switch sniffed {
+	case "text/plain":
+		return declaredMajor == "text" || isTextual(declared)
+	case "text/xml", "application/xml":
+		return isXML(declared)
+	case "application/zip":
+		return isZipContainer(declared)
+	case "application/ogg":
+		return declaredMajor == "audio" || declaredMajor == "video"
+	}
+	// Les conteneurs audio et vidéo partagent souvent leurs signatures (mp4, mov, m4a…).
+	if sniffedMajor == declaredMajor && (sniffedMajor == "audio" || sniffedMajor == "video") {
+		return true
+	}
+	return false
+}
+
+// recognizable indique si http.DetectContentType reconnaît de façon fiable le type déclaré.
+func recognizable(media, major string) bool {
+	switch media {
+	case "application/pdf", "image/png", "image/jpeg", "image/gif", "image/webp", "image/bmp":
+		return true
+	}
+	return major == "text" || isTextual(media) || isZipContainer(media)
+}
+
+func isTextual(media string) bool {
+	switch media {
+	case "application/json", "application/javascript", "application/x-ndjson", "application/x-subrip":
+		return true
+	}
+	return isXML(media)
+}
+
+func isXML(media string) bool {
+	return media == "application/xml" || media == "text/xml" || strings.HasSuffix(media, "+xml")
+}
+
+func isZipContainer(media string) bool {
+	switch media {
+	case "application/zip", "application/x-zip-compressed", "application/epub+zip", "application/java-archive":
+		return true
+	}
+	return strings.HasPrefix(media, "application/vnd.openxmlformats-officedocument.") ||
+		strings.HasPrefix(media, "application/vnd.oasis.opendocument.")
+}
+
+// allowedMimeTypes lit la liste blanche settings["content"]["allowed_mime_types"] de l'organisation.
+// Une liste absente ou vide autorise tous les types.
+func allowedMimeTypes(settings map[string]any) []string {
+	section, ok := settings["content"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	raw, ok := section["allowed_mime_types"].([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+			out = append(out, strings.ToLower(strings.TrimSpace(s)))
+		}
+	}
+	return out
+}
+
+// mimeAllowed vérifie un type contre la liste blanche ; les motifs "video/*" couvrent un type majeur.
+func mimeAllowed(allowlist []string, media string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+	media = baseMediaType(media)
+	major, _, _ := strings.Cut(media, "/")
+	for _, allowed := range allowlist {
+		if allowed == media || allowed == "*/*" || allowed == major+"/*" {
+			return true
+		}
+	}
+	return false
+}
@@ -6,4 +6,6 @@ var (
 	ErrInvalidInput = errors.New("course: invalid input")
 	ErrNotFound     = errors.New("course: not found")
 	ErrSlugTaken    = errors.New("course: slug déjà utilisé")
+	// ErrVersionMismatch signale que le cours ou le module a changé depuis la version attendue.
+	ErrVersionMismatch = errors.New("course: version modifiée")
 )
@@ -0,0 +1,226 @@
+package course
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entcourse "lms-go/internal/ent/course"
+	entmodule "lms-go/internal/ent/module"
+)
+
+// ExportFormat identifie la version du document produit par Export.
+const ExportFormat = "lms-go.course/v1"
+
+// Export est la représentation portable d'un cours et de ses modules, indépendante des
+// identifiants de l'organisation d'origine (hors références de contenus).
+type Export struct {
+	Format      string           `json:"format"`
+	ExportedAt  time.Time        `json:"exported_at"`
+	Title       string           `json:"title"`
+	Slug        string           `json:"slug"`
+	Description string           `json:"description,omitempty"`
+	Metadata    map[string]any   `json:"metadata,omitempty"`
+	Modules     []ExportedModule `json:"modules"`
+}
+
+type ExportedModule struct {
+	Title           string         `json:"title"`
+	ModuleType      string         `json:"module_type"`
+	ContentID       *uuid.UUID     `json:"content_id,omitempty"`
+	ContentRevision *int           `json:"content_revision,omitempty"`
+	DurationSeconds int            `json:"duration_seconds,omitempty"`
+	Status          string         `json:"status,omitempty"`
+	Data            map[string]any `json:"data,omitempty"`
+}
+
+// ImportInput décrit un import ; Slug remplace celui du document (utile pour dupliquer un cours).
+type ImportInput struct {
+	OrganizationID uuid.UUID
+	Export         *Export
+	Slug           string
+}
+
+// ImportResult renvoie le cours créé et le nombre de modules dont le contenu référencé
+// n'existe pas dans l'organisation : ces modules sont importés sans contenu.
+type ImportResult struct {
+	Course           *ent.Course
+	DetachedContents int
+}
+
+// GetBySlug récupère un cours de l'organisation par son slug.
+func (s *Service) GetBySlug(ctx context.Context, orgID uuid.UUID, slug string) (*ent.Course, error) {
+	course, err := s.client.Course.Query().
+		Where(entcourse.OrganizationIDEQ(orgID), entcourse.SlugEQ(sanitizeSlug(slug))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return course, nil
+}
+
+// Export sérialise le cours et ses modules dans l'ordre.
+func (s *Service) Export(ctx context.Context, orgID, courseID uuid.UUID) (*Export, error) {
+	course, err := s.Get(ctx, orgID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	out := &Export{
+		Format:      ExportFormat,
+		ExportedAt:  time.Now().UTC(),
+		Title:       course.Title,
+		Slug:        course.Slug,
+		Description: course.Description,
+		Metadata:    course.Metadata,
+		Modules:     make([]ExportedModule, 0, len(course.Edges.Modules)),
+	}
+	for _, m := range course.Edges.Modules {
+		out.Modules = append(out.Modules, ExportedModule{
+			Title:           m.Title,
+			ModuleType:      m.ModuleType,
+			ContentID:       m.ContentID,
+			ContentRevision: m.ContentRevision,
+			DurationSeconds: m.DurationSeconds,
+			Status:          m.Status,
+			Data:            m.Data,
+		})
+	}
+	return out, nil
+}
+
+// Import recrée un cours exporté, en brouillon, dans une seule transaction.
+func (s *Service) Import(ctx context.Context, input ImportInput) (*ImportResult, error) {
+	doc := input.Export
+	if input.OrganizationID == uuid.Nil || doc == nil || doc.Format != ExportFormat {
+		return nil, ErrInvalidInput
+	}
+	title := strings.TrimSpace(doc.Title)
+	slug := sanitizeSlug(input.Slug)
+	if slug == "" {
+		slug = sanitizeSlug(doc.Slug)
+	}
+	if title == "" || slug == "" {
+		return nil, ErrInvalidInput
+	}
+	for _, m := range doc.Modules {
+		if strings.TrimSpace(m.Title) == "" || !allowedModuleTypes[strings.ToLower(strings.TrimSpace(m.ModuleType))] {
+			return nil, ErrInvalidInput
+		}
+	}
+	if err := s.ensureOrg(ctx, input.OrganizationID); err != nil {
+		return nil, err
+	}
+	available, err := s.availableContents(ctx, input.OrganizationID, doc.Modules)
+	if err != nil {
+		return nil, err
+	}
+	// Une révision épinglée absente de l'organisation retombe sur la révision courante.
+	pinned := make(map[int]bool)
+	for pos, m := range doc.Modules {
+		if m.ContentID != nil && available[*m.ContentID] && m.ContentRevision != nil && *m.ContentRevision > 0 {
+			pinned[pos] = s.ensureRevision(ctx, input.OrganizationID, m.ContentID, *m.ContentRevision) == nil
+		}
+	}
+
+	metadata := doc.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	course, err := tx.Course.Create().
+		SetOrganizationID(input.OrganizationID).
+		SetTitle(title).
+		SetSlug(slug).
+		SetDescription(strings.TrimSpace(doc.Description)).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			err = ErrSlugTaken
+		}
+		return nil, err
+	}
+	result := &ImportResult{}
+	for pos, m := range doc.Modules {
+		builder := tx.Module.Create().
+			SetCourseID(course.ID).
+			SetTitle(strings.TrimSpace(m.Title)).
+			SetModuleType(strings.ToLower(strings.TrimSpace(m.ModuleType))).
+			SetPosition(pos)
+		if m.ContentID != nil {
+			if available[*m.ContentID] {
+				builder.SetContentID(*m.ContentID)
+				if pinned[pos] {
+					builder.SetContentRevision(*m.ContentRevision)
+				}
+			} else {
+				result.DetachedContents++
+			}
+		}
+		if m.DurationSeconds > 0 {
+			builder.SetDurationSeconds(m.DurationSeconds)
+		}
+		if m.Status != "" {
+			builder.SetStatus(m.Status)
+		}
+		if m.Data != nil {
+			builder.SetData(m.Data)
+		}
+		if _, err = builder.Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	result.Course, err = s.client.Course.Query().
+		Where(entcourse.IDEQ(course.ID)).
+		WithModules(func(q *ent.ModuleQuery) {
+			q.Order(entmodule.ByPosition())
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// availableContents renvoie les contenus référencés par les modules qui existent dans l'organisation.
+func (s *Service) availableContents(ctx context.Context, orgID uuid.UUID, modules []ExportedModule) (map[uuid.UUID]bool, error) {
+	var ids []uuid.UUID
+	for _, m := range modules {
+		if m.ContentID != nil {
+			ids = append(ids, *m.ContentID)
+		}
+	}
+	available := make(map[uuid.UUID]bool, len(ids))
+	if len(ids) == 0 {
+		return available, nil
+	}
+	found, err := s.client.Content.Query().
+		Where(entcontent.OrganizationIDEQ(orgID), entcontent.IDIn(ids...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range found {
+		available[id] = true
+	}
+	return available, nil
+}
@@ -2,6 +2,7 @@ package course
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/google/uuid"
 
 	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entrevision "lms-go/internal/ent/contentrevision"
 	entcourse "lms-go/internal/ent/course"
 	entenrollment "lms-go/internal/ent/enrollment"
 	entgroup "lms-go/internal/ent/group"
@@ -51,6 +54,8 @@ type UpdateCourseInput struct {
 	Title       *string
 	Description *string
 	Metadata    map[string]any
+	// IfMatch liste les versions attendues du cours ; vide, aucune n'est exigée.
+	IfMatch []int
 }
 
 type CourseFilter struct {
@@ -128,7 +133,11 @@ func (s *Service) List(ctx context.Context, orgID uuid.UUID, filter CourseFilter
 func (s *Service) Update(ctx context.Context, orgID, courseID uuid.UUID, input UpdateCourseInput) (*ent.Course, error) {
 	update := s.client.Course.UpdateOneID(courseID).
 		Where(entcourse.OrganizationIDEQ(orgID)).
+		AddVersion(1).
 		SetUpdatedAt(time.Now())
+	if len(input.IfMatch) > 0 {
+		update.Where(entcourse.VersionIn(input.IfMatch...))
+	}
 
 	if input.Title != nil {
 		title := strings.TrimSpace(*input.Title)
@@ -147,26 +156,28 @@ func (s *Service) Update(ctx context.Context, orgID, courseID uuid.UUID, input U
 	course, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			return nil, s.updateFailed(ctx, orgID, courseID, input.IfMatch)
 		}
 		return nil, err
 	}
 	return course, nil
 }
 
-func (s *Service) Publish(ctx context.Context, orgID, courseID uuid.UUID) (*ent.Course, error) {
-	return s.setStatus(ctx, orgID, courseID, StatusPublished)
+// Publish publie le cours. Comme Unpublish, Archive et Delete, il n'agit que si la version du
+// cours fait partie de ifMatch, lorsqu'il est fourni.
+func (s *Service) Publish(ctx context.Context, orgID, courseID uuid.UUID, ifMatch ...int) (*ent.Course, error) {
+	return s.setStatus(ctx, orgID, courseID, StatusPublished, ifMatch)
 }
 
-func (s *Service) Unpublish(ctx context.Context, orgID, courseID uuid.UUID) (*ent.Course, error) {
-	return s.setStatus(ctx, orgID, courseID, StatusDraft)
+func (s *Service) Unpublish(ctx context.Context, orgID, courseID uuid.UUID, ifMatch ...int) (*ent.Course, error) {
+	return s.setStatus(ctx, orgID, courseID, StatusDraft, ifMatch)
 }
 
-func (s *Service) Archive(ctx context.Context, orgID, courseID uuid.UUID) (*ent.Course, error) {
-	return s.setStatus(ctx, orgID, courseID, StatusArchived)
+func (s *Service) Archive(ctx context.Context, orgID, courseID uuid.UUID, ifMatch ...int) (*ent.Course, error) {
+	return s.setStatus(ctx, orgID, courseID, StatusArchived, ifMatch)
 }
 
-func (s *Service) Delete(ctx context.Context, orgID, courseID uuid.UUID) (err error) {
+func (s *Service) Delete(ctx context.Context, orgID, courseID uuid.UUID, ifMatch ...int) (err error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -186,6 +197,10 @@ func (s *Service) Delete(ctx context.Context, orgID, courseID uuid.UUID) (err er
 		}
 		return err
 	}
+	if !matchVersion(ifMatch, courseEntity.Version) {
+		err = ErrVersionMismatch
+		return err
+	}
 
 	modules, err := tx.Module.Query().
 		Where(entmodule.CourseIDEQ(courseID)).
@@ -232,11 +247,20 @@ func (s *Service) Delete(ctx context.Context, orgID, courseID uuid.UUID) (err er
 	if _, err = tx.Group.Update().
 		Where(entgroup.CourseIDEQ(courseID)).
 		ClearCourseID().
+		AddVersion(1).
 		Save(ctx); err != nil {
 		return err
 	}
 
-	if err = tx.Course.DeleteOne(courseEntity).Exec(ctx); err != nil {
+	// La suppression ne vaut que pour la version lue : une modification concurrente l'annule.
+	deleted, err := tx.Course.Delete().
+		Where(entcourse.IDEQ(courseEntity.ID), entcourse.VersionEQ(courseEntity.Version)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		err = ErrVersionMismatch
 		return err
 	}
 
@@ -244,33 +268,68 @@ func (s *Service) Delete(ctx context.Context, orgID, courseID uuid.UUID) (err er
 	return err
 }
 
-func (s *Service) setStatus(ctx context.Context, orgID, courseID uuid.UUID, status string) (*ent.Course, error) {
+func (s *Service) setStatus(ctx context.Context, orgID, courseID uuid.UUID, status string, ifMatch []int) (*ent.Course, error) {
 	update := s.client.Course.UpdateOneID(courseID).
 		Where(entcourse.OrganizationIDEQ(orgID)).
 		SetStatus(status).
+		AddVersion(1).
 		SetUpdatedAt(time.Now())
+	if len(ifMatch) > 0 {
+		update.Where(entcourse.VersionIn(ifMatch...))
+	}
 	if status == StatusPublished {
 		update.SetPublishedAt(time.Now())
 	}
 	course, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			return nil, s.updateFailed(ctx, orgID, courseID, ifMatch)
 		}
 		return nil, err
 	}
 	return course, nil
 }
 
+// updateFailed qualifie l'échec d'une mise à jour conditionnelle du cours : ErrVersionMismatch
+// s'il existe toujours mais a changé de version, ErrNotFound sinon.
+func (s *Service) updateFailed(ctx context.Context, orgID, courseID uuid.UUID, ifMatch []int) error {
+	if len(ifMatch) == 0 {
+		return ErrNotFound
+	}
+	if err := s.ensureCourse(ctx, orgID, courseID); err != nil {
+		return err
+	}
+	return ErrVersionMismatch
+}
+
+// matchVersion indique si version fait partie de ifMatch ; toujours vrai sans exigence.
+func matchVersion(ifMatch []int, version int) bool {
+	return len(ifMatch) == 0 || slices.Contains(ifMatch, version)
+}
+
+// touchCourse incrémente la version du cours : ses modules font partie de sa représentation.
+func touchCourse(ctx context.Context, client *ent.Client, courseID uuid.UUID) error {
+	return client.Course.UpdateOneID(courseID).
+		AddVersion(1).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
 type ModuleInput struct {
-	Title        string
-	ModuleType   string
-	ContentID    *uuid.UUID
-	DurationSecs int
-	Data         map[string]any
+	Title      string
+	ModuleType string
+	ContentID  *uuid.UUID
+	// ContentRevision épingle une révision du contenu : nil laisse l'épinglage inchangé,
+	// 0 suit la révision courante.
+	ContentRevision *int
+	DurationSecs    int
+	Data            map[string]any
+	// IfMatch liste les versions attendues du module, à la mise à jour ; vide, aucune n'est
+	// exigée.
+	IfMatch []int
 }
 
-func (s *Service) AddModule(ctx context.Context, orgID, courseID uuid.UUID, input ModuleInput) (*ent.Module, error) {
+func (s *Service) AddModule(ctx context.Context, orgID, courseID uuid.UUID, input ModuleInput) (_ *ent.Module, err error) {
 	if err := s.ensureCourse(ctx, orgID, courseID); err != nil {
 		return nil, err
 	}
@@ -283,13 +342,28 @@ func (s *Service) AddModule(ctx context.Context, orgID, courseID uuid.UUID, inpu
 	if !allowedModuleTypes[moduleType] {
 		return nil, ErrInvalidInput
 	}
+	if input.ContentRevision != nil && *input.ContentRevision != 0 {
+		if err := s.ensureRevision(ctx, orgID, input.ContentID, *input.ContentRevision); err != nil {
+			return nil, err
+		}
+	}
 
 	position, err := s.nextModulePosition(ctx, courseID)
 	if err != nil {
 		return nil, err
 	}
 
-	builder := s.client.Module.Create().
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	builder := tx.Module.Create().
 		SetCourseID(courseID).
 		SetTitle(title).
 		SetModuleType(moduleType).
@@ -298,6 +372,9 @@ func (s *Service) AddModule(ctx context.Context, orgID, courseID uuid.UUID, inpu
 	if input.ContentID != nil {
 		builder.SetContentID(*input.ContentID)
 	}
+	if input.ContentRevision != nil && *input.ContentRevision != 0 {
+		builder.SetContentRevision(*input.ContentRevision)
+	}
 	if input.DurationSecs > 0 {
 		builder.SetDurationSeconds(input.DurationSecs)
 	}
@@ -309,10 +386,16 @@ func (s *Service) AddModule(ctx context.Context, orgID, courseID uuid.UUID, inpu
 	if err != nil {
 		return nil, err
 	}
+	if err = touchCourse(ctx, tx.Client(), courseID); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 	return module, nil
 }
 
-func (s *Service) UpdateModule(ctx context.Context, orgID, moduleID uuid.UUID, input ModuleInput) (*ent.Module, error) {
+func (s *Service) UpdateModule(ctx context.Context, orgID, moduleID uuid.UUID, input ModuleInput) (_ *ent.Module, err error) {
 	module, err := s.client.Module.Query().
 		Where(entmodule.IDEQ(moduleID)).
 		WithCourse(func(q *ent.CourseQuery) {
@@ -326,7 +409,21 @@ func (s *Service) UpdateModule(ctx context.Context, orgID, moduleID uuid.UUID, i
 		return nil, err
 	}
 
-	update := s.client.Module.UpdateOne(module)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	update := tx.Module.UpdateOneID(module.ID).
+		AddVersion(1)
+	if len(input.IfMatch) > 0 {
+		update.Where(entmodule.VersionIn(input.IfMatch...))
+	}
 
 	if input.Title != "" {
 		update.SetTitle(strings.TrimSpace(input.Title))
@@ -338,8 +435,21 @@ func (s *Service) UpdateModule(ctx context.Context, orgID, moduleID uuid.UUID, i
 		}
 		update.SetModuleType(moduleType)
 	}
+	contentID := module.ContentID
 	if input.ContentID != nil {
 		update.SetContentID(*input.ContentID)
+		contentID = input.ContentID
+	}
+	switch {
+	case input.ContentRevision != nil && *input.ContentRevision != 0:
+		if err := s.ensureRevision(ctx, orgID, contentID, *input.ContentRevision); err != nil {
+			return nil, err
+		}
+		update.SetContentRevision(*input.ContentRevision)
+	case input.ContentRevision != nil,
+		// L'épinglage d'un ancien contenu ne vaut pas pour le nouveau.
+		input.ContentID != nil && (module.ContentID == nil || *module.ContentID != *input.ContentID):
+		update.ClearContentRevision()
 	}
 	if input.Data != nil {
 		update.SetData(input.Data)
@@ -351,11 +461,53 @@ func (s *Service) UpdateModule(ctx context.Context, orgID, moduleID uuid.UUID, i
 
 	mod, err := update.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) && len(input.IfMatch) > 0 {
+			return nil, ErrVersionMismatch
+		}
+		return nil, err
+	}
+	if err = touchCourse(ctx, tx.Client(), module.CourseID); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
 	return mod, nil
 }
 
+// ensureRevision vérifie que la révision number existe pour le contenu de l'organisation.
+// La révision courante d'un contenu antérieur aux révisions est acceptée.
+func (s *Service) ensureRevision(ctx context.Context, orgID uuid.UUID, contentID *uuid.UUID, number int) error {
+	if contentID == nil || number < 0 {
+		return ErrInvalidInput
+	}
+	exists, err := s.client.ContentRevision.Query().
+		Where(
+			entrevision.OrganizationIDEQ(orgID),
+			entrevision.ContentIDEQ(*contentID),
+			entrevision.NumberEQ(number),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	exists, err = s.client.Content.Query().
+		Where(
+			entcontent.IDEQ(*contentID),
+			entcontent.OrganizationIDEQ(orgID),
+			entcontent.CurrentRevisionEQ(number),
+			entcontent.Not(entcontent.HasRevisions()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
 func (s *Service) ListModules(ctx context.Context, orgID, courseID uuid.UUID) ([]*ent.Module, error) {
 	if err := s.ensureCourse(ctx, orgID, courseID); err != nil {
 		return nil, err
@@ -366,7 +518,8 @@ func (s *Service) ListModules(ctx context.Context, orgID, courseID uuid.UUID) ([
 		All(ctx)
 }
 
-func (s *Service) ReorderModules(ctx context.Context, orgID, courseID uuid.UUID, moduleIDs []uuid.UUID) error {
+// ReorderModules n'agit que si la version du cours fait partie de ifMatch, lorsqu'il est fourni.
+func (s *Service) ReorderModules(ctx context.Context, orgID, courseID uuid.UUID, moduleIDs []uuid.UUID, ifMatch ...int) (err error) {
 	modules, err := s.ListModules(ctx, orgID, courseID)
 	if err != nil {
 		return err
@@ -379,22 +532,51 @@ func (s *Service) ReorderModules(ctx context.Context, orgID, courseID uuid.UUID,
 	for pos, id := range moduleIDs {
 		index[id] = pos
 	}
-
 	for _, m := range modules {
-		pos, ok := index[m.ID]
-		if !ok {
+		if _, ok := index[m.ID]; !ok {
 			return ErrInvalidInput
 		}
-		if m.Position != pos {
-			if _, err := s.client.Module.UpdateOneID(m.ID).SetPosition(pos).Save(ctx); err != nil {
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// L'ordre des modules fait partie du cours : ifMatch porte sur la version du cours.
+	update := tx.Course.UpdateOneID(courseID).
+		Where(entcourse.OrganizationIDEQ(orgID)).
+		AddVersion(1).
+		SetUpdatedAt(time.Now())
+	if len(ifMatch) > 0 {
+		update.Where(entcourse.VersionIn(ifMatch...))
+	}
+	if err = update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			_ = tx.Rollback()
+			err = s.updateFailed(ctx, orgID, courseID, ifMatch)
+		}
+		return err
+	}
+
+	for _, m := range modules {
+		if pos := index[m.ID]; m.Position != pos {
+			if err = tx.Module.UpdateOneID(m.ID).SetPosition(pos).AddVersion(1).Exec(ctx); err != nil {
 				return err
 			}
 		}
 	}
-	return nil
+	err = tx.Commit()
+	return err
 }
 
-func (s *Service) RemoveModule(ctx context.Context, orgID, moduleID uuid.UUID) error {
+// RemoveModule n'agit que si la version du module fait partie de ifMatch, lorsqu'il est fourni.
+func (s *Service) RemoveModule(ctx context.Context, orgID, moduleID uuid.UUID, ifMatch ...int) (err error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -417,6 +599,10 @@ func (s *Service) RemoveModule(ctx context.Context, orgID, moduleID uuid.UUID) e
 		}
 		return err
 	}
+	if !matchVersion(ifMatch, module.Version) {
+		err = ErrVersionMismatch
+		return err
+	}
 
 	if _, err = tx.ModuleProgress.Delete().
 		Where(entmoduleprogress.ModuleIDEQ(moduleID)).
@@ -424,32 +610,41 @@ func (s *Service) RemoveModule(ctx context.Context, orgID, moduleID uuid.UUID) e
 		return err
 	}
 
-	if err = tx.Module.DeleteOne(module).Exec(ctx); err != nil {
+	deleted, err := tx.Module.Delete().
+		Where(entmodule.IDEQ(module.ID), entmodule.VersionEQ(module.Version)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		err = ErrVersionMismatch
 		return err
 	}
 
-	remaining, qErr := tx.Module.Query().
+	remaining, err := tx.Module.Query().
 		Where(
 			entmodule.CourseIDEQ(module.CourseID),
 			entmodule.PositionGT(module.Position),
 		).
 		Order(entmodule.ByPosition()).
 		All(ctx)
-	if qErr != nil {
-		return qErr
+	if err != nil {
+		return err
 	}
 	for _, m := range remaining {
-		if _, updateErr := tx.Module.UpdateOneID(m.ID).
+		if err = tx.Module.UpdateOneID(m.ID).
 			SetPosition(m.Position - 1).
-			Save(ctx); updateErr != nil {
-			return updateErr
+			AddVersion(1).
+			Exec(ctx); err != nil {
+			return err
 		}
 	}
-
-	if err = tx.Commit(); err != nil {
+	if err = touchCourse(ctx, tx.Client(), module.CourseID); err != nil {
 		return err
 	}
-	return nil
+
+	err = tx.Commit()
+	return err
 }
 
 func (s *Service) ensureOrg(ctx context.Context, orgID uuid.UUID) error {
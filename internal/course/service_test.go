@@ -3,6 +3,8 @@ package course
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
@@ -144,6 +146,54 @@ func TestModuleOperations(t *testing.T) {
 	require.Len(t, modules, 1)
 }
 
+func TestVersionPreconditions(t *testing.T) {
+	svc, orgID, cleanup := newCourseService(t)
+	t.Cleanup(cleanup)
+	ctx := context.Background()
+
+	course, err := svc.Create(ctx, CreateCourseInput{OrganizationID: orgID, Title: "Versions", Slug: "versions"})
+	require.NoError(t, err)
+	require.Equal(t, 1, course.Version)
+
+	title := "Versions v2"
+	updated, err := svc.Update(ctx, orgID, course.ID, UpdateCourseInput{Title: &title, IfMatch: []int{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, updated.Version)
+
+	// Une écriture fondée sur la version 1 est refusée, sans rien modifier.
+	other := "Écrasé"
+	_, err = svc.Update(ctx, orgID, course.ID, UpdateCourseInput{Title: &other, IfMatch: []int{1}})
+	require.ErrorIs(t, err, ErrVersionMismatch)
+	_, err = svc.Publish(ctx, orgID, course.ID, 1)
+	require.ErrorIs(t, err, ErrVersionMismatch)
+	require.ErrorIs(t, svc.Delete(ctx, orgID, course.ID, 1), ErrVersionMismatch)
+	_, err = svc.Update(ctx, orgID, uuid.New(), UpdateCourseInput{Title: &other, IfMatch: []int{1}})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Les modules font partie du cours : les modifier change sa version.
+	module, err := svc.AddModule(ctx, orgID, course.ID, ModuleInput{Title: "Intro", ModuleType: "article"})
+	require.NoError(t, err)
+	current, err := svc.Get(ctx, orgID, course.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Versions v2", current.Title)
+	require.Equal(t, 3, current.Version)
+
+	_, err = svc.UpdateModule(ctx, orgID, module.ID, ModuleInput{Title: "Intro bis", IfMatch: []int{2}})
+	require.ErrorIs(t, err, ErrVersionMismatch)
+	module, err = svc.UpdateModule(ctx, orgID, module.ID, ModuleInput{Title: "Intro bis", IfMatch: []int{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, module.Version)
+	require.ErrorIs(t, svc.RemoveModule(ctx, orgID, module.ID, 1), ErrVersionMismatch)
+	require.ErrorIs(t, svc.ReorderModules(ctx, orgID, course.ID, []uuid.UUID{module.ID}, 3), ErrVersionMismatch)
+	require.NoError(t, svc.ReorderModules(ctx, orgID, course.ID, []uuid.UUID{module.ID}, 4))
+
+	require.NoError(t, svc.RemoveModule(ctx, orgID, module.ID, 2))
+	current, err = svc.Get(ctx, orgID, course.ID)
+	require.NoError(t, err)
+	require.Equal(t, 6, current.Version)
+	require.NoError(t, svc.Delete(ctx, orgID, course.ID, 5, 6))
+}
+
 func TestDeleteCourseRemovesDependencies(t *testing.T) {
 	svc, orgID, cleanup := newCourseService(t)
 	t.Cleanup(cleanup)
@@ -214,3 +264,111 @@ func TestDeleteCourseRemovesDependencies(t *testing.T) {
 }
 
 func ptr(s string) *string { return &s }
+
+func TestModuleContentRevisionPin(t *testing.T) {
+	svc, orgID, cleanup := newCourseService(t)
+	t.Cleanup(cleanup)
+	ctx := context.Background()
+
+	course, err := svc.Create(ctx, CreateCourseInput{OrganizationID: orgID, Title: "Docs", Slug: "docs"})
+	require.NoError(t, err)
+	content, err := svc.client.Content.Create().
+		SetOrganizationID(orgID).
+		SetName("guide.pdf").
+		SetMimeType("application/pdf").
+		SetStorageKey("org/guide-v2.pdf").
+		SetCurrentRevision(2).
+		SetStatus("available").
+		Save(ctx)
+	require.NoError(t, err)
+	for _, n := range []int{1, 2} {
+		_, err = svc.client.ContentRevision.Create().
+			SetOrganizationID(orgID).
+			SetContentID(content.ID).
+			SetNumber(n).
+			SetStorageKey(fmt.Sprintf("org/guide-v%d.pdf", n)).
+			SetMimeType("application/pdf").
+			SetStatus("available").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	_, err = svc.AddModule(ctx, orgID, course.ID, ModuleInput{Title: "Guide", ModuleType: "pdf", ContentID: &content.ID, ContentRevision: ptrInt(3)})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	module, err := svc.AddModule(ctx, orgID, course.ID, ModuleInput{Title: "Guide", ModuleType: "pdf", ContentID: &content.ID, ContentRevision: ptrInt(1)})
+	require.NoError(t, err)
+	require.NotNil(t, module.ContentRevision)
+	require.Equal(t, 1, *module.ContentRevision)
+
+	// Une mise à jour sans épinglage conserve la révision épinglée.
+	module, err = svc.UpdateModule(ctx, orgID, module.ID, ModuleInput{Title: "Guide v1", ContentID: &content.ID})
+	require.NoError(t, err)
+	require.NotNil(t, module.ContentRevision)
+
+	module, err = svc.UpdateModule(ctx, orgID, module.ID, ModuleInput{ContentRevision: ptrInt(0)})
+	require.NoError(t, err)
+	require.Nil(t, module.ContentRevision)
+}
+
+func TestExportImport(t *testing.T) {
+	svc, orgID, cleanup := newCourseService(t)
+	t.Cleanup(cleanup)
+	ctx := context.Background()
+
+	course, err := svc.Create(ctx, CreateCourseInput{OrganizationID: orgID, Title: "Docs", Slug: "docs", Metadata: map[string]any{"level": "1"}})
+	require.NoError(t, err)
+	content, err := svc.client.Content.Create().
+		SetOrganizationID(orgID).
+		SetName("guide.pdf").
+		SetMimeType("application/pdf").
+		SetStorageKey("org/guide.pdf").
+		SetStatus("available").
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = svc.AddModule(ctx, orgID, course.ID, ModuleInput{Title: "Intro", ModuleType: "article", Data: map[string]any{"body": "Bienvenue"}})
+	require.NoError(t, err)
+	_, err = svc.AddModule(ctx, orgID, course.ID, ModuleInput{Title: "Guide", ModuleType: "pdf", ContentID: &content.ID, ContentRevision: ptrInt(1), DurationSecs: 600})
+	require.NoError(t, err)
+
+	export, err := svc.Export(ctx, orgID, course.ID)
+	require.NoError(t, err)
+	raw, err := json.Marshal(export)
+	require.NoError(t, err)
+	var doc Export
+	require.NoError(t, json.Unmarshal(raw, &doc))
+
+	_, err = svc.Import(ctx, ImportInput{OrganizationID: orgID, Export: &doc})
+	require.ErrorIs(t, err, ErrSlugTaken)
+
+	result, err := svc.Import(ctx, ImportInput{OrganizationID: orgID, Export: &doc, Slug: "docs-copy"})
+	require.NoError(t, err)
+	require.Zero(t, result.DetachedContents)
+	require.Equal(t, "docs-copy", result.Course.Slug)
+	require.Equal(t, StatusDraft, result.Course.Status)
+	require.Equal(t, "1", result.Course.Metadata["level"])
+	modules := result.Course.Edges.Modules
+	require.Len(t, modules, 2)
+	require.Equal(t, "Intro", modules[0].Title)
+	require.Equal(t, "Bienvenue", modules[0].Data["body"])
+	require.Equal(t, &content.ID, modules[1].ContentID)
+	require.Equal(t, ptrInt(1), modules[1].ContentRevision)
+	require.Equal(t, 600, modules[1].DurationSeconds)
+
+	// Dans une autre organisation, le contenu n'existe pas : le module est importé sans lui.
+	other, err := svc.client.Organization.Create().SetName("Other").SetSlug("other").Save(ctx)
+	require.NoError(t, err)
+	result, err = svc.Import(ctx, ImportInput{OrganizationID: other.ID, Export: &doc})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.DetachedContents)
+	require.Nil(t, result.Course.Edges.Modules[1].ContentID)
+	found, err := svc.GetBySlug(ctx, other.ID, "Docs")
+	require.NoError(t, err)
+	require.Equal(t, result.Course.ID, found.ID)
+
+	doc.Format = "autre"
+	_, err = svc.Import(ctx, ImportInput{OrganizationID: orgID, Export: &doc, Slug: "docs-v2"})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func ptrInt(v int) *int { return &v }
@@ -6,4 +6,7 @@ var (
 	ErrInvalidInput    = errors.New("enrollment: invalid input")
 	ErrNotFound        = errors.New("enrollment: not found")
 	ErrAlreadyEnrolled = errors.New("enrollment: user already enrolled")
+	ErrGroupConflict   = errors.New("enrollment: group external id already used")
+	// ErrVersionMismatch signale que le groupe a changé depuis la version attendue.
+	ErrVersionMismatch = errors.New("enrollment: version modifiée")
 )
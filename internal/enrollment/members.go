@@ -0,0 +1,171 @@
+package enrollment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entgroup "lms-go/internal/ent/group"
+	entuser "lms-go/internal/ent/user"
+)
+
+// AddGroupMembers ajoute des utilisateurs au groupe. Si le groupe est rattaché à un cours,
+// chaque membre y est inscrit via le groupe (liste d'attente si la capacité est atteinte).
+func (s *Service) AddGroupMembers(ctx context.Context, orgID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	group, err := s.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	current := make(map[uuid.UUID]struct{}, len(group.Edges.Members))
+	for _, member := range group.Edges.Members {
+		current[member.ID] = struct{}{}
+	}
+
+	var added []uuid.UUID
+	for _, userID := range userIDs {
+		if _, ok := current[userID]; ok {
+			continue
+		}
+		if err := s.ensureUser(ctx, orgID, userID); err != nil {
+			return err
+		}
+		current[userID] = struct{}{}
+		added = append(added, userID)
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	if err := s.client.Group.UpdateOneID(groupID).
+		AddMemberIDs(added...).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if group.CourseID == nil {
+		return nil
+	}
+	for _, userID := range added {
+		if err := s.enrollGroupMember(ctx, group, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveGroupMembers retire des utilisateurs du groupe et annule leurs inscriptions en cours
+// obtenues via ce groupe ; les inscriptions terminées sont conservées.
+func (s *Service) RemoveGroupMembers(ctx context.Context, orgID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	exists, err := s.client.Group.Query().
+		Where(entgroup.IDEQ(groupID), entgroup.OrganizationIDEQ(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+
+	if err := s.client.Group.UpdateOneID(groupID).
+		RemoveMemberIDs(userIDs...).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err = s.client.Enrollment.Update().
+		Where(
+			entenrollment.OrganizationIDEQ(orgID),
+			entenrollment.GroupIDEQ(groupID),
+			entenrollment.UserIDIn(userIDs...),
+			entenrollment.StatusNotIn(StatusCompleted, StatusCancelled),
+		).
+		SetStatus(StatusCancelled).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// SetGroupMembers remplace la liste des membres du groupe.
+func (s *Service) SetGroupMembers(ctx context.Context, orgID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	group, err := s.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[uuid.UUID]struct{}, len(userIDs))
+	for _, userID := range userIDs {
+		wanted[userID] = struct{}{}
+	}
+	var removed []uuid.UUID
+	for _, member := range group.Edges.Members {
+		if _, ok := wanted[member.ID]; !ok {
+			removed = append(removed, member.ID)
+		}
+	}
+	if err := s.RemoveGroupMembers(ctx, orgID, groupID, removed); err != nil {
+		return err
+	}
+	return s.AddGroupMembers(ctx, orgID, groupID, userIDs)
+}
+
+// GroupMembers liste les membres d'un groupe.
+func (s *Service) GroupMembers(ctx context.Context, orgID, groupID uuid.UUID) ([]*ent.User, error) {
+	group, err := s.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return group.QueryMembers().
+		Where(entuser.OrganizationIDEQ(orgID)).
+		Order(entuser.ByCreatedAt()).
+		All(ctx)
+}
+
+// enrollGroupMember inscrit le membre au cours du groupe, ou réactive une inscription annulée.
+func (s *Service) enrollGroupMember(ctx context.Context, group *ent.Group, userID uuid.UUID) error {
+	existing, err := s.client.Enrollment.Query().
+		Where(
+			entenrollment.OrganizationIDEQ(group.OrganizationID),
+			entenrollment.CourseIDEQ(*group.CourseID),
+			entenrollment.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	if existing == nil {
+		_, err := s.Enroll(ctx, EnrollInput{
+			OrganizationID: group.OrganizationID,
+			CourseID:       *group.CourseID,
+			UserID:         userID,
+			GroupID:        &group.ID,
+		})
+		if errors.Is(err, ErrAlreadyEnrolled) {
+			return nil
+		}
+		return err
+	}
+
+	update := s.client.Enrollment.UpdateOneID(existing.ID).
+		SetUpdatedAt(time.Now())
+	switch {
+	case existing.Status == StatusCancelled:
+		update.SetGroupID(group.ID)
+		if s.groupAtCapacity(ctx, group.ID) {
+			update.SetStatus(StatusWaitlisted)
+		} else {
+			update.SetStatus(StatusActive).SetStartedAt(time.Now())
+		}
+	case existing.GroupID == nil:
+		update.SetGroupID(group.ID)
+	default:
+		return nil
+	}
+	return update.Exec(ctx)
+}
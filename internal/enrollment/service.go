@@ -207,6 +207,7 @@ type CreateGroupInput struct {
 	Name           string
 	Description    string
 	Capacity       *int
+	ExternalID     string
 	Metadata       map[string]any
 }
 
@@ -214,11 +215,22 @@ type UpdateGroupInput struct {
 	Name        *string
 	Description *string
 	Capacity    *int
-	Metadata    map[string]any
+	// ExternalID vide efface l'identifiant externe.
+	ExternalID *string
+	Metadata   map[string]any
+	// IfMatch liste les versions attendues du groupe ; vide, aucune n'est exigée.
+	IfMatch []int
 }
 
 type GroupFilter struct {
-	CourseID uuid.UUID
+	CourseID   uuid.UUID
+	Name       string
+	ExternalID string
+	// WithMembers charge les membres dans Edges.Members.
+	WithMembers bool
+	// Offset et Limit paginent le résultat ; Limit à 0 renvoie tout.
+	Offset int
+	Limit  int
 }
 
 func (s *Service) CreateGroup(ctx context.Context, input CreateGroupInput) (*ent.Group, error) {
@@ -243,31 +255,81 @@ func (s *Service) CreateGroup(ctx context.Context, input CreateGroupInput) (*ent
 		}
 		builder.SetCourseID(*input.CourseID)
 	}
+	if externalID := strings.TrimSpace(input.ExternalID); externalID != "" {
+		builder.SetExternalID(externalID)
+	}
 	if input.Metadata != nil {
 		builder.SetMetadata(input.Metadata)
 	}
 
 	group, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrGroupConflict
+		}
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetGroup renvoie un groupe de l'organisation avec ses membres.
+func (s *Service) GetGroup(ctx context.Context, orgID, groupID uuid.UUID) (*ent.Group, error) {
+	group, err := s.client.Group.Query().
+		Where(entgroup.IDEQ(groupID), entgroup.OrganizationIDEQ(orgID)).
+		WithMembers().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return group, nil
 }
 
 func (s *Service) ListGroups(ctx context.Context, orgID uuid.UUID, filter GroupFilter) ([]*ent.Group, error) {
+	query := s.filteredGroups(orgID, filter).
+		Order(entgroup.ByCreatedAt(), entgroup.ByID())
+	if filter.WithMembers {
+		query = query.WithMembers()
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	return query.All(ctx)
+}
+
+// CountGroups renvoie le nombre de groupes correspondant au filtre, sans pagination.
+func (s *Service) CountGroups(ctx context.Context, orgID uuid.UUID, filter GroupFilter) (int, error) {
+	return s.filteredGroups(orgID, filter).Count(ctx)
+}
+
+func (s *Service) filteredGroups(orgID uuid.UUID, filter GroupFilter) *ent.GroupQuery {
 	query := s.client.Group.Query().
-		Where(entgroup.OrganizationIDEQ(orgID)).
-		Order(entgroup.ByCreatedAt())
+		Where(entgroup.OrganizationIDEQ(orgID))
 	if filter.CourseID != uuid.Nil {
 		query = query.Where(entgroup.CourseIDEQ(filter.CourseID))
 	}
-	return query.All(ctx)
+	if name := strings.TrimSpace(filter.Name); name != "" {
+		query = query.Where(entgroup.NameEQ(name))
+	}
+	if externalID := strings.TrimSpace(filter.ExternalID); externalID != "" {
+		query = query.Where(entgroup.ExternalIDEQ(externalID))
+	}
+	return query
 }
 
 func (s *Service) UpdateGroup(ctx context.Context, orgID, groupID uuid.UUID, input UpdateGroupInput) (*ent.Group, error) {
 	update := s.client.Group.UpdateOneID(groupID).
 		Where(entgroup.OrganizationIDEQ(orgID)).
+		AddVersion(1).
 		SetUpdatedAt(time.Now())
+	if len(input.IfMatch) > 0 {
+		update.Where(entgroup.VersionIn(input.IfMatch...))
+	}
 
 	if input.Name != nil {
 		name := strings.TrimSpace(*input.Name)
@@ -286,6 +348,13 @@ func (s *Service) UpdateGroup(ctx context.Context, orgID, groupID uuid.UUID, inp
 		}
 		update.SetCapacity(cap)
 	}
+	if input.ExternalID != nil {
+		if externalID := strings.TrimSpace(*input.ExternalID); externalID != "" {
+			update.SetExternalID(externalID)
+		} else {
+			update.ClearExternalID()
+		}
+	}
 	if input.Metadata != nil {
 		update.SetMetadata(input.Metadata)
 	}
@@ -293,26 +362,50 @@ func (s *Service) UpdateGroup(ctx context.Context, orgID, groupID uuid.UUID, inp
 	group, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			return nil, s.groupUpdateFailed(ctx, orgID, groupID, input.IfMatch)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, ErrGroupConflict
 		}
 		return nil, err
 	}
 	return group, nil
 }
 
-func (s *Service) DeleteGroup(ctx context.Context, orgID, groupID uuid.UUID) error {
-	err := s.client.Group.DeleteOneID(groupID).
-		Where(entgroup.OrganizationIDEQ(orgID)).
-		Exec(ctx)
-	if err != nil {
+// DeleteGroup supprime le groupe, si sa version fait partie de ifMatch lorsqu'il est fourni.
+func (s *Service) DeleteGroup(ctx context.Context, orgID, groupID uuid.UUID, ifMatch ...int) error {
+	remove := s.client.Group.DeleteOneID(groupID).
+		Where(entgroup.OrganizationIDEQ(orgID))
+	if len(ifMatch) > 0 {
+		remove.Where(entgroup.VersionIn(ifMatch...))
+	}
+	if err := remove.Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
-			return ErrNotFound
+			return s.groupUpdateFailed(ctx, orgID, groupID, ifMatch)
 		}
 		return err
 	}
 	return nil
 }
 
+// groupUpdateFailed qualifie l'échec d'une écriture conditionnelle sur le groupe :
+// ErrVersionMismatch s'il existe toujours mais a changé de version, ErrNotFound sinon.
+func (s *Service) groupUpdateFailed(ctx context.Context, orgID, groupID uuid.UUID, ifMatch []int) error {
+	if len(ifMatch) == 0 {
+		return ErrNotFound
+	}
+	exists, err := s.client.Group.Query().
+		Where(entgroup.IDEQ(groupID), entgroup.OrganizationIDEQ(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
+
 func (s *Service) ensureOrg(ctx context.Context, orgID uuid.UUID) error {
 	exists, err := s.client.Organization.Query().
 		Where(entorg.IDEQ(orgID)).
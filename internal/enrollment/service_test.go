@@ -73,6 +73,27 @@ func TestEnrollmentLifecycle(t *testing.T) {
 	require.NoError(t, svc.Cancel(ctx, orgID, enrollment.ID))
 }
 
+func TestGroupVersionPreconditions(t *testing.T) {
+	svc, orgID, _, _, cleanup := newEnrollmentSvc(t)
+	t.Cleanup(cleanup)
+	ctx := context.Background()
+
+	group, err := svc.CreateGroup(ctx, CreateGroupInput{OrganizationID: orgID, Name: "Cohorte"})
+	require.NoError(t, err)
+	require.Equal(t, 1, group.Version)
+
+	name := "Cohorte 2026"
+	group, err = svc.UpdateGroup(ctx, orgID, group.ID, UpdateGroupInput{Name: &name, IfMatch: []int{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, group.Version)
+	_, err = svc.UpdateGroup(ctx, orgID, group.ID, UpdateGroupInput{Name: &name, IfMatch: []int{1}})
+	require.ErrorIs(t, err, ErrVersionMismatch)
+
+	require.ErrorIs(t, svc.DeleteGroup(ctx, orgID, group.ID, 1), ErrVersionMismatch)
+	require.NoError(t, svc.DeleteGroup(ctx, orgID, group.ID, 2))
+	require.ErrorIs(t, svc.DeleteGroup(ctx, orgID, group.ID, 2), ErrNotFound)
+}
+
 func TestGroupCapacity(t *testing.T) {
 	svc, orgID, userID, courseID, cleanup := newEnrollmentSvc(t)
 	t.Cleanup(cleanup)
@@ -11,20 +11,33 @@ import (
 
 	"lms-go/internal/ent/migrate"
 
+	"lms-go/internal/ent/apikey"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/course"
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
+	"lms-go/internal/ent/idempotencykey"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/moduleprogress"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/samlassertion"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthnceremony"
+	"lms-go/internal/ent/webauthncredential"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,22 +45,44 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Content is the client for interacting with the Content builders.
 	Content *ContentClient
+	// ContentDownload is the client for interacting with the ContentDownload builders.
+	ContentDownload *ContentDownloadClient
+	// ContentRevision is the client for interacting with the ContentRevision builders.
+	ContentRevision *ContentRevisionClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
 	// Enrollment is the client for interacting with the Enrollment builders.
 	Enrollment *EnrollmentClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Module is the client for interacting with the Module builders.
 	Module *ModuleClient
 	// ModuleProgress is the client for interacting with the ModuleProgress builders.
 	ModuleProgress *ModuleProgressClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// PrivacyRequest is the client for interacting with the PrivacyRequest builders.
+	PrivacyRequest *PrivacyRequestClient
+	// SSOIdentity is the client for interacting with the SSOIdentity builders.
+	SSOIdentity *SSOIdentityClient
+	// SamlAssertion is the client for interacting with the SamlAssertion builders.
+	SamlAssertion *SamlAssertionClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
+	// ServiceAccount is the client for interacting with the ServiceAccount builders.
+	ServiceAccount *ServiceAccountClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebauthnCeremony is the client for interacting with the WebauthnCeremony builders.
+	WebauthnCeremony *WebauthnCeremonyClient
+	// WebauthnCredential is the client for interacting with the WebauthnCredential builders.
+	WebauthnCredential *WebauthnCredentialClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -59,14 +94,25 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.Content = NewContentClient(c.config)
+	c.ContentDownload = NewContentDownloadClient(c.config)
+	c.ContentRevision = NewContentRevisionClient(c.config)
 	c.Course = NewCourseClient(c.config)
 	c.Enrollment = NewEnrollmentClient(c.config)
 	c.Group = NewGroupClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
 	c.Module = NewModuleClient(c.config)
 	c.ModuleProgress = NewModuleProgressClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
+	c.PrivacyRequest = NewPrivacyRequestClient(c.config)
+	c.SSOIdentity = NewSSOIdentityClient(c.config)
+	c.SamlAssertion = NewSamlAssertionClient(c.config)
+	c.ScimToken = NewScimTokenClient(c.config)
+	c.ServiceAccount = NewServiceAccountClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WebauthnCeremony = NewWebauthnCeremonyClient(c.config)
+	c.WebauthnCredential = NewWebauthnCredentialClient(c.config)
 }
 
 type (
@@ -157,16 +203,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Content:        NewContentClient(cfg),
-		Course:         NewCourseClient(cfg),
-		Enrollment:     NewEnrollmentClient(cfg),
-		Group:          NewGroupClient(cfg),
-		Module:         NewModuleClient(cfg),
-		ModuleProgress: NewModuleProgressClient(cfg),
-		Organization:   NewOrganizationClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		Content:            NewContentClient(cfg),
+		ContentDownload:    NewContentDownloadClient(cfg),
+		ContentRevision:    NewContentRevisionClient(cfg),
+		Course:             NewCourseClient(cfg),
+		Enrollment:         NewEnrollmentClient(cfg),
+		Group:              NewGroupClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		Module:             NewModuleClient(cfg),
+		ModuleProgress:     NewModuleProgressClient(cfg),
+		Organization:       NewOrganizationClient(cfg),
+		PrivacyRequest:     NewPrivacyRequestClient(cfg),
+		SSOIdentity:        NewSSOIdentityClient(cfg),
+		SamlAssertion:      NewSamlAssertionClient(cfg),
+		ScimToken:          NewScimTokenClient(cfg),
+		ServiceAccount:     NewServiceAccountClient(cfg),
+		User:               NewUserClient(cfg),
+		WebauthnCeremony:   NewWebauthnCeremonyClient(cfg),
+		WebauthnCredential: NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -184,23 +241,34 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Content:        NewContentClient(cfg),
-		Course:         NewCourseClient(cfg),
-		Enrollment:     NewEnrollmentClient(cfg),
-		Group:          NewGroupClient(cfg),
-		Module:         NewModuleClient(cfg),
-		ModuleProgress: NewModuleProgressClient(cfg),
-		Organization:   NewOrganizationClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		Content:            NewContentClient(cfg),
+		ContentDownload:    NewContentDownloadClient(cfg),
+		ContentRevision:    NewContentRevisionClient(cfg),
+		Course:             NewCourseClient(cfg),
+		Enrollment:         NewEnrollmentClient(cfg),
+		Group:              NewGroupClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		Module:             NewModuleClient(cfg),
+		ModuleProgress:     NewModuleProgressClient(cfg),
+		Organization:       NewOrganizationClient(cfg),
+		PrivacyRequest:     NewPrivacyRequestClient(cfg),
+		SSOIdentity:        NewSSOIdentityClient(cfg),
+		SamlAssertion:      NewSamlAssertionClient(cfg),
+		ScimToken:          NewScimTokenClient(cfg),
+		ServiceAccount:     NewServiceAccountClient(cfg),
+		User:               NewUserClient(cfg),
+		WebauthnCeremony:   NewWebauthnCeremonyClient(cfg),
+		WebauthnCredential: NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Content.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -223,8 +291,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Content, c.Course, c.Enrollment, c.Group, c.Module, c.ModuleProgress,
-		c.Organization, c.User,
+		c.APIKey, c.Content, c.ContentDownload, c.ContentRevision, c.Course,
+		c.Enrollment, c.Group, c.IdempotencyKey, c.Module, c.ModuleProgress,
+		c.Organization, c.PrivacyRequest, c.SSOIdentity, c.SamlAssertion, c.ScimToken,
+		c.ServiceAccount, c.User, c.WebauthnCeremony, c.WebauthnCredential,
 	} {
 		n.Use(hooks...)
 	}
@@ -234,8 +304,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Content, c.Course, c.Enrollment, c.Group, c.Module, c.ModuleProgress,
-		c.Organization, c.User,
+		c.APIKey, c.Content, c.ContentDownload, c.ContentRevision, c.Course,
+		c.Enrollment, c.Group, c.IdempotencyKey, c.Module, c.ModuleProgress,
+		c.Organization, c.PrivacyRequest, c.SSOIdentity, c.SamlAssertion, c.ScimToken,
+		c.ServiceAccount, c.User, c.WebauthnCeremony, c.WebauthnCredential,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -244,27 +316,198 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *ContentMutation:
 		return c.Content.mutate(ctx, m)
+	case *ContentDownloadMutation:
+		return c.ContentDownload.mutate(ctx, m)
+	case *ContentRevisionMutation:
+		return c.ContentRevision.mutate(ctx, m)
 	case *CourseMutation:
 		return c.Course.mutate(ctx, m)
 	case *EnrollmentMutation:
 		return c.Enrollment.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
 	case *ModuleMutation:
 		return c.Module.mutate(ctx, m)
 	case *ModuleProgressMutation:
 		return c.ModuleProgress.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
+	case *PrivacyRequestMutation:
+		return c.PrivacyRequest.mutate(ctx, m)
+	case *SSOIdentityMutation:
+		return c.SSOIdentity.mutate(ctx, m)
+	case *SamlAssertionMutation:
+		return c.SamlAssertion.mutate(ctx, m)
+	case *ScimTokenMutation:
+		return c.ScimToken.mutate(ctx, m)
+	case *ServiceAccountMutation:
+		return c.ServiceAccount.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WebauthnCeremonyMutation:
+		return c.WebauthnCeremony.mutate(ctx, m)
+	case *WebauthnCredentialMutation:
+		return c.WebauthnCredential.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uuid.UUID) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uuid.UUID) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uuid.UUID) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryServiceAccount queries the service_account edge of a APIKey.
+func (c *APIKeyClient) QueryServiceAccount(ak *APIKey) *ServiceAccountQuery {
+	query := (&ServiceAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(serviceaccount.Table, serviceaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.ServiceAccountTable, apikey.ServiceAccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // ContentClient is a client for the Content schema.
 type ContentClient struct {
 	config
@@ -405,6 +648,38 @@ func (c *ContentClient) QueryModules(co *Content) *ModuleQuery {
 	return query
 }
 
+// QueryRevisions queries the revisions edge of a Content.
+func (c *ContentClient) QueryRevisions(co *Content) *ContentRevisionQuery {
+	query := (&ContentRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(content.Table, content.FieldID, id),
+			sqlgraph.To(contentrevision.Table, contentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, content.RevisionsTable, content.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDownloads queries the downloads edge of a Content.
+func (c *ContentClient) QueryDownloads(co *Content) *ContentDownloadQuery {
+	query := (&ContentDownloadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(content.Table, content.FieldID, id),
+			sqlgraph.To(contentdownload.Table, contentdownload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, content.DownloadsTable, content.DownloadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ContentClient) Hooks() []Hook {
 	return c.hooks.Content
@@ -430,107 +705,107 @@ func (c *ContentClient) mutate(ctx context.Context, m *ContentMutation) (Value,
 	}
 }
 
-// CourseClient is a client for the Course schema.
-type CourseClient struct {
+// ContentDownloadClient is a client for the ContentDownload schema.
+type ContentDownloadClient struct {
 	config
 }
 
-// NewCourseClient returns a client for the Course from the given config.
-func NewCourseClient(c config) *CourseClient {
-	return &CourseClient{config: c}
+// NewContentDownloadClient returns a client for the ContentDownload from the given config.
+func NewContentDownloadClient(c config) *ContentDownloadClient {
+	return &ContentDownloadClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `course.Hooks(f(g(h())))`.
-func (c *CourseClient) Use(hooks ...Hook) {
-	c.hooks.Course = append(c.hooks.Course, hooks...)
+// A call to `Use(f, g, h)` equals to `contentdownload.Hooks(f(g(h())))`.
+func (c *ContentDownloadClient) Use(hooks ...Hook) {
+	c.hooks.ContentDownload = append(c.hooks.ContentDownload, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `course.Intercept(f(g(h())))`.
-func (c *CourseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Course = append(c.inters.Course, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contentdownload.Intercept(f(g(h())))`.
+func (c *ContentDownloadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentDownload = append(c.inters.ContentDownload, interceptors...)
 }
 
-// Create returns a builder for creating a Course entity.
-func (c *CourseClient) Create() *CourseCreate {
-	mutation := newCourseMutation(c.config, OpCreate)
-	return &CourseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContentDownload entity.
+func (c *ContentDownloadClient) Create() *ContentDownloadCreate {
+	mutation := newContentDownloadMutation(c.config, OpCreate)
+	return &ContentDownloadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Course entities.
-func (c *CourseClient) CreateBulk(builders ...*CourseCreate) *CourseCreateBulk {
-	return &CourseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContentDownload entities.
+func (c *ContentDownloadClient) CreateBulk(builders ...*ContentDownloadCreate) *ContentDownloadCreateBulk {
+	return &ContentDownloadCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CourseClient) MapCreateBulk(slice any, setFunc func(*CourseCreate, int)) *CourseCreateBulk {
+func (c *ContentDownloadClient) MapCreateBulk(slice any, setFunc func(*ContentDownloadCreate, int)) *ContentDownloadCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CourseCreateBulk{err: fmt.Errorf("calling to CourseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContentDownloadCreateBulk{err: fmt.Errorf("calling to ContentDownloadClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CourseCreate, rv.Len())
+	builders := make([]*ContentDownloadCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CourseCreateBulk{config: c.config, builders: builders}
+	return &ContentDownloadCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Course.
-func (c *CourseClient) Update() *CourseUpdate {
-	mutation := newCourseMutation(c.config, OpUpdate)
-	return &CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContentDownload.
+func (c *ContentDownloadClient) Update() *ContentDownloadUpdate {
+	mutation := newContentDownloadMutation(c.config, OpUpdate)
+	return &ContentDownloadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CourseClient) UpdateOne(co *Course) *CourseUpdateOne {
-	mutation := newCourseMutation(c.config, OpUpdateOne, withCourse(co))
-	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentDownloadClient) UpdateOne(cd *ContentDownload) *ContentDownloadUpdateOne {
+	mutation := newContentDownloadMutation(c.config, OpUpdateOne, withContentDownload(cd))
+	return &ContentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CourseClient) UpdateOneID(id uuid.UUID) *CourseUpdateOne {
-	mutation := newCourseMutation(c.config, OpUpdateOne, withCourseID(id))
-	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentDownloadClient) UpdateOneID(id uuid.UUID) *ContentDownloadUpdateOne {
+	mutation := newContentDownloadMutation(c.config, OpUpdateOne, withContentDownloadID(id))
+	return &ContentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Course.
-func (c *CourseClient) Delete() *CourseDelete {
-	mutation := newCourseMutation(c.config, OpDelete)
-	return &CourseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ContentDownload.
+func (c *ContentDownloadClient) Delete() *ContentDownloadDelete {
+	mutation := newContentDownloadMutation(c.config, OpDelete)
+	return &ContentDownloadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CourseClient) DeleteOne(co *Course) *CourseDeleteOne {
-	return c.DeleteOneID(co.ID)
+func (c *ContentDownloadClient) DeleteOne(cd *ContentDownload) *ContentDownloadDeleteOne {
+	return c.DeleteOneID(cd.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CourseClient) DeleteOneID(id uuid.UUID) *CourseDeleteOne {
-	builder := c.Delete().Where(course.ID(id))
+func (c *ContentDownloadClient) DeleteOneID(id uuid.UUID) *ContentDownloadDeleteOne {
+	builder := c.Delete().Where(contentdownload.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CourseDeleteOne{builder}
+	return &ContentDownloadDeleteOne{builder}
 }
 
-// Query returns a query builder for Course.
-func (c *CourseClient) Query() *CourseQuery {
-	return &CourseQuery{
+// Query returns a query builder for ContentDownload.
+func (c *ContentDownloadClient) Query() *ContentDownloadQuery {
+	return &ContentDownloadQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCourse},
+		ctx:    &QueryContext{Type: TypeContentDownload},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Course entity by its id.
-func (c *CourseClient) Get(ctx context.Context, id uuid.UUID) (*Course, error) {
-	return c.Query().Where(course.ID(id)).Only(ctx)
+// Get returns a ContentDownload entity by its id.
+func (c *ContentDownloadClient) Get(ctx context.Context, id uuid.UUID) (*ContentDownload, error) {
+	return c.Query().Where(contentdownload.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CourseClient) GetX(ctx context.Context, id uuid.UUID) *Course {
+func (c *ContentDownloadClient) GetX(ctx context.Context, id uuid.UUID) *ContentDownload {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -538,196 +813,1383 @@ func (c *CourseClient) GetX(ctx context.Context, id uuid.UUID) *Course {
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a Course.
-func (c *CourseClient) QueryOrganization(co *Course) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, course.OrganizationTable, course.OrganizationColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryModules queries the modules edge of a Course.
-func (c *CourseClient) QueryModules(co *Course) *ModuleQuery {
-	query := (&ModuleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(module.Table, module.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, course.ModulesTable, course.ModulesColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnrollments queries the enrollments edge of a Course.
-func (c *CourseClient) QueryEnrollments(co *Course) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGroups queries the groups edge of a Course.
-func (c *CourseClient) QueryGroups(co *Course) *GroupQuery {
-	query := (&GroupClient{config: c.config}).Query()
+// QueryContent queries the content edge of a ContentDownload.
+func (c *ContentDownloadClient) QueryContent(cd *ContentDownload) *ContentQuery {
+	query := (&ContentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
+		id := cd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, course.GroupsTable, course.GroupsColumn),
+			sqlgraph.From(contentdownload.Table, contentdownload.FieldID, id),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentdownload.ContentTable, contentdownload.ContentColumn),
 		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *CourseClient) Hooks() []Hook {
-	return c.hooks.Course
+func (c *ContentDownloadClient) Hooks() []Hook {
+	return c.hooks.ContentDownload
 }
 
 // Interceptors returns the client interceptors.
-func (c *CourseClient) Interceptors() []Interceptor {
-	return c.inters.Course
+func (c *ContentDownloadClient) Interceptors() []Interceptor {
+	return c.inters.ContentDownload
 }
 
-func (c *CourseClient) mutate(ctx context.Context, m *CourseMutation) (Value, error) {
+func (c *ContentDownloadClient) mutate(ctx context.Context, m *ContentDownloadMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CourseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentDownloadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentDownloadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CourseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ContentDownloadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Course mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ContentDownload mutation op: %q", m.Op())
 	}
 }
 
-// EnrollmentClient is a client for the Enrollment schema.
-type EnrollmentClient struct {
+// ContentRevisionClient is a client for the ContentRevision schema.
+type ContentRevisionClient struct {
 	config
 }
 
-// NewEnrollmentClient returns a client for the Enrollment from the given config.
-func NewEnrollmentClient(c config) *EnrollmentClient {
-	return &EnrollmentClient{config: c}
+// NewContentRevisionClient returns a client for the ContentRevision from the given config.
+func NewContentRevisionClient(c config) *ContentRevisionClient {
+	return &ContentRevisionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `enrollment.Hooks(f(g(h())))`.
-func (c *EnrollmentClient) Use(hooks ...Hook) {
-	c.hooks.Enrollment = append(c.hooks.Enrollment, hooks...)
+// A call to `Use(f, g, h)` equals to `contentrevision.Hooks(f(g(h())))`.
+func (c *ContentRevisionClient) Use(hooks ...Hook) {
+	c.hooks.ContentRevision = append(c.hooks.ContentRevision, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `enrollment.Intercept(f(g(h())))`.
-func (c *EnrollmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Enrollment = append(c.inters.Enrollment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contentrevision.Intercept(f(g(h())))`.
+func (c *ContentRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentRevision = append(c.inters.ContentRevision, interceptors...)
 }
 
-// Create returns a builder for creating a Enrollment entity.
-func (c *EnrollmentClient) Create() *EnrollmentCreate {
-	mutation := newEnrollmentMutation(c.config, OpCreate)
-	return &EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContentRevision entity.
+func (c *ContentRevisionClient) Create() *ContentRevisionCreate {
+	mutation := newContentRevisionMutation(c.config, OpCreate)
+	return &ContentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Enrollment entities.
-func (c *EnrollmentClient) CreateBulk(builders ...*EnrollmentCreate) *EnrollmentCreateBulk {
-	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContentRevision entities.
+func (c *ContentRevisionClient) CreateBulk(builders ...*ContentRevisionCreate) *ContentRevisionCreateBulk {
+	return &ContentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EnrollmentClient) MapCreateBulk(slice any, setFunc func(*EnrollmentCreate, int)) *EnrollmentCreateBulk {
+func (c *ContentRevisionClient) MapCreateBulk(slice any, setFunc func(*ContentRevisionCreate, int)) *ContentRevisionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EnrollmentCreateBulk{err: fmt.Errorf("calling to EnrollmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContentRevisionCreateBulk{err: fmt.Errorf("calling to ContentRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EnrollmentCreate, rv.Len())
+	builders := make([]*ContentRevisionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+	return &ContentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Enrollment.
-func (c *EnrollmentClient) Update() *EnrollmentUpdate {
-	mutation := newEnrollmentMutation(c.config, OpUpdate)
-	return &EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContentRevision.
+func (c *ContentRevisionClient) Update() *ContentRevisionUpdate {
+	mutation := newContentRevisionMutation(c.config, OpUpdate)
+	return &ContentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnrollmentClient) UpdateOne(e *Enrollment) *EnrollmentUpdateOne {
-	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollment(e))
-	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentRevisionClient) UpdateOne(cr *ContentRevision) *ContentRevisionUpdateOne {
+	mutation := newContentRevisionMutation(c.config, OpUpdateOne, withContentRevision(cr))
+	return &ContentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EnrollmentClient) UpdateOneID(id uuid.UUID) *EnrollmentUpdateOne {
-	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollmentID(id))
-	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentRevisionClient) UpdateOneID(id uuid.UUID) *ContentRevisionUpdateOne {
+	mutation := newContentRevisionMutation(c.config, OpUpdateOne, withContentRevisionID(id))
+	return &ContentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Enrollment.
-func (c *EnrollmentClient) Delete() *EnrollmentDelete {
+// Delete returns a delete builder for ContentRevision.
+func (c *ContentRevisionClient) Delete() *ContentRevisionDelete {
+	mutation := newContentRevisionMutation(c.config, OpDelete)
+	return &ContentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentRevisionClient) DeleteOne(cr *ContentRevision) *ContentRevisionDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentRevisionClient) DeleteOneID(id uuid.UUID) *ContentRevisionDeleteOne {
+	builder := c.Delete().Where(contentrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentRevision.
+func (c *ContentRevisionClient) Query() *ContentRevisionQuery {
+	return &ContentRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentRevision entity by its id.
+func (c *ContentRevisionClient) Get(ctx context.Context, id uuid.UUID) (*ContentRevision, error) {
+	return c.Query().Where(contentrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentRevisionClient) GetX(ctx context.Context, id uuid.UUID) *ContentRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryContent queries the content edge of a ContentRevision.
+func (c *ContentRevisionClient) QueryContent(cr *ContentRevision) *ContentQuery {
+	query := (&ContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentrevision.Table, contentrevision.FieldID, id),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentrevision.ContentTable, contentrevision.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContentRevisionClient) Hooks() []Hook {
+	return c.hooks.ContentRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentRevisionClient) Interceptors() []Interceptor {
+	return c.inters.ContentRevision
+}
+
+func (c *ContentRevisionClient) mutate(ctx context.Context, m *ContentRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContentRevision mutation op: %q", m.Op())
+	}
+}
+
+// CourseClient is a client for the Course schema.
+type CourseClient struct {
+	config
+}
+
+// NewCourseClient returns a client for the Course from the given config.
+func NewCourseClient(c config) *CourseClient {
+	return &CourseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `course.Hooks(f(g(h())))`.
+func (c *CourseClient) Use(hooks ...Hook) {
+	c.hooks.Course = append(c.hooks.Course, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `course.Intercept(f(g(h())))`.
+func (c *CourseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Course = append(c.inters.Course, interceptors...)
+}
+
+// Create returns a builder for creating a Course entity.
+func (c *CourseClient) Create() *CourseCreate {
+	mutation := newCourseMutation(c.config, OpCreate)
+	return &CourseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Course entities.
+func (c *CourseClient) CreateBulk(builders ...*CourseCreate) *CourseCreateBulk {
+	return &CourseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CourseClient) MapCreateBulk(slice any, setFunc func(*CourseCreate, int)) *CourseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CourseCreateBulk{err: fmt.Errorf("calling to CourseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CourseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CourseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Course.
+func (c *CourseClient) Update() *CourseUpdate {
+	mutation := newCourseMutation(c.config, OpUpdate)
+	return &CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CourseClient) UpdateOne(co *Course) *CourseUpdateOne {
+	mutation := newCourseMutation(c.config, OpUpdateOne, withCourse(co))
+	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CourseClient) UpdateOneID(id uuid.UUID) *CourseUpdateOne {
+	mutation := newCourseMutation(c.config, OpUpdateOne, withCourseID(id))
+	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Course.
+func (c *CourseClient) Delete() *CourseDelete {
+	mutation := newCourseMutation(c.config, OpDelete)
+	return &CourseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CourseClient) DeleteOne(co *Course) *CourseDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CourseClient) DeleteOneID(id uuid.UUID) *CourseDeleteOne {
+	builder := c.Delete().Where(course.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CourseDeleteOne{builder}
+}
+
+// Query returns a query builder for Course.
+func (c *CourseClient) Query() *CourseQuery {
+	return &CourseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCourse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Course entity by its id.
+func (c *CourseClient) Get(ctx context.Context, id uuid.UUID) (*Course, error) {
+	return c.Query().Where(course.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CourseClient) GetX(ctx context.Context, id uuid.UUID) *Course {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Course.
+func (c *CourseClient) QueryOrganization(co *Course) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, course.OrganizationTable, course.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModules queries the modules edge of a Course.
+func (c *CourseClient) QueryModules(co *Course) *ModuleQuery {
+	query := (&ModuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(module.Table, module.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.ModulesTable, course.ModulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnrollments queries the enrollments edge of a Course.
+func (c *CourseClient) QueryEnrollments(co *Course) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Course.
+func (c *CourseClient) QueryGroups(co *Course) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.GroupsTable, course.GroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CourseClient) Hooks() []Hook {
+	return c.hooks.Course
+}
+
+// Interceptors returns the client interceptors.
+func (c *CourseClient) Interceptors() []Interceptor {
+	return c.inters.Course
+}
+
+func (c *CourseClient) mutate(ctx context.Context, m *CourseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CourseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CourseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Course mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentClient is a client for the Enrollment schema.
+type EnrollmentClient struct {
+	config
+}
+
+// NewEnrollmentClient returns a client for the Enrollment from the given config.
+func NewEnrollmentClient(c config) *EnrollmentClient {
+	return &EnrollmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollment.Hooks(f(g(h())))`.
+func (c *EnrollmentClient) Use(hooks ...Hook) {
+	c.hooks.Enrollment = append(c.hooks.Enrollment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollment.Intercept(f(g(h())))`.
+func (c *EnrollmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Enrollment = append(c.inters.Enrollment, interceptors...)
+}
+
+// Create returns a builder for creating a Enrollment entity.
+func (c *EnrollmentClient) Create() *EnrollmentCreate {
+	mutation := newEnrollmentMutation(c.config, OpCreate)
+	return &EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Enrollment entities.
+func (c *EnrollmentClient) CreateBulk(builders ...*EnrollmentCreate) *EnrollmentCreateBulk {
+	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentClient) MapCreateBulk(slice any, setFunc func(*EnrollmentCreate, int)) *EnrollmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentCreateBulk{err: fmt.Errorf("calling to EnrollmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Enrollment.
+func (c *EnrollmentClient) Update() *EnrollmentUpdate {
+	mutation := newEnrollmentMutation(c.config, OpUpdate)
+	return &EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentClient) UpdateOne(e *Enrollment) *EnrollmentUpdateOne {
+	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollment(e))
+	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentClient) UpdateOneID(id uuid.UUID) *EnrollmentUpdateOne {
+	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollmentID(id))
+	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Enrollment.
+func (c *EnrollmentClient) Delete() *EnrollmentDelete {
 	mutation := newEnrollmentMutation(c.config, OpDelete)
 	return &EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnrollmentClient) DeleteOne(e *Enrollment) *EnrollmentDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *EnrollmentClient) DeleteOne(e *Enrollment) *EnrollmentDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentClient) DeleteOneID(id uuid.UUID) *EnrollmentDeleteOne {
+	builder := c.Delete().Where(enrollment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Enrollment.
+func (c *EnrollmentClient) Query() *EnrollmentQuery {
+	return &EnrollmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Enrollment entity by its id.
+func (c *EnrollmentClient) Get(ctx context.Context, id uuid.UUID) (*Enrollment, error) {
+	return c.Query().Where(enrollment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentClient) GetX(ctx context.Context, id uuid.UUID) *Enrollment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Enrollment.
+func (c *EnrollmentClient) QueryOrganization(e *Enrollment) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.OrganizationTable, enrollment.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a Enrollment.
+func (c *EnrollmentClient) QueryCourse(e *Enrollment) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.CourseTable, enrollment.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Enrollment.
+func (c *EnrollmentClient) QueryUser(e *Enrollment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.UserTable, enrollment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a Enrollment.
+func (c *EnrollmentClient) QueryGroup(e *Enrollment) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.GroupTable, enrollment.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProgressEntries queries the progress_entries edge of a Enrollment.
+func (c *EnrollmentClient) QueryProgressEntries(e *Enrollment) *ModuleProgressQuery {
+	query := (&ModuleProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(moduleprogress.Table, moduleprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.ProgressEntriesTable, enrollment.ProgressEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentClient) Hooks() []Hook {
+	return c.hooks.Enrollment
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentClient) Interceptors() []Interceptor {
+	return c.inters.Enrollment
+}
+
+func (c *EnrollmentClient) mutate(ctx context.Context, m *EnrollmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Enrollment mutation op: %q", m.Op())
+	}
+}
+
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
+	config
+}
+
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupClient) UpdateOneID(id uuid.UUID) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupClient) DeleteOneID(id uuid.UUID) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id uuid.UUID) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupClient) GetX(ctx context.Context, id uuid.UUID) *Group {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Group.
+func (c *GroupClient) QueryOrganization(gr *Group) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, group.OrganizationTable, group.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a Group.
+func (c *GroupClient) QueryCourse(gr *Group) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, group.CourseTable, group.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnrollments queries the enrollments edge of a Group.
+func (c *GroupClient) QueryEnrollments(gr *Group) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.EnrollmentsTable, group.EnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Group.
+func (c *GroupClient) QueryMembers(gr *Group) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, group.MembersTable, group.MembersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// ModuleClient is a client for the Module schema.
+type ModuleClient struct {
+	config
+}
+
+// NewModuleClient returns a client for the Module from the given config.
+func NewModuleClient(c config) *ModuleClient {
+	return &ModuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `module.Hooks(f(g(h())))`.
+func (c *ModuleClient) Use(hooks ...Hook) {
+	c.hooks.Module = append(c.hooks.Module, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `module.Intercept(f(g(h())))`.
+func (c *ModuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Module = append(c.inters.Module, interceptors...)
+}
+
+// Create returns a builder for creating a Module entity.
+func (c *ModuleClient) Create() *ModuleCreate {
+	mutation := newModuleMutation(c.config, OpCreate)
+	return &ModuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Module entities.
+func (c *ModuleClient) CreateBulk(builders ...*ModuleCreate) *ModuleCreateBulk {
+	return &ModuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModuleClient) MapCreateBulk(slice any, setFunc func(*ModuleCreate, int)) *ModuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModuleCreateBulk{err: fmt.Errorf("calling to ModuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Module.
+func (c *ModuleClient) Update() *ModuleUpdate {
+	mutation := newModuleMutation(c.config, OpUpdate)
+	return &ModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModuleClient) UpdateOne(m *Module) *ModuleUpdateOne {
+	mutation := newModuleMutation(c.config, OpUpdateOne, withModule(m))
+	return &ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModuleClient) UpdateOneID(id uuid.UUID) *ModuleUpdateOne {
+	mutation := newModuleMutation(c.config, OpUpdateOne, withModuleID(id))
+	return &ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Module.
+func (c *ModuleClient) Delete() *ModuleDelete {
+	mutation := newModuleMutation(c.config, OpDelete)
+	return &ModuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModuleClient) DeleteOne(m *Module) *ModuleDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModuleClient) DeleteOneID(id uuid.UUID) *ModuleDeleteOne {
+	builder := c.Delete().Where(module.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModuleDeleteOne{builder}
+}
+
+// Query returns a query builder for Module.
+func (c *ModuleClient) Query() *ModuleQuery {
+	return &ModuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Module entity by its id.
+func (c *ModuleClient) Get(ctx context.Context, id uuid.UUID) (*Module, error) {
+	return c.Query().Where(module.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModuleClient) GetX(ctx context.Context, id uuid.UUID) *Module {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a Module.
+func (c *ModuleClient) QueryCourse(m *Module) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(module.Table, module.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, module.CourseTable, module.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContent queries the content edge of a Module.
+func (c *ModuleClient) QueryContent(m *Module) *ContentQuery {
+	query := (&ContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(module.Table, module.FieldID, id),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, module.ContentTable, module.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProgressEntries queries the progress_entries edge of a Module.
+func (c *ModuleClient) QueryProgressEntries(m *Module) *ModuleProgressQuery {
+	query := (&ModuleProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(module.Table, module.FieldID, id),
+			sqlgraph.To(moduleprogress.Table, moduleprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, module.ProgressEntriesTable, module.ProgressEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModuleClient) Hooks() []Hook {
+	return c.hooks.Module
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModuleClient) Interceptors() []Interceptor {
+	return c.inters.Module
+}
+
+func (c *ModuleClient) mutate(ctx context.Context, m *ModuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Module mutation op: %q", m.Op())
+	}
+}
+
+// ModuleProgressClient is a client for the ModuleProgress schema.
+type ModuleProgressClient struct {
+	config
+}
+
+// NewModuleProgressClient returns a client for the ModuleProgress from the given config.
+func NewModuleProgressClient(c config) *ModuleProgressClient {
+	return &ModuleProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moduleprogress.Hooks(f(g(h())))`.
+func (c *ModuleProgressClient) Use(hooks ...Hook) {
+	c.hooks.ModuleProgress = append(c.hooks.ModuleProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moduleprogress.Intercept(f(g(h())))`.
+func (c *ModuleProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModuleProgress = append(c.inters.ModuleProgress, interceptors...)
+}
+
+// Create returns a builder for creating a ModuleProgress entity.
+func (c *ModuleProgressClient) Create() *ModuleProgressCreate {
+	mutation := newModuleProgressMutation(c.config, OpCreate)
+	return &ModuleProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModuleProgress entities.
+func (c *ModuleProgressClient) CreateBulk(builders ...*ModuleProgressCreate) *ModuleProgressCreateBulk {
+	return &ModuleProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModuleProgressClient) MapCreateBulk(slice any, setFunc func(*ModuleProgressCreate, int)) *ModuleProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModuleProgressCreateBulk{err: fmt.Errorf("calling to ModuleProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModuleProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModuleProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModuleProgress.
+func (c *ModuleProgressClient) Update() *ModuleProgressUpdate {
+	mutation := newModuleProgressMutation(c.config, OpUpdate)
+	return &ModuleProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModuleProgressClient) UpdateOne(mp *ModuleProgress) *ModuleProgressUpdateOne {
+	mutation := newModuleProgressMutation(c.config, OpUpdateOne, withModuleProgress(mp))
+	return &ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModuleProgressClient) UpdateOneID(id uuid.UUID) *ModuleProgressUpdateOne {
+	mutation := newModuleProgressMutation(c.config, OpUpdateOne, withModuleProgressID(id))
+	return &ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModuleProgress.
+func (c *ModuleProgressClient) Delete() *ModuleProgressDelete {
+	mutation := newModuleProgressMutation(c.config, OpDelete)
+	return &ModuleProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModuleProgressClient) DeleteOne(mp *ModuleProgress) *ModuleProgressDeleteOne {
+	return c.DeleteOneID(mp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EnrollmentClient) DeleteOneID(id uuid.UUID) *EnrollmentDeleteOne {
-	builder := c.Delete().Where(enrollment.ID(id))
+func (c *ModuleProgressClient) DeleteOneID(id uuid.UUID) *ModuleProgressDeleteOne {
+	builder := c.Delete().Where(moduleprogress.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EnrollmentDeleteOne{builder}
+	return &ModuleProgressDeleteOne{builder}
 }
 
-// Query returns a query builder for Enrollment.
-func (c *EnrollmentClient) Query() *EnrollmentQuery {
-	return &EnrollmentQuery{
+// Query returns a query builder for ModuleProgress.
+func (c *ModuleProgressClient) Query() *ModuleProgressQuery {
+	return &ModuleProgressQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEnrollment},
+		ctx:    &QueryContext{Type: TypeModuleProgress},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Enrollment entity by its id.
-func (c *EnrollmentClient) Get(ctx context.Context, id uuid.UUID) (*Enrollment, error) {
-	return c.Query().Where(enrollment.ID(id)).Only(ctx)
+// Get returns a ModuleProgress entity by its id.
+func (c *ModuleProgressClient) Get(ctx context.Context, id uuid.UUID) (*ModuleProgress, error) {
+	return c.Query().Where(moduleprogress.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EnrollmentClient) GetX(ctx context.Context, id uuid.UUID) *Enrollment {
+func (c *ModuleProgressClient) GetX(ctx context.Context, id uuid.UUID) *ModuleProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnrollment queries the enrollment edge of a ModuleProgress.
+func (c *ModuleProgressClient) QueryEnrollment(mp *ModuleProgress) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moduleprogress.Table, moduleprogress.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moduleprogress.EnrollmentTable, moduleprogress.EnrollmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModule queries the module edge of a ModuleProgress.
+func (c *ModuleProgressClient) QueryModule(mp *ModuleProgress) *ModuleQuery {
+	query := (&ModuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moduleprogress.Table, moduleprogress.FieldID, id),
+			sqlgraph.To(module.Table, module.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moduleprogress.ModuleTable, moduleprogress.ModuleColumn),
+		)
+		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModuleProgressClient) Hooks() []Hook {
+	return c.hooks.ModuleProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModuleProgressClient) Interceptors() []Interceptor {
+	return c.inters.ModuleProgress
+}
+
+func (c *ModuleProgressClient) mutate(ctx context.Context, m *ModuleProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModuleProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModuleProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModuleProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModuleProgress mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -735,212 +2197,260 @@ func (c *EnrollmentClient) GetX(ctx context.Context, id uuid.UUID) *Enrollment {
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a Enrollment.
-func (c *EnrollmentClient) QueryOrganization(e *Enrollment) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a Organization.
+func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContents queries the contents edge of a Organization.
+func (c *OrganizationClient) QueryContents(o *Organization) *ContentQuery {
+	query := (&ContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ContentsTable, organization.ContentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourses queries the courses edge of a Organization.
+func (c *OrganizationClient) QueryCourses(o *Organization) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CoursesTable, organization.CoursesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Organization.
+func (c *OrganizationClient) QueryGroups(o *Organization) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.OrganizationTable, enrollment.OrganizationColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.GroupsTable, organization.GroupsColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCourse queries the course edge of a Enrollment.
-func (c *EnrollmentClient) QueryCourse(e *Enrollment) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
+// QueryEnrollments queries the enrollments edge of a Organization.
+func (c *OrganizationClient) QueryEnrollments(o *Organization) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.CourseTable, enrollment.CourseColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.EnrollmentsTable, organization.EnrollmentsColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryUser queries the user edge of a Enrollment.
-func (c *EnrollmentClient) QueryUser(e *Enrollment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryScimTokens queries the scim_tokens edge of a Organization.
+func (c *OrganizationClient) QueryScimTokens(o *Organization) *ScimTokenQuery {
+	query := (&ScimTokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.UserTable, enrollment.UserColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(scimtoken.Table, scimtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ScimTokensTable, organization.ScimTokensColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryGroup queries the group edge of a Enrollment.
-func (c *EnrollmentClient) QueryGroup(e *Enrollment) *GroupQuery {
-	query := (&GroupClient{config: c.config}).Query()
+// QueryServiceAccounts queries the service_accounts edge of a Organization.
+func (c *OrganizationClient) QueryServiceAccounts(o *Organization) *ServiceAccountQuery {
+	query := (&ServiceAccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.GroupTable, enrollment.GroupColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(serviceaccount.Table, serviceaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ServiceAccountsTable, organization.ServiceAccountsColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProgressEntries queries the progress_entries edge of a Enrollment.
-func (c *EnrollmentClient) QueryProgressEntries(e *Enrollment) *ModuleProgressQuery {
-	query := (&ModuleProgressClient{config: c.config}).Query()
+// QueryPrivacyRequests queries the privacy_requests edge of a Organization.
+func (c *OrganizationClient) QueryPrivacyRequests(o *Organization) *PrivacyRequestQuery {
+	query := (&PrivacyRequestClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := o.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(moduleprogress.Table, moduleprogress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.ProgressEntriesTable, enrollment.ProgressEntriesColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(privacyrequest.Table, privacyrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.PrivacyRequestsTable, organization.PrivacyRequestsColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *EnrollmentClient) Hooks() []Hook {
-	return c.hooks.Enrollment
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
 }
 
 // Interceptors returns the client interceptors.
-func (c *EnrollmentClient) Interceptors() []Interceptor {
-	return c.inters.Enrollment
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
 }
 
-func (c *EnrollmentClient) mutate(ctx context.Context, m *EnrollmentMutation) (Value, error) {
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Enrollment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
 	}
 }
 
-// GroupClient is a client for the Group schema.
-type GroupClient struct {
+// PrivacyRequestClient is a client for the PrivacyRequest schema.
+type PrivacyRequestClient struct {
 	config
 }
 
-// NewGroupClient returns a client for the Group from the given config.
-func NewGroupClient(c config) *GroupClient {
-	return &GroupClient{config: c}
+// NewPrivacyRequestClient returns a client for the PrivacyRequest from the given config.
+func NewPrivacyRequestClient(c config) *PrivacyRequestClient {
+	return &PrivacyRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
-func (c *GroupClient) Use(hooks ...Hook) {
-	c.hooks.Group = append(c.hooks.Group, hooks...)
+// A call to `Use(f, g, h)` equals to `privacyrequest.Hooks(f(g(h())))`.
+func (c *PrivacyRequestClient) Use(hooks ...Hook) {
+	c.hooks.PrivacyRequest = append(c.hooks.PrivacyRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
-func (c *GroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Group = append(c.inters.Group, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `privacyrequest.Intercept(f(g(h())))`.
+func (c *PrivacyRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivacyRequest = append(c.inters.PrivacyRequest, interceptors...)
 }
 
-// Create returns a builder for creating a Group entity.
-func (c *GroupClient) Create() *GroupCreate {
-	mutation := newGroupMutation(c.config, OpCreate)
-	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PrivacyRequest entity.
+func (c *PrivacyRequestClient) Create() *PrivacyRequestCreate {
+	mutation := newPrivacyRequestMutation(c.config, OpCreate)
+	return &PrivacyRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Group entities.
-func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
-	return &GroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PrivacyRequest entities.
+func (c *PrivacyRequestClient) CreateBulk(builders ...*PrivacyRequestCreate) *PrivacyRequestCreateBulk {
+	return &PrivacyRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+func (c *PrivacyRequestClient) MapCreateBulk(slice any, setFunc func(*PrivacyRequestCreate, int)) *PrivacyRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PrivacyRequestCreateBulk{err: fmt.Errorf("calling to PrivacyRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupCreate, rv.Len())
+	builders := make([]*PrivacyRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupCreateBulk{config: c.config, builders: builders}
+	return &PrivacyRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Group.
-func (c *GroupClient) Update() *GroupUpdate {
-	mutation := newGroupMutation(c.config, OpUpdate)
-	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PrivacyRequest.
+func (c *PrivacyRequestClient) Update() *PrivacyRequestUpdate {
+	mutation := newPrivacyRequestMutation(c.config, OpUpdate)
+	return &PrivacyRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PrivacyRequestClient) UpdateOne(pr *PrivacyRequest) *PrivacyRequestUpdateOne {
+	mutation := newPrivacyRequestMutation(c.config, OpUpdateOne, withPrivacyRequest(pr))
+	return &PrivacyRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupClient) UpdateOneID(id uuid.UUID) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PrivacyRequestClient) UpdateOneID(id uuid.UUID) *PrivacyRequestUpdateOne {
+	mutation := newPrivacyRequestMutation(c.config, OpUpdateOne, withPrivacyRequestID(id))
+	return &PrivacyRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Group.
-func (c *GroupClient) Delete() *GroupDelete {
-	mutation := newGroupMutation(c.config, OpDelete)
-	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PrivacyRequest.
+func (c *PrivacyRequestClient) Delete() *PrivacyRequestDelete {
+	mutation := newPrivacyRequestMutation(c.config, OpDelete)
+	return &PrivacyRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
-	return c.DeleteOneID(gr.ID)
+func (c *PrivacyRequestClient) DeleteOne(pr *PrivacyRequest) *PrivacyRequestDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupClient) DeleteOneID(id uuid.UUID) *GroupDeleteOne {
-	builder := c.Delete().Where(group.ID(id))
+func (c *PrivacyRequestClient) DeleteOneID(id uuid.UUID) *PrivacyRequestDeleteOne {
+	builder := c.Delete().Where(privacyrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupDeleteOne{builder}
+	return &PrivacyRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for Group.
-func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{
+// Query returns a query builder for PrivacyRequest.
+func (c *PrivacyRequestClient) Query() *PrivacyRequestQuery {
+	return &PrivacyRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroup},
+		ctx:    &QueryContext{Type: TypePrivacyRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Group entity by its id.
-func (c *GroupClient) Get(ctx context.Context, id uuid.UUID) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+// Get returns a PrivacyRequest entity by its id.
+func (c *PrivacyRequestClient) Get(ctx context.Context, id uuid.UUID) (*PrivacyRequest, error) {
+	return c.Query().Where(privacyrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupClient) GetX(ctx context.Context, id uuid.UUID) *Group {
+func (c *PrivacyRequestClient) GetX(ctx context.Context, id uuid.UUID) *PrivacyRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -948,361 +2458,297 @@ func (c *GroupClient) GetX(ctx context.Context, id uuid.UUID) *Group {
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a Group.
-func (c *GroupClient) QueryOrganization(gr *Group) *OrganizationQuery {
+// QueryOrganization queries the organization edge of a PrivacyRequest.
+func (c *PrivacyRequestClient) QueryOrganization(pr *PrivacyRequest) *OrganizationQuery {
 	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gr.ID
+		id := pr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.From(privacyrequest.Table, privacyrequest.FieldID, id),
 			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, group.OrganizationTable, group.OrganizationColumn),
-		)
-		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCourse queries the course edge of a Group.
-func (c *GroupClient) QueryCourse(gr *Group) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(group.Table, group.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, group.CourseTable, group.CourseColumn),
-		)
-		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnrollments queries the enrollments edge of a Group.
-func (c *GroupClient) QueryEnrollments(gr *Group) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(group.Table, group.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, group.EnrollmentsTable, group.EnrollmentsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, privacyrequest.OrganizationTable, privacyrequest.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+func (c *PrivacyRequestClient) Hooks() []Hook {
+	return c.hooks.PrivacyRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupClient) Interceptors() []Interceptor {
-	return c.inters.Group
+func (c *PrivacyRequestClient) Interceptors() []Interceptor {
+	return c.inters.PrivacyRequest
 }
 
-func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+func (c *PrivacyRequestClient) mutate(ctx context.Context, m *PrivacyRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrivacyRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrivacyRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrivacyRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PrivacyRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PrivacyRequest mutation op: %q", m.Op())
 	}
 }
 
-// ModuleClient is a client for the Module schema.
-type ModuleClient struct {
+// SSOIdentityClient is a client for the SSOIdentity schema.
+type SSOIdentityClient struct {
 	config
 }
 
-// NewModuleClient returns a client for the Module from the given config.
-func NewModuleClient(c config) *ModuleClient {
-	return &ModuleClient{config: c}
+// NewSSOIdentityClient returns a client for the SSOIdentity from the given config.
+func NewSSOIdentityClient(c config) *SSOIdentityClient {
+	return &SSOIdentityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `module.Hooks(f(g(h())))`.
-func (c *ModuleClient) Use(hooks ...Hook) {
-	c.hooks.Module = append(c.hooks.Module, hooks...)
+// A call to `Use(f, g, h)` equals to `ssoidentity.Hooks(f(g(h())))`.
+func (c *SSOIdentityClient) Use(hooks ...Hook) {
+	c.hooks.SSOIdentity = append(c.hooks.SSOIdentity, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `module.Intercept(f(g(h())))`.
-func (c *ModuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Module = append(c.inters.Module, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ssoidentity.Intercept(f(g(h())))`.
+func (c *SSOIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SSOIdentity = append(c.inters.SSOIdentity, interceptors...)
 }
 
-// Create returns a builder for creating a Module entity.
-func (c *ModuleClient) Create() *ModuleCreate {
-	mutation := newModuleMutation(c.config, OpCreate)
-	return &ModuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SSOIdentity entity.
+func (c *SSOIdentityClient) Create() *SSOIdentityCreate {
+	mutation := newSSOIdentityMutation(c.config, OpCreate)
+	return &SSOIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Module entities.
-func (c *ModuleClient) CreateBulk(builders ...*ModuleCreate) *ModuleCreateBulk {
-	return &ModuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SSOIdentity entities.
+func (c *SSOIdentityClient) CreateBulk(builders ...*SSOIdentityCreate) *SSOIdentityCreateBulk {
+	return &SSOIdentityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModuleClient) MapCreateBulk(slice any, setFunc func(*ModuleCreate, int)) *ModuleCreateBulk {
+func (c *SSOIdentityClient) MapCreateBulk(slice any, setFunc func(*SSOIdentityCreate, int)) *SSOIdentityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModuleCreateBulk{err: fmt.Errorf("calling to ModuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SSOIdentityCreateBulk{err: fmt.Errorf("calling to SSOIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModuleCreate, rv.Len())
+	builders := make([]*SSOIdentityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModuleCreateBulk{config: c.config, builders: builders}
+	return &SSOIdentityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Module.
-func (c *ModuleClient) Update() *ModuleUpdate {
-	mutation := newModuleMutation(c.config, OpUpdate)
-	return &ModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SSOIdentity.
+func (c *SSOIdentityClient) Update() *SSOIdentityUpdate {
+	mutation := newSSOIdentityMutation(c.config, OpUpdate)
+	return &SSOIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModuleClient) UpdateOne(m *Module) *ModuleUpdateOne {
-	mutation := newModuleMutation(c.config, OpUpdateOne, withModule(m))
-	return &ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SSOIdentityClient) UpdateOne(si *SSOIdentity) *SSOIdentityUpdateOne {
+	mutation := newSSOIdentityMutation(c.config, OpUpdateOne, withSSOIdentity(si))
+	return &SSOIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModuleClient) UpdateOneID(id uuid.UUID) *ModuleUpdateOne {
-	mutation := newModuleMutation(c.config, OpUpdateOne, withModuleID(id))
-	return &ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SSOIdentityClient) UpdateOneID(id uuid.UUID) *SSOIdentityUpdateOne {
+	mutation := newSSOIdentityMutation(c.config, OpUpdateOne, withSSOIdentityID(id))
+	return &SSOIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Module.
-func (c *ModuleClient) Delete() *ModuleDelete {
-	mutation := newModuleMutation(c.config, OpDelete)
-	return &ModuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SSOIdentity.
+func (c *SSOIdentityClient) Delete() *SSOIdentityDelete {
+	mutation := newSSOIdentityMutation(c.config, OpDelete)
+	return &SSOIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ModuleClient) DeleteOne(m *Module) *ModuleDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *SSOIdentityClient) DeleteOne(si *SSOIdentity) *SSOIdentityDeleteOne {
+	return c.DeleteOneID(si.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModuleClient) DeleteOneID(id uuid.UUID) *ModuleDeleteOne {
-	builder := c.Delete().Where(module.ID(id))
+func (c *SSOIdentityClient) DeleteOneID(id uuid.UUID) *SSOIdentityDeleteOne {
+	builder := c.Delete().Where(ssoidentity.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ModuleDeleteOne{builder}
+	return &SSOIdentityDeleteOne{builder}
 }
 
-// Query returns a query builder for Module.
-func (c *ModuleClient) Query() *ModuleQuery {
-	return &ModuleQuery{
+// Query returns a query builder for SSOIdentity.
+func (c *SSOIdentityClient) Query() *SSOIdentityQuery {
+	return &SSOIdentityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeModule},
+		ctx:    &QueryContext{Type: TypeSSOIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Module entity by its id.
-func (c *ModuleClient) Get(ctx context.Context, id uuid.UUID) (*Module, error) {
-	return c.Query().Where(module.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *ModuleClient) GetX(ctx context.Context, id uuid.UUID) *Module {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryCourse queries the course edge of a Module.
-func (c *ModuleClient) QueryCourse(m *Module) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(module.Table, module.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, module.CourseTable, module.CourseColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryContent queries the content edge of a Module.
-func (c *ModuleClient) QueryContent(m *Module) *ContentQuery {
-	query := (&ContentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(module.Table, module.FieldID, id),
-			sqlgraph.To(content.Table, content.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, module.ContentTable, module.ContentColumn),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a SSOIdentity entity by its id.
+func (c *SSOIdentityClient) Get(ctx context.Context, id uuid.UUID) (*SSOIdentity, error) {
+	return c.Query().Where(ssoidentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SSOIdentityClient) GetX(ctx context.Context, id uuid.UUID) *SSOIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryProgressEntries queries the progress_entries edge of a Module.
-func (c *ModuleClient) QueryProgressEntries(m *Module) *ModuleProgressQuery {
-	query := (&ModuleProgressClient{config: c.config}).Query()
+// QueryUser queries the user edge of a SSOIdentity.
+func (c *SSOIdentityClient) QueryUser(si *SSOIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := si.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(module.Table, module.FieldID, id),
-			sqlgraph.To(moduleprogress.Table, moduleprogress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, module.ProgressEntriesTable, module.ProgressEntriesColumn),
+			sqlgraph.From(ssoidentity.Table, ssoidentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ssoidentity.UserTable, ssoidentity.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ModuleClient) Hooks() []Hook {
-	return c.hooks.Module
+func (c *SSOIdentityClient) Hooks() []Hook {
+	return c.hooks.SSOIdentity
 }
 
 // Interceptors returns the client interceptors.
-func (c *ModuleClient) Interceptors() []Interceptor {
-	return c.inters.Module
+func (c *SSOIdentityClient) Interceptors() []Interceptor {
+	return c.inters.SSOIdentity
 }
 
-func (c *ModuleClient) mutate(ctx context.Context, m *ModuleMutation) (Value, error) {
+func (c *SSOIdentityClient) mutate(ctx context.Context, m *SSOIdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ModuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SSOIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SSOIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SSOIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ModuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SSOIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Module mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SSOIdentity mutation op: %q", m.Op())
 	}
 }
 
-// ModuleProgressClient is a client for the ModuleProgress schema.
-type ModuleProgressClient struct {
+// SamlAssertionClient is a client for the SamlAssertion schema.
+type SamlAssertionClient struct {
 	config
 }
 
-// NewModuleProgressClient returns a client for the ModuleProgress from the given config.
-func NewModuleProgressClient(c config) *ModuleProgressClient {
-	return &ModuleProgressClient{config: c}
+// NewSamlAssertionClient returns a client for the SamlAssertion from the given config.
+func NewSamlAssertionClient(c config) *SamlAssertionClient {
+	return &SamlAssertionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `moduleprogress.Hooks(f(g(h())))`.
-func (c *ModuleProgressClient) Use(hooks ...Hook) {
-	c.hooks.ModuleProgress = append(c.hooks.ModuleProgress, hooks...)
+// A call to `Use(f, g, h)` equals to `samlassertion.Hooks(f(g(h())))`.
+func (c *SamlAssertionClient) Use(hooks ...Hook) {
+	c.hooks.SamlAssertion = append(c.hooks.SamlAssertion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `moduleprogress.Intercept(f(g(h())))`.
-func (c *ModuleProgressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ModuleProgress = append(c.inters.ModuleProgress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `samlassertion.Intercept(f(g(h())))`.
+func (c *SamlAssertionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SamlAssertion = append(c.inters.SamlAssertion, interceptors...)
 }
 
-// Create returns a builder for creating a ModuleProgress entity.
-func (c *ModuleProgressClient) Create() *ModuleProgressCreate {
-	mutation := newModuleProgressMutation(c.config, OpCreate)
-	return &ModuleProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SamlAssertion entity.
+func (c *SamlAssertionClient) Create() *SamlAssertionCreate {
+	mutation := newSamlAssertionMutation(c.config, OpCreate)
+	return &SamlAssertionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ModuleProgress entities.
-func (c *ModuleProgressClient) CreateBulk(builders ...*ModuleProgressCreate) *ModuleProgressCreateBulk {
-	return &ModuleProgressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SamlAssertion entities.
+func (c *SamlAssertionClient) CreateBulk(builders ...*SamlAssertionCreate) *SamlAssertionCreateBulk {
+	return &SamlAssertionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModuleProgressClient) MapCreateBulk(slice any, setFunc func(*ModuleProgressCreate, int)) *ModuleProgressCreateBulk {
+func (c *SamlAssertionClient) MapCreateBulk(slice any, setFunc func(*SamlAssertionCreate, int)) *SamlAssertionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModuleProgressCreateBulk{err: fmt.Errorf("calling to ModuleProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SamlAssertionCreateBulk{err: fmt.Errorf("calling to SamlAssertionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModuleProgressCreate, rv.Len())
+	builders := make([]*SamlAssertionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModuleProgressCreateBulk{config: c.config, builders: builders}
+	return &SamlAssertionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ModuleProgress.
-func (c *ModuleProgressClient) Update() *ModuleProgressUpdate {
-	mutation := newModuleProgressMutation(c.config, OpUpdate)
-	return &ModuleProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SamlAssertion.
+func (c *SamlAssertionClient) Update() *SamlAssertionUpdate {
+	mutation := newSamlAssertionMutation(c.config, OpUpdate)
+	return &SamlAssertionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModuleProgressClient) UpdateOne(mp *ModuleProgress) *ModuleProgressUpdateOne {
-	mutation := newModuleProgressMutation(c.config, OpUpdateOne, withModuleProgress(mp))
-	return &ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlAssertionClient) UpdateOne(sa *SamlAssertion) *SamlAssertionUpdateOne {
+	mutation := newSamlAssertionMutation(c.config, OpUpdateOne, withSamlAssertion(sa))
+	return &SamlAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModuleProgressClient) UpdateOneID(id uuid.UUID) *ModuleProgressUpdateOne {
-	mutation := newModuleProgressMutation(c.config, OpUpdateOne, withModuleProgressID(id))
-	return &ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlAssertionClient) UpdateOneID(id uuid.UUID) *SamlAssertionUpdateOne {
+	mutation := newSamlAssertionMutation(c.config, OpUpdateOne, withSamlAssertionID(id))
+	return &SamlAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ModuleProgress.
-func (c *ModuleProgressClient) Delete() *ModuleProgressDelete {
-	mutation := newModuleProgressMutation(c.config, OpDelete)
-	return &ModuleProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SamlAssertion.
+func (c *SamlAssertionClient) Delete() *SamlAssertionDelete {
+	mutation := newSamlAssertionMutation(c.config, OpDelete)
+	return &SamlAssertionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ModuleProgressClient) DeleteOne(mp *ModuleProgress) *ModuleProgressDeleteOne {
-	return c.DeleteOneID(mp.ID)
+func (c *SamlAssertionClient) DeleteOne(sa *SamlAssertion) *SamlAssertionDeleteOne {
+	return c.DeleteOneID(sa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModuleProgressClient) DeleteOneID(id uuid.UUID) *ModuleProgressDeleteOne {
-	builder := c.Delete().Where(moduleprogress.ID(id))
+func (c *SamlAssertionClient) DeleteOneID(id uuid.UUID) *SamlAssertionDeleteOne {
+	builder := c.Delete().Where(samlassertion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ModuleProgressDeleteOne{builder}
+	return &SamlAssertionDeleteOne{builder}
 }
 
-// Query returns a query builder for ModuleProgress.
-func (c *ModuleProgressClient) Query() *ModuleProgressQuery {
-	return &ModuleProgressQuery{
+// Query returns a query builder for SamlAssertion.
+func (c *SamlAssertionClient) Query() *SamlAssertionQuery {
+	return &SamlAssertionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeModuleProgress},
+		ctx:    &QueryContext{Type: TypeSamlAssertion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ModuleProgress entity by its id.
-func (c *ModuleProgressClient) Get(ctx context.Context, id uuid.UUID) (*ModuleProgress, error) {
-	return c.Query().Where(moduleprogress.ID(id)).Only(ctx)
+// Get returns a SamlAssertion entity by its id.
+func (c *SamlAssertionClient) Get(ctx context.Context, id uuid.UUID) (*SamlAssertion, error) {
+	return c.Query().Where(samlassertion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ModuleProgressClient) GetX(ctx context.Context, id uuid.UUID) *ModuleProgress {
+func (c *SamlAssertionClient) GetX(ctx context.Context, id uuid.UUID) *SamlAssertion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1310,164 +2756,132 @@ func (c *ModuleProgressClient) GetX(ctx context.Context, id uuid.UUID) *ModulePr
 	return obj
 }
 
-// QueryEnrollment queries the enrollment edge of a ModuleProgress.
-func (c *ModuleProgressClient) QueryEnrollment(mp *ModuleProgress) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(moduleprogress.Table, moduleprogress.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, moduleprogress.EnrollmentTable, moduleprogress.EnrollmentColumn),
-		)
-		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryModule queries the module edge of a ModuleProgress.
-func (c *ModuleProgressClient) QueryModule(mp *ModuleProgress) *ModuleQuery {
-	query := (&ModuleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(moduleprogress.Table, moduleprogress.FieldID, id),
-			sqlgraph.To(module.Table, module.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, moduleprogress.ModuleTable, moduleprogress.ModuleColumn),
-		)
-		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ModuleProgressClient) Hooks() []Hook {
-	return c.hooks.ModuleProgress
+func (c *SamlAssertionClient) Hooks() []Hook {
+	return c.hooks.SamlAssertion
 }
 
 // Interceptors returns the client interceptors.
-func (c *ModuleProgressClient) Interceptors() []Interceptor {
-	return c.inters.ModuleProgress
+func (c *SamlAssertionClient) Interceptors() []Interceptor {
+	return c.inters.SamlAssertion
 }
 
-func (c *ModuleProgressClient) mutate(ctx context.Context, m *ModuleProgressMutation) (Value, error) {
+func (c *SamlAssertionClient) mutate(ctx context.Context, m *SamlAssertionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ModuleProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAssertionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ModuleProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAssertionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ModuleProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ModuleProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SamlAssertionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ModuleProgress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SamlAssertion mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// ScimTokenClient is a client for the ScimToken schema.
+type ScimTokenClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewScimTokenClient returns a client for the ScimToken from the given config.
+func NewScimTokenClient(c config) *ScimTokenClient {
+	return &ScimTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `scimtoken.Hooks(f(g(h())))`.
+func (c *ScimTokenClient) Use(hooks ...Hook) {
+	c.hooks.ScimToken = append(c.hooks.ScimToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scimtoken.Intercept(f(g(h())))`.
+func (c *ScimTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScimToken = append(c.inters.ScimToken, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScimToken entity.
+func (c *ScimTokenClient) Create() *ScimTokenCreate {
+	mutation := newScimTokenMutation(c.config, OpCreate)
+	return &ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScimToken entities.
+func (c *ScimTokenClient) CreateBulk(builders ...*ScimTokenCreate) *ScimTokenCreateBulk {
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *ScimTokenClient) MapCreateBulk(slice any, setFunc func(*ScimTokenCreate, int)) *ScimTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScimTokenCreateBulk{err: fmt.Errorf("calling to ScimTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*ScimTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScimToken.
+func (c *ScimTokenClient) Update() *ScimTokenUpdate {
+	mutation := newScimTokenMutation(c.config, OpUpdate)
+	return &ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScimTokenClient) UpdateOne(st *ScimToken) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimToken(st))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScimTokenClient) UpdateOneID(id uuid.UUID) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimTokenID(id))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScimToken.
+func (c *ScimTokenClient) Delete() *ScimTokenDelete {
+	mutation := newScimTokenMutation(c.config, OpDelete)
+	return &ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
-	return c.DeleteOneID(o.ID)
+func (c *ScimTokenClient) DeleteOne(st *ScimToken) *ScimTokenDeleteOne {
+	return c.DeleteOneID(st.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *ScimTokenClient) DeleteOneID(id uuid.UUID) *ScimTokenDeleteOne {
+	builder := c.Delete().Where(scimtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &ScimTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for ScimToken.
+func (c *ScimTokenClient) Query() *ScimTokenQuery {
+	return &ScimTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganization},
+		ctx:    &QueryContext{Type: TypeScimToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a ScimToken entity by its id.
+func (c *ScimTokenClient) Get(ctx context.Context, id uuid.UUID) (*ScimToken, error) {
+	return c.Query().Where(scimtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
+func (c *ScimTokenClient) GetX(ctx context.Context, id uuid.UUID) *ScimToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1475,108 +2889,209 @@ func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organizati
 	return obj
 }
 
-// QueryUsers queries the users edge of a Organization.
-func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a ScimToken.
+func (c *ScimTokenClient) QueryOrganization(st *ScimToken) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := st.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
+			sqlgraph.From(scimtoken.Table, scimtoken.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scimtoken.OrganizationTable, scimtoken.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryContents queries the contents edge of a Organization.
-func (c *OrganizationClient) QueryContents(o *Organization) *ContentQuery {
-	query := (&ContentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(content.Table, content.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ContentsTable, organization.ContentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ScimTokenClient) Hooks() []Hook {
+	return c.hooks.ScimToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScimTokenClient) Interceptors() []Interceptor {
+	return c.inters.ScimToken
+}
+
+func (c *ScimTokenClient) mutate(ctx context.Context, m *ScimTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScimToken mutation op: %q", m.Op())
+	}
+}
+
+// ServiceAccountClient is a client for the ServiceAccount schema.
+type ServiceAccountClient struct {
+	config
+}
+
+// NewServiceAccountClient returns a client for the ServiceAccount from the given config.
+func NewServiceAccountClient(c config) *ServiceAccountClient {
+	return &ServiceAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `serviceaccount.Hooks(f(g(h())))`.
+func (c *ServiceAccountClient) Use(hooks ...Hook) {
+	c.hooks.ServiceAccount = append(c.hooks.ServiceAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `serviceaccount.Intercept(f(g(h())))`.
+func (c *ServiceAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ServiceAccount = append(c.inters.ServiceAccount, interceptors...)
+}
+
+// Create returns a builder for creating a ServiceAccount entity.
+func (c *ServiceAccountClient) Create() *ServiceAccountCreate {
+	mutation := newServiceAccountMutation(c.config, OpCreate)
+	return &ServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ServiceAccount entities.
+func (c *ServiceAccountClient) CreateBulk(builders ...*ServiceAccountCreate) *ServiceAccountCreateBulk {
+	return &ServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ServiceAccountClient) MapCreateBulk(slice any, setFunc func(*ServiceAccountCreate, int)) *ServiceAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ServiceAccountCreateBulk{err: fmt.Errorf("calling to ServiceAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ServiceAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ServiceAccount.
+func (c *ServiceAccountClient) Update() *ServiceAccountUpdate {
+	mutation := newServiceAccountMutation(c.config, OpUpdate)
+	return &ServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ServiceAccountClient) UpdateOne(sa *ServiceAccount) *ServiceAccountUpdateOne {
+	mutation := newServiceAccountMutation(c.config, OpUpdateOne, withServiceAccount(sa))
+	return &ServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ServiceAccountClient) UpdateOneID(id uuid.UUID) *ServiceAccountUpdateOne {
+	mutation := newServiceAccountMutation(c.config, OpUpdateOne, withServiceAccountID(id))
+	return &ServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ServiceAccount.
+func (c *ServiceAccountClient) Delete() *ServiceAccountDelete {
+	mutation := newServiceAccountMutation(c.config, OpDelete)
+	return &ServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ServiceAccountClient) DeleteOne(sa *ServiceAccount) *ServiceAccountDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ServiceAccountClient) DeleteOneID(id uuid.UUID) *ServiceAccountDeleteOne {
+	builder := c.Delete().Where(serviceaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ServiceAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for ServiceAccount.
+func (c *ServiceAccountClient) Query() *ServiceAccountQuery {
+	return &ServiceAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeServiceAccount},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryCourses queries the courses edge of a Organization.
-func (c *OrganizationClient) QueryCourses(o *Organization) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.CoursesTable, organization.CoursesColumn),
-		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ServiceAccount entity by its id.
+func (c *ServiceAccountClient) Get(ctx context.Context, id uuid.UUID) (*ServiceAccount, error) {
+	return c.Query().Where(serviceaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ServiceAccountClient) GetX(ctx context.Context, id uuid.UUID) *ServiceAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryGroups queries the groups edge of a Organization.
-func (c *OrganizationClient) QueryGroups(o *Organization) *GroupQuery {
-	query := (&GroupClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a ServiceAccount.
+func (c *ServiceAccountClient) QueryOrganization(sa *ServiceAccount) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := sa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.GroupsTable, organization.GroupsColumn),
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, serviceaccount.OrganizationTable, serviceaccount.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryEnrollments queries the enrollments edge of a Organization.
-func (c *OrganizationClient) QueryEnrollments(o *Organization) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
+// QueryAPIKeys queries the api_keys edge of a ServiceAccount.
+func (c *ServiceAccountClient) QueryAPIKeys(sa *ServiceAccount) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := sa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.EnrollmentsTable, organization.EnrollmentsColumn),
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serviceaccount.APIKeysTable, serviceaccount.APIKeysColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *ServiceAccountClient) Hooks() []Hook {
+	return c.hooks.ServiceAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *ServiceAccountClient) Interceptors() []Interceptor {
+	return c.inters.ServiceAccount
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *ServiceAccountClient) mutate(ctx context.Context, m *ServiceAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ServiceAccount mutation op: %q", m.Op())
 	}
 }
 
@@ -1720,6 +3235,54 @@ func (c *UserClient) QueryEnrollments(u *User) *EnrollmentQuery {
 	return query
 }
 
+// QueryGroups queries the groups edge of a User.
+func (c *UserClient) QueryGroups(u *User) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.GroupsTable, user.GroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebauthnCredentials queries the webauthn_credentials edge of a User.
+func (c *UserClient) QueryWebauthnCredentials(u *User) *WebauthnCredentialQuery {
+	query := (&WebauthnCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(webauthncredential.Table, webauthncredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebauthnCredentialsTable, user.WebauthnCredentialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySSOIdentities queries the sso_identities edge of a User.
+func (c *UserClient) QuerySSOIdentities(u *User) *SSOIdentityQuery {
+	query := (&SSOIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(ssoidentity.Table, ssoidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SSOIdentitiesTable, user.SSOIdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1745,14 +3308,324 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WebauthnCeremonyClient is a client for the WebauthnCeremony schema.
+type WebauthnCeremonyClient struct {
+	config
+}
+
+// NewWebauthnCeremonyClient returns a client for the WebauthnCeremony from the given config.
+func NewWebauthnCeremonyClient(c config) *WebauthnCeremonyClient {
+	return &WebauthnCeremonyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthnceremony.Hooks(f(g(h())))`.
+func (c *WebauthnCeremonyClient) Use(hooks ...Hook) {
+	c.hooks.WebauthnCeremony = append(c.hooks.WebauthnCeremony, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webauthnceremony.Intercept(f(g(h())))`.
+func (c *WebauthnCeremonyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebauthnCeremony = append(c.inters.WebauthnCeremony, interceptors...)
+}
+
+// Create returns a builder for creating a WebauthnCeremony entity.
+func (c *WebauthnCeremonyClient) Create() *WebauthnCeremonyCreate {
+	mutation := newWebauthnCeremonyMutation(c.config, OpCreate)
+	return &WebauthnCeremonyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebauthnCeremony entities.
+func (c *WebauthnCeremonyClient) CreateBulk(builders ...*WebauthnCeremonyCreate) *WebauthnCeremonyCreateBulk {
+	return &WebauthnCeremonyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebauthnCeremonyClient) MapCreateBulk(slice any, setFunc func(*WebauthnCeremonyCreate, int)) *WebauthnCeremonyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebauthnCeremonyCreateBulk{err: fmt.Errorf("calling to WebauthnCeremonyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebauthnCeremonyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebauthnCeremonyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebauthnCeremony.
+func (c *WebauthnCeremonyClient) Update() *WebauthnCeremonyUpdate {
+	mutation := newWebauthnCeremonyMutation(c.config, OpUpdate)
+	return &WebauthnCeremonyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebauthnCeremonyClient) UpdateOne(wc *WebauthnCeremony) *WebauthnCeremonyUpdateOne {
+	mutation := newWebauthnCeremonyMutation(c.config, OpUpdateOne, withWebauthnCeremony(wc))
+	return &WebauthnCeremonyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebauthnCeremonyClient) UpdateOneID(id uuid.UUID) *WebauthnCeremonyUpdateOne {
+	mutation := newWebauthnCeremonyMutation(c.config, OpUpdateOne, withWebauthnCeremonyID(id))
+	return &WebauthnCeremonyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebauthnCeremony.
+func (c *WebauthnCeremonyClient) Delete() *WebauthnCeremonyDelete {
+	mutation := newWebauthnCeremonyMutation(c.config, OpDelete)
+	return &WebauthnCeremonyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebauthnCeremonyClient) DeleteOne(wc *WebauthnCeremony) *WebauthnCeremonyDeleteOne {
+	return c.DeleteOneID(wc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebauthnCeremonyClient) DeleteOneID(id uuid.UUID) *WebauthnCeremonyDeleteOne {
+	builder := c.Delete().Where(webauthnceremony.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebauthnCeremonyDeleteOne{builder}
+}
+
+// Query returns a query builder for WebauthnCeremony.
+func (c *WebauthnCeremonyClient) Query() *WebauthnCeremonyQuery {
+	return &WebauthnCeremonyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebauthnCeremony},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebauthnCeremony entity by its id.
+func (c *WebauthnCeremonyClient) Get(ctx context.Context, id uuid.UUID) (*WebauthnCeremony, error) {
+	return c.Query().Where(webauthnceremony.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebauthnCeremonyClient) GetX(ctx context.Context, id uuid.UUID) *WebauthnCeremony {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebauthnCeremonyClient) Hooks() []Hook {
+	return c.hooks.WebauthnCeremony
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebauthnCeremonyClient) Interceptors() []Interceptor {
+	return c.inters.WebauthnCeremony
+}
+
+func (c *WebauthnCeremonyClient) mutate(ctx context.Context, m *WebauthnCeremonyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebauthnCeremonyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebauthnCeremonyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebauthnCeremonyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebauthnCeremonyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebauthnCeremony mutation op: %q", m.Op())
+	}
+}
+
+// WebauthnCredentialClient is a client for the WebauthnCredential schema.
+type WebauthnCredentialClient struct {
+	config
+}
+
+// NewWebauthnCredentialClient returns a client for the WebauthnCredential from the given config.
+func NewWebauthnCredentialClient(c config) *WebauthnCredentialClient {
+	return &WebauthnCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebauthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebauthnCredential = append(c.hooks.WebauthnCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webauthncredential.Intercept(f(g(h())))`.
+func (c *WebauthnCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebauthnCredential = append(c.inters.WebauthnCredential, interceptors...)
+}
+
+// Create returns a builder for creating a WebauthnCredential entity.
+func (c *WebauthnCredentialClient) Create() *WebauthnCredentialCreate {
+	mutation := newWebauthnCredentialMutation(c.config, OpCreate)
+	return &WebauthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebauthnCredential entities.
+func (c *WebauthnCredentialClient) CreateBulk(builders ...*WebauthnCredentialCreate) *WebauthnCredentialCreateBulk {
+	return &WebauthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebauthnCredentialClient) MapCreateBulk(slice any, setFunc func(*WebauthnCredentialCreate, int)) *WebauthnCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebauthnCredentialCreateBulk{err: fmt.Errorf("calling to WebauthnCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebauthnCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebauthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Update() *WebauthnCredentialUpdate {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdate)
+	return &WebauthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebauthnCredentialClient) UpdateOne(wc *WebauthnCredential) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredential(wc))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebauthnCredentialClient) UpdateOneID(id uuid.UUID) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredentialID(id))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Delete() *WebauthnCredentialDelete {
+	mutation := newWebauthnCredentialMutation(c.config, OpDelete)
+	return &WebauthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebauthnCredentialClient) DeleteOne(wc *WebauthnCredential) *WebauthnCredentialDeleteOne {
+	return c.DeleteOneID(wc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebauthnCredentialClient) DeleteOneID(id uuid.UUID) *WebauthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebauthnCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Query() *WebauthnCredentialQuery {
+	return &WebauthnCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebauthnCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebauthnCredential entity by its id.
+func (c *WebauthnCredentialClient) Get(ctx context.Context, id uuid.UUID) (*WebauthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebauthnCredentialClient) GetX(ctx context.Context, id uuid.UUID) *WebauthnCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WebauthnCredential.
+func (c *WebauthnCredentialClient) QueryUser(wc *WebauthnCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webauthncredential.Table, webauthncredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webauthncredential.UserTable, webauthncredential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(wc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebauthnCredentialClient) Hooks() []Hook {
+	return c.hooks.WebauthnCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebauthnCredentialClient) Interceptors() []Interceptor {
+	return c.inters.WebauthnCredential
+}
+
+func (c *WebauthnCredentialClient) mutate(ctx context.Context, m *WebauthnCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebauthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebauthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebauthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebauthnCredential mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Content, Course, Enrollment, Group, Module, ModuleProgress, Organization,
-		User []ent.Hook
+		APIKey, Content, ContentDownload, ContentRevision, Course, Enrollment, Group,
+		IdempotencyKey, Module, ModuleProgress, Organization, PrivacyRequest,
+		SSOIdentity, SamlAssertion, ScimToken, ServiceAccount, User, WebauthnCeremony,
+		WebauthnCredential []ent.Hook
 	}
 	inters struct {
-		Content, Course, Enrollment, Group, Module, ModuleProgress, Organization,
-		User []ent.Interceptor
+		APIKey, Content, ContentDownload, ContentRevision, Course, Enrollment, Group,
+		IdempotencyKey, Module, ModuleProgress, Organization, PrivacyRequest,
+		SSOIdentity, SamlAssertion, ScimToken, ServiceAccount, User, WebauthnCeremony,
+		WebauthnCredential []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
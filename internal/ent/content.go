@@ -30,8 +30,20 @@ type Content struct {
 	SizeBytes int64 `json:"size_bytes,omitempty"`
 	// StorageKey holds the value of the "storage_key" field.
 	StorageKey string `json:"storage_key,omitempty"`
+	// CurrentRevision holds the value of the "current_revision" field.
+	CurrentRevision int `json:"current_revision,omitempty"`
+	// Etag holds the value of the "etag" field.
+	Etag string `json:"etag,omitempty"`
+	// ChecksumSha256 holds the value of the "checksum_sha256" field.
+	ChecksumSha256 string `json:"checksum_sha256,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// UploadID holds the value of the "upload_id" field.
+	UploadID *string `json:"upload_id,omitempty"`
+	// UploadPartSize holds the value of the "upload_part_size" field.
+	UploadPartSize int64 `json:"upload_part_size,omitempty"`
+	// UploadStartedAt holds the value of the "upload_started_at" field.
+	UploadStartedAt *time.Time `json:"upload_started_at,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -50,9 +62,19 @@ type ContentEdges struct {
 	Organization *Organization `json:"organization,omitempty"`
 	// Modules holds the value of the modules edge.
 	Modules []*Module `json:"modules,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*ContentRevision `json:"revisions,omitempty"`
+	// Downloads holds the value of the downloads edge.
+	Downloads []*ContentDownload `json:"downloads,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedModules   map[string][]*Module
+	namedRevisions map[string][]*ContentRevision
+	namedDownloads map[string][]*ContentDownload
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -75,6 +97,24 @@ func (e ContentEdges) ModulesOrErr() ([]*Module, error) {
 	return nil, &NotLoadedError{edge: "modules"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ContentEdges) RevisionsOrErr() ([]*ContentRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// DownloadsOrErr returns the Downloads value or an error if the edge
+// was not loaded in eager-loading.
+func (e ContentEdges) DownloadsOrErr() ([]*ContentDownload, error) {
+	if e.loadedTypes[3] {
+		return e.Downloads, nil
+	}
+	return nil, &NotLoadedError{edge: "downloads"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Content) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -82,11 +122,11 @@ func (*Content) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case content.FieldMetadata:
 			values[i] = new([]byte)
-		case content.FieldSizeBytes:
+		case content.FieldSizeBytes, content.FieldCurrentRevision, content.FieldUploadPartSize:
 			values[i] = new(sql.NullInt64)
-		case content.FieldName, content.FieldMimeType, content.FieldStorageKey, content.FieldStatus:
+		case content.FieldName, content.FieldMimeType, content.FieldStorageKey, content.FieldEtag, content.FieldChecksumSha256, content.FieldStatus, content.FieldUploadID:
 			values[i] = new(sql.NullString)
-		case content.FieldCreatedAt, content.FieldUpdatedAt:
+		case content.FieldUploadStartedAt, content.FieldCreatedAt, content.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case content.FieldID, content.FieldOrganizationID:
 			values[i] = new(uuid.UUID)
@@ -141,12 +181,50 @@ func (c *Content) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.StorageKey = value.String
 			}
+		case content.FieldCurrentRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_revision", values[i])
+			} else if value.Valid {
+				c.CurrentRevision = int(value.Int64)
+			}
+		case content.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				c.Etag = value.String
+			}
+		case content.FieldChecksumSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_sha256", values[i])
+			} else if value.Valid {
+				c.ChecksumSha256 = value.String
+			}
 		case content.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				c.Status = value.String
 			}
+		case content.FieldUploadID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_id", values[i])
+			} else if value.Valid {
+				c.UploadID = new(string)
+				*c.UploadID = value.String
+			}
+		case content.FieldUploadPartSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_part_size", values[i])
+			} else if value.Valid {
+				c.UploadPartSize = value.Int64
+			}
+		case content.FieldUploadStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_started_at", values[i])
+			} else if value.Valid {
+				c.UploadStartedAt = new(time.Time)
+				*c.UploadStartedAt = value.Time
+			}
 		case content.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -190,6 +268,16 @@ func (c *Content) QueryModules() *ModuleQuery {
 	return NewContentClient(c.config).QueryModules(c)
 }
 
+// QueryRevisions queries the "revisions" edge of the Content entity.
+func (c *Content) QueryRevisions() *ContentRevisionQuery {
+	return NewContentClient(c.config).QueryRevisions(c)
+}
+
+// QueryDownloads queries the "downloads" edge of the Content entity.
+func (c *Content) QueryDownloads() *ContentDownloadQuery {
+	return NewContentClient(c.config).QueryDownloads(c)
+}
+
 // Update returns a builder for updating this Content.
 // Note that you need to call Content.Unwrap() before calling this method if this Content
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -228,9 +316,31 @@ func (c *Content) String() string {
 	builder.WriteString("storage_key=")
 	builder.WriteString(c.StorageKey)
 	builder.WriteString(", ")
+	builder.WriteString("current_revision=")
+	builder.WriteString(fmt.Sprintf("%v", c.CurrentRevision))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(c.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_sha256=")
+	builder.WriteString(c.ChecksumSha256)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(c.Status)
 	builder.WriteString(", ")
+	if v := c.UploadID; v != nil {
+		builder.WriteString("upload_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("upload_part_size=")
+	builder.WriteString(fmt.Sprintf("%v", c.UploadPartSize))
+	builder.WriteString(", ")
+	if v := c.UploadStartedAt; v != nil {
+		builder.WriteString("upload_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", c.Metadata))
 	builder.WriteString(", ")
@@ -243,5 +353,77 @@ func (c *Content) String() string {
 	return builder.String()
 }
 
+// NamedModules returns the Modules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Content) NamedModules(name string) ([]*Module, error) {
+	if c.Edges.namedModules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedModules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Content) appendNamedModules(name string, edges ...*Module) {
+	if c.Edges.namedModules == nil {
+		c.Edges.namedModules = make(map[string][]*Module)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedModules[name] = []*Module{}
+	} else {
+		c.Edges.namedModules[name] = append(c.Edges.namedModules[name], edges...)
+	}
+}
+
+// NamedRevisions returns the Revisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Content) NamedRevisions(name string) ([]*ContentRevision, error) {
+	if c.Edges.namedRevisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedRevisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Content) appendNamedRevisions(name string, edges ...*ContentRevision) {
+	if c.Edges.namedRevisions == nil {
+		c.Edges.namedRevisions = make(map[string][]*ContentRevision)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedRevisions[name] = []*ContentRevision{}
+	} else {
+		c.Edges.namedRevisions[name] = append(c.Edges.namedRevisions[name], edges...)
+	}
+}
+
+// NamedDownloads returns the Downloads named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Content) NamedDownloads(name string) ([]*ContentDownload, error) {
+	if c.Edges.namedDownloads == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedDownloads[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Content) appendNamedDownloads(name string, edges ...*ContentDownload) {
+	if c.Edges.namedDownloads == nil {
+		c.Edges.namedDownloads = make(map[string][]*ContentDownload)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedDownloads[name] = []*ContentDownload{}
+	} else {
+		c.Edges.namedDownloads[name] = append(c.Edges.namedDownloads[name], edges...)
+	}
+}
+
 // Contents is a parsable slice of Content.
 type Contents []*Content
@@ -25,8 +25,20 @@ const (
 	FieldSizeBytes = "size_bytes"
 	// FieldStorageKey holds the string denoting the storage_key field in the database.
 	FieldStorageKey = "storage_key"
+	// FieldCurrentRevision holds the string denoting the current_revision field in the database.
+	FieldCurrentRevision = "current_revision"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
+	// FieldChecksumSha256 holds the string denoting the checksum_sha256 field in the database.
+	FieldChecksumSha256 = "checksum_sha256"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldUploadID holds the string denoting the upload_id field in the database.
+	FieldUploadID = "upload_id"
+	// FieldUploadPartSize holds the string denoting the upload_part_size field in the database.
+	FieldUploadPartSize = "upload_part_size"
+	// FieldUploadStartedAt holds the string denoting the upload_started_at field in the database.
+	FieldUploadStartedAt = "upload_started_at"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -37,6 +49,10 @@ const (
 	EdgeOrganization = "organization"
 	// EdgeModules holds the string denoting the modules edge name in mutations.
 	EdgeModules = "modules"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeDownloads holds the string denoting the downloads edge name in mutations.
+	EdgeDownloads = "downloads"
 	// Table holds the table name of the content in the database.
 	Table = "contents"
 	// OrganizationTable is the table that holds the organization relation/edge.
@@ -53,6 +69,20 @@ const (
 	ModulesInverseTable = "modules"
 	// ModulesColumn is the table column denoting the modules relation/edge.
 	ModulesColumn = "content_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "content_revisions"
+	// RevisionsInverseTable is the table name for the ContentRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "contentrevision" package.
+	RevisionsInverseTable = "content_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "content_id"
+	// DownloadsTable is the table that holds the downloads relation/edge.
+	DownloadsTable = "content_downloads"
+	// DownloadsInverseTable is the table name for the ContentDownload entity.
+	// It exists in this package in order to avoid circular dependency with the "contentdownload" package.
+	DownloadsInverseTable = "content_downloads"
+	// DownloadsColumn is the table column denoting the downloads relation/edge.
+	DownloadsColumn = "content_id"
 )
 
 // Columns holds all SQL columns for content fields.
@@ -63,7 +93,13 @@ var Columns = []string{
 	FieldMimeType,
 	FieldSizeBytes,
 	FieldStorageKey,
+	FieldCurrentRevision,
+	FieldEtag,
+	FieldChecksumSha256,
 	FieldStatus,
+	FieldUploadID,
+	FieldUploadPartSize,
+	FieldUploadStartedAt,
 	FieldMetadata,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -86,6 +122,8 @@ var (
 	MimeTypeValidator func(string) error
 	// StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
 	StorageKeyValidator func(string) error
+	// DefaultCurrentRevision holds the default value on creation for the "current_revision" field.
+	DefaultCurrentRevision int
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultMetadata holds the default value on creation for the "metadata" field.
@@ -133,11 +171,41 @@ func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
 }
 
+// ByCurrentRevision orders the results by the current_revision field.
+func ByCurrentRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentRevision, opts...).ToFunc()
+}
+
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
+// ByChecksumSha256 orders the results by the checksum_sha256 field.
+func ByChecksumSha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumSha256, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByUploadID orders the results by the upload_id field.
+func ByUploadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadID, opts...).ToFunc()
+}
+
+// ByUploadPartSize orders the results by the upload_part_size field.
+func ByUploadPartSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadPartSize, opts...).ToFunc()
+}
+
+// ByUploadStartedAt orders the results by the upload_started_at field.
+func ByUploadStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadStartedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -168,6 +236,34 @@ func ByModules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newModulesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDownloadsCount orders the results by downloads count.
+func ByDownloadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDownloadsStep(), opts...)
+	}
+}
+
+// ByDownloads orders the results by downloads terms.
+func ByDownloads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDownloadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrganizationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -182,3 +278,17 @@ func newModulesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ModulesTable, ModulesColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newDownloadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DownloadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DownloadsTable, DownloadsColumn),
+	)
+}
@@ -81,11 +81,41 @@ func StorageKey(v string) predicate.Content {
 	return predicate.Content(sql.FieldEQ(FieldStorageKey, v))
 }
 
+// CurrentRevision applies equality check predicate on the "current_revision" field. It's identical to CurrentRevisionEQ.
+func CurrentRevision(v int) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldCurrentRevision, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldEtag, v))
+}
+
+// ChecksumSha256 applies equality check predicate on the "checksum_sha256" field. It's identical to ChecksumSha256EQ.
+func ChecksumSha256(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Content {
 	return predicate.Content(sql.FieldEQ(FieldStatus, v))
 }
 
+// UploadID applies equality check predicate on the "upload_id" field. It's identical to UploadIDEQ.
+func UploadID(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadID, v))
+}
+
+// UploadPartSize applies equality check predicate on the "upload_part_size" field. It's identical to UploadPartSizeEQ.
+func UploadPartSize(v int64) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadPartSize, v))
+}
+
+// UploadStartedAt applies equality check predicate on the "upload_started_at" field. It's identical to UploadStartedAtEQ.
+func UploadStartedAt(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadStartedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Content {
 	return predicate.Content(sql.FieldEQ(FieldCreatedAt, v))
@@ -361,6 +391,196 @@ func StorageKeyContainsFold(v string) predicate.Content {
 	return predicate.Content(sql.FieldContainsFold(FieldStorageKey, v))
 }
 
+// CurrentRevisionEQ applies the EQ predicate on the "current_revision" field.
+func CurrentRevisionEQ(v int) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionNEQ applies the NEQ predicate on the "current_revision" field.
+func CurrentRevisionNEQ(v int) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionIn applies the In predicate on the "current_revision" field.
+func CurrentRevisionIn(vs ...int) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldCurrentRevision, vs...))
+}
+
+// CurrentRevisionNotIn applies the NotIn predicate on the "current_revision" field.
+func CurrentRevisionNotIn(vs ...int) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldCurrentRevision, vs...))
+}
+
+// CurrentRevisionGT applies the GT predicate on the "current_revision" field.
+func CurrentRevisionGT(v int) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionGTE applies the GTE predicate on the "current_revision" field.
+func CurrentRevisionGTE(v int) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionLT applies the LT predicate on the "current_revision" field.
+func CurrentRevisionLT(v int) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionLTE applies the LTE predicate on the "current_revision" field.
+func CurrentRevisionLTE(v int) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldCurrentRevision, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.Content {
+	return predicate.Content(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Content {
+	return predicate.Content(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Content {
+	return predicate.Content(sql.FieldNotNull(FieldEtag))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// ChecksumSha256EQ applies the EQ predicate on the "checksum_sha256" field.
+func ChecksumSha256EQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256NEQ applies the NEQ predicate on the "checksum_sha256" field.
+func ChecksumSha256NEQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256In applies the In predicate on the "checksum_sha256" field.
+func ChecksumSha256In(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256NotIn applies the NotIn predicate on the "checksum_sha256" field.
+func ChecksumSha256NotIn(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256GT applies the GT predicate on the "checksum_sha256" field.
+func ChecksumSha256GT(v string) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256GTE applies the GTE predicate on the "checksum_sha256" field.
+func ChecksumSha256GTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LT applies the LT predicate on the "checksum_sha256" field.
+func ChecksumSha256LT(v string) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LTE applies the LTE predicate on the "checksum_sha256" field.
+func ChecksumSha256LTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256Contains applies the Contains predicate on the "checksum_sha256" field.
+func ChecksumSha256Contains(v string) predicate.Content {
+	return predicate.Content(sql.FieldContains(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasPrefix applies the HasPrefix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasPrefix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasPrefix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasSuffix applies the HasSuffix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasSuffix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasSuffix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256IsNil applies the IsNil predicate on the "checksum_sha256" field.
+func ChecksumSha256IsNil() predicate.Content {
+	return predicate.Content(sql.FieldIsNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256NotNil applies the NotNil predicate on the "checksum_sha256" field.
+func ChecksumSha256NotNil() predicate.Content {
+	return predicate.Content(sql.FieldNotNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256EqualFold applies the EqualFold predicate on the "checksum_sha256" field.
+func ChecksumSha256EqualFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldEqualFold(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256ContainsFold applies the ContainsFold predicate on the "checksum_sha256" field.
+func ChecksumSha256ContainsFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldContainsFold(FieldChecksumSha256, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Content {
 	return predicate.Content(sql.FieldEQ(FieldStatus, v))
@@ -426,6 +646,181 @@ func StatusContainsFold(v string) predicate.Content {
 	return predicate.Content(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// UploadIDEQ applies the EQ predicate on the "upload_id" field.
+func UploadIDEQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadID, v))
+}
+
+// UploadIDNEQ applies the NEQ predicate on the "upload_id" field.
+func UploadIDNEQ(v string) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldUploadID, v))
+}
+
+// UploadIDIn applies the In predicate on the "upload_id" field.
+func UploadIDIn(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldUploadID, vs...))
+}
+
+// UploadIDNotIn applies the NotIn predicate on the "upload_id" field.
+func UploadIDNotIn(vs ...string) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldUploadID, vs...))
+}
+
+// UploadIDGT applies the GT predicate on the "upload_id" field.
+func UploadIDGT(v string) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldUploadID, v))
+}
+
+// UploadIDGTE applies the GTE predicate on the "upload_id" field.
+func UploadIDGTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldUploadID, v))
+}
+
+// UploadIDLT applies the LT predicate on the "upload_id" field.
+func UploadIDLT(v string) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldUploadID, v))
+}
+
+// UploadIDLTE applies the LTE predicate on the "upload_id" field.
+func UploadIDLTE(v string) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldUploadID, v))
+}
+
+// UploadIDContains applies the Contains predicate on the "upload_id" field.
+func UploadIDContains(v string) predicate.Content {
+	return predicate.Content(sql.FieldContains(FieldUploadID, v))
+}
+
+// UploadIDHasPrefix applies the HasPrefix predicate on the "upload_id" field.
+func UploadIDHasPrefix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasPrefix(FieldUploadID, v))
+}
+
+// UploadIDHasSuffix applies the HasSuffix predicate on the "upload_id" field.
+func UploadIDHasSuffix(v string) predicate.Content {
+	return predicate.Content(sql.FieldHasSuffix(FieldUploadID, v))
+}
+
+// UploadIDIsNil applies the IsNil predicate on the "upload_id" field.
+func UploadIDIsNil() predicate.Content {
+	return predicate.Content(sql.FieldIsNull(FieldUploadID))
+}
+
+// UploadIDNotNil applies the NotNil predicate on the "upload_id" field.
+func UploadIDNotNil() predicate.Content {
+	return predicate.Content(sql.FieldNotNull(FieldUploadID))
+}
+
+// UploadIDEqualFold applies the EqualFold predicate on the "upload_id" field.
+func UploadIDEqualFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldEqualFold(FieldUploadID, v))
+}
+
+// UploadIDContainsFold applies the ContainsFold predicate on the "upload_id" field.
+func UploadIDContainsFold(v string) predicate.Content {
+	return predicate.Content(sql.FieldContainsFold(FieldUploadID, v))
+}
+
+// UploadPartSizeEQ applies the EQ predicate on the "upload_part_size" field.
+func UploadPartSizeEQ(v int64) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeNEQ applies the NEQ predicate on the "upload_part_size" field.
+func UploadPartSizeNEQ(v int64) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeIn applies the In predicate on the "upload_part_size" field.
+func UploadPartSizeIn(vs ...int64) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldUploadPartSize, vs...))
+}
+
+// UploadPartSizeNotIn applies the NotIn predicate on the "upload_part_size" field.
+func UploadPartSizeNotIn(vs ...int64) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldUploadPartSize, vs...))
+}
+
+// UploadPartSizeGT applies the GT predicate on the "upload_part_size" field.
+func UploadPartSizeGT(v int64) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeGTE applies the GTE predicate on the "upload_part_size" field.
+func UploadPartSizeGTE(v int64) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeLT applies the LT predicate on the "upload_part_size" field.
+func UploadPartSizeLT(v int64) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeLTE applies the LTE predicate on the "upload_part_size" field.
+func UploadPartSizeLTE(v int64) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldUploadPartSize, v))
+}
+
+// UploadPartSizeIsNil applies the IsNil predicate on the "upload_part_size" field.
+func UploadPartSizeIsNil() predicate.Content {
+	return predicate.Content(sql.FieldIsNull(FieldUploadPartSize))
+}
+
+// UploadPartSizeNotNil applies the NotNil predicate on the "upload_part_size" field.
+func UploadPartSizeNotNil() predicate.Content {
+	return predicate.Content(sql.FieldNotNull(FieldUploadPartSize))
+}
+
+// UploadStartedAtEQ applies the EQ predicate on the "upload_started_at" field.
+func UploadStartedAtEQ(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldEQ(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtNEQ applies the NEQ predicate on the "upload_started_at" field.
+func UploadStartedAtNEQ(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldNEQ(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtIn applies the In predicate on the "upload_started_at" field.
+func UploadStartedAtIn(vs ...time.Time) predicate.Content {
+	return predicate.Content(sql.FieldIn(FieldUploadStartedAt, vs...))
+}
+
+// UploadStartedAtNotIn applies the NotIn predicate on the "upload_started_at" field.
+func UploadStartedAtNotIn(vs ...time.Time) predicate.Content {
+	return predicate.Content(sql.FieldNotIn(FieldUploadStartedAt, vs...))
+}
+
+// UploadStartedAtGT applies the GT predicate on the "upload_started_at" field.
+func UploadStartedAtGT(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldGT(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtGTE applies the GTE predicate on the "upload_started_at" field.
+func UploadStartedAtGTE(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldGTE(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtLT applies the LT predicate on the "upload_started_at" field.
+func UploadStartedAtLT(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldLT(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtLTE applies the LTE predicate on the "upload_started_at" field.
+func UploadStartedAtLTE(v time.Time) predicate.Content {
+	return predicate.Content(sql.FieldLTE(FieldUploadStartedAt, v))
+}
+
+// UploadStartedAtIsNil applies the IsNil predicate on the "upload_started_at" field.
+func UploadStartedAtIsNil() predicate.Content {
+	return predicate.Content(sql.FieldIsNull(FieldUploadStartedAt))
+}
+
+// UploadStartedAtNotNil applies the NotNil predicate on the "upload_started_at" field.
+func UploadStartedAtNotNil() predicate.Content {
+	return predicate.Content(sql.FieldNotNull(FieldUploadStartedAt))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Content {
 	return predicate.Content(sql.FieldIsNull(FieldMetadata))
@@ -562,6 +957,52 @@ func HasModulesWith(preds ...predicate.Module) predicate.Content {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Content {
+	return predicate.Content(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.ContentRevision) predicate.Content {
+	return predicate.Content(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDownloads applies the HasEdge predicate on the "downloads" edge.
+func HasDownloads() predicate.Content {
+	return predicate.Content(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DownloadsTable, DownloadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDownloadsWith applies the HasEdge predicate on the "downloads" edge with a given conditions (other predicates).
+func HasDownloadsWith(preds ...predicate.ContentDownload) predicate.Content {
+	return predicate.Content(func(s *sql.Selector) {
+		step := newDownloadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Content) predicate.Content {
 	return predicate.Content(sql.AndPredicates(predicates...))
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/organization"
 	"time"
@@ -61,6 +63,48 @@ func (cc *ContentCreate) SetStorageKey(s string) *ContentCreate {
 	return cc
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (cc *ContentCreate) SetCurrentRevision(i int) *ContentCreate {
+	cc.mutation.SetCurrentRevision(i)
+	return cc
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableCurrentRevision(i *int) *ContentCreate {
+	if i != nil {
+		cc.SetCurrentRevision(*i)
+	}
+	return cc
+}
+
+// SetEtag sets the "etag" field.
+func (cc *ContentCreate) SetEtag(s string) *ContentCreate {
+	cc.mutation.SetEtag(s)
+	return cc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableEtag(s *string) *ContentCreate {
+	if s != nil {
+		cc.SetEtag(*s)
+	}
+	return cc
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (cc *ContentCreate) SetChecksumSha256(s string) *ContentCreate {
+	cc.mutation.SetChecksumSha256(s)
+	return cc
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableChecksumSha256(s *string) *ContentCreate {
+	if s != nil {
+		cc.SetChecksumSha256(*s)
+	}
+	return cc
+}
+
 // SetStatus sets the "status" field.
 func (cc *ContentCreate) SetStatus(s string) *ContentCreate {
 	cc.mutation.SetStatus(s)
@@ -75,6 +119,48 @@ func (cc *ContentCreate) SetNillableStatus(s *string) *ContentCreate {
 	return cc
 }
 
+// SetUploadID sets the "upload_id" field.
+func (cc *ContentCreate) SetUploadID(s string) *ContentCreate {
+	cc.mutation.SetUploadID(s)
+	return cc
+}
+
+// SetNillableUploadID sets the "upload_id" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableUploadID(s *string) *ContentCreate {
+	if s != nil {
+		cc.SetUploadID(*s)
+	}
+	return cc
+}
+
+// SetUploadPartSize sets the "upload_part_size" field.
+func (cc *ContentCreate) SetUploadPartSize(i int64) *ContentCreate {
+	cc.mutation.SetUploadPartSize(i)
+	return cc
+}
+
+// SetNillableUploadPartSize sets the "upload_part_size" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableUploadPartSize(i *int64) *ContentCreate {
+	if i != nil {
+		cc.SetUploadPartSize(*i)
+	}
+	return cc
+}
+
+// SetUploadStartedAt sets the "upload_started_at" field.
+func (cc *ContentCreate) SetUploadStartedAt(t time.Time) *ContentCreate {
+	cc.mutation.SetUploadStartedAt(t)
+	return cc
+}
+
+// SetNillableUploadStartedAt sets the "upload_started_at" field if the given value is not nil.
+func (cc *ContentCreate) SetNillableUploadStartedAt(t *time.Time) *ContentCreate {
+	if t != nil {
+		cc.SetUploadStartedAt(*t)
+	}
+	return cc
+}
+
 // SetMetadata sets the "metadata" field.
 func (cc *ContentCreate) SetMetadata(m map[string]interface{}) *ContentCreate {
 	cc.mutation.SetMetadata(m)
@@ -143,6 +229,36 @@ func (cc *ContentCreate) AddModules(m ...*Module) *ContentCreate {
 	return cc.AddModuleIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the ContentRevision entity by IDs.
+func (cc *ContentCreate) AddRevisionIDs(ids ...uuid.UUID) *ContentCreate {
+	cc.mutation.AddRevisionIDs(ids...)
+	return cc
+}
+
+// AddRevisions adds the "revisions" edges to the ContentRevision entity.
+func (cc *ContentCreate) AddRevisions(c ...*ContentRevision) *ContentCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddRevisionIDs(ids...)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the ContentDownload entity by IDs.
+func (cc *ContentCreate) AddDownloadIDs(ids ...uuid.UUID) *ContentCreate {
+	cc.mutation.AddDownloadIDs(ids...)
+	return cc
+}
+
+// AddDownloads adds the "downloads" edges to the ContentDownload entity.
+func (cc *ContentCreate) AddDownloads(c ...*ContentDownload) *ContentCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddDownloadIDs(ids...)
+}
+
 // Mutation returns the ContentMutation object of the builder.
 func (cc *ContentCreate) Mutation() *ContentMutation {
 	return cc.mutation
@@ -178,6 +294,10 @@ func (cc *ContentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *ContentCreate) defaults() {
+	if _, ok := cc.mutation.CurrentRevision(); !ok {
+		v := content.DefaultCurrentRevision
+		cc.mutation.SetCurrentRevision(v)
+	}
 	if _, ok := cc.mutation.Status(); !ok {
 		v := content.DefaultStatus
 		cc.mutation.SetStatus(v)
@@ -229,6 +349,9 @@ func (cc *ContentCreate) check() error {
 			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Content.storage_key": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.CurrentRevision(); !ok {
+		return &ValidationError{Name: "current_revision", err: errors.New(`ent: missing required field "Content.current_revision"`)}
+	}
 	if _, ok := cc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Content.status"`)}
 	}
@@ -238,7 +361,7 @@ func (cc *ContentCreate) check() error {
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Content.updated_at"`)}
 	}
-	if _, ok := cc.mutation.OrganizationID(); !ok {
+	if len(cc.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Content.organization"`)}
 	}
 	return nil
@@ -292,10 +415,34 @@ func (cc *ContentCreate) createSpec() (*Content, *sqlgraph.CreateSpec) {
 		_spec.SetField(content.FieldStorageKey, field.TypeString, value)
 		_node.StorageKey = value
 	}
+	if value, ok := cc.mutation.CurrentRevision(); ok {
+		_spec.SetField(content.FieldCurrentRevision, field.TypeInt, value)
+		_node.CurrentRevision = value
+	}
+	if value, ok := cc.mutation.Etag(); ok {
+		_spec.SetField(content.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := cc.mutation.ChecksumSha256(); ok {
+		_spec.SetField(content.FieldChecksumSha256, field.TypeString, value)
+		_node.ChecksumSha256 = value
+	}
 	if value, ok := cc.mutation.Status(); ok {
 		_spec.SetField(content.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := cc.mutation.UploadID(); ok {
+		_spec.SetField(content.FieldUploadID, field.TypeString, value)
+		_node.UploadID = &value
+	}
+	if value, ok := cc.mutation.UploadPartSize(); ok {
+		_spec.SetField(content.FieldUploadPartSize, field.TypeInt64, value)
+		_node.UploadPartSize = value
+	}
+	if value, ok := cc.mutation.UploadStartedAt(); ok {
+		_spec.SetField(content.FieldUploadStartedAt, field.TypeTime, value)
+		_node.UploadStartedAt = &value
+	}
 	if value, ok := cc.mutation.Metadata(); ok {
 		_spec.SetField(content.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -341,6 +488,38 @@ func (cc *ContentCreate) createSpec() (*Content, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,11 +7,14 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -21,12 +24,19 @@ import (
 // ContentQuery is the builder for querying Content entities.
 type ContentQuery struct {
 	config
-	ctx              *QueryContext
-	order            []content.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Content
-	withOrganization *OrganizationQuery
-	withModules      *ModuleQuery
+	ctx                *QueryContext
+	order              []content.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Content
+	withOrganization   *OrganizationQuery
+	withModules        *ModuleQuery
+	withRevisions      *ContentRevisionQuery
+	withDownloads      *ContentDownloadQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*Content) error
+	withNamedModules   map[string]*ModuleQuery
+	withNamedRevisions map[string]*ContentRevisionQuery
+	withNamedDownloads map[string]*ContentDownloadQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,10 +117,54 @@ func (cq *ContentQuery) QueryModules() *ModuleQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (cq *ContentQuery) QueryRevisions() *ContentRevisionQuery {
+	query := (&ContentRevisionClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(content.Table, content.FieldID, selector),
+			sqlgraph.To(contentrevision.Table, contentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, content.RevisionsTable, content.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDownloads chains the current query on the "downloads" edge.
+func (cq *ContentQuery) QueryDownloads() *ContentDownloadQuery {
+	query := (&ContentDownloadClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(content.Table, content.FieldID, selector),
+			sqlgraph.To(contentdownload.Table, contentdownload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, content.DownloadsTable, content.DownloadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Content entity from the query.
 // Returns a *NotFoundError when no Content was found.
 func (cq *ContentQuery) First(ctx context.Context) (*Content, error) {
-	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, "First"))
+	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +187,7 @@ func (cq *ContentQuery) FirstX(ctx context.Context) *Content {
 // Returns a *NotFoundError when no Content ID was found.
 func (cq *ContentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, "FirstID")); err != nil {
+	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -156,7 +210,7 @@ func (cq *ContentQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one Content entity is found.
 // Returns a *NotFoundError when no Content entities are found.
 func (cq *ContentQuery) Only(ctx context.Context) (*Content, error) {
-	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, "Only"))
+	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +238,7 @@ func (cq *ContentQuery) OnlyX(ctx context.Context) *Content {
 // Returns a *NotFoundError when no entities are found.
 func (cq *ContentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, "OnlyID")); err != nil {
+	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -209,7 +263,7 @@ func (cq *ContentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of Contents.
 func (cq *ContentQuery) All(ctx context.Context) ([]*Content, error) {
-	ctx = setContextOp(ctx, cq.ctx, "All")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryAll)
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -231,7 +285,7 @@ func (cq *ContentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
 	if cq.ctx.Unique == nil && cq.path != nil {
 		cq.Unique(true)
 	}
-	ctx = setContextOp(ctx, cq.ctx, "IDs")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryIDs)
 	if err = cq.Select(content.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -249,7 +303,7 @@ func (cq *ContentQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (cq *ContentQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, cq.ctx, "Count")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryCount)
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -267,7 +321,7 @@ func (cq *ContentQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (cq *ContentQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, cq.ctx, "Exist")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryExist)
 	switch _, err := cq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -301,6 +355,8 @@ func (cq *ContentQuery) Clone() *ContentQuery {
 		predicates:       append([]predicate.Content{}, cq.predicates...),
 		withOrganization: cq.withOrganization.Clone(),
 		withModules:      cq.withModules.Clone(),
+		withRevisions:    cq.withRevisions.Clone(),
+		withDownloads:    cq.withDownloads.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -329,6 +385,28 @@ func (cq *ContentQuery) WithModules(opts ...func(*ModuleQuery)) *ContentQuery {
 	return cq
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ContentQuery) WithRevisions(opts ...func(*ContentRevisionQuery)) *ContentQuery {
+	query := (&ContentRevisionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRevisions = query
+	return cq
+}
+
+// WithDownloads tells the query-builder to eager-load the nodes that are connected to
+// the "downloads" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ContentQuery) WithDownloads(opts ...func(*ContentDownloadQuery)) *ContentQuery {
+	query := (&ContentDownloadClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withDownloads = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +485,11 @@ func (cq *ContentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cont
 	var (
 		nodes       = []*Content{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			cq.withOrganization != nil,
 			cq.withModules != nil,
+			cq.withRevisions != nil,
+			cq.withDownloads != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +501,9 @@ func (cq *ContentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cont
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -443,6 +526,46 @@ func (cq *ContentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cont
 			return nil, err
 		}
 	}
+	if query := cq.withRevisions; query != nil {
+		if err := cq.loadRevisions(ctx, query, nodes,
+			func(n *Content) { n.Edges.Revisions = []*ContentRevision{} },
+			func(n *Content, e *ContentRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withDownloads; query != nil {
+		if err := cq.loadDownloads(ctx, query, nodes,
+			func(n *Content) { n.Edges.Downloads = []*ContentDownload{} },
+			func(n *Content, e *ContentDownload) { n.Edges.Downloads = append(n.Edges.Downloads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedModules {
+		if err := cq.loadModules(ctx, query, nodes,
+			func(n *Content) { n.appendNamedModules(name) },
+			func(n *Content, e *Module) { n.appendNamedModules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedRevisions {
+		if err := cq.loadRevisions(ctx, query, nodes,
+			func(n *Content) { n.appendNamedRevisions(name) },
+			func(n *Content, e *ContentRevision) { n.appendNamedRevisions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedDownloads {
+		if err := cq.loadDownloads(ctx, query, nodes,
+			func(n *Content) { n.appendNamedDownloads(name) },
+			func(n *Content, e *ContentDownload) { n.appendNamedDownloads(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cq.loadTotal {
+		if err := cq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,9 +631,72 @@ func (cq *ContentQuery) loadModules(ctx context.Context, query *ModuleQuery, nod
 	}
 	return nil
 }
+func (cq *ContentQuery) loadRevisions(ctx context.Context, query *ContentRevisionQuery, nodes []*Content, init func(*Content), assign func(*Content, *ContentRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Content)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentrevision.FieldContentID)
+	}
+	query.Where(predicate.ContentRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(content.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ContentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "content_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *ContentQuery) loadDownloads(ctx context.Context, query *ContentDownloadQuery, nodes []*Content, init func(*Content), assign func(*Content, *ContentDownload)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Content)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentdownload.FieldContentID)
+	}
+	query.Where(predicate.ContentDownload(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(content.DownloadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ContentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "content_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *ContentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -593,6 +779,48 @@ func (cq *ContentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedModules tells the query-builder to eager-load the nodes that are connected to the "modules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *ContentQuery) WithNamedModules(name string, opts ...func(*ModuleQuery)) *ContentQuery {
+	query := (&ModuleClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedModules == nil {
+		cq.withNamedModules = make(map[string]*ModuleQuery)
+	}
+	cq.withNamedModules[name] = query
+	return cq
+}
+
+// WithNamedRevisions tells the query-builder to eager-load the nodes that are connected to the "revisions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *ContentQuery) WithNamedRevisions(name string, opts ...func(*ContentRevisionQuery)) *ContentQuery {
+	query := (&ContentRevisionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedRevisions == nil {
+		cq.withNamedRevisions = make(map[string]*ContentRevisionQuery)
+	}
+	cq.withNamedRevisions[name] = query
+	return cq
+}
+
+// WithNamedDownloads tells the query-builder to eager-load the nodes that are connected to the "downloads"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *ContentQuery) WithNamedDownloads(name string, opts ...func(*ContentDownloadQuery)) *ContentQuery {
+	query := (&ContentDownloadClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedDownloads == nil {
+		cq.withNamedDownloads = make(map[string]*ContentDownloadQuery)
+	}
+	cq.withNamedDownloads[name] = query
+	return cq
+}
+
 // ContentGroupBy is the group-by builder for Content entities.
 type ContentGroupBy struct {
 	selector
@@ -607,7 +835,7 @@ func (cgb *ContentGroupBy) Aggregate(fns ...AggregateFunc) *ContentGroupBy {
 
 // Scan applies the selector query and scans the result into the given value.
 func (cgb *ContentGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, cgb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, cgb.build.ctx, ent.OpQueryGroupBy)
 	if err := cgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -655,7 +883,7 @@ func (cs *ContentSelect) Aggregate(fns ...AggregateFunc) *ContentSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (cs *ContentSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, cs.ctx, "Select")
+	ctx = setContextOp(ctx, cs.ctx, ent.OpQuerySelect)
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
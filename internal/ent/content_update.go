@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
@@ -114,6 +116,67 @@ func (cu *ContentUpdate) SetNillableStorageKey(s *string) *ContentUpdate {
 	return cu
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (cu *ContentUpdate) SetCurrentRevision(i int) *ContentUpdate {
+	cu.mutation.ResetCurrentRevision()
+	cu.mutation.SetCurrentRevision(i)
+	return cu
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableCurrentRevision(i *int) *ContentUpdate {
+	if i != nil {
+		cu.SetCurrentRevision(*i)
+	}
+	return cu
+}
+
+// AddCurrentRevision adds i to the "current_revision" field.
+func (cu *ContentUpdate) AddCurrentRevision(i int) *ContentUpdate {
+	cu.mutation.AddCurrentRevision(i)
+	return cu
+}
+
+// SetEtag sets the "etag" field.
+func (cu *ContentUpdate) SetEtag(s string) *ContentUpdate {
+	cu.mutation.SetEtag(s)
+	return cu
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableEtag(s *string) *ContentUpdate {
+	if s != nil {
+		cu.SetEtag(*s)
+	}
+	return cu
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (cu *ContentUpdate) ClearEtag() *ContentUpdate {
+	cu.mutation.ClearEtag()
+	return cu
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (cu *ContentUpdate) SetChecksumSha256(s string) *ContentUpdate {
+	cu.mutation.SetChecksumSha256(s)
+	return cu
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableChecksumSha256(s *string) *ContentUpdate {
+	if s != nil {
+		cu.SetChecksumSha256(*s)
+	}
+	return cu
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (cu *ContentUpdate) ClearChecksumSha256() *ContentUpdate {
+	cu.mutation.ClearChecksumSha256()
+	return cu
+}
+
 // SetStatus sets the "status" field.
 func (cu *ContentUpdate) SetStatus(s string) *ContentUpdate {
 	cu.mutation.SetStatus(s)
@@ -128,6 +191,73 @@ func (cu *ContentUpdate) SetNillableStatus(s *string) *ContentUpdate {
 	return cu
 }
 
+// SetUploadID sets the "upload_id" field.
+func (cu *ContentUpdate) SetUploadID(s string) *ContentUpdate {
+	cu.mutation.SetUploadID(s)
+	return cu
+}
+
+// SetNillableUploadID sets the "upload_id" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableUploadID(s *string) *ContentUpdate {
+	if s != nil {
+		cu.SetUploadID(*s)
+	}
+	return cu
+}
+
+// ClearUploadID clears the value of the "upload_id" field.
+func (cu *ContentUpdate) ClearUploadID() *ContentUpdate {
+	cu.mutation.ClearUploadID()
+	return cu
+}
+
+// SetUploadPartSize sets the "upload_part_size" field.
+func (cu *ContentUpdate) SetUploadPartSize(i int64) *ContentUpdate {
+	cu.mutation.ResetUploadPartSize()
+	cu.mutation.SetUploadPartSize(i)
+	return cu
+}
+
+// SetNillableUploadPartSize sets the "upload_part_size" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableUploadPartSize(i *int64) *ContentUpdate {
+	if i != nil {
+		cu.SetUploadPartSize(*i)
+	}
+	return cu
+}
+
+// AddUploadPartSize adds i to the "upload_part_size" field.
+func (cu *ContentUpdate) AddUploadPartSize(i int64) *ContentUpdate {
+	cu.mutation.AddUploadPartSize(i)
+	return cu
+}
+
+// ClearUploadPartSize clears the value of the "upload_part_size" field.
+func (cu *ContentUpdate) ClearUploadPartSize() *ContentUpdate {
+	cu.mutation.ClearUploadPartSize()
+	return cu
+}
+
+// SetUploadStartedAt sets the "upload_started_at" field.
+func (cu *ContentUpdate) SetUploadStartedAt(t time.Time) *ContentUpdate {
+	cu.mutation.SetUploadStartedAt(t)
+	return cu
+}
+
+// SetNillableUploadStartedAt sets the "upload_started_at" field if the given value is not nil.
+func (cu *ContentUpdate) SetNillableUploadStartedAt(t *time.Time) *ContentUpdate {
+	if t != nil {
+		cu.SetUploadStartedAt(*t)
+	}
+	return cu
+}
+
+// ClearUploadStartedAt clears the value of the "upload_started_at" field.
+func (cu *ContentUpdate) ClearUploadStartedAt() *ContentUpdate {
+	cu.mutation.ClearUploadStartedAt()
+	return cu
+}
+
 // SetMetadata sets the "metadata" field.
 func (cu *ContentUpdate) SetMetadata(m map[string]interface{}) *ContentUpdate {
 	cu.mutation.SetMetadata(m)
@@ -166,6 +296,36 @@ func (cu *ContentUpdate) AddModules(m ...*Module) *ContentUpdate {
 	return cu.AddModuleIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the ContentRevision entity by IDs.
+func (cu *ContentUpdate) AddRevisionIDs(ids ...uuid.UUID) *ContentUpdate {
+	cu.mutation.AddRevisionIDs(ids...)
+	return cu
+}
+
+// AddRevisions adds the "revisions" edges to the ContentRevision entity.
+func (cu *ContentUpdate) AddRevisions(c ...*ContentRevision) *ContentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddRevisionIDs(ids...)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the ContentDownload entity by IDs.
+func (cu *ContentUpdate) AddDownloadIDs(ids ...uuid.UUID) *ContentUpdate {
+	cu.mutation.AddDownloadIDs(ids...)
+	return cu
+}
+
+// AddDownloads adds the "downloads" edges to the ContentDownload entity.
+func (cu *ContentUpdate) AddDownloads(c ...*ContentDownload) *ContentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddDownloadIDs(ids...)
+}
+
 // Mutation returns the ContentMutation object of the builder.
 func (cu *ContentUpdate) Mutation() *ContentMutation {
 	return cu.mutation
@@ -198,6 +358,48 @@ func (cu *ContentUpdate) RemoveModules(m ...*Module) *ContentUpdate {
 	return cu.RemoveModuleIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the ContentRevision entity.
+func (cu *ContentUpdate) ClearRevisions() *ContentUpdate {
+	cu.mutation.ClearRevisions()
+	return cu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ContentRevision entities by IDs.
+func (cu *ContentUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *ContentUpdate {
+	cu.mutation.RemoveRevisionIDs(ids...)
+	return cu
+}
+
+// RemoveRevisions removes "revisions" edges to ContentRevision entities.
+func (cu *ContentUpdate) RemoveRevisions(c ...*ContentRevision) *ContentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveRevisionIDs(ids...)
+}
+
+// ClearDownloads clears all "downloads" edges to the ContentDownload entity.
+func (cu *ContentUpdate) ClearDownloads() *ContentUpdate {
+	cu.mutation.ClearDownloads()
+	return cu
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to ContentDownload entities by IDs.
+func (cu *ContentUpdate) RemoveDownloadIDs(ids ...uuid.UUID) *ContentUpdate {
+	cu.mutation.RemoveDownloadIDs(ids...)
+	return cu
+}
+
+// RemoveDownloads removes "downloads" edges to ContentDownload entities.
+func (cu *ContentUpdate) RemoveDownloads(c ...*ContentDownload) *ContentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveDownloadIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ContentUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -251,7 +453,7 @@ func (cu *ContentUpdate) check() error {
 			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Content.storage_key": %w`, err)}
 		}
 	}
-	if _, ok := cu.mutation.OrganizationID(); cu.mutation.OrganizationCleared() && !ok {
+	if cu.mutation.OrganizationCleared() && len(cu.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Content.organization"`)
 	}
 	return nil
@@ -287,9 +489,48 @@ func (cu *ContentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.StorageKey(); ok {
 		_spec.SetField(content.FieldStorageKey, field.TypeString, value)
 	}
+	if value, ok := cu.mutation.CurrentRevision(); ok {
+		_spec.SetField(content.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedCurrentRevision(); ok {
+		_spec.AddField(content.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.Etag(); ok {
+		_spec.SetField(content.FieldEtag, field.TypeString, value)
+	}
+	if cu.mutation.EtagCleared() {
+		_spec.ClearField(content.FieldEtag, field.TypeString)
+	}
+	if value, ok := cu.mutation.ChecksumSha256(); ok {
+		_spec.SetField(content.FieldChecksumSha256, field.TypeString, value)
+	}
+	if cu.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(content.FieldChecksumSha256, field.TypeString)
+	}
 	if value, ok := cu.mutation.Status(); ok {
 		_spec.SetField(content.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := cu.mutation.UploadID(); ok {
+		_spec.SetField(content.FieldUploadID, field.TypeString, value)
+	}
+	if cu.mutation.UploadIDCleared() {
+		_spec.ClearField(content.FieldUploadID, field.TypeString)
+	}
+	if value, ok := cu.mutation.UploadPartSize(); ok {
+		_spec.SetField(content.FieldUploadPartSize, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedUploadPartSize(); ok {
+		_spec.AddField(content.FieldUploadPartSize, field.TypeInt64, value)
+	}
+	if cu.mutation.UploadPartSizeCleared() {
+		_spec.ClearField(content.FieldUploadPartSize, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.UploadStartedAt(); ok {
+		_spec.SetField(content.FieldUploadStartedAt, field.TypeTime, value)
+	}
+	if cu.mutation.UploadStartedAtCleared() {
+		_spec.ClearField(content.FieldUploadStartedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.Metadata(); ok {
 		_spec.SetField(content.FieldMetadata, field.TypeJSON, value)
 	}
@@ -373,6 +614,96 @@ func (cu *ContentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !cu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedDownloadsIDs(); len(nodes) > 0 && !cu.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{content.Label}
@@ -476,6 +807,67 @@ func (cuo *ContentUpdateOne) SetNillableStorageKey(s *string) *ContentUpdateOne
 	return cuo
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (cuo *ContentUpdateOne) SetCurrentRevision(i int) *ContentUpdateOne {
+	cuo.mutation.ResetCurrentRevision()
+	cuo.mutation.SetCurrentRevision(i)
+	return cuo
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableCurrentRevision(i *int) *ContentUpdateOne {
+	if i != nil {
+		cuo.SetCurrentRevision(*i)
+	}
+	return cuo
+}
+
+// AddCurrentRevision adds i to the "current_revision" field.
+func (cuo *ContentUpdateOne) AddCurrentRevision(i int) *ContentUpdateOne {
+	cuo.mutation.AddCurrentRevision(i)
+	return cuo
+}
+
+// SetEtag sets the "etag" field.
+func (cuo *ContentUpdateOne) SetEtag(s string) *ContentUpdateOne {
+	cuo.mutation.SetEtag(s)
+	return cuo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableEtag(s *string) *ContentUpdateOne {
+	if s != nil {
+		cuo.SetEtag(*s)
+	}
+	return cuo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (cuo *ContentUpdateOne) ClearEtag() *ContentUpdateOne {
+	cuo.mutation.ClearEtag()
+	return cuo
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (cuo *ContentUpdateOne) SetChecksumSha256(s string) *ContentUpdateOne {
+	cuo.mutation.SetChecksumSha256(s)
+	return cuo
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableChecksumSha256(s *string) *ContentUpdateOne {
+	if s != nil {
+		cuo.SetChecksumSha256(*s)
+	}
+	return cuo
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (cuo *ContentUpdateOne) ClearChecksumSha256() *ContentUpdateOne {
+	cuo.mutation.ClearChecksumSha256()
+	return cuo
+}
+
 // SetStatus sets the "status" field.
 func (cuo *ContentUpdateOne) SetStatus(s string) *ContentUpdateOne {
 	cuo.mutation.SetStatus(s)
@@ -490,6 +882,73 @@ func (cuo *ContentUpdateOne) SetNillableStatus(s *string) *ContentUpdateOne {
 	return cuo
 }
 
+// SetUploadID sets the "upload_id" field.
+func (cuo *ContentUpdateOne) SetUploadID(s string) *ContentUpdateOne {
+	cuo.mutation.SetUploadID(s)
+	return cuo
+}
+
+// SetNillableUploadID sets the "upload_id" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableUploadID(s *string) *ContentUpdateOne {
+	if s != nil {
+		cuo.SetUploadID(*s)
+	}
+	return cuo
+}
+
+// ClearUploadID clears the value of the "upload_id" field.
+func (cuo *ContentUpdateOne) ClearUploadID() *ContentUpdateOne {
+	cuo.mutation.ClearUploadID()
+	return cuo
+}
+
+// SetUploadPartSize sets the "upload_part_size" field.
+func (cuo *ContentUpdateOne) SetUploadPartSize(i int64) *ContentUpdateOne {
+	cuo.mutation.ResetUploadPartSize()
+	cuo.mutation.SetUploadPartSize(i)
+	return cuo
+}
+
+// SetNillableUploadPartSize sets the "upload_part_size" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableUploadPartSize(i *int64) *ContentUpdateOne {
+	if i != nil {
+		cuo.SetUploadPartSize(*i)
+	}
+	return cuo
+}
+
+// AddUploadPartSize adds i to the "upload_part_size" field.
+func (cuo *ContentUpdateOne) AddUploadPartSize(i int64) *ContentUpdateOne {
+	cuo.mutation.AddUploadPartSize(i)
+	return cuo
+}
+
+// ClearUploadPartSize clears the value of the "upload_part_size" field.
+func (cuo *ContentUpdateOne) ClearUploadPartSize() *ContentUpdateOne {
+	cuo.mutation.ClearUploadPartSize()
+	return cuo
+}
+
+// SetUploadStartedAt sets the "upload_started_at" field.
+func (cuo *ContentUpdateOne) SetUploadStartedAt(t time.Time) *ContentUpdateOne {
+	cuo.mutation.SetUploadStartedAt(t)
+	return cuo
+}
+
+// SetNillableUploadStartedAt sets the "upload_started_at" field if the given value is not nil.
+func (cuo *ContentUpdateOne) SetNillableUploadStartedAt(t *time.Time) *ContentUpdateOne {
+	if t != nil {
+		cuo.SetUploadStartedAt(*t)
+	}
+	return cuo
+}
+
+// ClearUploadStartedAt clears the value of the "upload_started_at" field.
+func (cuo *ContentUpdateOne) ClearUploadStartedAt() *ContentUpdateOne {
+	cuo.mutation.ClearUploadStartedAt()
+	return cuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (cuo *ContentUpdateOne) SetMetadata(m map[string]interface{}) *ContentUpdateOne {
 	cuo.mutation.SetMetadata(m)
@@ -528,6 +987,36 @@ func (cuo *ContentUpdateOne) AddModules(m ...*Module) *ContentUpdateOne {
 	return cuo.AddModuleIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the ContentRevision entity by IDs.
+func (cuo *ContentUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *ContentUpdateOne {
+	cuo.mutation.AddRevisionIDs(ids...)
+	return cuo
+}
+
+// AddRevisions adds the "revisions" edges to the ContentRevision entity.
+func (cuo *ContentUpdateOne) AddRevisions(c ...*ContentRevision) *ContentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddRevisionIDs(ids...)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the ContentDownload entity by IDs.
+func (cuo *ContentUpdateOne) AddDownloadIDs(ids ...uuid.UUID) *ContentUpdateOne {
+	cuo.mutation.AddDownloadIDs(ids...)
+	return cuo
+}
+
+// AddDownloads adds the "downloads" edges to the ContentDownload entity.
+func (cuo *ContentUpdateOne) AddDownloads(c ...*ContentDownload) *ContentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddDownloadIDs(ids...)
+}
+
 // Mutation returns the ContentMutation object of the builder.
 func (cuo *ContentUpdateOne) Mutation() *ContentMutation {
 	return cuo.mutation
@@ -560,6 +1049,48 @@ func (cuo *ContentUpdateOne) RemoveModules(m ...*Module) *ContentUpdateOne {
 	return cuo.RemoveModuleIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the ContentRevision entity.
+func (cuo *ContentUpdateOne) ClearRevisions() *ContentUpdateOne {
+	cuo.mutation.ClearRevisions()
+	return cuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ContentRevision entities by IDs.
+func (cuo *ContentUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *ContentUpdateOne {
+	cuo.mutation.RemoveRevisionIDs(ids...)
+	return cuo
+}
+
+// RemoveRevisions removes "revisions" edges to ContentRevision entities.
+func (cuo *ContentUpdateOne) RemoveRevisions(c ...*ContentRevision) *ContentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearDownloads clears all "downloads" edges to the ContentDownload entity.
+func (cuo *ContentUpdateOne) ClearDownloads() *ContentUpdateOne {
+	cuo.mutation.ClearDownloads()
+	return cuo
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to ContentDownload entities by IDs.
+func (cuo *ContentUpdateOne) RemoveDownloadIDs(ids ...uuid.UUID) *ContentUpdateOne {
+	cuo.mutation.RemoveDownloadIDs(ids...)
+	return cuo
+}
+
+// RemoveDownloads removes "downloads" edges to ContentDownload entities.
+func (cuo *ContentUpdateOne) RemoveDownloads(c ...*ContentDownload) *ContentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveDownloadIDs(ids...)
+}
+
 // Where appends a list predicates to the ContentUpdate builder.
 func (cuo *ContentUpdateOne) Where(ps ...predicate.Content) *ContentUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -626,7 +1157,7 @@ func (cuo *ContentUpdateOne) check() error {
 			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Content.storage_key": %w`, err)}
 		}
 	}
-	if _, ok := cuo.mutation.OrganizationID(); cuo.mutation.OrganizationCleared() && !ok {
+	if cuo.mutation.OrganizationCleared() && len(cuo.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Content.organization"`)
 	}
 	return nil
@@ -679,9 +1210,48 @@ func (cuo *ContentUpdateOne) sqlSave(ctx context.Context) (_node *Content, err e
 	if value, ok := cuo.mutation.StorageKey(); ok {
 		_spec.SetField(content.FieldStorageKey, field.TypeString, value)
 	}
+	if value, ok := cuo.mutation.CurrentRevision(); ok {
+		_spec.SetField(content.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedCurrentRevision(); ok {
+		_spec.AddField(content.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.Etag(); ok {
+		_spec.SetField(content.FieldEtag, field.TypeString, value)
+	}
+	if cuo.mutation.EtagCleared() {
+		_spec.ClearField(content.FieldEtag, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ChecksumSha256(); ok {
+		_spec.SetField(content.FieldChecksumSha256, field.TypeString, value)
+	}
+	if cuo.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(content.FieldChecksumSha256, field.TypeString)
+	}
 	if value, ok := cuo.mutation.Status(); ok {
 		_spec.SetField(content.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := cuo.mutation.UploadID(); ok {
+		_spec.SetField(content.FieldUploadID, field.TypeString, value)
+	}
+	if cuo.mutation.UploadIDCleared() {
+		_spec.ClearField(content.FieldUploadID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UploadPartSize(); ok {
+		_spec.SetField(content.FieldUploadPartSize, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedUploadPartSize(); ok {
+		_spec.AddField(content.FieldUploadPartSize, field.TypeInt64, value)
+	}
+	if cuo.mutation.UploadPartSizeCleared() {
+		_spec.ClearField(content.FieldUploadPartSize, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.UploadStartedAt(); ok {
+		_spec.SetField(content.FieldUploadStartedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.UploadStartedAtCleared() {
+		_spec.ClearField(content.FieldUploadStartedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.Metadata(); ok {
 		_spec.SetField(content.FieldMetadata, field.TypeJSON, value)
 	}
@@ -765,6 +1335,96 @@ func (cuo *ContentUpdateOne) sqlSave(ctx context.Context) (_node *Content, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !cuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.RevisionsTable,
+			Columns: []string{content.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedDownloadsIDs(); len(nodes) > 0 && !cuo.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   content.DownloadsTable,
+			Columns: []string{content.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Content{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
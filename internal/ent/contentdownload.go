@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ContentDownload is the model entity for the ContentDownload schema.
+type ContentDownload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// ContentID holds the value of the "content_id" field.
+	ContentID uuid.UUID `json:"content_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// ModuleID holds the value of the "module_id" field.
+	ModuleID *uuid.UUID `json:"module_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentDownloadQuery when eager-loading is set.
+	Edges        ContentDownloadEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentDownloadEdges holds the relations/edges for other nodes in the graph.
+type ContentDownloadEdges struct {
+	// Content holds the value of the content edge.
+	Content *Content `json:"content,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ContentOrErr returns the Content value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentDownloadEdges) ContentOrErr() (*Content, error) {
+	if e.Content != nil {
+		return e.Content, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: content.Label}
+	}
+	return nil, &NotLoadedError{edge: "content"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentDownload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentdownload.FieldUserID, contentdownload.FieldModuleID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case contentdownload.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case contentdownload.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case contentdownload.FieldID, contentdownload.FieldOrganizationID, contentdownload.FieldContentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentDownload fields.
+func (cd *ContentDownload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentdownload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cd.ID = *value
+			}
+		case contentdownload.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				cd.OrganizationID = *value
+			}
+		case contentdownload.FieldContentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field content_id", values[i])
+			} else if value != nil {
+				cd.ContentID = *value
+			}
+		case contentdownload.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				cd.Revision = int(value.Int64)
+			}
+		case contentdownload.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				cd.UserID = new(uuid.UUID)
+				*cd.UserID = *value.S.(*uuid.UUID)
+			}
+		case contentdownload.FieldModuleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field module_id", values[i])
+			} else if value.Valid {
+				cd.ModuleID = new(uuid.UUID)
+				*cd.ModuleID = *value.S.(*uuid.UUID)
+			}
+		case contentdownload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cd.CreatedAt = value.Time
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentDownload.
+// This includes values selected through modifiers, order, etc.
+func (cd *ContentDownload) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// QueryContent queries the "content" edge of the ContentDownload entity.
+func (cd *ContentDownload) QueryContent() *ContentQuery {
+	return NewContentDownloadClient(cd.config).QueryContent(cd)
+}
+
+// Update returns a builder for updating this ContentDownload.
+// Note that you need to call ContentDownload.Unwrap() before calling this method if this ContentDownload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ContentDownload) Update() *ContentDownloadUpdateOne {
+	return NewContentDownloadClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ContentDownload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ContentDownload) Unwrap() *ContentDownload {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentDownload is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ContentDownload) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentDownload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", cd.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("content_id=")
+	builder.WriteString(fmt.Sprintf("%v", cd.ContentID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Revision))
+	builder.WriteString(", ")
+	if v := cd.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cd.ModuleID; v != nil {
+		builder.WriteString("module_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentDownloads is a parsable slice of ContentDownload.
+type ContentDownloads []*ContentDownload
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentdownload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the contentdownload type in the database.
+	Label = "content_download"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldContentID holds the string denoting the content_id field in the database.
+	FieldContentID = "content_id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldModuleID holds the string denoting the module_id field in the database.
+	FieldModuleID = "module_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeContent holds the string denoting the content edge name in mutations.
+	EdgeContent = "content"
+	// Table holds the table name of the contentdownload in the database.
+	Table = "content_downloads"
+	// ContentTable is the table that holds the content relation/edge.
+	ContentTable = "content_downloads"
+	// ContentInverseTable is the table name for the Content entity.
+	// It exists in this package in order to avoid circular dependency with the "content" package.
+	ContentInverseTable = "contents"
+	// ContentColumn is the table column denoting the content relation/edge.
+	ContentColumn = "content_id"
+)
+
+// Columns holds all SQL columns for contentdownload fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationID,
+	FieldContentID,
+	FieldRevision,
+	FieldUserID,
+	FieldModuleID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ContentDownload queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByContentID orders the results by the content_id field.
+func ByContentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentID, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByModuleID orders the results by the module_id field.
+func ByModuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByContentField orders the results by content field.
+func ByContentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newContentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ContentTable, ContentColumn),
+	)
+}
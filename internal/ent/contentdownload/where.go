@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentdownload
+
+import (
+	"lms-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// ContentID applies equality check predicate on the "content_id" field. It's identical to ContentIDEQ.
+func ContentID(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldContentID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldRevision, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldUserID, v))
+}
+
+// ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
+func ModuleID(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldModuleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// ContentIDEQ applies the EQ predicate on the "content_id" field.
+func ContentIDEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldContentID, v))
+}
+
+// ContentIDNEQ applies the NEQ predicate on the "content_id" field.
+func ContentIDNEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldContentID, v))
+}
+
+// ContentIDIn applies the In predicate on the "content_id" field.
+func ContentIDIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldContentID, vs...))
+}
+
+// ContentIDNotIn applies the NotIn predicate on the "content_id" field.
+func ContentIDNotIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldContentID, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldRevision, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotNull(FieldUserID))
+}
+
+// ModuleIDEQ applies the EQ predicate on the "module_id" field.
+func ModuleIDEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldModuleID, v))
+}
+
+// ModuleIDNEQ applies the NEQ predicate on the "module_id" field.
+func ModuleIDNEQ(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldModuleID, v))
+}
+
+// ModuleIDIn applies the In predicate on the "module_id" field.
+func ModuleIDIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldModuleID, vs...))
+}
+
+// ModuleIDNotIn applies the NotIn predicate on the "module_id" field.
+func ModuleIDNotIn(vs ...uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldModuleID, vs...))
+}
+
+// ModuleIDGT applies the GT predicate on the "module_id" field.
+func ModuleIDGT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldModuleID, v))
+}
+
+// ModuleIDGTE applies the GTE predicate on the "module_id" field.
+func ModuleIDGTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldModuleID, v))
+}
+
+// ModuleIDLT applies the LT predicate on the "module_id" field.
+func ModuleIDLT(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldModuleID, v))
+}
+
+// ModuleIDLTE applies the LTE predicate on the "module_id" field.
+func ModuleIDLTE(v uuid.UUID) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldModuleID, v))
+}
+
+// ModuleIDIsNil applies the IsNil predicate on the "module_id" field.
+func ModuleIDIsNil() predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIsNull(FieldModuleID))
+}
+
+// ModuleIDNotNil applies the NotNil predicate on the "module_id" field.
+func ModuleIDNotNil() predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotNull(FieldModuleID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasContent applies the HasEdge predicate on the "content" edge.
+func HasContent() predicate.ContentDownload {
+	return predicate.ContentDownload(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContentTable, ContentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentWith applies the HasEdge predicate on the "content" edge with a given conditions (other predicates).
+func HasContentWith(preds ...predicate.Content) predicate.ContentDownload {
+	return predicate.ContentDownload(func(s *sql.Selector) {
+		step := newContentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentDownload) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentDownload) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentDownload) predicate.ContentDownload {
+	return predicate.ContentDownload(sql.NotPredicates(p))
+}
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentDownloadCreate is the builder for creating a ContentDownload entity.
+type ContentDownloadCreate struct {
+	config
+	mutation *ContentDownloadMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (cdc *ContentDownloadCreate) SetOrganizationID(u uuid.UUID) *ContentDownloadCreate {
+	cdc.mutation.SetOrganizationID(u)
+	return cdc
+}
+
+// SetContentID sets the "content_id" field.
+func (cdc *ContentDownloadCreate) SetContentID(u uuid.UUID) *ContentDownloadCreate {
+	cdc.mutation.SetContentID(u)
+	return cdc
+}
+
+// SetRevision sets the "revision" field.
+func (cdc *ContentDownloadCreate) SetRevision(i int) *ContentDownloadCreate {
+	cdc.mutation.SetRevision(i)
+	return cdc
+}
+
+// SetUserID sets the "user_id" field.
+func (cdc *ContentDownloadCreate) SetUserID(u uuid.UUID) *ContentDownloadCreate {
+	cdc.mutation.SetUserID(u)
+	return cdc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cdc *ContentDownloadCreate) SetNillableUserID(u *uuid.UUID) *ContentDownloadCreate {
+	if u != nil {
+		cdc.SetUserID(*u)
+	}
+	return cdc
+}
+
+// SetModuleID sets the "module_id" field.
+func (cdc *ContentDownloadCreate) SetModuleID(u uuid.UUID) *ContentDownloadCreate {
+	cdc.mutation.SetModuleID(u)
+	return cdc
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (cdc *ContentDownloadCreate) SetNillableModuleID(u *uuid.UUID) *ContentDownloadCreate {
+	if u != nil {
+		cdc.SetModuleID(*u)
+	}
+	return cdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cdc *ContentDownloadCreate) SetCreatedAt(t time.Time) *ContentDownloadCreate {
+	cdc.mutation.SetCreatedAt(t)
+	return cdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cdc *ContentDownloadCreate) SetNillableCreatedAt(t *time.Time) *ContentDownloadCreate {
+	if t != nil {
+		cdc.SetCreatedAt(*t)
+	}
+	return cdc
+}
+
+// SetID sets the "id" field.
+func (cdc *ContentDownloadCreate) SetID(u uuid.UUID) *ContentDownloadCreate {
+	cdc.mutation.SetID(u)
+	return cdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cdc *ContentDownloadCreate) SetNillableID(u *uuid.UUID) *ContentDownloadCreate {
+	if u != nil {
+		cdc.SetID(*u)
+	}
+	return cdc
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (cdc *ContentDownloadCreate) SetContent(c *Content) *ContentDownloadCreate {
+	return cdc.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentDownloadMutation object of the builder.
+func (cdc *ContentDownloadCreate) Mutation() *ContentDownloadMutation {
+	return cdc.mutation
+}
+
+// Save creates the ContentDownload in the database.
+func (cdc *ContentDownloadCreate) Save(ctx context.Context) (*ContentDownload, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ContentDownloadCreate) SaveX(ctx context.Context) *ContentDownload {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *ContentDownloadCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *ContentDownloadCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *ContentDownloadCreate) defaults() {
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		v := contentdownload.DefaultCreatedAt()
+		cdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cdc.mutation.ID(); !ok {
+		v := contentdownload.DefaultID()
+		cdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ContentDownloadCreate) check() error {
+	if _, ok := cdc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "ContentDownload.organization_id"`)}
+	}
+	if _, ok := cdc.mutation.ContentID(); !ok {
+		return &ValidationError{Name: "content_id", err: errors.New(`ent: missing required field "ContentDownload.content_id"`)}
+	}
+	if _, ok := cdc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "ContentDownload.revision"`)}
+	}
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContentDownload.created_at"`)}
+	}
+	if len(cdc.mutation.ContentIDs()) == 0 {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required edge "ContentDownload.content"`)}
+	}
+	return nil
+}
+
+func (cdc *ContentDownloadCreate) sqlSave(ctx context.Context) (*ContentDownload, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *ContentDownloadCreate) createSpec() (*ContentDownload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentDownload{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(contentdownload.Table, sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID))
+	)
+	if id, ok := cdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cdc.mutation.OrganizationID(); ok {
+		_spec.SetField(contentdownload.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := cdc.mutation.Revision(); ok {
+		_spec.SetField(contentdownload.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := cdc.mutation.UserID(); ok {
+		_spec.SetField(contentdownload.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := cdc.mutation.ModuleID(); ok {
+		_spec.SetField(contentdownload.FieldModuleID, field.TypeUUID, value)
+		_node.ModuleID = &value
+	}
+	if value, ok := cdc.mutation.CreatedAt(); ok {
+		_spec.SetField(contentdownload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cdc.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentdownload.ContentTable,
+			Columns: []string{contentdownload.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContentDownloadCreateBulk is the builder for creating many ContentDownload entities in bulk.
+type ContentDownloadCreateBulk struct {
+	config
+	err      error
+	builders []*ContentDownloadCreate
+}
+
+// Save creates the ContentDownload entities in the database.
+func (cdcb *ContentDownloadCreateBulk) Save(ctx context.Context) ([]*ContentDownload, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ContentDownload, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentDownloadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ContentDownloadCreateBulk) SaveX(ctx context.Context) []*ContentDownload {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *ContentDownloadCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *ContentDownloadCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
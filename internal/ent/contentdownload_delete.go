@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentDownloadDelete is the builder for deleting a ContentDownload entity.
+type ContentDownloadDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContentDownloadMutation
+}
+
+// Where appends a list predicates to the ContentDownloadDelete builder.
+func (cdd *ContentDownloadDelete) Where(ps ...predicate.ContentDownload) *ContentDownloadDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *ContentDownloadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *ContentDownloadDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *ContentDownloadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contentdownload.Table, sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// ContentDownloadDeleteOne is the builder for deleting a single ContentDownload entity.
+type ContentDownloadDeleteOne struct {
+	cdd *ContentDownloadDelete
+}
+
+// Where appends a list predicates to the ContentDownloadDelete builder.
+func (cddo *ContentDownloadDeleteOne) Where(ps ...predicate.ContentDownload) *ContentDownloadDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *ContentDownloadDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contentdownload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *ContentDownloadDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentDownloadQuery is the builder for querying ContentDownload entities.
+type ContentDownloadQuery struct {
+	config
+	ctx         *QueryContext
+	order       []contentdownload.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ContentDownload
+	withContent *ContentQuery
+	modifiers   []func(*sql.Selector)
+	loadTotal   []func(context.Context, []*ContentDownload) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContentDownloadQuery builder.
+func (cdq *ContentDownloadQuery) Where(ps ...predicate.ContentDownload) *ContentDownloadQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *ContentDownloadQuery) Limit(limit int) *ContentDownloadQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *ContentDownloadQuery) Offset(offset int) *ContentDownloadQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *ContentDownloadQuery) Unique(unique bool) *ContentDownloadQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *ContentDownloadQuery) Order(o ...contentdownload.OrderOption) *ContentDownloadQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// QueryContent chains the current query on the "content" edge.
+func (cdq *ContentDownloadQuery) QueryContent() *ContentQuery {
+	query := (&ContentClient{config: cdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentdownload.Table, contentdownload.FieldID, selector),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentdownload.ContentTable, contentdownload.ContentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContentDownload entity from the query.
+// Returns a *NotFoundError when no ContentDownload was found.
+func (cdq *ContentDownloadQuery) First(ctx context.Context) (*ContentDownload, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contentdownload.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) FirstX(ctx context.Context) *ContentDownload {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContentDownload ID from the query.
+// Returns a *NotFoundError when no ContentDownload ID was found.
+func (cdq *ContentDownloadQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contentdownload.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContentDownload entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContentDownload entity is found.
+// Returns a *NotFoundError when no ContentDownload entities are found.
+func (cdq *ContentDownloadQuery) Only(ctx context.Context) (*ContentDownload, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contentdownload.Label}
+	default:
+		return nil, &NotSingularError{contentdownload.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) OnlyX(ctx context.Context) *ContentDownload {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContentDownload ID in the query.
+// Returns a *NotSingularError when more than one ContentDownload ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *ContentDownloadQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contentdownload.Label}
+	default:
+		err = &NotSingularError{contentdownload.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContentDownloads.
+func (cdq *ContentDownloadQuery) All(ctx context.Context) ([]*ContentDownload, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryAll)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContentDownload, *ContentDownloadQuery]()
+	return withInterceptors[[]*ContentDownload](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) AllX(ctx context.Context) []*ContentDownload {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContentDownload IDs.
+func (cdq *ContentDownloadQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryIDs)
+	if err = cdq.Select(contentdownload.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *ContentDownloadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryCount)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*ContentDownloadQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *ContentDownloadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryExist)
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *ContentDownloadQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContentDownloadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *ContentDownloadQuery) Clone() *ContentDownloadQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &ContentDownloadQuery{
+		config:      cdq.config,
+		ctx:         cdq.ctx.Clone(),
+		order:       append([]contentdownload.OrderOption{}, cdq.order...),
+		inters:      append([]Interceptor{}, cdq.inters...),
+		predicates:  append([]predicate.ContentDownload{}, cdq.predicates...),
+		withContent: cdq.withContent.Clone(),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// WithContent tells the query-builder to eager-load the nodes that are connected to
+// the "content" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdq *ContentDownloadQuery) WithContent(opts ...func(*ContentQuery)) *ContentDownloadQuery {
+	query := (&ContentClient{config: cdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdq.withContent = query
+	return cdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContentDownload.Query().
+//		GroupBy(contentdownload.FieldOrganizationID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *ContentDownloadQuery) GroupBy(field string, fields ...string) *ContentDownloadGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContentDownloadGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = contentdownload.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//	}
+//
+//	client.ContentDownload.Query().
+//		Select(contentdownload.FieldOrganizationID).
+//		Scan(ctx, &v)
+func (cdq *ContentDownloadQuery) Select(fields ...string) *ContentDownloadSelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &ContentDownloadSelect{ContentDownloadQuery: cdq}
+	sbuild.label = contentdownload.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContentDownloadSelect configured with the given aggregations.
+func (cdq *ContentDownloadQuery) Aggregate(fns ...AggregateFunc) *ContentDownloadSelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *ContentDownloadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !contentdownload.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *ContentDownloadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContentDownload, error) {
+	var (
+		nodes       = []*ContentDownload{}
+		_spec       = cdq.querySpec()
+		loadedTypes = [1]bool{
+			cdq.withContent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContentDownload).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContentDownload{config: cdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cdq.withContent; query != nil {
+		if err := cdq.loadContent(ctx, query, nodes, nil,
+			func(n *ContentDownload, e *Content) { n.Edges.Content = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cdq.loadTotal {
+		if err := cdq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cdq *ContentDownloadQuery) loadContent(ctx context.Context, query *ContentQuery, nodes []*ContentDownload, init func(*ContentDownload), assign func(*ContentDownload, *Content)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ContentDownload)
+	for i := range nodes {
+		fk := nodes[i].ContentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(content.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "content_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cdq *ContentDownloadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *ContentDownloadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contentdownload.Table, contentdownload.Columns, sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentdownload.FieldID)
+		for i := range fields {
+			if fields[i] != contentdownload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cdq.withContent != nil {
+			_spec.Node.AddColumnOnce(contentdownload.FieldContentID)
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *ContentDownloadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(contentdownload.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contentdownload.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContentDownloadGroupBy is the group-by builder for ContentDownload entities.
+type ContentDownloadGroupBy struct {
+	selector
+	build *ContentDownloadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *ContentDownloadGroupBy) Aggregate(fns ...AggregateFunc) *ContentDownloadGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *ContentDownloadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentDownloadQuery, *ContentDownloadGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *ContentDownloadGroupBy) sqlScan(ctx context.Context, root *ContentDownloadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContentDownloadSelect is the builder for selecting fields of ContentDownload entities.
+type ContentDownloadSelect struct {
+	*ContentDownloadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *ContentDownloadSelect) Aggregate(fns ...AggregateFunc) *ContentDownloadSelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *ContentDownloadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, ent.OpQuerySelect)
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentDownloadQuery, *ContentDownloadSelect](ctx, cds.ContentDownloadQuery, cds, cds.inters, v)
+}
+
+func (cds *ContentDownloadSelect) sqlScan(ctx context.Context, root *ContentDownloadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
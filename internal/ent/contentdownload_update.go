@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentDownloadUpdate is the builder for updating ContentDownload entities.
+type ContentDownloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentDownloadMutation
+}
+
+// Where appends a list predicates to the ContentDownloadUpdate builder.
+func (cdu *ContentDownloadUpdate) Where(ps ...predicate.ContentDownload) *ContentDownloadUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (cdu *ContentDownloadUpdate) SetOrganizationID(u uuid.UUID) *ContentDownloadUpdate {
+	cdu.mutation.SetOrganizationID(u)
+	return cdu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cdu *ContentDownloadUpdate) SetNillableOrganizationID(u *uuid.UUID) *ContentDownloadUpdate {
+	if u != nil {
+		cdu.SetOrganizationID(*u)
+	}
+	return cdu
+}
+
+// SetContentID sets the "content_id" field.
+func (cdu *ContentDownloadUpdate) SetContentID(u uuid.UUID) *ContentDownloadUpdate {
+	cdu.mutation.SetContentID(u)
+	return cdu
+}
+
+// SetNillableContentID sets the "content_id" field if the given value is not nil.
+func (cdu *ContentDownloadUpdate) SetNillableContentID(u *uuid.UUID) *ContentDownloadUpdate {
+	if u != nil {
+		cdu.SetContentID(*u)
+	}
+	return cdu
+}
+
+// SetRevision sets the "revision" field.
+func (cdu *ContentDownloadUpdate) SetRevision(i int) *ContentDownloadUpdate {
+	cdu.mutation.ResetRevision()
+	cdu.mutation.SetRevision(i)
+	return cdu
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (cdu *ContentDownloadUpdate) SetNillableRevision(i *int) *ContentDownloadUpdate {
+	if i != nil {
+		cdu.SetRevision(*i)
+	}
+	return cdu
+}
+
+// AddRevision adds i to the "revision" field.
+func (cdu *ContentDownloadUpdate) AddRevision(i int) *ContentDownloadUpdate {
+	cdu.mutation.AddRevision(i)
+	return cdu
+}
+
+// SetUserID sets the "user_id" field.
+func (cdu *ContentDownloadUpdate) SetUserID(u uuid.UUID) *ContentDownloadUpdate {
+	cdu.mutation.SetUserID(u)
+	return cdu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cdu *ContentDownloadUpdate) SetNillableUserID(u *uuid.UUID) *ContentDownloadUpdate {
+	if u != nil {
+		cdu.SetUserID(*u)
+	}
+	return cdu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (cdu *ContentDownloadUpdate) ClearUserID() *ContentDownloadUpdate {
+	cdu.mutation.ClearUserID()
+	return cdu
+}
+
+// SetModuleID sets the "module_id" field.
+func (cdu *ContentDownloadUpdate) SetModuleID(u uuid.UUID) *ContentDownloadUpdate {
+	cdu.mutation.SetModuleID(u)
+	return cdu
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (cdu *ContentDownloadUpdate) SetNillableModuleID(u *uuid.UUID) *ContentDownloadUpdate {
+	if u != nil {
+		cdu.SetModuleID(*u)
+	}
+	return cdu
+}
+
+// ClearModuleID clears the value of the "module_id" field.
+func (cdu *ContentDownloadUpdate) ClearModuleID() *ContentDownloadUpdate {
+	cdu.mutation.ClearModuleID()
+	return cdu
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (cdu *ContentDownloadUpdate) SetContent(c *Content) *ContentDownloadUpdate {
+	return cdu.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentDownloadMutation object of the builder.
+func (cdu *ContentDownloadUpdate) Mutation() *ContentDownloadMutation {
+	return cdu.mutation
+}
+
+// ClearContent clears the "content" edge to the Content entity.
+func (cdu *ContentDownloadUpdate) ClearContent() *ContentDownloadUpdate {
+	cdu.mutation.ClearContent()
+	return cdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ContentDownloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ContentDownloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ContentDownloadUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ContentDownloadUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdu *ContentDownloadUpdate) check() error {
+	if cdu.mutation.ContentCleared() && len(cdu.mutation.ContentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentDownload.content"`)
+	}
+	return nil
+}
+
+func (cdu *ContentDownloadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentdownload.Table, contentdownload.Columns, sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.OrganizationID(); ok {
+		_spec.SetField(contentdownload.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := cdu.mutation.Revision(); ok {
+		_spec.SetField(contentdownload.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedRevision(); ok {
+		_spec.AddField(contentdownload.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.UserID(); ok {
+		_spec.SetField(contentdownload.FieldUserID, field.TypeUUID, value)
+	}
+	if cdu.mutation.UserIDCleared() {
+		_spec.ClearField(contentdownload.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := cdu.mutation.ModuleID(); ok {
+		_spec.SetField(contentdownload.FieldModuleID, field.TypeUUID, value)
+	}
+	if cdu.mutation.ModuleIDCleared() {
+		_spec.ClearField(contentdownload.FieldModuleID, field.TypeUUID)
+	}
+	if cdu.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentdownload.ContentTable,
+			Columns: []string{contentdownload.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentdownload.ContentTable,
+			Columns: []string{contentdownload.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentdownload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// ContentDownloadUpdateOne is the builder for updating a single ContentDownload entity.
+type ContentDownloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentDownloadMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (cduo *ContentDownloadUpdateOne) SetOrganizationID(u uuid.UUID) *ContentDownloadUpdateOne {
+	cduo.mutation.SetOrganizationID(u)
+	return cduo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cduo *ContentDownloadUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *ContentDownloadUpdateOne {
+	if u != nil {
+		cduo.SetOrganizationID(*u)
+	}
+	return cduo
+}
+
+// SetContentID sets the "content_id" field.
+func (cduo *ContentDownloadUpdateOne) SetContentID(u uuid.UUID) *ContentDownloadUpdateOne {
+	cduo.mutation.SetContentID(u)
+	return cduo
+}
+
+// SetNillableContentID sets the "content_id" field if the given value is not nil.
+func (cduo *ContentDownloadUpdateOne) SetNillableContentID(u *uuid.UUID) *ContentDownloadUpdateOne {
+	if u != nil {
+		cduo.SetContentID(*u)
+	}
+	return cduo
+}
+
+// SetRevision sets the "revision" field.
+func (cduo *ContentDownloadUpdateOne) SetRevision(i int) *ContentDownloadUpdateOne {
+	cduo.mutation.ResetRevision()
+	cduo.mutation.SetRevision(i)
+	return cduo
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (cduo *ContentDownloadUpdateOne) SetNillableRevision(i *int) *ContentDownloadUpdateOne {
+	if i != nil {
+		cduo.SetRevision(*i)
+	}
+	return cduo
+}
+
+// AddRevision adds i to the "revision" field.
+func (cduo *ContentDownloadUpdateOne) AddRevision(i int) *ContentDownloadUpdateOne {
+	cduo.mutation.AddRevision(i)
+	return cduo
+}
+
+// SetUserID sets the "user_id" field.
+func (cduo *ContentDownloadUpdateOne) SetUserID(u uuid.UUID) *ContentDownloadUpdateOne {
+	cduo.mutation.SetUserID(u)
+	return cduo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cduo *ContentDownloadUpdateOne) SetNillableUserID(u *uuid.UUID) *ContentDownloadUpdateOne {
+	if u != nil {
+		cduo.SetUserID(*u)
+	}
+	return cduo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (cduo *ContentDownloadUpdateOne) ClearUserID() *ContentDownloadUpdateOne {
+	cduo.mutation.ClearUserID()
+	return cduo
+}
+
+// SetModuleID sets the "module_id" field.
+func (cduo *ContentDownloadUpdateOne) SetModuleID(u uuid.UUID) *ContentDownloadUpdateOne {
+	cduo.mutation.SetModuleID(u)
+	return cduo
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (cduo *ContentDownloadUpdateOne) SetNillableModuleID(u *uuid.UUID) *ContentDownloadUpdateOne {
+	if u != nil {
+		cduo.SetModuleID(*u)
+	}
+	return cduo
+}
+
+// ClearModuleID clears the value of the "module_id" field.
+func (cduo *ContentDownloadUpdateOne) ClearModuleID() *ContentDownloadUpdateOne {
+	cduo.mutation.ClearModuleID()
+	return cduo
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (cduo *ContentDownloadUpdateOne) SetContent(c *Content) *ContentDownloadUpdateOne {
+	return cduo.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentDownloadMutation object of the builder.
+func (cduo *ContentDownloadUpdateOne) Mutation() *ContentDownloadMutation {
+	return cduo.mutation
+}
+
+// ClearContent clears the "content" edge to the Content entity.
+func (cduo *ContentDownloadUpdateOne) ClearContent() *ContentDownloadUpdateOne {
+	cduo.mutation.ClearContent()
+	return cduo
+}
+
+// Where appends a list predicates to the ContentDownloadUpdate builder.
+func (cduo *ContentDownloadUpdateOne) Where(ps ...predicate.ContentDownload) *ContentDownloadUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *ContentDownloadUpdateOne) Select(field string, fields ...string) *ContentDownloadUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated ContentDownload entity.
+func (cduo *ContentDownloadUpdateOne) Save(ctx context.Context) (*ContentDownload, error) {
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ContentDownloadUpdateOne) SaveX(ctx context.Context) *ContentDownload {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ContentDownloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ContentDownloadUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cduo *ContentDownloadUpdateOne) check() error {
+	if cduo.mutation.ContentCleared() && len(cduo.mutation.ContentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentDownload.content"`)
+	}
+	return nil
+}
+
+func (cduo *ContentDownloadUpdateOne) sqlSave(ctx context.Context) (_node *ContentDownload, err error) {
+	if err := cduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentdownload.Table, contentdownload.Columns, sqlgraph.NewFieldSpec(contentdownload.FieldID, field.TypeUUID))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentDownload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentdownload.FieldID)
+		for _, f := range fields {
+			if !contentdownload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentdownload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.OrganizationID(); ok {
+		_spec.SetField(contentdownload.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := cduo.mutation.Revision(); ok {
+		_spec.SetField(contentdownload.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedRevision(); ok {
+		_spec.AddField(contentdownload.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.UserID(); ok {
+		_spec.SetField(contentdownload.FieldUserID, field.TypeUUID, value)
+	}
+	if cduo.mutation.UserIDCleared() {
+		_spec.ClearField(contentdownload.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := cduo.mutation.ModuleID(); ok {
+		_spec.SetField(contentdownload.FieldModuleID, field.TypeUUID, value)
+	}
+	if cduo.mutation.ModuleIDCleared() {
+		_spec.ClearField(contentdownload.FieldModuleID, field.TypeUUID)
+	}
+	if cduo.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentdownload.ContentTable,
+			Columns: []string{contentdownload.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentdownload.ContentTable,
+			Columns: []string{contentdownload.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContentDownload{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentdownload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
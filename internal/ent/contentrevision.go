@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentrevision"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ContentRevision is the model entity for the ContentRevision schema.
+type ContentRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// ContentID holds the value of the "content_id" field.
+	ContentID uuid.UUID `json:"content_id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
+	// SizeBytes holds the value of the "size_bytes" field.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+	// Etag holds the value of the "etag" field.
+	Etag string `json:"etag,omitempty"`
+	// ChecksumSha256 holds the value of the "checksum_sha256" field.
+	ChecksumSha256 string `json:"checksum_sha256,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentRevisionQuery when eager-loading is set.
+	Edges        ContentRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentRevisionEdges holds the relations/edges for other nodes in the graph.
+type ContentRevisionEdges struct {
+	// Content holds the value of the content edge.
+	Content *Content `json:"content,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ContentOrErr returns the Content value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentRevisionEdges) ContentOrErr() (*Content, error) {
+	if e.Content != nil {
+		return e.Content, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: content.Label}
+	}
+	return nil, &NotLoadedError{edge: "content"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentrevision.FieldNumber, contentrevision.FieldSizeBytes:
+			values[i] = new(sql.NullInt64)
+		case contentrevision.FieldStorageKey, contentrevision.FieldMimeType, contentrevision.FieldEtag, contentrevision.FieldChecksumSha256, contentrevision.FieldStatus:
+			values[i] = new(sql.NullString)
+		case contentrevision.FieldCreatedAt, contentrevision.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case contentrevision.FieldID, contentrevision.FieldOrganizationID, contentrevision.FieldContentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentRevision fields.
+func (cr *ContentRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cr.ID = *value
+			}
+		case contentrevision.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				cr.OrganizationID = *value
+			}
+		case contentrevision.FieldContentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field content_id", values[i])
+			} else if value != nil {
+				cr.ContentID = *value
+			}
+		case contentrevision.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				cr.Number = int(value.Int64)
+			}
+		case contentrevision.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				cr.StorageKey = value.String
+			}
+		case contentrevision.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				cr.MimeType = value.String
+			}
+		case contentrevision.FieldSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
+			} else if value.Valid {
+				cr.SizeBytes = value.Int64
+			}
+		case contentrevision.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				cr.Etag = value.String
+			}
+		case contentrevision.FieldChecksumSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_sha256", values[i])
+			} else if value.Valid {
+				cr.ChecksumSha256 = value.String
+			}
+		case contentrevision.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cr.Status = value.String
+			}
+		case contentrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case contentrevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentRevision.
+// This includes values selected through modifiers, order, etc.
+func (cr *ContentRevision) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// QueryContent queries the "content" edge of the ContentRevision entity.
+func (cr *ContentRevision) QueryContent() *ContentQuery {
+	return NewContentRevisionClient(cr.config).QueryContent(cr)
+}
+
+// Update returns a builder for updating this ContentRevision.
+// Note that you need to call ContentRevision.Unwrap() before calling this method if this ContentRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *ContentRevision) Update() *ContentRevisionUpdateOne {
+	return NewContentRevisionClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the ContentRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *ContentRevision) Unwrap() *ContentRevision {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentRevision is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *ContentRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("content_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.ContentID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Number))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(cr.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(cr.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", cr.SizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(cr.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_sha256=")
+	builder.WriteString(cr.ChecksumSha256)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(cr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentRevisions is a parsable slice of ContentRevision.
+type ContentRevisions []*ContentRevision
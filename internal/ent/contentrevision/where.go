@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentrevision
+
+import (
+	"lms-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// ContentID applies equality check predicate on the "content_id" field. It's identical to ContentIDEQ.
+func ContentID(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldContentID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldMimeType, v))
+}
+
+// SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
+func SizeBytes(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldEtag, v))
+}
+
+// ChecksumSha256 applies equality check predicate on the "checksum_sha256" field. It's identical to ChecksumSha256EQ.
+func ChecksumSha256(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// ContentIDEQ applies the EQ predicate on the "content_id" field.
+func ContentIDEQ(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldContentID, v))
+}
+
+// ContentIDNEQ applies the NEQ predicate on the "content_id" field.
+func ContentIDNEQ(v uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldContentID, v))
+}
+
+// ContentIDIn applies the In predicate on the "content_id" field.
+func ContentIDIn(vs ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldContentID, vs...))
+}
+
+// ContentIDNotIn applies the NotIn predicate on the "content_id" field.
+func ContentIDNotIn(vs ...uuid.UUID) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldContentID, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldNumber, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
+func SizeBytesEQ(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesNEQ applies the NEQ predicate on the "size_bytes" field.
+func SizeBytesNEQ(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesIn applies the In predicate on the "size_bytes" field.
+func SizeBytesIn(vs ...int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesNotIn applies the NotIn predicate on the "size_bytes" field.
+func SizeBytesNotIn(vs ...int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesGT applies the GT predicate on the "size_bytes" field.
+func SizeBytesGT(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldSizeBytes, v))
+}
+
+// SizeBytesGTE applies the GTE predicate on the "size_bytes" field.
+func SizeBytesGTE(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldSizeBytes, v))
+}
+
+// SizeBytesLT applies the LT predicate on the "size_bytes" field.
+func SizeBytesLT(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldSizeBytes, v))
+}
+
+// SizeBytesLTE applies the LTE predicate on the "size_bytes" field.
+func SizeBytesLTE(v int64) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldSizeBytes, v))
+}
+
+// SizeBytesIsNil applies the IsNil predicate on the "size_bytes" field.
+func SizeBytesIsNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIsNull(FieldSizeBytes))
+}
+
+// SizeBytesNotNil applies the NotNil predicate on the "size_bytes" field.
+func SizeBytesNotNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotNull(FieldSizeBytes))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotNull(FieldEtag))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// ChecksumSha256EQ applies the EQ predicate on the "checksum_sha256" field.
+func ChecksumSha256EQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256NEQ applies the NEQ predicate on the "checksum_sha256" field.
+func ChecksumSha256NEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256In applies the In predicate on the "checksum_sha256" field.
+func ChecksumSha256In(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256NotIn applies the NotIn predicate on the "checksum_sha256" field.
+func ChecksumSha256NotIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256GT applies the GT predicate on the "checksum_sha256" field.
+func ChecksumSha256GT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256GTE applies the GTE predicate on the "checksum_sha256" field.
+func ChecksumSha256GTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LT applies the LT predicate on the "checksum_sha256" field.
+func ChecksumSha256LT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LTE applies the LTE predicate on the "checksum_sha256" field.
+func ChecksumSha256LTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256Contains applies the Contains predicate on the "checksum_sha256" field.
+func ChecksumSha256Contains(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContains(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasPrefix applies the HasPrefix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasPrefix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasPrefix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasSuffix applies the HasSuffix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasSuffix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasSuffix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256IsNil applies the IsNil predicate on the "checksum_sha256" field.
+func ChecksumSha256IsNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIsNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256NotNil applies the NotNil predicate on the "checksum_sha256" field.
+func ChecksumSha256NotNil() predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256EqualFold applies the EqualFold predicate on the "checksum_sha256" field.
+func ChecksumSha256EqualFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEqualFold(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256ContainsFold applies the ContainsFold predicate on the "checksum_sha256" field.
+func ChecksumSha256ContainsFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContainsFold(FieldChecksumSha256, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasContent applies the HasEdge predicate on the "content" edge.
+func HasContent() predicate.ContentRevision {
+	return predicate.ContentRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContentTable, ContentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentWith applies the HasEdge predicate on the "content" edge with a given conditions (other predicates).
+func HasContentWith(preds ...predicate.Content) predicate.ContentRevision {
+	return predicate.ContentRevision(func(s *sql.Selector) {
+		step := newContentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentRevision) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentRevision) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentRevision) predicate.ContentRevision {
+	return predicate.ContentRevision(sql.NotPredicates(p))
+}
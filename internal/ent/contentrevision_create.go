@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentrevision"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentRevisionCreate is the builder for creating a ContentRevision entity.
+type ContentRevisionCreate struct {
+	config
+	mutation *ContentRevisionMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (crc *ContentRevisionCreate) SetOrganizationID(u uuid.UUID) *ContentRevisionCreate {
+	crc.mutation.SetOrganizationID(u)
+	return crc
+}
+
+// SetContentID sets the "content_id" field.
+func (crc *ContentRevisionCreate) SetContentID(u uuid.UUID) *ContentRevisionCreate {
+	crc.mutation.SetContentID(u)
+	return crc
+}
+
+// SetNumber sets the "number" field.
+func (crc *ContentRevisionCreate) SetNumber(i int) *ContentRevisionCreate {
+	crc.mutation.SetNumber(i)
+	return crc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (crc *ContentRevisionCreate) SetStorageKey(s string) *ContentRevisionCreate {
+	crc.mutation.SetStorageKey(s)
+	return crc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (crc *ContentRevisionCreate) SetMimeType(s string) *ContentRevisionCreate {
+	crc.mutation.SetMimeType(s)
+	return crc
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (crc *ContentRevisionCreate) SetSizeBytes(i int64) *ContentRevisionCreate {
+	crc.mutation.SetSizeBytes(i)
+	return crc
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableSizeBytes(i *int64) *ContentRevisionCreate {
+	if i != nil {
+		crc.SetSizeBytes(*i)
+	}
+	return crc
+}
+
+// SetEtag sets the "etag" field.
+func (crc *ContentRevisionCreate) SetEtag(s string) *ContentRevisionCreate {
+	crc.mutation.SetEtag(s)
+	return crc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableEtag(s *string) *ContentRevisionCreate {
+	if s != nil {
+		crc.SetEtag(*s)
+	}
+	return crc
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (crc *ContentRevisionCreate) SetChecksumSha256(s string) *ContentRevisionCreate {
+	crc.mutation.SetChecksumSha256(s)
+	return crc
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableChecksumSha256(s *string) *ContentRevisionCreate {
+	if s != nil {
+		crc.SetChecksumSha256(*s)
+	}
+	return crc
+}
+
+// SetStatus sets the "status" field.
+func (crc *ContentRevisionCreate) SetStatus(s string) *ContentRevisionCreate {
+	crc.mutation.SetStatus(s)
+	return crc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableStatus(s *string) *ContentRevisionCreate {
+	if s != nil {
+		crc.SetStatus(*s)
+	}
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *ContentRevisionCreate) SetCreatedAt(t time.Time) *ContentRevisionCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableCreatedAt(t *time.Time) *ContentRevisionCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *ContentRevisionCreate) SetUpdatedAt(t time.Time) *ContentRevisionCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableUpdatedAt(t *time.Time) *ContentRevisionCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *ContentRevisionCreate) SetID(u uuid.UUID) *ContentRevisionCreate {
+	crc.mutation.SetID(u)
+	return crc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (crc *ContentRevisionCreate) SetNillableID(u *uuid.UUID) *ContentRevisionCreate {
+	if u != nil {
+		crc.SetID(*u)
+	}
+	return crc
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (crc *ContentRevisionCreate) SetContent(c *Content) *ContentRevisionCreate {
+	return crc.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentRevisionMutation object of the builder.
+func (crc *ContentRevisionCreate) Mutation() *ContentRevisionMutation {
+	return crc.mutation
+}
+
+// Save creates the ContentRevision in the database.
+func (crc *ContentRevisionCreate) Save(ctx context.Context) (*ContentRevision, error) {
+	crc.defaults()
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *ContentRevisionCreate) SaveX(ctx context.Context) *ContentRevision {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *ContentRevisionCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *ContentRevisionCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *ContentRevisionCreate) defaults() {
+	if _, ok := crc.mutation.Status(); !ok {
+		v := contentrevision.DefaultStatus
+		crc.mutation.SetStatus(v)
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		v := contentrevision.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		v := contentrevision.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := crc.mutation.ID(); !ok {
+		v := contentrevision.DefaultID()
+		crc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *ContentRevisionCreate) check() error {
+	if _, ok := crc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "ContentRevision.organization_id"`)}
+	}
+	if _, ok := crc.mutation.ContentID(); !ok {
+		return &ValidationError{Name: "content_id", err: errors.New(`ent: missing required field "ContentRevision.content_id"`)}
+	}
+	if _, ok := crc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "ContentRevision.number"`)}
+	}
+	if v, ok := crc.mutation.Number(); ok {
+		if err := contentrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "ContentRevision.number": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "ContentRevision.storage_key"`)}
+	}
+	if v, ok := crc.mutation.StorageKey(); ok {
+		if err := contentrevision.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "ContentRevision.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "ContentRevision.mime_type"`)}
+	}
+	if v, ok := crc.mutation.MimeType(); ok {
+		if err := contentrevision.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "ContentRevision.mime_type": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ContentRevision.status"`)}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContentRevision.created_at"`)}
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ContentRevision.updated_at"`)}
+	}
+	if len(crc.mutation.ContentIDs()) == 0 {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required edge "ContentRevision.content"`)}
+	}
+	return nil
+}
+
+func (crc *ContentRevisionCreate) sqlSave(ctx context.Context) (*ContentRevision, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *ContentRevisionCreate) createSpec() (*ContentRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentRevision{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(contentrevision.Table, sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := crc.mutation.OrganizationID(); ok {
+		_spec.SetField(contentrevision.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := crc.mutation.Number(); ok {
+		_spec.SetField(contentrevision.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := crc.mutation.StorageKey(); ok {
+		_spec.SetField(contentrevision.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := crc.mutation.MimeType(); ok {
+		_spec.SetField(contentrevision.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := crc.mutation.SizeBytes(); ok {
+		_spec.SetField(contentrevision.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := crc.mutation.Etag(); ok {
+		_spec.SetField(contentrevision.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := crc.mutation.ChecksumSha256(); ok {
+		_spec.SetField(contentrevision.FieldChecksumSha256, field.TypeString, value)
+		_node.ChecksumSha256 = value
+	}
+	if value, ok := crc.mutation.Status(); ok {
+		_spec.SetField(contentrevision.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(contentrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := crc.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentrevision.ContentTable,
+			Columns: []string{contentrevision.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContentRevisionCreateBulk is the builder for creating many ContentRevision entities in bulk.
+type ContentRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*ContentRevisionCreate
+}
+
+// Save creates the ContentRevision entities in the database.
+func (crcb *ContentRevisionCreateBulk) Save(ctx context.Context) ([]*ContentRevision, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*ContentRevision, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *ContentRevisionCreateBulk) SaveX(ctx context.Context) []*ContentRevision {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *ContentRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *ContentRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
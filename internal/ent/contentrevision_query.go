@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentrevision"
+	"lms-go/internal/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentRevisionQuery is the builder for querying ContentRevision entities.
+type ContentRevisionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []contentrevision.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ContentRevision
+	withContent *ContentQuery
+	modifiers   []func(*sql.Selector)
+	loadTotal   []func(context.Context, []*ContentRevision) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContentRevisionQuery builder.
+func (crq *ContentRevisionQuery) Where(ps ...predicate.ContentRevision) *ContentRevisionQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit the number of records to be returned by this query.
+func (crq *ContentRevisionQuery) Limit(limit int) *ContentRevisionQuery {
+	crq.ctx.Limit = &limit
+	return crq
+}
+
+// Offset to start from.
+func (crq *ContentRevisionQuery) Offset(offset int) *ContentRevisionQuery {
+	crq.ctx.Offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *ContentRevisionQuery) Unique(unique bool) *ContentRevisionQuery {
+	crq.ctx.Unique = &unique
+	return crq
+}
+
+// Order specifies how the records should be ordered.
+func (crq *ContentRevisionQuery) Order(o ...contentrevision.OrderOption) *ContentRevisionQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// QueryContent chains the current query on the "content" edge.
+func (crq *ContentRevisionQuery) QueryContent() *ContentQuery {
+	query := (&ContentClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentrevision.Table, contentrevision.FieldID, selector),
+			sqlgraph.To(content.Table, content.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentrevision.ContentTable, contentrevision.ContentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContentRevision entity from the query.
+// Returns a *NotFoundError when no ContentRevision was found.
+func (crq *ContentRevisionQuery) First(ctx context.Context) (*ContentRevision, error) {
+	nodes, err := crq.Limit(1).All(setContextOp(ctx, crq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contentrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *ContentRevisionQuery) FirstX(ctx context.Context) *ContentRevision {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContentRevision ID from the query.
+// Returns a *NotFoundError when no ContentRevision ID was found.
+func (crq *ContentRevisionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(1).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contentrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *ContentRevisionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContentRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContentRevision entity is found.
+// Returns a *NotFoundError when no ContentRevision entities are found.
+func (crq *ContentRevisionQuery) Only(ctx context.Context) (*ContentRevision, error) {
+	nodes, err := crq.Limit(2).All(setContextOp(ctx, crq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contentrevision.Label}
+	default:
+		return nil, &NotSingularError{contentrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *ContentRevisionQuery) OnlyX(ctx context.Context) *ContentRevision {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContentRevision ID in the query.
+// Returns a *NotSingularError when more than one ContentRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *ContentRevisionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(2).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contentrevision.Label}
+	default:
+		err = &NotSingularError{contentrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *ContentRevisionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContentRevisions.
+func (crq *ContentRevisionQuery) All(ctx context.Context) ([]*ContentRevision, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryAll)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContentRevision, *ContentRevisionQuery]()
+	return withInterceptors[[]*ContentRevision](ctx, crq, qr, crq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *ContentRevisionQuery) AllX(ctx context.Context) []*ContentRevision {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContentRevision IDs.
+func (crq *ContentRevisionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if crq.ctx.Unique == nil && crq.path != nil {
+		crq.Unique(true)
+	}
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryIDs)
+	if err = crq.Select(contentrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *ContentRevisionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *ContentRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryCount)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, crq, querierCount[*ContentRevisionQuery](), crq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *ContentRevisionQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *ContentRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryExist)
+	switch _, err := crq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *ContentRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContentRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *ContentRevisionQuery) Clone() *ContentRevisionQuery {
+	if crq == nil {
+		return nil
+	}
+	return &ContentRevisionQuery{
+		config:      crq.config,
+		ctx:         crq.ctx.Clone(),
+		order:       append([]contentrevision.OrderOption{}, crq.order...),
+		inters:      append([]Interceptor{}, crq.inters...),
+		predicates:  append([]predicate.ContentRevision{}, crq.predicates...),
+		withContent: crq.withContent.Clone(),
+		// clone intermediate query.
+		sql:  crq.sql.Clone(),
+		path: crq.path,
+	}
+}
+
+// WithContent tells the query-builder to eager-load the nodes that are connected to
+// the "content" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ContentRevisionQuery) WithContent(opts ...func(*ContentQuery)) *ContentRevisionQuery {
+	query := (&ContentClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withContent = query
+	return crq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContentRevision.Query().
+//		GroupBy(contentrevision.FieldOrganizationID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (crq *ContentRevisionQuery) GroupBy(field string, fields ...string) *ContentRevisionGroupBy {
+	crq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContentRevisionGroupBy{build: crq}
+	grbuild.flds = &crq.ctx.Fields
+	grbuild.label = contentrevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//	}
+//
+//	client.ContentRevision.Query().
+//		Select(contentrevision.FieldOrganizationID).
+//		Scan(ctx, &v)
+func (crq *ContentRevisionQuery) Select(fields ...string) *ContentRevisionSelect {
+	crq.ctx.Fields = append(crq.ctx.Fields, fields...)
+	sbuild := &ContentRevisionSelect{ContentRevisionQuery: crq}
+	sbuild.label = contentrevision.Label
+	sbuild.flds, sbuild.scan = &crq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContentRevisionSelect configured with the given aggregations.
+func (crq *ContentRevisionQuery) Aggregate(fns ...AggregateFunc) *ContentRevisionSelect {
+	return crq.Select().Aggregate(fns...)
+}
+
+func (crq *ContentRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range crq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, crq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range crq.ctx.Fields {
+		if !contentrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	return nil
+}
+
+func (crq *ContentRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContentRevision, error) {
+	var (
+		nodes       = []*ContentRevision{}
+		_spec       = crq.querySpec()
+		loadedTypes = [1]bool{
+			crq.withContent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContentRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContentRevision{config: crq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := crq.withContent; query != nil {
+		if err := crq.loadContent(ctx, query, nodes, nil,
+			func(n *ContentRevision, e *Content) { n.Edges.Content = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range crq.loadTotal {
+		if err := crq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (crq *ContentRevisionQuery) loadContent(ctx context.Context, query *ContentQuery, nodes []*ContentRevision, init func(*ContentRevision), assign func(*ContentRevision, *Content)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ContentRevision)
+	for i := range nodes {
+		fk := nodes[i].ContentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(content.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "content_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (crq *ContentRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	_spec.Node.Columns = crq.ctx.Fields
+	if len(crq.ctx.Fields) > 0 {
+		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *ContentRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contentrevision.Table, contentrevision.Columns, sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID))
+	_spec.From = crq.sql
+	if unique := crq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if crq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := crq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentrevision.FieldID)
+		for i := range fields {
+			if fields[i] != contentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if crq.withContent != nil {
+			_spec.Node.AddColumnOnce(contentrevision.FieldContentID)
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *ContentRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(contentrevision.Table)
+	columns := crq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contentrevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.ctx.Unique != nil && *crq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContentRevisionGroupBy is the group-by builder for ContentRevision entities.
+type ContentRevisionGroupBy struct {
+	selector
+	build *ContentRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *ContentRevisionGroupBy) Aggregate(fns ...AggregateFunc) *ContentRevisionGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crgb *ContentRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crgb.build.ctx, ent.OpQueryGroupBy)
+	if err := crgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentRevisionQuery, *ContentRevisionGroupBy](ctx, crgb.build, crgb, crgb.build.inters, v)
+}
+
+func (crgb *ContentRevisionGroupBy) sqlScan(ctx context.Context, root *ContentRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*crgb.flds)+len(crgb.fns))
+		for _, f := range *crgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*crgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContentRevisionSelect is the builder for selecting fields of ContentRevision entities.
+type ContentRevisionSelect struct {
+	*ContentRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (crs *ContentRevisionSelect) Aggregate(fns ...AggregateFunc) *ContentRevisionSelect {
+	crs.fns = append(crs.fns, fns...)
+	return crs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *ContentRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crs.ctx, ent.OpQuerySelect)
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentRevisionQuery, *ContentRevisionSelect](ctx, crs.ContentRevisionQuery, crs, crs.inters, v)
+}
+
+func (crs *ContentRevisionSelect) sqlScan(ctx context.Context, root *ContentRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(crs.fns))
+	for _, fn := range crs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*crs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
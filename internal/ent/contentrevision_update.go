@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentrevision"
+	"lms-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ContentRevisionUpdate is the builder for updating ContentRevision entities.
+type ContentRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentRevisionMutation
+}
+
+// Where appends a list predicates to the ContentRevisionUpdate builder.
+func (cru *ContentRevisionUpdate) Where(ps ...predicate.ContentRevision) *ContentRevisionUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (cru *ContentRevisionUpdate) SetOrganizationID(u uuid.UUID) *ContentRevisionUpdate {
+	cru.mutation.SetOrganizationID(u)
+	return cru
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableOrganizationID(u *uuid.UUID) *ContentRevisionUpdate {
+	if u != nil {
+		cru.SetOrganizationID(*u)
+	}
+	return cru
+}
+
+// SetContentID sets the "content_id" field.
+func (cru *ContentRevisionUpdate) SetContentID(u uuid.UUID) *ContentRevisionUpdate {
+	cru.mutation.SetContentID(u)
+	return cru
+}
+
+// SetNillableContentID sets the "content_id" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableContentID(u *uuid.UUID) *ContentRevisionUpdate {
+	if u != nil {
+		cru.SetContentID(*u)
+	}
+	return cru
+}
+
+// SetMimeType sets the "mime_type" field.
+func (cru *ContentRevisionUpdate) SetMimeType(s string) *ContentRevisionUpdate {
+	cru.mutation.SetMimeType(s)
+	return cru
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableMimeType(s *string) *ContentRevisionUpdate {
+	if s != nil {
+		cru.SetMimeType(*s)
+	}
+	return cru
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (cru *ContentRevisionUpdate) SetSizeBytes(i int64) *ContentRevisionUpdate {
+	cru.mutation.ResetSizeBytes()
+	cru.mutation.SetSizeBytes(i)
+	return cru
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableSizeBytes(i *int64) *ContentRevisionUpdate {
+	if i != nil {
+		cru.SetSizeBytes(*i)
+	}
+	return cru
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (cru *ContentRevisionUpdate) AddSizeBytes(i int64) *ContentRevisionUpdate {
+	cru.mutation.AddSizeBytes(i)
+	return cru
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (cru *ContentRevisionUpdate) ClearSizeBytes() *ContentRevisionUpdate {
+	cru.mutation.ClearSizeBytes()
+	return cru
+}
+
+// SetEtag sets the "etag" field.
+func (cru *ContentRevisionUpdate) SetEtag(s string) *ContentRevisionUpdate {
+	cru.mutation.SetEtag(s)
+	return cru
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableEtag(s *string) *ContentRevisionUpdate {
+	if s != nil {
+		cru.SetEtag(*s)
+	}
+	return cru
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (cru *ContentRevisionUpdate) ClearEtag() *ContentRevisionUpdate {
+	cru.mutation.ClearEtag()
+	return cru
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (cru *ContentRevisionUpdate) SetChecksumSha256(s string) *ContentRevisionUpdate {
+	cru.mutation.SetChecksumSha256(s)
+	return cru
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableChecksumSha256(s *string) *ContentRevisionUpdate {
+	if s != nil {
+		cru.SetChecksumSha256(*s)
+	}
+	return cru
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (cru *ContentRevisionUpdate) ClearChecksumSha256() *ContentRevisionUpdate {
+	cru.mutation.ClearChecksumSha256()
+	return cru
+}
+
+// SetStatus sets the "status" field.
+func (cru *ContentRevisionUpdate) SetStatus(s string) *ContentRevisionUpdate {
+	cru.mutation.SetStatus(s)
+	return cru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cru *ContentRevisionUpdate) SetNillableStatus(s *string) *ContentRevisionUpdate {
+	if s != nil {
+		cru.SetStatus(*s)
+	}
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *ContentRevisionUpdate) SetUpdatedAt(t time.Time) *ContentRevisionUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (cru *ContentRevisionUpdate) SetContent(c *Content) *ContentRevisionUpdate {
+	return cru.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentRevisionMutation object of the builder.
+func (cru *ContentRevisionUpdate) Mutation() *ContentRevisionMutation {
+	return cru.mutation
+}
+
+// ClearContent clears the "content" edge to the Content entity.
+func (cru *ContentRevisionUpdate) ClearContent() *ContentRevisionUpdate {
+	cru.mutation.ClearContent()
+	return cru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *ContentRevisionUpdate) Save(ctx context.Context) (int, error) {
+	cru.defaults()
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *ContentRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *ContentRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *ContentRevisionUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cru *ContentRevisionUpdate) defaults() {
+	if _, ok := cru.mutation.UpdatedAt(); !ok {
+		v := contentrevision.UpdateDefaultUpdatedAt()
+		cru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *ContentRevisionUpdate) check() error {
+	if v, ok := cru.mutation.MimeType(); ok {
+		if err := contentrevision.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "ContentRevision.mime_type": %w`, err)}
+		}
+	}
+	if cru.mutation.ContentCleared() && len(cru.mutation.ContentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentRevision.content"`)
+	}
+	return nil
+}
+
+func (cru *ContentRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentrevision.Table, contentrevision.Columns, sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.OrganizationID(); ok {
+		_spec.SetField(contentrevision.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := cru.mutation.MimeType(); ok {
+		_spec.SetField(contentrevision.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.SizeBytes(); ok {
+		_spec.SetField(contentrevision.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := cru.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(contentrevision.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if cru.mutation.SizeBytesCleared() {
+		_spec.ClearField(contentrevision.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := cru.mutation.Etag(); ok {
+		_spec.SetField(contentrevision.FieldEtag, field.TypeString, value)
+	}
+	if cru.mutation.EtagCleared() {
+		_spec.ClearField(contentrevision.FieldEtag, field.TypeString)
+	}
+	if value, ok := cru.mutation.ChecksumSha256(); ok {
+		_spec.SetField(contentrevision.FieldChecksumSha256, field.TypeString, value)
+	}
+	if cru.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(contentrevision.FieldChecksumSha256, field.TypeString)
+	}
+	if value, ok := cru.mutation.Status(); ok {
+		_spec.SetField(contentrevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cru.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentrevision.ContentTable,
+			Columns: []string{contentrevision.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentrevision.ContentTable,
+			Columns: []string{contentrevision.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// ContentRevisionUpdateOne is the builder for updating a single ContentRevision entity.
+type ContentRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentRevisionMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (cruo *ContentRevisionUpdateOne) SetOrganizationID(u uuid.UUID) *ContentRevisionUpdateOne {
+	cruo.mutation.SetOrganizationID(u)
+	return cruo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *ContentRevisionUpdateOne {
+	if u != nil {
+		cruo.SetOrganizationID(*u)
+	}
+	return cruo
+}
+
+// SetContentID sets the "content_id" field.
+func (cruo *ContentRevisionUpdateOne) SetContentID(u uuid.UUID) *ContentRevisionUpdateOne {
+	cruo.mutation.SetContentID(u)
+	return cruo
+}
+
+// SetNillableContentID sets the "content_id" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableContentID(u *uuid.UUID) *ContentRevisionUpdateOne {
+	if u != nil {
+		cruo.SetContentID(*u)
+	}
+	return cruo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (cruo *ContentRevisionUpdateOne) SetMimeType(s string) *ContentRevisionUpdateOne {
+	cruo.mutation.SetMimeType(s)
+	return cruo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableMimeType(s *string) *ContentRevisionUpdateOne {
+	if s != nil {
+		cruo.SetMimeType(*s)
+	}
+	return cruo
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (cruo *ContentRevisionUpdateOne) SetSizeBytes(i int64) *ContentRevisionUpdateOne {
+	cruo.mutation.ResetSizeBytes()
+	cruo.mutation.SetSizeBytes(i)
+	return cruo
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableSizeBytes(i *int64) *ContentRevisionUpdateOne {
+	if i != nil {
+		cruo.SetSizeBytes(*i)
+	}
+	return cruo
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (cruo *ContentRevisionUpdateOne) AddSizeBytes(i int64) *ContentRevisionUpdateOne {
+	cruo.mutation.AddSizeBytes(i)
+	return cruo
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (cruo *ContentRevisionUpdateOne) ClearSizeBytes() *ContentRevisionUpdateOne {
+	cruo.mutation.ClearSizeBytes()
+	return cruo
+}
+
+// SetEtag sets the "etag" field.
+func (cruo *ContentRevisionUpdateOne) SetEtag(s string) *ContentRevisionUpdateOne {
+	cruo.mutation.SetEtag(s)
+	return cruo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableEtag(s *string) *ContentRevisionUpdateOne {
+	if s != nil {
+		cruo.SetEtag(*s)
+	}
+	return cruo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (cruo *ContentRevisionUpdateOne) ClearEtag() *ContentRevisionUpdateOne {
+	cruo.mutation.ClearEtag()
+	return cruo
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (cruo *ContentRevisionUpdateOne) SetChecksumSha256(s string) *ContentRevisionUpdateOne {
+	cruo.mutation.SetChecksumSha256(s)
+	return cruo
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableChecksumSha256(s *string) *ContentRevisionUpdateOne {
+	if s != nil {
+		cruo.SetChecksumSha256(*s)
+	}
+	return cruo
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (cruo *ContentRevisionUpdateOne) ClearChecksumSha256() *ContentRevisionUpdateOne {
+	cruo.mutation.ClearChecksumSha256()
+	return cruo
+}
+
+// SetStatus sets the "status" field.
+func (cruo *ContentRevisionUpdateOne) SetStatus(s string) *ContentRevisionUpdateOne {
+	cruo.mutation.SetStatus(s)
+	return cruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cruo *ContentRevisionUpdateOne) SetNillableStatus(s *string) *ContentRevisionUpdateOne {
+	if s != nil {
+		cruo.SetStatus(*s)
+	}
+	return cruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *ContentRevisionUpdateOne) SetUpdatedAt(t time.Time) *ContentRevisionUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// SetContent sets the "content" edge to the Content entity.
+func (cruo *ContentRevisionUpdateOne) SetContent(c *Content) *ContentRevisionUpdateOne {
+	return cruo.SetContentID(c.ID)
+}
+
+// Mutation returns the ContentRevisionMutation object of the builder.
+func (cruo *ContentRevisionUpdateOne) Mutation() *ContentRevisionMutation {
+	return cruo.mutation
+}
+
+// ClearContent clears the "content" edge to the Content entity.
+func (cruo *ContentRevisionUpdateOne) ClearContent() *ContentRevisionUpdateOne {
+	cruo.mutation.ClearContent()
+	return cruo
+}
+
+// Where appends a list predicates to the ContentRevisionUpdate builder.
+func (cruo *ContentRevisionUpdateOne) Where(ps ...predicate.ContentRevision) *ContentRevisionUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *ContentRevisionUpdateOne) Select(field string, fields ...string) *ContentRevisionUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated ContentRevision entity.
+func (cruo *ContentRevisionUpdateOne) Save(ctx context.Context) (*ContentRevision, error) {
+	cruo.defaults()
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *ContentRevisionUpdateOne) SaveX(ctx context.Context) *ContentRevision {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *ContentRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *ContentRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cruo *ContentRevisionUpdateOne) defaults() {
+	if _, ok := cruo.mutation.UpdatedAt(); !ok {
+		v := contentrevision.UpdateDefaultUpdatedAt()
+		cruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *ContentRevisionUpdateOne) check() error {
+	if v, ok := cruo.mutation.MimeType(); ok {
+		if err := contentrevision.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "ContentRevision.mime_type": %w`, err)}
+		}
+	}
+	if cruo.mutation.ContentCleared() && len(cruo.mutation.ContentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentRevision.content"`)
+	}
+	return nil
+}
+
+func (cruo *ContentRevisionUpdateOne) sqlSave(ctx context.Context) (_node *ContentRevision, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentrevision.Table, contentrevision.Columns, sqlgraph.NewFieldSpec(contentrevision.FieldID, field.TypeUUID))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentrevision.FieldID)
+		for _, f := range fields {
+			if !contentrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.OrganizationID(); ok {
+		_spec.SetField(contentrevision.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := cruo.mutation.MimeType(); ok {
+		_spec.SetField(contentrevision.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.SizeBytes(); ok {
+		_spec.SetField(contentrevision.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := cruo.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(contentrevision.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if cruo.mutation.SizeBytesCleared() {
+		_spec.ClearField(contentrevision.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := cruo.mutation.Etag(); ok {
+		_spec.SetField(contentrevision.FieldEtag, field.TypeString, value)
+	}
+	if cruo.mutation.EtagCleared() {
+		_spec.ClearField(contentrevision.FieldEtag, field.TypeString)
+	}
+	if value, ok := cruo.mutation.ChecksumSha256(); ok {
+		_spec.SetField(contentrevision.FieldChecksumSha256, field.TypeString, value)
+	}
+	if cruo.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(contentrevision.FieldChecksumSha256, field.TypeString)
+	}
+	if value, ok := cruo.mutation.Status(); ok {
+		_spec.SetField(contentrevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cruo.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentrevision.ContentTable,
+			Columns: []string{contentrevision.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentrevision.ContentTable,
+			Columns: []string{contentrevision.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(content.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContentRevision{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
@@ -59,6 +59,12 @@ type CourseEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [4]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedModules     map[string][]*Module
+	namedEnrollments map[string][]*Enrollment
+	namedGroups      map[string][]*Group
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -289,5 +295,77 @@ func (c *Course) String() string {
 	return builder.String()
 }
 
+// NamedModules returns the Modules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Course) NamedModules(name string) ([]*Module, error) {
+	if c.Edges.namedModules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedModules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Course) appendNamedModules(name string, edges ...*Module) {
+	if c.Edges.namedModules == nil {
+		c.Edges.namedModules = make(map[string][]*Module)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedModules[name] = []*Module{}
+	} else {
+		c.Edges.namedModules[name] = append(c.Edges.namedModules[name], edges...)
+	}
+}
+
+// NamedEnrollments returns the Enrollments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Course) NamedEnrollments(name string) ([]*Enrollment, error) {
+	if c.Edges.namedEnrollments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedEnrollments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Course) appendNamedEnrollments(name string, edges ...*Enrollment) {
+	if c.Edges.namedEnrollments == nil {
+		c.Edges.namedEnrollments = make(map[string][]*Enrollment)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedEnrollments[name] = []*Enrollment{}
+	} else {
+		c.Edges.namedEnrollments[name] = append(c.Edges.namedEnrollments[name], edges...)
+	}
+}
+
+// NamedGroups returns the Groups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Course) NamedGroups(name string) ([]*Group, error) {
+	if c.Edges.namedGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Course) appendNamedGroups(name string, edges ...*Group) {
+	if c.Edges.namedGroups == nil {
+		c.Edges.namedGroups = make(map[string][]*Group)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedGroups[name] = []*Group{}
+	} else {
+		c.Edges.namedGroups[name] = append(c.Edges.namedGroups[name], edges...)
+	}
+}
+
 // Courses is a parsable slice of Course.
 type Courses []*Course
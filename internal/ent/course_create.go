@@ -291,7 +291,7 @@ func (cc *CourseCreate) check() error {
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Course.updated_at"`)}
 	}
-	if _, ok := cc.mutation.OrganizationID(); !ok {
+	if len(cc.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Course.organization"`)}
 	}
 	return nil
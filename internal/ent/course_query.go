@@ -14,6 +14,7 @@ import (
 	"lms-go/internal/ent/predicate"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,14 +24,19 @@ import (
 // CourseQuery is the builder for querying Course entities.
 type CourseQuery struct {
 	config
-	ctx              *QueryContext
-	order            []course.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Course
-	withOrganization *OrganizationQuery
-	withModules      *ModuleQuery
-	withEnrollments  *EnrollmentQuery
-	withGroups       *GroupQuery
+	ctx                  *QueryContext
+	order                []course.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Course
+	withOrganization     *OrganizationQuery
+	withModules          *ModuleQuery
+	withEnrollments      *EnrollmentQuery
+	withGroups           *GroupQuery
+	modifiers            []func(*sql.Selector)
+	loadTotal            []func(context.Context, []*Course) error
+	withNamedModules     map[string]*ModuleQuery
+	withNamedEnrollments map[string]*EnrollmentQuery
+	withNamedGroups      map[string]*GroupQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -158,7 +164,7 @@ func (cq *CourseQuery) QueryGroups() *GroupQuery {
 // First returns the first Course entity from the query.
 // Returns a *NotFoundError when no Course was found.
 func (cq *CourseQuery) First(ctx context.Context) (*Course, error) {
-	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, "First"))
+	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +187,7 @@ func (cq *CourseQuery) FirstX(ctx context.Context) *Course {
 // Returns a *NotFoundError when no Course ID was found.
 func (cq *CourseQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, "FirstID")); err != nil {
+	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -204,7 +210,7 @@ func (cq *CourseQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one Course entity is found.
 // Returns a *NotFoundError when no Course entities are found.
 func (cq *CourseQuery) Only(ctx context.Context) (*Course, error) {
-	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, "Only"))
+	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +238,7 @@ func (cq *CourseQuery) OnlyX(ctx context.Context) *Course {
 // Returns a *NotFoundError when no entities are found.
 func (cq *CourseQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, "OnlyID")); err != nil {
+	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -257,7 +263,7 @@ func (cq *CourseQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of Courses.
 func (cq *CourseQuery) All(ctx context.Context) ([]*Course, error) {
-	ctx = setContextOp(ctx, cq.ctx, "All")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryAll)
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -279,7 +285,7 @@ func (cq *CourseQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
 	if cq.ctx.Unique == nil && cq.path != nil {
 		cq.Unique(true)
 	}
-	ctx = setContextOp(ctx, cq.ctx, "IDs")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryIDs)
 	if err = cq.Select(course.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -297,7 +303,7 @@ func (cq *CourseQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (cq *CourseQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, cq.ctx, "Count")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryCount)
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -315,7 +321,7 @@ func (cq *CourseQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (cq *CourseQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, cq.ctx, "Exist")
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryExist)
 	switch _, err := cq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -495,6 +501,9 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -531,6 +540,32 @@ func (cq *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 			return nil, err
 		}
 	}
+	for name, query := range cq.withNamedModules {
+		if err := cq.loadModules(ctx, query, nodes,
+			func(n *Course) { n.appendNamedModules(name) },
+			func(n *Course, e *Module) { n.appendNamedModules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedEnrollments {
+		if err := cq.loadEnrollments(ctx, query, nodes,
+			func(n *Course) { n.appendNamedEnrollments(name) },
+			func(n *Course, e *Enrollment) { n.appendNamedEnrollments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedGroups {
+		if err := cq.loadGroups(ctx, query, nodes,
+			func(n *Course) { n.appendNamedGroups(name) },
+			func(n *Course, e *Group) { n.appendNamedGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cq.loadTotal {
+		if err := cq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -659,6 +694,9 @@ func (cq *CourseQuery) loadGroups(ctx context.Context, query *GroupQuery, nodes
 
 func (cq *CourseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -741,6 +779,48 @@ func (cq *CourseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedModules tells the query-builder to eager-load the nodes that are connected to the "modules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithNamedModules(name string, opts ...func(*ModuleQuery)) *CourseQuery {
+	query := (&ModuleClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedModules == nil {
+		cq.withNamedModules = make(map[string]*ModuleQuery)
+	}
+	cq.withNamedModules[name] = query
+	return cq
+}
+
+// WithNamedEnrollments tells the query-builder to eager-load the nodes that are connected to the "enrollments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithNamedEnrollments(name string, opts ...func(*EnrollmentQuery)) *CourseQuery {
+	query := (&EnrollmentClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedEnrollments == nil {
+		cq.withNamedEnrollments = make(map[string]*EnrollmentQuery)
+	}
+	cq.withNamedEnrollments[name] = query
+	return cq
+}
+
+// WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CourseQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *CourseQuery {
+	query := (&GroupClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedGroups == nil {
+		cq.withNamedGroups = make(map[string]*GroupQuery)
+	}
+	cq.withNamedGroups[name] = query
+	return cq
+}
+
 // CourseGroupBy is the group-by builder for Course entities.
 type CourseGroupBy struct {
 	selector
@@ -755,7 +835,7 @@ func (cgb *CourseGroupBy) Aggregate(fns ...AggregateFunc) *CourseGroupBy {
 
 // Scan applies the selector query and scans the result into the given value.
 func (cgb *CourseGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, cgb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, cgb.build.ctx, ent.OpQueryGroupBy)
 	if err := cgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -803,7 +883,7 @@ func (cs *CourseSelect) Aggregate(fns ...AggregateFunc) *CourseSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (cs *CourseSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, cs.ctx, "Select")
+	ctx = setContextOp(ctx, cs.ctx, ent.OpQuerySelect)
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -340,7 +340,7 @@ func (cu *CourseUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Course.slug": %w`, err)}
 		}
 	}
-	if _, ok := cu.mutation.OrganizationID(); cu.mutation.OrganizationCleared() && !ok {
+	if cu.mutation.OrganizationCleared() && len(cu.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Course.organization"`)
 	}
 	return nil
@@ -898,7 +898,7 @@ func (cuo *CourseUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Course.slug": %w`, err)}
 		}
 	}
-	if _, ok := cuo.mutation.OrganizationID(); cuo.mutation.OrganizationCleared() && !ok {
+	if cuo.mutation.OrganizationCleared() && len(cuo.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Course.organization"`)
 	}
 	return nil
@@ -66,6 +66,10 @@ type EnrollmentEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [4]map[string]int
+
+	namedProgressEntries map[string][]*ModuleProgress
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -333,5 +337,29 @@ func (e *Enrollment) String() string {
 	return builder.String()
 }
 
+// NamedProgressEntries returns the ProgressEntries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (e *Enrollment) NamedProgressEntries(name string) ([]*ModuleProgress, error) {
+	if e.Edges.namedProgressEntries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := e.Edges.namedProgressEntries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (e *Enrollment) appendNamedProgressEntries(name string, edges ...*ModuleProgress) {
+	if e.Edges.namedProgressEntries == nil {
+		e.Edges.namedProgressEntries = make(map[string][]*ModuleProgress)
+	}
+	if len(edges) == 0 {
+		e.Edges.namedProgressEntries[name] = []*ModuleProgress{}
+	} else {
+		e.Edges.namedProgressEntries[name] = append(e.Edges.namedProgressEntries[name], edges...)
+	}
+}
+
 // Enrollments is a parsable slice of Enrollment.
 type Enrollments []*Enrollment
@@ -281,13 +281,13 @@ func (ec *EnrollmentCreate) check() error {
 	if _, ok := ec.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Enrollment.updated_at"`)}
 	}
-	if _, ok := ec.mutation.OrganizationID(); !ok {
+	if len(ec.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Enrollment.organization"`)}
 	}
-	if _, ok := ec.mutation.CourseID(); !ok {
+	if len(ec.mutation.CourseIDs()) == 0 {
 		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "Enrollment.course"`)}
 	}
-	if _, ok := ec.mutation.UserID(); !ok {
+	if len(ec.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Enrollment.user"`)}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"lms-go/internal/ent/user"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,15 +25,18 @@ import (
 // EnrollmentQuery is the builder for querying Enrollment entities.
 type EnrollmentQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []enrollment.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Enrollment
-	withOrganization    *OrganizationQuery
-	withCourse          *CourseQuery
-	withUser            *UserQuery
-	withGroup           *GroupQuery
-	withProgressEntries *ModuleProgressQuery
+	ctx                      *QueryContext
+	order                    []enrollment.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Enrollment
+	withOrganization         *OrganizationQuery
+	withCourse               *CourseQuery
+	withUser                 *UserQuery
+	withGroup                *GroupQuery
+	withProgressEntries      *ModuleProgressQuery
+	modifiers                []func(*sql.Selector)
+	loadTotal                []func(context.Context, []*Enrollment) error
+	withNamedProgressEntries map[string]*ModuleProgressQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -182,7 +186,7 @@ func (eq *EnrollmentQuery) QueryProgressEntries() *ModuleProgressQuery {
 // First returns the first Enrollment entity from the query.
 // Returns a *NotFoundError when no Enrollment was found.
 func (eq *EnrollmentQuery) First(ctx context.Context) (*Enrollment, error) {
-	nodes, err := eq.Limit(1).All(setContextOp(ctx, eq.ctx, "First"))
+	nodes, err := eq.Limit(1).All(setContextOp(ctx, eq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +209,7 @@ func (eq *EnrollmentQuery) FirstX(ctx context.Context) *Enrollment {
 // Returns a *NotFoundError when no Enrollment ID was found.
 func (eq *EnrollmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = eq.Limit(1).IDs(setContextOp(ctx, eq.ctx, "FirstID")); err != nil {
+	if ids, err = eq.Limit(1).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -228,7 +232,7 @@ func (eq *EnrollmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one Enrollment entity is found.
 // Returns a *NotFoundError when no Enrollment entities are found.
 func (eq *EnrollmentQuery) Only(ctx context.Context) (*Enrollment, error) {
-	nodes, err := eq.Limit(2).All(setContextOp(ctx, eq.ctx, "Only"))
+	nodes, err := eq.Limit(2).All(setContextOp(ctx, eq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +260,7 @@ func (eq *EnrollmentQuery) OnlyX(ctx context.Context) *Enrollment {
 // Returns a *NotFoundError when no entities are found.
 func (eq *EnrollmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = eq.Limit(2).IDs(setContextOp(ctx, eq.ctx, "OnlyID")); err != nil {
+	if ids, err = eq.Limit(2).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -281,7 +285,7 @@ func (eq *EnrollmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of Enrollments.
 func (eq *EnrollmentQuery) All(ctx context.Context) ([]*Enrollment, error) {
-	ctx = setContextOp(ctx, eq.ctx, "All")
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryAll)
 	if err := eq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -303,7 +307,7 @@ func (eq *EnrollmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error)
 	if eq.ctx.Unique == nil && eq.path != nil {
 		eq.Unique(true)
 	}
-	ctx = setContextOp(ctx, eq.ctx, "IDs")
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryIDs)
 	if err = eq.Select(enrollment.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -321,7 +325,7 @@ func (eq *EnrollmentQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (eq *EnrollmentQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, eq.ctx, "Count")
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryCount)
 	if err := eq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -339,7 +343,7 @@ func (eq *EnrollmentQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (eq *EnrollmentQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, eq.ctx, "Exist")
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryExist)
 	switch _, err := eq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -532,6 +536,9 @@ func (eq *EnrollmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -572,6 +579,18 @@ func (eq *EnrollmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 			return nil, err
 		}
 	}
+	for name, query := range eq.withNamedProgressEntries {
+		if err := eq.loadProgressEntries(ctx, query, nodes,
+			func(n *Enrollment) { n.appendNamedProgressEntries(name) },
+			func(n *Enrollment, e *ModuleProgress) { n.appendNamedProgressEntries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range eq.loadTotal {
+		if err := eq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -727,6 +746,9 @@ func (eq *EnrollmentQuery) loadProgressEntries(ctx context.Context, query *Modul
 
 func (eq *EnrollmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	_spec.Node.Columns = eq.ctx.Fields
 	if len(eq.ctx.Fields) > 0 {
 		_spec.Unique = eq.ctx.Unique != nil && *eq.ctx.Unique
@@ -818,6 +840,20 @@ func (eq *EnrollmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedProgressEntries tells the query-builder to eager-load the nodes that are connected to the "progress_entries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnrollmentQuery) WithNamedProgressEntries(name string, opts ...func(*ModuleProgressQuery)) *EnrollmentQuery {
+	query := (&ModuleProgressClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if eq.withNamedProgressEntries == nil {
+		eq.withNamedProgressEntries = make(map[string]*ModuleProgressQuery)
+	}
+	eq.withNamedProgressEntries[name] = query
+	return eq
+}
+
 // EnrollmentGroupBy is the group-by builder for Enrollment entities.
 type EnrollmentGroupBy struct {
 	selector
@@ -832,7 +868,7 @@ func (egb *EnrollmentGroupBy) Aggregate(fns ...AggregateFunc) *EnrollmentGroupBy
 
 // Scan applies the selector query and scans the result into the given value.
 func (egb *EnrollmentGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, egb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, egb.build.ctx, ent.OpQueryGroupBy)
 	if err := egb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -880,7 +916,7 @@ func (es *EnrollmentSelect) Aggregate(fns ...AggregateFunc) *EnrollmentSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (es *EnrollmentSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, es.ctx, "Select")
+	ctx = setContextOp(ctx, es.ctx, ent.OpQuerySelect)
 	if err := es.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -312,13 +312,13 @@ func (eu *EnrollmentUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (eu *EnrollmentUpdate) check() error {
-	if _, ok := eu.mutation.OrganizationID(); eu.mutation.OrganizationCleared() && !ok {
+	if eu.mutation.OrganizationCleared() && len(eu.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.organization"`)
 	}
-	if _, ok := eu.mutation.CourseID(); eu.mutation.CourseCleared() && !ok {
+	if eu.mutation.CourseCleared() && len(eu.mutation.CourseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.course"`)
 	}
-	if _, ok := eu.mutation.UserID(); eu.mutation.UserCleared() && !ok {
+	if eu.mutation.UserCleared() && len(eu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.user"`)
 	}
 	return nil
@@ -838,13 +838,13 @@ func (euo *EnrollmentUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (euo *EnrollmentUpdateOne) check() error {
-	if _, ok := euo.mutation.OrganizationID(); euo.mutation.OrganizationCleared() && !ok {
+	if euo.mutation.OrganizationCleared() && len(euo.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.organization"`)
 	}
-	if _, ok := euo.mutation.CourseID(); euo.mutation.CourseCleared() && !ok {
+	if euo.mutation.CourseCleared() && len(euo.mutation.CourseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.course"`)
 	}
-	if _, ok := euo.mutation.UserID(); euo.mutation.UserCleared() && !ok {
+	if euo.mutation.UserCleared() && len(euo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Enrollment.user"`)
 	}
 	return nil
@@ -6,14 +6,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"lms-go/internal/ent/apikey"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/course"
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
+	"lms-go/internal/ent/idempotencykey"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/moduleprogress"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/samlassertion"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthnceremony"
+	"lms-go/internal/ent/webauthncredential"
 	"reflect"
 	"sync"
 
@@ -80,14 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			content.Table:        content.ValidColumn,
-			course.Table:         course.ValidColumn,
-			enrollment.Table:     enrollment.ValidColumn,
-			group.Table:          group.ValidColumn,
-			module.Table:         module.ValidColumn,
-			moduleprogress.Table: moduleprogress.ValidColumn,
-			organization.Table:   organization.ValidColumn,
-			user.Table:           user.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			content.Table:            content.ValidColumn,
+			contentdownload.Table:    contentdownload.ValidColumn,
+			contentrevision.Table:    contentrevision.ValidColumn,
+			course.Table:             course.ValidColumn,
+			enrollment.Table:         enrollment.ValidColumn,
+			group.Table:              group.ValidColumn,
+			idempotencykey.Table:     idempotencykey.ValidColumn,
+			module.Table:             module.ValidColumn,
+			moduleprogress.Table:     moduleprogress.ValidColumn,
+			organization.Table:       organization.ValidColumn,
+			privacyrequest.Table:     privacyrequest.ValidColumn,
+			ssoidentity.Table:        ssoidentity.ValidColumn,
+			samlassertion.Table:      samlassertion.ValidColumn,
+			scimtoken.Table:          scimtoken.ValidColumn,
+			serviceaccount.Table:     serviceaccount.ValidColumn,
+			user.Table:               user.ValidColumn,
+			webauthnceremony.Table:   webauthnceremony.ValidColumn,
+			webauthncredential.Table: webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,61 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+	"slices"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Génère le client ent et, via entgql, le schéma GraphQL des entités exposées
+// (internal/graph/ent.graphql) : types, connexions Relay, tris et filtres where.
+func main() {
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithWhereInputs(true),
+		entgql.WithConfigPath("../graph/gqlgen.yml"),
+		entgql.WithSchemaPath("../graph/ent.graphql"),
+		entgql.WithSchemaHook(nullableRefs),
+	)
+	if err != nil {
+		log.Fatalf("entgql : %v", err)
+	}
+	err = entc.Generate("./schema", &gen.Config{
+		Features: []gen.Feature{gen.FeatureExecQuery},
+	}, entc.Extensions(ex))
+	if err != nil {
+		log.Fatalf("ent : %v", err)
+	}
+}
+
+// nullableRefs rend nullables les connexions de Query et les arêtes simples : une entité
+// invisible de l'appelant, ou un refus (scope manquant d'une clé d'API), donne null sans
+// effacer le reste de la réponse. Il retire aussi le forceResolver: false qu'entgql pose sur
+// les arêtes renommées, pour que gqlgen.yml décide seul des resolvers.
+func nullableRefs(_ *gen.Graph, s *ast.Schema) error {
+	for _, def := range s.Types {
+		if def.Kind != ast.Object {
+			continue
+		}
+		for _, f := range def.Fields {
+			if d := f.Directives.ForName("goField"); d != nil {
+				d.Arguments = slices.DeleteFunc(d.Arguments, func(a *ast.Argument) bool {
+					return a.Name == "forceResolver" && a.Value.Raw == "false"
+				})
+			}
+			target := s.Types[f.Type.NamedType]
+			if target == nil || target.Kind != ast.Object {
+				continue
+			}
+			if def.Name == "Query" || slices.Contains(target.Interfaces, "Node") {
+				f.Type.NonNull = false
+			}
+		}
+	}
+	return nil
+}
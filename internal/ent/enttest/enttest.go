@@ -4,6 +4,7 @@ package enttest
 
 import (
 	"context"
+
 	"lms-go/internal/ent"
 	// required by schema hooks.
 	_ "lms-go/internal/ent/runtime"
@@ -0,0 +1,911 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/course"
+	"lms-go/internal/ent/enrollment"
+	"lms-go/internal/ent/group"
+	"lms-go/internal/ent/module"
+	"lms-go/internal/ent/moduleprogress"
+	"lms-go/internal/ent/user"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (c *CourseQuery) CollectFields(ctx context.Context, satisfies ...string) (*CourseQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	if err := c.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *CourseQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(course.Columns))
+		selectedFields = []string{course.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "modules":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ModuleClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, moduleImplementors)...); err != nil {
+				return err
+			}
+			c.WithNamedModules(alias, func(wq *ModuleQuery) {
+				*wq = *query
+			})
+		case "title":
+			if _, ok := fieldSeen[course.FieldTitle]; !ok {
+				selectedFields = append(selectedFields, course.FieldTitle)
+				fieldSeen[course.FieldTitle] = struct{}{}
+			}
+		case "slug":
+			if _, ok := fieldSeen[course.FieldSlug]; !ok {
+				selectedFields = append(selectedFields, course.FieldSlug)
+				fieldSeen[course.FieldSlug] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[course.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, course.FieldDescription)
+				fieldSeen[course.FieldDescription] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[course.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, course.FieldStatus)
+				fieldSeen[course.FieldStatus] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[course.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, course.FieldVersion)
+				fieldSeen[course.FieldVersion] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[course.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, course.FieldMetadata)
+				fieldSeen[course.FieldMetadata] = struct{}{}
+			}
+		case "publishedAt":
+			if _, ok := fieldSeen[course.FieldPublishedAt]; !ok {
+				selectedFields = append(selectedFields, course.FieldPublishedAt)
+				fieldSeen[course.FieldPublishedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[course.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, course.FieldCreatedAt)
+				fieldSeen[course.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[course.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, course.FieldUpdatedAt)
+				fieldSeen[course.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		c.Select(selectedFields...)
+	}
+	return nil
+}
+
+type coursePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CoursePaginateOption
+}
+
+func newCoursePaginateArgs(rv map[string]any) *coursePaginateArgs {
+	args := &coursePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &CourseOrder{Field: &CourseOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithCourseOrder(order))
+			}
+		case *CourseOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithCourseOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*CourseWhereInput); ok {
+		args.opts = append(args.opts, WithCourseFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (e *EnrollmentQuery) CollectFields(ctx context.Context, satisfies ...string) (*EnrollmentQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return e, nil
+	}
+	if err := e.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *EnrollmentQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(enrollment.Columns))
+		selectedFields = []string{enrollment.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "course":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CourseClient{config: e.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, courseImplementors)...); err != nil {
+				return err
+			}
+			e.withCourse = query
+			if _, ok := fieldSeen[enrollment.FieldCourseID]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldCourseID)
+				fieldSeen[enrollment.FieldCourseID] = struct{}{}
+			}
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: e.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			e.withUser = query
+			if _, ok := fieldSeen[enrollment.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldUserID)
+				fieldSeen[enrollment.FieldUserID] = struct{}{}
+			}
+
+		case "group":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GroupClient{config: e.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, groupImplementors)...); err != nil {
+				return err
+			}
+			e.withGroup = query
+			if _, ok := fieldSeen[enrollment.FieldGroupID]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldGroupID)
+				fieldSeen[enrollment.FieldGroupID] = struct{}{}
+			}
+
+		case "moduleProgress":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ModuleProgressClient{config: e.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, moduleprogressImplementors)...); err != nil {
+				return err
+			}
+			e.WithNamedProgressEntries(alias, func(wq *ModuleProgressQuery) {
+				*wq = *query
+			})
+		case "status":
+			if _, ok := fieldSeen[enrollment.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldStatus)
+				fieldSeen[enrollment.FieldStatus] = struct{}{}
+			}
+		case "progress":
+			if _, ok := fieldSeen[enrollment.FieldProgress]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldProgress)
+				fieldSeen[enrollment.FieldProgress] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[enrollment.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldStartedAt)
+				fieldSeen[enrollment.FieldStartedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[enrollment.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldCompletedAt)
+				fieldSeen[enrollment.FieldCompletedAt] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[enrollment.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldMetadata)
+				fieldSeen[enrollment.FieldMetadata] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[enrollment.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldCreatedAt)
+				fieldSeen[enrollment.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[enrollment.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, enrollment.FieldUpdatedAt)
+				fieldSeen[enrollment.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		e.Select(selectedFields...)
+	}
+	return nil
+}
+
+type enrollmentPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []EnrollmentPaginateOption
+}
+
+func newEnrollmentPaginateArgs(rv map[string]any) *enrollmentPaginateArgs {
+	args := &enrollmentPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &EnrollmentOrder{Field: &EnrollmentOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithEnrollmentOrder(order))
+			}
+		case *EnrollmentOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithEnrollmentOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*EnrollmentWhereInput); ok {
+		args.opts = append(args.opts, WithEnrollmentFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (gr *GroupQuery) CollectFields(ctx context.Context, satisfies ...string) (*GroupQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gr, nil
+	}
+	if err := gr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return gr, nil
+}
+
+func (gr *GroupQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(group.Columns))
+		selectedFields = []string{group.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "course":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CourseClient{config: gr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, courseImplementors)...); err != nil {
+				return err
+			}
+			gr.withCourse = query
+			if _, ok := fieldSeen[group.FieldCourseID]; !ok {
+				selectedFields = append(selectedFields, group.FieldCourseID)
+				fieldSeen[group.FieldCourseID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[group.FieldName]; !ok {
+				selectedFields = append(selectedFields, group.FieldName)
+				fieldSeen[group.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[group.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, group.FieldDescription)
+				fieldSeen[group.FieldDescription] = struct{}{}
+			}
+		case "capacity":
+			if _, ok := fieldSeen[group.FieldCapacity]; !ok {
+				selectedFields = append(selectedFields, group.FieldCapacity)
+				fieldSeen[group.FieldCapacity] = struct{}{}
+			}
+		case "externalId":
+			if _, ok := fieldSeen[group.FieldExternalID]; !ok {
+				selectedFields = append(selectedFields, group.FieldExternalID)
+				fieldSeen[group.FieldExternalID] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[group.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, group.FieldMetadata)
+				fieldSeen[group.FieldMetadata] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[group.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, group.FieldVersion)
+				fieldSeen[group.FieldVersion] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[group.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, group.FieldCreatedAt)
+				fieldSeen[group.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[group.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, group.FieldUpdatedAt)
+				fieldSeen[group.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		gr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type groupPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GroupPaginateOption
+}
+
+func newGroupPaginateArgs(rv map[string]any) *groupPaginateArgs {
+	args := &groupPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &GroupOrder{Field: &GroupOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithGroupOrder(order))
+			}
+		case *GroupOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithGroupOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*GroupWhereInput); ok {
+		args.opts = append(args.opts, WithGroupFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (m *ModuleQuery) CollectFields(ctx context.Context, satisfies ...string) (*ModuleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return m, nil
+	}
+	if err := m.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *ModuleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(module.Columns))
+		selectedFields = []string{module.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "course":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CourseClient{config: m.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, courseImplementors)...); err != nil {
+				return err
+			}
+			m.withCourse = query
+			if _, ok := fieldSeen[module.FieldCourseID]; !ok {
+				selectedFields = append(selectedFields, module.FieldCourseID)
+				fieldSeen[module.FieldCourseID] = struct{}{}
+			}
+		case "contentId":
+			if _, ok := fieldSeen[module.FieldContentID]; !ok {
+				selectedFields = append(selectedFields, module.FieldContentID)
+				fieldSeen[module.FieldContentID] = struct{}{}
+			}
+		case "contentRevision":
+			if _, ok := fieldSeen[module.FieldContentRevision]; !ok {
+				selectedFields = append(selectedFields, module.FieldContentRevision)
+				fieldSeen[module.FieldContentRevision] = struct{}{}
+			}
+		case "title":
+			if _, ok := fieldSeen[module.FieldTitle]; !ok {
+				selectedFields = append(selectedFields, module.FieldTitle)
+				fieldSeen[module.FieldTitle] = struct{}{}
+			}
+		case "moduleType":
+			if _, ok := fieldSeen[module.FieldModuleType]; !ok {
+				selectedFields = append(selectedFields, module.FieldModuleType)
+				fieldSeen[module.FieldModuleType] = struct{}{}
+			}
+		case "position":
+			if _, ok := fieldSeen[module.FieldPosition]; !ok {
+				selectedFields = append(selectedFields, module.FieldPosition)
+				fieldSeen[module.FieldPosition] = struct{}{}
+			}
+		case "durationSeconds":
+			if _, ok := fieldSeen[module.FieldDurationSeconds]; !ok {
+				selectedFields = append(selectedFields, module.FieldDurationSeconds)
+				fieldSeen[module.FieldDurationSeconds] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[module.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, module.FieldStatus)
+				fieldSeen[module.FieldStatus] = struct{}{}
+			}
+		case "data":
+			if _, ok := fieldSeen[module.FieldData]; !ok {
+				selectedFields = append(selectedFields, module.FieldData)
+				fieldSeen[module.FieldData] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[module.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, module.FieldVersion)
+				fieldSeen[module.FieldVersion] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[module.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, module.FieldCreatedAt)
+				fieldSeen[module.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[module.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, module.FieldUpdatedAt)
+				fieldSeen[module.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		m.Select(selectedFields...)
+	}
+	return nil
+}
+
+type modulePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ModulePaginateOption
+}
+
+func newModulePaginateArgs(rv map[string]any) *modulePaginateArgs {
+	args := &modulePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (mp *ModuleProgressQuery) CollectFields(ctx context.Context, satisfies ...string) (*ModuleProgressQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return mp, nil
+	}
+	if err := mp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
+func (mp *ModuleProgressQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(moduleprogress.Columns))
+		selectedFields = []string{moduleprogress.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "module":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ModuleClient{config: mp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, moduleImplementors)...); err != nil {
+				return err
+			}
+			mp.withModule = query
+			if _, ok := fieldSeen[moduleprogress.FieldModuleID]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldModuleID)
+				fieldSeen[moduleprogress.FieldModuleID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[moduleprogress.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldStatus)
+				fieldSeen[moduleprogress.FieldStatus] = struct{}{}
+			}
+		case "score":
+			if _, ok := fieldSeen[moduleprogress.FieldScore]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldScore)
+				fieldSeen[moduleprogress.FieldScore] = struct{}{}
+			}
+		case "attempts":
+			if _, ok := fieldSeen[moduleprogress.FieldAttempts]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldAttempts)
+				fieldSeen[moduleprogress.FieldAttempts] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[moduleprogress.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldStartedAt)
+				fieldSeen[moduleprogress.FieldStartedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[moduleprogress.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldCompletedAt)
+				fieldSeen[moduleprogress.FieldCompletedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[moduleprogress.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldCreatedAt)
+				fieldSeen[moduleprogress.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[moduleprogress.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, moduleprogress.FieldUpdatedAt)
+				fieldSeen[moduleprogress.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		mp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type moduleprogressPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ModuleProgressPaginateOption
+}
+
+func newModuleProgressPaginateArgs(rv map[string]any) *moduleprogressPaginateArgs {
+	args := &moduleprogressPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return u, nil
+	}
+	if err := u.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(user.Columns))
+		selectedFields = []string{user.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "enrollments":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&EnrollmentClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, enrollmentImplementors)...); err != nil {
+				return err
+			}
+			u.WithNamedEnrollments(alias, func(wq *EnrollmentQuery) {
+				*wq = *query
+			})
+		case "role":
+			if _, ok := fieldSeen[user.FieldRole]; !ok {
+				selectedFields = append(selectedFields, user.FieldRole)
+				fieldSeen[user.FieldRole] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[user.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, user.FieldStatus)
+				fieldSeen[user.FieldStatus] = struct{}{}
+			}
+		case "lastLoginAt":
+			if _, ok := fieldSeen[user.FieldLastLoginAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldLastLoginAt)
+				fieldSeen[user.FieldLastLoginAt] = struct{}{}
+			}
+		case "externalId":
+			if _, ok := fieldSeen[user.FieldExternalID]; !ok {
+				selectedFields = append(selectedFields, user.FieldExternalID)
+				fieldSeen[user.FieldExternalID] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[user.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, user.FieldVersion)
+				fieldSeen[user.FieldVersion] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[user.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldCreatedAt)
+				fieldSeen[user.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[user.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldUpdatedAt)
+				fieldSeen[user.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		u.Select(selectedFields...)
+	}
+	return nil
+}
+
+type userPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []UserPaginateOption
+}
+
+func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
+	args := &userPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &UserOrder{Field: &UserOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithUserOrder(order))
+			}
+		case *UserOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithUserOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*UserWhereInput); ok {
+		args.opts = append(args.opts, WithUserFilter(v.Filter))
+	}
+	return args
+}
+
+const (
+	afterField     = "after"
+	firstField     = "first"
+	beforeField    = "before"
+	lastField      = "last"
+	orderByField   = "orderBy"
+	directionField = "direction"
+	fieldField     = "field"
+	whereField     = "where"
+)
+
+func fieldArgs(ctx context.Context, whereInput any, path ...string) map[string]any {
+	field := collectedField(ctx, path...)
+	if field == nil || field.Arguments == nil {
+		return nil
+	}
+	oc := graphql.GetOperationContext(ctx)
+	args := field.ArgumentMap(oc.Variables)
+	return unmarshalArgs(ctx, whereInput, args)
+}
+
+// unmarshalArgs allows extracting the field arguments from their raw representation.
+func unmarshalArgs(ctx context.Context, whereInput any, args map[string]any) map[string]any {
+	for _, k := range []string{firstField, lastField} {
+		v, ok := args[k]
+		if !ok {
+			continue
+		}
+		i, err := graphql.UnmarshalInt(v)
+		if err == nil {
+			args[k] = &i
+		}
+	}
+	for _, k := range []string{beforeField, afterField} {
+		v, ok := args[k]
+		if !ok {
+			continue
+		}
+		c := &Cursor{}
+		if c.UnmarshalGQL(v) == nil {
+			args[k] = c
+		}
+	}
+	if v, ok := args[whereField]; ok && whereInput != nil {
+		if err := graphql.UnmarshalInputFromContext(ctx, v, whereInput); err == nil {
+			args[whereField] = whereInput
+		}
+	}
+
+	return args
+}
+
+// mayAddCondition appends another type condition to the satisfies list
+// if it does not exist in the list.
+func mayAddCondition(satisfies []string, typeCond []string) []string {
+Cond:
+	for _, c := range typeCond {
+		for _, s := range satisfies {
+			if c == s {
+				continue Cond
+			}
+		}
+		satisfies = append(satisfies, c)
+	}
+	return satisfies
+}
@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (c *Course) Modules(ctx context.Context) (result []*Module, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = c.NamedModules(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = c.Edges.ModulesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = c.QueryModules().All(ctx)
+	}
+	return result, err
+}
+
+func (e *Enrollment) Course(ctx context.Context) (*Course, error) {
+	result, err := e.Edges.CourseOrErr()
+	if IsNotLoaded(err) {
+		result, err = e.QueryCourse().Only(ctx)
+	}
+	return result, err
+}
+
+func (e *Enrollment) User(ctx context.Context) (*User, error) {
+	result, err := e.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = e.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (e *Enrollment) Group(ctx context.Context) (*Group, error) {
+	result, err := e.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = e.QueryGroup().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (e *Enrollment) ProgressEntries(ctx context.Context) (result []*ModuleProgress, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = e.NamedProgressEntries(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = e.Edges.ProgressEntriesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = e.QueryProgressEntries().All(ctx)
+	}
+	return result, err
+}
+
+func (gr *Group) Course(ctx context.Context) (*Course, error) {
+	result, err := gr.Edges.CourseOrErr()
+	if IsNotLoaded(err) {
+		result, err = gr.QueryCourse().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (m *Module) Course(ctx context.Context) (*Course, error) {
+	result, err := m.Edges.CourseOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QueryCourse().Only(ctx)
+	}
+	return result, err
+}
+
+func (mp *ModuleProgress) Module(ctx context.Context) (*Module, error) {
+	result, err := mp.Edges.ModuleOrErr()
+	if IsNotLoaded(err) {
+		result, err = mp.QueryModule().Only(ctx)
+	}
+	return result, err
+}
+
+func (u *User) Enrollments(ctx context.Context) (result []*Enrollment, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedEnrollments(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.EnrollmentsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryEnrollments().All(ctx)
+	}
+	return result, err
+}
@@ -31,8 +31,12 @@ type Group struct {
 	Description string `json:"description,omitempty"`
 	// Capacity holds the value of the "capacity" field.
 	Capacity *int `json:"capacity,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID *string `json:"external_id,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -51,9 +55,16 @@ type GroupEdges struct {
 	Course *Course `json:"course,omitempty"`
 	// Enrollments holds the value of the enrollments edge.
 	Enrollments []*Enrollment `json:"enrollments,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*User `json:"members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedEnrollments map[string][]*Enrollment
+	namedMembers     map[string][]*User
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -87,6 +98,15 @@ func (e GroupEdges) EnrollmentsOrErr() ([]*Enrollment, error) {
 	return nil, &NotLoadedError{edge: "enrollments"}
 }
 
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) MembersOrErr() ([]*User, error) {
+	if e.loadedTypes[3] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -96,9 +116,9 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case group.FieldMetadata:
 			values[i] = new([]byte)
-		case group.FieldCapacity:
+		case group.FieldCapacity, group.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case group.FieldName, group.FieldDescription:
+		case group.FieldName, group.FieldDescription, group.FieldExternalID:
 			values[i] = new(sql.NullString)
 		case group.FieldCreatedAt, group.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -157,6 +177,13 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 				gr.Capacity = new(int)
 				*gr.Capacity = int(value.Int64)
 			}
+		case group.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				gr.ExternalID = new(string)
+				*gr.ExternalID = value.String
+			}
 		case group.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -165,6 +192,12 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case group.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				gr.Version = int(value.Int64)
+			}
 		case group.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -205,6 +238,11 @@ func (gr *Group) QueryEnrollments() *EnrollmentQuery {
 	return NewGroupClient(gr.config).QueryEnrollments(gr)
 }
 
+// QueryMembers queries the "members" edge of the Group entity.
+func (gr *Group) QueryMembers() *UserQuery {
+	return NewGroupClient(gr.config).QueryMembers(gr)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -247,9 +285,17 @@ func (gr *Group) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := gr.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", gr.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", gr.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(gr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -259,5 +305,53 @@ func (gr *Group) String() string {
 	return builder.String()
 }
 
+// NamedEnrollments returns the Enrollments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (gr *Group) NamedEnrollments(name string) ([]*Enrollment, error) {
+	if gr.Edges.namedEnrollments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := gr.Edges.namedEnrollments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (gr *Group) appendNamedEnrollments(name string, edges ...*Enrollment) {
+	if gr.Edges.namedEnrollments == nil {
+		gr.Edges.namedEnrollments = make(map[string][]*Enrollment)
+	}
+	if len(edges) == 0 {
+		gr.Edges.namedEnrollments[name] = []*Enrollment{}
+	} else {
+		gr.Edges.namedEnrollments[name] = append(gr.Edges.namedEnrollments[name], edges...)
+	}
+}
+
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (gr *Group) NamedMembers(name string) ([]*User, error) {
+	if gr.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := gr.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (gr *Group) appendNamedMembers(name string, edges ...*User) {
+	if gr.Edges.namedMembers == nil {
+		gr.Edges.namedMembers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		gr.Edges.namedMembers[name] = []*User{}
+	} else {
+		gr.Edges.namedMembers[name] = append(gr.Edges.namedMembers[name], edges...)
+	}
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
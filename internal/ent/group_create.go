@@ -10,6 +10,7 @@ import (
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -78,12 +79,40 @@ func (gc *GroupCreate) SetNillableCapacity(i *int) *GroupCreate {
 	return gc
 }
 
+// SetExternalID sets the "external_id" field.
+func (gc *GroupCreate) SetExternalID(s string) *GroupCreate {
+	gc.mutation.SetExternalID(s)
+	return gc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableExternalID(s *string) *GroupCreate {
+	if s != nil {
+		gc.SetExternalID(*s)
+	}
+	return gc
+}
+
 // SetMetadata sets the "metadata" field.
 func (gc *GroupCreate) SetMetadata(m map[string]interface{}) *GroupCreate {
 	gc.mutation.SetMetadata(m)
 	return gc
 }
 
+// SetVersion sets the "version" field.
+func (gc *GroupCreate) SetVersion(i int) *GroupCreate {
+	gc.mutation.SetVersion(i)
+	return gc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableVersion(i *int) *GroupCreate {
+	if i != nil {
+		gc.SetVersion(*i)
+	}
+	return gc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (gc *GroupCreate) SetCreatedAt(t time.Time) *GroupCreate {
 	gc.mutation.SetCreatedAt(t)
@@ -151,6 +180,21 @@ func (gc *GroupCreate) AddEnrollments(e ...*Enrollment) *GroupCreate {
 	return gc.AddEnrollmentIDs(ids...)
 }
 
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (gc *GroupCreate) AddMemberIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddMemberIDs(ids...)
+	return gc
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (gc *GroupCreate) AddMembers(u ...*User) *GroupCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gc.AddMemberIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gc *GroupCreate) Mutation() *GroupMutation {
 	return gc.mutation
@@ -190,6 +234,10 @@ func (gc *GroupCreate) defaults() {
 		v := group.DefaultMetadata
 		gc.mutation.SetMetadata(v)
 	}
+	if _, ok := gc.mutation.Version(); !ok {
+		v := group.DefaultVersion
+		gc.mutation.SetVersion(v)
+	}
 	if _, ok := gc.mutation.CreatedAt(); !ok {
 		v := group.DefaultCreatedAt()
 		gc.mutation.SetCreatedAt(v)
@@ -217,13 +265,16 @@ func (gc *GroupCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
+	if _, ok := gc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Group.version"`)}
+	}
 	if _, ok := gc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Group.created_at"`)}
 	}
 	if _, ok := gc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Group.updated_at"`)}
 	}
-	if _, ok := gc.mutation.OrganizationID(); !ok {
+	if len(gc.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Group.organization"`)}
 	}
 	return nil
@@ -273,10 +324,18 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldCapacity, field.TypeInt, value)
 		_node.Capacity = &value
 	}
+	if value, ok := gc.mutation.ExternalID(); ok {
+		_spec.SetField(group.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
 	if value, ok := gc.mutation.Metadata(); ok {
 		_spec.SetField(group.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := gc.mutation.Version(); ok {
+		_spec.SetField(group.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := gc.mutation.CreatedAt(); ok {
 		_spec.SetField(group.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -335,6 +394,22 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
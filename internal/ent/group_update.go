@@ -11,6 +11,7 @@ import (
 	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -127,6 +128,26 @@ func (gu *GroupUpdate) ClearCapacity() *GroupUpdate {
 	return gu
 }
 
+// SetExternalID sets the "external_id" field.
+func (gu *GroupUpdate) SetExternalID(s string) *GroupUpdate {
+	gu.mutation.SetExternalID(s)
+	return gu
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableExternalID(s *string) *GroupUpdate {
+	if s != nil {
+		gu.SetExternalID(*s)
+	}
+	return gu
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (gu *GroupUpdate) ClearExternalID() *GroupUpdate {
+	gu.mutation.ClearExternalID()
+	return gu
+}
+
 // SetMetadata sets the "metadata" field.
 func (gu *GroupUpdate) SetMetadata(m map[string]interface{}) *GroupUpdate {
 	gu.mutation.SetMetadata(m)
@@ -139,6 +160,27 @@ func (gu *GroupUpdate) ClearMetadata() *GroupUpdate {
 	return gu
 }
 
+// SetVersion sets the "version" field.
+func (gu *GroupUpdate) SetVersion(i int) *GroupUpdate {
+	gu.mutation.ResetVersion()
+	gu.mutation.SetVersion(i)
+	return gu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableVersion(i *int) *GroupUpdate {
+	if i != nil {
+		gu.SetVersion(*i)
+	}
+	return gu
+}
+
+// AddVersion adds i to the "version" field.
+func (gu *GroupUpdate) AddVersion(i int) *GroupUpdate {
+	gu.mutation.AddVersion(i)
+	return gu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (gu *GroupUpdate) SetUpdatedAt(t time.Time) *GroupUpdate {
 	gu.mutation.SetUpdatedAt(t)
@@ -170,6 +212,21 @@ func (gu *GroupUpdate) AddEnrollments(e ...*Enrollment) *GroupUpdate {
 	return gu.AddEnrollmentIDs(ids...)
 }
 
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (gu *GroupUpdate) AddMemberIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (gu *GroupUpdate) AddMembers(u ...*User) *GroupUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -208,6 +265,27 @@ func (gu *GroupUpdate) RemoveEnrollments(e ...*Enrollment) *GroupUpdate {
 	return gu.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearMembers clears all "members" edges to the User entity.
+func (gu *GroupUpdate) ClearMembers() *GroupUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (gu *GroupUpdate) RemoveMemberIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (gu *GroupUpdate) RemoveMembers(u ...*User) *GroupUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
 	gu.defaults()
@@ -251,7 +329,7 @@ func (gu *GroupUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
-	if _, ok := gu.mutation.OrganizationID(); gu.mutation.OrganizationCleared() && !ok {
+	if gu.mutation.OrganizationCleared() && len(gu.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Group.organization"`)
 	}
 	return nil
@@ -287,12 +365,24 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if gu.mutation.CapacityCleared() {
 		_spec.ClearField(group.FieldCapacity, field.TypeInt)
 	}
+	if value, ok := gu.mutation.ExternalID(); ok {
+		_spec.SetField(group.FieldExternalID, field.TypeString, value)
+	}
+	if gu.mutation.ExternalIDCleared() {
+		_spec.ClearField(group.FieldExternalID, field.TypeString)
+	}
 	if value, ok := gu.mutation.Metadata(); ok {
 		_spec.SetField(group.FieldMetadata, field.TypeJSON, value)
 	}
 	if gu.mutation.MetadataCleared() {
 		_spec.ClearField(group.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := gu.mutation.Version(); ok {
+		_spec.SetField(group.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedVersion(); ok {
+		_spec.AddField(group.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := gu.mutation.UpdatedAt(); ok {
 		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -399,6 +489,51 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
@@ -514,6 +649,26 @@ func (guo *GroupUpdateOne) ClearCapacity() *GroupUpdateOne {
 	return guo
 }
 
+// SetExternalID sets the "external_id" field.
+func (guo *GroupUpdateOne) SetExternalID(s string) *GroupUpdateOne {
+	guo.mutation.SetExternalID(s)
+	return guo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableExternalID(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetExternalID(*s)
+	}
+	return guo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (guo *GroupUpdateOne) ClearExternalID() *GroupUpdateOne {
+	guo.mutation.ClearExternalID()
+	return guo
+}
+
 // SetMetadata sets the "metadata" field.
 func (guo *GroupUpdateOne) SetMetadata(m map[string]interface{}) *GroupUpdateOne {
 	guo.mutation.SetMetadata(m)
@@ -526,6 +681,27 @@ func (guo *GroupUpdateOne) ClearMetadata() *GroupUpdateOne {
 	return guo
 }
 
+// SetVersion sets the "version" field.
+func (guo *GroupUpdateOne) SetVersion(i int) *GroupUpdateOne {
+	guo.mutation.ResetVersion()
+	guo.mutation.SetVersion(i)
+	return guo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableVersion(i *int) *GroupUpdateOne {
+	if i != nil {
+		guo.SetVersion(*i)
+	}
+	return guo
+}
+
+// AddVersion adds i to the "version" field.
+func (guo *GroupUpdateOne) AddVersion(i int) *GroupUpdateOne {
+	guo.mutation.AddVersion(i)
+	return guo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (guo *GroupUpdateOne) SetUpdatedAt(t time.Time) *GroupUpdateOne {
 	guo.mutation.SetUpdatedAt(t)
@@ -557,6 +733,21 @@ func (guo *GroupUpdateOne) AddEnrollments(e ...*Enrollment) *GroupUpdateOne {
 	return guo.AddEnrollmentIDs(ids...)
 }
 
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (guo *GroupUpdateOne) AddMemberIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (guo *GroupUpdateOne) AddMembers(u ...*User) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return guo.AddMemberIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -595,6 +786,27 @@ func (guo *GroupUpdateOne) RemoveEnrollments(e ...*Enrollment) *GroupUpdateOne {
 	return guo.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearMembers clears all "members" edges to the User entity.
+func (guo *GroupUpdateOne) ClearMembers() *GroupUpdateOne {
+	guo.mutation.ClearMembers()
+	return guo
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (guo *GroupUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
+	return guo
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (guo *GroupUpdateOne) RemoveMembers(u ...*User) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
 // Where appends a list predicates to the GroupUpdate builder.
 func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
 	guo.mutation.Where(ps...)
@@ -651,7 +863,7 @@ func (guo *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
-	if _, ok := guo.mutation.OrganizationID(); guo.mutation.OrganizationCleared() && !ok {
+	if guo.mutation.OrganizationCleared() && len(guo.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Group.organization"`)
 	}
 	return nil
@@ -704,12 +916,24 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	if guo.mutation.CapacityCleared() {
 		_spec.ClearField(group.FieldCapacity, field.TypeInt)
 	}
+	if value, ok := guo.mutation.ExternalID(); ok {
+		_spec.SetField(group.FieldExternalID, field.TypeString, value)
+	}
+	if guo.mutation.ExternalIDCleared() {
+		_spec.ClearField(group.FieldExternalID, field.TypeString)
+	}
 	if value, ok := guo.mutation.Metadata(); ok {
 		_spec.SetField(group.FieldMetadata, field.TypeJSON, value)
 	}
 	if guo.mutation.MetadataCleared() {
 		_spec.ClearField(group.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := guo.mutation.Version(); ok {
+		_spec.SetField(group.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedVersion(); ok {
+		_spec.AddField(group.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := guo.mutation.UpdatedAt(); ok {
 		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -816,6 +1040,51 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
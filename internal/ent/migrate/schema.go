@@ -8,6 +8,33 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "secret_hash", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "service_account_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_service_accounts_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[9]},
+				RefColumns: []*schema.Column{ServiceAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ContentsColumns holds the columns for the "contents" table.
 	ContentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -15,7 +42,13 @@ var (
 		{Name: "mime_type", Type: field.TypeString},
 		{Name: "size_bytes", Type: field.TypeInt64, Nullable: true},
 		{Name: "storage_key", Type: field.TypeString},
+		{Name: "current_revision", Type: field.TypeInt, Default: 1},
+		{Name: "etag", Type: field.TypeString, Nullable: true},
+		{Name: "checksum_sha256", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "upload_id", Type: field.TypeString, Nullable: true},
+		{Name: "upload_part_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "upload_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -29,7 +62,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "contents_organizations_contents",
-				Columns:    []*schema.Column{ContentsColumns[9]},
+				Columns:    []*schema.Column{ContentsColumns[15]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -38,12 +71,89 @@ var (
 			{
 				Name:    "content_organization_id_storage_key",
 				Unique:  true,
-				Columns: []*schema.Column{ContentsColumns[9], ContentsColumns[4]},
+				Columns: []*schema.Column{ContentsColumns[15], ContentsColumns[4]},
 			},
 			{
 				Name:    "content_organization_id_name",
 				Unique:  false,
-				Columns: []*schema.Column{ContentsColumns[9], ContentsColumns[1]},
+				Columns: []*schema.Column{ContentsColumns[15], ContentsColumns[1]},
+			},
+		},
+	}
+	// ContentDownloadsColumns holds the columns for the "content_downloads" table.
+	ContentDownloadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "module_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "content_id", Type: field.TypeUUID},
+	}
+	// ContentDownloadsTable holds the schema information for the "content_downloads" table.
+	ContentDownloadsTable = &schema.Table{
+		Name:       "content_downloads",
+		Columns:    ContentDownloadsColumns,
+		PrimaryKey: []*schema.Column{ContentDownloadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_downloads_contents_downloads",
+				Columns:    []*schema.Column{ContentDownloadsColumns[6]},
+				RefColumns: []*schema.Column{ContentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentdownload_content_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContentDownloadsColumns[6], ContentDownloadsColumns[5]},
+			},
+			{
+				Name:    "contentdownload_user_id_content_id",
+				Unique:  false,
+				Columns: []*schema.Column{ContentDownloadsColumns[3], ContentDownloadsColumns[6]},
+			},
+		},
+	}
+	// ContentRevisionsColumns holds the columns for the "content_revisions" table.
+	ContentRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "size_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "etag", Type: field.TypeString, Nullable: true},
+		{Name: "checksum_sha256", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "content_id", Type: field.TypeUUID},
+	}
+	// ContentRevisionsTable holds the schema information for the "content_revisions" table.
+	ContentRevisionsTable = &schema.Table{
+		Name:       "content_revisions",
+		Columns:    ContentRevisionsColumns,
+		PrimaryKey: []*schema.Column{ContentRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_revisions_contents_revisions",
+				Columns:    []*schema.Column{ContentRevisionsColumns[11]},
+				RefColumns: []*schema.Column{ContentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentrevision_content_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{ContentRevisionsColumns[11], ContentRevisionsColumns[2]},
+			},
+			{
+				Name:    "contentrevision_organization_id_storage_key",
+				Unique:  true,
+				Columns: []*schema.Column{ContentRevisionsColumns[1], ContentRevisionsColumns[3]},
 			},
 		},
 	}
@@ -152,7 +262,9 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "capacity", Type: field.TypeInt, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "course_id", Type: field.TypeUUID, Nullable: true},
@@ -166,13 +278,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "groups_courses_groups",
-				Columns:    []*schema.Column{GroupsColumns[7]},
+				Columns:    []*schema.Column{GroupsColumns[9]},
 				RefColumns: []*schema.Column{CoursesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "groups_organizations_groups",
-				Columns:    []*schema.Column{GroupsColumns[8]},
+				Columns:    []*schema.Column{GroupsColumns[10]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -181,24 +293,55 @@ var (
 			{
 				Name:    "group_organization_id_name",
 				Unique:  false,
-				Columns: []*schema.Column{GroupsColumns[8], GroupsColumns[1]},
+				Columns: []*schema.Column{GroupsColumns[10], GroupsColumns[1]},
 			},
 			{
 				Name:    "group_organization_id_course_id",
 				Unique:  false,
-				Columns: []*schema.Column{GroupsColumns[8], GroupsColumns[7]},
+				Columns: []*schema.Column{GroupsColumns[10], GroupsColumns[9]},
+			},
+			{
+				Name:    "group_organization_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupsColumns[10], GroupsColumns[4]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "header", Type: field.TypeJSON, Nullable: true},
+		{Name: "body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[7]},
 			},
 		},
 	}
 	// ModulesColumns holds the columns for the "modules" table.
 	ModulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "content_revision", Type: field.TypeInt, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "module_type", Type: field.TypeString},
 		{Name: "position", Type: field.TypeInt, Default: 0},
 		{Name: "duration_seconds", Type: field.TypeInt, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "content_id", Type: field.TypeUUID, Nullable: true},
@@ -212,13 +355,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "modules_contents_modules",
-				Columns:    []*schema.Column{ModulesColumns[9]},
+				Columns:    []*schema.Column{ModulesColumns[11]},
 				RefColumns: []*schema.Column{ContentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "modules_courses_modules",
-				Columns:    []*schema.Column{ModulesColumns[10]},
+				Columns:    []*schema.Column{ModulesColumns[12]},
 				RefColumns: []*schema.Column{CoursesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -227,12 +370,12 @@ var (
 			{
 				Name:    "module_course_id_position",
 				Unique:  false,
-				Columns: []*schema.Column{ModulesColumns[10], ModulesColumns[3]},
+				Columns: []*schema.Column{ModulesColumns[12], ModulesColumns[4]},
 			},
 			{
 				Name:    "module_course_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ModulesColumns[10], ModulesColumns[5]},
+				Columns: []*schema.Column{ModulesColumns[12], ModulesColumns[6]},
 			},
 		},
 	}
@@ -288,6 +431,10 @@ var (
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "storage_bytes_used", Type: field.TypeInt64, Default: 0},
+		{Name: "storage_objects_used", Type: field.TypeInt, Default: 0},
+		{Name: "storage_alert_level", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -297,6 +444,161 @@ var (
 		Columns:    OrganizationsColumns,
 		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
 	}
+	// PrivacyRequestsColumns holds the columns for the "privacy_requests" table.
+	PrivacyRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "mode", Type: field.TypeString, Nullable: true},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "requested_by_kind", Type: field.TypeString, Nullable: true},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "cancelled_by_kind", Type: field.TypeString, Nullable: true},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "size_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "summary", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// PrivacyRequestsTable holds the schema information for the "privacy_requests" table.
+	PrivacyRequestsTable = &schema.Table{
+		Name:       "privacy_requests",
+		Columns:    PrivacyRequestsColumns,
+		PrimaryKey: []*schema.Column{PrivacyRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "privacy_requests_organizations_privacy_requests",
+				Columns:    []*schema.Column{PrivacyRequestsColumns[20]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "privacyrequest_organization_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PrivacyRequestsColumns[20], PrivacyRequestsColumns[1]},
+			},
+			{
+				Name:    "privacyrequest_status_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{PrivacyRequestsColumns[3], PrivacyRequestsColumns[7]},
+			},
+		},
+	}
+	// SSOIdentitiesColumns holds the columns for the "sso_identities" table.
+	SSOIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "protocol", Type: field.TypeString},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SSOIdentitiesTable holds the schema information for the "sso_identities" table.
+	SSOIdentitiesTable = &schema.Table{
+		Name:       "sso_identities",
+		Columns:    SSOIdentitiesColumns,
+		PrimaryKey: []*schema.Column{SSOIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sso_identities_users_sso_identities",
+				Columns:    []*schema.Column{SSOIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ssoidentity_organization_id_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{SSOIdentitiesColumns[1], SSOIdentitiesColumns[3], SSOIdentitiesColumns[4]},
+			},
+		},
+	}
+	// SamlAssertionsColumns holds the columns for the "saml_assertions" table.
+	SamlAssertionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "assertion_id", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SamlAssertionsTable holds the schema information for the "saml_assertions" table.
+	SamlAssertionsTable = &schema.Table{
+		Name:       "saml_assertions",
+		Columns:    SamlAssertionsColumns,
+		PrimaryKey: []*schema.Column{SamlAssertionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "samlassertion_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SamlAssertionsColumns[2]},
+			},
+		},
+	}
+	// ScimTokensColumns holds the columns for the "scim_tokens" table.
+	ScimTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// ScimTokensTable holds the schema information for the "scim_tokens" table.
+	ScimTokensTable = &schema.Table{
+		Name:       "scim_tokens",
+		Columns:    ScimTokensColumns,
+		PrimaryKey: []*schema.Column{ScimTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scim_tokens_organizations_scim_tokens",
+				Columns:    []*schema.Column{ScimTokensColumns[5]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ServiceAccountsColumns holds the columns for the "service_accounts" table.
+	ServiceAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// ServiceAccountsTable holds the schema information for the "service_accounts" table.
+	ServiceAccountsTable = &schema.Table{
+		Name:       "service_accounts",
+		Columns:    ServiceAccountsColumns,
+		PrimaryKey: []*schema.Column{ServiceAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "service_accounts_organizations_service_accounts",
+				Columns:    []*schema.Column{ServiceAccountsColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "serviceaccount_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ServiceAccountsColumns[6], ServiceAccountsColumns[1]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -306,7 +608,16 @@ var (
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "refresh_token_id", Type: field.TypeString, Nullable: true},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_login_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_secret", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "mfa_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "magic_link_hash", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "organization_id", Type: field.TypeUUID},
@@ -319,7 +630,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_organizations_users",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[19]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -328,25 +639,120 @@ var (
 			{
 				Name:    "user_organization_id_email",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[10], UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[19], UsersColumns[1]},
+			},
+			{
+				Name:    "user_organization_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[19], UsersColumns[14]},
+			},
+		},
+	}
+	// WebauthnCeremoniesColumns holds the columns for the "webauthn_ceremonies" table.
+	WebauthnCeremoniesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "session_id", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebauthnCeremoniesTable holds the schema information for the "webauthn_ceremonies" table.
+	WebauthnCeremoniesTable = &schema.Table{
+		Name:       "webauthn_ceremonies",
+		Columns:    WebauthnCeremoniesColumns,
+		PrimaryKey: []*schema.Column{WebauthnCeremoniesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webauthnceremony_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebauthnCeremoniesColumns[2]},
+			},
+		},
+	}
+	// WebauthnCredentialsColumns holds the columns for the "webauthn_credentials" table.
+	WebauthnCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Default: "none"},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "name", Type: field.TypeString, Default: "Passkey"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// WebauthnCredentialsTable holds the schema information for the "webauthn_credentials" table.
+	WebauthnCredentialsTable = &schema.Table{
+		Name:       "webauthn_credentials",
+		Columns:    WebauthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{WebauthnCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webauthn_credentials_users_webauthn_credentials",
+				Columns:    []*schema.Column{WebauthnCredentialsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// GroupMembersColumns holds the columns for the "group_members" table.
+	GroupMembersColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GroupMembersTable holds the schema information for the "group_members" table.
+	GroupMembersTable = &schema.Table{
+		Name:       "group_members",
+		Columns:    GroupMembersColumns,
+		PrimaryKey: []*schema.Column{GroupMembersColumns[0], GroupMembersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_members_group_id",
+				Columns:    []*schema.Column{GroupMembersColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_members_user_id",
+				Columns:    []*schema.Column{GroupMembersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		ContentsTable,
+		ContentDownloadsTable,
+		ContentRevisionsTable,
 		CoursesTable,
 		EnrollmentsTable,
 		GroupsTable,
+		IdempotencyKeysTable,
 		ModulesTable,
 		ModuleProgressesTable,
 		OrganizationsTable,
+		PrivacyRequestsTable,
+		SSOIdentitiesTable,
+		SamlAssertionsTable,
+		ScimTokensTable,
+		ServiceAccountsTable,
 		UsersTable,
+		WebauthnCeremoniesTable,
+		WebauthnCredentialsTable,
+		GroupMembersTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = ServiceAccountsTable
 	ContentsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ContentDownloadsTable.ForeignKeys[0].RefTable = ContentsTable
+	ContentRevisionsTable.ForeignKeys[0].RefTable = ContentsTable
 	CoursesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	EnrollmentsTable.ForeignKeys[0].RefTable = CoursesTable
 	EnrollmentsTable.ForeignKeys[1].RefTable = GroupsTable
@@ -358,5 +764,12 @@ func init() {
 	ModulesTable.ForeignKeys[1].RefTable = CoursesTable
 	ModuleProgressesTable.ForeignKeys[0].RefTable = EnrollmentsTable
 	ModuleProgressesTable.ForeignKeys[1].RefTable = ModulesTable
+	PrivacyRequestsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	SSOIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	ScimTokensTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ServiceAccountsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	UsersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebauthnCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	GroupMembersTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupMembersTable.ForeignKeys[1].RefTable = UsersTable
 }
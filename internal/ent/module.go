@@ -25,6 +25,8 @@ type Module struct {
 	CourseID uuid.UUID `json:"course_id,omitempty"`
 	// ContentID holds the value of the "content_id" field.
 	ContentID *uuid.UUID `json:"content_id,omitempty"`
+	// ContentRevision holds the value of the "content_revision" field.
+	ContentRevision *int `json:"content_revision,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// ModuleType holds the value of the "module_type" field.
@@ -37,6 +39,8 @@ type Module struct {
 	Status string `json:"status,omitempty"`
 	// Data holds the value of the "data" field.
 	Data map[string]interface{} `json:"data,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -58,6 +62,10 @@ type ModuleEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedProgressEntries map[string][]*ModuleProgress
 }
 
 // CourseOrErr returns the Course value or an error if the edge
@@ -100,7 +108,7 @@ func (*Module) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case module.FieldData:
 			values[i] = new([]byte)
-		case module.FieldPosition, module.FieldDurationSeconds:
+		case module.FieldContentRevision, module.FieldPosition, module.FieldDurationSeconds, module.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case module.FieldTitle, module.FieldModuleType, module.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -142,6 +150,13 @@ func (m *Module) assignValues(columns []string, values []any) error {
 				m.ContentID = new(uuid.UUID)
 				*m.ContentID = *value.S.(*uuid.UUID)
 			}
+		case module.FieldContentRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field content_revision", values[i])
+			} else if value.Valid {
+				m.ContentRevision = new(int)
+				*m.ContentRevision = int(value.Int64)
+			}
 		case module.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -180,6 +195,12 @@ func (m *Module) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field data: %w", err)
 				}
 			}
+		case module.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				m.Version = int(value.Int64)
+			}
 		case module.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -251,6 +272,11 @@ func (m *Module) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := m.ContentRevision; v != nil {
+		builder.WriteString("content_revision=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(m.Title)
 	builder.WriteString(", ")
@@ -269,6 +295,9 @@ func (m *Module) String() string {
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", m.Data))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -278,5 +307,29 @@ func (m *Module) String() string {
 	return builder.String()
 }
 
+// NamedProgressEntries returns the ProgressEntries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (m *Module) NamedProgressEntries(name string) ([]*ModuleProgress, error) {
+	if m.Edges.namedProgressEntries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := m.Edges.namedProgressEntries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (m *Module) appendNamedProgressEntries(name string, edges ...*ModuleProgress) {
+	if m.Edges.namedProgressEntries == nil {
+		m.Edges.namedProgressEntries = make(map[string][]*ModuleProgress)
+	}
+	if len(edges) == 0 {
+		m.Edges.namedProgressEntries[name] = []*ModuleProgress{}
+	} else {
+		m.Edges.namedProgressEntries[name] = append(m.Edges.namedProgressEntries[name], edges...)
+	}
+}
+
 // Modules is a parsable slice of Module.
 type Modules []*Module
@@ -19,6 +19,8 @@ const (
 	FieldCourseID = "course_id"
 	// FieldContentID holds the string denoting the content_id field in the database.
 	FieldContentID = "content_id"
+	// FieldContentRevision holds the string denoting the content_revision field in the database.
+	FieldContentRevision = "content_revision"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldModuleType holds the string denoting the module_type field in the database.
@@ -31,6 +33,8 @@ const (
 	FieldStatus = "status"
 	// FieldData holds the string denoting the data field in the database.
 	FieldData = "data"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -71,12 +75,14 @@ var Columns = []string{
 	FieldID,
 	FieldCourseID,
 	FieldContentID,
+	FieldContentRevision,
 	FieldTitle,
 	FieldModuleType,
 	FieldPosition,
 	FieldDurationSeconds,
 	FieldStatus,
 	FieldData,
+	FieldVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -102,6 +108,8 @@ var (
 	DefaultStatus string
 	// DefaultData holds the default value on creation for the "data" field.
 	DefaultData map[string]interface{}
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -130,6 +138,11 @@ func ByContentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentID, opts...).ToFunc()
 }
 
+// ByContentRevision orders the results by the content_revision field.
+func ByContentRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentRevision, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -155,6 +168,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
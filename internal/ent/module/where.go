@@ -66,6 +66,11 @@ func ContentID(v uuid.UUID) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldContentID, v))
 }
 
+// ContentRevision applies equality check predicate on the "content_revision" field. It's identical to ContentRevisionEQ.
+func ContentRevision(v int) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldContentRevision, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldTitle, v))
@@ -91,6 +96,11 @@ func Status(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldStatus, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldCreatedAt, v))
@@ -151,6 +161,56 @@ func ContentIDNotNil() predicate.Module {
 	return predicate.Module(sql.FieldNotNull(FieldContentID))
 }
 
+// ContentRevisionEQ applies the EQ predicate on the "content_revision" field.
+func ContentRevisionEQ(v int) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldContentRevision, v))
+}
+
+// ContentRevisionNEQ applies the NEQ predicate on the "content_revision" field.
+func ContentRevisionNEQ(v int) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldContentRevision, v))
+}
+
+// ContentRevisionIn applies the In predicate on the "content_revision" field.
+func ContentRevisionIn(vs ...int) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldContentRevision, vs...))
+}
+
+// ContentRevisionNotIn applies the NotIn predicate on the "content_revision" field.
+func ContentRevisionNotIn(vs ...int) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldContentRevision, vs...))
+}
+
+// ContentRevisionGT applies the GT predicate on the "content_revision" field.
+func ContentRevisionGT(v int) predicate.Module {
+	return predicate.Module(sql.FieldGT(FieldContentRevision, v))
+}
+
+// ContentRevisionGTE applies the GTE predicate on the "content_revision" field.
+func ContentRevisionGTE(v int) predicate.Module {
+	return predicate.Module(sql.FieldGTE(FieldContentRevision, v))
+}
+
+// ContentRevisionLT applies the LT predicate on the "content_revision" field.
+func ContentRevisionLT(v int) predicate.Module {
+	return predicate.Module(sql.FieldLT(FieldContentRevision, v))
+}
+
+// ContentRevisionLTE applies the LTE predicate on the "content_revision" field.
+func ContentRevisionLTE(v int) predicate.Module {
+	return predicate.Module(sql.FieldLTE(FieldContentRevision, v))
+}
+
+// ContentRevisionIsNil applies the IsNil predicate on the "content_revision" field.
+func ContentRevisionIsNil() predicate.Module {
+	return predicate.Module(sql.FieldIsNull(FieldContentRevision))
+}
+
+// ContentRevisionNotNil applies the NotNil predicate on the "content_revision" field.
+func ContentRevisionNotNil() predicate.Module {
+	return predicate.Module(sql.FieldNotNull(FieldContentRevision))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldTitle, v))
@@ -446,6 +506,46 @@ func DataNotNil() predicate.Module {
 	return predicate.Module(sql.FieldNotNull(FieldData))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Module {
+	return predicate.Module(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Module {
+	return predicate.Module(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Module {
+	return predicate.Module(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Module {
+	return predicate.Module(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldCreatedAt, v))
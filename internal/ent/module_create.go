@@ -44,6 +44,20 @@ func (mc *ModuleCreate) SetNillableContentID(u *uuid.UUID) *ModuleCreate {
 	return mc
 }
 
+// SetContentRevision sets the "content_revision" field.
+func (mc *ModuleCreate) SetContentRevision(i int) *ModuleCreate {
+	mc.mutation.SetContentRevision(i)
+	return mc
+}
+
+// SetNillableContentRevision sets the "content_revision" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableContentRevision(i *int) *ModuleCreate {
+	if i != nil {
+		mc.SetContentRevision(*i)
+	}
+	return mc
+}
+
 // SetTitle sets the "title" field.
 func (mc *ModuleCreate) SetTitle(s string) *ModuleCreate {
 	mc.mutation.SetTitle(s)
@@ -104,6 +118,20 @@ func (mc *ModuleCreate) SetData(m map[string]interface{}) *ModuleCreate {
 	return mc
 }
 
+// SetVersion sets the "version" field.
+func (mc *ModuleCreate) SetVersion(i int) *ModuleCreate {
+	mc.mutation.SetVersion(i)
+	return mc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableVersion(i *int) *ModuleCreate {
+	if i != nil {
+		mc.SetVersion(*i)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *ModuleCreate) SetCreatedAt(t time.Time) *ModuleCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -218,6 +246,10 @@ func (mc *ModuleCreate) defaults() {
 		v := module.DefaultData
 		mc.mutation.SetData(v)
 	}
+	if _, ok := mc.mutation.Version(); !ok {
+		v := module.DefaultVersion
+		mc.mutation.SetVersion(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := module.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -259,13 +291,16 @@ func (mc *ModuleCreate) check() error {
 	if _, ok := mc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Module.status"`)}
 	}
+	if _, ok := mc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Module.version"`)}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Module.created_at"`)}
 	}
 	if _, ok := mc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Module.updated_at"`)}
 	}
-	if _, ok := mc.mutation.CourseID(); !ok {
+	if len(mc.mutation.CourseIDs()) == 0 {
 		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "Module.course"`)}
 	}
 	return nil
@@ -303,6 +338,10 @@ func (mc *ModuleCreate) createSpec() (*Module, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := mc.mutation.ContentRevision(); ok {
+		_spec.SetField(module.FieldContentRevision, field.TypeInt, value)
+		_node.ContentRevision = &value
+	}
 	if value, ok := mc.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -327,6 +366,10 @@ func (mc *ModuleCreate) createSpec() (*Module, *sqlgraph.CreateSpec) {
 		_spec.SetField(module.FieldData, field.TypeJSON, value)
 		_node.Data = value
 	}
+	if value, ok := mc.mutation.Version(); ok {
+		_spec.SetField(module.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(module.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -13,6 +13,7 @@ import (
 	"lms-go/internal/ent/predicate"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,13 +23,16 @@ import (
 // ModuleQuery is the builder for querying Module entities.
 type ModuleQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []module.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Module
-	withCourse          *CourseQuery
-	withContent         *ContentQuery
-	withProgressEntries *ModuleProgressQuery
+	ctx                      *QueryContext
+	order                    []module.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Module
+	withCourse               *CourseQuery
+	withContent              *ContentQuery
+	withProgressEntries      *ModuleProgressQuery
+	modifiers                []func(*sql.Selector)
+	loadTotal                []func(context.Context, []*Module) error
+	withNamedProgressEntries map[string]*ModuleProgressQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -134,7 +138,7 @@ func (mq *ModuleQuery) QueryProgressEntries() *ModuleProgressQuery {
 // First returns the first Module entity from the query.
 // Returns a *NotFoundError when no Module was found.
 func (mq *ModuleQuery) First(ctx context.Context) (*Module, error) {
-	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, "First"))
+	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +161,7 @@ func (mq *ModuleQuery) FirstX(ctx context.Context) *Module {
 // Returns a *NotFoundError when no Module ID was found.
 func (mq *ModuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, "FirstID")); err != nil {
+	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -180,7 +184,7 @@ func (mq *ModuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one Module entity is found.
 // Returns a *NotFoundError when no Module entities are found.
 func (mq *ModuleQuery) Only(ctx context.Context) (*Module, error) {
-	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, "Only"))
+	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +212,7 @@ func (mq *ModuleQuery) OnlyX(ctx context.Context) *Module {
 // Returns a *NotFoundError when no entities are found.
 func (mq *ModuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, "OnlyID")); err != nil {
+	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -233,7 +237,7 @@ func (mq *ModuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of Modules.
 func (mq *ModuleQuery) All(ctx context.Context) ([]*Module, error) {
-	ctx = setContextOp(ctx, mq.ctx, "All")
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryAll)
 	if err := mq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -255,7 +259,7 @@ func (mq *ModuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
 	if mq.ctx.Unique == nil && mq.path != nil {
 		mq.Unique(true)
 	}
-	ctx = setContextOp(ctx, mq.ctx, "IDs")
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryIDs)
 	if err = mq.Select(module.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -273,7 +277,7 @@ func (mq *ModuleQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (mq *ModuleQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, mq.ctx, "Count")
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryCount)
 	if err := mq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -291,7 +295,7 @@ func (mq *ModuleQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (mq *ModuleQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, mq.ctx, "Exist")
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryExist)
 	switch _, err := mq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -458,6 +462,9 @@ func (mq *ModuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Modul
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(mq.modifiers) > 0 {
+		_spec.Modifiers = mq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -486,6 +493,18 @@ func (mq *ModuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Modul
 			return nil, err
 		}
 	}
+	for name, query := range mq.withNamedProgressEntries {
+		if err := mq.loadProgressEntries(ctx, query, nodes,
+			func(n *Module) { n.appendNamedProgressEntries(name) },
+			func(n *Module, e *ModuleProgress) { n.appendNamedProgressEntries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range mq.loadTotal {
+		if err := mq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -583,6 +602,9 @@ func (mq *ModuleQuery) loadProgressEntries(ctx context.Context, query *ModulePro
 
 func (mq *ModuleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
+	if len(mq.modifiers) > 0 {
+		_spec.Modifiers = mq.modifiers
+	}
 	_spec.Node.Columns = mq.ctx.Fields
 	if len(mq.ctx.Fields) > 0 {
 		_spec.Unique = mq.ctx.Unique != nil && *mq.ctx.Unique
@@ -668,6 +690,20 @@ func (mq *ModuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedProgressEntries tells the query-builder to eager-load the nodes that are connected to the "progress_entries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (mq *ModuleQuery) WithNamedProgressEntries(name string, opts ...func(*ModuleProgressQuery)) *ModuleQuery {
+	query := (&ModuleProgressClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if mq.withNamedProgressEntries == nil {
+		mq.withNamedProgressEntries = make(map[string]*ModuleProgressQuery)
+	}
+	mq.withNamedProgressEntries[name] = query
+	return mq
+}
+
 // ModuleGroupBy is the group-by builder for Module entities.
 type ModuleGroupBy struct {
 	selector
@@ -682,7 +718,7 @@ func (mgb *ModuleGroupBy) Aggregate(fns ...AggregateFunc) *ModuleGroupBy {
 
 // Scan applies the selector query and scans the result into the given value.
 func (mgb *ModuleGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, mgb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, mgb.build.ctx, ent.OpQueryGroupBy)
 	if err := mgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -730,7 +766,7 @@ func (ms *ModuleSelect) Aggregate(fns ...AggregateFunc) *ModuleSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (ms *ModuleSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, ms.ctx, "Select")
+	ctx = setContextOp(ctx, ms.ctx, ent.OpQuerySelect)
 	if err := ms.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -66,6 +66,33 @@ func (mu *ModuleUpdate) ClearContentID() *ModuleUpdate {
 	return mu
 }
 
+// SetContentRevision sets the "content_revision" field.
+func (mu *ModuleUpdate) SetContentRevision(i int) *ModuleUpdate {
+	mu.mutation.ResetContentRevision()
+	mu.mutation.SetContentRevision(i)
+	return mu
+}
+
+// SetNillableContentRevision sets the "content_revision" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableContentRevision(i *int) *ModuleUpdate {
+	if i != nil {
+		mu.SetContentRevision(*i)
+	}
+	return mu
+}
+
+// AddContentRevision adds i to the "content_revision" field.
+func (mu *ModuleUpdate) AddContentRevision(i int) *ModuleUpdate {
+	mu.mutation.AddContentRevision(i)
+	return mu
+}
+
+// ClearContentRevision clears the value of the "content_revision" field.
+func (mu *ModuleUpdate) ClearContentRevision() *ModuleUpdate {
+	mu.mutation.ClearContentRevision()
+	return mu
+}
+
 // SetTitle sets the "title" field.
 func (mu *ModuleUpdate) SetTitle(s string) *ModuleUpdate {
 	mu.mutation.SetTitle(s)
@@ -168,6 +195,27 @@ func (mu *ModuleUpdate) ClearData() *ModuleUpdate {
 	return mu
 }
 
+// SetVersion sets the "version" field.
+func (mu *ModuleUpdate) SetVersion(i int) *ModuleUpdate {
+	mu.mutation.ResetVersion()
+	mu.mutation.SetVersion(i)
+	return mu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableVersion(i *int) *ModuleUpdate {
+	if i != nil {
+		mu.SetVersion(*i)
+	}
+	return mu
+}
+
+// AddVersion adds i to the "version" field.
+func (mu *ModuleUpdate) AddVersion(i int) *ModuleUpdate {
+	mu.mutation.AddVersion(i)
+	return mu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (mu *ModuleUpdate) SetUpdatedAt(t time.Time) *ModuleUpdate {
 	mu.mutation.SetUpdatedAt(t)
@@ -285,7 +333,7 @@ func (mu *ModuleUpdate) check() error {
 			return &ValidationError{Name: "module_type", err: fmt.Errorf(`ent: validator failed for field "Module.module_type": %w`, err)}
 		}
 	}
-	if _, ok := mu.mutation.CourseID(); mu.mutation.CourseCleared() && !ok {
+	if mu.mutation.CourseCleared() && len(mu.mutation.CourseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Module.course"`)
 	}
 	return nil
@@ -303,6 +351,15 @@ func (mu *ModuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := mu.mutation.ContentRevision(); ok {
+		_spec.SetField(module.FieldContentRevision, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedContentRevision(); ok {
+		_spec.AddField(module.FieldContentRevision, field.TypeInt, value)
+	}
+	if mu.mutation.ContentRevisionCleared() {
+		_spec.ClearField(module.FieldContentRevision, field.TypeInt)
+	}
 	if value, ok := mu.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 	}
@@ -333,6 +390,12 @@ func (mu *ModuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.DataCleared() {
 		_spec.ClearField(module.FieldData, field.TypeJSON)
 	}
+	if value, ok := mu.mutation.Version(); ok {
+		_spec.SetField(module.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedVersion(); ok {
+		_spec.AddField(module.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := mu.mutation.UpdatedAt(); ok {
 		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -493,6 +556,33 @@ func (muo *ModuleUpdateOne) ClearContentID() *ModuleUpdateOne {
 	return muo
 }
 
+// SetContentRevision sets the "content_revision" field.
+func (muo *ModuleUpdateOne) SetContentRevision(i int) *ModuleUpdateOne {
+	muo.mutation.ResetContentRevision()
+	muo.mutation.SetContentRevision(i)
+	return muo
+}
+
+// SetNillableContentRevision sets the "content_revision" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableContentRevision(i *int) *ModuleUpdateOne {
+	if i != nil {
+		muo.SetContentRevision(*i)
+	}
+	return muo
+}
+
+// AddContentRevision adds i to the "content_revision" field.
+func (muo *ModuleUpdateOne) AddContentRevision(i int) *ModuleUpdateOne {
+	muo.mutation.AddContentRevision(i)
+	return muo
+}
+
+// ClearContentRevision clears the value of the "content_revision" field.
+func (muo *ModuleUpdateOne) ClearContentRevision() *ModuleUpdateOne {
+	muo.mutation.ClearContentRevision()
+	return muo
+}
+
 // SetTitle sets the "title" field.
 func (muo *ModuleUpdateOne) SetTitle(s string) *ModuleUpdateOne {
 	muo.mutation.SetTitle(s)
@@ -595,6 +685,27 @@ func (muo *ModuleUpdateOne) ClearData() *ModuleUpdateOne {
 	return muo
 }
 
+// SetVersion sets the "version" field.
+func (muo *ModuleUpdateOne) SetVersion(i int) *ModuleUpdateOne {
+	muo.mutation.ResetVersion()
+	muo.mutation.SetVersion(i)
+	return muo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableVersion(i *int) *ModuleUpdateOne {
+	if i != nil {
+		muo.SetVersion(*i)
+	}
+	return muo
+}
+
+// AddVersion adds i to the "version" field.
+func (muo *ModuleUpdateOne) AddVersion(i int) *ModuleUpdateOne {
+	muo.mutation.AddVersion(i)
+	return muo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (muo *ModuleUpdateOne) SetUpdatedAt(t time.Time) *ModuleUpdateOne {
 	muo.mutation.SetUpdatedAt(t)
@@ -725,7 +836,7 @@ func (muo *ModuleUpdateOne) check() error {
 			return &ValidationError{Name: "module_type", err: fmt.Errorf(`ent: validator failed for field "Module.module_type": %w`, err)}
 		}
 	}
-	if _, ok := muo.mutation.CourseID(); muo.mutation.CourseCleared() && !ok {
+	if muo.mutation.CourseCleared() && len(muo.mutation.CourseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Module.course"`)
 	}
 	return nil
@@ -760,6 +871,15 @@ func (muo *ModuleUpdateOne) sqlSave(ctx context.Context) (_node *Module, err err
 			}
 		}
 	}
+	if value, ok := muo.mutation.ContentRevision(); ok {
+		_spec.SetField(module.FieldContentRevision, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedContentRevision(); ok {
+		_spec.AddField(module.FieldContentRevision, field.TypeInt, value)
+	}
+	if muo.mutation.ContentRevisionCleared() {
+		_spec.ClearField(module.FieldContentRevision, field.TypeInt)
+	}
 	if value, ok := muo.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 	}
@@ -790,6 +910,12 @@ func (muo *ModuleUpdateOne) sqlSave(ctx context.Context) (_node *Module, err err
 	if muo.mutation.DataCleared() {
 		_spec.ClearField(module.FieldData, field.TypeJSON)
 	}
+	if value, ok := muo.mutation.Version(); ok {
+		_spec.SetField(module.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedVersion(); ok {
+		_spec.AddField(module.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := muo.mutation.UpdatedAt(); ok {
 		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
 	}
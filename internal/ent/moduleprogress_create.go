@@ -234,10 +234,10 @@ func (mpc *ModuleProgressCreate) check() error {
 	if _, ok := mpc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModuleProgress.updated_at"`)}
 	}
-	if _, ok := mpc.mutation.EnrollmentID(); !ok {
+	if len(mpc.mutation.EnrollmentIDs()) == 0 {
 		return &ValidationError{Name: "enrollment", err: errors.New(`ent: missing required edge "ModuleProgress.enrollment"`)}
 	}
-	if _, ok := mpc.mutation.ModuleID(); !ok {
+	if len(mpc.mutation.ModuleIDs()) == 0 {
 		return &ValidationError{Name: "module", err: errors.New(`ent: missing required edge "ModuleProgress.module"`)}
 	}
 	return nil
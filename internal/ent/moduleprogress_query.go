@@ -11,6 +11,7 @@ import (
 	"lms-go/internal/ent/predicate"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,8 @@ type ModuleProgressQuery struct {
 	predicates     []predicate.ModuleProgress
 	withEnrollment *EnrollmentQuery
 	withModule     *ModuleQuery
+	modifiers      []func(*sql.Selector)
+	loadTotal      []func(context.Context, []*ModuleProgress) error
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,7 +112,7 @@ func (mpq *ModuleProgressQuery) QueryModule() *ModuleQuery {
 // First returns the first ModuleProgress entity from the query.
 // Returns a *NotFoundError when no ModuleProgress was found.
 func (mpq *ModuleProgressQuery) First(ctx context.Context) (*ModuleProgress, error) {
-	nodes, err := mpq.Limit(1).All(setContextOp(ctx, mpq.ctx, "First"))
+	nodes, err := mpq.Limit(1).All(setContextOp(ctx, mpq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +135,7 @@ func (mpq *ModuleProgressQuery) FirstX(ctx context.Context) *ModuleProgress {
 // Returns a *NotFoundError when no ModuleProgress ID was found.
 func (mpq *ModuleProgressQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = mpq.Limit(1).IDs(setContextOp(ctx, mpq.ctx, "FirstID")); err != nil {
+	if ids, err = mpq.Limit(1).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -155,7 +158,7 @@ func (mpq *ModuleProgressQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one ModuleProgress entity is found.
 // Returns a *NotFoundError when no ModuleProgress entities are found.
 func (mpq *ModuleProgressQuery) Only(ctx context.Context) (*ModuleProgress, error) {
-	nodes, err := mpq.Limit(2).All(setContextOp(ctx, mpq.ctx, "Only"))
+	nodes, err := mpq.Limit(2).All(setContextOp(ctx, mpq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +186,7 @@ func (mpq *ModuleProgressQuery) OnlyX(ctx context.Context) *ModuleProgress {
 // Returns a *NotFoundError when no entities are found.
 func (mpq *ModuleProgressQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = mpq.Limit(2).IDs(setContextOp(ctx, mpq.ctx, "OnlyID")); err != nil {
+	if ids, err = mpq.Limit(2).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -208,7 +211,7 @@ func (mpq *ModuleProgressQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of ModuleProgresses.
 func (mpq *ModuleProgressQuery) All(ctx context.Context) ([]*ModuleProgress, error) {
-	ctx = setContextOp(ctx, mpq.ctx, "All")
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryAll)
 	if err := mpq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -230,7 +233,7 @@ func (mpq *ModuleProgressQuery) IDs(ctx context.Context) (ids []uuid.UUID, err e
 	if mpq.ctx.Unique == nil && mpq.path != nil {
 		mpq.Unique(true)
 	}
-	ctx = setContextOp(ctx, mpq.ctx, "IDs")
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryIDs)
 	if err = mpq.Select(moduleprogress.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -248,7 +251,7 @@ func (mpq *ModuleProgressQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (mpq *ModuleProgressQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, mpq.ctx, "Count")
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryCount)
 	if err := mpq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -266,7 +269,7 @@ func (mpq *ModuleProgressQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (mpq *ModuleProgressQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, mpq.ctx, "Exist")
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryExist)
 	switch _, err := mpq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -420,6 +423,9 @@ func (mpq *ModuleProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(mpq.modifiers) > 0 {
+		_spec.Modifiers = mpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -441,6 +447,11 @@ func (mpq *ModuleProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	for i := range mpq.loadTotal {
+		if err := mpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +516,9 @@ func (mpq *ModuleProgressQuery) loadModule(ctx context.Context, query *ModuleQue
 
 func (mpq *ModuleProgressQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mpq.querySpec()
+	if len(mpq.modifiers) > 0 {
+		_spec.Modifiers = mpq.modifiers
+	}
 	_spec.Node.Columns = mpq.ctx.Fields
 	if len(mpq.ctx.Fields) > 0 {
 		_spec.Unique = mpq.ctx.Unique != nil && *mpq.ctx.Unique
@@ -604,7 +618,7 @@ func (mpgb *ModuleProgressGroupBy) Aggregate(fns ...AggregateFunc) *ModuleProgre
 
 // Scan applies the selector query and scans the result into the given value.
 func (mpgb *ModuleProgressGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, mpgb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, mpgb.build.ctx, ent.OpQueryGroupBy)
 	if err := mpgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -652,7 +666,7 @@ func (mps *ModuleProgressSelect) Aggregate(fns ...AggregateFunc) *ModuleProgress
 
 // Scan applies the selector query and scans the result into the given value.
 func (mps *ModuleProgressSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, mps.ctx, "Select")
+	ctx = setContextOp(ctx, mps.ctx, ent.OpQuerySelect)
 	if err := mps.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -232,10 +232,10 @@ func (mpu *ModuleProgressUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (mpu *ModuleProgressUpdate) check() error {
-	if _, ok := mpu.mutation.EnrollmentID(); mpu.mutation.EnrollmentCleared() && !ok {
+	if mpu.mutation.EnrollmentCleared() && len(mpu.mutation.EnrollmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModuleProgress.enrollment"`)
 	}
-	if _, ok := mpu.mutation.ModuleID(); mpu.mutation.ModuleCleared() && !ok {
+	if mpu.mutation.ModuleCleared() && len(mpu.mutation.ModuleIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModuleProgress.module"`)
 	}
 	return nil
@@ -578,10 +578,10 @@ func (mpuo *ModuleProgressUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (mpuo *ModuleProgressUpdateOne) check() error {
-	if _, ok := mpuo.mutation.EnrollmentID(); mpuo.mutation.EnrollmentCleared() && !ok {
+	if mpuo.mutation.EnrollmentCleared() && len(mpuo.mutation.EnrollmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModuleProgress.enrollment"`)
 	}
-	if _, ok := mpuo.mutation.ModuleID(); mpuo.mutation.ModuleCleared() && !ok {
+	if mpuo.mutation.ModuleCleared() && len(mpuo.mutation.ModuleIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModuleProgress.module"`)
 	}
 	return nil
@@ -6,15 +6,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"lms-go/internal/ent/apikey"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/course"
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
+	"lms-go/internal/ent/idempotencykey"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/moduleprogress"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/samlassertion"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthnceremony"
+	"lms-go/internal/ent/webauthncredential"
 	"sync"
 	"time"
 
@@ -32,53 +43,61 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeContent        = "Content"
-	TypeCourse         = "Course"
-	TypeEnrollment     = "Enrollment"
-	TypeGroup          = "Group"
-	TypeModule         = "Module"
-	TypeModuleProgress = "ModuleProgress"
-	TypeOrganization   = "Organization"
-	TypeUser           = "User"
+	TypeAPIKey             = "APIKey"
+	TypeContent            = "Content"
+	TypeContentDownload    = "ContentDownload"
+	TypeContentRevision    = "ContentRevision"
+	TypeCourse             = "Course"
+	TypeEnrollment         = "Enrollment"
+	TypeGroup              = "Group"
+	TypeIdempotencyKey     = "IdempotencyKey"
+	TypeModule             = "Module"
+	TypeModuleProgress     = "ModuleProgress"
+	TypeOrganization       = "Organization"
+	TypePrivacyRequest     = "PrivacyRequest"
+	TypeSSOIdentity        = "SSOIdentity"
+	TypeSamlAssertion      = "SamlAssertion"
+	TypeScimToken          = "ScimToken"
+	TypeServiceAccount     = "ServiceAccount"
+	TypeUser               = "User"
+	TypeWebauthnCeremony   = "WebauthnCeremony"
+	TypeWebauthnCredential = "WebauthnCredential"
 )
 
-// ContentMutation represents an operation that mutates the Content nodes in the graph.
-type ContentMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	name                *string
-	mime_type           *string
-	size_bytes          *int64
-	addsize_bytes       *int64
-	storage_key         *string
-	status              *string
-	metadata            *map[string]interface{}
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	organization        *uuid.UUID
-	clearedorganization bool
-	modules             map[uuid.UUID]struct{}
-	removedmodules      map[uuid.UUID]struct{}
-	clearedmodules      bool
-	done                bool
-	oldValue            func(context.Context) (*Content, error)
-	predicates          []predicate.Content
-}
-
-var _ ent.Mutation = (*ContentMutation)(nil)
-
-// contentOption allows management of the mutation configuration using functional options.
-type contentOption func(*ContentMutation)
-
-// newContentMutation creates new mutation for the Content entity.
-func newContentMutation(c config, op Op, opts ...contentOption) *ContentMutation {
-	m := &ContentMutation{
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	name                   *string
+	prefix                 *string
+	secret_hash            *string
+	scopes                 *[]string
+	appendscopes           []string
+	expires_at             *time.Time
+	last_used_at           *time.Time
+	revoked_at             *time.Time
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	service_account        *uuid.UUID
+	clearedservice_account bool
+	done                   bool
+	oldValue               func(context.Context) (*APIKey, error)
+	predicates             []predicate.APIKey
+}
+
+var _ ent.Mutation = (*APIKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
+
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeContent,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -87,20 +106,20 @@ func newContentMutation(c config, op Op, opts ...contentOption) *ContentMutation
 	return m
 }
 
-// withContentID sets the ID field of the mutation.
-func withContentID(id uuid.UUID) contentOption {
-	return func(m *ContentMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uuid.UUID) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Content
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Content, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Content.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -109,10 +128,10 @@ func withContentID(id uuid.UUID) contentOption {
 	}
 }
 
-// withContent sets the old Content of the mutation.
-func withContent(node *Content) contentOption {
-	return func(m *ContentMutation) {
-		m.oldValue = func(context.Context) (*Content, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -121,7 +140,7 @@ func withContent(node *Content) contentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ContentMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -129,7 +148,7 @@ func (m ContentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ContentMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -139,14 +158,14 @@ func (m ContentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Content entities.
-func (m *ContentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ContentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -157,7 +176,7 @@ func (m *ContentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -166,55 +185,55 @@ func (m *ContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Content.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOrganizationID sets the "organization_id" field.
-func (m *ContentMutation) SetOrganizationID(u uuid.UUID) {
-	m.organization = &u
+// SetServiceAccountID sets the "service_account_id" field.
+func (m *APIKeyMutation) SetServiceAccountID(u uuid.UUID) {
+	m.service_account = &u
 }
 
-// OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *ContentMutation) OrganizationID() (r uuid.UUID, exists bool) {
-	v := m.organization
+// ServiceAccountID returns the value of the "service_account_id" field in the mutation.
+func (m *APIKeyMutation) ServiceAccountID() (r uuid.UUID, exists bool) {
+	v := m.service_account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldServiceAccountID returns the old "service_account_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *APIKeyMutation) OldServiceAccountID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldServiceAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+		return v, errors.New("OldServiceAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldServiceAccountID: %w", err)
 	}
-	return oldValue.OrganizationID, nil
+	return oldValue.ServiceAccountID, nil
 }
 
-// ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *ContentMutation) ResetOrganizationID() {
-	m.organization = nil
+// ResetServiceAccountID resets all changes to the "service_account_id" field.
+func (m *APIKeyMutation) ResetServiceAccountID() {
+	m.service_account = nil
 }
 
 // SetName sets the "name" field.
-func (m *ContentMutation) SetName(s string) {
+func (m *APIKeyMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ContentMutation) Name() (r string, exists bool) {
+func (m *APIKeyMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -222,10 +241,10 @@ func (m *ContentMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -240,244 +259,287 @@ func (m *ContentMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ContentMutation) ResetName() {
+func (m *APIKeyMutation) ResetName() {
 	m.name = nil
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *ContentMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *ContentMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMimeType returns the old "mime_type" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldMimeType(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.MimeType, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *ContentMutation) ResetMimeType() {
-	m.mime_type = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetSizeBytes sets the "size_bytes" field.
-func (m *ContentMutation) SetSizeBytes(i int64) {
-	m.size_bytes = &i
-	m.addsize_bytes = nil
+// SetSecretHash sets the "secret_hash" field.
+func (m *APIKeyMutation) SetSecretHash(s string) {
+	m.secret_hash = &s
 }
 
-// SizeBytes returns the value of the "size_bytes" field in the mutation.
-func (m *ContentMutation) SizeBytes() (r int64, exists bool) {
-	v := m.size_bytes
+// SecretHash returns the value of the "secret_hash" field in the mutation.
+func (m *APIKeyMutation) SecretHash() (r string, exists bool) {
+	v := m.secret_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSizeBytes returns the old "size_bytes" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretHash returns the old "secret_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+func (m *APIKeyMutation) OldSecretHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+		return v, errors.New("OldSecretHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretHash: %w", err)
 	}
-	return oldValue.SizeBytes, nil
+	return oldValue.SecretHash, nil
 }
 
-// AddSizeBytes adds i to the "size_bytes" field.
-func (m *ContentMutation) AddSizeBytes(i int64) {
-	if m.addsize_bytes != nil {
-		*m.addsize_bytes += i
-	} else {
-		m.addsize_bytes = &i
-	}
+// ResetSecretHash resets all changes to the "secret_hash" field.
+func (m *APIKeyMutation) ResetSecretHash() {
+	m.secret_hash = nil
 }
 
-// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
-func (m *ContentMutation) AddedSizeBytes() (r int64, exists bool) {
-	v := m.addsize_bytes
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSizeBytes clears the value of the "size_bytes" field.
-func (m *ContentMutation) ClearSizeBytes() {
-	m.size_bytes = nil
-	m.addsize_bytes = nil
-	m.clearedFields[content.FieldSizeBytes] = struct{}{}
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
 }
 
-// SizeBytesCleared returns if the "size_bytes" field was cleared in this mutation.
-func (m *ContentMutation) SizeBytesCleared() bool {
-	_, ok := m.clearedFields[content.FieldSizeBytes]
-	return ok
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// ResetSizeBytes resets all changes to the "size_bytes" field.
-func (m *ContentMutation) ResetSizeBytes() {
-	m.size_bytes = nil
-	m.addsize_bytes = nil
-	delete(m.clearedFields, content.FieldSizeBytes)
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// SetStorageKey sets the "storage_key" field.
-func (m *ContentMutation) SetStorageKey(s string) {
-	m.storage_key = &s
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// StorageKey returns the value of the "storage_key" field in the mutation.
-func (m *ContentMutation) StorageKey() (r string, exists bool) {
-	v := m.storage_key
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStorageKey returns the old "storage_key" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.StorageKey, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetStorageKey resets all changes to the "storage_key" field.
-func (m *ContentMutation) ResetStorageKey() {
-	m.storage_key = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *ContentMutation) SetStatus(s string) {
-	m.status = &s
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ContentMutation) Status() (r string, exists bool) {
-	v := m.status
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ContentMutation) ResetStatus() {
-	m.status = nil
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *ContentMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *ContentMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *ContentMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[content.FieldMetadata] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *ContentMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[content.FieldMetadata]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *ContentMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, content.FieldMetadata)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ContentMutation) SetCreatedAt(t time.Time) {
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ContentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -485,10 +547,10 @@ func (m *ContentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -503,188 +565,98 @@ func (m *ContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ContentMutation) ResetCreatedAt() {
+func (m *APIKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ContentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearServiceAccount clears the "service_account" edge to the ServiceAccount entity.
+func (m *APIKeyMutation) ClearServiceAccount() {
+	m.clearedservice_account = true
+	m.clearedFields[apikey.FieldServiceAccountID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ContentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ServiceAccountCleared reports if the "service_account" edge to the ServiceAccount entity was cleared.
+func (m *APIKeyMutation) ServiceAccountCleared() bool {
+	return m.clearedservice_account
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Content entity.
-// If the Content object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// ServiceAccountIDs returns the "service_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceAccountID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) ServiceAccountIDs() (ids []uuid.UUID) {
+	if id := m.service_account; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ContentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetServiceAccount resets all changes to the "service_account" edge.
+func (m *APIKeyMutation) ResetServiceAccount() {
+	m.service_account = nil
+	m.clearedservice_account = false
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *ContentMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[content.FieldOrganizationID] = struct{}{}
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *ContentMutation) OrganizationCleared() bool {
-	return m.clearedorganization
-}
-
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *ContentMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *ContentMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
-}
-
-// AddModuleIDs adds the "modules" edge to the Module entity by ids.
-func (m *ContentMutation) AddModuleIDs(ids ...uuid.UUID) {
-	if m.modules == nil {
-		m.modules = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.modules[ids[i]] = struct{}{}
-	}
-}
-
-// ClearModules clears the "modules" edge to the Module entity.
-func (m *ContentMutation) ClearModules() {
-	m.clearedmodules = true
-}
-
-// ModulesCleared reports if the "modules" edge to the Module entity was cleared.
-func (m *ContentMutation) ModulesCleared() bool {
-	return m.clearedmodules
-}
-
-// RemoveModuleIDs removes the "modules" edge to the Module entity by IDs.
-func (m *ContentMutation) RemoveModuleIDs(ids ...uuid.UUID) {
-	if m.removedmodules == nil {
-		m.removedmodules = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.modules, ids[i])
-		m.removedmodules[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedModules returns the removed IDs of the "modules" edge to the Module entity.
-func (m *ContentMutation) RemovedModulesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmodules {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ModulesIDs returns the "modules" edge IDs in the mutation.
-func (m *ContentMutation) ModulesIDs() (ids []uuid.UUID) {
-	for id := range m.modules {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetModules resets all changes to the "modules" edge.
-func (m *ContentMutation) ResetModules() {
-	m.modules = nil
-	m.clearedmodules = false
-	m.removedmodules = nil
-}
-
-// Where appends a list predicates to the ContentMutation builder.
-func (m *ContentMutation) Where(ps ...predicate.Content) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ContentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ContentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Content, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *ContentMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ContentMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Content).
-func (m *ContentMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ContentMutation) Fields() []string {
+func (m *APIKeyMutation) Fields() []string {
 	fields := make([]string, 0, 9)
-	if m.organization != nil {
-		fields = append(fields, content.FieldOrganizationID)
+	if m.service_account != nil {
+		fields = append(fields, apikey.FieldServiceAccountID)
 	}
 	if m.name != nil {
-		fields = append(fields, content.FieldName)
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.mime_type != nil {
-		fields = append(fields, content.FieldMimeType)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
 	}
-	if m.size_bytes != nil {
-		fields = append(fields, content.FieldSizeBytes)
+	if m.secret_hash != nil {
+		fields = append(fields, apikey.FieldSecretHash)
 	}
-	if m.storage_key != nil {
-		fields = append(fields, content.FieldStorageKey)
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
-	if m.status != nil {
-		fields = append(fields, content.FieldStatus)
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
 	}
-	if m.metadata != nil {
-		fields = append(fields, content.FieldMetadata)
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, content.FieldCreatedAt)
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, content.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	return fields
 }
@@ -692,26 +664,26 @@ func (m *ContentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ContentMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case content.FieldOrganizationID:
-		return m.OrganizationID()
-	case content.FieldName:
+	case apikey.FieldServiceAccountID:
+		return m.ServiceAccountID()
+	case apikey.FieldName:
 		return m.Name()
-	case content.FieldMimeType:
-		return m.MimeType()
-	case content.FieldSizeBytes:
-		return m.SizeBytes()
-	case content.FieldStorageKey:
-		return m.StorageKey()
-	case content.FieldStatus:
-		return m.Status()
-	case content.FieldMetadata:
-		return m.Metadata()
-	case content.FieldCreatedAt:
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldSecretHash:
+		return m.SecretHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case content.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -719,324 +691,294 @@ func (m *ContentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ContentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case content.FieldOrganizationID:
-		return m.OldOrganizationID(ctx)
-	case content.FieldName:
+	case apikey.FieldServiceAccountID:
+		return m.OldServiceAccountID(ctx)
+	case apikey.FieldName:
 		return m.OldName(ctx)
-	case content.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case content.FieldSizeBytes:
-		return m.OldSizeBytes(ctx)
-	case content.FieldStorageKey:
-		return m.OldStorageKey(ctx)
-	case content.FieldStatus:
-		return m.OldStatus(ctx)
-	case content.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case content.FieldCreatedAt:
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldSecretHash:
+		return m.OldSecretHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case content.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Content field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ContentMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case content.FieldOrganizationID:
+	case apikey.FieldServiceAccountID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrganizationID(v)
+		m.SetServiceAccountID(v)
 		return nil
-	case content.FieldName:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case content.FieldMimeType:
+	case apikey.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMimeType(v)
+		m.SetPrefix(v)
 		return nil
-	case content.FieldSizeBytes:
-		v, ok := value.(int64)
+	case apikey.FieldSecretHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSizeBytes(v)
+		m.SetSecretHash(v)
 		return nil
-	case content.FieldStorageKey:
-		v, ok := value.(string)
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStorageKey(v)
+		m.SetScopes(v)
 		return nil
-	case content.FieldStatus:
-		v, ok := value.(string)
+	case apikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetExpiresAt(v)
 		return nil
-	case content.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case content.FieldCreatedAt:
+	case apikey.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRevokedAt(v)
 		return nil
-	case content.FieldUpdatedAt:
+	case apikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Content field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ContentMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize_bytes != nil {
-		fields = append(fields, content.FieldSizeBytes)
-	}
-	return fields
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ContentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case content.FieldSizeBytes:
-		return m.AddedSizeBytes()
-	}
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ContentMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case content.FieldSizeBytes:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSizeBytes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Content numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ContentMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(content.FieldSizeBytes) {
-		fields = append(fields, content.FieldSizeBytes)
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
 	}
-	if m.FieldCleared(content.FieldMetadata) {
-		fields = append(fields, content.FieldMetadata)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ContentMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ContentMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case content.FieldSizeBytes:
-		m.ClearSizeBytes()
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case content.FieldMetadata:
-		m.ClearMetadata()
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Content nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ContentMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case content.FieldOrganizationID:
-		m.ResetOrganizationID()
+	case apikey.FieldServiceAccountID:
+		m.ResetServiceAccountID()
 		return nil
-	case content.FieldName:
+	case apikey.FieldName:
 		m.ResetName()
 		return nil
-	case content.FieldMimeType:
-		m.ResetMimeType()
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case content.FieldSizeBytes:
-		m.ResetSizeBytes()
+	case apikey.FieldSecretHash:
+		m.ResetSecretHash()
 		return nil
-	case content.FieldStorageKey:
-		m.ResetStorageKey()
+	case apikey.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case content.FieldStatus:
-		m.ResetStatus()
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case content.FieldMetadata:
-		m.ResetMetadata()
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case content.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
-	case content.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Content field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ContentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.organization != nil {
-		edges = append(edges, content.EdgeOrganization)
-	}
-	if m.modules != nil {
-		edges = append(edges, content.EdgeModules)
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.service_account != nil {
+		edges = append(edges, apikey.EdgeServiceAccount)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ContentMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case content.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case apikey.EdgeServiceAccount:
+		if id := m.service_account; id != nil {
 			return []ent.Value{*id}
 		}
-	case content.EdgeModules:
-		ids := make([]ent.Value, 0, len(m.modules))
-		for id := range m.modules {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ContentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmodules != nil {
-		edges = append(edges, content.EdgeModules)
-	}
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ContentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case content.EdgeModules:
-		ids := make([]ent.Value, 0, len(m.removedmodules))
-		for id := range m.removedmodules {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ContentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedorganization {
-		edges = append(edges, content.EdgeOrganization)
-	}
-	if m.clearedmodules {
-		edges = append(edges, content.EdgeModules)
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedservice_account {
+		edges = append(edges, apikey.EdgeServiceAccount)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ContentMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case content.EdgeOrganization:
-		return m.clearedorganization
-	case content.EdgeModules:
-		return m.clearedmodules
+	case apikey.EdgeServiceAccount:
+		return m.clearedservice_account
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ContentMutation) ClearEdge(name string) error {
+func (m *APIKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case content.EdgeOrganization:
-		m.ClearOrganization()
+	case apikey.EdgeServiceAccount:
+		m.ClearServiceAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown Content unique edge %s", name)
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ContentMutation) ResetEdge(name string) error {
+func (m *APIKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case content.EdgeOrganization:
-		m.ResetOrganization()
-		return nil
-	case content.EdgeModules:
-		m.ResetModules()
+	case apikey.EdgeServiceAccount:
+		m.ResetServiceAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown Content edge %s", name)
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// CourseMutation represents an operation that mutates the Course nodes in the graph.
-type CourseMutation struct {
+// ContentMutation represents an operation that mutates the Content nodes in the graph.
+type ContentMutation struct {
 	config
 	op                  Op
 	typ                 string
 	id                  *uuid.UUID
-	title               *string
-	slug                *string
-	description         *string
+	name                *string
+	mime_type           *string
+	size_bytes          *int64
+	addsize_bytes       *int64
+	storage_key         *string
+	current_revision    *int
+	addcurrent_revision *int
+	etag                *string
+	checksum_sha256     *string
 	status              *string
-	version             *int
-	addversion          *int
+	upload_id           *string
+	upload_part_size    *int64
+	addupload_part_size *int64
+	upload_started_at   *time.Time
 	metadata            *map[string]interface{}
-	published_at        *time.Time
 	created_at          *time.Time
 	updated_at          *time.Time
 	clearedFields       map[string]struct{}
@@ -1045,28 +987,28 @@ type CourseMutation struct {
 	modules             map[uuid.UUID]struct{}
 	removedmodules      map[uuid.UUID]struct{}
 	clearedmodules      bool
-	enrollments         map[uuid.UUID]struct{}
-	removedenrollments  map[uuid.UUID]struct{}
-	clearedenrollments  bool
-	groups              map[uuid.UUID]struct{}
-	removedgroups       map[uuid.UUID]struct{}
-	clearedgroups       bool
+	revisions           map[uuid.UUID]struct{}
+	removedrevisions    map[uuid.UUID]struct{}
+	clearedrevisions    bool
+	downloads           map[uuid.UUID]struct{}
+	removeddownloads    map[uuid.UUID]struct{}
+	cleareddownloads    bool
 	done                bool
-	oldValue            func(context.Context) (*Course, error)
-	predicates          []predicate.Course
+	oldValue            func(context.Context) (*Content, error)
+	predicates          []predicate.Content
 }
 
-var _ ent.Mutation = (*CourseMutation)(nil)
+var _ ent.Mutation = (*ContentMutation)(nil)
 
-// courseOption allows management of the mutation configuration using functional options.
-type courseOption func(*CourseMutation)
+// contentOption allows management of the mutation configuration using functional options.
+type contentOption func(*ContentMutation)
 
-// newCourseMutation creates new mutation for the Course entity.
-func newCourseMutation(c config, op Op, opts ...courseOption) *CourseMutation {
-	m := &CourseMutation{
+// newContentMutation creates new mutation for the Content entity.
+func newContentMutation(c config, op Op, opts ...contentOption) *ContentMutation {
+	m := &ContentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCourse,
+		typ:           TypeContent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1075,20 +1017,20 @@ func newCourseMutation(c config, op Op, opts ...courseOption) *CourseMutation {
 	return m
 }
 
-// withCourseID sets the ID field of the mutation.
-func withCourseID(id uuid.UUID) courseOption {
-	return func(m *CourseMutation) {
+// withContentID sets the ID field of the mutation.
+func withContentID(id uuid.UUID) contentOption {
+	return func(m *ContentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Course
+			value *Content
 		)
-		m.oldValue = func(ctx context.Context) (*Course, error) {
+		m.oldValue = func(ctx context.Context) (*Content, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Course.Get(ctx, id)
+					value, err = m.Client().Content.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1097,10 +1039,10 @@ func withCourseID(id uuid.UUID) courseOption {
 	}
 }
 
-// withCourse sets the old Course of the mutation.
-func withCourse(node *Course) courseOption {
-	return func(m *CourseMutation) {
-		m.oldValue = func(context.Context) (*Course, error) {
+// withContent sets the old Content of the mutation.
+func withContent(node *Content) contentOption {
+	return func(m *ContentMutation) {
+		m.oldValue = func(context.Context) (*Content, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1109,7 +1051,7 @@ func withCourse(node *Course) courseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CourseMutation) Client() *Client {
+func (m ContentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1117,7 +1059,7 @@ func (m CourseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CourseMutation) Tx() (*Tx, error) {
+func (m ContentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1127,14 +1069,14 @@ func (m CourseMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Course entities.
-func (m *CourseMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Content entities.
+func (m *ContentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CourseMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ContentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1145,7 +1087,7 @@ func (m *CourseMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CourseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1154,19 +1096,19 @@ func (m *CourseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Course.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Content.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetOrganizationID sets the "organization_id" field.
-func (m *CourseMutation) SetOrganizationID(u uuid.UUID) {
+func (m *ContentMutation) SetOrganizationID(u uuid.UUID) {
 	m.organization = &u
 }
 
 // OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *CourseMutation) OrganizationID() (r uuid.UUID, exists bool) {
+func (m *ContentMutation) OrganizationID() (r uuid.UUID, exists bool) {
 	v := m.organization
 	if v == nil {
 		return
@@ -1174,10 +1116,10 @@ func (m *CourseMutation) OrganizationID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
@@ -1192,646 +1134,935 @@ func (m *CourseMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, er
 }
 
 // ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *CourseMutation) ResetOrganizationID() {
+func (m *ContentMutation) ResetOrganizationID() {
 	m.organization = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *CourseMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *ContentMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *CourseMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *ContentMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ContentMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *CourseMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *ContentMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *CourseMutation) SetSlug(s string) {
-	m.slug = &s
+// SetMimeType sets the "mime_type" field.
+func (m *ContentMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *CourseMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *ContentMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldMimeType returns the old "mime_type" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *ContentMutation) OldMimeType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.MimeType, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *CourseMutation) ResetSlug() {
-	m.slug = nil
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *ContentMutation) ResetMimeType() {
+	m.mime_type = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *CourseMutation) SetDescription(s string) {
-	m.description = &s
+// SetSizeBytes sets the "size_bytes" field.
+func (m *ContentMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CourseMutation) Description() (r string, exists bool) {
-	v := m.description
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *ContentMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldSizeBytes returns the old "size_bytes" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ContentMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.SizeBytes, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *CourseMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[course.FieldDescription] = struct{}{}
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *ContentMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *CourseMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[course.FieldDescription]
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *ContentMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (m *ContentMutation) ClearSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+	m.clearedFields[content.FieldSizeBytes] = struct{}{}
+}
+
+// SizeBytesCleared returns if the "size_bytes" field was cleared in this mutation.
+func (m *ContentMutation) SizeBytesCleared() bool {
+	_, ok := m.clearedFields[content.FieldSizeBytes]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *CourseMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, course.FieldDescription)
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *ContentMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+	delete(m.clearedFields, content.FieldSizeBytes)
 }
 
-// SetStatus sets the "status" field.
-func (m *CourseMutation) SetStatus(s string) {
-	m.status = &s
+// SetStorageKey sets the "storage_key" field.
+func (m *ContentMutation) SetStorageKey(s string) {
+	m.storage_key = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *CourseMutation) Status() (r string, exists bool) {
-	v := m.status
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *ContentMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageKey returns the old "storage_key" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ContentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.StorageKey, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *CourseMutation) ResetStatus() {
-	m.status = nil
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *ContentMutation) ResetStorageKey() {
+	m.storage_key = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *CourseMutation) SetVersion(i int) {
-	m.version = &i
-	m.addversion = nil
+// SetCurrentRevision sets the "current_revision" field.
+func (m *ContentMutation) SetCurrentRevision(i int) {
+	m.current_revision = &i
+	m.addcurrent_revision = nil
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *CourseMutation) Version() (r int, exists bool) {
-	v := m.version
+// CurrentRevision returns the value of the "current_revision" field in the mutation.
+func (m *ContentMutation) CurrentRevision() (r int, exists bool) {
+	v := m.current_revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrentRevision returns the old "current_revision" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldVersion(ctx context.Context) (v int, err error) {
+func (m *ContentMutation) OldCurrentRevision(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentRevision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldCurrentRevision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentRevision: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.CurrentRevision, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *CourseMutation) AddVersion(i int) {
-	if m.addversion != nil {
-		*m.addversion += i
+// AddCurrentRevision adds i to the "current_revision" field.
+func (m *ContentMutation) AddCurrentRevision(i int) {
+	if m.addcurrent_revision != nil {
+		*m.addcurrent_revision += i
 	} else {
-		m.addversion = &i
+		m.addcurrent_revision = &i
 	}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *CourseMutation) AddedVersion() (r int, exists bool) {
-	v := m.addversion
+// AddedCurrentRevision returns the value that was added to the "current_revision" field in this mutation.
+func (m *ContentMutation) AddedCurrentRevision() (r int, exists bool) {
+	v := m.addcurrent_revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *CourseMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetCurrentRevision resets all changes to the "current_revision" field.
+func (m *ContentMutation) ResetCurrentRevision() {
+	m.current_revision = nil
+	m.addcurrent_revision = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *CourseMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetEtag sets the "etag" field.
+func (m *ContentMutation) SetEtag(s string) {
+	m.etag = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *CourseMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// Etag returns the value of the "etag" field in the mutation.
+func (m *ContentMutation) Etag() (r string, exists bool) {
+	v := m.etag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldEtag returns the old "etag" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ContentMutation) OldEtag(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldEtag requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.Etag, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *CourseMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[course.FieldMetadata] = struct{}{}
+// ClearEtag clears the value of the "etag" field.
+func (m *ContentMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[content.FieldEtag] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *CourseMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[course.FieldMetadata]
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *ContentMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[content.FieldEtag]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *CourseMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, course.FieldMetadata)
+// ResetEtag resets all changes to the "etag" field.
+func (m *ContentMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, content.FieldEtag)
 }
 
-// SetPublishedAt sets the "published_at" field.
-func (m *CourseMutation) SetPublishedAt(t time.Time) {
-	m.published_at = &t
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (m *ContentMutation) SetChecksumSha256(s string) {
+	m.checksum_sha256 = &s
 }
 
-// PublishedAt returns the value of the "published_at" field in the mutation.
-func (m *CourseMutation) PublishedAt() (r time.Time, exists bool) {
-	v := m.published_at
+// ChecksumSha256 returns the value of the "checksum_sha256" field in the mutation.
+func (m *ContentMutation) ChecksumSha256() (r string, exists bool) {
+	v := m.checksum_sha256
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublishedAt returns the old "published_at" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksumSha256 returns the old "checksum_sha256" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ContentMutation) OldChecksumSha256(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksumSha256 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+		return v, errors.New("OldChecksumSha256 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksumSha256: %w", err)
 	}
-	return oldValue.PublishedAt, nil
+	return oldValue.ChecksumSha256, nil
 }
 
-// ClearPublishedAt clears the value of the "published_at" field.
-func (m *CourseMutation) ClearPublishedAt() {
-	m.published_at = nil
-	m.clearedFields[course.FieldPublishedAt] = struct{}{}
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (m *ContentMutation) ClearChecksumSha256() {
+	m.checksum_sha256 = nil
+	m.clearedFields[content.FieldChecksumSha256] = struct{}{}
 }
 
-// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
-func (m *CourseMutation) PublishedAtCleared() bool {
-	_, ok := m.clearedFields[course.FieldPublishedAt]
+// ChecksumSha256Cleared returns if the "checksum_sha256" field was cleared in this mutation.
+func (m *ContentMutation) ChecksumSha256Cleared() bool {
+	_, ok := m.clearedFields[content.FieldChecksumSha256]
 	return ok
 }
 
-// ResetPublishedAt resets all changes to the "published_at" field.
-func (m *CourseMutation) ResetPublishedAt() {
-	m.published_at = nil
-	delete(m.clearedFields, course.FieldPublishedAt)
+// ResetChecksumSha256 resets all changes to the "checksum_sha256" field.
+func (m *ContentMutation) ResetChecksumSha256() {
+	m.checksum_sha256 = nil
+	delete(m.clearedFields, content.FieldChecksumSha256)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CourseMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetStatus sets the "status" field.
+func (m *ContentMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CourseMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Status returns the value of the "status" field in the mutation.
+func (m *ContentMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContentMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CourseMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ContentMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CourseMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUploadID sets the "upload_id" field.
+func (m *ContentMutation) SetUploadID(s string) {
+	m.upload_id = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CourseMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UploadID returns the value of the "upload_id" field in the mutation.
+func (m *ContentMutation) UploadID() (r string, exists bool) {
+	v := m.upload_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Course entity.
-// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// OldUploadID returns the old "upload_id" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CourseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContentMutation) OldUploadID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploadID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUploadID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploadID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UploadID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CourseMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearUploadID clears the value of the "upload_id" field.
+func (m *ContentMutation) ClearUploadID() {
+	m.upload_id = nil
+	m.clearedFields[content.FieldUploadID] = struct{}{}
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *CourseMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[course.FieldOrganizationID] = struct{}{}
+// UploadIDCleared returns if the "upload_id" field was cleared in this mutation.
+func (m *ContentMutation) UploadIDCleared() bool {
+	_, ok := m.clearedFields[content.FieldUploadID]
+	return ok
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *CourseMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ResetUploadID resets all changes to the "upload_id" field.
+func (m *ContentMutation) ResetUploadID() {
+	m.upload_id = nil
+	delete(m.clearedFields, content.FieldUploadID)
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *CourseMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetUploadPartSize sets the "upload_part_size" field.
+func (m *ContentMutation) SetUploadPartSize(i int64) {
+	m.upload_part_size = &i
+	m.addupload_part_size = nil
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *CourseMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// UploadPartSize returns the value of the "upload_part_size" field in the mutation.
+func (m *ContentMutation) UploadPartSize() (r int64, exists bool) {
+	v := m.upload_part_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddModuleIDs adds the "modules" edge to the Module entity by ids.
-func (m *CourseMutation) AddModuleIDs(ids ...uuid.UUID) {
-	if m.modules == nil {
-		m.modules = make(map[uuid.UUID]struct{})
+// OldUploadPartSize returns the old "upload_part_size" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentMutation) OldUploadPartSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadPartSize is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.modules[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadPartSize requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadPartSize: %w", err)
+	}
+	return oldValue.UploadPartSize, nil
 }
 
-// ClearModules clears the "modules" edge to the Module entity.
-func (m *CourseMutation) ClearModules() {
-	m.clearedmodules = true
-}
-
-// ModulesCleared reports if the "modules" edge to the Module entity was cleared.
-func (m *CourseMutation) ModulesCleared() bool {
-	return m.clearedmodules
-}
-
-// RemoveModuleIDs removes the "modules" edge to the Module entity by IDs.
-func (m *CourseMutation) RemoveModuleIDs(ids ...uuid.UUID) {
-	if m.removedmodules == nil {
-		m.removedmodules = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.modules, ids[i])
-		m.removedmodules[ids[i]] = struct{}{}
+// AddUploadPartSize adds i to the "upload_part_size" field.
+func (m *ContentMutation) AddUploadPartSize(i int64) {
+	if m.addupload_part_size != nil {
+		*m.addupload_part_size += i
+	} else {
+		m.addupload_part_size = &i
 	}
 }
 
-// RemovedModules returns the removed IDs of the "modules" edge to the Module entity.
-func (m *CourseMutation) RemovedModulesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmodules {
-		ids = append(ids, id)
+// AddedUploadPartSize returns the value that was added to the "upload_part_size" field in this mutation.
+func (m *ContentMutation) AddedUploadPartSize() (r int64, exists bool) {
+	v := m.addupload_part_size
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ModulesIDs returns the "modules" edge IDs in the mutation.
-func (m *CourseMutation) ModulesIDs() (ids []uuid.UUID) {
-	for id := range m.modules {
-		ids = append(ids, id)
-	}
-	return
+// ClearUploadPartSize clears the value of the "upload_part_size" field.
+func (m *ContentMutation) ClearUploadPartSize() {
+	m.upload_part_size = nil
+	m.addupload_part_size = nil
+	m.clearedFields[content.FieldUploadPartSize] = struct{}{}
 }
 
-// ResetModules resets all changes to the "modules" edge.
-func (m *CourseMutation) ResetModules() {
-	m.modules = nil
-	m.clearedmodules = false
-	m.removedmodules = nil
+// UploadPartSizeCleared returns if the "upload_part_size" field was cleared in this mutation.
+func (m *ContentMutation) UploadPartSizeCleared() bool {
+	_, ok := m.clearedFields[content.FieldUploadPartSize]
+	return ok
 }
 
-// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
-func (m *CourseMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
-	if m.enrollments == nil {
-		m.enrollments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.enrollments[ids[i]] = struct{}{}
-	}
+// ResetUploadPartSize resets all changes to the "upload_part_size" field.
+func (m *ContentMutation) ResetUploadPartSize() {
+	m.upload_part_size = nil
+	m.addupload_part_size = nil
+	delete(m.clearedFields, content.FieldUploadPartSize)
 }
 
-// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
-func (m *CourseMutation) ClearEnrollments() {
-	m.clearedenrollments = true
+// SetUploadStartedAt sets the "upload_started_at" field.
+func (m *ContentMutation) SetUploadStartedAt(t time.Time) {
+	m.upload_started_at = &t
 }
 
-// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
-func (m *CourseMutation) EnrollmentsCleared() bool {
-	return m.clearedenrollments
+// UploadStartedAt returns the value of the "upload_started_at" field in the mutation.
+func (m *ContentMutation) UploadStartedAt() (r time.Time, exists bool) {
+	v := m.upload_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
-func (m *CourseMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
-	if m.removedenrollments == nil {
-		m.removedenrollments = make(map[uuid.UUID]struct{})
+// OldUploadStartedAt returns the old "upload_started_at" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentMutation) OldUploadStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadStartedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.enrollments, ids[i])
-		m.removedenrollments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadStartedAt: %w", err)
 	}
+	return oldValue.UploadStartedAt, nil
 }
 
-// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
-func (m *CourseMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedenrollments {
-		ids = append(ids, id)
-	}
-	return
+// ClearUploadStartedAt clears the value of the "upload_started_at" field.
+func (m *ContentMutation) ClearUploadStartedAt() {
+	m.upload_started_at = nil
+	m.clearedFields[content.FieldUploadStartedAt] = struct{}{}
 }
 
-// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
-func (m *CourseMutation) EnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.enrollments {
-		ids = append(ids, id)
+// UploadStartedAtCleared returns if the "upload_started_at" field was cleared in this mutation.
+func (m *ContentMutation) UploadStartedAtCleared() bool {
+	_, ok := m.clearedFields[content.FieldUploadStartedAt]
+	return ok
+}
+
+// ResetUploadStartedAt resets all changes to the "upload_started_at" field.
+func (m *ContentMutation) ResetUploadStartedAt() {
+	m.upload_started_at = nil
+	delete(m.clearedFields, content.FieldUploadStartedAt)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ContentMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ContentMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ContentMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[content.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ContentMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[content.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ContentMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, content.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ContentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ContentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ContentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ContentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ContentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Content entity.
+// If the Content object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ContentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *ContentMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[content.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *ContentMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *ContentMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEnrollments resets all changes to the "enrollments" edge.
-func (m *CourseMutation) ResetEnrollments() {
-	m.enrollments = nil
-	m.clearedenrollments = false
-	m.removedenrollments = nil
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *ContentMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *CourseMutation) AddGroupIDs(ids ...uuid.UUID) {
-	if m.groups == nil {
-		m.groups = make(map[uuid.UUID]struct{})
+// AddModuleIDs adds the "modules" edge to the Module entity by ids.
+func (m *ContentMutation) AddModuleIDs(ids ...uuid.UUID) {
+	if m.modules == nil {
+		m.modules = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
+		m.modules[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *CourseMutation) ClearGroups() {
-	m.clearedgroups = true
+// ClearModules clears the "modules" edge to the Module entity.
+func (m *ContentMutation) ClearModules() {
+	m.clearedmodules = true
 }
 
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *CourseMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// ModulesCleared reports if the "modules" edge to the Module entity was cleared.
+func (m *ContentMutation) ModulesCleared() bool {
+	return m.clearedmodules
 }
 
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *CourseMutation) RemoveGroupIDs(ids ...uuid.UUID) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[uuid.UUID]struct{})
+// RemoveModuleIDs removes the "modules" edge to the Module entity by IDs.
+func (m *ContentMutation) RemoveModuleIDs(ids ...uuid.UUID) {
+	if m.removedmodules == nil {
+		m.removedmodules = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+		delete(m.modules, ids[i])
+		m.removedmodules[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *CourseMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgroups {
+// RemovedModules returns the removed IDs of the "modules" edge to the Module entity.
+func (m *ContentMutation) RemovedModulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *CourseMutation) GroupsIDs() (ids []uuid.UUID) {
-	for id := range m.groups {
+// ModulesIDs returns the "modules" edge IDs in the mutation.
+func (m *ContentMutation) ModulesIDs() (ids []uuid.UUID) {
+	for id := range m.modules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *CourseMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// ResetModules resets all changes to the "modules" edge.
+func (m *ContentMutation) ResetModules() {
+	m.modules = nil
+	m.clearedmodules = false
+	m.removedmodules = nil
 }
 
-// Where appends a list predicates to the CourseMutation builder.
-func (m *CourseMutation) Where(ps ...predicate.Course) {
-	m.predicates = append(m.predicates, ps...)
+// AddRevisionIDs adds the "revisions" edge to the ContentRevision entity by ids.
+func (m *ContentMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the CourseMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CourseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Course, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearRevisions clears the "revisions" edge to the ContentRevision entity.
+func (m *ContentMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the ContentRevision entity was cleared.
+func (m *ContentMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the ContentRevision entity by IDs.
+func (m *ContentMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *CourseMutation) Op() Op {
-	return m.op
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the ContentRevision entity.
+func (m *ContentMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CourseMutation) SetOp(op Op) {
-	m.op = op
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *ContentMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (Course).
-func (m *CourseMutation) Type() string {
-	return m.typ
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *ContentMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CourseMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.organization != nil {
-		fields = append(fields, course.FieldOrganizationID)
+// AddDownloadIDs adds the "downloads" edge to the ContentDownload entity by ids.
+func (m *ContentMutation) AddDownloadIDs(ids ...uuid.UUID) {
+	if m.downloads == nil {
+		m.downloads = make(map[uuid.UUID]struct{})
 	}
-	if m.title != nil {
-		fields = append(fields, course.FieldTitle)
+	for i := range ids {
+		m.downloads[ids[i]] = struct{}{}
 	}
-	if m.slug != nil {
-		fields = append(fields, course.FieldSlug)
+}
+
+// ClearDownloads clears the "downloads" edge to the ContentDownload entity.
+func (m *ContentMutation) ClearDownloads() {
+	m.cleareddownloads = true
+}
+
+// DownloadsCleared reports if the "downloads" edge to the ContentDownload entity was cleared.
+func (m *ContentMutation) DownloadsCleared() bool {
+	return m.cleareddownloads
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to the ContentDownload entity by IDs.
+func (m *ContentMutation) RemoveDownloadIDs(ids ...uuid.UUID) {
+	if m.removeddownloads == nil {
+		m.removeddownloads = make(map[uuid.UUID]struct{})
 	}
-	if m.description != nil {
-		fields = append(fields, course.FieldDescription)
+	for i := range ids {
+		delete(m.downloads, ids[i])
+		m.removeddownloads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDownloads returns the removed IDs of the "downloads" edge to the ContentDownload entity.
+func (m *ContentMutation) RemovedDownloadsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddownloads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DownloadsIDs returns the "downloads" edge IDs in the mutation.
+func (m *ContentMutation) DownloadsIDs() (ids []uuid.UUID) {
+	for id := range m.downloads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDownloads resets all changes to the "downloads" edge.
+func (m *ContentMutation) ResetDownloads() {
+	m.downloads = nil
+	m.cleareddownloads = false
+	m.removeddownloads = nil
+}
+
+// Where appends a list predicates to the ContentMutation builder.
+func (m *ContentMutation) Where(ps ...predicate.Content) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ContentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ContentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Content, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ContentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ContentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Content).
+func (m *ContentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContentMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.organization != nil {
+		fields = append(fields, content.FieldOrganizationID)
+	}
+	if m.name != nil {
+		fields = append(fields, content.FieldName)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, content.FieldMimeType)
+	}
+	if m.size_bytes != nil {
+		fields = append(fields, content.FieldSizeBytes)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, content.FieldStorageKey)
+	}
+	if m.current_revision != nil {
+		fields = append(fields, content.FieldCurrentRevision)
+	}
+	if m.etag != nil {
+		fields = append(fields, content.FieldEtag)
+	}
+	if m.checksum_sha256 != nil {
+		fields = append(fields, content.FieldChecksumSha256)
 	}
 	if m.status != nil {
-		fields = append(fields, course.FieldStatus)
+		fields = append(fields, content.FieldStatus)
 	}
-	if m.version != nil {
-		fields = append(fields, course.FieldVersion)
+	if m.upload_id != nil {
+		fields = append(fields, content.FieldUploadID)
 	}
-	if m.metadata != nil {
-		fields = append(fields, course.FieldMetadata)
+	if m.upload_part_size != nil {
+		fields = append(fields, content.FieldUploadPartSize)
 	}
-	if m.published_at != nil {
-		fields = append(fields, course.FieldPublishedAt)
+	if m.upload_started_at != nil {
+		fields = append(fields, content.FieldUploadStartedAt)
+	}
+	if m.metadata != nil {
+		fields = append(fields, content.FieldMetadata)
 	}
 	if m.created_at != nil {
-		fields = append(fields, course.FieldCreatedAt)
+		fields = append(fields, content.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, course.FieldUpdatedAt)
+		fields = append(fields, content.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1839,27 +2070,37 @@ func (m *CourseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CourseMutation) Field(name string) (ent.Value, bool) {
+func (m *ContentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case course.FieldOrganizationID:
+	case content.FieldOrganizationID:
 		return m.OrganizationID()
-	case course.FieldTitle:
-		return m.Title()
-	case course.FieldSlug:
-		return m.Slug()
-	case course.FieldDescription:
-		return m.Description()
-	case course.FieldStatus:
+	case content.FieldName:
+		return m.Name()
+	case content.FieldMimeType:
+		return m.MimeType()
+	case content.FieldSizeBytes:
+		return m.SizeBytes()
+	case content.FieldStorageKey:
+		return m.StorageKey()
+	case content.FieldCurrentRevision:
+		return m.CurrentRevision()
+	case content.FieldEtag:
+		return m.Etag()
+	case content.FieldChecksumSha256:
+		return m.ChecksumSha256()
+	case content.FieldStatus:
 		return m.Status()
-	case course.FieldVersion:
-		return m.Version()
-	case course.FieldMetadata:
+	case content.FieldUploadID:
+		return m.UploadID()
+	case content.FieldUploadPartSize:
+		return m.UploadPartSize()
+	case content.FieldUploadStartedAt:
+		return m.UploadStartedAt()
+	case content.FieldMetadata:
 		return m.Metadata()
-	case course.FieldPublishedAt:
-		return m.PublishedAt()
-	case course.FieldCreatedAt:
+	case content.FieldCreatedAt:
 		return m.CreatedAt()
-	case course.FieldUpdatedAt:
+	case content.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1868,101 +2109,146 @@ func (m *CourseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CourseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case course.FieldOrganizationID:
+	case content.FieldOrganizationID:
 		return m.OldOrganizationID(ctx)
-	case course.FieldTitle:
-		return m.OldTitle(ctx)
-	case course.FieldSlug:
-		return m.OldSlug(ctx)
-	case course.FieldDescription:
-		return m.OldDescription(ctx)
-	case course.FieldStatus:
+	case content.FieldName:
+		return m.OldName(ctx)
+	case content.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case content.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case content.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case content.FieldCurrentRevision:
+		return m.OldCurrentRevision(ctx)
+	case content.FieldEtag:
+		return m.OldEtag(ctx)
+	case content.FieldChecksumSha256:
+		return m.OldChecksumSha256(ctx)
+	case content.FieldStatus:
 		return m.OldStatus(ctx)
-	case course.FieldVersion:
-		return m.OldVersion(ctx)
-	case course.FieldMetadata:
+	case content.FieldUploadID:
+		return m.OldUploadID(ctx)
+	case content.FieldUploadPartSize:
+		return m.OldUploadPartSize(ctx)
+	case content.FieldUploadStartedAt:
+		return m.OldUploadStartedAt(ctx)
+	case content.FieldMetadata:
 		return m.OldMetadata(ctx)
-	case course.FieldPublishedAt:
-		return m.OldPublishedAt(ctx)
-	case course.FieldCreatedAt:
+	case content.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case course.FieldUpdatedAt:
+	case content.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Course field %s", name)
+	return nil, fmt.Errorf("unknown Content field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CourseMutation) SetField(name string, value ent.Value) error {
+func (m *ContentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case course.FieldOrganizationID:
+	case content.FieldOrganizationID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOrganizationID(v)
 		return nil
-	case course.FieldTitle:
+	case content.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case course.FieldSlug:
+	case content.FieldMimeType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetMimeType(v)
 		return nil
-	case course.FieldDescription:
+	case content.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case content.FieldStorageKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStorageKey(v)
 		return nil
-	case course.FieldStatus:
+	case content.FieldCurrentRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentRevision(v)
+		return nil
+	case content.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case content.FieldChecksumSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumSha256(v)
+		return nil
+	case content.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case course.FieldVersion:
-		v, ok := value.(int)
+	case content.FieldUploadID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetUploadID(v)
 		return nil
-	case course.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case content.FieldUploadPartSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetUploadPartSize(v)
 		return nil
-	case course.FieldPublishedAt:
+	case content.FieldUploadStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublishedAt(v)
+		m.SetUploadStartedAt(v)
 		return nil
-	case course.FieldCreatedAt:
+	case content.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case content.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case course.FieldUpdatedAt:
+	case content.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1970,15 +2256,21 @@ func (m *CourseMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Course field %s", name)
+	return fmt.Errorf("unknown Content field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CourseMutation) AddedFields() []string {
+func (m *ContentMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, course.FieldVersion)
+	if m.addsize_bytes != nil {
+		fields = append(fields, content.FieldSizeBytes)
+	}
+	if m.addcurrent_revision != nil {
+		fields = append(fields, content.FieldCurrentRevision)
+	}
+	if m.addupload_part_size != nil {
+		fields = append(fields, content.FieldUploadPartSize)
 	}
 	return fields
 }
@@ -1986,10 +2278,14 @@ func (m *CourseMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CourseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ContentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case course.FieldVersion:
-		return m.AddedVersion()
+	case content.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	case content.FieldCurrentRevision:
+		return m.AddedCurrentRevision()
+	case content.FieldUploadPartSize:
+		return m.AddedUploadPartSize()
 	}
 	return nil, false
 }
@@ -1997,138 +2293,191 @@ func (m *CourseMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CourseMutation) AddField(name string, value ent.Value) error {
+func (m *ContentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case course.FieldVersion:
+	case content.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	case content.FieldCurrentRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddCurrentRevision(v)
+		return nil
+	case content.FieldUploadPartSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploadPartSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Course numeric field %s", name)
+	return fmt.Errorf("unknown Content numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CourseMutation) ClearedFields() []string {
+func (m *ContentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(course.FieldDescription) {
-		fields = append(fields, course.FieldDescription)
+	if m.FieldCleared(content.FieldSizeBytes) {
+		fields = append(fields, content.FieldSizeBytes)
 	}
-	if m.FieldCleared(course.FieldMetadata) {
-		fields = append(fields, course.FieldMetadata)
+	if m.FieldCleared(content.FieldEtag) {
+		fields = append(fields, content.FieldEtag)
 	}
-	if m.FieldCleared(course.FieldPublishedAt) {
-		fields = append(fields, course.FieldPublishedAt)
+	if m.FieldCleared(content.FieldChecksumSha256) {
+		fields = append(fields, content.FieldChecksumSha256)
+	}
+	if m.FieldCleared(content.FieldUploadID) {
+		fields = append(fields, content.FieldUploadID)
+	}
+	if m.FieldCleared(content.FieldUploadPartSize) {
+		fields = append(fields, content.FieldUploadPartSize)
+	}
+	if m.FieldCleared(content.FieldUploadStartedAt) {
+		fields = append(fields, content.FieldUploadStartedAt)
+	}
+	if m.FieldCleared(content.FieldMetadata) {
+		fields = append(fields, content.FieldMetadata)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CourseMutation) FieldCleared(name string) bool {
+func (m *ContentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CourseMutation) ClearField(name string) error {
+func (m *ContentMutation) ClearField(name string) error {
 	switch name {
-	case course.FieldDescription:
-		m.ClearDescription()
+	case content.FieldSizeBytes:
+		m.ClearSizeBytes()
 		return nil
-	case course.FieldMetadata:
-		m.ClearMetadata()
+	case content.FieldEtag:
+		m.ClearEtag()
 		return nil
-	case course.FieldPublishedAt:
-		m.ClearPublishedAt()
+	case content.FieldChecksumSha256:
+		m.ClearChecksumSha256()
+		return nil
+	case content.FieldUploadID:
+		m.ClearUploadID()
+		return nil
+	case content.FieldUploadPartSize:
+		m.ClearUploadPartSize()
+		return nil
+	case content.FieldUploadStartedAt:
+		m.ClearUploadStartedAt()
+		return nil
+	case content.FieldMetadata:
+		m.ClearMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown Course nullable field %s", name)
+	return fmt.Errorf("unknown Content nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CourseMutation) ResetField(name string) error {
+func (m *ContentMutation) ResetField(name string) error {
 	switch name {
-	case course.FieldOrganizationID:
+	case content.FieldOrganizationID:
 		m.ResetOrganizationID()
 		return nil
-	case course.FieldTitle:
-		m.ResetTitle()
+	case content.FieldName:
+		m.ResetName()
 		return nil
-	case course.FieldSlug:
-		m.ResetSlug()
+	case content.FieldMimeType:
+		m.ResetMimeType()
 		return nil
-	case course.FieldDescription:
-		m.ResetDescription()
+	case content.FieldSizeBytes:
+		m.ResetSizeBytes()
 		return nil
-	case course.FieldStatus:
+	case content.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case content.FieldCurrentRevision:
+		m.ResetCurrentRevision()
+		return nil
+	case content.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case content.FieldChecksumSha256:
+		m.ResetChecksumSha256()
+		return nil
+	case content.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case course.FieldVersion:
-		m.ResetVersion()
+	case content.FieldUploadID:
+		m.ResetUploadID()
 		return nil
-	case course.FieldMetadata:
-		m.ResetMetadata()
+	case content.FieldUploadPartSize:
+		m.ResetUploadPartSize()
 		return nil
-	case course.FieldPublishedAt:
-		m.ResetPublishedAt()
+	case content.FieldUploadStartedAt:
+		m.ResetUploadStartedAt()
 		return nil
-	case course.FieldCreatedAt:
+	case content.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case content.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case course.FieldUpdatedAt:
+	case content.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Course field %s", name)
+	return fmt.Errorf("unknown Content field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CourseMutation) AddedEdges() []string {
+func (m *ContentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 4)
 	if m.organization != nil {
-		edges = append(edges, course.EdgeOrganization)
+		edges = append(edges, content.EdgeOrganization)
 	}
 	if m.modules != nil {
-		edges = append(edges, course.EdgeModules)
+		edges = append(edges, content.EdgeModules)
 	}
-	if m.enrollments != nil {
-		edges = append(edges, course.EdgeEnrollments)
+	if m.revisions != nil {
+		edges = append(edges, content.EdgeRevisions)
 	}
-	if m.groups != nil {
-		edges = append(edges, course.EdgeGroups)
+	if m.downloads != nil {
+		edges = append(edges, content.EdgeDownloads)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CourseMutation) AddedIDs(name string) []ent.Value {
+func (m *ContentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case course.EdgeOrganization:
+	case content.EdgeOrganization:
 		if id := m.organization; id != nil {
 			return []ent.Value{*id}
 		}
-	case course.EdgeModules:
+	case content.EdgeModules:
 		ids := make([]ent.Value, 0, len(m.modules))
 		for id := range m.modules {
 			ids = append(ids, id)
 		}
 		return ids
-	case course.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.enrollments))
-		for id := range m.enrollments {
+	case content.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
 			ids = append(ids, id)
 		}
 		return ids
-	case course.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
+	case content.EdgeDownloads:
+		ids := make([]ent.Value, 0, len(m.downloads))
+		for id := range m.downloads {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2137,39 +2486,39 @@ func (m *CourseMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CourseMutation) RemovedEdges() []string {
+func (m *ContentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 4)
 	if m.removedmodules != nil {
-		edges = append(edges, course.EdgeModules)
+		edges = append(edges, content.EdgeModules)
 	}
-	if m.removedenrollments != nil {
-		edges = append(edges, course.EdgeEnrollments)
+	if m.removedrevisions != nil {
+		edges = append(edges, content.EdgeRevisions)
 	}
-	if m.removedgroups != nil {
-		edges = append(edges, course.EdgeGroups)
+	if m.removeddownloads != nil {
+		edges = append(edges, content.EdgeDownloads)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CourseMutation) RemovedIDs(name string) []ent.Value {
+func (m *ContentMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case course.EdgeModules:
+	case content.EdgeModules:
 		ids := make([]ent.Value, 0, len(m.removedmodules))
 		for id := range m.removedmodules {
 			ids = append(ids, id)
 		}
 		return ids
-	case course.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.removedenrollments))
-		for id := range m.removedenrollments {
+	case content.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
 			ids = append(ids, id)
 		}
 		return ids
-	case course.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
+	case content.EdgeDownloads:
+		ids := make([]ent.Value, 0, len(m.removeddownloads))
+		for id := range m.removeddownloads {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2178,112 +2527,101 @@ func (m *CourseMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CourseMutation) ClearedEdges() []string {
+func (m *ContentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 4)
 	if m.clearedorganization {
-		edges = append(edges, course.EdgeOrganization)
+		edges = append(edges, content.EdgeOrganization)
 	}
 	if m.clearedmodules {
-		edges = append(edges, course.EdgeModules)
+		edges = append(edges, content.EdgeModules)
 	}
-	if m.clearedenrollments {
-		edges = append(edges, course.EdgeEnrollments)
+	if m.clearedrevisions {
+		edges = append(edges, content.EdgeRevisions)
 	}
-	if m.clearedgroups {
-		edges = append(edges, course.EdgeGroups)
+	if m.cleareddownloads {
+		edges = append(edges, content.EdgeDownloads)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CourseMutation) EdgeCleared(name string) bool {
+func (m *ContentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case course.EdgeOrganization:
+	case content.EdgeOrganization:
 		return m.clearedorganization
-	case course.EdgeModules:
+	case content.EdgeModules:
 		return m.clearedmodules
-	case course.EdgeEnrollments:
-		return m.clearedenrollments
-	case course.EdgeGroups:
-		return m.clearedgroups
+	case content.EdgeRevisions:
+		return m.clearedrevisions
+	case content.EdgeDownloads:
+		return m.cleareddownloads
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CourseMutation) ClearEdge(name string) error {
+func (m *ContentMutation) ClearEdge(name string) error {
 	switch name {
-	case course.EdgeOrganization:
+	case content.EdgeOrganization:
 		m.ClearOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Course unique edge %s", name)
+	return fmt.Errorf("unknown Content unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CourseMutation) ResetEdge(name string) error {
+func (m *ContentMutation) ResetEdge(name string) error {
 	switch name {
-	case course.EdgeOrganization:
+	case content.EdgeOrganization:
 		m.ResetOrganization()
 		return nil
-	case course.EdgeModules:
+	case content.EdgeModules:
 		m.ResetModules()
 		return nil
-	case course.EdgeEnrollments:
-		m.ResetEnrollments()
+	case content.EdgeRevisions:
+		m.ResetRevisions()
 		return nil
-	case course.EdgeGroups:
-		m.ResetGroups()
+	case content.EdgeDownloads:
+		m.ResetDownloads()
 		return nil
 	}
-	return fmt.Errorf("unknown Course edge %s", name)
+	return fmt.Errorf("unknown Content edge %s", name)
 }
 
-// EnrollmentMutation represents an operation that mutates the Enrollment nodes in the graph.
-type EnrollmentMutation struct {
+// ContentDownloadMutation represents an operation that mutates the ContentDownload nodes in the graph.
+type ContentDownloadMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	status                  *string
-	progress                *float32
-	addprogress             *float32
-	started_at              *time.Time
-	completed_at            *time.Time
-	metadata                *map[string]interface{}
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	organization            *uuid.UUID
-	clearedorganization     bool
-	course                  *uuid.UUID
-	clearedcourse           bool
-	user                    *uuid.UUID
-	cleareduser             bool
-	group                   *uuid.UUID
-	clearedgroup            bool
-	progress_entries        map[uuid.UUID]struct{}
-	removedprogress_entries map[uuid.UUID]struct{}
-	clearedprogress_entries bool
-	done                    bool
-	oldValue                func(context.Context) (*Enrollment, error)
-	predicates              []predicate.Enrollment
-}
-
-var _ ent.Mutation = (*EnrollmentMutation)(nil)
-
-// enrollmentOption allows management of the mutation configuration using functional options.
-type enrollmentOption func(*EnrollmentMutation)
-
-// newEnrollmentMutation creates new mutation for the Enrollment entity.
-func newEnrollmentMutation(c config, op Op, opts ...enrollmentOption) *EnrollmentMutation {
-	m := &EnrollmentMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	organization_id *uuid.UUID
+	revision        *int
+	addrevision     *int
+	user_id         *uuid.UUID
+	module_id       *uuid.UUID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	content         *uuid.UUID
+	clearedcontent  bool
+	done            bool
+	oldValue        func(context.Context) (*ContentDownload, error)
+	predicates      []predicate.ContentDownload
+}
+
+var _ ent.Mutation = (*ContentDownloadMutation)(nil)
+
+// contentdownloadOption allows management of the mutation configuration using functional options.
+type contentdownloadOption func(*ContentDownloadMutation)
+
+// newContentDownloadMutation creates new mutation for the ContentDownload entity.
+func newContentDownloadMutation(c config, op Op, opts ...contentdownloadOption) *ContentDownloadMutation {
+	m := &ContentDownloadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnrollment,
+		typ:           TypeContentDownload,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2292,20 +2630,20 @@ func newEnrollmentMutation(c config, op Op, opts ...enrollmentOption) *Enrollmen
 	return m
 }
 
-// withEnrollmentID sets the ID field of the mutation.
-func withEnrollmentID(id uuid.UUID) enrollmentOption {
-	return func(m *EnrollmentMutation) {
+// withContentDownloadID sets the ID field of the mutation.
+func withContentDownloadID(id uuid.UUID) contentdownloadOption {
+	return func(m *ContentDownloadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Enrollment
+			value *ContentDownload
 		)
-		m.oldValue = func(ctx context.Context) (*Enrollment, error) {
+		m.oldValue = func(ctx context.Context) (*ContentDownload, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Enrollment.Get(ctx, id)
+					value, err = m.Client().ContentDownload.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2314,10 +2652,10 @@ func withEnrollmentID(id uuid.UUID) enrollmentOption {
 	}
 }
 
-// withEnrollment sets the old Enrollment of the mutation.
-func withEnrollment(node *Enrollment) enrollmentOption {
-	return func(m *EnrollmentMutation) {
-		m.oldValue = func(context.Context) (*Enrollment, error) {
+// withContentDownload sets the old ContentDownload of the mutation.
+func withContentDownload(node *ContentDownload) contentdownloadOption {
+	return func(m *ContentDownloadMutation) {
+		m.oldValue = func(context.Context) (*ContentDownload, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2326,7 +2664,7 @@ func withEnrollment(node *Enrollment) enrollmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnrollmentMutation) Client() *Client {
+func (m ContentDownloadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2334,7 +2672,7 @@ func (m EnrollmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnrollmentMutation) Tx() (*Tx, error) {
+func (m ContentDownloadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2344,14 +2682,14 @@ func (m EnrollmentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Enrollment entities.
-func (m *EnrollmentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ContentDownload entities.
+func (m *ContentDownloadMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnrollmentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ContentDownloadMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2362,7 +2700,7 @@ func (m *EnrollmentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnrollmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ContentDownloadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2371,30 +2709,30 @@ func (m *EnrollmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Enrollment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContentDownload.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetOrganizationID sets the "organization_id" field.
-func (m *EnrollmentMutation) SetOrganizationID(u uuid.UUID) {
-	m.organization = &u
+func (m *ContentDownloadMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
 }
 
 // OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *EnrollmentMutation) OrganizationID() (r uuid.UUID, exists bool) {
-	v := m.organization
+func (m *ContentDownloadMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentDownloadMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
@@ -2409,4328 +2747,4208 @@ func (m *EnrollmentMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID
 }
 
 // ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *EnrollmentMutation) ResetOrganizationID() {
-	m.organization = nil
+func (m *ContentDownloadMutation) ResetOrganizationID() {
+	m.organization_id = nil
 }
 
-// SetCourseID sets the "course_id" field.
-func (m *EnrollmentMutation) SetCourseID(u uuid.UUID) {
-	m.course = &u
+// SetContentID sets the "content_id" field.
+func (m *ContentDownloadMutation) SetContentID(u uuid.UUID) {
+	m.content = &u
 }
 
-// CourseID returns the value of the "course_id" field in the mutation.
-func (m *EnrollmentMutation) CourseID() (r uuid.UUID, exists bool) {
-	v := m.course
+// ContentID returns the value of the "content_id" field in the mutation.
+func (m *ContentDownloadMutation) ContentID() (r uuid.UUID, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCourseID returns the old "course_id" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldContentID returns the old "content_id" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldCourseID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentDownloadMutation) OldContentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCourseID requires an ID field in the mutation")
+		return v, errors.New("OldContentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentID: %w", err)
 	}
-	return oldValue.CourseID, nil
+	return oldValue.ContentID, nil
 }
 
-// ResetCourseID resets all changes to the "course_id" field.
-func (m *EnrollmentMutation) ResetCourseID() {
-	m.course = nil
+// ResetContentID resets all changes to the "content_id" field.
+func (m *ContentDownloadMutation) ResetContentID() {
+	m.content = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *EnrollmentMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetRevision sets the "revision" field.
+func (m *ContentDownloadMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *EnrollmentMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ContentDownloadMutation) Revision() (r int, exists bool) {
+	v := m.revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldRevision returns the old "revision" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentDownloadMutation) OldRevision(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldRevision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Revision, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *EnrollmentMutation) ResetUserID() {
-	m.user = nil
+// AddRevision adds i to the "revision" field.
+func (m *ContentDownloadMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *EnrollmentMutation) SetGroupID(u uuid.UUID) {
-	m.group = &u
-}
-
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *EnrollmentMutation) GroupID() (r uuid.UUID, exists bool) {
-	v := m.group
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ContentDownloadMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
-	}
-	return oldValue.GroupID, nil
-}
-
-// ClearGroupID clears the value of the "group_id" field.
-func (m *EnrollmentMutation) ClearGroupID() {
-	m.group = nil
-	m.clearedFields[enrollment.FieldGroupID] = struct{}{}
-}
-
-// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
-func (m *EnrollmentMutation) GroupIDCleared() bool {
-	_, ok := m.clearedFields[enrollment.FieldGroupID]
-	return ok
-}
-
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *EnrollmentMutation) ResetGroupID() {
-	m.group = nil
-	delete(m.clearedFields, enrollment.FieldGroupID)
+// ResetRevision resets all changes to the "revision" field.
+func (m *ContentDownloadMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *EnrollmentMutation) SetStatus(s string) {
-	m.status = &s
+// SetUserID sets the "user_id" field.
+func (m *ContentDownloadMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EnrollmentMutation) Status() (r string, exists bool) {
-	v := m.status
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ContentDownloadMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ContentDownloadMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UserID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EnrollmentMutation) ResetStatus() {
-	m.status = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *ContentDownloadMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[contentdownload.FieldUserID] = struct{}{}
 }
 
-// SetProgress sets the "progress" field.
-func (m *EnrollmentMutation) SetProgress(f float32) {
-	m.progress = &f
-	m.addprogress = nil
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ContentDownloadMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[contentdownload.FieldUserID]
+	return ok
 }
 
-// Progress returns the value of the "progress" field in the mutation.
-func (m *EnrollmentMutation) Progress() (r float32, exists bool) {
-	v := m.progress
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ContentDownloadMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, contentdownload.FieldUserID)
+}
+
+// SetModuleID sets the "module_id" field.
+func (m *ContentDownloadMutation) SetModuleID(u uuid.UUID) {
+	m.module_id = &u
+}
+
+// ModuleID returns the value of the "module_id" field in the mutation.
+func (m *ContentDownloadMutation) ModuleID() (r uuid.UUID, exists bool) {
+	v := m.module_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProgress returns the old "progress" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldModuleID returns the old "module_id" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldProgress(ctx context.Context) (v float32, err error) {
+func (m *ContentDownloadMutation) OldModuleID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+		return v, errors.New("OldModuleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProgress requires an ID field in the mutation")
+		return v, errors.New("OldModuleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+		return v, fmt.Errorf("querying old value for OldModuleID: %w", err)
 	}
-	return oldValue.Progress, nil
+	return oldValue.ModuleID, nil
 }
 
-// AddProgress adds f to the "progress" field.
-func (m *EnrollmentMutation) AddProgress(f float32) {
-	if m.addprogress != nil {
-		*m.addprogress += f
-	} else {
-		m.addprogress = &f
-	}
+// ClearModuleID clears the value of the "module_id" field.
+func (m *ContentDownloadMutation) ClearModuleID() {
+	m.module_id = nil
+	m.clearedFields[contentdownload.FieldModuleID] = struct{}{}
 }
 
-// AddedProgress returns the value that was added to the "progress" field in this mutation.
-func (m *EnrollmentMutation) AddedProgress() (r float32, exists bool) {
-	v := m.addprogress
-	if v == nil {
-		return
-	}
-	return *v, true
+// ModuleIDCleared returns if the "module_id" field was cleared in this mutation.
+func (m *ContentDownloadMutation) ModuleIDCleared() bool {
+	_, ok := m.clearedFields[contentdownload.FieldModuleID]
+	return ok
 }
 
-// ResetProgress resets all changes to the "progress" field.
-func (m *EnrollmentMutation) ResetProgress() {
-	m.progress = nil
-	m.addprogress = nil
+// ResetModuleID resets all changes to the "module_id" field.
+func (m *ContentDownloadMutation) ResetModuleID() {
+	m.module_id = nil
+	delete(m.clearedFields, contentdownload.FieldModuleID)
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *EnrollmentMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *ContentDownloadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *EnrollmentMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ContentDownloadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ContentDownload entity.
+// If the ContentDownload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ContentDownloadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.StartedAt, nil
-}
-
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *EnrollmentMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[enrollment.FieldStartedAt] = struct{}{}
+	return oldValue.CreatedAt, nil
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *EnrollmentMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[enrollment.FieldStartedAt]
-	return ok
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ContentDownloadMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *EnrollmentMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, enrollment.FieldStartedAt)
+// ClearContent clears the "content" edge to the Content entity.
+func (m *ContentDownloadMutation) ClearContent() {
+	m.clearedcontent = true
+	m.clearedFields[contentdownload.FieldContentID] = struct{}{}
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *EnrollmentMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// ContentCleared reports if the "content" edge to the Content entity was cleared.
+func (m *ContentDownloadMutation) ContentCleared() bool {
+	return m.clearedcontent
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *EnrollmentMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
+// ContentIDs returns the "content" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentID instead. It exists only for internal usage by the builders.
+func (m *ContentDownloadMutation) ContentIDs() (ids []uuid.UUID) {
+	if id := m.content; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
-	}
-	return oldValue.CompletedAt, nil
+// ResetContent resets all changes to the "content" edge.
+func (m *ContentDownloadMutation) ResetContent() {
+	m.content = nil
+	m.clearedcontent = false
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *EnrollmentMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[enrollment.FieldCompletedAt] = struct{}{}
+// Where appends a list predicates to the ContentDownloadMutation builder.
+func (m *ContentDownloadMutation) Where(ps ...predicate.ContentDownload) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *EnrollmentMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[enrollment.FieldCompletedAt]
-	return ok
+// WhereP appends storage-level predicates to the ContentDownloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ContentDownloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContentDownload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *EnrollmentMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, enrollment.FieldCompletedAt)
+// Op returns the operation name.
+func (m *ContentDownloadMutation) Op() Op {
+	return m.op
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *EnrollmentMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetOp allows setting the mutation operation.
+func (m *ContentDownloadMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *EnrollmentMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ContentDownload).
+func (m *ContentDownloadMutation) Type() string {
+	return m.typ
 }
 
-// OldMetadata returns the old "metadata" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContentDownloadMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.organization_id != nil {
+		fields = append(fields, contentdownload.FieldOrganizationID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	if m.content != nil {
+		fields = append(fields, contentdownload.FieldContentID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	if m.revision != nil {
+		fields = append(fields, contentdownload.FieldRevision)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *EnrollmentMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[enrollment.FieldMetadata] = struct{}{}
+	if m.user_id != nil {
+		fields = append(fields, contentdownload.FieldUserID)
+	}
+	if m.module_id != nil {
+		fields = append(fields, contentdownload.FieldModuleID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, contentdownload.FieldCreatedAt)
+	}
+	return fields
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *EnrollmentMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[enrollment.FieldMetadata]
-	return ok
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContentDownloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contentdownload.FieldOrganizationID:
+		return m.OrganizationID()
+	case contentdownload.FieldContentID:
+		return m.ContentID()
+	case contentdownload.FieldRevision:
+		return m.Revision()
+	case contentdownload.FieldUserID:
+		return m.UserID()
+	case contentdownload.FieldModuleID:
+		return m.ModuleID()
+	case contentdownload.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *EnrollmentMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, enrollment.FieldMetadata)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *EnrollmentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnrollmentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContentDownloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contentdownload.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case contentdownload.FieldContentID:
+		return m.OldContentID(ctx)
+	case contentdownload.FieldRevision:
+		return m.OldRevision(ctx)
+	case contentdownload.FieldUserID:
+		return m.OldUserID(ctx)
+	case contentdownload.FieldModuleID:
+		return m.OldModuleID(ctx)
+	case contentdownload.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ContentDownload field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentDownloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contentdownload.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case contentdownload.FieldContentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentID(v)
+		return nil
+	case contentdownload.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case contentdownload.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case contentdownload.FieldModuleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModuleID(v)
+		return nil
+	case contentdownload.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown ContentDownload field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnrollmentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContentDownloadMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, contentdownload.FieldRevision)
+	}
+	return fields
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnrollmentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContentDownloadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contentdownload.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnrollmentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentDownloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contentdownload.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ContentDownload numeric field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Enrollment entity.
-// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrollmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContentDownloadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contentdownload.FieldUserID) {
+		fields = append(fields, contentdownload.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.FieldCleared(contentdownload.FieldModuleID) {
+		fields = append(fields, contentdownload.FieldModuleID)
 	}
-	return oldValue.UpdatedAt, nil
+	return fields
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnrollmentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContentDownloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *EnrollmentMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[enrollment.FieldOrganizationID] = struct{}{}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContentDownloadMutation) ClearField(name string) error {
+	switch name {
+	case contentdownload.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case contentdownload.FieldModuleID:
+		m.ClearModuleID()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentDownload nullable field %s", name)
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *EnrollmentMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContentDownloadMutation) ResetField(name string) error {
+	switch name {
+	case contentdownload.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case contentdownload.FieldContentID:
+		m.ResetContentID()
+		return nil
+	case contentdownload.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case contentdownload.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case contentdownload.FieldModuleID:
+		m.ResetModuleID()
+		return nil
+	case contentdownload.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentDownload field %s", name)
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *EnrollmentMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContentDownloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.content != nil {
+		edges = append(edges, contentdownload.EdgeContent)
 	}
-	return
+	return edges
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *EnrollmentMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContentDownloadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contentdownload.EdgeContent:
+		if id := m.content; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ClearCourse clears the "course" edge to the Course entity.
-func (m *EnrollmentMutation) ClearCourse() {
-	m.clearedcourse = true
-	m.clearedFields[enrollment.FieldCourseID] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContentDownloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// CourseCleared reports if the "course" edge to the Course entity was cleared.
-func (m *EnrollmentMutation) CourseCleared() bool {
-	return m.clearedcourse
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContentDownloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// CourseIDs returns the "course" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CourseID instead. It exists only for internal usage by the builders.
-func (m *EnrollmentMutation) CourseIDs() (ids []uuid.UUID) {
-	if id := m.course; id != nil {
-		ids = append(ids, *id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContentDownloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcontent {
+		edges = append(edges, contentdownload.EdgeContent)
 	}
-	return
+	return edges
 }
 
-// ResetCourse resets all changes to the "course" edge.
-func (m *EnrollmentMutation) ResetCourse() {
-	m.course = nil
-	m.clearedcourse = false
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContentDownloadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contentdownload.EdgeContent:
+		return m.clearedcontent
+	}
+	return false
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *EnrollmentMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[enrollment.FieldUserID] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContentDownloadMutation) ClearEdge(name string) error {
+	switch name {
+	case contentdownload.EdgeContent:
+		m.ClearContent()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentDownload unique edge %s", name)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *EnrollmentMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContentDownloadMutation) ResetEdge(name string) error {
+	switch name {
+	case contentdownload.EdgeContent:
+		m.ResetContent()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentDownload edge %s", name)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *EnrollmentMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ContentRevisionMutation represents an operation that mutates the ContentRevision nodes in the graph.
+type ContentRevisionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	organization_id *uuid.UUID
+	number          *int
+	addnumber       *int
+	storage_key     *string
+	mime_type       *string
+	size_bytes      *int64
+	addsize_bytes   *int64
+	etag            *string
+	checksum_sha256 *string
+	status          *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	content         *uuid.UUID
+	clearedcontent  bool
+	done            bool
+	oldValue        func(context.Context) (*ContentRevision, error)
+	predicates      []predicate.ContentRevision
+}
+
+var _ ent.Mutation = (*ContentRevisionMutation)(nil)
+
+// contentrevisionOption allows management of the mutation configuration using functional options.
+type contentrevisionOption func(*ContentRevisionMutation)
+
+// newContentRevisionMutation creates new mutation for the ContentRevision entity.
+func newContentRevisionMutation(c config, op Op, opts ...contentrevisionOption) *ContentRevisionMutation {
+	m := &ContentRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContentRevision,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *EnrollmentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
+// withContentRevisionID sets the ID field of the mutation.
+func withContentRevisionID(id uuid.UUID) contentrevisionOption {
+	return func(m *ContentRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ContentRevision
+		)
+		m.oldValue = func(ctx context.Context) (*ContentRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ContentRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *EnrollmentMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[enrollment.FieldGroupID] = struct{}{}
+// withContentRevision sets the old ContentRevision of the mutation.
+func withContentRevision(node *ContentRevision) contentrevisionOption {
+	return func(m *ContentRevisionMutation) {
+		m.oldValue = func(context.Context) (*ContentRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *EnrollmentMutation) GroupCleared() bool {
-	return m.GroupIDCleared() || m.clearedgroup
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContentRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *EnrollmentMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContentRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *EnrollmentMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ContentRevision entities.
+func (m *ContentRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddProgressEntryIDs adds the "progress_entries" edge to the ModuleProgress entity by ids.
-func (m *EnrollmentMutation) AddProgressEntryIDs(ids ...uuid.UUID) {
-	if m.progress_entries == nil {
-		m.progress_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.progress_entries[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ContentRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// ClearProgressEntries clears the "progress_entries" edge to the ModuleProgress entity.
-func (m *EnrollmentMutation) ClearProgressEntries() {
-	m.clearedprogress_entries = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ContentRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ContentRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// ProgressEntriesCleared reports if the "progress_entries" edge to the ModuleProgress entity was cleared.
-func (m *EnrollmentMutation) ProgressEntriesCleared() bool {
-	return m.clearedprogress_entries
+// SetOrganizationID sets the "organization_id" field.
+func (m *ContentRevisionMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
 }
 
-// RemoveProgressEntryIDs removes the "progress_entries" edge to the ModuleProgress entity by IDs.
-func (m *EnrollmentMutation) RemoveProgressEntryIDs(ids ...uuid.UUID) {
-	if m.removedprogress_entries == nil {
-		m.removedprogress_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.progress_entries, ids[i])
-		m.removedprogress_entries[ids[i]] = struct{}{}
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *ContentRevisionMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedProgressEntries returns the removed IDs of the "progress_entries" edge to the ModuleProgress entity.
-func (m *EnrollmentMutation) RemovedProgressEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedprogress_entries {
-		ids = append(ids, id)
+// OldOrganizationID returns the old "organization_id" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ProgressEntriesIDs returns the "progress_entries" edge IDs in the mutation.
-func (m *EnrollmentMutation) ProgressEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.progress_entries {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
 }
 
-// ResetProgressEntries resets all changes to the "progress_entries" edge.
-func (m *EnrollmentMutation) ResetProgressEntries() {
-	m.progress_entries = nil
-	m.clearedprogress_entries = false
-	m.removedprogress_entries = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *ContentRevisionMutation) ResetOrganizationID() {
+	m.organization_id = nil
 }
 
-// Where appends a list predicates to the EnrollmentMutation builder.
-func (m *EnrollmentMutation) Where(ps ...predicate.Enrollment) {
-	m.predicates = append(m.predicates, ps...)
+// SetContentID sets the "content_id" field.
+func (m *ContentRevisionMutation) SetContentID(u uuid.UUID) {
+	m.content = &u
 }
 
-// WhereP appends storage-level predicates to the EnrollmentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnrollmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Enrollment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ContentID returns the value of the "content_id" field in the mutation.
+func (m *ContentRevisionMutation) ContentID() (r uuid.UUID, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *EnrollmentMutation) Op() Op {
-	return m.op
+// OldContentID returns the old "content_id" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldContentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentID: %w", err)
+	}
+	return oldValue.ContentID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EnrollmentMutation) SetOp(op Op) {
-	m.op = op
+// ResetContentID resets all changes to the "content_id" field.
+func (m *ContentRevisionMutation) ResetContentID() {
+	m.content = nil
 }
 
-// Type returns the node type of this mutation (Enrollment).
-func (m *EnrollmentMutation) Type() string {
-	return m.typ
+// SetNumber sets the "number" field.
+func (m *ContentRevisionMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EnrollmentMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.organization != nil {
-		fields = append(fields, enrollment.FieldOrganizationID)
+// Number returns the value of the "number" field in the mutation.
+func (m *ContentRevisionMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
 	}
-	if m.course != nil {
-		fields = append(fields, enrollment.FieldCourseID)
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
 	}
-	if m.user != nil {
-		fields = append(fields, enrollment.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
 	}
-	if m.group != nil {
-		fields = append(fields, enrollment.FieldGroupID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, enrollment.FieldStatus)
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *ContentRevisionMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
 	}
-	if m.progress != nil {
-		fields = append(fields, enrollment.FieldProgress)
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *ContentRevisionMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
 	}
-	if m.started_at != nil {
-		fields = append(fields, enrollment.FieldStartedAt)
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *ContentRevisionMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *ContentRevisionMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *ContentRevisionMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
 	}
-	if m.completed_at != nil {
-		fields = append(fields, enrollment.FieldCompletedAt)
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
-	if m.metadata != nil {
-		fields = append(fields, enrollment.FieldMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, enrollment.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, enrollment.FieldUpdatedAt)
+	return oldValue.StorageKey, nil
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *ContentRevisionMutation) ResetStorageKey() {
+	m.storage_key = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *ContentRevisionMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *ContentRevisionMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EnrollmentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case enrollment.FieldOrganizationID:
-		return m.OrganizationID()
-	case enrollment.FieldCourseID:
-		return m.CourseID()
-	case enrollment.FieldUserID:
-		return m.UserID()
-	case enrollment.FieldGroupID:
-		return m.GroupID()
-	case enrollment.FieldStatus:
-		return m.Status()
-	case enrollment.FieldProgress:
-		return m.Progress()
-	case enrollment.FieldStartedAt:
-		return m.StartedAt()
-	case enrollment.FieldCompletedAt:
-		return m.CompletedAt()
-	case enrollment.FieldMetadata:
-		return m.Metadata()
-	case enrollment.FieldCreatedAt:
-		return m.CreatedAt()
-	case enrollment.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldMimeType returns the old "mime_type" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EnrollmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case enrollment.FieldOrganizationID:
-		return m.OldOrganizationID(ctx)
-	case enrollment.FieldCourseID:
-		return m.OldCourseID(ctx)
-	case enrollment.FieldUserID:
-		return m.OldUserID(ctx)
-	case enrollment.FieldGroupID:
-		return m.OldGroupID(ctx)
-	case enrollment.FieldStatus:
-		return m.OldStatus(ctx)
-	case enrollment.FieldProgress:
-		return m.OldProgress(ctx)
-	case enrollment.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case enrollment.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case enrollment.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case enrollment.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case enrollment.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Enrollment field %s", name)
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *ContentRevisionMutation) ResetMimeType() {
+	m.mime_type = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnrollmentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case enrollment.FieldOrganizationID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrganizationID(v)
-		return nil
-	case enrollment.FieldCourseID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCourseID(v)
-		return nil
-	case enrollment.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case enrollment.FieldGroupID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupID(v)
-		return nil
-	case enrollment.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case enrollment.FieldProgress:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProgress(v)
-		return nil
-	case enrollment.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case enrollment.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case enrollment.FieldMetadata:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case enrollment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case enrollment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// SetSizeBytes sets the "size_bytes" field.
+func (m *ContentRevisionMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
+}
+
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *ContentRevisionMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Enrollment field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EnrollmentMutation) AddedFields() []string {
-	var fields []string
-	if m.addprogress != nil {
-		fields = append(fields, enrollment.FieldProgress)
+// OldSizeBytes returns the old "size_bytes" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+	}
+	return oldValue.SizeBytes, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EnrollmentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case enrollment.FieldProgress:
-		return m.AddedProgress()
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *ContentRevisionMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnrollmentMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case enrollment.FieldProgress:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProgress(v)
-		return nil
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *ContentRevisionMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Enrollment numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EnrollmentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(enrollment.FieldGroupID) {
-		fields = append(fields, enrollment.FieldGroupID)
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (m *ContentRevisionMutation) ClearSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+	m.clearedFields[contentrevision.FieldSizeBytes] = struct{}{}
+}
+
+// SizeBytesCleared returns if the "size_bytes" field was cleared in this mutation.
+func (m *ContentRevisionMutation) SizeBytesCleared() bool {
+	_, ok := m.clearedFields[contentrevision.FieldSizeBytes]
+	return ok
+}
+
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *ContentRevisionMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+	delete(m.clearedFields, contentrevision.FieldSizeBytes)
+}
+
+// SetEtag sets the "etag" field.
+func (m *ContentRevisionMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *ContentRevisionMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(enrollment.FieldStartedAt) {
-		fields = append(fields, enrollment.FieldStartedAt)
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(enrollment.FieldCompletedAt) {
-		fields = append(fields, enrollment.FieldCompletedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
 	}
-	if m.FieldCleared(enrollment.FieldMetadata) {
-		fields = append(fields, enrollment.FieldMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
 	}
-	return fields
+	return oldValue.Etag, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EnrollmentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearEtag clears the value of the "etag" field.
+func (m *ContentRevisionMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[contentrevision.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *ContentRevisionMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[contentrevision.FieldEtag]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EnrollmentMutation) ClearField(name string) error {
-	switch name {
-	case enrollment.FieldGroupID:
-		m.ClearGroupID()
-		return nil
-	case enrollment.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case enrollment.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case enrollment.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Enrollment nullable field %s", name)
+// ResetEtag resets all changes to the "etag" field.
+func (m *ContentRevisionMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, contentrevision.FieldEtag)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EnrollmentMutation) ResetField(name string) error {
-	switch name {
-	case enrollment.FieldOrganizationID:
-		m.ResetOrganizationID()
-		return nil
-	case enrollment.FieldCourseID:
-		m.ResetCourseID()
-		return nil
-	case enrollment.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case enrollment.FieldGroupID:
-		m.ResetGroupID()
-		return nil
-	case enrollment.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case enrollment.FieldProgress:
-		m.ResetProgress()
-		return nil
-	case enrollment.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case enrollment.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case enrollment.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case enrollment.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case enrollment.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Enrollment field %s", name)
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (m *ContentRevisionMutation) SetChecksumSha256(s string) {
+	m.checksum_sha256 = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnrollmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.organization != nil {
-		edges = append(edges, enrollment.EdgeOrganization)
-	}
-	if m.course != nil {
-		edges = append(edges, enrollment.EdgeCourse)
+// ChecksumSha256 returns the value of the "checksum_sha256" field in the mutation.
+func (m *ContentRevisionMutation) ChecksumSha256() (r string, exists bool) {
+	v := m.checksum_sha256
+	if v == nil {
+		return
 	}
-	if m.user != nil {
-		edges = append(edges, enrollment.EdgeUser)
+	return *v, true
+}
+
+// OldChecksumSha256 returns the old "checksum_sha256" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldChecksumSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumSha256 is only allowed on UpdateOne operations")
 	}
-	if m.group != nil {
-		edges = append(edges, enrollment.EdgeGroup)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumSha256 requires an ID field in the mutation")
 	}
-	if m.progress_entries != nil {
-		edges = append(edges, enrollment.EdgeProgressEntries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumSha256: %w", err)
 	}
-	return edges
+	return oldValue.ChecksumSha256, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EnrollmentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case enrollment.EdgeOrganization:
-		if id := m.organization; id != nil {
-			return []ent.Value{*id}
-		}
-	case enrollment.EdgeCourse:
-		if id := m.course; id != nil {
-			return []ent.Value{*id}
-		}
-	case enrollment.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case enrollment.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case enrollment.EdgeProgressEntries:
-		ids := make([]ent.Value, 0, len(m.progress_entries))
-		for id := range m.progress_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (m *ContentRevisionMutation) ClearChecksumSha256() {
+	m.checksum_sha256 = nil
+	m.clearedFields[contentrevision.FieldChecksumSha256] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnrollmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedprogress_entries != nil {
-		edges = append(edges, enrollment.EdgeProgressEntries)
-	}
-	return edges
+// ChecksumSha256Cleared returns if the "checksum_sha256" field was cleared in this mutation.
+func (m *ContentRevisionMutation) ChecksumSha256Cleared() bool {
+	_, ok := m.clearedFields[contentrevision.FieldChecksumSha256]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EnrollmentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case enrollment.EdgeProgressEntries:
-		ids := make([]ent.Value, 0, len(m.removedprogress_entries))
-		for id := range m.removedprogress_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetChecksumSha256 resets all changes to the "checksum_sha256" field.
+func (m *ContentRevisionMutation) ResetChecksumSha256() {
+	m.checksum_sha256 = nil
+	delete(m.clearedFields, contentrevision.FieldChecksumSha256)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnrollmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedorganization {
-		edges = append(edges, enrollment.EdgeOrganization)
-	}
-	if m.clearedcourse {
-		edges = append(edges, enrollment.EdgeCourse)
+// SetStatus sets the "status" field.
+func (m *ContentRevisionMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ContentRevisionMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	if m.cleareduser {
-		edges = append(edges, enrollment.EdgeUser)
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	if m.clearedgroup {
-		edges = append(edges, enrollment.EdgeGroup)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
-	if m.clearedprogress_entries {
-		edges = append(edges, enrollment.EdgeProgressEntries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return edges
+	return oldValue.Status, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EnrollmentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case enrollment.EdgeOrganization:
-		return m.clearedorganization
-	case enrollment.EdgeCourse:
-		return m.clearedcourse
-	case enrollment.EdgeUser:
-		return m.cleareduser
-	case enrollment.EdgeGroup:
-		return m.clearedgroup
-	case enrollment.EdgeProgressEntries:
-		return m.clearedprogress_entries
+// ResetStatus resets all changes to the "status" field.
+func (m *ContentRevisionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ContentRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ContentRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EnrollmentMutation) ClearEdge(name string) error {
-	switch name {
-	case enrollment.EdgeOrganization:
-		m.ClearOrganization()
-		return nil
-	case enrollment.EdgeCourse:
-		m.ClearCourse()
-		return nil
-	case enrollment.EdgeUser:
-		m.ClearUser()
-		return nil
-	case enrollment.EdgeGroup:
-		m.ClearGroup()
-		return nil
+// OldCreatedAt returns the old "created_at" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Enrollment unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EnrollmentMutation) ResetEdge(name string) error {
-	switch name {
-	case enrollment.EdgeOrganization:
-		m.ResetOrganization()
-		return nil
-	case enrollment.EdgeCourse:
-		m.ResetCourse()
-		return nil
-	case enrollment.EdgeUser:
-		m.ResetUser()
-		return nil
-	case enrollment.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case enrollment.EdgeProgressEntries:
-		m.ResetProgressEntries()
-		return nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ContentRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ContentRevisionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ContentRevisionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Enrollment edge %s", name)
+	return *v, true
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	name                *string
-	description         *string
-	capacity            *int
-	addcapacity         *int
-	metadata            *map[string]interface{}
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	organization        *uuid.UUID
-	clearedorganization bool
-	course              *uuid.UUID
-	clearedcourse       bool
-	enrollments         map[uuid.UUID]struct{}
-	removedenrollments  map[uuid.UUID]struct{}
-	clearedenrollments  bool
-	done                bool
-	oldValue            func(context.Context) (*Group, error)
-	predicates          []predicate.Group
-}
-
-var _ ent.Mutation = (*GroupMutation)(nil)
-
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
-
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGroup,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// OldUpdatedAt returns the old "updated_at" field's value of the ContentRevision entity.
+// If the ContentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentRevisionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return m
-}
-
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id uuid.UUID) groupOption {
-	return func(m *GroupMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Group
-		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Group.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-}
-
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ContentRevisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearContent clears the "content" edge to the Content entity.
+func (m *ContentRevisionMutation) ClearContent() {
+	m.clearedcontent = true
+	m.clearedFields[contentrevision.FieldContentID] = struct{}{}
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Group entities.
-func (m *GroupMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ContentCleared reports if the "content" edge to the Content entity was cleared.
+func (m *ContentRevisionMutation) ContentCleared() bool {
+	return m.clearedcontent
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// ContentIDs returns the "content" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentID instead. It exists only for internal usage by the builders.
+func (m *ContentRevisionMutation) ContentIDs() (ids []uuid.UUID) {
+	if id := m.content; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetContent resets all changes to the "content" edge.
+func (m *ContentRevisionMutation) ResetContent() {
+	m.content = nil
+	m.clearedcontent = false
 }
 
-// SetOrganizationID sets the "organization_id" field.
-func (m *GroupMutation) SetOrganizationID(u uuid.UUID) {
-	m.organization = &u
+// Where appends a list predicates to the ContentRevisionMutation builder.
+func (m *ContentRevisionMutation) Where(ps ...predicate.ContentRevision) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *GroupMutation) OrganizationID() (r uuid.UUID, exists bool) {
-	v := m.organization
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ContentRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ContentRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContentRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
-	}
-	return oldValue.OrganizationID, nil
+// Op returns the operation name.
+func (m *ContentRevisionMutation) Op() Op {
+	return m.op
 }
 
-// ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *GroupMutation) ResetOrganizationID() {
-	m.organization = nil
+// SetOp allows setting the mutation operation.
+func (m *ContentRevisionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetCourseID sets the "course_id" field.
-func (m *GroupMutation) SetCourseID(u uuid.UUID) {
-	m.course = &u
+// Type returns the node type of this mutation (ContentRevision).
+func (m *ContentRevisionMutation) Type() string {
+	return m.typ
 }
 
-// CourseID returns the value of the "course_id" field in the mutation.
-func (m *GroupMutation) CourseID() (r uuid.UUID, exists bool) {
-	v := m.course
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContentRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.organization_id != nil {
+		fields = append(fields, contentrevision.FieldOrganizationID)
 	}
-	return *v, true
-}
-
-// OldCourseID returns the old "course_id" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCourseID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
+	if m.content != nil {
+		fields = append(fields, contentrevision.FieldContentID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCourseID requires an ID field in the mutation")
+	if m.number != nil {
+		fields = append(fields, contentrevision.FieldNumber)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
+	if m.storage_key != nil {
+		fields = append(fields, contentrevision.FieldStorageKey)
 	}
-	return oldValue.CourseID, nil
-}
-
-// ClearCourseID clears the value of the "course_id" field.
-func (m *GroupMutation) ClearCourseID() {
-	m.course = nil
-	m.clearedFields[group.FieldCourseID] = struct{}{}
-}
-
-// CourseIDCleared returns if the "course_id" field was cleared in this mutation.
-func (m *GroupMutation) CourseIDCleared() bool {
-	_, ok := m.clearedFields[group.FieldCourseID]
-	return ok
-}
-
-// ResetCourseID resets all changes to the "course_id" field.
-func (m *GroupMutation) ResetCourseID() {
-	m.course = nil
-	delete(m.clearedFields, group.FieldCourseID)
-}
-
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+	if m.mime_type != nil {
+		fields = append(fields, contentrevision.FieldMimeType)
 	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	if m.size_bytes != nil {
+		fields = append(fields, contentrevision.FieldSizeBytes)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	if m.etag != nil {
+		fields = append(fields, contentrevision.FieldEtag)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	if m.checksum_sha256 != nil {
+		fields = append(fields, contentrevision.FieldChecksumSha256)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+	if m.status != nil {
+		fields = append(fields, contentrevision.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, contentrevision.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, contentrevision.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// SetDescription sets the "description" field.
-func (m *GroupMutation) SetDescription(s string) {
-	m.description = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContentRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contentrevision.FieldOrganizationID:
+		return m.OrganizationID()
+	case contentrevision.FieldContentID:
+		return m.ContentID()
+	case contentrevision.FieldNumber:
+		return m.Number()
+	case contentrevision.FieldStorageKey:
+		return m.StorageKey()
+	case contentrevision.FieldMimeType:
+		return m.MimeType()
+	case contentrevision.FieldSizeBytes:
+		return m.SizeBytes()
+	case contentrevision.FieldEtag:
+		return m.Etag()
+	case contentrevision.FieldChecksumSha256:
+		return m.ChecksumSha256()
+	case contentrevision.FieldStatus:
+		return m.Status()
+	case contentrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	case contentrevision.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *GroupMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContentRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contentrevision.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case contentrevision.FieldContentID:
+		return m.OldContentID(ctx)
+	case contentrevision.FieldNumber:
+		return m.OldNumber(ctx)
+	case contentrevision.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case contentrevision.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case contentrevision.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case contentrevision.FieldEtag:
+		return m.OldEtag(ctx)
+	case contentrevision.FieldChecksumSha256:
+		return m.OldChecksumSha256(ctx)
+	case contentrevision.FieldStatus:
+		return m.OldStatus(ctx)
+	case contentrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case contentrevision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ContentRevision field %s", name)
 }
 
-// OldDescription returns the old "description" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contentrevision.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case contentrevision.FieldContentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentID(v)
+		return nil
+	case contentrevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case contentrevision.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case contentrevision.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case contentrevision.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case contentrevision.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case contentrevision.FieldChecksumSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumSha256(v)
+		return nil
+	case contentrevision.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case contentrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case contentrevision.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	return fmt.Errorf("unknown ContentRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContentRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, contentrevision.FieldNumber)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	if m.addsize_bytes != nil {
+		fields = append(fields, contentrevision.FieldSizeBytes)
 	}
-	return oldValue.Description, nil
+	return fields
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *GroupMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[group.FieldDescription] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContentRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contentrevision.FieldNumber:
+		return m.AddedNumber()
+	case contentrevision.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	}
+	return nil, false
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *GroupMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[group.FieldDescription]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contentrevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case contentrevision.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContentRevision numeric field %s", name)
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *GroupMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, group.FieldDescription)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContentRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contentrevision.FieldSizeBytes) {
+		fields = append(fields, contentrevision.FieldSizeBytes)
+	}
+	if m.FieldCleared(contentrevision.FieldEtag) {
+		fields = append(fields, contentrevision.FieldEtag)
+	}
+	if m.FieldCleared(contentrevision.FieldChecksumSha256) {
+		fields = append(fields, contentrevision.FieldChecksumSha256)
+	}
+	return fields
 }
 
-// SetCapacity sets the "capacity" field.
-func (m *GroupMutation) SetCapacity(i int) {
-	m.capacity = &i
-	m.addcapacity = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContentRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Capacity returns the value of the "capacity" field in the mutation.
-func (m *GroupMutation) Capacity() (r int, exists bool) {
-	v := m.capacity
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContentRevisionMutation) ClearField(name string) error {
+	switch name {
+	case contentrevision.FieldSizeBytes:
+		m.ClearSizeBytes()
+		return nil
+	case contentrevision.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case contentrevision.FieldChecksumSha256:
+		m.ClearChecksumSha256()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ContentRevision nullable field %s", name)
 }
 
-// OldCapacity returns the old "capacity" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCapacity(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapacity is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapacity requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapacity: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContentRevisionMutation) ResetField(name string) error {
+	switch name {
+	case contentrevision.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case contentrevision.FieldContentID:
+		m.ResetContentID()
+		return nil
+	case contentrevision.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case contentrevision.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case contentrevision.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case contentrevision.FieldSizeBytes:
+		m.ResetSizeBytes()
+		return nil
+	case contentrevision.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case contentrevision.FieldChecksumSha256:
+		m.ResetChecksumSha256()
+		return nil
+	case contentrevision.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case contentrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case contentrevision.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.Capacity, nil
+	return fmt.Errorf("unknown ContentRevision field %s", name)
 }
 
-// AddCapacity adds i to the "capacity" field.
-func (m *GroupMutation) AddCapacity(i int) {
-	if m.addcapacity != nil {
-		*m.addcapacity += i
-	} else {
-		m.addcapacity = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContentRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.content != nil {
+		edges = append(edges, contentrevision.EdgeContent)
 	}
+	return edges
 }
 
-// AddedCapacity returns the value that was added to the "capacity" field in this mutation.
-func (m *GroupMutation) AddedCapacity() (r int, exists bool) {
-	v := m.addcapacity
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContentRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contentrevision.EdgeContent:
+		if id := m.content; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// ClearCapacity clears the value of the "capacity" field.
-func (m *GroupMutation) ClearCapacity() {
-	m.capacity = nil
-	m.addcapacity = nil
-	m.clearedFields[group.FieldCapacity] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContentRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// CapacityCleared returns if the "capacity" field was cleared in this mutation.
-func (m *GroupMutation) CapacityCleared() bool {
-	_, ok := m.clearedFields[group.FieldCapacity]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContentRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCapacity resets all changes to the "capacity" field.
-func (m *GroupMutation) ResetCapacity() {
-	m.capacity = nil
-	m.addcapacity = nil
-	delete(m.clearedFields, group.FieldCapacity)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContentRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcontent {
+		edges = append(edges, contentrevision.EdgeContent)
+	}
+	return edges
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *GroupMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContentRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contentrevision.EdgeContent:
+		return m.clearedcontent
+	}
+	return false
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *GroupMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContentRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case contentrevision.EdgeContent:
+		m.ClearContent()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ContentRevision unique edge %s", name)
 }
 
-// OldMetadata returns the old "metadata" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContentRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case contentrevision.EdgeContent:
+		m.ResetContent()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	return fmt.Errorf("unknown ContentRevision edge %s", name)
+}
+
+// CourseMutation represents an operation that mutates the Course nodes in the graph.
+type CourseMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	title               *string
+	slug                *string
+	description         *string
+	status              *string
+	version             *int
+	addversion          *int
+	metadata            *map[string]interface{}
+	published_at        *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	modules             map[uuid.UUID]struct{}
+	removedmodules      map[uuid.UUID]struct{}
+	clearedmodules      bool
+	enrollments         map[uuid.UUID]struct{}
+	removedenrollments  map[uuid.UUID]struct{}
+	clearedenrollments  bool
+	groups              map[uuid.UUID]struct{}
+	removedgroups       map[uuid.UUID]struct{}
+	clearedgroups       bool
+	done                bool
+	oldValue            func(context.Context) (*Course, error)
+	predicates          []predicate.Course
+}
+
+var _ ent.Mutation = (*CourseMutation)(nil)
+
+// courseOption allows management of the mutation configuration using functional options.
+type courseOption func(*CourseMutation)
+
+// newCourseMutation creates new mutation for the Course entity.
+func newCourseMutation(c config, op Op, opts ...courseOption) *CourseMutation {
+	m := &CourseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCourse,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Metadata, nil
+	return m
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *GroupMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[group.FieldMetadata] = struct{}{}
+// withCourseID sets the ID field of the mutation.
+func withCourseID(id uuid.UUID) courseOption {
+	return func(m *CourseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Course
+		)
+		m.oldValue = func(ctx context.Context) (*Course, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Course.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *GroupMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[group.FieldMetadata]
-	return ok
+// withCourse sets the old Course of the mutation.
+func withCourse(node *Course) courseOption {
+	return func(m *CourseMutation) {
+		m.oldValue = func(context.Context) (*Course, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *GroupMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, group.FieldMetadata)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CourseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CourseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Course entities.
+func (m *CourseMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CourseMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CourseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Course.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *CourseMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *CourseMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CourseMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.OrganizationID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *CourseMutation) ResetOrganizationID() {
+	m.organization = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTitle sets the "title" field.
+func (m *CourseMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Title returns the value of the "title" field in the mutation.
+func (m *CourseMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CourseMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Title, nil
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *GroupMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[group.FieldOrganizationID] = struct{}{}
+// ResetTitle resets all changes to the "title" field.
+func (m *CourseMutation) ResetTitle() {
+	m.title = nil
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *GroupMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// SetSlug sets the "slug" field.
+func (m *CourseMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *GroupMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CourseMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *GroupMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// OldSlug returns the old "slug" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
 }
 
-// ClearCourse clears the "course" edge to the Course entity.
-func (m *GroupMutation) ClearCourse() {
-	m.clearedcourse = true
-	m.clearedFields[group.FieldCourseID] = struct{}{}
+// ResetSlug resets all changes to the "slug" field.
+func (m *CourseMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// CourseCleared reports if the "course" edge to the Course entity was cleared.
-func (m *GroupMutation) CourseCleared() bool {
-	return m.CourseIDCleared() || m.clearedcourse
+// SetDescription sets the "description" field.
+func (m *CourseMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// CourseIDs returns the "course" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CourseID instead. It exists only for internal usage by the builders.
-func (m *GroupMutation) CourseIDs() (ids []uuid.UUID) {
-	if id := m.course; id != nil {
-		ids = append(ids, *id)
+// Description returns the value of the "description" field in the mutation.
+func (m *CourseMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetCourse resets all changes to the "course" edge.
-func (m *GroupMutation) ResetCourse() {
-	m.course = nil
-	m.clearedcourse = false
+	return *v, true
 }
 
-// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
-func (m *GroupMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
-	if m.enrollments == nil {
-		m.enrollments = make(map[uuid.UUID]struct{})
+// OldDescription returns the old "description" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.enrollments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
+	return oldValue.Description, nil
 }
 
-// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
-func (m *GroupMutation) ClearEnrollments() {
-	m.clearedenrollments = true
+// ClearDescription clears the value of the "description" field.
+func (m *CourseMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[course.FieldDescription] = struct{}{}
 }
 
-// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
-func (m *GroupMutation) EnrollmentsCleared() bool {
-	return m.clearedenrollments
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CourseMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[course.FieldDescription]
+	return ok
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
-func (m *GroupMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
-	if m.removedenrollments == nil {
-		m.removedenrollments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.enrollments, ids[i])
-		m.removedenrollments[ids[i]] = struct{}{}
+// ResetDescription resets all changes to the "description" field.
+func (m *CourseMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, course.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *CourseMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CourseMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
-func (m *GroupMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedenrollments {
-		ids = append(ids, id)
+// OldStatus returns the old "status" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
-func (m *GroupMutation) EnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.enrollments {
-		ids = append(ids, id)
+// ResetStatus resets all changes to the "status" field.
+func (m *CourseMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *CourseMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *CourseMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetEnrollments resets all changes to the "enrollments" edge.
-func (m *GroupMutation) ResetEnrollments() {
-	m.enrollments = nil
-	m.clearedenrollments = false
-	m.removedenrollments = nil
+// OldVersion returns the old "version" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
-	m.predicates = append(m.predicates, ps...)
+// AddVersion adds i to the "version" field.
+func (m *CourseMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *CourseMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GroupMutation) Op() Op {
-	return m.op
+// ResetVersion resets all changes to the "version" field.
+func (m *CourseMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
-	m.op = op
+// SetMetadata sets the "metadata" field.
+func (m *CourseMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
-	return m.typ
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *CourseMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.organization != nil {
-		fields = append(fields, group.FieldOrganizationID)
+// OldMetadata returns the old "metadata" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
-	if m.course != nil {
-		fields = append(fields, group.FieldCourseID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	if m.description != nil {
-		fields = append(fields, group.FieldDescription)
-	}
-	if m.capacity != nil {
-		fields = append(fields, group.FieldCapacity)
-	}
-	if m.metadata != nil {
-		fields = append(fields, group.FieldMetadata)
-	}
-	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case group.FieldOrganizationID:
-		return m.OrganizationID()
-	case group.FieldCourseID:
-		return m.CourseID()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldDescription:
-		return m.Description()
-	case group.FieldCapacity:
-		return m.Capacity()
-	case group.FieldMetadata:
-		return m.Metadata()
-	case group.FieldCreatedAt:
-		return m.CreatedAt()
-	case group.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+	return oldValue.Metadata, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case group.FieldOrganizationID:
-		return m.OldOrganizationID(ctx)
-	case group.FieldCourseID:
-		return m.OldCourseID(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldDescription:
-		return m.OldDescription(ctx)
-	case group.FieldCapacity:
-		return m.OldCapacity(ctx)
-	case group.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case group.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+// ClearMetadata clears the value of the "metadata" field.
+func (m *CourseMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[course.FieldMetadata] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case group.FieldOrganizationID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrganizationID(v)
-		return nil
-	case group.FieldCourseID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCourseID(v)
-		return nil
-	case group.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case group.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case group.FieldCapacity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCapacity(v)
-		return nil
-	case group.FieldMetadata:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case group.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case group.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Group field %s", name)
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *CourseMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[course.FieldMetadata]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GroupMutation) AddedFields() []string {
-	var fields []string
-	if m.addcapacity != nil {
-		fields = append(fields, group.FieldCapacity)
-	}
-	return fields
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *CourseMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, course.FieldMetadata)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case group.FieldCapacity:
-		return m.AddedCapacity()
-	}
-	return nil, false
+// SetPublishedAt sets the "published_at" field.
+func (m *CourseMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case group.FieldCapacity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCapacity(v)
-		return nil
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *CourseMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(group.FieldCourseID) {
-		fields = append(fields, group.FieldCourseID)
-	}
-	if m.FieldCleared(group.FieldDescription) {
-		fields = append(fields, group.FieldDescription)
+// OldPublishedAt returns the old "published_at" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(group.FieldCapacity) {
-		fields = append(fields, group.FieldCapacity)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(group.FieldMetadata) {
-		fields = append(fields, group.FieldMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
 	}
-	return fields
+	return oldValue.PublishedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *CourseMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[course.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *CourseMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[course.FieldPublishedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	switch name {
-	case group.FieldCourseID:
-		m.ClearCourseID()
-		return nil
-	case group.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case group.FieldCapacity:
-		m.ClearCapacity()
-		return nil
-	case group.FieldMetadata:
-		m.ClearMetadata()
-		return nil
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *CourseMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, course.FieldPublishedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CourseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CourseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
-	switch name {
-	case group.FieldOrganizationID:
-		m.ResetOrganizationID()
-		return nil
-	case group.FieldCourseID:
-		m.ResetCourseID()
-		return nil
-	case group.FieldName:
-		m.ResetName()
-		return nil
-	case group.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case group.FieldCapacity:
-		m.ResetCapacity()
-		return nil
-	case group.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case group.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case group.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Group field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.organization != nil {
-		edges = append(edges, group.EdgeOrganization)
+// OldCreatedAt returns the old "created_at" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.course != nil {
-		edges = append(edges, group.EdgeCourse)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.enrollments != nil {
-		edges = append(edges, group.EdgeEnrollments)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return edges
+	return oldValue.CreatedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeOrganization:
-		if id := m.organization; id != nil {
-			return []ent.Value{*id}
-		}
-	case group.EdgeCourse:
-		if id := m.course; id != nil {
-			return []ent.Value{*id}
-		}
-	case group.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.enrollments))
-		for id := range m.enrollments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CourseMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedenrollments != nil {
-		edges = append(edges, group.EdgeEnrollments)
-	}
-	return edges
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CourseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.removedenrollments))
-		for id := range m.removedenrollments {
-			ids = append(ids, id)
-		}
-		return ids
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CourseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedorganization {
-		edges = append(edges, group.EdgeOrganization)
+// OldUpdatedAt returns the old "updated_at" field's value of the Course entity.
+// If the Course object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CourseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedcourse {
-		edges = append(edges, group.EdgeCourse)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.clearedenrollments {
-		edges = append(edges, group.EdgeEnrollments)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return edges
+	return oldValue.UpdatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
-	switch name {
-	case group.EdgeOrganization:
-		return m.clearedorganization
-	case group.EdgeCourse:
-		return m.clearedcourse
-	case group.EdgeEnrollments:
-		return m.clearedenrollments
-	}
-	return false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CourseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	switch name {
-	case group.EdgeOrganization:
-		m.ClearOrganization()
-		return nil
-	case group.EdgeCourse:
-		m.ClearCourse()
-		return nil
-	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *CourseMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[course.FieldOrganizationID] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeOrganization:
-		m.ResetOrganization()
-		return nil
-	case group.EdgeCourse:
-		m.ResetCourse()
-		return nil
-	case group.EdgeEnrollments:
-		m.ResetEnrollments()
-		return nil
-	}
-	return fmt.Errorf("unknown Group edge %s", name)
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *CourseMutation) OrganizationCleared() bool {
+	return m.clearedorganization
 }
 
-// ModuleMutation represents an operation that mutates the Module nodes in the graph.
-type ModuleMutation struct {
-	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	title                   *string
-	module_type             *string
-	position                *int
-	addposition             *int
-	duration_seconds        *int
-	addduration_seconds     *int
-	status                  *string
-	data                    *map[string]interface{}
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	course                  *uuid.UUID
-	clearedcourse           bool
-	content                 *uuid.UUID
-	clearedcontent          bool
-	progress_entries        map[uuid.UUID]struct{}
-	removedprogress_entries map[uuid.UUID]struct{}
-	clearedprogress_entries bool
-	done                    bool
-	oldValue                func(context.Context) (*Module, error)
-	predicates              []predicate.Module
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *CourseMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-var _ ent.Mutation = (*ModuleMutation)(nil)
-
-// moduleOption allows management of the mutation configuration using functional options.
-type moduleOption func(*ModuleMutation)
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *CourseMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
 
-// newModuleMutation creates new mutation for the Module entity.
-func newModuleMutation(c config, op Op, opts ...moduleOption) *ModuleMutation {
-	m := &ModuleMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeModule,
-		clearedFields: make(map[string]struct{}),
+// AddModuleIDs adds the "modules" edge to the Module entity by ids.
+func (m *CourseMutation) AddModuleIDs(ids ...uuid.UUID) {
+	if m.modules == nil {
+		m.modules = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.modules[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withModuleID sets the ID field of the mutation.
-func withModuleID(id uuid.UUID) moduleOption {
-	return func(m *ModuleMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Module
-		)
-		m.oldValue = func(ctx context.Context) (*Module, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Module.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearModules clears the "modules" edge to the Module entity.
+func (m *CourseMutation) ClearModules() {
+	m.clearedmodules = true
 }
 
-// withModule sets the old Module of the mutation.
-func withModule(node *Module) moduleOption {
-	return func(m *ModuleMutation) {
-		m.oldValue = func(context.Context) (*Module, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ModulesCleared reports if the "modules" edge to the Module entity was cleared.
+func (m *CourseMutation) ModulesCleared() bool {
+	return m.clearedmodules
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModuleMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemoveModuleIDs removes the "modules" edge to the Module entity by IDs.
+func (m *CourseMutation) RemoveModuleIDs(ids ...uuid.UUID) {
+	if m.removedmodules == nil {
+		m.removedmodules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.modules, ids[i])
+		m.removedmodules[ids[i]] = struct{}{}
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ModuleMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// RemovedModules returns the removed IDs of the "modules" edge to the Module entity.
+func (m *CourseMutation) RemovedModulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodules {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Module entities.
-func (m *ModuleMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ModulesIDs returns the "modules" edge IDs in the mutation.
+func (m *CourseMutation) ModulesIDs() (ids []uuid.UUID) {
+	for id := range m.modules {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ModuleMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetModules resets all changes to the "modules" edge.
+func (m *CourseMutation) ResetModules() {
+	m.modules = nil
+	m.clearedmodules = false
+	m.removedmodules = nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ModuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Module.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
+func (m *CourseMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
+	if m.enrollments == nil {
+		m.enrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.enrollments[ids[i]] = struct{}{}
 	}
 }
 
-// SetCourseID sets the "course_id" field.
-func (m *ModuleMutation) SetCourseID(u uuid.UUID) {
-	m.course = &u
+// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
+func (m *CourseMutation) ClearEnrollments() {
+	m.clearedenrollments = true
 }
 
-// CourseID returns the value of the "course_id" field in the mutation.
-func (m *ModuleMutation) CourseID() (r uuid.UUID, exists bool) {
-	v := m.course
-	if v == nil {
-		return
-	}
-	return *v, true
+// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
+func (m *CourseMutation) EnrollmentsCleared() bool {
+	return m.clearedenrollments
 }
 
-// OldCourseID returns the old "course_id" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldCourseID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCourseID requires an ID field in the mutation")
+// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
+func (m *CourseMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
+	if m.removedenrollments == nil {
+		m.removedenrollments = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
+	for i := range ids {
+		delete(m.enrollments, ids[i])
+		m.removedenrollments[ids[i]] = struct{}{}
 	}
-	return oldValue.CourseID, nil
 }
 
-// ResetCourseID resets all changes to the "course_id" field.
-func (m *ModuleMutation) ResetCourseID() {
-	m.course = nil
+// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
+func (m *CourseMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedenrollments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetContentID sets the "content_id" field.
-func (m *ModuleMutation) SetContentID(u uuid.UUID) {
-	m.content = &u
+// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
+func (m *CourseMutation) EnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.enrollments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ContentID returns the value of the "content_id" field in the mutation.
-func (m *ModuleMutation) ContentID() (r uuid.UUID, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEnrollments resets all changes to the "enrollments" edge.
+func (m *CourseMutation) ResetEnrollments() {
+	m.enrollments = nil
+	m.clearedenrollments = false
+	m.removedenrollments = nil
 }
 
-// OldContentID returns the old "content_id" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldContentID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentID requires an ID field in the mutation")
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *CourseMutation) AddGroupIDs(ids ...uuid.UUID) {
+	if m.groups == nil {
+		m.groups = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentID: %w", err)
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
 	}
-	return oldValue.ContentID, nil
-}
-
-// ClearContentID clears the value of the "content_id" field.
-func (m *ModuleMutation) ClearContentID() {
-	m.content = nil
-	m.clearedFields[module.FieldContentID] = struct{}{}
 }
 
-// ContentIDCleared returns if the "content_id" field was cleared in this mutation.
-func (m *ModuleMutation) ContentIDCleared() bool {
-	_, ok := m.clearedFields[module.FieldContentID]
-	return ok
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *CourseMutation) ClearGroups() {
+	m.clearedgroups = true
 }
 
-// ResetContentID resets all changes to the "content_id" field.
-func (m *ModuleMutation) ResetContentID() {
-	m.content = nil
-	delete(m.clearedFields, module.FieldContentID)
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *CourseMutation) GroupsCleared() bool {
+	return m.clearedgroups
 }
 
-// SetTitle sets the "title" field.
-func (m *ModuleMutation) SetTitle(s string) {
-	m.title = &s
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *CourseMutation) RemoveGroupIDs(ids ...uuid.UUID) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ModuleMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *CourseMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldTitle returns the old "title" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *CourseMutation) GroupsIDs() (ids []uuid.UUID) {
+	for id := range m.groups {
+		ids = append(ids, id)
 	}
-	return oldValue.Title, nil
+	return
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ModuleMutation) ResetTitle() {
-	m.title = nil
+// ResetGroups resets all changes to the "groups" edge.
+func (m *CourseMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
 }
 
-// SetModuleType sets the "module_type" field.
-func (m *ModuleMutation) SetModuleType(s string) {
-	m.module_type = &s
+// Where appends a list predicates to the CourseMutation builder.
+func (m *CourseMutation) Where(ps ...predicate.Course) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ModuleType returns the value of the "module_type" field in the mutation.
-func (m *ModuleMutation) ModuleType() (r string, exists bool) {
-	v := m.module_type
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the CourseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CourseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Course, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldModuleType returns the old "module_type" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldModuleType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModuleType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModuleType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModuleType: %w", err)
-	}
-	return oldValue.ModuleType, nil
+// Op returns the operation name.
+func (m *CourseMutation) Op() Op {
+	return m.op
 }
 
-// ResetModuleType resets all changes to the "module_type" field.
-func (m *ModuleMutation) ResetModuleType() {
-	m.module_type = nil
+// SetOp allows setting the mutation operation.
+func (m *CourseMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetPosition sets the "position" field.
-func (m *ModuleMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// Type returns the node type of this mutation (Course).
+func (m *CourseMutation) Type() string {
+	return m.typ
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *ModuleMutation) Position() (r int, exists bool) {
-	v := m.position
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CourseMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.organization != nil {
+		fields = append(fields, course.FieldOrganizationID)
 	}
-	return *v, true
-}
-
-// OldPosition returns the old "position" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldPosition(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	if m.title != nil {
+		fields = append(fields, course.FieldTitle)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+	if m.slug != nil {
+		fields = append(fields, course.FieldSlug)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	if m.description != nil {
+		fields = append(fields, course.FieldDescription)
 	}
-	return oldValue.Position, nil
+	if m.status != nil {
+		fields = append(fields, course.FieldStatus)
+	}
+	if m.version != nil {
+		fields = append(fields, course.FieldVersion)
+	}
+	if m.metadata != nil {
+		fields = append(fields, course.FieldMetadata)
+	}
+	if m.published_at != nil {
+		fields = append(fields, course.FieldPublishedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, course.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, course.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// AddPosition adds i to the "position" field.
-func (m *ModuleMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
-	} else {
-		m.addposition = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CourseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case course.FieldOrganizationID:
+		return m.OrganizationID()
+	case course.FieldTitle:
+		return m.Title()
+	case course.FieldSlug:
+		return m.Slug()
+	case course.FieldDescription:
+		return m.Description()
+	case course.FieldStatus:
+		return m.Status()
+	case course.FieldVersion:
+		return m.Version()
+	case course.FieldMetadata:
+		return m.Metadata()
+	case course.FieldPublishedAt:
+		return m.PublishedAt()
+	case course.FieldCreatedAt:
+		return m.CreatedAt()
+	case course.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
+	return nil, false
 }
 
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *ModuleMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CourseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case course.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case course.FieldTitle:
+		return m.OldTitle(ctx)
+	case course.FieldSlug:
+		return m.OldSlug(ctx)
+	case course.FieldDescription:
+		return m.OldDescription(ctx)
+	case course.FieldStatus:
+		return m.OldStatus(ctx)
+	case course.FieldVersion:
+		return m.OldVersion(ctx)
+	case course.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case course.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case course.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case course.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Course field %s", name)
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *ModuleMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CourseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case course.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case course.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case course.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case course.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case course.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case course.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case course.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case course.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case course.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case course.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Course field %s", name)
 }
 
-// SetDurationSeconds sets the "duration_seconds" field.
-func (m *ModuleMutation) SetDurationSeconds(i int) {
-	m.duration_seconds = &i
-	m.addduration_seconds = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CourseMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, course.FieldVersion)
+	}
+	return fields
 }
 
-// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
-func (m *ModuleMutation) DurationSeconds() (r int, exists bool) {
-	v := m.duration_seconds
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CourseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case course.FieldVersion:
+		return m.AddedVersion()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldDurationSeconds returns the old "duration_seconds" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CourseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case course.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return oldValue.DurationSeconds, nil
+	return fmt.Errorf("unknown Course numeric field %s", name)
 }
 
-// AddDurationSeconds adds i to the "duration_seconds" field.
-func (m *ModuleMutation) AddDurationSeconds(i int) {
-	if m.addduration_seconds != nil {
-		*m.addduration_seconds += i
-	} else {
-		m.addduration_seconds = &i
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CourseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(course.FieldDescription) {
+		fields = append(fields, course.FieldDescription)
 	}
-}
-
-// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
-func (m *ModuleMutation) AddedDurationSeconds() (r int, exists bool) {
-	v := m.addduration_seconds
-	if v == nil {
-		return
+	if m.FieldCleared(course.FieldMetadata) {
+		fields = append(fields, course.FieldMetadata)
 	}
-	return *v, true
-}
-
-// ClearDurationSeconds clears the value of the "duration_seconds" field.
-func (m *ModuleMutation) ClearDurationSeconds() {
-	m.duration_seconds = nil
-	m.addduration_seconds = nil
-	m.clearedFields[module.FieldDurationSeconds] = struct{}{}
+	if m.FieldCleared(course.FieldPublishedAt) {
+		fields = append(fields, course.FieldPublishedAt)
+	}
+	return fields
 }
 
-// DurationSecondsCleared returns if the "duration_seconds" field was cleared in this mutation.
-func (m *ModuleMutation) DurationSecondsCleared() bool {
-	_, ok := m.clearedFields[module.FieldDurationSeconds]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CourseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDurationSeconds resets all changes to the "duration_seconds" field.
-func (m *ModuleMutation) ResetDurationSeconds() {
-	m.duration_seconds = nil
-	m.addduration_seconds = nil
-	delete(m.clearedFields, module.FieldDurationSeconds)
-}
-
-// SetStatus sets the "status" field.
-func (m *ModuleMutation) SetStatus(s string) {
-	m.status = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CourseMutation) ClearField(name string) error {
+	switch name {
+	case course.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case course.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case course.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Course nullable field %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ModuleMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CourseMutation) ResetField(name string) error {
+	switch name {
+	case course.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case course.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case course.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case course.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case course.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case course.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case course.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case course.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case course.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case course.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Course field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CourseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.organization != nil {
+		edges = append(edges, course.EdgeOrganization)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	if m.modules != nil {
+		edges = append(edges, course.EdgeModules)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.enrollments != nil {
+		edges = append(edges, course.EdgeEnrollments)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *ModuleMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetData sets the "data" field.
-func (m *ModuleMutation) SetData(value map[string]interface{}) {
-	m.data = &value
+	if m.groups != nil {
+		edges = append(edges, course.EdgeGroups)
+	}
+	return edges
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *ModuleMutation) Data() (r map[string]interface{}, exists bool) {
-	v := m.data
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CourseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case course.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case course.EdgeModules:
+		ids := make([]ent.Value, 0, len(m.modules))
+		for id := range m.modules {
+			ids = append(ids, id)
+		}
+		return ids
+	case course.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.enrollments))
+		for id := range m.enrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case course.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldData returns the old "data" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CourseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmodules != nil {
+		edges = append(edges, course.EdgeModules)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+	if m.removedenrollments != nil {
+		edges = append(edges, course.EdgeEnrollments)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	if m.removedgroups != nil {
+		edges = append(edges, course.EdgeGroups)
 	}
-	return oldValue.Data, nil
-}
-
-// ClearData clears the value of the "data" field.
-func (m *ModuleMutation) ClearData() {
-	m.data = nil
-	m.clearedFields[module.FieldData] = struct{}{}
+	return edges
 }
 
-// DataCleared returns if the "data" field was cleared in this mutation.
-func (m *ModuleMutation) DataCleared() bool {
-	_, ok := m.clearedFields[module.FieldData]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CourseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case course.EdgeModules:
+		ids := make([]ent.Value, 0, len(m.removedmodules))
+		for id := range m.removedmodules {
+			ids = append(ids, id)
+		}
+		return ids
+	case course.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.removedenrollments))
+		for id := range m.removedenrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case course.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *ModuleMutation) ResetData() {
-	m.data = nil
-	delete(m.clearedFields, module.FieldData)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CourseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedorganization {
+		edges = append(edges, course.EdgeOrganization)
+	}
+	if m.clearedmodules {
+		edges = append(edges, course.EdgeModules)
+	}
+	if m.clearedenrollments {
+		edges = append(edges, course.EdgeEnrollments)
+	}
+	if m.clearedgroups {
+		edges = append(edges, course.EdgeGroups)
+	}
+	return edges
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ModuleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CourseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case course.EdgeOrganization:
+		return m.clearedorganization
+	case course.EdgeModules:
+		return m.clearedmodules
+	case course.EdgeEnrollments:
+		return m.clearedenrollments
+	case course.EdgeGroups:
+		return m.clearedgroups
+	}
+	return false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ModuleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CourseMutation) ClearEdge(name string) error {
+	switch name {
+	case course.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown Course unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CourseMutation) ResetEdge(name string) error {
+	switch name {
+	case course.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case course.EdgeModules:
+		m.ResetModules()
+		return nil
+	case course.EdgeEnrollments:
+		m.ResetEnrollments()
+		return nil
+	case course.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown Course edge %s", name)
+}
+
+// EnrollmentMutation represents an operation that mutates the Enrollment nodes in the graph.
+type EnrollmentMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	status                  *string
+	progress                *float32
+	addprogress             *float32
+	started_at              *time.Time
+	completed_at            *time.Time
+	metadata                *map[string]interface{}
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	organization            *uuid.UUID
+	clearedorganization     bool
+	course                  *uuid.UUID
+	clearedcourse           bool
+	user                    *uuid.UUID
+	cleareduser             bool
+	group                   *uuid.UUID
+	clearedgroup            bool
+	progress_entries        map[uuid.UUID]struct{}
+	removedprogress_entries map[uuid.UUID]struct{}
+	clearedprogress_entries bool
+	done                    bool
+	oldValue                func(context.Context) (*Enrollment, error)
+	predicates              []predicate.Enrollment
+}
+
+var _ ent.Mutation = (*EnrollmentMutation)(nil)
+
+// enrollmentOption allows management of the mutation configuration using functional options.
+type enrollmentOption func(*EnrollmentMutation)
+
+// newEnrollmentMutation creates new mutation for the Enrollment entity.
+func newEnrollmentMutation(c config, op Op, opts ...enrollmentOption) *EnrollmentMutation {
+	m := &EnrollmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnrollment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnrollmentID sets the ID field of the mutation.
+func withEnrollmentID(id uuid.UUID) enrollmentOption {
+	return func(m *EnrollmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Enrollment
+		)
+		m.oldValue = func(ctx context.Context) (*Enrollment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Enrollment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnrollment sets the old Enrollment of the mutation.
+func withEnrollment(node *Enrollment) enrollmentOption {
+	return func(m *EnrollmentMutation) {
+		m.oldValue = func(context.Context) (*Enrollment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnrollmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnrollmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Enrollment entities.
+func (m *EnrollmentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnrollmentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnrollmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Enrollment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *EnrollmentMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *EnrollmentMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnrollmentMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.OrganizationID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ModuleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *EnrollmentMutation) ResetOrganizationID() {
+	m.organization = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ModuleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCourseID sets the "course_id" field.
+func (m *EnrollmentMutation) SetCourseID(u uuid.UUID) {
+	m.course = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ModuleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CourseID returns the value of the "course_id" field in the mutation.
+func (m *EnrollmentMutation) CourseID() (r uuid.UUID, exists bool) {
+	v := m.course
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// OldCourseID returns the old "course_id" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnrollmentMutation) OldCourseID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCourseID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CourseID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ModuleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCourseID resets all changes to the "course_id" field.
+func (m *EnrollmentMutation) ResetCourseID() {
+	m.course = nil
 }
 
-// ClearCourse clears the "course" edge to the Course entity.
-func (m *ModuleMutation) ClearCourse() {
-	m.clearedcourse = true
-	m.clearedFields[module.FieldCourseID] = struct{}{}
+// SetUserID sets the "user_id" field.
+func (m *EnrollmentMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// CourseCleared reports if the "course" edge to the Course entity was cleared.
-func (m *ModuleMutation) CourseCleared() bool {
-	return m.clearedcourse
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EnrollmentMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CourseIDs returns the "course" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CourseID instead. It exists only for internal usage by the builders.
-func (m *ModuleMutation) CourseIDs() (ids []uuid.UUID) {
-	if id := m.course; id != nil {
-		ids = append(ids, *id)
+// OldUserID returns the old "user_id" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ResetCourse resets all changes to the "course" edge.
-func (m *ModuleMutation) ResetCourse() {
-	m.course = nil
-	m.clearedcourse = false
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EnrollmentMutation) ResetUserID() {
+	m.user = nil
 }
 
-// ClearContent clears the "content" edge to the Content entity.
-func (m *ModuleMutation) ClearContent() {
-	m.clearedcontent = true
-	m.clearedFields[module.FieldContentID] = struct{}{}
+// SetGroupID sets the "group_id" field.
+func (m *EnrollmentMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
 }
 
-// ContentCleared reports if the "content" edge to the Content entity was cleared.
-func (m *ModuleMutation) ContentCleared() bool {
-	return m.ContentIDCleared() || m.clearedcontent
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *EnrollmentMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentIDs returns the "content" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ContentID instead. It exists only for internal usage by the builders.
-func (m *ModuleMutation) ContentIDs() (ids []uuid.UUID) {
-	if id := m.content; id != nil {
-		ids = append(ids, *id)
+// OldGroupID returns the old "group_id" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
 }
 
-// ResetContent resets all changes to the "content" edge.
-func (m *ModuleMutation) ResetContent() {
-	m.content = nil
-	m.clearedcontent = false
+// ClearGroupID clears the value of the "group_id" field.
+func (m *EnrollmentMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[enrollment.FieldGroupID] = struct{}{}
 }
 
-// AddProgressEntryIDs adds the "progress_entries" edge to the ModuleProgress entity by ids.
-func (m *ModuleMutation) AddProgressEntryIDs(ids ...uuid.UUID) {
-	if m.progress_entries == nil {
-		m.progress_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.progress_entries[ids[i]] = struct{}{}
-	}
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *EnrollmentMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[enrollment.FieldGroupID]
+	return ok
 }
 
-// ClearProgressEntries clears the "progress_entries" edge to the ModuleProgress entity.
-func (m *ModuleMutation) ClearProgressEntries() {
-	m.clearedprogress_entries = true
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *EnrollmentMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, enrollment.FieldGroupID)
 }
 
-// ProgressEntriesCleared reports if the "progress_entries" edge to the ModuleProgress entity was cleared.
-func (m *ModuleMutation) ProgressEntriesCleared() bool {
-	return m.clearedprogress_entries
+// SetStatus sets the "status" field.
+func (m *EnrollmentMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// RemoveProgressEntryIDs removes the "progress_entries" edge to the ModuleProgress entity by IDs.
-func (m *ModuleMutation) RemoveProgressEntryIDs(ids ...uuid.UUID) {
-	if m.removedprogress_entries == nil {
-		m.removedprogress_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.progress_entries, ids[i])
-		m.removedprogress_entries[ids[i]] = struct{}{}
+// Status returns the value of the "status" field in the mutation.
+func (m *EnrollmentMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedProgressEntries returns the removed IDs of the "progress_entries" edge to the ModuleProgress entity.
-func (m *ModuleMutation) RemovedProgressEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedprogress_entries {
-		ids = append(ids, id)
+// OldStatus returns the old "status" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ProgressEntriesIDs returns the "progress_entries" edge IDs in the mutation.
-func (m *ModuleMutation) ProgressEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.progress_entries {
-		ids = append(ids, id)
-	}
-	return
+// ResetStatus resets all changes to the "status" field.
+func (m *EnrollmentMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ResetProgressEntries resets all changes to the "progress_entries" edge.
-func (m *ModuleMutation) ResetProgressEntries() {
-	m.progress_entries = nil
-	m.clearedprogress_entries = false
-	m.removedprogress_entries = nil
+// SetProgress sets the "progress" field.
+func (m *EnrollmentMutation) SetProgress(f float32) {
+	m.progress = &f
+	m.addprogress = nil
 }
 
-// Where appends a list predicates to the ModuleMutation builder.
-func (m *ModuleMutation) Where(ps ...predicate.Module) {
-	m.predicates = append(m.predicates, ps...)
+// Progress returns the value of the "progress" field in the mutation.
+func (m *EnrollmentMutation) Progress() (r float32, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the ModuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Module, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldProgress returns the old "progress" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldProgress(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
 }
 
-// Op returns the operation name.
-func (m *ModuleMutation) Op() Op {
-	return m.op
+// AddProgress adds f to the "progress" field.
+func (m *EnrollmentMutation) AddProgress(f float32) {
+	if m.addprogress != nil {
+		*m.addprogress += f
+	} else {
+		m.addprogress = &f
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModuleMutation) SetOp(op Op) {
-	m.op = op
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *EnrollmentMutation) AddedProgress() (r float32, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (Module).
-func (m *ModuleMutation) Type() string {
-	return m.typ
+// ResetProgress resets all changes to the "progress" field.
+func (m *EnrollmentMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModuleMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.course != nil {
-		fields = append(fields, module.FieldCourseID)
-	}
-	if m.content != nil {
-		fields = append(fields, module.FieldContentID)
+// SetStartedAt sets the "started_at" field.
+func (m *EnrollmentMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *EnrollmentMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
 	}
-	if m.title != nil {
-		fields = append(fields, module.FieldTitle)
-	}
-	if m.module_type != nil {
-		fields = append(fields, module.FieldModuleType)
-	}
-	if m.position != nil {
-		fields = append(fields, module.FieldPosition)
-	}
-	if m.duration_seconds != nil {
-		fields = append(fields, module.FieldDurationSeconds)
-	}
-	if m.status != nil {
-		fields = append(fields, module.FieldStatus)
-	}
-	if m.data != nil {
-		fields = append(fields, module.FieldData)
-	}
-	if m.created_at != nil {
-		fields = append(fields, module.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, module.FieldUpdatedAt)
-	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModuleMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case module.FieldCourseID:
-		return m.CourseID()
-	case module.FieldContentID:
-		return m.ContentID()
-	case module.FieldTitle:
-		return m.Title()
-	case module.FieldModuleType:
-		return m.ModuleType()
-	case module.FieldPosition:
-		return m.Position()
-	case module.FieldDurationSeconds:
-		return m.DurationSeconds()
-	case module.FieldStatus:
-		return m.Status()
-	case module.FieldData:
-		return m.Data()
-	case module.FieldCreatedAt:
-		return m.CreatedAt()
-	case module.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldStartedAt returns the old "started_at" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case module.FieldCourseID:
-		return m.OldCourseID(ctx)
-	case module.FieldContentID:
-		return m.OldContentID(ctx)
-	case module.FieldTitle:
-		return m.OldTitle(ctx)
-	case module.FieldModuleType:
-		return m.OldModuleType(ctx)
-	case module.FieldPosition:
-		return m.OldPosition(ctx)
-	case module.FieldDurationSeconds:
-		return m.OldDurationSeconds(ctx)
-	case module.FieldStatus:
-		return m.OldStatus(ctx)
-	case module.FieldData:
-		return m.OldData(ctx)
-	case module.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case module.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Module field %s", name)
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *EnrollmentMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[enrollment.FieldStartedAt] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModuleMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case module.FieldCourseID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCourseID(v)
-		return nil
-	case module.FieldContentID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentID(v)
-		return nil
-	case module.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case module.FieldModuleType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModuleType(v)
-		return nil
-	case module.FieldPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPosition(v)
-		return nil
-	case module.FieldDurationSeconds:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationSeconds(v)
-		return nil
-	case module.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case module.FieldData:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetData(v)
-		return nil
-	case module.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case module.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Module field %s", name)
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *EnrollmentMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[enrollment.FieldStartedAt]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModuleMutation) AddedFields() []string {
-	var fields []string
-	if m.addposition != nil {
-		fields = append(fields, module.FieldPosition)
-	}
-	if m.addduration_seconds != nil {
-		fields = append(fields, module.FieldDurationSeconds)
-	}
-	return fields
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *EnrollmentMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, enrollment.FieldStartedAt)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModuleMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case module.FieldPosition:
-		return m.AddedPosition()
-	case module.FieldDurationSeconds:
-		return m.AddedDurationSeconds()
-	}
-	return nil, false
+// SetCompletedAt sets the "completed_at" field.
+func (m *EnrollmentMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModuleMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case module.FieldPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPosition(v)
-		return nil
-	case module.FieldDurationSeconds:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationSeconds(v)
-		return nil
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *EnrollmentMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Module numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModuleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(module.FieldContentID) {
-		fields = append(fields, module.FieldContentID)
+// OldCompletedAt returns the old "completed_at" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(module.FieldDurationSeconds) {
-		fields = append(fields, module.FieldDurationSeconds)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(module.FieldData) {
-		fields = append(fields, module.FieldData)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return fields
+	return oldValue.CompletedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModuleMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *EnrollmentMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[enrollment.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *EnrollmentMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[enrollment.FieldCompletedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModuleMutation) ClearField(name string) error {
-	switch name {
-	case module.FieldContentID:
-		m.ClearContentID()
-		return nil
-	case module.FieldDurationSeconds:
-		m.ClearDurationSeconds()
-		return nil
-	case module.FieldData:
-		m.ClearData()
-		return nil
-	}
-	return fmt.Errorf("unknown Module nullable field %s", name)
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *EnrollmentMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, enrollment.FieldCompletedAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModuleMutation) ResetField(name string) error {
-	switch name {
-	case module.FieldCourseID:
-		m.ResetCourseID()
-		return nil
-	case module.FieldContentID:
-		m.ResetContentID()
-		return nil
-	case module.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case module.FieldModuleType:
-		m.ResetModuleType()
-		return nil
-	case module.FieldPosition:
-		m.ResetPosition()
-		return nil
-	case module.FieldDurationSeconds:
-		m.ResetDurationSeconds()
-		return nil
-	case module.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case module.FieldData:
-		m.ResetData()
-		return nil
-	case module.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case module.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// SetMetadata sets the "metadata" field.
+func (m *EnrollmentMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *EnrollmentMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Module field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModuleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.course != nil {
-		edges = append(edges, module.EdgeCourse)
+// OldMetadata returns the old "metadata" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
-	if m.content != nil {
-		edges = append(edges, module.EdgeContent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
-	if m.progress_entries != nil {
-		edges = append(edges, module.EdgeProgressEntries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return edges
+	return oldValue.Metadata, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModuleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case module.EdgeCourse:
-		if id := m.course; id != nil {
-			return []ent.Value{*id}
-		}
-	case module.EdgeContent:
-		if id := m.content; id != nil {
-			return []ent.Value{*id}
-		}
-	case module.EdgeProgressEntries:
-		ids := make([]ent.Value, 0, len(m.progress_entries))
-		for id := range m.progress_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *EnrollmentMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[enrollment.FieldMetadata] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModuleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedprogress_entries != nil {
-		edges = append(edges, module.EdgeProgressEntries)
-	}
-	return edges
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *EnrollmentMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[enrollment.FieldMetadata]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModuleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case module.EdgeProgressEntries:
-		ids := make([]ent.Value, 0, len(m.removedprogress_entries))
-		for id := range m.removedprogress_entries {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *EnrollmentMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, enrollment.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnrollmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnrollmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModuleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedcourse {
-		edges = append(edges, module.EdgeCourse)
+// OldCreatedAt returns the old "created_at" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedcontent {
-		edges = append(edges, module.EdgeContent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.clearedprogress_entries {
-		edges = append(edges, module.EdgeProgressEntries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return edges
+	return oldValue.CreatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModuleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case module.EdgeCourse:
-		return m.clearedcourse
-	case module.EdgeContent:
-		return m.clearedcontent
-	case module.EdgeProgressEntries:
-		return m.clearedprogress_entries
-	}
-	return false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnrollmentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModuleMutation) ClearEdge(name string) error {
-	switch name {
-	case module.EdgeCourse:
-		m.ClearCourse()
-		return nil
-	case module.EdgeContent:
-		m.ClearContent()
-		return nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnrollmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnrollmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Module unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModuleMutation) ResetEdge(name string) error {
-	switch name {
-	case module.EdgeCourse:
-		m.ResetCourse()
-		return nil
-	case module.EdgeContent:
-		m.ResetContent()
-		return nil
-	case module.EdgeProgressEntries:
-		m.ResetProgressEntries()
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the Enrollment entity.
+// If the Enrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Module edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ModuleProgressMutation represents an operation that mutates the ModuleProgress nodes in the graph.
-type ModuleProgressMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	status            *string
-	score             *float32
-	addscore          *float32
-	attempts          *int
-	addattempts       *int
-	started_at        *time.Time
-	completed_at      *time.Time
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	enrollment        *uuid.UUID
-	clearedenrollment bool
-	module            *uuid.UUID
-	clearedmodule     bool
-	done              bool
-	oldValue          func(context.Context) (*ModuleProgress, error)
-	predicates        []predicate.ModuleProgress
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnrollmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-var _ ent.Mutation = (*ModuleProgressMutation)(nil)
-
-// moduleprogressOption allows management of the mutation configuration using functional options.
-type moduleprogressOption func(*ModuleProgressMutation)
-
-// newModuleProgressMutation creates new mutation for the ModuleProgress entity.
-func newModuleProgressMutation(c config, op Op, opts ...moduleprogressOption) *ModuleProgressMutation {
-	m := &ModuleProgressMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeModuleProgress,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *EnrollmentMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[enrollment.FieldOrganizationID] = struct{}{}
 }
 
-// withModuleProgressID sets the ID field of the mutation.
-func withModuleProgressID(id uuid.UUID) moduleprogressOption {
-	return func(m *ModuleProgressMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ModuleProgress
-		)
-		m.oldValue = func(ctx context.Context) (*ModuleProgress, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ModuleProgress.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *EnrollmentMutation) OrganizationCleared() bool {
+	return m.clearedorganization
 }
 
-// withModuleProgress sets the old ModuleProgress of the mutation.
-func withModuleProgress(node *ModuleProgress) moduleprogressOption {
-	return func(m *ModuleProgressMutation) {
-		m.oldValue = func(context.Context) (*ModuleProgress, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *EnrollmentMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModuleProgressMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *EnrollmentMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ModuleProgressMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearCourse clears the "course" edge to the Course entity.
+func (m *EnrollmentMutation) ClearCourse() {
+	m.clearedcourse = true
+	m.clearedFields[enrollment.FieldCourseID] = struct{}{}
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModuleProgress entities.
-func (m *ModuleProgressMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// CourseCleared reports if the "course" edge to the Course entity was cleared.
+func (m *EnrollmentMutation) CourseCleared() bool {
+	return m.clearedcourse
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ModuleProgressMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// CourseIDs returns the "course" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CourseID instead. It exists only for internal usage by the builders.
+func (m *EnrollmentMutation) CourseIDs() (ids []uuid.UUID) {
+	if id := m.course; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ModuleProgressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModuleProgress.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCourse resets all changes to the "course" edge.
+func (m *EnrollmentMutation) ResetCourse() {
+	m.course = nil
+	m.clearedcourse = false
 }
 
-// SetEnrollmentID sets the "enrollment_id" field.
-func (m *ModuleProgressMutation) SetEnrollmentID(u uuid.UUID) {
-	m.enrollment = &u
+// ClearUser clears the "user" edge to the User entity.
+func (m *EnrollmentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[enrollment.FieldUserID] = struct{}{}
 }
 
-// EnrollmentID returns the value of the "enrollment_id" field in the mutation.
-func (m *ModuleProgressMutation) EnrollmentID() (r uuid.UUID, exists bool) {
-	v := m.enrollment
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EnrollmentMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldEnrollmentID returns the old "enrollment_id" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldEnrollmentID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnrollmentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnrollmentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnrollmentID: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EnrollmentMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.EnrollmentID, nil
+	return
 }
 
-// ResetEnrollmentID resets all changes to the "enrollment_id" field.
-func (m *ModuleProgressMutation) ResetEnrollmentID() {
-	m.enrollment = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *EnrollmentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetModuleID sets the "module_id" field.
-func (m *ModuleProgressMutation) SetModuleID(u uuid.UUID) {
-	m.module = &u
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *EnrollmentMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[enrollment.FieldGroupID] = struct{}{}
 }
 
-// ModuleID returns the value of the "module_id" field in the mutation.
-func (m *ModuleProgressMutation) ModuleID() (r uuid.UUID, exists bool) {
-	v := m.module
-	if v == nil {
-		return
-	}
-	return *v, true
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *EnrollmentMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
 }
 
-// OldModuleID returns the old "module_id" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldModuleID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModuleID is only allowed on UpdateOne operations")
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *EnrollmentMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModuleID requires an ID field in the mutation")
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *EnrollmentMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddProgressEntryIDs adds the "progress_entries" edge to the ModuleProgress entity by ids.
+func (m *EnrollmentMutation) AddProgressEntryIDs(ids ...uuid.UUID) {
+	if m.progress_entries == nil {
+		m.progress_entries = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModuleID: %w", err)
+	for i := range ids {
+		m.progress_entries[ids[i]] = struct{}{}
 	}
-	return oldValue.ModuleID, nil
 }
 
-// ResetModuleID resets all changes to the "module_id" field.
-func (m *ModuleProgressMutation) ResetModuleID() {
-	m.module = nil
+// ClearProgressEntries clears the "progress_entries" edge to the ModuleProgress entity.
+func (m *EnrollmentMutation) ClearProgressEntries() {
+	m.clearedprogress_entries = true
 }
 
-// SetStatus sets the "status" field.
-func (m *ModuleProgressMutation) SetStatus(s string) {
-	m.status = &s
+// ProgressEntriesCleared reports if the "progress_entries" edge to the ModuleProgress entity was cleared.
+func (m *EnrollmentMutation) ProgressEntriesCleared() bool {
+	return m.clearedprogress_entries
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ModuleProgressMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// RemoveProgressEntryIDs removes the "progress_entries" edge to the ModuleProgress entity by IDs.
+func (m *EnrollmentMutation) RemoveProgressEntryIDs(ids ...uuid.UUID) {
+	if m.removedprogress_entries == nil {
+		m.removedprogress_entries = make(map[uuid.UUID]struct{})
 	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	for i := range ids {
+		delete(m.progress_entries, ids[i])
+		m.removedprogress_entries[ids[i]] = struct{}{}
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *ModuleProgressMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetScore sets the "score" field.
-func (m *ModuleProgressMutation) SetScore(f float32) {
-	m.score = &f
-	m.addscore = nil
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *ModuleProgressMutation) Score() (r float32, exists bool) {
-	v := m.score
-	if v == nil {
-		return
+// RemovedProgressEntries returns the removed IDs of the "progress_entries" edge to the ModuleProgress entity.
+func (m *EnrollmentMutation) RemovedProgressEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedprogress_entries {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldScore returns the old "score" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldScore(ctx context.Context) (v float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+// ProgressEntriesIDs returns the "progress_entries" edge IDs in the mutation.
+func (m *EnrollmentMutation) ProgressEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.progress_entries {
+		ids = append(ids, id)
 	}
-	return oldValue.Score, nil
+	return
 }
 
-// AddScore adds f to the "score" field.
-func (m *ModuleProgressMutation) AddScore(f float32) {
-	if m.addscore != nil {
-		*m.addscore += f
-	} else {
-		m.addscore = &f
-	}
+// ResetProgressEntries resets all changes to the "progress_entries" edge.
+func (m *EnrollmentMutation) ResetProgressEntries() {
+	m.progress_entries = nil
+	m.clearedprogress_entries = false
+	m.removedprogress_entries = nil
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *ModuleProgressMutation) AddedScore() (r float32, exists bool) {
-	v := m.addscore
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the EnrollmentMutation builder.
+func (m *EnrollmentMutation) Where(ps ...predicate.Enrollment) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearScore clears the value of the "score" field.
-func (m *ModuleProgressMutation) ClearScore() {
-	m.score = nil
-	m.addscore = nil
-	m.clearedFields[moduleprogress.FieldScore] = struct{}{}
+// WhereP appends storage-level predicates to the EnrollmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnrollmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Enrollment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ScoreCleared returns if the "score" field was cleared in this mutation.
-func (m *ModuleProgressMutation) ScoreCleared() bool {
-	_, ok := m.clearedFields[moduleprogress.FieldScore]
-	return ok
+// Op returns the operation name.
+func (m *EnrollmentMutation) Op() Op {
+	return m.op
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *ModuleProgressMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
-	delete(m.clearedFields, moduleprogress.FieldScore)
+// SetOp allows setting the mutation operation.
+func (m *EnrollmentMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *ModuleProgressMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// Type returns the node type of this mutation (Enrollment).
+func (m *EnrollmentMutation) Type() string {
+	return m.typ
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *ModuleProgressMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnrollmentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.organization != nil {
+		fields = append(fields, enrollment.FieldOrganizationID)
 	}
-	return *v, true
-}
-
-// OldAttempts returns the old "attempts" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldAttempts(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	if m.course != nil {
+		fields = append(fields, enrollment.FieldCourseID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	if m.user != nil {
+		fields = append(fields, enrollment.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	if m.group != nil {
+		fields = append(fields, enrollment.FieldGroupID)
 	}
-	return oldValue.Attempts, nil
-}
-
-// AddAttempts adds i to the "attempts" field.
-func (m *ModuleProgressMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
+	if m.status != nil {
+		fields = append(fields, enrollment.FieldStatus)
 	}
-}
-
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *ModuleProgressMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
-	if v == nil {
-		return
+	if m.progress != nil {
+		fields = append(fields, enrollment.FieldProgress)
 	}
-	return *v, true
-}
-
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *ModuleProgressMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
-}
-
-// SetStartedAt sets the "started_at" field.
-func (m *ModuleProgressMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
-}
-
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *ModuleProgressMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
-	if v == nil {
-		return
+	if m.started_at != nil {
+		fields = append(fields, enrollment.FieldStartedAt)
 	}
-	return *v, true
-}
-
-// OldStartedAt returns the old "started_at" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	if m.completed_at != nil {
+		fields = append(fields, enrollment.FieldCompletedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	if m.metadata != nil {
+		fields = append(fields, enrollment.FieldMetadata)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, enrollment.FieldCreatedAt)
 	}
-	return oldValue.StartedAt, nil
-}
-
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *ModuleProgressMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[moduleprogress.FieldStartedAt] = struct{}{}
+	if m.updated_at != nil {
+		fields = append(fields, enrollment.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *ModuleProgressMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[moduleprogress.FieldStartedAt]
-	return ok
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnrollmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case enrollment.FieldOrganizationID:
+		return m.OrganizationID()
+	case enrollment.FieldCourseID:
+		return m.CourseID()
+	case enrollment.FieldUserID:
+		return m.UserID()
+	case enrollment.FieldGroupID:
+		return m.GroupID()
+	case enrollment.FieldStatus:
+		return m.Status()
+	case enrollment.FieldProgress:
+		return m.Progress()
+	case enrollment.FieldStartedAt:
+		return m.StartedAt()
+	case enrollment.FieldCompletedAt:
+		return m.CompletedAt()
+	case enrollment.FieldMetadata:
+		return m.Metadata()
+	case enrollment.FieldCreatedAt:
+		return m.CreatedAt()
+	case enrollment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *ModuleProgressMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, moduleprogress.FieldStartedAt)
-}
-
-// SetCompletedAt sets the "completed_at" field.
-func (m *ModuleProgressMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
-}
-
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *ModuleProgressMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnrollmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case enrollment.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case enrollment.FieldCourseID:
+		return m.OldCourseID(ctx)
+	case enrollment.FieldUserID:
+		return m.OldUserID(ctx)
+	case enrollment.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case enrollment.FieldStatus:
+		return m.OldStatus(ctx)
+	case enrollment.FieldProgress:
+		return m.OldProgress(ctx)
+	case enrollment.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case enrollment.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case enrollment.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case enrollment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case enrollment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Enrollment field %s", name)
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case enrollment.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case enrollment.FieldCourseID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCourseID(v)
+		return nil
+	case enrollment.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case enrollment.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case enrollment.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case enrollment.FieldProgress:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case enrollment.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case enrollment.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case enrollment.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case enrollment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case enrollment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.CompletedAt, nil
-}
-
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *ModuleProgressMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[moduleprogress.FieldCompletedAt] = struct{}{}
-}
-
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *ModuleProgressMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[moduleprogress.FieldCompletedAt]
-	return ok
+	return fmt.Errorf("unknown Enrollment field %s", name)
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *ModuleProgressMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, moduleprogress.FieldCompletedAt)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnrollmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, enrollment.FieldProgress)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ModuleProgressMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnrollmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case enrollment.FieldProgress:
+		return m.AddedProgress()
+	}
+	return nil, false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ModuleProgressMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case enrollment.FieldProgress:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Enrollment numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnrollmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(enrollment.FieldGroupID) {
+		fields = append(fields, enrollment.FieldGroupID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(enrollment.FieldStartedAt) {
+		fields = append(fields, enrollment.FieldStartedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(enrollment.FieldCompletedAt) {
+		fields = append(fields, enrollment.FieldCompletedAt)
 	}
-	return oldValue.CreatedAt, nil
+	if m.FieldCleared(enrollment.FieldMetadata) {
+		fields = append(fields, enrollment.FieldMetadata)
+	}
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ModuleProgressMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnrollmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ModuleProgressMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnrollmentMutation) ClearField(name string) error {
+	switch name {
+	case enrollment.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	case enrollment.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case enrollment.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case enrollment.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Enrollment nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ModuleProgressMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnrollmentMutation) ResetField(name string) error {
+	switch name {
+	case enrollment.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case enrollment.FieldCourseID:
+		m.ResetCourseID()
+		return nil
+	case enrollment.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case enrollment.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case enrollment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case enrollment.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case enrollment.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case enrollment.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case enrollment.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case enrollment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case enrollment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Enrollment field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ModuleProgress entity.
-// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnrollmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.organization != nil {
+		edges = append(edges, enrollment.EdgeOrganization)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.course != nil {
+		edges = append(edges, enrollment.EdgeCourse)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.user != nil {
+		edges = append(edges, enrollment.EdgeUser)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ModuleProgressMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
-func (m *ModuleProgressMutation) ClearEnrollment() {
-	m.clearedenrollment = true
-	m.clearedFields[moduleprogress.FieldEnrollmentID] = struct{}{}
-}
-
-// EnrollmentCleared reports if the "enrollment" edge to the Enrollment entity was cleared.
-func (m *ModuleProgressMutation) EnrollmentCleared() bool {
-	return m.clearedenrollment
-}
-
-// EnrollmentIDs returns the "enrollment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnrollmentID instead. It exists only for internal usage by the builders.
-func (m *ModuleProgressMutation) EnrollmentIDs() (ids []uuid.UUID) {
-	if id := m.enrollment; id != nil {
-		ids = append(ids, *id)
+	if m.group != nil {
+		edges = append(edges, enrollment.EdgeGroup)
 	}
-	return
-}
-
-// ResetEnrollment resets all changes to the "enrollment" edge.
-func (m *ModuleProgressMutation) ResetEnrollment() {
-	m.enrollment = nil
-	m.clearedenrollment = false
-}
-
-// ClearModule clears the "module" edge to the Module entity.
-func (m *ModuleProgressMutation) ClearModule() {
-	m.clearedmodule = true
-	m.clearedFields[moduleprogress.FieldModuleID] = struct{}{}
-}
-
-// ModuleCleared reports if the "module" edge to the Module entity was cleared.
-func (m *ModuleProgressMutation) ModuleCleared() bool {
-	return m.clearedmodule
-}
-
-// ModuleIDs returns the "module" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModuleID instead. It exists only for internal usage by the builders.
-func (m *ModuleProgressMutation) ModuleIDs() (ids []uuid.UUID) {
-	if id := m.module; id != nil {
-		ids = append(ids, *id)
+	if m.progress_entries != nil {
+		edges = append(edges, enrollment.EdgeProgressEntries)
 	}
-	return
-}
-
-// ResetModule resets all changes to the "module" edge.
-func (m *ModuleProgressMutation) ResetModule() {
-	m.module = nil
-	m.clearedmodule = false
-}
-
-// Where appends a list predicates to the ModuleProgressMutation builder.
-func (m *ModuleProgressMutation) Where(ps ...predicate.ModuleProgress) {
-	m.predicates = append(m.predicates, ps...)
+	return edges
 }
 
-// WhereP appends storage-level predicates to the ModuleProgressMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModuleProgressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModuleProgress, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnrollmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case enrollment.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case enrollment.EdgeCourse:
+		if id := m.course; id != nil {
+			return []ent.Value{*id}
+		}
+	case enrollment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case enrollment.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case enrollment.EdgeProgressEntries:
+		ids := make([]ent.Value, 0, len(m.progress_entries))
+		for id := range m.progress_entries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ModuleProgressMutation) Op() Op {
-	return m.op
+	return nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModuleProgressMutation) SetOp(op Op) {
-	m.op = op
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnrollmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedprogress_entries != nil {
+		edges = append(edges, enrollment.EdgeProgressEntries)
+	}
+	return edges
 }
 
-// Type returns the node type of this mutation (ModuleProgress).
-func (m *ModuleProgressMutation) Type() string {
-	return m.typ
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnrollmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case enrollment.EdgeProgressEntries:
+		ids := make([]ent.Value, 0, len(m.removedprogress_entries))
+		for id := range m.removedprogress_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModuleProgressMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.enrollment != nil {
-		fields = append(fields, moduleprogress.FieldEnrollmentID)
-	}
-	if m.module != nil {
-		fields = append(fields, moduleprogress.FieldModuleID)
-	}
-	if m.status != nil {
-		fields = append(fields, moduleprogress.FieldStatus)
-	}
-	if m.score != nil {
-		fields = append(fields, moduleprogress.FieldScore)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnrollmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedorganization {
+		edges = append(edges, enrollment.EdgeOrganization)
 	}
-	if m.attempts != nil {
-		fields = append(fields, moduleprogress.FieldAttempts)
+	if m.clearedcourse {
+		edges = append(edges, enrollment.EdgeCourse)
 	}
-	if m.started_at != nil {
-		fields = append(fields, moduleprogress.FieldStartedAt)
+	if m.cleareduser {
+		edges = append(edges, enrollment.EdgeUser)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, moduleprogress.FieldCompletedAt)
+	if m.clearedgroup {
+		edges = append(edges, enrollment.EdgeGroup)
 	}
-	if m.created_at != nil {
-		fields = append(fields, moduleprogress.FieldCreatedAt)
+	if m.clearedprogress_entries {
+		edges = append(edges, enrollment.EdgeProgressEntries)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, moduleprogress.FieldUpdatedAt)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnrollmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case enrollment.EdgeOrganization:
+		return m.clearedorganization
+	case enrollment.EdgeCourse:
+		return m.clearedcourse
+	case enrollment.EdgeUser:
+		return m.cleareduser
+	case enrollment.EdgeGroup:
+		return m.clearedgroup
+	case enrollment.EdgeProgressEntries:
+		return m.clearedprogress_entries
 	}
-	return fields
+	return false
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModuleProgressMutation) Field(name string) (ent.Value, bool) {
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnrollmentMutation) ClearEdge(name string) error {
 	switch name {
-	case moduleprogress.FieldEnrollmentID:
-		return m.EnrollmentID()
-	case moduleprogress.FieldModuleID:
-		return m.ModuleID()
-	case moduleprogress.FieldStatus:
-		return m.Status()
-	case moduleprogress.FieldScore:
-		return m.Score()
-	case moduleprogress.FieldAttempts:
-		return m.Attempts()
-	case moduleprogress.FieldStartedAt:
-		return m.StartedAt()
-	case moduleprogress.FieldCompletedAt:
-		return m.CompletedAt()
-	case moduleprogress.FieldCreatedAt:
-		return m.CreatedAt()
-	case moduleprogress.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModuleProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case moduleprogress.FieldEnrollmentID:
-		return m.OldEnrollmentID(ctx)
-	case moduleprogress.FieldModuleID:
-		return m.OldModuleID(ctx)
-	case moduleprogress.FieldStatus:
-		return m.OldStatus(ctx)
-	case moduleprogress.FieldScore:
-		return m.OldScore(ctx)
-	case moduleprogress.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case moduleprogress.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case moduleprogress.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case moduleprogress.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case moduleprogress.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown ModuleProgress field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModuleProgressMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case moduleprogress.FieldEnrollmentID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnrollmentID(v)
+	case enrollment.EdgeOrganization:
+		m.ClearOrganization()
 		return nil
-	case moduleprogress.FieldModuleID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModuleID(v)
+	case enrollment.EdgeCourse:
+		m.ClearCourse()
 		return nil
-	case moduleprogress.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+	case enrollment.EdgeUser:
+		m.ClearUser()
 		return nil
-	case moduleprogress.FieldScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScore(v)
+	case enrollment.EdgeGroup:
+		m.ClearGroup()
 		return nil
-	case moduleprogress.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttempts(v)
+	}
+	return fmt.Errorf("unknown Enrollment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnrollmentMutation) ResetEdge(name string) error {
+	switch name {
+	case enrollment.EdgeOrganization:
+		m.ResetOrganization()
 		return nil
-	case moduleprogress.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
+	case enrollment.EdgeCourse:
+		m.ResetCourse()
 		return nil
-	case moduleprogress.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
+	case enrollment.EdgeUser:
+		m.ResetUser()
 		return nil
-	case moduleprogress.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+	case enrollment.EdgeGroup:
+		m.ResetGroup()
 		return nil
-	case moduleprogress.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+	case enrollment.EdgeProgressEntries:
+		m.ResetProgressEntries()
 		return nil
 	}
-	return fmt.Errorf("unknown ModuleProgress field %s", name)
+	return fmt.Errorf("unknown Enrollment edge %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModuleProgressMutation) AddedFields() []string {
-	var fields []string
-	if m.addscore != nil {
-		fields = append(fields, moduleprogress.FieldScore)
-	}
-	if m.addattempts != nil {
-		fields = append(fields, moduleprogress.FieldAttempts)
-	}
-	return fields
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	description         *string
+	capacity            *int
+	addcapacity         *int
+	external_id         *string
+	metadata            *map[string]interface{}
+	version             *int
+	addversion          *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	course              *uuid.UUID
+	clearedcourse       bool
+	enrollments         map[uuid.UUID]struct{}
+	removedenrollments  map[uuid.UUID]struct{}
+	clearedenrollments  bool
+	members             map[uuid.UUID]struct{}
+	removedmembers      map[uuid.UUID]struct{}
+	clearedmembers      bool
+	done                bool
+	oldValue            func(context.Context) (*Group, error)
+	predicates          []predicate.Group
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModuleProgressMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case moduleprogress.FieldScore:
-		return m.AddedScore()
-	case moduleprogress.FieldAttempts:
-		return m.AddedAttempts()
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
 	}
-	return nil, false
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModuleProgressMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case moduleprogress.FieldScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddScore(v)
-		return nil
-	case moduleprogress.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id uuid.UUID) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.AddAttempts(v)
-		return nil
+		m.id = &id
 	}
-	return fmt.Errorf("unknown ModuleProgress numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModuleProgressMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(moduleprogress.FieldScore) {
-		fields = append(fields, moduleprogress.FieldScore)
-	}
-	if m.FieldCleared(moduleprogress.FieldStartedAt) {
-		fields = append(fields, moduleprogress.FieldStartedAt)
-	}
-	if m.FieldCleared(moduleprogress.FieldCompletedAt) {
-		fields = append(fields, moduleprogress.FieldCompletedAt)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModuleProgressMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModuleProgressMutation) ClearField(name string) error {
-	switch name {
-	case moduleprogress.FieldScore:
-		m.ClearScore()
-		return nil
-	case moduleprogress.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case moduleprogress.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown ModuleProgress nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModuleProgressMutation) ResetField(name string) error {
-	switch name {
-	case moduleprogress.FieldEnrollmentID:
-		m.ResetEnrollmentID()
-		return nil
-	case moduleprogress.FieldModuleID:
-		m.ResetModuleID()
-		return nil
-	case moduleprogress.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case moduleprogress.FieldScore:
-		m.ResetScore()
-		return nil
-	case moduleprogress.FieldAttempts:
-		m.ResetAttempts()
-		return nil
-	case moduleprogress.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case moduleprogress.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case moduleprogress.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case moduleprogress.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown ModuleProgress field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModuleProgressMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.enrollment != nil {
-		edges = append(edges, moduleprogress.EdgeEnrollment)
-	}
-	if m.module != nil {
-		edges = append(edges, moduleprogress.EdgeModule)
-	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModuleProgressMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case moduleprogress.EdgeEnrollment:
-		if id := m.enrollment; id != nil {
-			return []ent.Value{*id}
-		}
-	case moduleprogress.EdgeModule:
-		if id := m.module; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModuleProgressMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModuleProgressMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModuleProgressMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedenrollment {
-		edges = append(edges, moduleprogress.EdgeEnrollment)
-	}
-	if m.clearedmodule {
-		edges = append(edges, moduleprogress.EdgeModule)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModuleProgressMutation) EdgeCleared(name string) bool {
-	switch name {
-	case moduleprogress.EdgeEnrollment:
-		return m.clearedenrollment
-	case moduleprogress.EdgeModule:
-		return m.clearedmodule
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModuleProgressMutation) ClearEdge(name string) error {
-	switch name {
-	case moduleprogress.EdgeEnrollment:
-		m.ClearEnrollment()
-		return nil
-	case moduleprogress.EdgeModule:
-		m.ClearModule()
-		return nil
-	}
-	return fmt.Errorf("unknown ModuleProgress unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModuleProgressMutation) ResetEdge(name string) error {
-	switch name {
-	case moduleprogress.EdgeEnrollment:
-		m.ResetEnrollment()
-		return nil
-	case moduleprogress.EdgeModule:
-		m.ResetModule()
-		return nil
-	}
-	return fmt.Errorf("unknown ModuleProgress edge %s", name)
-}
-
-// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
-type OrganizationMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	name               *string
-	slug               *string
-	status             *string
-	settings           *map[string]interface{}
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	users              map[uuid.UUID]struct{}
-	removedusers       map[uuid.UUID]struct{}
-	clearedusers       bool
-	contents           map[uuid.UUID]struct{}
-	removedcontents    map[uuid.UUID]struct{}
-	clearedcontents    bool
-	courses            map[uuid.UUID]struct{}
-	removedcourses     map[uuid.UUID]struct{}
-	clearedcourses     bool
-	groups             map[uuid.UUID]struct{}
-	removedgroups      map[uuid.UUID]struct{}
-	clearedgroups      bool
-	enrollments        map[uuid.UUID]struct{}
-	removedenrollments map[uuid.UUID]struct{}
-	clearedenrollments bool
-	done               bool
-	oldValue           func(context.Context) (*Organization, error)
-	predicates         []predicate.Organization
-}
-
-var _ ent.Mutation = (*OrganizationMutation)(nil)
-
-// organizationOption allows management of the mutation configuration using functional options.
-type organizationOption func(*OrganizationMutation)
-
-// newOrganizationMutation creates new mutation for the Organization entity.
-func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
-	m := &OrganizationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeOrganization,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withOrganizationID sets the ID field of the mutation.
-func withOrganizationID(id uuid.UUID) organizationOption {
-	return func(m *OrganizationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Organization
-		)
-		m.oldValue = func(ctx context.Context) (*Organization, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Organization.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withOrganization sets the old Organization of the mutation.
-func withOrganization(node *Organization) organizationOption {
-	return func(m *OrganizationMutation) {
-		m.oldValue = func(context.Context) (*Organization, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrganizationMutation) Client() *Client {
+func (m GroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6738,7 +6956,7 @@ func (m OrganizationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrganizationMutation) Tx() (*Tx, error) {
+func (m GroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6748,14 +6966,14 @@ func (m OrganizationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Organization entities.
-func (m *OrganizationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Group entities.
+func (m *GroupMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6766,7 +6984,7 @@ func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6775,520 +6993,12216 @@ func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *OrganizationMutation) SetName(s string) {
-	m.name = &s
+// SetOrganizationID sets the "organization_id" field.
+func (m *GroupMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OrganizationMutation) Name() (r string, exists bool) {
-	v := m.name
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *GroupMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OrganizationID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OrganizationMutation) ResetName() {
-	m.name = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *GroupMutation) ResetOrganizationID() {
+	m.organization = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *OrganizationMutation) SetSlug(s string) {
-	m.slug = &s
+// SetCourseID sets the "course_id" field.
+func (m *GroupMutation) SetCourseID(u uuid.UUID) {
+	m.course = &u
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *OrganizationMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// CourseID returns the value of the "course_id" field in the mutation.
+func (m *GroupMutation) CourseID() (r uuid.UUID, exists bool) {
+	v := m.course
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// OldCourseID returns the old "course_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldCourseID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldCourseID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.CourseID, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *OrganizationMutation) ResetSlug() {
-	m.slug = nil
+// ClearCourseID clears the value of the "course_id" field.
+func (m *GroupMutation) ClearCourseID() {
+	m.course = nil
+	m.clearedFields[group.FieldCourseID] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *OrganizationMutation) SetStatus(s string) {
-	m.status = &s
+// CourseIDCleared returns if the "course_id" field was cleared in this mutation.
+func (m *GroupMutation) CourseIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldCourseID]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *OrganizationMutation) Status() (r string, exists bool) {
-	v := m.status
+// ResetCourseID resets all changes to the "course_id" field.
+func (m *GroupMutation) ResetCourseID() {
+	m.course = nil
+	delete(m.clearedFields, group.FieldCourseID)
+}
+
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[group.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[group.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, group.FieldDescription)
+}
+
+// SetCapacity sets the "capacity" field.
+func (m *GroupMutation) SetCapacity(i int) {
+	m.capacity = &i
+	m.addcapacity = nil
+}
+
+// Capacity returns the value of the "capacity" field in the mutation.
+func (m *GroupMutation) Capacity() (r int, exists bool) {
+	v := m.capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapacity returns the old "capacity" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCapacity(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacity: %w", err)
+	}
+	return oldValue.Capacity, nil
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (m *GroupMutation) AddCapacity(i int) {
+	if m.addcapacity != nil {
+		*m.addcapacity += i
+	} else {
+		m.addcapacity = &i
+	}
+}
+
+// AddedCapacity returns the value that was added to the "capacity" field in this mutation.
+func (m *GroupMutation) AddedCapacity() (r int, exists bool) {
+	v := m.addcapacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCapacity clears the value of the "capacity" field.
+func (m *GroupMutation) ClearCapacity() {
+	m.capacity = nil
+	m.addcapacity = nil
+	m.clearedFields[group.FieldCapacity] = struct{}{}
+}
+
+// CapacityCleared returns if the "capacity" field was cleared in this mutation.
+func (m *GroupMutation) CapacityCleared() bool {
+	_, ok := m.clearedFields[group.FieldCapacity]
+	return ok
+}
+
+// ResetCapacity resets all changes to the "capacity" field.
+func (m *GroupMutation) ResetCapacity() {
+	m.capacity = nil
+	m.addcapacity = nil
+	delete(m.clearedFields, group.FieldCapacity)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *GroupMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *GroupMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *GroupMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[group.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *GroupMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *GroupMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, group.FieldExternalID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *GroupMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *GroupMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *GroupMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[group.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *GroupMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[group.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *GroupMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, group.FieldMetadata)
+}
+
+// SetVersion sets the "version" field.
+func (m *GroupMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *GroupMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *GroupMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *GroupMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *GroupMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *GroupMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[group.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *GroupMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *GroupMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (m *GroupMutation) ClearCourse() {
+	m.clearedcourse = true
+	m.clearedFields[group.FieldCourseID] = struct{}{}
+}
+
+// CourseCleared reports if the "course" edge to the Course entity was cleared.
+func (m *GroupMutation) CourseCleared() bool {
+	return m.CourseIDCleared() || m.clearedcourse
+}
+
+// CourseIDs returns the "course" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CourseID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) CourseIDs() (ids []uuid.UUID) {
+	if id := m.course; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCourse resets all changes to the "course" edge.
+func (m *GroupMutation) ResetCourse() {
+	m.course = nil
+	m.clearedcourse = false
+}
+
+// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
+func (m *GroupMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
+	if m.enrollments == nil {
+		m.enrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.enrollments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
+func (m *GroupMutation) ClearEnrollments() {
+	m.clearedenrollments = true
+}
+
+// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
+func (m *GroupMutation) EnrollmentsCleared() bool {
+	return m.clearedenrollments
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
+func (m *GroupMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
+	if m.removedenrollments == nil {
+		m.removedenrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.enrollments, ids[i])
+		m.removedenrollments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
+func (m *GroupMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedenrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
+func (m *GroupMutation) EnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.enrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnrollments resets all changes to the "enrollments" edge.
+func (m *GroupMutation) ResetEnrollments() {
+	m.enrollments = nil
+	m.clearedenrollments = false
+	m.removedenrollments = nil
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by ids.
+func (m *GroupMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the User entity.
+func (m *GroupMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the User entity was cleared.
+func (m *GroupMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the User entity.
+func (m *GroupMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *GroupMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *GroupMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.organization != nil {
+		fields = append(fields, group.FieldOrganizationID)
+	}
+	if m.course != nil {
+		fields = append(fields, group.FieldCourseID)
+	}
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.capacity != nil {
+		fields = append(fields, group.FieldCapacity)
+	}
+	if m.external_id != nil {
+		fields = append(fields, group.FieldExternalID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, group.FieldMetadata)
+	}
+	if m.version != nil {
+		fields = append(fields, group.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldOrganizationID:
+		return m.OrganizationID()
+	case group.FieldCourseID:
+		return m.CourseID()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	case group.FieldCapacity:
+		return m.Capacity()
+	case group.FieldExternalID:
+		return m.ExternalID()
+	case group.FieldMetadata:
+		return m.Metadata()
+	case group.FieldVersion:
+		return m.Version()
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case group.FieldCourseID:
+		return m.OldCourseID(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	case group.FieldCapacity:
+		return m.OldCapacity(ctx)
+	case group.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case group.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case group.FieldVersion:
+		return m.OldVersion(ctx)
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case group.FieldCourseID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCourseID(v)
+		return nil
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case group.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacity(v)
+		return nil
+	case group.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case group.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case group.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addcapacity != nil {
+		fields = append(fields, group.FieldCapacity)
+	}
+	if m.addversion != nil {
+		fields = append(fields, group.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldCapacity:
+		return m.AddedCapacity()
+	case group.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacity(v)
+		return nil
+	case group.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldCourseID) {
+		fields = append(fields, group.FieldCourseID)
+	}
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.FieldCleared(group.FieldCapacity) {
+		fields = append(fields, group.FieldCapacity)
+	}
+	if m.FieldCleared(group.FieldExternalID) {
+		fields = append(fields, group.FieldExternalID)
+	}
+	if m.FieldCleared(group.FieldMetadata) {
+		fields = append(fields, group.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldCourseID:
+		m.ClearCourseID()
+		return nil
+	case group.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case group.FieldCapacity:
+		m.ClearCapacity()
+		return nil
+	case group.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case group.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case group.FieldCourseID:
+		m.ResetCourseID()
+		return nil
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case group.FieldCapacity:
+		m.ResetCapacity()
+		return nil
+	case group.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case group.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case group.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.organization != nil {
+		edges = append(edges, group.EdgeOrganization)
+	}
+	if m.course != nil {
+		edges = append(edges, group.EdgeCourse)
+	}
+	if m.enrollments != nil {
+		edges = append(edges, group.EdgeEnrollments)
+	}
+	if m.members != nil {
+		edges = append(edges, group.EdgeMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case group.EdgeCourse:
+		if id := m.course; id != nil {
+			return []ent.Value{*id}
+		}
+	case group.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.enrollments))
+		for id := range m.enrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedenrollments != nil {
+		edges = append(edges, group.EdgeEnrollments)
+	}
+	if m.removedmembers != nil {
+		edges = append(edges, group.EdgeMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.removedenrollments))
+		for id := range m.removedenrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedorganization {
+		edges = append(edges, group.EdgeOrganization)
+	}
+	if m.clearedcourse {
+		edges = append(edges, group.EdgeCourse)
+	}
+	if m.clearedenrollments {
+		edges = append(edges, group.EdgeEnrollments)
+	}
+	if m.clearedmembers {
+		edges = append(edges, group.EdgeMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeOrganization:
+		return m.clearedorganization
+	case group.EdgeCourse:
+		return m.clearedcourse
+	case group.EdgeEnrollments:
+		return m.clearedenrollments
+	case group.EdgeMembers:
+		return m.clearedmembers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	case group.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case group.EdgeCourse:
+		m.ClearCourse()
+		return nil
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case group.EdgeCourse:
+		m.ResetCourse()
+		return nil
+	case group.EdgeEnrollments:
+		m.ResetEnrollments()
+		return nil
+	case group.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	key            *string
+	fingerprint    *string
+	status_code    *int
+	addstatus_code *int
+	header         *map[string][]string
+	body           *[]byte
+	created_at     *time.Time
+	expires_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*IdempotencyKey, error)
+	predicates     []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *IdempotencyKeyMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *IdempotencyKeyMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *IdempotencyKeyMutation) ResetFingerprint() {
+	m.fingerprint = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *IdempotencyKeyMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *IdempotencyKeyMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *IdempotencyKeyMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *IdempotencyKeyMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *IdempotencyKeyMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+}
+
+// SetHeader sets the "header" field.
+func (m *IdempotencyKeyMutation) SetHeader(value map[string][]string) {
+	m.header = &value
+}
+
+// Header returns the value of the "header" field in the mutation.
+func (m *IdempotencyKeyMutation) Header() (r map[string][]string, exists bool) {
+	v := m.header
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeader returns the old "header" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldHeader(ctx context.Context) (v map[string][]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeader is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeader requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeader: %w", err)
+	}
+	return oldValue.Header, nil
+}
+
+// ClearHeader clears the value of the "header" field.
+func (m *IdempotencyKeyMutation) ClearHeader() {
+	m.header = nil
+	m.clearedFields[idempotencykey.FieldHeader] = struct{}{}
+}
+
+// HeaderCleared returns if the "header" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) HeaderCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldHeader]
+	return ok
+}
+
+// ResetHeader resets all changes to the "header" field.
+func (m *IdempotencyKeyMutation) ResetHeader() {
+	m.header = nil
+	delete(m.clearedFields, idempotencykey.FieldHeader)
+}
+
+// SetBody sets the "body" field.
+func (m *IdempotencyKeyMutation) SetBody(b []byte) {
+	m.body = &b
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *IdempotencyKeyMutation) Body() (r []byte, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldBody(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *IdempotencyKeyMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[idempotencykey.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *IdempotencyKeyMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, idempotencykey.FieldBody)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, idempotencykey.FieldFingerprint)
+	}
+	if m.status_code != nil {
+		fields = append(fields, idempotencykey.FieldStatusCode)
+	}
+	if m.header != nil {
+		fields = append(fields, idempotencykey.FieldHeader)
+	}
+	if m.body != nil {
+		fields = append(fields, idempotencykey.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldFingerprint:
+		return m.Fingerprint()
+	case idempotencykey.FieldStatusCode:
+		return m.StatusCode()
+	case idempotencykey.FieldHeader:
+		return m.Header()
+	case idempotencykey.FieldBody:
+		return m.Body()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case idempotencykey.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case idempotencykey.FieldHeader:
+		return m.OldHeader(ctx)
+	case idempotencykey.FieldBody:
+		return m.OldBody(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case idempotencykey.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case idempotencykey.FieldHeader:
+		v, ok := value.(map[string][]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeader(v)
+		return nil
+	case idempotencykey.FieldBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, idempotencykey.FieldStatusCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldHeader) {
+		fields = append(fields, idempotencykey.FieldHeader)
+	}
+	if m.FieldCleared(idempotencykey.FieldBody) {
+		fields = append(fields, idempotencykey.FieldBody)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldHeader:
+		m.ClearHeader()
+		return nil
+	case idempotencykey.FieldBody:
+		m.ClearBody()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case idempotencykey.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case idempotencykey.FieldHeader:
+		m.ResetHeader()
+		return nil
+	case idempotencykey.FieldBody:
+		m.ResetBody()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// ModuleMutation represents an operation that mutates the Module nodes in the graph.
+type ModuleMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	content_revision        *int
+	addcontent_revision     *int
+	title                   *string
+	module_type             *string
+	position                *int
+	addposition             *int
+	duration_seconds        *int
+	addduration_seconds     *int
+	status                  *string
+	data                    *map[string]interface{}
+	version                 *int
+	addversion              *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	course                  *uuid.UUID
+	clearedcourse           bool
+	content                 *uuid.UUID
+	clearedcontent          bool
+	progress_entries        map[uuid.UUID]struct{}
+	removedprogress_entries map[uuid.UUID]struct{}
+	clearedprogress_entries bool
+	done                    bool
+	oldValue                func(context.Context) (*Module, error)
+	predicates              []predicate.Module
+}
+
+var _ ent.Mutation = (*ModuleMutation)(nil)
+
+// moduleOption allows management of the mutation configuration using functional options.
+type moduleOption func(*ModuleMutation)
+
+// newModuleMutation creates new mutation for the Module entity.
+func newModuleMutation(c config, op Op, opts ...moduleOption) *ModuleMutation {
+	m := &ModuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModuleID sets the ID field of the mutation.
+func withModuleID(id uuid.UUID) moduleOption {
+	return func(m *ModuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Module
+		)
+		m.oldValue = func(ctx context.Context) (*Module, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Module.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModule sets the old Module of the mutation.
+func withModule(node *Module) moduleOption {
+	return func(m *ModuleMutation) {
+		m.oldValue = func(context.Context) (*Module, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Module entities.
+func (m *ModuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Module.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCourseID sets the "course_id" field.
+func (m *ModuleMutation) SetCourseID(u uuid.UUID) {
+	m.course = &u
+}
+
+// CourseID returns the value of the "course_id" field in the mutation.
+func (m *ModuleMutation) CourseID() (r uuid.UUID, exists bool) {
+	v := m.course
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCourseID returns the old "course_id" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldCourseID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCourseID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCourseID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCourseID: %w", err)
+	}
+	return oldValue.CourseID, nil
+}
+
+// ResetCourseID resets all changes to the "course_id" field.
+func (m *ModuleMutation) ResetCourseID() {
+	m.course = nil
+}
+
+// SetContentID sets the "content_id" field.
+func (m *ModuleMutation) SetContentID(u uuid.UUID) {
+	m.content = &u
+}
+
+// ContentID returns the value of the "content_id" field in the mutation.
+func (m *ModuleMutation) ContentID() (r uuid.UUID, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentID returns the old "content_id" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldContentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentID: %w", err)
+	}
+	return oldValue.ContentID, nil
+}
+
+// ClearContentID clears the value of the "content_id" field.
+func (m *ModuleMutation) ClearContentID() {
+	m.content = nil
+	m.clearedFields[module.FieldContentID] = struct{}{}
+}
+
+// ContentIDCleared returns if the "content_id" field was cleared in this mutation.
+func (m *ModuleMutation) ContentIDCleared() bool {
+	_, ok := m.clearedFields[module.FieldContentID]
+	return ok
+}
+
+// ResetContentID resets all changes to the "content_id" field.
+func (m *ModuleMutation) ResetContentID() {
+	m.content = nil
+	delete(m.clearedFields, module.FieldContentID)
+}
+
+// SetContentRevision sets the "content_revision" field.
+func (m *ModuleMutation) SetContentRevision(i int) {
+	m.content_revision = &i
+	m.addcontent_revision = nil
+}
+
+// ContentRevision returns the value of the "content_revision" field in the mutation.
+func (m *ModuleMutation) ContentRevision() (r int, exists bool) {
+	v := m.content_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentRevision returns the old "content_revision" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldContentRevision(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentRevision: %w", err)
+	}
+	return oldValue.ContentRevision, nil
+}
+
+// AddContentRevision adds i to the "content_revision" field.
+func (m *ModuleMutation) AddContentRevision(i int) {
+	if m.addcontent_revision != nil {
+		*m.addcontent_revision += i
+	} else {
+		m.addcontent_revision = &i
+	}
+}
+
+// AddedContentRevision returns the value that was added to the "content_revision" field in this mutation.
+func (m *ModuleMutation) AddedContentRevision() (r int, exists bool) {
+	v := m.addcontent_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContentRevision clears the value of the "content_revision" field.
+func (m *ModuleMutation) ClearContentRevision() {
+	m.content_revision = nil
+	m.addcontent_revision = nil
+	m.clearedFields[module.FieldContentRevision] = struct{}{}
+}
+
+// ContentRevisionCleared returns if the "content_revision" field was cleared in this mutation.
+func (m *ModuleMutation) ContentRevisionCleared() bool {
+	_, ok := m.clearedFields[module.FieldContentRevision]
+	return ok
+}
+
+// ResetContentRevision resets all changes to the "content_revision" field.
+func (m *ModuleMutation) ResetContentRevision() {
+	m.content_revision = nil
+	m.addcontent_revision = nil
+	delete(m.clearedFields, module.FieldContentRevision)
+}
+
+// SetTitle sets the "title" field.
+func (m *ModuleMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ModuleMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ModuleMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetModuleType sets the "module_type" field.
+func (m *ModuleMutation) SetModuleType(s string) {
+	m.module_type = &s
+}
+
+// ModuleType returns the value of the "module_type" field in the mutation.
+func (m *ModuleMutation) ModuleType() (r string, exists bool) {
+	v := m.module_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModuleType returns the old "module_type" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldModuleType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModuleType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModuleType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModuleType: %w", err)
+	}
+	return oldValue.ModuleType, nil
+}
+
+// ResetModuleType resets all changes to the "module_type" field.
+func (m *ModuleMutation) ResetModuleType() {
+	m.module_type = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ModuleMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ModuleMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *ModuleMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ModuleMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *ModuleMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *ModuleMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
+}
+
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *ModuleMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	}
+	return oldValue.DurationSeconds, nil
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *ModuleMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
+	}
+}
+
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *ModuleMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (m *ModuleMutation) ClearDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+	m.clearedFields[module.FieldDurationSeconds] = struct{}{}
+}
+
+// DurationSecondsCleared returns if the "duration_seconds" field was cleared in this mutation.
+func (m *ModuleMutation) DurationSecondsCleared() bool {
+	_, ok := m.clearedFields[module.FieldDurationSeconds]
+	return ok
+}
+
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *ModuleMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+	delete(m.clearedFields, module.FieldDurationSeconds)
+}
+
+// SetStatus sets the "status" field.
+func (m *ModuleMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ModuleMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ModuleMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetData sets the "data" field.
+func (m *ModuleMutation) SetData(value map[string]interface{}) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ModuleMutation) Data() (r map[string]interface{}, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *ModuleMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[module.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *ModuleMutation) DataCleared() bool {
+	_, ok := m.clearedFields[module.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *ModuleMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, module.FieldData)
+}
+
+// SetVersion sets the "version" field.
+func (m *ModuleMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ModuleMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ModuleMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ModuleMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ModuleMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (m *ModuleMutation) ClearCourse() {
+	m.clearedcourse = true
+	m.clearedFields[module.FieldCourseID] = struct{}{}
+}
+
+// CourseCleared reports if the "course" edge to the Course entity was cleared.
+func (m *ModuleMutation) CourseCleared() bool {
+	return m.clearedcourse
+}
+
+// CourseIDs returns the "course" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CourseID instead. It exists only for internal usage by the builders.
+func (m *ModuleMutation) CourseIDs() (ids []uuid.UUID) {
+	if id := m.course; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCourse resets all changes to the "course" edge.
+func (m *ModuleMutation) ResetCourse() {
+	m.course = nil
+	m.clearedcourse = false
+}
+
+// ClearContent clears the "content" edge to the Content entity.
+func (m *ModuleMutation) ClearContent() {
+	m.clearedcontent = true
+	m.clearedFields[module.FieldContentID] = struct{}{}
+}
+
+// ContentCleared reports if the "content" edge to the Content entity was cleared.
+func (m *ModuleMutation) ContentCleared() bool {
+	return m.ContentIDCleared() || m.clearedcontent
+}
+
+// ContentIDs returns the "content" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentID instead. It exists only for internal usage by the builders.
+func (m *ModuleMutation) ContentIDs() (ids []uuid.UUID) {
+	if id := m.content; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContent resets all changes to the "content" edge.
+func (m *ModuleMutation) ResetContent() {
+	m.content = nil
+	m.clearedcontent = false
+}
+
+// AddProgressEntryIDs adds the "progress_entries" edge to the ModuleProgress entity by ids.
+func (m *ModuleMutation) AddProgressEntryIDs(ids ...uuid.UUID) {
+	if m.progress_entries == nil {
+		m.progress_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.progress_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProgressEntries clears the "progress_entries" edge to the ModuleProgress entity.
+func (m *ModuleMutation) ClearProgressEntries() {
+	m.clearedprogress_entries = true
+}
+
+// ProgressEntriesCleared reports if the "progress_entries" edge to the ModuleProgress entity was cleared.
+func (m *ModuleMutation) ProgressEntriesCleared() bool {
+	return m.clearedprogress_entries
+}
+
+// RemoveProgressEntryIDs removes the "progress_entries" edge to the ModuleProgress entity by IDs.
+func (m *ModuleMutation) RemoveProgressEntryIDs(ids ...uuid.UUID) {
+	if m.removedprogress_entries == nil {
+		m.removedprogress_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.progress_entries, ids[i])
+		m.removedprogress_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProgressEntries returns the removed IDs of the "progress_entries" edge to the ModuleProgress entity.
+func (m *ModuleMutation) RemovedProgressEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedprogress_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProgressEntriesIDs returns the "progress_entries" edge IDs in the mutation.
+func (m *ModuleMutation) ProgressEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.progress_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProgressEntries resets all changes to the "progress_entries" edge.
+func (m *ModuleMutation) ResetProgressEntries() {
+	m.progress_entries = nil
+	m.clearedprogress_entries = false
+	m.removedprogress_entries = nil
+}
+
+// Where appends a list predicates to the ModuleMutation builder.
+func (m *ModuleMutation) Where(ps ...predicate.Module) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Module, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Module).
+func (m *ModuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModuleMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.course != nil {
+		fields = append(fields, module.FieldCourseID)
+	}
+	if m.content != nil {
+		fields = append(fields, module.FieldContentID)
+	}
+	if m.content_revision != nil {
+		fields = append(fields, module.FieldContentRevision)
+	}
+	if m.title != nil {
+		fields = append(fields, module.FieldTitle)
+	}
+	if m.module_type != nil {
+		fields = append(fields, module.FieldModuleType)
+	}
+	if m.position != nil {
+		fields = append(fields, module.FieldPosition)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, module.FieldDurationSeconds)
+	}
+	if m.status != nil {
+		fields = append(fields, module.FieldStatus)
+	}
+	if m.data != nil {
+		fields = append(fields, module.FieldData)
+	}
+	if m.version != nil {
+		fields = append(fields, module.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, module.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, module.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case module.FieldCourseID:
+		return m.CourseID()
+	case module.FieldContentID:
+		return m.ContentID()
+	case module.FieldContentRevision:
+		return m.ContentRevision()
+	case module.FieldTitle:
+		return m.Title()
+	case module.FieldModuleType:
+		return m.ModuleType()
+	case module.FieldPosition:
+		return m.Position()
+	case module.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case module.FieldStatus:
+		return m.Status()
+	case module.FieldData:
+		return m.Data()
+	case module.FieldVersion:
+		return m.Version()
+	case module.FieldCreatedAt:
+		return m.CreatedAt()
+	case module.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case module.FieldCourseID:
+		return m.OldCourseID(ctx)
+	case module.FieldContentID:
+		return m.OldContentID(ctx)
+	case module.FieldContentRevision:
+		return m.OldContentRevision(ctx)
+	case module.FieldTitle:
+		return m.OldTitle(ctx)
+	case module.FieldModuleType:
+		return m.OldModuleType(ctx)
+	case module.FieldPosition:
+		return m.OldPosition(ctx)
+	case module.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case module.FieldStatus:
+		return m.OldStatus(ctx)
+	case module.FieldData:
+		return m.OldData(ctx)
+	case module.FieldVersion:
+		return m.OldVersion(ctx)
+	case module.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case module.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Module field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case module.FieldCourseID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCourseID(v)
+		return nil
+	case module.FieldContentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentID(v)
+		return nil
+	case module.FieldContentRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentRevision(v)
+		return nil
+	case module.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case module.FieldModuleType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModuleType(v)
+		return nil
+	case module.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case module.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case module.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case module.FieldData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case module.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case module.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case module.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Module field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addcontent_revision != nil {
+		fields = append(fields, module.FieldContentRevision)
+	}
+	if m.addposition != nil {
+		fields = append(fields, module.FieldPosition)
+	}
+	if m.addduration_seconds != nil {
+		fields = append(fields, module.FieldDurationSeconds)
+	}
+	if m.addversion != nil {
+		fields = append(fields, module.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case module.FieldContentRevision:
+		return m.AddedContentRevision()
+	case module.FieldPosition:
+		return m.AddedPosition()
+	case module.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	case module.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case module.FieldContentRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContentRevision(v)
+		return nil
+	case module.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case module.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	case module.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Module numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(module.FieldContentID) {
+		fields = append(fields, module.FieldContentID)
+	}
+	if m.FieldCleared(module.FieldContentRevision) {
+		fields = append(fields, module.FieldContentRevision)
+	}
+	if m.FieldCleared(module.FieldDurationSeconds) {
+		fields = append(fields, module.FieldDurationSeconds)
+	}
+	if m.FieldCleared(module.FieldData) {
+		fields = append(fields, module.FieldData)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModuleMutation) ClearField(name string) error {
+	switch name {
+	case module.FieldContentID:
+		m.ClearContentID()
+		return nil
+	case module.FieldContentRevision:
+		m.ClearContentRevision()
+		return nil
+	case module.FieldDurationSeconds:
+		m.ClearDurationSeconds()
+		return nil
+	case module.FieldData:
+		m.ClearData()
+		return nil
+	}
+	return fmt.Errorf("unknown Module nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModuleMutation) ResetField(name string) error {
+	switch name {
+	case module.FieldCourseID:
+		m.ResetCourseID()
+		return nil
+	case module.FieldContentID:
+		m.ResetContentID()
+		return nil
+	case module.FieldContentRevision:
+		m.ResetContentRevision()
+		return nil
+	case module.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case module.FieldModuleType:
+		m.ResetModuleType()
+		return nil
+	case module.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case module.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case module.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case module.FieldData:
+		m.ResetData()
+		return nil
+	case module.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case module.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case module.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Module field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.course != nil {
+		edges = append(edges, module.EdgeCourse)
+	}
+	if m.content != nil {
+		edges = append(edges, module.EdgeContent)
+	}
+	if m.progress_entries != nil {
+		edges = append(edges, module.EdgeProgressEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case module.EdgeCourse:
+		if id := m.course; id != nil {
+			return []ent.Value{*id}
+		}
+	case module.EdgeContent:
+		if id := m.content; id != nil {
+			return []ent.Value{*id}
+		}
+	case module.EdgeProgressEntries:
+		ids := make([]ent.Value, 0, len(m.progress_entries))
+		for id := range m.progress_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedprogress_entries != nil {
+		edges = append(edges, module.EdgeProgressEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case module.EdgeProgressEntries:
+		ids := make([]ent.Value, 0, len(m.removedprogress_entries))
+		for id := range m.removedprogress_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcourse {
+		edges = append(edges, module.EdgeCourse)
+	}
+	if m.clearedcontent {
+		edges = append(edges, module.EdgeContent)
+	}
+	if m.clearedprogress_entries {
+		edges = append(edges, module.EdgeProgressEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case module.EdgeCourse:
+		return m.clearedcourse
+	case module.EdgeContent:
+		return m.clearedcontent
+	case module.EdgeProgressEntries:
+		return m.clearedprogress_entries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModuleMutation) ClearEdge(name string) error {
+	switch name {
+	case module.EdgeCourse:
+		m.ClearCourse()
+		return nil
+	case module.EdgeContent:
+		m.ClearContent()
+		return nil
+	}
+	return fmt.Errorf("unknown Module unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModuleMutation) ResetEdge(name string) error {
+	switch name {
+	case module.EdgeCourse:
+		m.ResetCourse()
+		return nil
+	case module.EdgeContent:
+		m.ResetContent()
+		return nil
+	case module.EdgeProgressEntries:
+		m.ResetProgressEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown Module edge %s", name)
+}
+
+// ModuleProgressMutation represents an operation that mutates the ModuleProgress nodes in the graph.
+type ModuleProgressMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	status            *string
+	score             *float32
+	addscore          *float32
+	attempts          *int
+	addattempts       *int
+	started_at        *time.Time
+	completed_at      *time.Time
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	enrollment        *uuid.UUID
+	clearedenrollment bool
+	module            *uuid.UUID
+	clearedmodule     bool
+	done              bool
+	oldValue          func(context.Context) (*ModuleProgress, error)
+	predicates        []predicate.ModuleProgress
+}
+
+var _ ent.Mutation = (*ModuleProgressMutation)(nil)
+
+// moduleprogressOption allows management of the mutation configuration using functional options.
+type moduleprogressOption func(*ModuleProgressMutation)
+
+// newModuleProgressMutation creates new mutation for the ModuleProgress entity.
+func newModuleProgressMutation(c config, op Op, opts ...moduleprogressOption) *ModuleProgressMutation {
+	m := &ModuleProgressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModuleProgress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModuleProgressID sets the ID field of the mutation.
+func withModuleProgressID(id uuid.UUID) moduleprogressOption {
+	return func(m *ModuleProgressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModuleProgress
+		)
+		m.oldValue = func(ctx context.Context) (*ModuleProgress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModuleProgress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModuleProgress sets the old ModuleProgress of the mutation.
+func withModuleProgress(node *ModuleProgress) moduleprogressOption {
+	return func(m *ModuleProgressMutation) {
+		m.oldValue = func(context.Context) (*ModuleProgress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModuleProgressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModuleProgressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModuleProgress entities.
+func (m *ModuleProgressMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModuleProgressMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModuleProgressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModuleProgress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (m *ModuleProgressMutation) SetEnrollmentID(u uuid.UUID) {
+	m.enrollment = &u
+}
+
+// EnrollmentID returns the value of the "enrollment_id" field in the mutation.
+func (m *ModuleProgressMutation) EnrollmentID() (r uuid.UUID, exists bool) {
+	v := m.enrollment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnrollmentID returns the old "enrollment_id" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldEnrollmentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnrollmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnrollmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnrollmentID: %w", err)
+	}
+	return oldValue.EnrollmentID, nil
+}
+
+// ResetEnrollmentID resets all changes to the "enrollment_id" field.
+func (m *ModuleProgressMutation) ResetEnrollmentID() {
+	m.enrollment = nil
+}
+
+// SetModuleID sets the "module_id" field.
+func (m *ModuleProgressMutation) SetModuleID(u uuid.UUID) {
+	m.module = &u
+}
+
+// ModuleID returns the value of the "module_id" field in the mutation.
+func (m *ModuleProgressMutation) ModuleID() (r uuid.UUID, exists bool) {
+	v := m.module
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModuleID returns the old "module_id" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldModuleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModuleID: %w", err)
+	}
+	return oldValue.ModuleID, nil
+}
+
+// ResetModuleID resets all changes to the "module_id" field.
+func (m *ModuleProgressMutation) ResetModuleID() {
+	m.module = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ModuleProgressMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ModuleProgressMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ModuleProgressMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetScore sets the "score" field.
+func (m *ModuleProgressMutation) SetScore(f float32) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *ModuleProgressMutation) Score() (r float32, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldScore(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *ModuleProgressMutation) AddScore(f float32) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ModuleProgressMutation) AddedScore() (r float32, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *ModuleProgressMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[moduleprogress.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *ModuleProgressMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[moduleprogress.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *ModuleProgressMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, moduleprogress.FieldScore)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ModuleProgressMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ModuleProgressMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ModuleProgressMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ModuleProgressMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ModuleProgressMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ModuleProgressMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ModuleProgressMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *ModuleProgressMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[moduleprogress.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *ModuleProgressMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[moduleprogress.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ModuleProgressMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, moduleprogress.FieldStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ModuleProgressMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ModuleProgressMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ModuleProgressMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[moduleprogress.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ModuleProgressMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[moduleprogress.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ModuleProgressMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, moduleprogress.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModuleProgressMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModuleProgressMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModuleProgressMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModuleProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModuleProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ModuleProgress entity.
+// If the ModuleProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModuleProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
+func (m *ModuleProgressMutation) ClearEnrollment() {
+	m.clearedenrollment = true
+	m.clearedFields[moduleprogress.FieldEnrollmentID] = struct{}{}
+}
+
+// EnrollmentCleared reports if the "enrollment" edge to the Enrollment entity was cleared.
+func (m *ModuleProgressMutation) EnrollmentCleared() bool {
+	return m.clearedenrollment
+}
+
+// EnrollmentIDs returns the "enrollment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnrollmentID instead. It exists only for internal usage by the builders.
+func (m *ModuleProgressMutation) EnrollmentIDs() (ids []uuid.UUID) {
+	if id := m.enrollment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnrollment resets all changes to the "enrollment" edge.
+func (m *ModuleProgressMutation) ResetEnrollment() {
+	m.enrollment = nil
+	m.clearedenrollment = false
+}
+
+// ClearModule clears the "module" edge to the Module entity.
+func (m *ModuleProgressMutation) ClearModule() {
+	m.clearedmodule = true
+	m.clearedFields[moduleprogress.FieldModuleID] = struct{}{}
+}
+
+// ModuleCleared reports if the "module" edge to the Module entity was cleared.
+func (m *ModuleProgressMutation) ModuleCleared() bool {
+	return m.clearedmodule
+}
+
+// ModuleIDs returns the "module" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModuleID instead. It exists only for internal usage by the builders.
+func (m *ModuleProgressMutation) ModuleIDs() (ids []uuid.UUID) {
+	if id := m.module; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModule resets all changes to the "module" edge.
+func (m *ModuleProgressMutation) ResetModule() {
+	m.module = nil
+	m.clearedmodule = false
+}
+
+// Where appends a list predicates to the ModuleProgressMutation builder.
+func (m *ModuleProgressMutation) Where(ps ...predicate.ModuleProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModuleProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModuleProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModuleProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModuleProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModuleProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModuleProgress).
+func (m *ModuleProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModuleProgressMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.enrollment != nil {
+		fields = append(fields, moduleprogress.FieldEnrollmentID)
+	}
+	if m.module != nil {
+		fields = append(fields, moduleprogress.FieldModuleID)
+	}
+	if m.status != nil {
+		fields = append(fields, moduleprogress.FieldStatus)
+	}
+	if m.score != nil {
+		fields = append(fields, moduleprogress.FieldScore)
+	}
+	if m.attempts != nil {
+		fields = append(fields, moduleprogress.FieldAttempts)
+	}
+	if m.started_at != nil {
+		fields = append(fields, moduleprogress.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, moduleprogress.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moduleprogress.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, moduleprogress.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModuleProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moduleprogress.FieldEnrollmentID:
+		return m.EnrollmentID()
+	case moduleprogress.FieldModuleID:
+		return m.ModuleID()
+	case moduleprogress.FieldStatus:
+		return m.Status()
+	case moduleprogress.FieldScore:
+		return m.Score()
+	case moduleprogress.FieldAttempts:
+		return m.Attempts()
+	case moduleprogress.FieldStartedAt:
+		return m.StartedAt()
+	case moduleprogress.FieldCompletedAt:
+		return m.CompletedAt()
+	case moduleprogress.FieldCreatedAt:
+		return m.CreatedAt()
+	case moduleprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModuleProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moduleprogress.FieldEnrollmentID:
+		return m.OldEnrollmentID(ctx)
+	case moduleprogress.FieldModuleID:
+		return m.OldModuleID(ctx)
+	case moduleprogress.FieldStatus:
+		return m.OldStatus(ctx)
+	case moduleprogress.FieldScore:
+		return m.OldScore(ctx)
+	case moduleprogress.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case moduleprogress.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case moduleprogress.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case moduleprogress.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case moduleprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModuleProgress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModuleProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moduleprogress.FieldEnrollmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnrollmentID(v)
+		return nil
+	case moduleprogress.FieldModuleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModuleID(v)
+		return nil
+	case moduleprogress.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case moduleprogress.FieldScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case moduleprogress.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case moduleprogress.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case moduleprogress.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case moduleprogress.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case moduleprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModuleProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, moduleprogress.FieldScore)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, moduleprogress.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModuleProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case moduleprogress.FieldScore:
+		return m.AddedScore()
+	case moduleprogress.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModuleProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case moduleprogress.FieldScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case moduleprogress.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModuleProgressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moduleprogress.FieldScore) {
+		fields = append(fields, moduleprogress.FieldScore)
+	}
+	if m.FieldCleared(moduleprogress.FieldStartedAt) {
+		fields = append(fields, moduleprogress.FieldStartedAt)
+	}
+	if m.FieldCleared(moduleprogress.FieldCompletedAt) {
+		fields = append(fields, moduleprogress.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModuleProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModuleProgressMutation) ClearField(name string) error {
+	switch name {
+	case moduleprogress.FieldScore:
+		m.ClearScore()
+		return nil
+	case moduleprogress.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case moduleprogress.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModuleProgressMutation) ResetField(name string) error {
+	switch name {
+	case moduleprogress.FieldEnrollmentID:
+		m.ResetEnrollmentID()
+		return nil
+	case moduleprogress.FieldModuleID:
+		m.ResetModuleID()
+		return nil
+	case moduleprogress.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case moduleprogress.FieldScore:
+		m.ResetScore()
+		return nil
+	case moduleprogress.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case moduleprogress.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case moduleprogress.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case moduleprogress.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case moduleprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModuleProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.enrollment != nil {
+		edges = append(edges, moduleprogress.EdgeEnrollment)
+	}
+	if m.module != nil {
+		edges = append(edges, moduleprogress.EdgeModule)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModuleProgressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moduleprogress.EdgeEnrollment:
+		if id := m.enrollment; id != nil {
+			return []ent.Value{*id}
+		}
+	case moduleprogress.EdgeModule:
+		if id := m.module; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModuleProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModuleProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModuleProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenrollment {
+		edges = append(edges, moduleprogress.EdgeEnrollment)
+	}
+	if m.clearedmodule {
+		edges = append(edges, moduleprogress.EdgeModule)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModuleProgressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moduleprogress.EdgeEnrollment:
+		return m.clearedenrollment
+	case moduleprogress.EdgeModule:
+		return m.clearedmodule
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModuleProgressMutation) ClearEdge(name string) error {
+	switch name {
+	case moduleprogress.EdgeEnrollment:
+		m.ClearEnrollment()
+		return nil
+	case moduleprogress.EdgeModule:
+		m.ClearModule()
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModuleProgressMutation) ResetEdge(name string) error {
+	switch name {
+	case moduleprogress.EdgeEnrollment:
+		m.ResetEnrollment()
+		return nil
+	case moduleprogress.EdgeModule:
+		m.ResetModule()
+		return nil
+	}
+	return fmt.Errorf("unknown ModuleProgress edge %s", name)
+}
+
+// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
+type OrganizationMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	name                    *string
+	slug                    *string
+	status                  *string
+	settings                *map[string]interface{}
+	storage_bytes_used      *int64
+	addstorage_bytes_used   *int64
+	storage_objects_used    *int
+	addstorage_objects_used *int
+	storage_alert_level     *int
+	addstorage_alert_level  *int
+	version                 *int
+	addversion              *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	users                   map[uuid.UUID]struct{}
+	removedusers            map[uuid.UUID]struct{}
+	clearedusers            bool
+	contents                map[uuid.UUID]struct{}
+	removedcontents         map[uuid.UUID]struct{}
+	clearedcontents         bool
+	courses                 map[uuid.UUID]struct{}
+	removedcourses          map[uuid.UUID]struct{}
+	clearedcourses          bool
+	groups                  map[uuid.UUID]struct{}
+	removedgroups           map[uuid.UUID]struct{}
+	clearedgroups           bool
+	enrollments             map[uuid.UUID]struct{}
+	removedenrollments      map[uuid.UUID]struct{}
+	clearedenrollments      bool
+	scim_tokens             map[uuid.UUID]struct{}
+	removedscim_tokens      map[uuid.UUID]struct{}
+	clearedscim_tokens      bool
+	service_accounts        map[uuid.UUID]struct{}
+	removedservice_accounts map[uuid.UUID]struct{}
+	clearedservice_accounts bool
+	privacy_requests        map[uuid.UUID]struct{}
+	removedprivacy_requests map[uuid.UUID]struct{}
+	clearedprivacy_requests bool
+	done                    bool
+	oldValue                func(context.Context) (*Organization, error)
+	predicates              []predicate.Organization
+}
+
+var _ ent.Mutation = (*OrganizationMutation)(nil)
+
+// organizationOption allows management of the mutation configuration using functional options.
+type organizationOption func(*OrganizationMutation)
+
+// newOrganizationMutation creates new mutation for the Organization entity.
+func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
+	m := &OrganizationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganization,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationID sets the ID field of the mutation.
+func withOrganizationID(id uuid.UUID) organizationOption {
+	return func(m *OrganizationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Organization
+		)
+		m.oldValue = func(ctx context.Context) (*Organization, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Organization.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganization sets the old Organization of the mutation.
+func withOrganization(node *Organization) organizationOption {
+	return func(m *OrganizationMutation) {
+		m.oldValue = func(context.Context) (*Organization, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Organization entities.
+func (m *OrganizationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *OrganizationMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *OrganizationMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *OrganizationMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OrganizationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrganizationMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrganizationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSettings sets the "settings" field.
+func (m *OrganizationMutation) SetSettings(value map[string]interface{}) {
+	m.settings = &value
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *OrganizationMutation) Settings() (r map[string]interface{}, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *OrganizationMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[organization.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *OrganizationMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[organization.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *OrganizationMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, organization.FieldSettings)
+}
+
+// SetStorageBytesUsed sets the "storage_bytes_used" field.
+func (m *OrganizationMutation) SetStorageBytesUsed(i int64) {
+	m.storage_bytes_used = &i
+	m.addstorage_bytes_used = nil
+}
+
+// StorageBytesUsed returns the value of the "storage_bytes_used" field in the mutation.
+func (m *OrganizationMutation) StorageBytesUsed() (r int64, exists bool) {
+	v := m.storage_bytes_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageBytesUsed returns the old "storage_bytes_used" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldStorageBytesUsed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageBytesUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageBytesUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageBytesUsed: %w", err)
+	}
+	return oldValue.StorageBytesUsed, nil
+}
+
+// AddStorageBytesUsed adds i to the "storage_bytes_used" field.
+func (m *OrganizationMutation) AddStorageBytesUsed(i int64) {
+	if m.addstorage_bytes_used != nil {
+		*m.addstorage_bytes_used += i
+	} else {
+		m.addstorage_bytes_used = &i
+	}
+}
+
+// AddedStorageBytesUsed returns the value that was added to the "storage_bytes_used" field in this mutation.
+func (m *OrganizationMutation) AddedStorageBytesUsed() (r int64, exists bool) {
+	v := m.addstorage_bytes_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageBytesUsed resets all changes to the "storage_bytes_used" field.
+func (m *OrganizationMutation) ResetStorageBytesUsed() {
+	m.storage_bytes_used = nil
+	m.addstorage_bytes_used = nil
+}
+
+// SetStorageObjectsUsed sets the "storage_objects_used" field.
+func (m *OrganizationMutation) SetStorageObjectsUsed(i int) {
+	m.storage_objects_used = &i
+	m.addstorage_objects_used = nil
+}
+
+// StorageObjectsUsed returns the value of the "storage_objects_used" field in the mutation.
+func (m *OrganizationMutation) StorageObjectsUsed() (r int, exists bool) {
+	v := m.storage_objects_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageObjectsUsed returns the old "storage_objects_used" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldStorageObjectsUsed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageObjectsUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageObjectsUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageObjectsUsed: %w", err)
+	}
+	return oldValue.StorageObjectsUsed, nil
+}
+
+// AddStorageObjectsUsed adds i to the "storage_objects_used" field.
+func (m *OrganizationMutation) AddStorageObjectsUsed(i int) {
+	if m.addstorage_objects_used != nil {
+		*m.addstorage_objects_used += i
+	} else {
+		m.addstorage_objects_used = &i
+	}
+}
+
+// AddedStorageObjectsUsed returns the value that was added to the "storage_objects_used" field in this mutation.
+func (m *OrganizationMutation) AddedStorageObjectsUsed() (r int, exists bool) {
+	v := m.addstorage_objects_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageObjectsUsed resets all changes to the "storage_objects_used" field.
+func (m *OrganizationMutation) ResetStorageObjectsUsed() {
+	m.storage_objects_used = nil
+	m.addstorage_objects_used = nil
+}
+
+// SetStorageAlertLevel sets the "storage_alert_level" field.
+func (m *OrganizationMutation) SetStorageAlertLevel(i int) {
+	m.storage_alert_level = &i
+	m.addstorage_alert_level = nil
+}
+
+// StorageAlertLevel returns the value of the "storage_alert_level" field in the mutation.
+func (m *OrganizationMutation) StorageAlertLevel() (r int, exists bool) {
+	v := m.storage_alert_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageAlertLevel returns the old "storage_alert_level" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldStorageAlertLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageAlertLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageAlertLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageAlertLevel: %w", err)
+	}
+	return oldValue.StorageAlertLevel, nil
+}
+
+// AddStorageAlertLevel adds i to the "storage_alert_level" field.
+func (m *OrganizationMutation) AddStorageAlertLevel(i int) {
+	if m.addstorage_alert_level != nil {
+		*m.addstorage_alert_level += i
+	} else {
+		m.addstorage_alert_level = &i
+	}
+}
+
+// AddedStorageAlertLevel returns the value that was added to the "storage_alert_level" field in this mutation.
+func (m *OrganizationMutation) AddedStorageAlertLevel() (r int, exists bool) {
+	v := m.addstorage_alert_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageAlertLevel resets all changes to the "storage_alert_level" field.
+func (m *OrganizationMutation) ResetStorageAlertLevel() {
+	m.storage_alert_level = nil
+	m.addstorage_alert_level = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *OrganizationMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *OrganizationMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *OrganizationMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *OrganizationMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *OrganizationMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *OrganizationMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *OrganizationMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *OrganizationMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *OrganizationMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *OrganizationMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *OrganizationMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *OrganizationMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddContentIDs adds the "contents" edge to the Content entity by ids.
+func (m *OrganizationMutation) AddContentIDs(ids ...uuid.UUID) {
+	if m.contents == nil {
+		m.contents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.contents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContents clears the "contents" edge to the Content entity.
+func (m *OrganizationMutation) ClearContents() {
+	m.clearedcontents = true
+}
+
+// ContentsCleared reports if the "contents" edge to the Content entity was cleared.
+func (m *OrganizationMutation) ContentsCleared() bool {
+	return m.clearedcontents
+}
+
+// RemoveContentIDs removes the "contents" edge to the Content entity by IDs.
+func (m *OrganizationMutation) RemoveContentIDs(ids ...uuid.UUID) {
+	if m.removedcontents == nil {
+		m.removedcontents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.contents, ids[i])
+		m.removedcontents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContents returns the removed IDs of the "contents" edge to the Content entity.
+func (m *OrganizationMutation) RemovedContentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContentsIDs returns the "contents" edge IDs in the mutation.
+func (m *OrganizationMutation) ContentsIDs() (ids []uuid.UUID) {
+	for id := range m.contents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContents resets all changes to the "contents" edge.
+func (m *OrganizationMutation) ResetContents() {
+	m.contents = nil
+	m.clearedcontents = false
+	m.removedcontents = nil
+}
+
+// AddCourseIDs adds the "courses" edge to the Course entity by ids.
+func (m *OrganizationMutation) AddCourseIDs(ids ...uuid.UUID) {
+	if m.courses == nil {
+		m.courses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.courses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCourses clears the "courses" edge to the Course entity.
+func (m *OrganizationMutation) ClearCourses() {
+	m.clearedcourses = true
+}
+
+// CoursesCleared reports if the "courses" edge to the Course entity was cleared.
+func (m *OrganizationMutation) CoursesCleared() bool {
+	return m.clearedcourses
+}
+
+// RemoveCourseIDs removes the "courses" edge to the Course entity by IDs.
+func (m *OrganizationMutation) RemoveCourseIDs(ids ...uuid.UUID) {
+	if m.removedcourses == nil {
+		m.removedcourses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.courses, ids[i])
+		m.removedcourses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCourses returns the removed IDs of the "courses" edge to the Course entity.
+func (m *OrganizationMutation) RemovedCoursesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcourses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CoursesIDs returns the "courses" edge IDs in the mutation.
+func (m *OrganizationMutation) CoursesIDs() (ids []uuid.UUID) {
+	for id := range m.courses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCourses resets all changes to the "courses" edge.
+func (m *OrganizationMutation) ResetCourses() {
+	m.courses = nil
+	m.clearedcourses = false
+	m.removedcourses = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *OrganizationMutation) AddGroupIDs(ids ...uuid.UUID) {
+	if m.groups == nil {
+		m.groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *OrganizationMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *OrganizationMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *OrganizationMutation) RemoveGroupIDs(ids ...uuid.UUID) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *OrganizationMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *OrganizationMutation) GroupsIDs() (ids []uuid.UUID) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *OrganizationMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
+func (m *OrganizationMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
+	if m.enrollments == nil {
+		m.enrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.enrollments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
+func (m *OrganizationMutation) ClearEnrollments() {
+	m.clearedenrollments = true
+}
+
+// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
+func (m *OrganizationMutation) EnrollmentsCleared() bool {
+	return m.clearedenrollments
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
+func (m *OrganizationMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
+	if m.removedenrollments == nil {
+		m.removedenrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.enrollments, ids[i])
+		m.removedenrollments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
+func (m *OrganizationMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedenrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
+func (m *OrganizationMutation) EnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.enrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnrollments resets all changes to the "enrollments" edge.
+func (m *OrganizationMutation) ResetEnrollments() {
+	m.enrollments = nil
+	m.clearedenrollments = false
+	m.removedenrollments = nil
+}
+
+// AddScimTokenIDs adds the "scim_tokens" edge to the ScimToken entity by ids.
+func (m *OrganizationMutation) AddScimTokenIDs(ids ...uuid.UUID) {
+	if m.scim_tokens == nil {
+		m.scim_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.scim_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScimTokens clears the "scim_tokens" edge to the ScimToken entity.
+func (m *OrganizationMutation) ClearScimTokens() {
+	m.clearedscim_tokens = true
+}
+
+// ScimTokensCleared reports if the "scim_tokens" edge to the ScimToken entity was cleared.
+func (m *OrganizationMutation) ScimTokensCleared() bool {
+	return m.clearedscim_tokens
+}
+
+// RemoveScimTokenIDs removes the "scim_tokens" edge to the ScimToken entity by IDs.
+func (m *OrganizationMutation) RemoveScimTokenIDs(ids ...uuid.UUID) {
+	if m.removedscim_tokens == nil {
+		m.removedscim_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.scim_tokens, ids[i])
+		m.removedscim_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScimTokens returns the removed IDs of the "scim_tokens" edge to the ScimToken entity.
+func (m *OrganizationMutation) RemovedScimTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedscim_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScimTokensIDs returns the "scim_tokens" edge IDs in the mutation.
+func (m *OrganizationMutation) ScimTokensIDs() (ids []uuid.UUID) {
+	for id := range m.scim_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScimTokens resets all changes to the "scim_tokens" edge.
+func (m *OrganizationMutation) ResetScimTokens() {
+	m.scim_tokens = nil
+	m.clearedscim_tokens = false
+	m.removedscim_tokens = nil
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the ServiceAccount entity by ids.
+func (m *OrganizationMutation) AddServiceAccountIDs(ids ...uuid.UUID) {
+	if m.service_accounts == nil {
+		m.service_accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.service_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceAccounts clears the "service_accounts" edge to the ServiceAccount entity.
+func (m *OrganizationMutation) ClearServiceAccounts() {
+	m.clearedservice_accounts = true
+}
+
+// ServiceAccountsCleared reports if the "service_accounts" edge to the ServiceAccount entity was cleared.
+func (m *OrganizationMutation) ServiceAccountsCleared() bool {
+	return m.clearedservice_accounts
+}
+
+// RemoveServiceAccountIDs removes the "service_accounts" edge to the ServiceAccount entity by IDs.
+func (m *OrganizationMutation) RemoveServiceAccountIDs(ids ...uuid.UUID) {
+	if m.removedservice_accounts == nil {
+		m.removedservice_accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.service_accounts, ids[i])
+		m.removedservice_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceAccounts returns the removed IDs of the "service_accounts" edge to the ServiceAccount entity.
+func (m *OrganizationMutation) RemovedServiceAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.removedservice_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceAccountsIDs returns the "service_accounts" edge IDs in the mutation.
+func (m *OrganizationMutation) ServiceAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.service_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceAccounts resets all changes to the "service_accounts" edge.
+func (m *OrganizationMutation) ResetServiceAccounts() {
+	m.service_accounts = nil
+	m.clearedservice_accounts = false
+	m.removedservice_accounts = nil
+}
+
+// AddPrivacyRequestIDs adds the "privacy_requests" edge to the PrivacyRequest entity by ids.
+func (m *OrganizationMutation) AddPrivacyRequestIDs(ids ...uuid.UUID) {
+	if m.privacy_requests == nil {
+		m.privacy_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.privacy_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPrivacyRequests clears the "privacy_requests" edge to the PrivacyRequest entity.
+func (m *OrganizationMutation) ClearPrivacyRequests() {
+	m.clearedprivacy_requests = true
+}
+
+// PrivacyRequestsCleared reports if the "privacy_requests" edge to the PrivacyRequest entity was cleared.
+func (m *OrganizationMutation) PrivacyRequestsCleared() bool {
+	return m.clearedprivacy_requests
+}
+
+// RemovePrivacyRequestIDs removes the "privacy_requests" edge to the PrivacyRequest entity by IDs.
+func (m *OrganizationMutation) RemovePrivacyRequestIDs(ids ...uuid.UUID) {
+	if m.removedprivacy_requests == nil {
+		m.removedprivacy_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.privacy_requests, ids[i])
+		m.removedprivacy_requests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPrivacyRequests returns the removed IDs of the "privacy_requests" edge to the PrivacyRequest entity.
+func (m *OrganizationMutation) RemovedPrivacyRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedprivacy_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PrivacyRequestsIDs returns the "privacy_requests" edge IDs in the mutation.
+func (m *OrganizationMutation) PrivacyRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.privacy_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPrivacyRequests resets all changes to the "privacy_requests" edge.
+func (m *OrganizationMutation) ResetPrivacyRequests() {
+	m.privacy_requests = nil
+	m.clearedprivacy_requests = false
+	m.removedprivacy_requests = nil
+}
+
+// Where appends a list predicates to the OrganizationMutation builder.
+func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Organization, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Organization).
+func (m *OrganizationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, organization.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, organization.FieldSlug)
+	}
+	if m.status != nil {
+		fields = append(fields, organization.FieldStatus)
+	}
+	if m.settings != nil {
+		fields = append(fields, organization.FieldSettings)
+	}
+	if m.storage_bytes_used != nil {
+		fields = append(fields, organization.FieldStorageBytesUsed)
+	}
+	if m.storage_objects_used != nil {
+		fields = append(fields, organization.FieldStorageObjectsUsed)
+	}
+	if m.storage_alert_level != nil {
+		fields = append(fields, organization.FieldStorageAlertLevel)
+	}
+	if m.version != nil {
+		fields = append(fields, organization.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organization.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organization.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organization.FieldName:
+		return m.Name()
+	case organization.FieldSlug:
+		return m.Slug()
+	case organization.FieldStatus:
+		return m.Status()
+	case organization.FieldSettings:
+		return m.Settings()
+	case organization.FieldStorageBytesUsed:
+		return m.StorageBytesUsed()
+	case organization.FieldStorageObjectsUsed:
+		return m.StorageObjectsUsed()
+	case organization.FieldStorageAlertLevel:
+		return m.StorageAlertLevel()
+	case organization.FieldVersion:
+		return m.Version()
+	case organization.FieldCreatedAt:
+		return m.CreatedAt()
+	case organization.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organization.FieldName:
+		return m.OldName(ctx)
+	case organization.FieldSlug:
+		return m.OldSlug(ctx)
+	case organization.FieldStatus:
+		return m.OldStatus(ctx)
+	case organization.FieldSettings:
+		return m.OldSettings(ctx)
+	case organization.FieldStorageBytesUsed:
+		return m.OldStorageBytesUsed(ctx)
+	case organization.FieldStorageObjectsUsed:
+		return m.OldStorageObjectsUsed(ctx)
+	case organization.FieldStorageAlertLevel:
+		return m.OldStorageAlertLevel(ctx)
+	case organization.FieldVersion:
+		return m.OldVersion(ctx)
+	case organization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organization.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Organization field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organization.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case organization.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case organization.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case organization.FieldSettings:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case organization.FieldStorageBytesUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageBytesUsed(v)
+		return nil
+	case organization.FieldStorageObjectsUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageObjectsUsed(v)
+		return nil
+	case organization.FieldStorageAlertLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageAlertLevel(v)
+		return nil
+	case organization.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case organization.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organization.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationMutation) AddedFields() []string {
+	var fields []string
+	if m.addstorage_bytes_used != nil {
+		fields = append(fields, organization.FieldStorageBytesUsed)
+	}
+	if m.addstorage_objects_used != nil {
+		fields = append(fields, organization.FieldStorageObjectsUsed)
+	}
+	if m.addstorage_alert_level != nil {
+		fields = append(fields, organization.FieldStorageAlertLevel)
+	}
+	if m.addversion != nil {
+		fields = append(fields, organization.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case organization.FieldStorageBytesUsed:
+		return m.AddedStorageBytesUsed()
+	case organization.FieldStorageObjectsUsed:
+		return m.AddedStorageObjectsUsed()
+	case organization.FieldStorageAlertLevel:
+		return m.AddedStorageAlertLevel()
+	case organization.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case organization.FieldStorageBytesUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageBytesUsed(v)
+		return nil
+	case organization.FieldStorageObjectsUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageObjectsUsed(v)
+		return nil
+	case organization.FieldStorageAlertLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageAlertLevel(v)
+		return nil
+	case organization.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organization numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organization.FieldSettings) {
+		fields = append(fields, organization.FieldSettings)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMutation) ClearField(name string) error {
+	switch name {
+	case organization.FieldSettings:
+		m.ClearSettings()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationMutation) ResetField(name string) error {
+	switch name {
+	case organization.FieldName:
+		m.ResetName()
+		return nil
+	case organization.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case organization.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case organization.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case organization.FieldStorageBytesUsed:
+		m.ResetStorageBytesUsed()
+		return nil
+	case organization.FieldStorageObjectsUsed:
+		m.ResetStorageObjectsUsed()
+		return nil
+	case organization.FieldStorageAlertLevel:
+		m.ResetStorageAlertLevel()
+		return nil
+	case organization.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case organization.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organization.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.users != nil {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.contents != nil {
+		edges = append(edges, organization.EdgeContents)
+	}
+	if m.courses != nil {
+		edges = append(edges, organization.EdgeCourses)
+	}
+	if m.groups != nil {
+		edges = append(edges, organization.EdgeGroups)
+	}
+	if m.enrollments != nil {
+		edges = append(edges, organization.EdgeEnrollments)
+	}
+	if m.scim_tokens != nil {
+		edges = append(edges, organization.EdgeScimTokens)
+	}
+	if m.service_accounts != nil {
+		edges = append(edges, organization.EdgeServiceAccounts)
+	}
+	if m.privacy_requests != nil {
+		edges = append(edges, organization.EdgePrivacyRequests)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeContents:
+		ids := make([]ent.Value, 0, len(m.contents))
+		for id := range m.contents {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeCourses:
+		ids := make([]ent.Value, 0, len(m.courses))
+		for id := range m.courses {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.enrollments))
+		for id := range m.enrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeScimTokens:
+		ids := make([]ent.Value, 0, len(m.scim_tokens))
+		for id := range m.scim_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeServiceAccounts:
+		ids := make([]ent.Value, 0, len(m.service_accounts))
+		for id := range m.service_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgePrivacyRequests:
+		ids := make([]ent.Value, 0, len(m.privacy_requests))
+		for id := range m.privacy_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedusers != nil {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.removedcontents != nil {
+		edges = append(edges, organization.EdgeContents)
+	}
+	if m.removedcourses != nil {
+		edges = append(edges, organization.EdgeCourses)
+	}
+	if m.removedgroups != nil {
+		edges = append(edges, organization.EdgeGroups)
+	}
+	if m.removedenrollments != nil {
+		edges = append(edges, organization.EdgeEnrollments)
+	}
+	if m.removedscim_tokens != nil {
+		edges = append(edges, organization.EdgeScimTokens)
+	}
+	if m.removedservice_accounts != nil {
+		edges = append(edges, organization.EdgeServiceAccounts)
+	}
+	if m.removedprivacy_requests != nil {
+		edges = append(edges, organization.EdgePrivacyRequests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeContents:
+		ids := make([]ent.Value, 0, len(m.removedcontents))
+		for id := range m.removedcontents {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeCourses:
+		ids := make([]ent.Value, 0, len(m.removedcourses))
+		for id := range m.removedcourses {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.removedenrollments))
+		for id := range m.removedenrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeScimTokens:
+		ids := make([]ent.Value, 0, len(m.removedscim_tokens))
+		for id := range m.removedscim_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeServiceAccounts:
+		ids := make([]ent.Value, 0, len(m.removedservice_accounts))
+		for id := range m.removedservice_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgePrivacyRequests:
+		ids := make([]ent.Value, 0, len(m.removedprivacy_requests))
+		for id := range m.removedprivacy_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedusers {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.clearedcontents {
+		edges = append(edges, organization.EdgeContents)
+	}
+	if m.clearedcourses {
+		edges = append(edges, organization.EdgeCourses)
+	}
+	if m.clearedgroups {
+		edges = append(edges, organization.EdgeGroups)
+	}
+	if m.clearedenrollments {
+		edges = append(edges, organization.EdgeEnrollments)
+	}
+	if m.clearedscim_tokens {
+		edges = append(edges, organization.EdgeScimTokens)
+	}
+	if m.clearedservice_accounts {
+		edges = append(edges, organization.EdgeServiceAccounts)
+	}
+	if m.clearedprivacy_requests {
+		edges = append(edges, organization.EdgePrivacyRequests)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organization.EdgeUsers:
+		return m.clearedusers
+	case organization.EdgeContents:
+		return m.clearedcontents
+	case organization.EdgeCourses:
+		return m.clearedcourses
+	case organization.EdgeGroups:
+		return m.clearedgroups
+	case organization.EdgeEnrollments:
+		return m.clearedenrollments
+	case organization.EdgeScimTokens:
+		return m.clearedscim_tokens
+	case organization.EdgeServiceAccounts:
+		return m.clearedservice_accounts
+	case organization.EdgePrivacyRequests:
+		return m.clearedprivacy_requests
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationMutation) ResetEdge(name string) error {
+	switch name {
+	case organization.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case organization.EdgeContents:
+		m.ResetContents()
+		return nil
+	case organization.EdgeCourses:
+		m.ResetCourses()
+		return nil
+	case organization.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	case organization.EdgeEnrollments:
+		m.ResetEnrollments()
+		return nil
+	case organization.EdgeScimTokens:
+		m.ResetScimTokens()
+		return nil
+	case organization.EdgeServiceAccounts:
+		m.ResetServiceAccounts()
+		return nil
+	case organization.EdgePrivacyRequests:
+		m.ResetPrivacyRequests()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization edge %s", name)
+}
+
+// PrivacyRequestMutation represents an operation that mutates the PrivacyRequest nodes in the graph.
+type PrivacyRequestMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *uuid.UUID
+	kind                *string
+	status              *string
+	mode                *string
+	requested_by        *uuid.UUID
+	requested_by_kind   *string
+	scheduled_at        *time.Time
+	started_at          *time.Time
+	completed_at        *time.Time
+	cancelled_at        *time.Time
+	cancelled_by        *uuid.UUID
+	cancelled_by_kind   *string
+	storage_key         *string
+	size_bytes          *int64
+	addsize_bytes       *int64
+	expires_at          *time.Time
+	summary             *map[string]int
+	error               *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*PrivacyRequest, error)
+	predicates          []predicate.PrivacyRequest
+}
+
+var _ ent.Mutation = (*PrivacyRequestMutation)(nil)
+
+// privacyrequestOption allows management of the mutation configuration using functional options.
+type privacyrequestOption func(*PrivacyRequestMutation)
+
+// newPrivacyRequestMutation creates new mutation for the PrivacyRequest entity.
+func newPrivacyRequestMutation(c config, op Op, opts ...privacyrequestOption) *PrivacyRequestMutation {
+	m := &PrivacyRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivacyRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivacyRequestID sets the ID field of the mutation.
+func withPrivacyRequestID(id uuid.UUID) privacyrequestOption {
+	return func(m *PrivacyRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivacyRequest
+		)
+		m.oldValue = func(ctx context.Context) (*PrivacyRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivacyRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivacyRequest sets the old PrivacyRequest of the mutation.
+func withPrivacyRequest(node *PrivacyRequest) privacyrequestOption {
+	return func(m *PrivacyRequestMutation) {
+		m.oldValue = func(context.Context) (*PrivacyRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivacyRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivacyRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PrivacyRequest entities.
+func (m *PrivacyRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivacyRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivacyRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivacyRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *PrivacyRequestMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *PrivacyRequestMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *PrivacyRequestMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PrivacyRequestMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PrivacyRequestMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PrivacyRequestMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *PrivacyRequestMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *PrivacyRequestMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *PrivacyRequestMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PrivacyRequestMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PrivacyRequestMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PrivacyRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *PrivacyRequestMutation) SetMode(s string) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *PrivacyRequestMutation) Mode() (r string, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ClearMode clears the value of the "mode" field.
+func (m *PrivacyRequestMutation) ClearMode() {
+	m.mode = nil
+	m.clearedFields[privacyrequest.FieldMode] = struct{}{}
+}
+
+// ModeCleared returns if the "mode" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) ModeCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldMode]
+	return ok
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *PrivacyRequestMutation) ResetMode() {
+	m.mode = nil
+	delete(m.clearedFields, privacyrequest.FieldMode)
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *PrivacyRequestMutation) SetRequestedBy(u uuid.UUID) {
+	m.requested_by = &u
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *PrivacyRequestMutation) RequestedBy() (r uuid.UUID, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldRequestedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (m *PrivacyRequestMutation) ClearRequestedBy() {
+	m.requested_by = nil
+	m.clearedFields[privacyrequest.FieldRequestedBy] = struct{}{}
+}
+
+// RequestedByCleared returns if the "requested_by" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) RequestedByCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldRequestedBy]
+	return ok
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *PrivacyRequestMutation) ResetRequestedBy() {
+	m.requested_by = nil
+	delete(m.clearedFields, privacyrequest.FieldRequestedBy)
+}
+
+// SetRequestedByKind sets the "requested_by_kind" field.
+func (m *PrivacyRequestMutation) SetRequestedByKind(s string) {
+	m.requested_by_kind = &s
+}
+
+// RequestedByKind returns the value of the "requested_by_kind" field in the mutation.
+func (m *PrivacyRequestMutation) RequestedByKind() (r string, exists bool) {
+	v := m.requested_by_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedByKind returns the old "requested_by_kind" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldRequestedByKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedByKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedByKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedByKind: %w", err)
+	}
+	return oldValue.RequestedByKind, nil
+}
+
+// ClearRequestedByKind clears the value of the "requested_by_kind" field.
+func (m *PrivacyRequestMutation) ClearRequestedByKind() {
+	m.requested_by_kind = nil
+	m.clearedFields[privacyrequest.FieldRequestedByKind] = struct{}{}
+}
+
+// RequestedByKindCleared returns if the "requested_by_kind" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) RequestedByKindCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldRequestedByKind]
+	return ok
+}
+
+// ResetRequestedByKind resets all changes to the "requested_by_kind" field.
+func (m *PrivacyRequestMutation) ResetRequestedByKind() {
+	m.requested_by_kind = nil
+	delete(m.clearedFields, privacyrequest.FieldRequestedByKind)
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *PrivacyRequestMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *PrivacyRequestMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *PrivacyRequestMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *PrivacyRequestMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *PrivacyRequestMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *PrivacyRequestMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[privacyrequest.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *PrivacyRequestMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, privacyrequest.FieldStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *PrivacyRequestMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *PrivacyRequestMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *PrivacyRequestMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[privacyrequest.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *PrivacyRequestMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, privacyrequest.FieldCompletedAt)
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (m *PrivacyRequestMutation) SetCancelledAt(t time.Time) {
+	m.cancelled_at = &t
+}
+
+// CancelledAt returns the value of the "cancelled_at" field in the mutation.
+func (m *PrivacyRequestMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledAt returns the old "cancelled_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
+	}
+	return oldValue.CancelledAt, nil
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (m *PrivacyRequestMutation) ClearCancelledAt() {
+	m.cancelled_at = nil
+	m.clearedFields[privacyrequest.FieldCancelledAt] = struct{}{}
+}
+
+// CancelledAtCleared returns if the "cancelled_at" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldCancelledAt]
+	return ok
+}
+
+// ResetCancelledAt resets all changes to the "cancelled_at" field.
+func (m *PrivacyRequestMutation) ResetCancelledAt() {
+	m.cancelled_at = nil
+	delete(m.clearedFields, privacyrequest.FieldCancelledAt)
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (m *PrivacyRequestMutation) SetCancelledBy(u uuid.UUID) {
+	m.cancelled_by = &u
+}
+
+// CancelledBy returns the value of the "cancelled_by" field in the mutation.
+func (m *PrivacyRequestMutation) CancelledBy() (r uuid.UUID, exists bool) {
+	v := m.cancelled_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledBy returns the old "cancelled_by" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldCancelledBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledBy: %w", err)
+	}
+	return oldValue.CancelledBy, nil
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (m *PrivacyRequestMutation) ClearCancelledBy() {
+	m.cancelled_by = nil
+	m.clearedFields[privacyrequest.FieldCancelledBy] = struct{}{}
+}
+
+// CancelledByCleared returns if the "cancelled_by" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) CancelledByCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldCancelledBy]
+	return ok
+}
+
+// ResetCancelledBy resets all changes to the "cancelled_by" field.
+func (m *PrivacyRequestMutation) ResetCancelledBy() {
+	m.cancelled_by = nil
+	delete(m.clearedFields, privacyrequest.FieldCancelledBy)
+}
+
+// SetCancelledByKind sets the "cancelled_by_kind" field.
+func (m *PrivacyRequestMutation) SetCancelledByKind(s string) {
+	m.cancelled_by_kind = &s
+}
+
+// CancelledByKind returns the value of the "cancelled_by_kind" field in the mutation.
+func (m *PrivacyRequestMutation) CancelledByKind() (r string, exists bool) {
+	v := m.cancelled_by_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledByKind returns the old "cancelled_by_kind" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldCancelledByKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledByKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledByKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledByKind: %w", err)
+	}
+	return oldValue.CancelledByKind, nil
+}
+
+// ClearCancelledByKind clears the value of the "cancelled_by_kind" field.
+func (m *PrivacyRequestMutation) ClearCancelledByKind() {
+	m.cancelled_by_kind = nil
+	m.clearedFields[privacyrequest.FieldCancelledByKind] = struct{}{}
+}
+
+// CancelledByKindCleared returns if the "cancelled_by_kind" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) CancelledByKindCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldCancelledByKind]
+	return ok
+}
+
+// ResetCancelledByKind resets all changes to the "cancelled_by_kind" field.
+func (m *PrivacyRequestMutation) ResetCancelledByKind() {
+	m.cancelled_by_kind = nil
+	delete(m.clearedFields, privacyrequest.FieldCancelledByKind)
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *PrivacyRequestMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *PrivacyRequestMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldStorageKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (m *PrivacyRequestMutation) ClearStorageKey() {
+	m.storage_key = nil
+	m.clearedFields[privacyrequest.FieldStorageKey] = struct{}{}
+}
+
+// StorageKeyCleared returns if the "storage_key" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) StorageKeyCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldStorageKey]
+	return ok
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *PrivacyRequestMutation) ResetStorageKey() {
+	m.storage_key = nil
+	delete(m.clearedFields, privacyrequest.FieldStorageKey)
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (m *PrivacyRequestMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
+}
+
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *PrivacyRequestMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeBytes returns the old "size_bytes" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+	}
+	return oldValue.SizeBytes, nil
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *PrivacyRequestMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
+	}
+}
+
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *PrivacyRequestMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *PrivacyRequestMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PrivacyRequestMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PrivacyRequestMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *PrivacyRequestMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[privacyrequest.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PrivacyRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, privacyrequest.FieldExpiresAt)
+}
+
+// SetSummary sets the "summary" field.
+func (m *PrivacyRequestMutation) SetSummary(value map[string]int) {
+	m.summary = &value
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *PrivacyRequestMutation) Summary() (r map[string]int, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldSummary(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *PrivacyRequestMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[privacyrequest.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *PrivacyRequestMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, privacyrequest.FieldSummary)
+}
+
+// SetError sets the "error" field.
+func (m *PrivacyRequestMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *PrivacyRequestMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *PrivacyRequestMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[privacyrequest.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *PrivacyRequestMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[privacyrequest.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *PrivacyRequestMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, privacyrequest.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrivacyRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrivacyRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrivacyRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PrivacyRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PrivacyRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PrivacyRequest entity.
+// If the PrivacyRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PrivacyRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *PrivacyRequestMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[privacyrequest.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *PrivacyRequestMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *PrivacyRequestMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *PrivacyRequestMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the PrivacyRequestMutation builder.
+func (m *PrivacyRequestMutation) Where(ps ...predicate.PrivacyRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivacyRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivacyRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivacyRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivacyRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivacyRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivacyRequest).
+func (m *PrivacyRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivacyRequestMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.organization != nil {
+		fields = append(fields, privacyrequest.FieldOrganizationID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, privacyrequest.FieldUserID)
+	}
+	if m.kind != nil {
+		fields = append(fields, privacyrequest.FieldKind)
+	}
+	if m.status != nil {
+		fields = append(fields, privacyrequest.FieldStatus)
+	}
+	if m.mode != nil {
+		fields = append(fields, privacyrequest.FieldMode)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, privacyrequest.FieldRequestedBy)
+	}
+	if m.requested_by_kind != nil {
+		fields = append(fields, privacyrequest.FieldRequestedByKind)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, privacyrequest.FieldScheduledAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, privacyrequest.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, privacyrequest.FieldCompletedAt)
+	}
+	if m.cancelled_at != nil {
+		fields = append(fields, privacyrequest.FieldCancelledAt)
+	}
+	if m.cancelled_by != nil {
+		fields = append(fields, privacyrequest.FieldCancelledBy)
+	}
+	if m.cancelled_by_kind != nil {
+		fields = append(fields, privacyrequest.FieldCancelledByKind)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, privacyrequest.FieldStorageKey)
+	}
+	if m.size_bytes != nil {
+		fields = append(fields, privacyrequest.FieldSizeBytes)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, privacyrequest.FieldExpiresAt)
+	}
+	if m.summary != nil {
+		fields = append(fields, privacyrequest.FieldSummary)
+	}
+	if m.error != nil {
+		fields = append(fields, privacyrequest.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, privacyrequest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, privacyrequest.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivacyRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privacyrequest.FieldOrganizationID:
+		return m.OrganizationID()
+	case privacyrequest.FieldUserID:
+		return m.UserID()
+	case privacyrequest.FieldKind:
+		return m.Kind()
+	case privacyrequest.FieldStatus:
+		return m.Status()
+	case privacyrequest.FieldMode:
+		return m.Mode()
+	case privacyrequest.FieldRequestedBy:
+		return m.RequestedBy()
+	case privacyrequest.FieldRequestedByKind:
+		return m.RequestedByKind()
+	case privacyrequest.FieldScheduledAt:
+		return m.ScheduledAt()
+	case privacyrequest.FieldStartedAt:
+		return m.StartedAt()
+	case privacyrequest.FieldCompletedAt:
+		return m.CompletedAt()
+	case privacyrequest.FieldCancelledAt:
+		return m.CancelledAt()
+	case privacyrequest.FieldCancelledBy:
+		return m.CancelledBy()
+	case privacyrequest.FieldCancelledByKind:
+		return m.CancelledByKind()
+	case privacyrequest.FieldStorageKey:
+		return m.StorageKey()
+	case privacyrequest.FieldSizeBytes:
+		return m.SizeBytes()
+	case privacyrequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	case privacyrequest.FieldSummary:
+		return m.Summary()
+	case privacyrequest.FieldError:
+		return m.Error()
+	case privacyrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case privacyrequest.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivacyRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privacyrequest.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case privacyrequest.FieldUserID:
+		return m.OldUserID(ctx)
+	case privacyrequest.FieldKind:
+		return m.OldKind(ctx)
+	case privacyrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case privacyrequest.FieldMode:
+		return m.OldMode(ctx)
+	case privacyrequest.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case privacyrequest.FieldRequestedByKind:
+		return m.OldRequestedByKind(ctx)
+	case privacyrequest.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case privacyrequest.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case privacyrequest.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case privacyrequest.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
+	case privacyrequest.FieldCancelledBy:
+		return m.OldCancelledBy(ctx)
+	case privacyrequest.FieldCancelledByKind:
+		return m.OldCancelledByKind(ctx)
+	case privacyrequest.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case privacyrequest.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case privacyrequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case privacyrequest.FieldSummary:
+		return m.OldSummary(ctx)
+	case privacyrequest.FieldError:
+		return m.OldError(ctx)
+	case privacyrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case privacyrequest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivacyRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privacyrequest.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case privacyrequest.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case privacyrequest.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case privacyrequest.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case privacyrequest.FieldMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case privacyrequest.FieldRequestedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case privacyrequest.FieldRequestedByKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedByKind(v)
+		return nil
+	case privacyrequest.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case privacyrequest.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case privacyrequest.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case privacyrequest.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
+	case privacyrequest.FieldCancelledBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledBy(v)
+		return nil
+	case privacyrequest.FieldCancelledByKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledByKind(v)
+		return nil
+	case privacyrequest.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case privacyrequest.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case privacyrequest.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case privacyrequest.FieldSummary:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case privacyrequest.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case privacyrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case privacyrequest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivacyRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_bytes != nil {
+		fields = append(fields, privacyrequest.FieldSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivacyRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case privacyrequest.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case privacyrequest.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivacyRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(privacyrequest.FieldMode) {
+		fields = append(fields, privacyrequest.FieldMode)
+	}
+	if m.FieldCleared(privacyrequest.FieldRequestedBy) {
+		fields = append(fields, privacyrequest.FieldRequestedBy)
+	}
+	if m.FieldCleared(privacyrequest.FieldRequestedByKind) {
+		fields = append(fields, privacyrequest.FieldRequestedByKind)
+	}
+	if m.FieldCleared(privacyrequest.FieldStartedAt) {
+		fields = append(fields, privacyrequest.FieldStartedAt)
+	}
+	if m.FieldCleared(privacyrequest.FieldCompletedAt) {
+		fields = append(fields, privacyrequest.FieldCompletedAt)
+	}
+	if m.FieldCleared(privacyrequest.FieldCancelledAt) {
+		fields = append(fields, privacyrequest.FieldCancelledAt)
+	}
+	if m.FieldCleared(privacyrequest.FieldCancelledBy) {
+		fields = append(fields, privacyrequest.FieldCancelledBy)
+	}
+	if m.FieldCleared(privacyrequest.FieldCancelledByKind) {
+		fields = append(fields, privacyrequest.FieldCancelledByKind)
+	}
+	if m.FieldCleared(privacyrequest.FieldStorageKey) {
+		fields = append(fields, privacyrequest.FieldStorageKey)
+	}
+	if m.FieldCleared(privacyrequest.FieldExpiresAt) {
+		fields = append(fields, privacyrequest.FieldExpiresAt)
+	}
+	if m.FieldCleared(privacyrequest.FieldSummary) {
+		fields = append(fields, privacyrequest.FieldSummary)
+	}
+	if m.FieldCleared(privacyrequest.FieldError) {
+		fields = append(fields, privacyrequest.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivacyRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivacyRequestMutation) ClearField(name string) error {
+	switch name {
+	case privacyrequest.FieldMode:
+		m.ClearMode()
+		return nil
+	case privacyrequest.FieldRequestedBy:
+		m.ClearRequestedBy()
+		return nil
+	case privacyrequest.FieldRequestedByKind:
+		m.ClearRequestedByKind()
+		return nil
+	case privacyrequest.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case privacyrequest.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case privacyrequest.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	case privacyrequest.FieldCancelledBy:
+		m.ClearCancelledBy()
+		return nil
+	case privacyrequest.FieldCancelledByKind:
+		m.ClearCancelledByKind()
+		return nil
+	case privacyrequest.FieldStorageKey:
+		m.ClearStorageKey()
+		return nil
+	case privacyrequest.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case privacyrequest.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case privacyrequest.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivacyRequestMutation) ResetField(name string) error {
+	switch name {
+	case privacyrequest.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case privacyrequest.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case privacyrequest.FieldKind:
+		m.ResetKind()
+		return nil
+	case privacyrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case privacyrequest.FieldMode:
+		m.ResetMode()
+		return nil
+	case privacyrequest.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case privacyrequest.FieldRequestedByKind:
+		m.ResetRequestedByKind()
+		return nil
+	case privacyrequest.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case privacyrequest.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case privacyrequest.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case privacyrequest.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
+	case privacyrequest.FieldCancelledBy:
+		m.ResetCancelledBy()
+		return nil
+	case privacyrequest.FieldCancelledByKind:
+		m.ResetCancelledByKind()
+		return nil
+	case privacyrequest.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case privacyrequest.FieldSizeBytes:
+		m.ResetSizeBytes()
+		return nil
+	case privacyrequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case privacyrequest.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case privacyrequest.FieldError:
+		m.ResetError()
+		return nil
+	case privacyrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case privacyrequest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivacyRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, privacyrequest.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivacyRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case privacyrequest.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivacyRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivacyRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivacyRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, privacyrequest.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivacyRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case privacyrequest.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivacyRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case privacyrequest.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivacyRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case privacyrequest.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyRequest edge %s", name)
+}
+
+// SSOIdentityMutation represents an operation that mutates the SSOIdentity nodes in the graph.
+type SSOIdentityMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	organization_id *uuid.UUID
+	protocol        *string
+	issuer          *string
+	subject         *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*SSOIdentity, error)
+	predicates      []predicate.SSOIdentity
+}
+
+var _ ent.Mutation = (*SSOIdentityMutation)(nil)
+
+// ssoidentityOption allows management of the mutation configuration using functional options.
+type ssoidentityOption func(*SSOIdentityMutation)
+
+// newSSOIdentityMutation creates new mutation for the SSOIdentity entity.
+func newSSOIdentityMutation(c config, op Op, opts ...ssoidentityOption) *SSOIdentityMutation {
+	m := &SSOIdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSSOIdentity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSSOIdentityID sets the ID field of the mutation.
+func withSSOIdentityID(id uuid.UUID) ssoidentityOption {
+	return func(m *SSOIdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SSOIdentity
+		)
+		m.oldValue = func(ctx context.Context) (*SSOIdentity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SSOIdentity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSSOIdentity sets the old SSOIdentity of the mutation.
+func withSSOIdentity(node *SSOIdentity) ssoidentityOption {
+	return func(m *SSOIdentityMutation) {
+		m.oldValue = func(context.Context) (*SSOIdentity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SSOIdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SSOIdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SSOIdentity entities.
+func (m *SSOIdentityMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SSOIdentityMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SSOIdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SSOIdentity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *SSOIdentityMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *SSOIdentityMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *SSOIdentityMutation) ResetOrganizationID() {
+	m.organization_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SSOIdentityMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SSOIdentityMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SSOIdentityMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *SSOIdentityMutation) SetProtocol(s string) {
+	m.protocol = &s
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *SSOIdentityMutation) Protocol() (r string, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *SSOIdentityMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetIssuer sets the "issuer" field.
+func (m *SSOIdentityMutation) SetIssuer(s string) {
+	m.issuer = &s
+}
+
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *SSOIdentityMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuer returns the old "issuer" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
+	}
+	return oldValue.Issuer, nil
+}
+
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *SSOIdentityMutation) ResetIssuer() {
+	m.issuer = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *SSOIdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *SSOIdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *SSOIdentityMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SSOIdentityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SSOIdentityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SSOIdentity entity.
+// If the SSOIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SSOIdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SSOIdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SSOIdentityMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[ssoidentity.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SSOIdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SSOIdentityMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SSOIdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SSOIdentityMutation builder.
+func (m *SSOIdentityMutation) Where(ps ...predicate.SSOIdentity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SSOIdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SSOIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SSOIdentity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SSOIdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SSOIdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SSOIdentity).
+func (m *SSOIdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SSOIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.organization_id != nil {
+		fields = append(fields, ssoidentity.FieldOrganizationID)
+	}
+	if m.user != nil {
+		fields = append(fields, ssoidentity.FieldUserID)
+	}
+	if m.protocol != nil {
+		fields = append(fields, ssoidentity.FieldProtocol)
+	}
+	if m.issuer != nil {
+		fields = append(fields, ssoidentity.FieldIssuer)
+	}
+	if m.subject != nil {
+		fields = append(fields, ssoidentity.FieldSubject)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ssoidentity.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SSOIdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ssoidentity.FieldOrganizationID:
+		return m.OrganizationID()
+	case ssoidentity.FieldUserID:
+		return m.UserID()
+	case ssoidentity.FieldProtocol:
+		return m.Protocol()
+	case ssoidentity.FieldIssuer:
+		return m.Issuer()
+	case ssoidentity.FieldSubject:
+		return m.Subject()
+	case ssoidentity.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SSOIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ssoidentity.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case ssoidentity.FieldUserID:
+		return m.OldUserID(ctx)
+	case ssoidentity.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case ssoidentity.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case ssoidentity.FieldSubject:
+		return m.OldSubject(ctx)
+	case ssoidentity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SSOIdentity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SSOIdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ssoidentity.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case ssoidentity.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case ssoidentity.FieldProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case ssoidentity.FieldIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuer(v)
+		return nil
+	case ssoidentity.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case ssoidentity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SSOIdentity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SSOIdentityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SSOIdentityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SSOIdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SSOIdentity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SSOIdentityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SSOIdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SSOIdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SSOIdentity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SSOIdentityMutation) ResetField(name string) error {
+	switch name {
+	case ssoidentity.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case ssoidentity.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case ssoidentity.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case ssoidentity.FieldIssuer:
+		m.ResetIssuer()
+		return nil
+	case ssoidentity.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case ssoidentity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SSOIdentity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SSOIdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, ssoidentity.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SSOIdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ssoidentity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SSOIdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SSOIdentityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SSOIdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, ssoidentity.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SSOIdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ssoidentity.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SSOIdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case ssoidentity.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SSOIdentity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SSOIdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case ssoidentity.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SSOIdentity edge %s", name)
+}
+
+// SamlAssertionMutation represents an operation that mutates the SamlAssertion nodes in the graph.
+type SamlAssertionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	assertion_id  *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SamlAssertion, error)
+	predicates    []predicate.SamlAssertion
+}
+
+var _ ent.Mutation = (*SamlAssertionMutation)(nil)
+
+// samlassertionOption allows management of the mutation configuration using functional options.
+type samlassertionOption func(*SamlAssertionMutation)
+
+// newSamlAssertionMutation creates new mutation for the SamlAssertion entity.
+func newSamlAssertionMutation(c config, op Op, opts ...samlassertionOption) *SamlAssertionMutation {
+	m := &SamlAssertionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSamlAssertion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSamlAssertionID sets the ID field of the mutation.
+func withSamlAssertionID(id uuid.UUID) samlassertionOption {
+	return func(m *SamlAssertionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SamlAssertion
+		)
+		m.oldValue = func(ctx context.Context) (*SamlAssertion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SamlAssertion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSamlAssertion sets the old SamlAssertion of the mutation.
+func withSamlAssertion(node *SamlAssertion) samlassertionOption {
+	return func(m *SamlAssertionMutation) {
+		m.oldValue = func(context.Context) (*SamlAssertion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SamlAssertionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SamlAssertionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SamlAssertion entities.
+func (m *SamlAssertionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SamlAssertionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SamlAssertionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SamlAssertion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAssertionID sets the "assertion_id" field.
+func (m *SamlAssertionMutation) SetAssertionID(s string) {
+	m.assertion_id = &s
+}
+
+// AssertionID returns the value of the "assertion_id" field in the mutation.
+func (m *SamlAssertionMutation) AssertionID() (r string, exists bool) {
+	v := m.assertion_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssertionID returns the old "assertion_id" field's value of the SamlAssertion entity.
+// If the SamlAssertion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlAssertionMutation) OldAssertionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssertionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssertionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssertionID: %w", err)
+	}
+	return oldValue.AssertionID, nil
+}
+
+// ResetAssertionID resets all changes to the "assertion_id" field.
+func (m *SamlAssertionMutation) ResetAssertionID() {
+	m.assertion_id = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SamlAssertionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SamlAssertionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the SamlAssertion entity.
+// If the SamlAssertion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlAssertionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SamlAssertionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SamlAssertionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SamlAssertionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SamlAssertion entity.
+// If the SamlAssertion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlAssertionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SamlAssertionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SamlAssertionMutation builder.
+func (m *SamlAssertionMutation) Where(ps ...predicate.SamlAssertion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SamlAssertionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SamlAssertionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SamlAssertion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SamlAssertionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SamlAssertionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SamlAssertion).
+func (m *SamlAssertionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SamlAssertionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.assertion_id != nil {
+		fields = append(fields, samlassertion.FieldAssertionID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, samlassertion.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, samlassertion.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SamlAssertionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case samlassertion.FieldAssertionID:
+		return m.AssertionID()
+	case samlassertion.FieldExpiresAt:
+		return m.ExpiresAt()
+	case samlassertion.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SamlAssertionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case samlassertion.FieldAssertionID:
+		return m.OldAssertionID(ctx)
+	case samlassertion.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case samlassertion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SamlAssertion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SamlAssertionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case samlassertion.FieldAssertionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssertionID(v)
+		return nil
+	case samlassertion.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case samlassertion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SamlAssertion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SamlAssertionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SamlAssertionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SamlAssertionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SamlAssertion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SamlAssertionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SamlAssertionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SamlAssertionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SamlAssertion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SamlAssertionMutation) ResetField(name string) error {
+	switch name {
+	case samlassertion.FieldAssertionID:
+		m.ResetAssertionID()
+		return nil
+	case samlassertion.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case samlassertion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SamlAssertion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SamlAssertionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SamlAssertionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SamlAssertionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SamlAssertionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SamlAssertionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SamlAssertionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SamlAssertionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SamlAssertion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SamlAssertionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SamlAssertion edge %s", name)
+}
+
+// ScimTokenMutation represents an operation that mutates the ScimToken nodes in the graph.
+type ScimTokenMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	token_hash          *string
+	last_used_at        *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*ScimToken, error)
+	predicates          []predicate.ScimToken
+}
+
+var _ ent.Mutation = (*ScimTokenMutation)(nil)
+
+// scimtokenOption allows management of the mutation configuration using functional options.
+type scimtokenOption func(*ScimTokenMutation)
+
+// newScimTokenMutation creates new mutation for the ScimToken entity.
+func newScimTokenMutation(c config, op Op, opts ...scimtokenOption) *ScimTokenMutation {
+	m := &ScimTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScimToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScimTokenID sets the ID field of the mutation.
+func withScimTokenID(id uuid.UUID) scimtokenOption {
+	return func(m *ScimTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScimToken
+		)
+		m.oldValue = func(ctx context.Context) (*ScimToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScimToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScimToken sets the old ScimToken of the mutation.
+func withScimToken(node *ScimToken) scimtokenOption {
+	return func(m *ScimTokenMutation) {
+		m.oldValue = func(context.Context) (*ScimToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScimTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScimTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScimToken entities.
+func (m *ScimTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScimTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScimTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScimToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *ScimTokenMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *ScimTokenMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the ScimToken entity.
+// If the ScimToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScimTokenMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *ScimTokenMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetName sets the "name" field.
+func (m *ScimTokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScimTokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ScimToken entity.
+// If the ScimToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScimTokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScimTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *ScimTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *ScimTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the ScimToken entity.
+// If the ScimToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScimTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *ScimTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ScimTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ScimTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the ScimToken entity.
+// If the ScimToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScimTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ScimTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[scimtoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ScimTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[scimtoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ScimTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, scimtoken.FieldLastUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScimTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScimTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ScimToken entity.
+// If the ScimToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScimTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScimTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *ScimTokenMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[scimtoken.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *ScimTokenMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *ScimTokenMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *ScimTokenMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the ScimTokenMutation builder.
+func (m *ScimTokenMutation) Where(ps ...predicate.ScimToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScimTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScimTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScimToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScimTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScimTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScimToken).
+func (m *ScimTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScimTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.organization != nil {
+		fields = append(fields, scimtoken.FieldOrganizationID)
+	}
+	if m.name != nil {
+		fields = append(fields, scimtoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, scimtoken.FieldTokenHash)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, scimtoken.FieldLastUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, scimtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScimTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scimtoken.FieldOrganizationID:
+		return m.OrganizationID()
+	case scimtoken.FieldName:
+		return m.Name()
+	case scimtoken.FieldTokenHash:
+		return m.TokenHash()
+	case scimtoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case scimtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScimTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scimtoken.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case scimtoken.FieldName:
+		return m.OldName(ctx)
+	case scimtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case scimtoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case scimtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScimToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScimTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scimtoken.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case scimtoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case scimtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case scimtoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case scimtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScimToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScimTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScimTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScimTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScimToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScimTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scimtoken.FieldLastUsedAt) {
+		fields = append(fields, scimtoken.FieldLastUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScimTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScimTokenMutation) ClearField(name string) error {
+	switch name {
+	case scimtoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScimToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScimTokenMutation) ResetField(name string) error {
+	switch name {
+	case scimtoken.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case scimtoken.FieldName:
+		m.ResetName()
+		return nil
+	case scimtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case scimtoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case scimtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScimToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScimTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, scimtoken.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScimTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scimtoken.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScimTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScimTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScimTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, scimtoken.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScimTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scimtoken.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScimTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case scimtoken.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown ScimToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScimTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case scimtoken.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown ScimToken edge %s", name)
+}
+
+// ServiceAccountMutation represents an operation that mutates the ServiceAccount nodes in the graph.
+type ServiceAccountMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	description         *string
+	status              *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	api_keys            map[uuid.UUID]struct{}
+	removedapi_keys     map[uuid.UUID]struct{}
+	clearedapi_keys     bool
+	done                bool
+	oldValue            func(context.Context) (*ServiceAccount, error)
+	predicates          []predicate.ServiceAccount
+}
+
+var _ ent.Mutation = (*ServiceAccountMutation)(nil)
+
+// serviceaccountOption allows management of the mutation configuration using functional options.
+type serviceaccountOption func(*ServiceAccountMutation)
+
+// newServiceAccountMutation creates new mutation for the ServiceAccount entity.
+func newServiceAccountMutation(c config, op Op, opts ...serviceaccountOption) *ServiceAccountMutation {
+	m := &ServiceAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeServiceAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withServiceAccountID sets the ID field of the mutation.
+func withServiceAccountID(id uuid.UUID) serviceaccountOption {
+	return func(m *ServiceAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ServiceAccount
+		)
+		m.oldValue = func(ctx context.Context) (*ServiceAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ServiceAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withServiceAccount sets the old ServiceAccount of the mutation.
+func withServiceAccount(node *ServiceAccount) serviceaccountOption {
+	return func(m *ServiceAccountMutation) {
+		m.oldValue = func(context.Context) (*ServiceAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ServiceAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ServiceAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ServiceAccount entities.
+func (m *ServiceAccountMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ServiceAccountMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ServiceAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ServiceAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *ServiceAccountMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *ServiceAccountMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *ServiceAccountMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetName sets the "name" field.
+func (m *ServiceAccountMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ServiceAccountMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ServiceAccountMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ServiceAccountMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ServiceAccountMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ServiceAccountMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[serviceaccount.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ServiceAccountMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ServiceAccountMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, serviceaccount.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *ServiceAccountMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ServiceAccountMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ServiceAccountMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ServiceAccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ServiceAccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ServiceAccountMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ServiceAccountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ServiceAccountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ServiceAccountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *ServiceAccountMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[serviceaccount.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *ServiceAccountMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *ServiceAccountMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *ServiceAccountMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *ServiceAccountMutation) AddAPIKeyIDs(ids ...uuid.UUID) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *ServiceAccountMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *ServiceAccountMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *ServiceAccountMutation) RemoveAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *ServiceAccountMutation) RemovedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *ServiceAccountMutation) APIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *ServiceAccountMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// Where appends a list predicates to the ServiceAccountMutation builder.
+func (m *ServiceAccountMutation) Where(ps ...predicate.ServiceAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ServiceAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ServiceAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ServiceAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ServiceAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ServiceAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ServiceAccount).
+func (m *ServiceAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ServiceAccountMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.organization != nil {
+		fields = append(fields, serviceaccount.FieldOrganizationID)
+	}
+	if m.name != nil {
+		fields = append(fields, serviceaccount.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, serviceaccount.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, serviceaccount.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, serviceaccount.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, serviceaccount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ServiceAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case serviceaccount.FieldOrganizationID:
+		return m.OrganizationID()
+	case serviceaccount.FieldName:
+		return m.Name()
+	case serviceaccount.FieldDescription:
+		return m.Description()
+	case serviceaccount.FieldStatus:
+		return m.Status()
+	case serviceaccount.FieldCreatedAt:
+		return m.CreatedAt()
+	case serviceaccount.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ServiceAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case serviceaccount.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case serviceaccount.FieldName:
+		return m.OldName(ctx)
+	case serviceaccount.FieldDescription:
+		return m.OldDescription(ctx)
+	case serviceaccount.FieldStatus:
+		return m.OldStatus(ctx)
+	case serviceaccount.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case serviceaccount.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case serviceaccount.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case serviceaccount.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case serviceaccount.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case serviceaccount.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case serviceaccount.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case serviceaccount.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ServiceAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ServiceAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ServiceAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ServiceAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(serviceaccount.FieldDescription) {
+		fields = append(fields, serviceaccount.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ServiceAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ServiceAccountMutation) ClearField(name string) error {
+	switch name {
+	case serviceaccount.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ServiceAccountMutation) ResetField(name string) error {
+	switch name {
+	case serviceaccount.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case serviceaccount.FieldName:
+		m.ResetName()
+		return nil
+	case serviceaccount.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case serviceaccount.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case serviceaccount.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case serviceaccount.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ServiceAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, serviceaccount.EdgeOrganization)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, serviceaccount.EdgeAPIKeys)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ServiceAccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case serviceaccount.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case serviceaccount.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ServiceAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedapi_keys != nil {
+		edges = append(edges, serviceaccount.EdgeAPIKeys)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ServiceAccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case serviceaccount.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ServiceAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, serviceaccount.EdgeOrganization)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, serviceaccount.EdgeAPIKeys)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ServiceAccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case serviceaccount.EdgeOrganization:
+		return m.clearedorganization
+	case serviceaccount.EdgeAPIKeys:
+		return m.clearedapi_keys
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ServiceAccountMutation) ClearEdge(name string) error {
+	switch name {
+	case serviceaccount.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ServiceAccountMutation) ResetEdge(name string) error {
+	switch name {
+	case serviceaccount.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case serviceaccount.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	email                       *string
+	password_hash               *string
+	role                        *string
+	status                      *string
+	refresh_token_id            *string
+	last_login_at               *time.Time
+	failed_login_attempts       *int
+	addfailed_login_attempts    *int
+	locked_until                *time.Time
+	mfa_secret                  *string
+	mfa_enabled_at              *time.Time
+	mfa_recovery_codes          *[]string
+	appendmfa_recovery_codes    []string
+	mfa_last_step               *int64
+	addmfa_last_step            *int64
+	magic_link_hash             *string
+	external_id                 *string
+	metadata                    *map[string]interface{}
+	version                     *int
+	addversion                  *int
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	organization                *uuid.UUID
+	clearedorganization         bool
+	enrollments                 map[uuid.UUID]struct{}
+	removedenrollments          map[uuid.UUID]struct{}
+	clearedenrollments          bool
+	groups                      map[uuid.UUID]struct{}
+	removedgroups               map[uuid.UUID]struct{}
+	clearedgroups               bool
+	webauthn_credentials        map[uuid.UUID]struct{}
+	removedwebauthn_credentials map[uuid.UUID]struct{}
+	clearedwebauthn_credentials bool
+	sso_identities              map[uuid.UUID]struct{}
+	removedsso_identities       map[uuid.UUID]struct{}
+	clearedsso_identities       bool
+	done                        bool
+	oldValue                    func(context.Context) (*User, error)
+	predicates                  []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *UserMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *UserMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *UserMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (m *UserMutation) SetRefreshTokenID(s string) {
+	m.refresh_token_id = &s
+}
+
+// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
+func (m *UserMutation) RefreshTokenID() (r string, exists bool) {
+	v := m.refresh_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshTokenID returns the old "refresh_token_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRefreshTokenID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
+	}
+	return oldValue.RefreshTokenID, nil
+}
+
+// ClearRefreshTokenID clears the value of the "refresh_token_id" field.
+func (m *UserMutation) ClearRefreshTokenID() {
+	m.refresh_token_id = nil
+	m.clearedFields[user.FieldRefreshTokenID] = struct{}{}
+}
+
+// RefreshTokenIDCleared returns if the "refresh_token_id" field was cleared in this mutation.
+func (m *UserMutation) RefreshTokenIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldRefreshTokenID]
+	return ok
+}
+
+// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
+func (m *UserMutation) ResetRefreshTokenID() {
+	m.refresh_token_id = nil
+	delete(m.clearedFields, user.FieldRefreshTokenID)
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (m *UserMutation) SetFailedLoginAttempts(i int) {
+	m.failed_login_attempts = &i
+	m.addfailed_login_attempts = nil
+}
+
+// FailedLoginAttempts returns the value of the "failed_login_attempts" field in the mutation.
+func (m *UserMutation) FailedLoginAttempts() (r int, exists bool) {
+	v := m.failed_login_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedLoginAttempts returns the old "failed_login_attempts" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFailedLoginAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedLoginAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedLoginAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedLoginAttempts: %w", err)
+	}
+	return oldValue.FailedLoginAttempts, nil
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (m *UserMutation) AddFailedLoginAttempts(i int) {
+	if m.addfailed_login_attempts != nil {
+		*m.addfailed_login_attempts += i
+	} else {
+		m.addfailed_login_attempts = &i
+	}
+}
+
+// AddedFailedLoginAttempts returns the value that was added to the "failed_login_attempts" field in this mutation.
+func (m *UserMutation) AddedFailedLoginAttempts() (r int, exists bool) {
+	v := m.addfailed_login_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedLoginAttempts resets all changes to the "failed_login_attempts" field.
+func (m *UserMutation) ResetFailedLoginAttempts() {
+	m.failed_login_attempts = nil
+	m.addfailed_login_attempts = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *UserMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *UserMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *UserMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[user.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *UserMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *UserMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, user.FieldLockedUntil)
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (m *UserMutation) SetMfaSecret(s string) {
+	m.mfa_secret = &s
+}
+
+// MfaSecret returns the value of the "mfa_secret" field in the mutation.
+func (m *UserMutation) MfaSecret() (r string, exists bool) {
+	v := m.mfa_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaSecret returns the old "mfa_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMfaSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaSecret: %w", err)
+	}
+	return oldValue.MfaSecret, nil
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (m *UserMutation) ClearMfaSecret() {
+	m.mfa_secret = nil
+	m.clearedFields[user.FieldMfaSecret] = struct{}{}
+}
+
+// MfaSecretCleared returns if the "mfa_secret" field was cleared in this mutation.
+func (m *UserMutation) MfaSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaSecret]
+	return ok
+}
+
+// ResetMfaSecret resets all changes to the "mfa_secret" field.
+func (m *UserMutation) ResetMfaSecret() {
+	m.mfa_secret = nil
+	delete(m.clearedFields, user.FieldMfaSecret)
+}
+
+// SetMfaEnabledAt sets the "mfa_enabled_at" field.
+func (m *UserMutation) SetMfaEnabledAt(t time.Time) {
+	m.mfa_enabled_at = &t
+}
+
+// MfaEnabledAt returns the value of the "mfa_enabled_at" field in the mutation.
+func (m *UserMutation) MfaEnabledAt() (r time.Time, exists bool) {
+	v := m.mfa_enabled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaEnabledAt returns the old "mfa_enabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMfaEnabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaEnabledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaEnabledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaEnabledAt: %w", err)
+	}
+	return oldValue.MfaEnabledAt, nil
+}
+
+// ClearMfaEnabledAt clears the value of the "mfa_enabled_at" field.
+func (m *UserMutation) ClearMfaEnabledAt() {
+	m.mfa_enabled_at = nil
+	m.clearedFields[user.FieldMfaEnabledAt] = struct{}{}
+}
+
+// MfaEnabledAtCleared returns if the "mfa_enabled_at" field was cleared in this mutation.
+func (m *UserMutation) MfaEnabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaEnabledAt]
+	return ok
+}
+
+// ResetMfaEnabledAt resets all changes to the "mfa_enabled_at" field.
+func (m *UserMutation) ResetMfaEnabledAt() {
+	m.mfa_enabled_at = nil
+	delete(m.clearedFields, user.FieldMfaEnabledAt)
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (m *UserMutation) SetMfaRecoveryCodes(s []string) {
+	m.mfa_recovery_codes = &s
+	m.appendmfa_recovery_codes = nil
+}
+
+// MfaRecoveryCodes returns the value of the "mfa_recovery_codes" field in the mutation.
+func (m *UserMutation) MfaRecoveryCodes() (r []string, exists bool) {
+	v := m.mfa_recovery_codes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaRecoveryCodes returns the old "mfa_recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMfaRecoveryCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaRecoveryCodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaRecoveryCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaRecoveryCodes: %w", err)
+	}
+	return oldValue.MfaRecoveryCodes, nil
+}
+
+// AppendMfaRecoveryCodes adds s to the "mfa_recovery_codes" field.
+func (m *UserMutation) AppendMfaRecoveryCodes(s []string) {
+	m.appendmfa_recovery_codes = append(m.appendmfa_recovery_codes, s...)
+}
+
+// AppendedMfaRecoveryCodes returns the list of values that were appended to the "mfa_recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedMfaRecoveryCodes() ([]string, bool) {
+	if len(m.appendmfa_recovery_codes) == 0 {
+		return nil, false
+	}
+	return m.appendmfa_recovery_codes, true
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (m *UserMutation) ClearMfaRecoveryCodes() {
+	m.mfa_recovery_codes = nil
+	m.appendmfa_recovery_codes = nil
+	m.clearedFields[user.FieldMfaRecoveryCodes] = struct{}{}
+}
+
+// MfaRecoveryCodesCleared returns if the "mfa_recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) MfaRecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldMfaRecoveryCodes]
+	return ok
+}
+
+// ResetMfaRecoveryCodes resets all changes to the "mfa_recovery_codes" field.
+func (m *UserMutation) ResetMfaRecoveryCodes() {
+	m.mfa_recovery_codes = nil
+	m.appendmfa_recovery_codes = nil
+	delete(m.clearedFields, user.FieldMfaRecoveryCodes)
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (m *UserMutation) SetMfaLastStep(i int64) {
+	m.mfa_last_step = &i
+	m.addmfa_last_step = nil
+}
+
+// MfaLastStep returns the value of the "mfa_last_step" field in the mutation.
+func (m *UserMutation) MfaLastStep() (r int64, exists bool) {
+	v := m.mfa_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaLastStep returns the old "mfa_last_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMfaLastStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaLastStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaLastStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaLastStep: %w", err)
+	}
+	return oldValue.MfaLastStep, nil
+}
+
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (m *UserMutation) AddMfaLastStep(i int64) {
+	if m.addmfa_last_step != nil {
+		*m.addmfa_last_step += i
+	} else {
+		m.addmfa_last_step = &i
+	}
+}
+
+// AddedMfaLastStep returns the value that was added to the "mfa_last_step" field in this mutation.
+func (m *UserMutation) AddedMfaLastStep() (r int64, exists bool) {
+	v := m.addmfa_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMfaLastStep resets all changes to the "mfa_last_step" field.
+func (m *UserMutation) ResetMfaLastStep() {
+	m.mfa_last_step = nil
+	m.addmfa_last_step = nil
+}
+
+// SetMagicLinkHash sets the "magic_link_hash" field.
+func (m *UserMutation) SetMagicLinkHash(s string) {
+	m.magic_link_hash = &s
+}
+
+// MagicLinkHash returns the value of the "magic_link_hash" field in the mutation.
+func (m *UserMutation) MagicLinkHash() (r string, exists bool) {
+	v := m.magic_link_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMagicLinkHash returns the old "magic_link_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMagicLinkHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMagicLinkHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMagicLinkHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMagicLinkHash: %w", err)
+	}
+	return oldValue.MagicLinkHash, nil
+}
+
+// ClearMagicLinkHash clears the value of the "magic_link_hash" field.
+func (m *UserMutation) ClearMagicLinkHash() {
+	m.magic_link_hash = nil
+	m.clearedFields[user.FieldMagicLinkHash] = struct{}{}
+}
+
+// MagicLinkHashCleared returns if the "magic_link_hash" field was cleared in this mutation.
+func (m *UserMutation) MagicLinkHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldMagicLinkHash]
+	return ok
+}
+
+// ResetMagicLinkHash resets all changes to the "magic_link_hash" field.
+func (m *UserMutation) ResetMagicLinkHash() {
+	m.magic_link_hash = nil
+	delete(m.clearedFields, user.FieldMagicLinkHash)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *UserMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *UserMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *UserMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[user.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *UserMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *UserMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, user.FieldExternalID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *UserMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *UserMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *UserMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[user.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *UserMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[user.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *UserMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, user.FieldMetadata)
+}
+
+// SetVersion sets the "version" field.
+func (m *UserMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *UserMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *UserMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *UserMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *UserMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *UserMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[user.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *UserMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *UserMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
+func (m *UserMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
+	if m.enrollments == nil {
+		m.enrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.enrollments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
+func (m *UserMutation) ClearEnrollments() {
+	m.clearedenrollments = true
+}
+
+// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
+func (m *UserMutation) EnrollmentsCleared() bool {
+	return m.clearedenrollments
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
+func (m *UserMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
+	if m.removedenrollments == nil {
+		m.removedenrollments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.enrollments, ids[i])
+		m.removedenrollments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
+func (m *UserMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedenrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
+func (m *UserMutation) EnrollmentsIDs() (ids []uuid.UUID) {
+	for id := range m.enrollments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnrollments resets all changes to the "enrollments" edge.
+func (m *UserMutation) ResetEnrollments() {
+	m.enrollments = nil
+	m.clearedenrollments = false
+	m.removedenrollments = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *UserMutation) AddGroupIDs(ids ...uuid.UUID) {
+	if m.groups == nil {
+		m.groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *UserMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *UserMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *UserMutation) RemoveGroupIDs(ids ...uuid.UUID) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *UserMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *UserMutation) GroupsIDs() (ids []uuid.UUID) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *UserMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebauthnCredential entity by ids.
+func (m *UserMutation) AddWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m.webauthn_credentials == nil {
+		m.webauthn_credentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webauthn_credentials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebauthnCredentials clears the "webauthn_credentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) ClearWebauthnCredentials() {
+	m.clearedwebauthn_credentials = true
+}
+
+// WebauthnCredentialsCleared reports if the "webauthn_credentials" edge to the WebauthnCredential entity was cleared.
+func (m *UserMutation) WebauthnCredentialsCleared() bool {
+	return m.clearedwebauthn_credentials
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to the WebauthnCredential entity by IDs.
+func (m *UserMutation) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m.removedwebauthn_credentials == nil {
+		m.removedwebauthn_credentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webauthn_credentials, ids[i])
+		m.removedwebauthn_credentials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebauthnCredentials returns the removed IDs of the "webauthn_credentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) RemovedWebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebauthn_credentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebauthnCredentialsIDs returns the "webauthn_credentials" edge IDs in the mutation.
+func (m *UserMutation) WebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.webauthn_credentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebauthnCredentials resets all changes to the "webauthn_credentials" edge.
+func (m *UserMutation) ResetWebauthnCredentials() {
+	m.webauthn_credentials = nil
+	m.clearedwebauthn_credentials = false
+	m.removedwebauthn_credentials = nil
+}
+
+// AddSSOIdentityIDs adds the "sso_identities" edge to the SSOIdentity entity by ids.
+func (m *UserMutation) AddSSOIdentityIDs(ids ...uuid.UUID) {
+	if m.sso_identities == nil {
+		m.sso_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sso_identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSSOIdentities clears the "sso_identities" edge to the SSOIdentity entity.
+func (m *UserMutation) ClearSSOIdentities() {
+	m.clearedsso_identities = true
+}
+
+// SSOIdentitiesCleared reports if the "sso_identities" edge to the SSOIdentity entity was cleared.
+func (m *UserMutation) SSOIdentitiesCleared() bool {
+	return m.clearedsso_identities
+}
+
+// RemoveSSOIdentityIDs removes the "sso_identities" edge to the SSOIdentity entity by IDs.
+func (m *UserMutation) RemoveSSOIdentityIDs(ids ...uuid.UUID) {
+	if m.removedsso_identities == nil {
+		m.removedsso_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sso_identities, ids[i])
+		m.removedsso_identities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSSOIdentities returns the removed IDs of the "sso_identities" edge to the SSOIdentity entity.
+func (m *UserMutation) RemovedSSOIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsso_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SSOIdentitiesIDs returns the "sso_identities" edge IDs in the mutation.
+func (m *UserMutation) SSOIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.sso_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSSOIdentities resets all changes to the "sso_identities" edge.
+func (m *UserMutation) ResetSSOIdentities() {
+	m.sso_identities = nil
+	m.clearedsso_identities = false
+	m.removedsso_identities = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.organization != nil {
+		fields = append(fields, user.FieldOrganizationID)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.refresh_token_id != nil {
+		fields = append(fields, user.FieldRefreshTokenID)
+	}
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
+	}
+	if m.failed_login_attempts != nil {
+		fields = append(fields, user.FieldFailedLoginAttempts)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, user.FieldLockedUntil)
+	}
+	if m.mfa_secret != nil {
+		fields = append(fields, user.FieldMfaSecret)
+	}
+	if m.mfa_enabled_at != nil {
+		fields = append(fields, user.FieldMfaEnabledAt)
+	}
+	if m.mfa_recovery_codes != nil {
+		fields = append(fields, user.FieldMfaRecoveryCodes)
+	}
+	if m.mfa_last_step != nil {
+		fields = append(fields, user.FieldMfaLastStep)
+	}
+	if m.magic_link_hash != nil {
+		fields = append(fields, user.FieldMagicLinkHash)
+	}
+	if m.external_id != nil {
+		fields = append(fields, user.FieldExternalID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, user.FieldMetadata)
+	}
+	if m.version != nil {
+		fields = append(fields, user.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldOrganizationID:
+		return m.OrganizationID()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldRefreshTokenID:
+		return m.RefreshTokenID()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
+	case user.FieldFailedLoginAttempts:
+		return m.FailedLoginAttempts()
+	case user.FieldLockedUntil:
+		return m.LockedUntil()
+	case user.FieldMfaSecret:
+		return m.MfaSecret()
+	case user.FieldMfaEnabledAt:
+		return m.MfaEnabledAt()
+	case user.FieldMfaRecoveryCodes:
+		return m.MfaRecoveryCodes()
+	case user.FieldMfaLastStep:
+		return m.MfaLastStep()
+	case user.FieldMagicLinkHash:
+		return m.MagicLinkHash()
+	case user.FieldExternalID:
+		return m.ExternalID()
+	case user.FieldMetadata:
+		return m.Metadata()
+	case user.FieldVersion:
+		return m.Version()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldRefreshTokenID:
+		return m.OldRefreshTokenID(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	case user.FieldFailedLoginAttempts:
+		return m.OldFailedLoginAttempts(ctx)
+	case user.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case user.FieldMfaSecret:
+		return m.OldMfaSecret(ctx)
+	case user.FieldMfaEnabledAt:
+		return m.OldMfaEnabledAt(ctx)
+	case user.FieldMfaRecoveryCodes:
+		return m.OldMfaRecoveryCodes(ctx)
+	case user.FieldMfaLastStep:
+		return m.OldMfaLastStep(ctx)
+	case user.FieldMagicLinkHash:
+		return m.OldMagicLinkHash(ctx)
+	case user.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case user.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case user.FieldVersion:
+		return m.OldVersion(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldRefreshTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenID(v)
+		return nil
+	case user.FieldLastLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLoginAt(v)
+		return nil
+	case user.FieldFailedLoginAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedLoginAttempts(v)
+		return nil
+	case user.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case user.FieldMfaSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaSecret(v)
+		return nil
+	case user.FieldMfaEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaEnabledAt(v)
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaRecoveryCodes(v)
+		return nil
+	case user.FieldMfaLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaLastStep(v)
+		return nil
+	case user.FieldMagicLinkHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMagicLinkHash(v)
+		return nil
+	case user.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case user.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addfailed_login_attempts != nil {
+		fields = append(fields, user.FieldFailedLoginAttempts)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	if m.addmfa_last_step != nil {
+		fields = append(fields, user.FieldMfaLastStep)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.addversion != nil {
+		fields = append(fields, user.FieldVersion)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *OrganizationMutation) ResetStatus() {
-	m.status = nil
+	return fields
 }
 
-// SetSettings sets the "settings" field.
-func (m *OrganizationMutation) SetSettings(value map[string]interface{}) {
-	m.settings = &value
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldFailedLoginAttempts:
+		return m.AddedFailedLoginAttempts()
+	case user.FieldMfaLastStep:
+		return m.AddedMfaLastStep()
+	case user.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *OrganizationMutation) Settings() (r map[string]interface{}, exists bool) {
-	v := m.settings
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldFailedLoginAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedLoginAttempts(v)
+		return nil
+	case user.FieldMfaLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMfaLastStep(v)
+		return nil
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// OldSettings returns the old "settings" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldRefreshTokenID) {
+		fields = append(fields, user.FieldRefreshTokenID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	if m.FieldCleared(user.FieldLockedUntil) {
+		fields = append(fields, user.FieldLockedUntil)
 	}
-	return oldValue.Settings, nil
-}
-
-// ClearSettings clears the value of the "settings" field.
-func (m *OrganizationMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[organization.FieldSettings] = struct{}{}
-}
-
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *OrganizationMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[organization.FieldSettings]
-	return ok
-}
-
-// ResetSettings resets all changes to the "settings" field.
-func (m *OrganizationMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, organization.FieldSettings)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+	if m.FieldCleared(user.FieldMfaSecret) {
+		fields = append(fields, user.FieldMfaSecret)
 	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	if m.FieldCleared(user.FieldMfaEnabledAt) {
+		fields = append(fields, user.FieldMfaEnabledAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(user.FieldMfaRecoveryCodes) {
+		fields = append(fields, user.FieldMfaRecoveryCodes)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(user.FieldMagicLinkHash) {
+		fields = append(fields, user.FieldMagicLinkHash)
 	}
-	return oldValue.CreatedAt, nil
+	if m.FieldCleared(user.FieldExternalID) {
+		fields = append(fields, user.FieldExternalID)
+	}
+	if m.FieldCleared(user.FieldMetadata) {
+		fields = append(fields, user.FieldMetadata)
+	}
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrganizationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldRefreshTokenID:
+		m.ClearRefreshTokenID()
+		return nil
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	case user.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case user.FieldMfaSecret:
+		m.ClearMfaSecret()
+		return nil
+	case user.FieldMfaEnabledAt:
+		m.ClearMfaEnabledAt()
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		m.ClearMfaRecoveryCodes()
+		return nil
+	case user.FieldMagicLinkHash:
+		m.ClearMagicLinkHash()
+		return nil
+	case user.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case user.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldRefreshTokenID:
+		m.ResetRefreshTokenID()
+		return nil
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
+		return nil
+	case user.FieldFailedLoginAttempts:
+		m.ResetFailedLoginAttempts()
+		return nil
+	case user.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case user.FieldMfaSecret:
+		m.ResetMfaSecret()
+		return nil
+	case user.FieldMfaEnabledAt:
+		m.ResetMfaEnabledAt()
+		return nil
+	case user.FieldMfaRecoveryCodes:
+		m.ResetMfaRecoveryCodes()
+		return nil
+	case user.FieldMfaLastStep:
+		m.ResetMfaLastStep()
+		return nil
+	case user.FieldMagicLinkHash:
+		m.ResetMagicLinkHash()
+		return nil
+	case user.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case user.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case user.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.organization != nil {
+		edges = append(edges, user.EdgeOrganization)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.enrollments != nil {
+		edges = append(edges, user.EdgeEnrollments)
+	}
+	if m.groups != nil {
+		edges = append(edges, user.EdgeGroups)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.webauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
 	}
-	return oldValue.UpdatedAt, nil
+	if m.sso_identities != nil {
+		edges = append(edges, user.EdgeSSOIdentities)
+	}
+	return edges
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrganizationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.enrollments))
+		for id := range m.enrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.webauthn_credentials))
+		for id := range m.webauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSSOIdentities:
+		ids := make([]ent.Value, 0, len(m.sso_identities))
+		for id := range m.sso_identities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *OrganizationMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedenrollments != nil {
+		edges = append(edges, user.EdgeEnrollments)
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	if m.removedgroups != nil {
+		edges = append(edges, user.EdgeGroups)
 	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *OrganizationMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *OrganizationMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *OrganizationMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
+	if m.removedwebauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	if m.removedsso_identities != nil {
+		edges = append(edges, user.EdgeSSOIdentities)
 	}
+	return edges
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *OrganizationMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeEnrollments:
+		ids := make([]ent.Value, 0, len(m.removedenrollments))
+		for id := range m.removedenrollments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.removedwebauthn_credentials))
+		for id := range m.removedwebauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSSOIdentities:
+		ids := make([]ent.Value, 0, len(m.removedsso_identities))
+		for id := range m.removedsso_identities {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return
+	return nil
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *OrganizationMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedorganization {
+		edges = append(edges, user.EdgeOrganization)
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *OrganizationMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// AddContentIDs adds the "contents" edge to the Content entity by ids.
-func (m *OrganizationMutation) AddContentIDs(ids ...uuid.UUID) {
-	if m.contents == nil {
-		m.contents = make(map[uuid.UUID]struct{})
+	if m.clearedenrollments {
+		edges = append(edges, user.EdgeEnrollments)
 	}
-	for i := range ids {
-		m.contents[ids[i]] = struct{}{}
+	if m.clearedgroups {
+		edges = append(edges, user.EdgeGroups)
 	}
-}
-
-// ClearContents clears the "contents" edge to the Content entity.
-func (m *OrganizationMutation) ClearContents() {
-	m.clearedcontents = true
-}
-
-// ContentsCleared reports if the "contents" edge to the Content entity was cleared.
-func (m *OrganizationMutation) ContentsCleared() bool {
-	return m.clearedcontents
-}
-
-// RemoveContentIDs removes the "contents" edge to the Content entity by IDs.
-func (m *OrganizationMutation) RemoveContentIDs(ids ...uuid.UUID) {
-	if m.removedcontents == nil {
-		m.removedcontents = make(map[uuid.UUID]struct{})
+	if m.clearedwebauthn_credentials {
+		edges = append(edges, user.EdgeWebauthnCredentials)
 	}
-	for i := range ids {
-		delete(m.contents, ids[i])
-		m.removedcontents[ids[i]] = struct{}{}
+	if m.clearedsso_identities {
+		edges = append(edges, user.EdgeSSOIdentities)
 	}
+	return edges
 }
 
-// RemovedContents returns the removed IDs of the "contents" edge to the Content entity.
-func (m *OrganizationMutation) RemovedContentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcontents {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeOrganization:
+		return m.clearedorganization
+	case user.EdgeEnrollments:
+		return m.clearedenrollments
+	case user.EdgeGroups:
+		return m.clearedgroups
+	case user.EdgeWebauthnCredentials:
+		return m.clearedwebauthn_credentials
+	case user.EdgeSSOIdentities:
+		return m.clearedsso_identities
 	}
-	return
+	return false
 }
 
-// ContentsIDs returns the "contents" edge IDs in the mutation.
-func (m *OrganizationMutation) ContentsIDs() (ids []uuid.UUID) {
-	for id := range m.contents {
-		ids = append(ids, id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// ResetContents resets all changes to the "contents" edge.
-func (m *OrganizationMutation) ResetContents() {
-	m.contents = nil
-	m.clearedcontents = false
-	m.removedcontents = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case user.EdgeEnrollments:
+		m.ResetEnrollments()
+		return nil
+	case user.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	case user.EdgeWebauthnCredentials:
+		m.ResetWebauthnCredentials()
+		return nil
+	case user.EdgeSSOIdentities:
+		m.ResetSSOIdentities()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// AddCourseIDs adds the "courses" edge to the Course entity by ids.
-func (m *OrganizationMutation) AddCourseIDs(ids ...uuid.UUID) {
-	if m.courses == nil {
-		m.courses = make(map[uuid.UUID]struct{})
+// WebauthnCeremonyMutation represents an operation that mutates the WebauthnCeremony nodes in the graph.
+type WebauthnCeremonyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	session_id    *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WebauthnCeremony, error)
+	predicates    []predicate.WebauthnCeremony
+}
+
+var _ ent.Mutation = (*WebauthnCeremonyMutation)(nil)
+
+// webauthnceremonyOption allows management of the mutation configuration using functional options.
+type webauthnceremonyOption func(*WebauthnCeremonyMutation)
+
+// newWebauthnCeremonyMutation creates new mutation for the WebauthnCeremony entity.
+func newWebauthnCeremonyMutation(c config, op Op, opts ...webauthnceremonyOption) *WebauthnCeremonyMutation {
+	m := &WebauthnCeremonyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebauthnCeremony,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.courses[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// ClearCourses clears the "courses" edge to the Course entity.
-func (m *OrganizationMutation) ClearCourses() {
-	m.clearedcourses = true
-}
-
-// CoursesCleared reports if the "courses" edge to the Course entity was cleared.
-func (m *OrganizationMutation) CoursesCleared() bool {
-	return m.clearedcourses
+// withWebauthnCeremonyID sets the ID field of the mutation.
+func withWebauthnCeremonyID(id uuid.UUID) webauthnceremonyOption {
+	return func(m *WebauthnCeremonyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebauthnCeremony
+		)
+		m.oldValue = func(ctx context.Context) (*WebauthnCeremony, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebauthnCeremony.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// RemoveCourseIDs removes the "courses" edge to the Course entity by IDs.
-func (m *OrganizationMutation) RemoveCourseIDs(ids ...uuid.UUID) {
-	if m.removedcourses == nil {
-		m.removedcourses = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.courses, ids[i])
-		m.removedcourses[ids[i]] = struct{}{}
+// withWebauthnCeremony sets the old WebauthnCeremony of the mutation.
+func withWebauthnCeremony(node *WebauthnCeremony) webauthnceremonyOption {
+	return func(m *WebauthnCeremonyMutation) {
+		m.oldValue = func(context.Context) (*WebauthnCeremony, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// RemovedCourses returns the removed IDs of the "courses" edge to the Course entity.
-func (m *OrganizationMutation) RemovedCoursesIDs() (ids []uuid.UUID) {
-	for id := range m.removedcourses {
-		ids = append(ids, id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebauthnCeremonyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// CoursesIDs returns the "courses" edge IDs in the mutation.
-func (m *OrganizationMutation) CoursesIDs() (ids []uuid.UUID) {
-	for id := range m.courses {
-		ids = append(ids, id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebauthnCeremonyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetCourses resets all changes to the "courses" edge.
-func (m *OrganizationMutation) ResetCourses() {
-	m.courses = nil
-	m.clearedcourses = false
-	m.removedcourses = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebauthnCeremony entities.
+func (m *WebauthnCeremonyMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *OrganizationMutation) AddGroupIDs(ids ...uuid.UUID) {
-	if m.groups == nil {
-		m.groups = make(map[uuid.UUID]struct{})
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebauthnCeremonyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebauthnCeremonyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebauthnCeremony.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *OrganizationMutation) ClearGroups() {
-	m.clearedgroups = true
+// SetSessionID sets the "session_id" field.
+func (m *WebauthnCeremonyMutation) SetSessionID(s string) {
+	m.session_id = &s
 }
 
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *OrganizationMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *WebauthnCeremonyMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *OrganizationMutation) RemoveGroupIDs(ids ...uuid.UUID) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[uuid.UUID]struct{})
+// OldSessionID returns the old "session_id" field's value of the WebauthnCeremony entity.
+// If the WebauthnCeremony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCeremonyMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
+	return oldValue.SessionID, nil
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *OrganizationMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgroups {
-		ids = append(ids, id)
-	}
-	return
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *WebauthnCeremonyMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *OrganizationMutation) GroupsIDs() (ids []uuid.UUID) {
-	for id := range m.groups {
-		ids = append(ids, id)
-	}
-	return
+// SetExpiresAt sets the "expires_at" field.
+func (m *WebauthnCeremonyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *OrganizationMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *WebauthnCeremonyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
-func (m *OrganizationMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
-	if m.enrollments == nil {
-		m.enrollments = make(map[uuid.UUID]struct{})
+// OldExpiresAt returns the old "expires_at" field's value of the WebauthnCeremony entity.
+// If the WebauthnCeremony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCeremonyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.enrollments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
-func (m *OrganizationMutation) ClearEnrollments() {
-	m.clearedenrollments = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *WebauthnCeremonyMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
-func (m *OrganizationMutation) EnrollmentsCleared() bool {
-	return m.clearedenrollments
+// SetCreatedAt sets the "created_at" field.
+func (m *WebauthnCeremonyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
-func (m *OrganizationMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
-	if m.removedenrollments == nil {
-		m.removedenrollments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.enrollments, ids[i])
-		m.removedenrollments[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebauthnCeremonyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
-func (m *OrganizationMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedenrollments {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the WebauthnCeremony entity.
+// If the WebauthnCeremony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCeremonyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
-func (m *OrganizationMutation) EnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.enrollments {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEnrollments resets all changes to the "enrollments" edge.
-func (m *OrganizationMutation) ResetEnrollments() {
-	m.enrollments = nil
-	m.clearedenrollments = false
-	m.removedenrollments = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebauthnCeremonyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the OrganizationMutation builder.
-func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
+// Where appends a list predicates to the WebauthnCeremonyMutation builder.
+func (m *WebauthnCeremonyMutation) Where(ps ...predicate.WebauthnCeremony) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebauthnCeremonyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Organization, len(ps))
+func (m *WebauthnCeremonyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebauthnCeremony, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7296,42 +19210,33 @@ func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrganizationMutation) Op() Op {
+func (m *WebauthnCeremonyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrganizationMutation) SetOp(op Op) {
+func (m *WebauthnCeremonyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Organization).
-func (m *OrganizationMutation) Type() string {
+// Type returns the node type of this mutation (WebauthnCeremony).
+func (m *WebauthnCeremonyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrganizationMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, organization.FieldName)
-	}
-	if m.slug != nil {
-		fields = append(fields, organization.FieldSlug)
-	}
-	if m.status != nil {
-		fields = append(fields, organization.FieldStatus)
+func (m *WebauthnCeremonyMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.session_id != nil {
+		fields = append(fields, webauthnceremony.FieldSessionID)
 	}
-	if m.settings != nil {
-		fields = append(fields, organization.FieldSettings)
+	if m.expires_at != nil {
+		fields = append(fields, webauthnceremony.FieldExpiresAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, organization.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, organization.FieldUpdatedAt)
+		fields = append(fields, webauthnceremony.FieldCreatedAt)
 	}
 	return fields
 }
@@ -7339,20 +19244,14 @@ func (m *OrganizationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+func (m *WebauthnCeremonyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case organization.FieldName:
-		return m.Name()
-	case organization.FieldSlug:
-		return m.Slug()
-	case organization.FieldStatus:
-		return m.Status()
-	case organization.FieldSettings:
-		return m.Settings()
-	case organization.FieldCreatedAt:
+	case webauthnceremony.FieldSessionID:
+		return m.SessionID()
+	case webauthnceremony.FieldExpiresAt:
+		return m.ExpiresAt()
+	case webauthnceremony.FieldCreatedAt:
 		return m.CreatedAt()
-	case organization.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7360,376 +19259,192 @@ func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebauthnCeremonyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case organization.FieldName:
-		return m.OldName(ctx)
-	case organization.FieldSlug:
-		return m.OldSlug(ctx)
-	case organization.FieldStatus:
-		return m.OldStatus(ctx)
-	case organization.FieldSettings:
-		return m.OldSettings(ctx)
-	case organization.FieldCreatedAt:
+	case webauthnceremony.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case webauthnceremony.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case webauthnceremony.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case organization.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Organization field %s", name)
+	return nil, fmt.Errorf("unknown WebauthnCeremony field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+func (m *WebauthnCeremonyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case organization.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case organization.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case organization.FieldStatus:
+	case webauthnceremony.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
-		return nil
-	case organization.FieldSettings:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSettings(v)
+		m.SetSessionID(v)
 		return nil
-	case organization.FieldCreatedAt:
+	case webauthnceremony.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case organization.FieldUpdatedAt:
+	case webauthnceremony.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	return fmt.Errorf("unknown WebauthnCeremony field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrganizationMutation) AddedFields() []string {
+func (m *WebauthnCeremonyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebauthnCeremonyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+func (m *WebauthnCeremonyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Organization numeric field %s", name)
+	return fmt.Errorf("unknown WebauthnCeremony numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrganizationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(organization.FieldSettings) {
-		fields = append(fields, organization.FieldSettings)
-	}
-	return fields
+func (m *WebauthnCeremonyMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrganizationMutation) FieldCleared(name string) bool {
+func (m *WebauthnCeremonyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrganizationMutation) ClearField(name string) error {
-	switch name {
-	case organization.FieldSettings:
-		m.ClearSettings()
-		return nil
-	}
-	return fmt.Errorf("unknown Organization nullable field %s", name)
+func (m *WebauthnCeremonyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebauthnCeremony nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrganizationMutation) ResetField(name string) error {
+func (m *WebauthnCeremonyMutation) ResetField(name string) error {
 	switch name {
-	case organization.FieldName:
-		m.ResetName()
-		return nil
-	case organization.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case organization.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case organization.FieldSettings:
-		m.ResetSettings()
+	case webauthnceremony.FieldSessionID:
+		m.ResetSessionID()
 		return nil
-	case organization.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webauthnceremony.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case organization.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webauthnceremony.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	return fmt.Errorf("unknown WebauthnCeremony field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrganizationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.users != nil {
-		edges = append(edges, organization.EdgeUsers)
-	}
-	if m.contents != nil {
-		edges = append(edges, organization.EdgeContents)
-	}
-	if m.courses != nil {
-		edges = append(edges, organization.EdgeCourses)
-	}
-	if m.groups != nil {
-		edges = append(edges, organization.EdgeGroups)
-	}
-	if m.enrollments != nil {
-		edges = append(edges, organization.EdgeEnrollments)
-	}
+func (m *WebauthnCeremonyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case organization.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeContents:
-		ids := make([]ent.Value, 0, len(m.contents))
-		for id := range m.contents {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeCourses:
-		ids := make([]ent.Value, 0, len(m.courses))
-		for id := range m.courses {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.enrollments))
-		for id := range m.enrollments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebauthnCeremonyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrganizationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedusers != nil {
-		edges = append(edges, organization.EdgeUsers)
-	}
-	if m.removedcontents != nil {
-		edges = append(edges, organization.EdgeContents)
-	}
-	if m.removedcourses != nil {
-		edges = append(edges, organization.EdgeCourses)
-	}
-	if m.removedgroups != nil {
-		edges = append(edges, organization.EdgeGroups)
-	}
-	if m.removedenrollments != nil {
-		edges = append(edges, organization.EdgeEnrollments)
-	}
+func (m *WebauthnCeremonyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case organization.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeContents:
-		ids := make([]ent.Value, 0, len(m.removedcontents))
-		for id := range m.removedcontents {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeCourses:
-		ids := make([]ent.Value, 0, len(m.removedcourses))
-		for id := range m.removedcourses {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.removedenrollments))
-		for id := range m.removedenrollments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebauthnCeremonyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrganizationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedusers {
-		edges = append(edges, organization.EdgeUsers)
-	}
-	if m.clearedcontents {
-		edges = append(edges, organization.EdgeContents)
-	}
-	if m.clearedcourses {
-		edges = append(edges, organization.EdgeCourses)
-	}
-	if m.clearedgroups {
-		edges = append(edges, organization.EdgeGroups)
-	}
-	if m.clearedenrollments {
-		edges = append(edges, organization.EdgeEnrollments)
-	}
+func (m *WebauthnCeremonyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrganizationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case organization.EdgeUsers:
-		return m.clearedusers
-	case organization.EdgeContents:
-		return m.clearedcontents
-	case organization.EdgeCourses:
-		return m.clearedcourses
-	case organization.EdgeGroups:
-		return m.clearedgroups
-	case organization.EdgeEnrollments:
-		return m.clearedenrollments
-	}
+func (m *WebauthnCeremonyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrganizationMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Organization unique edge %s", name)
+func (m *WebauthnCeremonyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebauthnCeremony unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrganizationMutation) ResetEdge(name string) error {
-	switch name {
-	case organization.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case organization.EdgeContents:
-		m.ResetContents()
-		return nil
-	case organization.EdgeCourses:
-		m.ResetCourses()
-		return nil
-	case organization.EdgeGroups:
-		m.ResetGroups()
-		return nil
-	case organization.EdgeEnrollments:
-		m.ResetEnrollments()
-		return nil
-	}
-	return fmt.Errorf("unknown Organization edge %s", name)
+func (m *WebauthnCeremonyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebauthnCeremony edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebauthnCredentialMutation represents an operation that mutates the WebauthnCredential nodes in the graph.
+type WebauthnCredentialMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	email               *string
-	password_hash       *string
-	role                *string
-	status              *string
-	refresh_token_id    *string
-	last_login_at       *time.Time
-	metadata            *map[string]interface{}
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	organization        *uuid.UUID
-	clearedorganization bool
-	enrollments         map[uuid.UUID]struct{}
-	removedenrollments  map[uuid.UUID]struct{}
-	clearedenrollments  bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	credential_id    *[]byte
+	public_key       *[]byte
+	attestation_type *string
+	aaguid           *[]byte
+	sign_count       *uint32
+	addsign_count    *int32
+	transports       *[]string
+	appendtransports []string
+	backup_eligible  *bool
+	backup_state     *bool
+	name             *string
+	last_used_at     *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*WebauthnCredential, error)
+	predicates       []predicate.WebauthnCredential
+}
+
+var _ ent.Mutation = (*WebauthnCredentialMutation)(nil)
+
+// webauthncredentialOption allows management of the mutation configuration using functional options.
+type webauthncredentialOption func(*WebauthnCredentialMutation)
+
+// newWebauthnCredentialMutation creates new mutation for the WebauthnCredential entity.
+func newWebauthnCredentialMutation(c config, op Op, opts ...webauthncredentialOption) *WebauthnCredentialMutation {
+	m := &WebauthnCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebauthnCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7738,20 +19453,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebauthnCredentialID sets the ID field of the mutation.
+func withWebauthnCredentialID(id uuid.UUID) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebauthnCredential
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebauthnCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebauthnCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7760,10 +19475,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebauthnCredential sets the old WebauthnCredential of the mutation.
+func withWebauthnCredential(node *WebauthnCredential) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
+		m.oldValue = func(context.Context) (*WebauthnCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7772,7 +19487,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebauthnCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7780,7 +19495,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebauthnCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7790,14 +19505,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebauthnCredential entities.
+func (m *WebauthnCredentialMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebauthnCredentialMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7808,7 +19523,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebauthnCredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7817,501 +19532,555 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebauthnCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOrganizationID sets the "organization_id" field.
-func (m *UserMutation) SetOrganizationID(u uuid.UUID) {
-	m.organization = &u
+// SetUserID sets the "user_id" field.
+func (m *WebauthnCredentialMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *UserMutation) OrganizationID() (r uuid.UUID, exists bool) {
-	v := m.organization
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WebauthnCredentialMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebauthnCredentialMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.OrganizationID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *UserMutation) ResetOrganizationID() {
-	m.organization = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WebauthnCredentialMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetCredentialID sets the "credential_id" field.
+func (m *WebauthnCredentialMutation) SetCredentialID(b []byte) {
+	m.credential_id = &b
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *WebauthnCredentialMutation) CredentialID() (r []byte, exists bool) {
+	v := m.credential_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialID returns the old "credential_id" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldCredentialID(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.CredentialID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *WebauthnCredentialMutation) ResetCredentialID() {
+	m.credential_id = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetPublicKey sets the "public_key" field.
+func (m *WebauthnCredentialMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *WebauthnCredentialMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicKey returns the old "public_key" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.PublicKey, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *WebauthnCredentialMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(s string) {
-	m.role = &s
+// SetAttestationType sets the "attestation_type" field.
+func (m *WebauthnCredentialMutation) SetAttestationType(s string) {
+	m.attestation_type = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r string, exists bool) {
-	v := m.role
+// AttestationType returns the value of the "attestation_type" field in the mutation.
+func (m *WebauthnCredentialMutation) AttestationType() (r string, exists bool) {
+	v := m.attestation_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAttestationType returns the old "attestation_type" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldAttestationType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttestationType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldAttestationType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttestationType: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.AttestationType, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ResetAttestationType resets all changes to the "attestation_type" field.
+func (m *WebauthnCredentialMutation) ResetAttestationType() {
+	m.attestation_type = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(s string) {
-	m.status = &s
+// SetAaguid sets the "aaguid" field.
+func (m *WebauthnCredentialMutation) SetAaguid(b []byte) {
+	m.aaguid = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r string, exists bool) {
-	v := m.status
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *WebauthnCredentialMutation) Aaguid() (r []byte, exists bool) {
+	v := m.aaguid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAaguid returns the old "aaguid" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldAaguid(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Aaguid, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *WebauthnCredentialMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[webauthncredential.FieldAaguid] = struct{}{}
 }
 
-// SetRefreshTokenID sets the "refresh_token_id" field.
-func (m *UserMutation) SetRefreshTokenID(s string) {
-	m.refresh_token_id = &s
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAaguid]
+	return ok
 }
 
-// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
-func (m *UserMutation) RefreshTokenID() (r string, exists bool) {
-	v := m.refresh_token_id
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *WebauthnCredentialMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, webauthncredential.FieldAaguid)
+}
+
+// SetSignCount sets the "sign_count" field.
+func (m *WebauthnCredentialMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
+}
+
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *WebauthnCredentialMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefreshTokenID returns the old "refresh_token_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSignCount returns the old "sign_count" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRefreshTokenID(ctx context.Context) (v *string, err error) {
+func (m *WebauthnCredentialMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
 	}
-	return oldValue.RefreshTokenID, nil
+	return oldValue.SignCount, nil
 }
 
-// ClearRefreshTokenID clears the value of the "refresh_token_id" field.
-func (m *UserMutation) ClearRefreshTokenID() {
-	m.refresh_token_id = nil
-	m.clearedFields[user.FieldRefreshTokenID] = struct{}{}
+// AddSignCount adds u to the "sign_count" field.
+func (m *WebauthnCredentialMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
+	} else {
+		m.addsign_count = &u
+	}
 }
 
-// RefreshTokenIDCleared returns if the "refresh_token_id" field was cleared in this mutation.
-func (m *UserMutation) RefreshTokenIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldRefreshTokenID]
-	return ok
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *WebauthnCredentialMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
-func (m *UserMutation) ResetRefreshTokenID() {
-	m.refresh_token_id = nil
-	delete(m.clearedFields, user.FieldRefreshTokenID)
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *WebauthnCredentialMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
 }
 
-// SetLastLoginAt sets the "last_login_at" field.
-func (m *UserMutation) SetLastLoginAt(t time.Time) {
-	m.last_login_at = &t
+// SetTransports sets the "transports" field.
+func (m *WebauthnCredentialMutation) SetTransports(s []string) {
+	m.transports = &s
+	m.appendtransports = nil
 }
 
-// LastLoginAt returns the value of the "last_login_at" field in the mutation.
-func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
-	v := m.last_login_at
+// Transports returns the value of the "transports" field in the mutation.
+func (m *WebauthnCredentialMutation) Transports() (r []string, exists bool) {
+	v := m.transports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTransports returns the old "transports" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebauthnCredentialMutation) OldTransports(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+		return v, errors.New("OldTransports requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
 	}
-	return oldValue.LastLoginAt, nil
+	return oldValue.Transports, nil
 }
 
-// ClearLastLoginAt clears the value of the "last_login_at" field.
-func (m *UserMutation) ClearLastLoginAt() {
-	m.last_login_at = nil
-	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+// AppendTransports adds s to the "transports" field.
+func (m *WebauthnCredentialMutation) AppendTransports(s []string) {
+	m.appendtransports = append(m.appendtransports, s...)
 }
 
-// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
-func (m *UserMutation) LastLoginAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastLoginAt]
+// AppendedTransports returns the list of values that were appended to the "transports" field in this mutation.
+func (m *WebauthnCredentialMutation) AppendedTransports() ([]string, bool) {
+	if len(m.appendtransports) == 0 {
+		return nil, false
+	}
+	return m.appendtransports, true
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (m *WebauthnCredentialMutation) ClearTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	m.clearedFields[webauthncredential.FieldTransports] = struct{}{}
+}
+
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldTransports]
 	return ok
 }
 
-// ResetLastLoginAt resets all changes to the "last_login_at" field.
-func (m *UserMutation) ResetLastLoginAt() {
-	m.last_login_at = nil
-	delete(m.clearedFields, user.FieldLastLoginAt)
+// ResetTransports resets all changes to the "transports" field.
+func (m *WebauthnCredentialMutation) ResetTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	delete(m.clearedFields, webauthncredential.FieldTransports)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *UserMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetBackupEligible sets the "backup_eligible" field.
+func (m *WebauthnCredentialMutation) SetBackupEligible(b bool) {
+	m.backup_eligible = &b
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *UserMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// BackupEligible returns the value of the "backup_eligible" field in the mutation.
+func (m *WebauthnCredentialMutation) BackupEligible() (r bool, exists bool) {
+	v := m.backup_eligible
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldBackupEligible returns the old "backup_eligible" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *WebauthnCredentialMutation) OldBackupEligible(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackupEligible is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldBackupEligible requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackupEligible: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.BackupEligible, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *UserMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[user.FieldMetadata] = struct{}{}
+// ResetBackupEligible resets all changes to the "backup_eligible" field.
+func (m *WebauthnCredentialMutation) ResetBackupEligible() {
+	m.backup_eligible = nil
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *UserMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[user.FieldMetadata]
-	return ok
+// SetBackupState sets the "backup_state" field.
+func (m *WebauthnCredentialMutation) SetBackupState(b bool) {
+	m.backup_state = &b
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *UserMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, user.FieldMetadata)
+// BackupState returns the value of the "backup_state" field in the mutation.
+func (m *WebauthnCredentialMutation) BackupState() (r bool, exists bool) {
+	v := m.backup_state
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// OldBackupState returns the old "backup_state" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldBackupState(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackupState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackupState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackupState: %w", err)
+	}
+	return oldValue.BackupState, nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetBackupState resets all changes to the "backup_state" field.
+func (m *WebauthnCredentialMutation) ResetBackupState() {
+	m.backup_state = nil
+}
+
+// SetName sets the "name" field.
+func (m *WebauthnCredentialMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebauthnCredentialMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebauthnCredentialMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *WebauthnCredentialMutation) ResetName() {
+	m.name = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *WebauthnCredentialMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *WebauthnCredentialMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebauthnCredentialMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[webauthncredential.FieldLastUsedAt] = struct{}{}
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *UserMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[user.FieldOrganizationID] = struct{}{}
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldLastUsedAt]
+	return ok
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *UserMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, webauthncredential.FieldLastUsedAt)
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *WebauthnCredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *UserMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebauthnCredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by ids.
-func (m *UserMutation) AddEnrollmentIDs(ids ...uuid.UUID) {
-	if m.enrollments == nil {
-		m.enrollments = make(map[uuid.UUID]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.enrollments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearEnrollments clears the "enrollments" edge to the Enrollment entity.
-func (m *UserMutation) ClearEnrollments() {
-	m.clearedenrollments = true
-}
-
-// EnrollmentsCleared reports if the "enrollments" edge to the Enrollment entity was cleared.
-func (m *UserMutation) EnrollmentsCleared() bool {
-	return m.clearedenrollments
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebauthnCredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to the Enrollment entity by IDs.
-func (m *UserMutation) RemoveEnrollmentIDs(ids ...uuid.UUID) {
-	if m.removedenrollments == nil {
-		m.removedenrollments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.enrollments, ids[i])
-		m.removedenrollments[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *WebauthnCredentialMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[webauthncredential.FieldUserID] = struct{}{}
 }
 
-// RemovedEnrollments returns the removed IDs of the "enrollments" edge to the Enrollment entity.
-func (m *UserMutation) RemovedEnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedenrollments {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WebauthnCredentialMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// EnrollmentsIDs returns the "enrollments" edge IDs in the mutation.
-func (m *UserMutation) EnrollmentsIDs() (ids []uuid.UUID) {
-	for id := range m.enrollments {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WebauthnCredentialMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEnrollments resets all changes to the "enrollments" edge.
-func (m *UserMutation) ResetEnrollments() {
-	m.enrollments = nil
-	m.clearedenrollments = false
-	m.removedenrollments = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *WebauthnCredentialMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebauthnCredentialMutation builder.
+func (m *WebauthnCredentialMutation) Where(ps ...predicate.WebauthnCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebauthnCredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebauthnCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebauthnCredential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8319,54 +20088,60 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebauthnCredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebauthnCredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebauthnCredential).
+func (m *WebauthnCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.organization != nil {
-		fields = append(fields, user.FieldOrganizationID)
+func (m *WebauthnCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user != nil {
+		fields = append(fields, webauthncredential.FieldUserID)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.credential_id != nil {
+		fields = append(fields, webauthncredential.FieldCredentialID)
 	}
-	if m.password_hash != nil {
-		fields = append(fields, user.FieldPasswordHash)
+	if m.public_key != nil {
+		fields = append(fields, webauthncredential.FieldPublicKey)
 	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+	if m.attestation_type != nil {
+		fields = append(fields, webauthncredential.FieldAttestationType)
 	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.aaguid != nil {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.refresh_token_id != nil {
-		fields = append(fields, user.FieldRefreshTokenID)
+	if m.sign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
 	}
-	if m.last_login_at != nil {
-		fields = append(fields, user.FieldLastLoginAt)
+	if m.transports != nil {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.metadata != nil {
-		fields = append(fields, user.FieldMetadata)
+	if m.backup_eligible != nil {
+		fields = append(fields, webauthncredential.FieldBackupEligible)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.backup_state != nil {
+		fields = append(fields, webauthncredential.FieldBackupState)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.name != nil {
+		fields = append(fields, webauthncredential.FieldName)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webauthncredential.FieldCreatedAt)
 	}
 	return fields
 }
@@ -8374,28 +20149,32 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldOrganizationID:
-		return m.OrganizationID()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPasswordHash:
-		return m.PasswordHash()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldStatus:
-		return m.Status()
-	case user.FieldRefreshTokenID:
-		return m.RefreshTokenID()
-	case user.FieldLastLoginAt:
-		return m.LastLoginAt()
-	case user.FieldMetadata:
-		return m.Metadata()
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldUserID:
+		return m.UserID()
+	case webauthncredential.FieldCredentialID:
+		return m.CredentialID()
+	case webauthncredential.FieldPublicKey:
+		return m.PublicKey()
+	case webauthncredential.FieldAttestationType:
+		return m.AttestationType()
+	case webauthncredential.FieldAaguid:
+		return m.Aaguid()
+	case webauthncredential.FieldSignCount:
+		return m.SignCount()
+	case webauthncredential.FieldTransports:
+		return m.Transports()
+	case webauthncredential.FieldBackupEligible:
+		return m.BackupEligible()
+	case webauthncredential.FieldBackupState:
+		return m.BackupState()
+	case webauthncredential.FieldName:
+		return m.Name()
+	case webauthncredential.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case webauthncredential.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -8403,309 +20182,320 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebauthnCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldOrganizationID:
-		return m.OldOrganizationID(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
-	case user.FieldRefreshTokenID:
-		return m.OldRefreshTokenID(ctx)
-	case user.FieldLastLoginAt:
-		return m.OldLastLoginAt(ctx)
-	case user.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldUserID:
+		return m.OldUserID(ctx)
+	case webauthncredential.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case webauthncredential.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case webauthncredential.FieldAttestationType:
+		return m.OldAttestationType(ctx)
+	case webauthncredential.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case webauthncredential.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case webauthncredential.FieldTransports:
+		return m.OldTransports(ctx)
+	case webauthncredential.FieldBackupEligible:
+		return m.OldBackupEligible(ctx)
+	case webauthncredential.FieldBackupState:
+		return m.OldBackupState(ctx)
+	case webauthncredential.FieldName:
+		return m.OldName(ctx)
+	case webauthncredential.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case webauthncredential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldOrganizationID:
+	case webauthncredential.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrganizationID(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case webauthncredential.FieldCredentialID:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetCredentialID(v)
 		return nil
-	case user.FieldPasswordHash:
-		v, ok := value.(string)
+	case webauthncredential.FieldPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordHash(v)
+		m.SetPublicKey(v)
 		return nil
-	case user.FieldRole:
+	case webauthncredential.FieldAttestationType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetAttestationType(v)
 		return nil
-	case user.FieldStatus:
-		v, ok := value.(string)
+	case webauthncredential.FieldAaguid:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetAaguid(v)
 		return nil
-	case user.FieldRefreshTokenID:
-		v, ok := value.(string)
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshTokenID(v)
+		m.SetSignCount(v)
 		return nil
-	case user.FieldLastLoginAt:
-		v, ok := value.(time.Time)
+	case webauthncredential.FieldTransports:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastLoginAt(v)
+		m.SetTransports(v)
 		return nil
-	case user.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case webauthncredential.FieldBackupEligible:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetBackupEligible(v)
 		return nil
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldBackupState:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackupState(v)
+		return nil
+	case webauthncredential.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webauthncredential.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WebauthnCredentialMutation) AddedFields() []string {
+	var fields []string
+	if m.addsign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webauthncredential.FieldSignCount:
+		return m.AddedSignCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSignCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebauthnCredentialMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldRefreshTokenID) {
-		fields = append(fields, user.FieldRefreshTokenID)
+	if m.FieldCleared(webauthncredential.FieldAaguid) {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.FieldCleared(user.FieldLastLoginAt) {
-		fields = append(fields, user.FieldLastLoginAt)
+	if m.FieldCleared(webauthncredential.FieldTransports) {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.FieldCleared(user.FieldMetadata) {
-		fields = append(fields, user.FieldMetadata)
+	if m.FieldCleared(webauthncredential.FieldLastUsedAt) {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebauthnCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebauthnCredentialMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldRefreshTokenID:
-		m.ClearRefreshTokenID()
+	case webauthncredential.FieldAaguid:
+		m.ClearAaguid()
 		return nil
-	case user.FieldLastLoginAt:
-		m.ClearLastLoginAt()
+	case webauthncredential.FieldTransports:
+		m.ClearTransports()
 		return nil
-	case user.FieldMetadata:
-		m.ClearMetadata()
+	case webauthncredential.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebauthnCredentialMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldOrganizationID:
-		m.ResetOrganizationID()
+	case webauthncredential.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webauthncredential.FieldCredentialID:
+		m.ResetCredentialID()
 		return nil
-	case user.FieldPasswordHash:
-		m.ResetPasswordHash()
+	case webauthncredential.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case user.FieldRole:
-		m.ResetRole()
+	case webauthncredential.FieldAttestationType:
+		m.ResetAttestationType()
 		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
+	case webauthncredential.FieldAaguid:
+		m.ResetAaguid()
 		return nil
-	case user.FieldRefreshTokenID:
-		m.ResetRefreshTokenID()
+	case webauthncredential.FieldSignCount:
+		m.ResetSignCount()
 		return nil
-	case user.FieldLastLoginAt:
-		m.ResetLastLoginAt()
+	case webauthncredential.FieldTransports:
+		m.ResetTransports()
 		return nil
-	case user.FieldMetadata:
-		m.ResetMetadata()
+	case webauthncredential.FieldBackupEligible:
+		m.ResetBackupEligible()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webauthncredential.FieldBackupState:
+		m.ResetBackupState()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webauthncredential.FieldName:
+		m.ResetName()
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case webauthncredential.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.organization != nil {
-		edges = append(edges, user.EdgeOrganization)
-	}
-	if m.enrollments != nil {
-		edges = append(edges, user.EdgeEnrollments)
+func (m *WebauthnCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, webauthncredential.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebauthnCredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case webauthncredential.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case user.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.enrollments))
-		for id := range m.enrollments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedenrollments != nil {
-		edges = append(edges, user.EdgeEnrollments)
-	}
+func (m *WebauthnCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeEnrollments:
-		ids := make([]ent.Value, 0, len(m.removedenrollments))
-		for id := range m.removedenrollments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebauthnCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedorganization {
-		edges = append(edges, user.EdgeOrganization)
-	}
-	if m.clearedenrollments {
-		edges = append(edges, user.EdgeEnrollments)
+func (m *WebauthnCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, webauthncredential.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebauthnCredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeOrganization:
-		return m.clearedorganization
-	case user.EdgeEnrollments:
-		return m.clearedenrollments
+	case webauthncredential.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebauthnCredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeOrganization:
-		m.ClearOrganization()
+	case webauthncredential.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebauthnCredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeOrganization:
-		m.ResetOrganization()
-		return nil
-	case user.EdgeEnrollments:
-		m.ResetEnrollments()
+	case webauthncredential.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential edge %s", name)
 }
@@ -27,6 +27,14 @@ type Organization struct {
 	Status string `json:"status,omitempty"`
 	// Settings holds the value of the "settings" field.
 	Settings map[string]interface{} `json:"settings,omitempty"`
+	// StorageBytesUsed holds the value of the "storage_bytes_used" field.
+	StorageBytesUsed int64 `json:"storage_bytes_used,omitempty"`
+	// StorageObjectsUsed holds the value of the "storage_objects_used" field.
+	StorageObjectsUsed int `json:"storage_objects_used,omitempty"`
+	// StorageAlertLevel holds the value of the "storage_alert_level" field.
+	StorageAlertLevel int `json:"storage_alert_level,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +57,26 @@ type OrganizationEdges struct {
 	Groups []*Group `json:"groups,omitempty"`
 	// Enrollments holds the value of the enrollments edge.
 	Enrollments []*Enrollment `json:"enrollments,omitempty"`
+	// ScimTokens holds the value of the scim_tokens edge.
+	ScimTokens []*ScimToken `json:"scim_tokens,omitempty"`
+	// ServiceAccounts holds the value of the service_accounts edge.
+	ServiceAccounts []*ServiceAccount `json:"service_accounts,omitempty"`
+	// PrivacyRequests holds the value of the privacy_requests edge.
+	PrivacyRequests []*PrivacyRequest `json:"privacy_requests,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
+	// totalCount holds the count of the edges above.
+	totalCount [4]map[string]int
+
+	namedUsers           map[string][]*User
+	namedContents        map[string][]*Content
+	namedCourses         map[string][]*Course
+	namedGroups          map[string][]*Group
+	namedEnrollments     map[string][]*Enrollment
+	namedScimTokens      map[string][]*ScimToken
+	namedServiceAccounts map[string][]*ServiceAccount
+	namedPrivacyRequests map[string][]*PrivacyRequest
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -99,6 +124,33 @@ func (e OrganizationEdges) EnrollmentsOrErr() ([]*Enrollment, error) {
 	return nil, &NotLoadedError{edge: "enrollments"}
 }
 
+// ScimTokensOrErr returns the ScimTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ScimTokensOrErr() ([]*ScimToken, error) {
+	if e.loadedTypes[5] {
+		return e.ScimTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "scim_tokens"}
+}
+
+// ServiceAccountsOrErr returns the ServiceAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ServiceAccountsOrErr() ([]*ServiceAccount, error) {
+	if e.loadedTypes[6] {
+		return e.ServiceAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "service_accounts"}
+}
+
+// PrivacyRequestsOrErr returns the PrivacyRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) PrivacyRequestsOrErr() ([]*PrivacyRequest, error) {
+	if e.loadedTypes[7] {
+		return e.PrivacyRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "privacy_requests"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -106,6 +158,8 @@ func (*Organization) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case organization.FieldSettings:
 			values[i] = new([]byte)
+		case organization.FieldStorageBytesUsed, organization.FieldStorageObjectsUsed, organization.FieldStorageAlertLevel, organization.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case organization.FieldName, organization.FieldSlug, organization.FieldStatus:
 			values[i] = new(sql.NullString)
 		case organization.FieldCreatedAt, organization.FieldUpdatedAt:
@@ -159,6 +213,30 @@ func (o *Organization) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field settings: %w", err)
 				}
 			}
+		case organization.FieldStorageBytesUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_bytes_used", values[i])
+			} else if value.Valid {
+				o.StorageBytesUsed = value.Int64
+			}
+		case organization.FieldStorageObjectsUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_objects_used", values[i])
+			} else if value.Valid {
+				o.StorageObjectsUsed = int(value.Int64)
+			}
+		case organization.FieldStorageAlertLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_alert_level", values[i])
+			} else if value.Valid {
+				o.StorageAlertLevel = int(value.Int64)
+			}
+		case organization.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				o.Version = int(value.Int64)
+			}
 		case organization.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -209,6 +287,21 @@ func (o *Organization) QueryEnrollments() *EnrollmentQuery {
 	return NewOrganizationClient(o.config).QueryEnrollments(o)
 }
 
+// QueryScimTokens queries the "scim_tokens" edge of the Organization entity.
+func (o *Organization) QueryScimTokens() *ScimTokenQuery {
+	return NewOrganizationClient(o.config).QueryScimTokens(o)
+}
+
+// QueryServiceAccounts queries the "service_accounts" edge of the Organization entity.
+func (o *Organization) QueryServiceAccounts() *ServiceAccountQuery {
+	return NewOrganizationClient(o.config).QueryServiceAccounts(o)
+}
+
+// QueryPrivacyRequests queries the "privacy_requests" edge of the Organization entity.
+func (o *Organization) QueryPrivacyRequests() *PrivacyRequestQuery {
+	return NewOrganizationClient(o.config).QueryPrivacyRequests(o)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -244,6 +337,18 @@ func (o *Organization) String() string {
 	builder.WriteString("settings=")
 	builder.WriteString(fmt.Sprintf("%v", o.Settings))
 	builder.WriteString(", ")
+	builder.WriteString("storage_bytes_used=")
+	builder.WriteString(fmt.Sprintf("%v", o.StorageBytesUsed))
+	builder.WriteString(", ")
+	builder.WriteString("storage_objects_used=")
+	builder.WriteString(fmt.Sprintf("%v", o.StorageObjectsUsed))
+	builder.WriteString(", ")
+	builder.WriteString("storage_alert_level=")
+	builder.WriteString(fmt.Sprintf("%v", o.StorageAlertLevel))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", o.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -253,5 +358,197 @@ func (o *Organization) String() string {
 	return builder.String()
 }
 
+// NamedUsers returns the Users named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedUsers(name string) ([]*User, error) {
+	if o.Edges.namedUsers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedUsers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedUsers(name string, edges ...*User) {
+	if o.Edges.namedUsers == nil {
+		o.Edges.namedUsers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedUsers[name] = []*User{}
+	} else {
+		o.Edges.namedUsers[name] = append(o.Edges.namedUsers[name], edges...)
+	}
+}
+
+// NamedContents returns the Contents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedContents(name string) ([]*Content, error) {
+	if o.Edges.namedContents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedContents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedContents(name string, edges ...*Content) {
+	if o.Edges.namedContents == nil {
+		o.Edges.namedContents = make(map[string][]*Content)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedContents[name] = []*Content{}
+	} else {
+		o.Edges.namedContents[name] = append(o.Edges.namedContents[name], edges...)
+	}
+}
+
+// NamedCourses returns the Courses named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedCourses(name string) ([]*Course, error) {
+	if o.Edges.namedCourses == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedCourses[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedCourses(name string, edges ...*Course) {
+	if o.Edges.namedCourses == nil {
+		o.Edges.namedCourses = make(map[string][]*Course)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedCourses[name] = []*Course{}
+	} else {
+		o.Edges.namedCourses[name] = append(o.Edges.namedCourses[name], edges...)
+	}
+}
+
+// NamedGroups returns the Groups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedGroups(name string) ([]*Group, error) {
+	if o.Edges.namedGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedGroups(name string, edges ...*Group) {
+	if o.Edges.namedGroups == nil {
+		o.Edges.namedGroups = make(map[string][]*Group)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedGroups[name] = []*Group{}
+	} else {
+		o.Edges.namedGroups[name] = append(o.Edges.namedGroups[name], edges...)
+	}
+}
+
+// NamedEnrollments returns the Enrollments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedEnrollments(name string) ([]*Enrollment, error) {
+	if o.Edges.namedEnrollments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedEnrollments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedEnrollments(name string, edges ...*Enrollment) {
+	if o.Edges.namedEnrollments == nil {
+		o.Edges.namedEnrollments = make(map[string][]*Enrollment)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedEnrollments[name] = []*Enrollment{}
+	} else {
+		o.Edges.namedEnrollments[name] = append(o.Edges.namedEnrollments[name], edges...)
+	}
+}
+
+// NamedScimTokens returns the ScimTokens named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedScimTokens(name string) ([]*ScimToken, error) {
+	if o.Edges.namedScimTokens == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedScimTokens[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedScimTokens(name string, edges ...*ScimToken) {
+	if o.Edges.namedScimTokens == nil {
+		o.Edges.namedScimTokens = make(map[string][]*ScimToken)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedScimTokens[name] = []*ScimToken{}
+	} else {
+		o.Edges.namedScimTokens[name] = append(o.Edges.namedScimTokens[name], edges...)
+	}
+}
+
+// NamedServiceAccounts returns the ServiceAccounts named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedServiceAccounts(name string) ([]*ServiceAccount, error) {
+	if o.Edges.namedServiceAccounts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedServiceAccounts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedServiceAccounts(name string, edges ...*ServiceAccount) {
+	if o.Edges.namedServiceAccounts == nil {
+		o.Edges.namedServiceAccounts = make(map[string][]*ServiceAccount)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedServiceAccounts[name] = []*ServiceAccount{}
+	} else {
+		o.Edges.namedServiceAccounts[name] = append(o.Edges.namedServiceAccounts[name], edges...)
+	}
+}
+
+// NamedPrivacyRequests returns the PrivacyRequests named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedPrivacyRequests(name string) ([]*PrivacyRequest, error) {
+	if o.Edges.namedPrivacyRequests == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedPrivacyRequests[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedPrivacyRequests(name string, edges ...*PrivacyRequest) {
+	if o.Edges.namedPrivacyRequests == nil {
+		o.Edges.namedPrivacyRequests = make(map[string][]*PrivacyRequest)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedPrivacyRequests[name] = []*PrivacyRequest{}
+	} else {
+		o.Edges.namedPrivacyRequests[name] = append(o.Edges.namedPrivacyRequests[name], edges...)
+	}
+}
+
 // Organizations is a parsable slice of Organization.
 type Organizations []*Organization
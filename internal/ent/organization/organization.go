@@ -23,6 +23,14 @@ const (
 	FieldStatus = "status"
 	// FieldSettings holds the string denoting the settings field in the database.
 	FieldSettings = "settings"
+	// FieldStorageBytesUsed holds the string denoting the storage_bytes_used field in the database.
+	FieldStorageBytesUsed = "storage_bytes_used"
+	// FieldStorageObjectsUsed holds the string denoting the storage_objects_used field in the database.
+	FieldStorageObjectsUsed = "storage_objects_used"
+	// FieldStorageAlertLevel holds the string denoting the storage_alert_level field in the database.
+	FieldStorageAlertLevel = "storage_alert_level"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -37,6 +45,12 @@ const (
 	EdgeGroups = "groups"
 	// EdgeEnrollments holds the string denoting the enrollments edge name in mutations.
 	EdgeEnrollments = "enrollments"
+	// EdgeScimTokens holds the string denoting the scim_tokens edge name in mutations.
+	EdgeScimTokens = "scim_tokens"
+	// EdgeServiceAccounts holds the string denoting the service_accounts edge name in mutations.
+	EdgeServiceAccounts = "service_accounts"
+	// EdgePrivacyRequests holds the string denoting the privacy_requests edge name in mutations.
+	EdgePrivacyRequests = "privacy_requests"
 	// Table holds the table name of the organization in the database.
 	Table = "organizations"
 	// UsersTable is the table that holds the users relation/edge.
@@ -74,6 +88,27 @@ const (
 	EnrollmentsInverseTable = "enrollments"
 	// EnrollmentsColumn is the table column denoting the enrollments relation/edge.
 	EnrollmentsColumn = "organization_id"
+	// ScimTokensTable is the table that holds the scim_tokens relation/edge.
+	ScimTokensTable = "scim_tokens"
+	// ScimTokensInverseTable is the table name for the ScimToken entity.
+	// It exists in this package in order to avoid circular dependency with the "scimtoken" package.
+	ScimTokensInverseTable = "scim_tokens"
+	// ScimTokensColumn is the table column denoting the scim_tokens relation/edge.
+	ScimTokensColumn = "organization_id"
+	// ServiceAccountsTable is the table that holds the service_accounts relation/edge.
+	ServiceAccountsTable = "service_accounts"
+	// ServiceAccountsInverseTable is the table name for the ServiceAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "serviceaccount" package.
+	ServiceAccountsInverseTable = "service_accounts"
+	// ServiceAccountsColumn is the table column denoting the service_accounts relation/edge.
+	ServiceAccountsColumn = "organization_id"
+	// PrivacyRequestsTable is the table that holds the privacy_requests relation/edge.
+	PrivacyRequestsTable = "privacy_requests"
+	// PrivacyRequestsInverseTable is the table name for the PrivacyRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "privacyrequest" package.
+	PrivacyRequestsInverseTable = "privacy_requests"
+	// PrivacyRequestsColumn is the table column denoting the privacy_requests relation/edge.
+	PrivacyRequestsColumn = "organization_id"
 )
 
 // Columns holds all SQL columns for organization fields.
@@ -83,6 +118,10 @@ var Columns = []string{
 	FieldSlug,
 	FieldStatus,
 	FieldSettings,
+	FieldStorageBytesUsed,
+	FieldStorageObjectsUsed,
+	FieldStorageAlertLevel,
+	FieldVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -108,6 +147,14 @@ var (
 	StatusValidator func(string) error
 	// DefaultSettings holds the default value on creation for the "settings" field.
 	DefaultSettings map[string]interface{}
+	// DefaultStorageBytesUsed holds the default value on creation for the "storage_bytes_used" field.
+	DefaultStorageBytesUsed int64
+	// DefaultStorageObjectsUsed holds the default value on creation for the "storage_objects_used" field.
+	DefaultStorageObjectsUsed int
+	// DefaultStorageAlertLevel holds the default value on creation for the "storage_alert_level" field.
+	DefaultStorageAlertLevel int
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -141,6 +188,26 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByStorageBytesUsed orders the results by the storage_bytes_used field.
+func ByStorageBytesUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageBytesUsed, opts...).ToFunc()
+}
+
+// ByStorageObjectsUsed orders the results by the storage_objects_used field.
+func ByStorageObjectsUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageObjectsUsed, opts...).ToFunc()
+}
+
+// ByStorageAlertLevel orders the results by the storage_alert_level field.
+func ByStorageAlertLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageAlertLevel, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -220,6 +287,48 @@ func ByEnrollments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEnrollmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByScimTokensCount orders the results by scim_tokens count.
+func ByScimTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScimTokensStep(), opts...)
+	}
+}
+
+// ByScimTokens orders the results by scim_tokens terms.
+func ByScimTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScimTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByServiceAccountsCount orders the results by service_accounts count.
+func ByServiceAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServiceAccountsStep(), opts...)
+	}
+}
+
+// ByServiceAccounts orders the results by service_accounts terms.
+func ByServiceAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServiceAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPrivacyRequestsCount orders the results by privacy_requests count.
+func ByPrivacyRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrivacyRequestsStep(), opts...)
+	}
+}
+
+// ByPrivacyRequests orders the results by privacy_requests terms.
+func ByPrivacyRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrivacyRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -255,3 +364,24 @@ func newEnrollmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EnrollmentsTable, EnrollmentsColumn),
 	)
 }
+func newScimTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScimTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScimTokensTable, ScimTokensColumn),
+	)
+}
+func newServiceAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServiceAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountsTable, ServiceAccountsColumn),
+	)
+}
+func newPrivacyRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrivacyRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrivacyRequestsTable, PrivacyRequestsColumn),
+	)
+}
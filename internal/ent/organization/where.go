@@ -71,6 +71,26 @@ func Status(v string) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldStatus, v))
 }
 
+// StorageBytesUsed applies equality check predicate on the "storage_bytes_used" field. It's identical to StorageBytesUsedEQ.
+func StorageBytesUsed(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageBytesUsed, v))
+}
+
+// StorageObjectsUsed applies equality check predicate on the "storage_objects_used" field. It's identical to StorageObjectsUsedEQ.
+func StorageObjectsUsed(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageObjectsUsed, v))
+}
+
+// StorageAlertLevel applies equality check predicate on the "storage_alert_level" field. It's identical to StorageAlertLevelEQ.
+func StorageAlertLevel(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageAlertLevel, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -286,6 +306,166 @@ func SettingsNotNil() predicate.Organization {
 	return predicate.Organization(sql.FieldNotNull(FieldSettings))
 }
 
+// StorageBytesUsedEQ applies the EQ predicate on the "storage_bytes_used" field.
+func StorageBytesUsedEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageBytesUsed, v))
+}
+
+// StorageBytesUsedNEQ applies the NEQ predicate on the "storage_bytes_used" field.
+func StorageBytesUsedNEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldStorageBytesUsed, v))
+}
+
+// StorageBytesUsedIn applies the In predicate on the "storage_bytes_used" field.
+func StorageBytesUsedIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldStorageBytesUsed, vs...))
+}
+
+// StorageBytesUsedNotIn applies the NotIn predicate on the "storage_bytes_used" field.
+func StorageBytesUsedNotIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldStorageBytesUsed, vs...))
+}
+
+// StorageBytesUsedGT applies the GT predicate on the "storage_bytes_used" field.
+func StorageBytesUsedGT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldStorageBytesUsed, v))
+}
+
+// StorageBytesUsedGTE applies the GTE predicate on the "storage_bytes_used" field.
+func StorageBytesUsedGTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldStorageBytesUsed, v))
+}
+
+// StorageBytesUsedLT applies the LT predicate on the "storage_bytes_used" field.
+func StorageBytesUsedLT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldStorageBytesUsed, v))
+}
+
+// StorageBytesUsedLTE applies the LTE predicate on the "storage_bytes_used" field.
+func StorageBytesUsedLTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldStorageBytesUsed, v))
+}
+
+// StorageObjectsUsedEQ applies the EQ predicate on the "storage_objects_used" field.
+func StorageObjectsUsedEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageObjectsUsed, v))
+}
+
+// StorageObjectsUsedNEQ applies the NEQ predicate on the "storage_objects_used" field.
+func StorageObjectsUsedNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldStorageObjectsUsed, v))
+}
+
+// StorageObjectsUsedIn applies the In predicate on the "storage_objects_used" field.
+func StorageObjectsUsedIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldStorageObjectsUsed, vs...))
+}
+
+// StorageObjectsUsedNotIn applies the NotIn predicate on the "storage_objects_used" field.
+func StorageObjectsUsedNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldStorageObjectsUsed, vs...))
+}
+
+// StorageObjectsUsedGT applies the GT predicate on the "storage_objects_used" field.
+func StorageObjectsUsedGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldStorageObjectsUsed, v))
+}
+
+// StorageObjectsUsedGTE applies the GTE predicate on the "storage_objects_used" field.
+func StorageObjectsUsedGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldStorageObjectsUsed, v))
+}
+
+// StorageObjectsUsedLT applies the LT predicate on the "storage_objects_used" field.
+func StorageObjectsUsedLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldStorageObjectsUsed, v))
+}
+
+// StorageObjectsUsedLTE applies the LTE predicate on the "storage_objects_used" field.
+func StorageObjectsUsedLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldStorageObjectsUsed, v))
+}
+
+// StorageAlertLevelEQ applies the EQ predicate on the "storage_alert_level" field.
+func StorageAlertLevelEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldStorageAlertLevel, v))
+}
+
+// StorageAlertLevelNEQ applies the NEQ predicate on the "storage_alert_level" field.
+func StorageAlertLevelNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldStorageAlertLevel, v))
+}
+
+// StorageAlertLevelIn applies the In predicate on the "storage_alert_level" field.
+func StorageAlertLevelIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldStorageAlertLevel, vs...))
+}
+
+// StorageAlertLevelNotIn applies the NotIn predicate on the "storage_alert_level" field.
+func StorageAlertLevelNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldStorageAlertLevel, vs...))
+}
+
+// StorageAlertLevelGT applies the GT predicate on the "storage_alert_level" field.
+func StorageAlertLevelGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldStorageAlertLevel, v))
+}
+
+// StorageAlertLevelGTE applies the GTE predicate on the "storage_alert_level" field.
+func StorageAlertLevelGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldStorageAlertLevel, v))
+}
+
+// StorageAlertLevelLT applies the LT predicate on the "storage_alert_level" field.
+func StorageAlertLevelLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldStorageAlertLevel, v))
+}
+
+// StorageAlertLevelLTE applies the LTE predicate on the "storage_alert_level" field.
+func StorageAlertLevelLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldStorageAlertLevel, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -481,6 +661,75 @@ func HasEnrollmentsWith(preds ...predicate.Enrollment) predicate.Organization {
 	})
 }
 
+// HasScimTokens applies the HasEdge predicate on the "scim_tokens" edge.
+func HasScimTokens() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScimTokensTable, ScimTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScimTokensWith applies the HasEdge predicate on the "scim_tokens" edge with a given conditions (other predicates).
+func HasScimTokensWith(preds ...predicate.ScimToken) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newScimTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServiceAccounts applies the HasEdge predicate on the "service_accounts" edge.
+func HasServiceAccounts() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountsTable, ServiceAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceAccountsWith applies the HasEdge predicate on the "service_accounts" edge with a given conditions (other predicates).
+func HasServiceAccountsWith(preds ...predicate.ServiceAccount) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newServiceAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrivacyRequests applies the HasEdge predicate on the "privacy_requests" edge.
+func HasPrivacyRequests() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrivacyRequestsTable, PrivacyRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrivacyRequestsWith applies the HasEdge predicate on the "privacy_requests" edge with a given conditions (other predicates).
+func HasPrivacyRequestsWith(preds ...predicate.PrivacyRequest) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newPrivacyRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Organization) predicate.Organization {
 	return predicate.Organization(sql.AndPredicates(predicates...))
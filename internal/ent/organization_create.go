@@ -11,6 +11,9 @@ import (
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
 	"lms-go/internal/ent/user"
 	"time"
 
@@ -58,6 +61,62 @@ func (oc *OrganizationCreate) SetSettings(m map[string]interface{}) *Organizatio
 	return oc
 }
 
+// SetStorageBytesUsed sets the "storage_bytes_used" field.
+func (oc *OrganizationCreate) SetStorageBytesUsed(i int64) *OrganizationCreate {
+	oc.mutation.SetStorageBytesUsed(i)
+	return oc
+}
+
+// SetNillableStorageBytesUsed sets the "storage_bytes_used" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableStorageBytesUsed(i *int64) *OrganizationCreate {
+	if i != nil {
+		oc.SetStorageBytesUsed(*i)
+	}
+	return oc
+}
+
+// SetStorageObjectsUsed sets the "storage_objects_used" field.
+func (oc *OrganizationCreate) SetStorageObjectsUsed(i int) *OrganizationCreate {
+	oc.mutation.SetStorageObjectsUsed(i)
+	return oc
+}
+
+// SetNillableStorageObjectsUsed sets the "storage_objects_used" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableStorageObjectsUsed(i *int) *OrganizationCreate {
+	if i != nil {
+		oc.SetStorageObjectsUsed(*i)
+	}
+	return oc
+}
+
+// SetStorageAlertLevel sets the "storage_alert_level" field.
+func (oc *OrganizationCreate) SetStorageAlertLevel(i int) *OrganizationCreate {
+	oc.mutation.SetStorageAlertLevel(i)
+	return oc
+}
+
+// SetNillableStorageAlertLevel sets the "storage_alert_level" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableStorageAlertLevel(i *int) *OrganizationCreate {
+	if i != nil {
+		oc.SetStorageAlertLevel(*i)
+	}
+	return oc
+}
+
+// SetVersion sets the "version" field.
+func (oc *OrganizationCreate) SetVersion(i int) *OrganizationCreate {
+	oc.mutation.SetVersion(i)
+	return oc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableVersion(i *int) *OrganizationCreate {
+	if i != nil {
+		oc.SetVersion(*i)
+	}
+	return oc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (oc *OrganizationCreate) SetCreatedAt(t time.Time) *OrganizationCreate {
 	oc.mutation.SetCreatedAt(t)
@@ -175,6 +234,51 @@ func (oc *OrganizationCreate) AddEnrollments(e ...*Enrollment) *OrganizationCrea
 	return oc.AddEnrollmentIDs(ids...)
 }
 
+// AddScimTokenIDs adds the "scim_tokens" edge to the ScimToken entity by IDs.
+func (oc *OrganizationCreate) AddScimTokenIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddScimTokenIDs(ids...)
+	return oc
+}
+
+// AddScimTokens adds the "scim_tokens" edges to the ScimToken entity.
+func (oc *OrganizationCreate) AddScimTokens(s ...*ScimToken) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oc.AddScimTokenIDs(ids...)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the ServiceAccount entity by IDs.
+func (oc *OrganizationCreate) AddServiceAccountIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddServiceAccountIDs(ids...)
+	return oc
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the ServiceAccount entity.
+func (oc *OrganizationCreate) AddServiceAccounts(s ...*ServiceAccount) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oc.AddServiceAccountIDs(ids...)
+}
+
+// AddPrivacyRequestIDs adds the "privacy_requests" edge to the PrivacyRequest entity by IDs.
+func (oc *OrganizationCreate) AddPrivacyRequestIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddPrivacyRequestIDs(ids...)
+	return oc
+}
+
+// AddPrivacyRequests adds the "privacy_requests" edges to the PrivacyRequest entity.
+func (oc *OrganizationCreate) AddPrivacyRequests(p ...*PrivacyRequest) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return oc.AddPrivacyRequestIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (oc *OrganizationCreate) Mutation() *OrganizationMutation {
 	return oc.mutation
@@ -218,6 +322,22 @@ func (oc *OrganizationCreate) defaults() {
 		v := organization.DefaultSettings
 		oc.mutation.SetSettings(v)
 	}
+	if _, ok := oc.mutation.StorageBytesUsed(); !ok {
+		v := organization.DefaultStorageBytesUsed
+		oc.mutation.SetStorageBytesUsed(v)
+	}
+	if _, ok := oc.mutation.StorageObjectsUsed(); !ok {
+		v := organization.DefaultStorageObjectsUsed
+		oc.mutation.SetStorageObjectsUsed(v)
+	}
+	if _, ok := oc.mutation.StorageAlertLevel(); !ok {
+		v := organization.DefaultStorageAlertLevel
+		oc.mutation.SetStorageAlertLevel(v)
+	}
+	if _, ok := oc.mutation.Version(); !ok {
+		v := organization.DefaultVersion
+		oc.mutation.SetVersion(v)
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		v := organization.DefaultCreatedAt()
 		oc.mutation.SetCreatedAt(v)
@@ -258,6 +378,18 @@ func (oc *OrganizationCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Organization.status": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.StorageBytesUsed(); !ok {
+		return &ValidationError{Name: "storage_bytes_used", err: errors.New(`ent: missing required field "Organization.storage_bytes_used"`)}
+	}
+	if _, ok := oc.mutation.StorageObjectsUsed(); !ok {
+		return &ValidationError{Name: "storage_objects_used", err: errors.New(`ent: missing required field "Organization.storage_objects_used"`)}
+	}
+	if _, ok := oc.mutation.StorageAlertLevel(); !ok {
+		return &ValidationError{Name: "storage_alert_level", err: errors.New(`ent: missing required field "Organization.storage_alert_level"`)}
+	}
+	if _, ok := oc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Organization.version"`)}
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Organization.created_at"`)}
 	}
@@ -315,6 +447,22 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldSettings, field.TypeJSON, value)
 		_node.Settings = value
 	}
+	if value, ok := oc.mutation.StorageBytesUsed(); ok {
+		_spec.SetField(organization.FieldStorageBytesUsed, field.TypeInt64, value)
+		_node.StorageBytesUsed = value
+	}
+	if value, ok := oc.mutation.StorageObjectsUsed(); ok {
+		_spec.SetField(organization.FieldStorageObjectsUsed, field.TypeInt, value)
+		_node.StorageObjectsUsed = value
+	}
+	if value, ok := oc.mutation.StorageAlertLevel(); ok {
+		_spec.SetField(organization.FieldStorageAlertLevel, field.TypeInt, value)
+		_node.StorageAlertLevel = value
+	}
+	if value, ok := oc.mutation.Version(); ok {
+		_spec.SetField(organization.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := oc.mutation.CreatedAt(); ok {
 		_spec.SetField(organization.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -403,6 +551,54 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.ScimTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.PrivacyRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
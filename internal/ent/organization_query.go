@@ -12,9 +12,13 @@ import (
 	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
 	"lms-go/internal/ent/user"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,15 +28,28 @@ import (
 // OrganizationQuery is the builder for querying Organization entities.
 type OrganizationQuery struct {
 	config
-	ctx             *QueryContext
-	order           []organization.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Organization
-	withUsers       *UserQuery
-	withContents    *ContentQuery
-	withCourses     *CourseQuery
-	withGroups      *GroupQuery
-	withEnrollments *EnrollmentQuery
+	ctx                      *QueryContext
+	order                    []organization.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Organization
+	withUsers                *UserQuery
+	withContents             *ContentQuery
+	withCourses              *CourseQuery
+	withGroups               *GroupQuery
+	withEnrollments          *EnrollmentQuery
+	withScimTokens           *ScimTokenQuery
+	withServiceAccounts      *ServiceAccountQuery
+	withPrivacyRequests      *PrivacyRequestQuery
+	modifiers                []func(*sql.Selector)
+	loadTotal                []func(context.Context, []*Organization) error
+	withNamedUsers           map[string]*UserQuery
+	withNamedContents        map[string]*ContentQuery
+	withNamedCourses         map[string]*CourseQuery
+	withNamedGroups          map[string]*GroupQuery
+	withNamedEnrollments     map[string]*EnrollmentQuery
+	withNamedScimTokens      map[string]*ScimTokenQuery
+	withNamedServiceAccounts map[string]*ServiceAccountQuery
+	withNamedPrivacyRequests map[string]*PrivacyRequestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -179,10 +196,76 @@ func (oq *OrganizationQuery) QueryEnrollments() *EnrollmentQuery {
 	return query
 }
 
+// QueryScimTokens chains the current query on the "scim_tokens" edge.
+func (oq *OrganizationQuery) QueryScimTokens() *ScimTokenQuery {
+	query := (&ScimTokenClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(scimtoken.Table, scimtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ScimTokensTable, organization.ScimTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryServiceAccounts chains the current query on the "service_accounts" edge.
+func (oq *OrganizationQuery) QueryServiceAccounts() *ServiceAccountQuery {
+	query := (&ServiceAccountClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(serviceaccount.Table, serviceaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ServiceAccountsTable, organization.ServiceAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrivacyRequests chains the current query on the "privacy_requests" edge.
+func (oq *OrganizationQuery) QueryPrivacyRequests() *PrivacyRequestQuery {
+	query := (&PrivacyRequestClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(privacyrequest.Table, privacyrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.PrivacyRequestsTable, organization.PrivacyRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Organization entity from the query.
 // Returns a *NotFoundError when no Organization was found.
 func (oq *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
-	nodes, err := oq.Limit(1).All(setContextOp(ctx, oq.ctx, "First"))
+	nodes, err := oq.Limit(1).All(setContextOp(ctx, oq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +288,7 @@ func (oq *OrganizationQuery) FirstX(ctx context.Context) *Organization {
 // Returns a *NotFoundError when no Organization ID was found.
 func (oq *OrganizationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = oq.Limit(1).IDs(setContextOp(ctx, oq.ctx, "FirstID")); err != nil {
+	if ids, err = oq.Limit(1).IDs(setContextOp(ctx, oq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -228,7 +311,7 @@ func (oq *OrganizationQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Returns a *NotSingularError when more than one Organization entity is found.
 // Returns a *NotFoundError when no Organization entities are found.
 func (oq *OrganizationQuery) Only(ctx context.Context) (*Organization, error) {
-	nodes, err := oq.Limit(2).All(setContextOp(ctx, oq.ctx, "Only"))
+	nodes, err := oq.Limit(2).All(setContextOp(ctx, oq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +339,7 @@ func (oq *OrganizationQuery) OnlyX(ctx context.Context) *Organization {
 // Returns a *NotFoundError when no entities are found.
 func (oq *OrganizationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = oq.Limit(2).IDs(setContextOp(ctx, oq.ctx, "OnlyID")); err != nil {
+	if ids, err = oq.Limit(2).IDs(setContextOp(ctx, oq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -281,7 +364,7 @@ func (oq *OrganizationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 
 // All executes the query and returns a list of Organizations.
 func (oq *OrganizationQuery) All(ctx context.Context) ([]*Organization, error) {
-	ctx = setContextOp(ctx, oq.ctx, "All")
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryAll)
 	if err := oq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -303,7 +386,7 @@ func (oq *OrganizationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err erro
 	if oq.ctx.Unique == nil && oq.path != nil {
 		oq.Unique(true)
 	}
-	ctx = setContextOp(ctx, oq.ctx, "IDs")
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryIDs)
 	if err = oq.Select(organization.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -321,7 +404,7 @@ func (oq *OrganizationQuery) IDsX(ctx context.Context) []uuid.UUID {
 
 // Count returns the count of the given query.
 func (oq *OrganizationQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, oq.ctx, "Count")
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryCount)
 	if err := oq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -339,7 +422,7 @@ func (oq *OrganizationQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (oq *OrganizationQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, oq.ctx, "Exist")
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryExist)
 	switch _, err := oq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -366,16 +449,19 @@ func (oq *OrganizationQuery) Clone() *OrganizationQuery {
 		return nil
 	}
 	return &OrganizationQuery{
-		config:          oq.config,
-		ctx:             oq.ctx.Clone(),
-		order:           append([]organization.OrderOption{}, oq.order...),
-		inters:          append([]Interceptor{}, oq.inters...),
-		predicates:      append([]predicate.Organization{}, oq.predicates...),
-		withUsers:       oq.withUsers.Clone(),
-		withContents:    oq.withContents.Clone(),
-		withCourses:     oq.withCourses.Clone(),
-		withGroups:      oq.withGroups.Clone(),
-		withEnrollments: oq.withEnrollments.Clone(),
+		config:              oq.config,
+		ctx:                 oq.ctx.Clone(),
+		order:               append([]organization.OrderOption{}, oq.order...),
+		inters:              append([]Interceptor{}, oq.inters...),
+		predicates:          append([]predicate.Organization{}, oq.predicates...),
+		withUsers:           oq.withUsers.Clone(),
+		withContents:        oq.withContents.Clone(),
+		withCourses:         oq.withCourses.Clone(),
+		withGroups:          oq.withGroups.Clone(),
+		withEnrollments:     oq.withEnrollments.Clone(),
+		withScimTokens:      oq.withScimTokens.Clone(),
+		withServiceAccounts: oq.withServiceAccounts.Clone(),
+		withPrivacyRequests: oq.withPrivacyRequests.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
@@ -437,6 +523,39 @@ func (oq *OrganizationQuery) WithEnrollments(opts ...func(*EnrollmentQuery)) *Or
 	return oq
 }
 
+// WithScimTokens tells the query-builder to eager-load the nodes that are connected to
+// the "scim_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithScimTokens(opts ...func(*ScimTokenQuery)) *OrganizationQuery {
+	query := (&ScimTokenClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withScimTokens = query
+	return oq
+}
+
+// WithServiceAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "service_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithServiceAccounts(opts ...func(*ServiceAccountQuery)) *OrganizationQuery {
+	query := (&ServiceAccountClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withServiceAccounts = query
+	return oq
+}
+
+// WithPrivacyRequests tells the query-builder to eager-load the nodes that are connected to
+// the "privacy_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithPrivacyRequests(opts ...func(*PrivacyRequestQuery)) *OrganizationQuery {
+	query := (&PrivacyRequestClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withPrivacyRequests = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -515,12 +634,15 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Organization{}
 		_spec       = oq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			oq.withUsers != nil,
 			oq.withContents != nil,
 			oq.withCourses != nil,
 			oq.withGroups != nil,
 			oq.withEnrollments != nil,
+			oq.withScimTokens != nil,
+			oq.withServiceAccounts != nil,
+			oq.withPrivacyRequests != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -532,6 +654,9 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -576,6 +701,88 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := oq.withScimTokens; query != nil {
+		if err := oq.loadScimTokens(ctx, query, nodes,
+			func(n *Organization) { n.Edges.ScimTokens = []*ScimToken{} },
+			func(n *Organization, e *ScimToken) { n.Edges.ScimTokens = append(n.Edges.ScimTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withServiceAccounts; query != nil {
+		if err := oq.loadServiceAccounts(ctx, query, nodes,
+			func(n *Organization) { n.Edges.ServiceAccounts = []*ServiceAccount{} },
+			func(n *Organization, e *ServiceAccount) { n.Edges.ServiceAccounts = append(n.Edges.ServiceAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withPrivacyRequests; query != nil {
+		if err := oq.loadPrivacyRequests(ctx, query, nodes,
+			func(n *Organization) { n.Edges.PrivacyRequests = []*PrivacyRequest{} },
+			func(n *Organization, e *PrivacyRequest) { n.Edges.PrivacyRequests = append(n.Edges.PrivacyRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedUsers {
+		if err := oq.loadUsers(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedUsers(name) },
+			func(n *Organization, e *User) { n.appendNamedUsers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedContents {
+		if err := oq.loadContents(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedContents(name) },
+			func(n *Organization, e *Content) { n.appendNamedContents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedCourses {
+		if err := oq.loadCourses(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedCourses(name) },
+			func(n *Organization, e *Course) { n.appendNamedCourses(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedGroups {
+		if err := oq.loadGroups(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedGroups(name) },
+			func(n *Organization, e *Group) { n.appendNamedGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedEnrollments {
+		if err := oq.loadEnrollments(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedEnrollments(name) },
+			func(n *Organization, e *Enrollment) { n.appendNamedEnrollments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedScimTokens {
+		if err := oq.loadScimTokens(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedScimTokens(name) },
+			func(n *Organization, e *ScimToken) { n.appendNamedScimTokens(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedServiceAccounts {
+		if err := oq.loadServiceAccounts(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedServiceAccounts(name) },
+			func(n *Organization, e *ServiceAccount) { n.appendNamedServiceAccounts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range oq.withNamedPrivacyRequests {
+		if err := oq.loadPrivacyRequests(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedPrivacyRequests(name) },
+			func(n *Organization, e *PrivacyRequest) { n.appendNamedPrivacyRequests(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range oq.loadTotal {
+		if err := oq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -729,9 +936,102 @@ func (oq *OrganizationQuery) loadEnrollments(ctx context.Context, query *Enrollm
 	}
 	return nil
 }
+func (oq *OrganizationQuery) loadScimTokens(ctx context.Context, query *ScimTokenQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *ScimToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scimtoken.FieldOrganizationID)
+	}
+	query.Where(predicate.ScimToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.ScimTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadServiceAccounts(ctx context.Context, query *ServiceAccountQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *ServiceAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(serviceaccount.FieldOrganizationID)
+	}
+	query.Where(predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.ServiceAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadPrivacyRequests(ctx context.Context, query *PrivacyRequestQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *PrivacyRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(privacyrequest.FieldOrganizationID)
+	}
+	query.Where(predicate.PrivacyRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.PrivacyRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (oq *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	_spec.Node.Columns = oq.ctx.Fields
 	if len(oq.ctx.Fields) > 0 {
 		_spec.Unique = oq.ctx.Unique != nil && *oq.ctx.Unique
@@ -811,6 +1111,118 @@ func (oq *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedUsers tells the query-builder to eager-load the nodes that are connected to the "users"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedUsers(name string, opts ...func(*UserQuery)) *OrganizationQuery {
+	query := (&UserClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedUsers == nil {
+		oq.withNamedUsers = make(map[string]*UserQuery)
+	}
+	oq.withNamedUsers[name] = query
+	return oq
+}
+
+// WithNamedContents tells the query-builder to eager-load the nodes that are connected to the "contents"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedContents(name string, opts ...func(*ContentQuery)) *OrganizationQuery {
+	query := (&ContentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedContents == nil {
+		oq.withNamedContents = make(map[string]*ContentQuery)
+	}
+	oq.withNamedContents[name] = query
+	return oq
+}
+
+// WithNamedCourses tells the query-builder to eager-load the nodes that are connected to the "courses"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedCourses(name string, opts ...func(*CourseQuery)) *OrganizationQuery {
+	query := (&CourseClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedCourses == nil {
+		oq.withNamedCourses = make(map[string]*CourseQuery)
+	}
+	oq.withNamedCourses[name] = query
+	return oq
+}
+
+// WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *OrganizationQuery {
+	query := (&GroupClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedGroups == nil {
+		oq.withNamedGroups = make(map[string]*GroupQuery)
+	}
+	oq.withNamedGroups[name] = query
+	return oq
+}
+
+// WithNamedEnrollments tells the query-builder to eager-load the nodes that are connected to the "enrollments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedEnrollments(name string, opts ...func(*EnrollmentQuery)) *OrganizationQuery {
+	query := (&EnrollmentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedEnrollments == nil {
+		oq.withNamedEnrollments = make(map[string]*EnrollmentQuery)
+	}
+	oq.withNamedEnrollments[name] = query
+	return oq
+}
+
+// WithNamedScimTokens tells the query-builder to eager-load the nodes that are connected to the "scim_tokens"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedScimTokens(name string, opts ...func(*ScimTokenQuery)) *OrganizationQuery {
+	query := (&ScimTokenClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedScimTokens == nil {
+		oq.withNamedScimTokens = make(map[string]*ScimTokenQuery)
+	}
+	oq.withNamedScimTokens[name] = query
+	return oq
+}
+
+// WithNamedServiceAccounts tells the query-builder to eager-load the nodes that are connected to the "service_accounts"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedServiceAccounts(name string, opts ...func(*ServiceAccountQuery)) *OrganizationQuery {
+	query := (&ServiceAccountClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedServiceAccounts == nil {
+		oq.withNamedServiceAccounts = make(map[string]*ServiceAccountQuery)
+	}
+	oq.withNamedServiceAccounts[name] = query
+	return oq
+}
+
+// WithNamedPrivacyRequests tells the query-builder to eager-load the nodes that are connected to the "privacy_requests"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedPrivacyRequests(name string, opts ...func(*PrivacyRequestQuery)) *OrganizationQuery {
+	query := (&PrivacyRequestClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedPrivacyRequests == nil {
+		oq.withNamedPrivacyRequests = make(map[string]*PrivacyRequestQuery)
+	}
+	oq.withNamedPrivacyRequests[name] = query
+	return oq
+}
+
 // OrganizationGroupBy is the group-by builder for Organization entities.
 type OrganizationGroupBy struct {
 	selector
@@ -825,7 +1237,7 @@ func (ogb *OrganizationGroupBy) Aggregate(fns ...AggregateFunc) *OrganizationGro
 
 // Scan applies the selector query and scans the result into the given value.
 func (ogb *OrganizationGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, ogb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, ogb.build.ctx, ent.OpQueryGroupBy)
 	if err := ogb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -873,7 +1285,7 @@ func (os *OrganizationSelect) Aggregate(fns ...AggregateFunc) *OrganizationSelec
 
 // Scan applies the selector query and scans the result into the given value.
 func (os *OrganizationSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, os.ctx, "Select")
+	ctx = setContextOp(ctx, os.ctx, ent.OpQuerySelect)
 	if err := os.prepareQuery(ctx); err != nil {
 		return err
 	}
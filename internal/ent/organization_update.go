@@ -12,6 +12,9 @@ import (
 	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
 	"lms-go/internal/ent/user"
 	"time"
 
@@ -88,6 +91,90 @@ func (ou *OrganizationUpdate) ClearSettings() *OrganizationUpdate {
 	return ou
 }
 
+// SetStorageBytesUsed sets the "storage_bytes_used" field.
+func (ou *OrganizationUpdate) SetStorageBytesUsed(i int64) *OrganizationUpdate {
+	ou.mutation.ResetStorageBytesUsed()
+	ou.mutation.SetStorageBytesUsed(i)
+	return ou
+}
+
+// SetNillableStorageBytesUsed sets the "storage_bytes_used" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableStorageBytesUsed(i *int64) *OrganizationUpdate {
+	if i != nil {
+		ou.SetStorageBytesUsed(*i)
+	}
+	return ou
+}
+
+// AddStorageBytesUsed adds i to the "storage_bytes_used" field.
+func (ou *OrganizationUpdate) AddStorageBytesUsed(i int64) *OrganizationUpdate {
+	ou.mutation.AddStorageBytesUsed(i)
+	return ou
+}
+
+// SetStorageObjectsUsed sets the "storage_objects_used" field.
+func (ou *OrganizationUpdate) SetStorageObjectsUsed(i int) *OrganizationUpdate {
+	ou.mutation.ResetStorageObjectsUsed()
+	ou.mutation.SetStorageObjectsUsed(i)
+	return ou
+}
+
+// SetNillableStorageObjectsUsed sets the "storage_objects_used" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableStorageObjectsUsed(i *int) *OrganizationUpdate {
+	if i != nil {
+		ou.SetStorageObjectsUsed(*i)
+	}
+	return ou
+}
+
+// AddStorageObjectsUsed adds i to the "storage_objects_used" field.
+func (ou *OrganizationUpdate) AddStorageObjectsUsed(i int) *OrganizationUpdate {
+	ou.mutation.AddStorageObjectsUsed(i)
+	return ou
+}
+
+// SetStorageAlertLevel sets the "storage_alert_level" field.
+func (ou *OrganizationUpdate) SetStorageAlertLevel(i int) *OrganizationUpdate {
+	ou.mutation.ResetStorageAlertLevel()
+	ou.mutation.SetStorageAlertLevel(i)
+	return ou
+}
+
+// SetNillableStorageAlertLevel sets the "storage_alert_level" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableStorageAlertLevel(i *int) *OrganizationUpdate {
+	if i != nil {
+		ou.SetStorageAlertLevel(*i)
+	}
+	return ou
+}
+
+// AddStorageAlertLevel adds i to the "storage_alert_level" field.
+func (ou *OrganizationUpdate) AddStorageAlertLevel(i int) *OrganizationUpdate {
+	ou.mutation.AddStorageAlertLevel(i)
+	return ou
+}
+
+// SetVersion sets the "version" field.
+func (ou *OrganizationUpdate) SetVersion(i int) *OrganizationUpdate {
+	ou.mutation.ResetVersion()
+	ou.mutation.SetVersion(i)
+	return ou
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableVersion(i *int) *OrganizationUpdate {
+	if i != nil {
+		ou.SetVersion(*i)
+	}
+	return ou
+}
+
+// AddVersion adds i to the "version" field.
+func (ou *OrganizationUpdate) AddVersion(i int) *OrganizationUpdate {
+	ou.mutation.AddVersion(i)
+	return ou
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ou *OrganizationUpdate) SetUpdatedAt(t time.Time) *OrganizationUpdate {
 	ou.mutation.SetUpdatedAt(t)
@@ -169,6 +256,51 @@ func (ou *OrganizationUpdate) AddEnrollments(e ...*Enrollment) *OrganizationUpda
 	return ou.AddEnrollmentIDs(ids...)
 }
 
+// AddScimTokenIDs adds the "scim_tokens" edge to the ScimToken entity by IDs.
+func (ou *OrganizationUpdate) AddScimTokenIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddScimTokenIDs(ids...)
+	return ou
+}
+
+// AddScimTokens adds the "scim_tokens" edges to the ScimToken entity.
+func (ou *OrganizationUpdate) AddScimTokens(s ...*ScimToken) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddScimTokenIDs(ids...)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the ServiceAccount entity by IDs.
+func (ou *OrganizationUpdate) AddServiceAccountIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddServiceAccountIDs(ids...)
+	return ou
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the ServiceAccount entity.
+func (ou *OrganizationUpdate) AddServiceAccounts(s ...*ServiceAccount) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddServiceAccountIDs(ids...)
+}
+
+// AddPrivacyRequestIDs adds the "privacy_requests" edge to the PrivacyRequest entity by IDs.
+func (ou *OrganizationUpdate) AddPrivacyRequestIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddPrivacyRequestIDs(ids...)
+	return ou
+}
+
+// AddPrivacyRequests adds the "privacy_requests" edges to the PrivacyRequest entity.
+func (ou *OrganizationUpdate) AddPrivacyRequests(p ...*PrivacyRequest) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.AddPrivacyRequestIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ou *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return ou.mutation
@@ -279,6 +411,69 @@ func (ou *OrganizationUpdate) RemoveEnrollments(e ...*Enrollment) *OrganizationU
 	return ou.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearScimTokens clears all "scim_tokens" edges to the ScimToken entity.
+func (ou *OrganizationUpdate) ClearScimTokens() *OrganizationUpdate {
+	ou.mutation.ClearScimTokens()
+	return ou
+}
+
+// RemoveScimTokenIDs removes the "scim_tokens" edge to ScimToken entities by IDs.
+func (ou *OrganizationUpdate) RemoveScimTokenIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemoveScimTokenIDs(ids...)
+	return ou
+}
+
+// RemoveScimTokens removes "scim_tokens" edges to ScimToken entities.
+func (ou *OrganizationUpdate) RemoveScimTokens(s ...*ScimToken) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveScimTokenIDs(ids...)
+}
+
+// ClearServiceAccounts clears all "service_accounts" edges to the ServiceAccount entity.
+func (ou *OrganizationUpdate) ClearServiceAccounts() *OrganizationUpdate {
+	ou.mutation.ClearServiceAccounts()
+	return ou
+}
+
+// RemoveServiceAccountIDs removes the "service_accounts" edge to ServiceAccount entities by IDs.
+func (ou *OrganizationUpdate) RemoveServiceAccountIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemoveServiceAccountIDs(ids...)
+	return ou
+}
+
+// RemoveServiceAccounts removes "service_accounts" edges to ServiceAccount entities.
+func (ou *OrganizationUpdate) RemoveServiceAccounts(s ...*ServiceAccount) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveServiceAccountIDs(ids...)
+}
+
+// ClearPrivacyRequests clears all "privacy_requests" edges to the PrivacyRequest entity.
+func (ou *OrganizationUpdate) ClearPrivacyRequests() *OrganizationUpdate {
+	ou.mutation.ClearPrivacyRequests()
+	return ou
+}
+
+// RemovePrivacyRequestIDs removes the "privacy_requests" edge to PrivacyRequest entities by IDs.
+func (ou *OrganizationUpdate) RemovePrivacyRequestIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemovePrivacyRequestIDs(ids...)
+	return ou
+}
+
+// RemovePrivacyRequests removes "privacy_requests" edges to PrivacyRequest entities.
+func (ou *OrganizationUpdate) RemovePrivacyRequests(p ...*PrivacyRequest) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.RemovePrivacyRequestIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -362,6 +557,30 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ou.mutation.SettingsCleared() {
 		_spec.ClearField(organization.FieldSettings, field.TypeJSON)
 	}
+	if value, ok := ou.mutation.StorageBytesUsed(); ok {
+		_spec.SetField(organization.FieldStorageBytesUsed, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedStorageBytesUsed(); ok {
+		_spec.AddField(organization.FieldStorageBytesUsed, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.StorageObjectsUsed(); ok {
+		_spec.SetField(organization.FieldStorageObjectsUsed, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedStorageObjectsUsed(); ok {
+		_spec.AddField(organization.FieldStorageObjectsUsed, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.StorageAlertLevel(); ok {
+		_spec.SetField(organization.FieldStorageAlertLevel, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedStorageAlertLevel(); ok {
+		_spec.AddField(organization.FieldStorageAlertLevel, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.Version(); ok {
+		_spec.SetField(organization.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedVersion(); ok {
+		_spec.AddField(organization.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -590,6 +809,141 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.ScimTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedScimTokensIDs(); len(nodes) > 0 && !ou.mutation.ScimTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ScimTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedServiceAccountsIDs(); len(nodes) > 0 && !ou.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.PrivacyRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedPrivacyRequestsIDs(); len(nodes) > 0 && !ou.mutation.PrivacyRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.PrivacyRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organization.Label}
@@ -664,6 +1018,90 @@ func (ouo *OrganizationUpdateOne) ClearSettings() *OrganizationUpdateOne {
 	return ouo
 }
 
+// SetStorageBytesUsed sets the "storage_bytes_used" field.
+func (ouo *OrganizationUpdateOne) SetStorageBytesUsed(i int64) *OrganizationUpdateOne {
+	ouo.mutation.ResetStorageBytesUsed()
+	ouo.mutation.SetStorageBytesUsed(i)
+	return ouo
+}
+
+// SetNillableStorageBytesUsed sets the "storage_bytes_used" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableStorageBytesUsed(i *int64) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetStorageBytesUsed(*i)
+	}
+	return ouo
+}
+
+// AddStorageBytesUsed adds i to the "storage_bytes_used" field.
+func (ouo *OrganizationUpdateOne) AddStorageBytesUsed(i int64) *OrganizationUpdateOne {
+	ouo.mutation.AddStorageBytesUsed(i)
+	return ouo
+}
+
+// SetStorageObjectsUsed sets the "storage_objects_used" field.
+func (ouo *OrganizationUpdateOne) SetStorageObjectsUsed(i int) *OrganizationUpdateOne {
+	ouo.mutation.ResetStorageObjectsUsed()
+	ouo.mutation.SetStorageObjectsUsed(i)
+	return ouo
+}
+
+// SetNillableStorageObjectsUsed sets the "storage_objects_used" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableStorageObjectsUsed(i *int) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetStorageObjectsUsed(*i)
+	}
+	return ouo
+}
+
+// AddStorageObjectsUsed adds i to the "storage_objects_used" field.
+func (ouo *OrganizationUpdateOne) AddStorageObjectsUsed(i int) *OrganizationUpdateOne {
+	ouo.mutation.AddStorageObjectsUsed(i)
+	return ouo
+}
+
+// SetStorageAlertLevel sets the "storage_alert_level" field.
+func (ouo *OrganizationUpdateOne) SetStorageAlertLevel(i int) *OrganizationUpdateOne {
+	ouo.mutation.ResetStorageAlertLevel()
+	ouo.mutation.SetStorageAlertLevel(i)
+	return ouo
+}
+
+// SetNillableStorageAlertLevel sets the "storage_alert_level" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableStorageAlertLevel(i *int) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetStorageAlertLevel(*i)
+	}
+	return ouo
+}
+
+// AddStorageAlertLevel adds i to the "storage_alert_level" field.
+func (ouo *OrganizationUpdateOne) AddStorageAlertLevel(i int) *OrganizationUpdateOne {
+	ouo.mutation.AddStorageAlertLevel(i)
+	return ouo
+}
+
+// SetVersion sets the "version" field.
+func (ouo *OrganizationUpdateOne) SetVersion(i int) *OrganizationUpdateOne {
+	ouo.mutation.ResetVersion()
+	ouo.mutation.SetVersion(i)
+	return ouo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableVersion(i *int) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetVersion(*i)
+	}
+	return ouo
+}
+
+// AddVersion adds i to the "version" field.
+func (ouo *OrganizationUpdateOne) AddVersion(i int) *OrganizationUpdateOne {
+	ouo.mutation.AddVersion(i)
+	return ouo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ouo *OrganizationUpdateOne) SetUpdatedAt(t time.Time) *OrganizationUpdateOne {
 	ouo.mutation.SetUpdatedAt(t)
@@ -745,6 +1183,51 @@ func (ouo *OrganizationUpdateOne) AddEnrollments(e ...*Enrollment) *Organization
 	return ouo.AddEnrollmentIDs(ids...)
 }
 
+// AddScimTokenIDs adds the "scim_tokens" edge to the ScimToken entity by IDs.
+func (ouo *OrganizationUpdateOne) AddScimTokenIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddScimTokenIDs(ids...)
+	return ouo
+}
+
+// AddScimTokens adds the "scim_tokens" edges to the ScimToken entity.
+func (ouo *OrganizationUpdateOne) AddScimTokens(s ...*ScimToken) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddScimTokenIDs(ids...)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the ServiceAccount entity by IDs.
+func (ouo *OrganizationUpdateOne) AddServiceAccountIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddServiceAccountIDs(ids...)
+	return ouo
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the ServiceAccount entity.
+func (ouo *OrganizationUpdateOne) AddServiceAccounts(s ...*ServiceAccount) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddServiceAccountIDs(ids...)
+}
+
+// AddPrivacyRequestIDs adds the "privacy_requests" edge to the PrivacyRequest entity by IDs.
+func (ouo *OrganizationUpdateOne) AddPrivacyRequestIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddPrivacyRequestIDs(ids...)
+	return ouo
+}
+
+// AddPrivacyRequests adds the "privacy_requests" edges to the PrivacyRequest entity.
+func (ouo *OrganizationUpdateOne) AddPrivacyRequests(p ...*PrivacyRequest) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ouo.AddPrivacyRequestIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
 	return ouo.mutation
@@ -855,6 +1338,69 @@ func (ouo *OrganizationUpdateOne) RemoveEnrollments(e ...*Enrollment) *Organizat
 	return ouo.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearScimTokens clears all "scim_tokens" edges to the ScimToken entity.
+func (ouo *OrganizationUpdateOne) ClearScimTokens() *OrganizationUpdateOne {
+	ouo.mutation.ClearScimTokens()
+	return ouo
+}
+
+// RemoveScimTokenIDs removes the "scim_tokens" edge to ScimToken entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveScimTokenIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemoveScimTokenIDs(ids...)
+	return ouo
+}
+
+// RemoveScimTokens removes "scim_tokens" edges to ScimToken entities.
+func (ouo *OrganizationUpdateOne) RemoveScimTokens(s ...*ScimToken) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveScimTokenIDs(ids...)
+}
+
+// ClearServiceAccounts clears all "service_accounts" edges to the ServiceAccount entity.
+func (ouo *OrganizationUpdateOne) ClearServiceAccounts() *OrganizationUpdateOne {
+	ouo.mutation.ClearServiceAccounts()
+	return ouo
+}
+
+// RemoveServiceAccountIDs removes the "service_accounts" edge to ServiceAccount entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveServiceAccountIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemoveServiceAccountIDs(ids...)
+	return ouo
+}
+
+// RemoveServiceAccounts removes "service_accounts" edges to ServiceAccount entities.
+func (ouo *OrganizationUpdateOne) RemoveServiceAccounts(s ...*ServiceAccount) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveServiceAccountIDs(ids...)
+}
+
+// ClearPrivacyRequests clears all "privacy_requests" edges to the PrivacyRequest entity.
+func (ouo *OrganizationUpdateOne) ClearPrivacyRequests() *OrganizationUpdateOne {
+	ouo.mutation.ClearPrivacyRequests()
+	return ouo
+}
+
+// RemovePrivacyRequestIDs removes the "privacy_requests" edge to PrivacyRequest entities by IDs.
+func (ouo *OrganizationUpdateOne) RemovePrivacyRequestIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemovePrivacyRequestIDs(ids...)
+	return ouo
+}
+
+// RemovePrivacyRequests removes "privacy_requests" edges to PrivacyRequest entities.
+func (ouo *OrganizationUpdateOne) RemovePrivacyRequests(p ...*PrivacyRequest) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ouo.RemovePrivacyRequestIDs(ids...)
+}
+
 // Where appends a list predicates to the OrganizationUpdate builder.
 func (ouo *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -968,6 +1514,30 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 	if ouo.mutation.SettingsCleared() {
 		_spec.ClearField(organization.FieldSettings, field.TypeJSON)
 	}
+	if value, ok := ouo.mutation.StorageBytesUsed(); ok {
+		_spec.SetField(organization.FieldStorageBytesUsed, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedStorageBytesUsed(); ok {
+		_spec.AddField(organization.FieldStorageBytesUsed, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.StorageObjectsUsed(); ok {
+		_spec.SetField(organization.FieldStorageObjectsUsed, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedStorageObjectsUsed(); ok {
+		_spec.AddField(organization.FieldStorageObjectsUsed, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.StorageAlertLevel(); ok {
+		_spec.SetField(organization.FieldStorageAlertLevel, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedStorageAlertLevel(); ok {
+		_spec.AddField(organization.FieldStorageAlertLevel, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.Version(); ok {
+		_spec.SetField(organization.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedVersion(); ok {
+		_spec.AddField(organization.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1196,6 +1766,141 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.ScimTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedScimTokensIDs(); len(nodes) > 0 && !ouo.mutation.ScimTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ScimTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScimTokensTable,
+			Columns: []string{organization.ScimTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedServiceAccountsIDs(); len(nodes) > 0 && !ouo.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ServiceAccountsTable,
+			Columns: []string{organization.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.PrivacyRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedPrivacyRequestsIDs(); len(nodes) > 0 && !ouo.mutation.PrivacyRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.PrivacyRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PrivacyRequestsTable,
+			Columns: []string{organization.PrivacyRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Organization{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,9 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Content is the predicate function for content builders.
 type Content func(*sql.Selector)
 
+// ContentDownload is the predicate function for contentdownload builders.
+type ContentDownload func(*sql.Selector)
+
+// ContentRevision is the predicate function for contentrevision builders.
+type ContentRevision func(*sql.Selector)
+
 // Course is the predicate function for course builders.
 type Course func(*sql.Selector)
 
@@ -18,6 +27,9 @@ type Enrollment func(*sql.Selector)
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
 // Module is the predicate function for module builders.
 type Module func(*sql.Selector)
 
@@ -27,5 +39,26 @@ type ModuleProgress func(*sql.Selector)
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
+// PrivacyRequest is the predicate function for privacyrequest builders.
+type PrivacyRequest func(*sql.Selector)
+
+// SSOIdentity is the predicate function for ssoidentity builders.
+type SSOIdentity func(*sql.Selector)
+
+// SamlAssertion is the predicate function for samlassertion builders.
+type SamlAssertion func(*sql.Selector)
+
+// ScimToken is the predicate function for scimtoken builders.
+type ScimToken func(*sql.Selector)
+
+// ServiceAccount is the predicate function for serviceaccount builders.
+type ServiceAccount func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WebauthnCeremony is the predicate function for webauthnceremony builders.
+type WebauthnCeremony func(*sql.Selector)
+
+// WebauthnCredential is the predicate function for webauthncredential builders.
+type WebauthnCredential func(*sql.Selector)
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PrivacyRequest is the model entity for the PrivacyRequest schema.
+type PrivacyRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy *uuid.UUID `json:"requested_by,omitempty"`
+	// RequestedByKind holds the value of the "requested_by_kind" field.
+	RequestedByKind string `json:"requested_by_kind,omitempty"`
+	// ScheduledAt holds the value of the "scheduled_at" field.
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancelledBy holds the value of the "cancelled_by" field.
+	CancelledBy *uuid.UUID `json:"cancelled_by,omitempty"`
+	// CancelledByKind holds the value of the "cancelled_by_kind" field.
+	CancelledByKind string `json:"cancelled_by_kind,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey *string `json:"storage_key,omitempty"`
+	// SizeBytes holds the value of the "size_bytes" field.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary map[string]int `json:"summary,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PrivacyRequestQuery when eager-loading is set.
+	Edges        PrivacyRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PrivacyRequestEdges holds the relations/edges for other nodes in the graph.
+type PrivacyRequestEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PrivacyRequestEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PrivacyRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case privacyrequest.FieldRequestedBy, privacyrequest.FieldCancelledBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case privacyrequest.FieldSummary:
+			values[i] = new([]byte)
+		case privacyrequest.FieldSizeBytes:
+			values[i] = new(sql.NullInt64)
+		case privacyrequest.FieldKind, privacyrequest.FieldStatus, privacyrequest.FieldMode, privacyrequest.FieldRequestedByKind, privacyrequest.FieldCancelledByKind, privacyrequest.FieldStorageKey, privacyrequest.FieldError:
+			values[i] = new(sql.NullString)
+		case privacyrequest.FieldScheduledAt, privacyrequest.FieldStartedAt, privacyrequest.FieldCompletedAt, privacyrequest.FieldCancelledAt, privacyrequest.FieldExpiresAt, privacyrequest.FieldCreatedAt, privacyrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case privacyrequest.FieldID, privacyrequest.FieldOrganizationID, privacyrequest.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PrivacyRequest fields.
+func (pr *PrivacyRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case privacyrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case privacyrequest.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				pr.OrganizationID = *value
+			}
+		case privacyrequest.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				pr.UserID = *value
+			}
+		case privacyrequest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pr.Kind = value.String
+			}
+		case privacyrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = value.String
+			}
+		case privacyrequest.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				pr.Mode = value.String
+			}
+		case privacyrequest.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				pr.RequestedBy = new(uuid.UUID)
+				*pr.RequestedBy = *value.S.(*uuid.UUID)
+			}
+		case privacyrequest.FieldRequestedByKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by_kind", values[i])
+			} else if value.Valid {
+				pr.RequestedByKind = value.String
+			}
+		case privacyrequest.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				pr.ScheduledAt = value.Time
+			}
+		case privacyrequest.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				pr.StartedAt = new(time.Time)
+				*pr.StartedAt = value.Time
+			}
+		case privacyrequest.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				pr.CompletedAt = new(time.Time)
+				*pr.CompletedAt = value.Time
+			}
+		case privacyrequest.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				pr.CancelledAt = new(time.Time)
+				*pr.CancelledAt = value.Time
+			}
+		case privacyrequest.FieldCancelledBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by", values[i])
+			} else if value.Valid {
+				pr.CancelledBy = new(uuid.UUID)
+				*pr.CancelledBy = *value.S.(*uuid.UUID)
+			}
+		case privacyrequest.FieldCancelledByKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by_kind", values[i])
+			} else if value.Valid {
+				pr.CancelledByKind = value.String
+			}
+		case privacyrequest.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				pr.StorageKey = new(string)
+				*pr.StorageKey = value.String
+			}
+		case privacyrequest.FieldSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
+			} else if value.Valid {
+				pr.SizeBytes = value.Int64
+			}
+		case privacyrequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pr.ExpiresAt = new(time.Time)
+				*pr.ExpiresAt = value.Time
+			}
+		case privacyrequest.FieldSummary:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Summary); err != nil {
+					return fmt.Errorf("unmarshal field summary: %w", err)
+				}
+			}
+		case privacyrequest.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				pr.Error = value.String
+			}
+		case privacyrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case privacyrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PrivacyRequest.
+// This includes values selected through modifiers, order, etc.
+func (pr *PrivacyRequest) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the PrivacyRequest entity.
+func (pr *PrivacyRequest) QueryOrganization() *OrganizationQuery {
+	return NewPrivacyRequestClient(pr.config).QueryOrganization(pr)
+}
+
+// Update returns a builder for updating this PrivacyRequest.
+// Note that you need to call PrivacyRequest.Unwrap() before calling this method if this PrivacyRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PrivacyRequest) Update() *PrivacyRequestUpdateOne {
+	return NewPrivacyRequestClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PrivacyRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PrivacyRequest) Unwrap() *PrivacyRequest {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PrivacyRequest is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PrivacyRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("PrivacyRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(pr.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(pr.Mode)
+	builder.WriteString(", ")
+	if v := pr.RequestedBy; v != nil {
+		builder.WriteString("requested_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("requested_by_kind=")
+	builder.WriteString(pr.RequestedByKind)
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(pr.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pr.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.CancelledBy; v != nil {
+		builder.WriteString("cancelled_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancelled_by_kind=")
+	builder.WriteString(pr.CancelledByKind)
+	builder.WriteString(", ")
+	if v := pr.StorageKey; v != nil {
+		builder.WriteString("storage_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SizeBytes))
+	builder.WriteString(", ")
+	if v := pr.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Summary))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(pr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PrivacyRequests is a parsable slice of PrivacyRequest.
+type PrivacyRequests []*PrivacyRequest
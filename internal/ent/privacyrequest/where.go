@@ -0,0 +1,1225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacyrequest
+
+import (
+	"lms-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldKind, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldMode, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByKind applies equality check predicate on the "requested_by_kind" field. It's identical to RequestedByKindEQ.
+func RequestedByKind(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldRequestedByKind, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledBy applies equality check predicate on the "cancelled_by" field. It's identical to CancelledByEQ.
+func CancelledBy(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelledByKind applies equality check predicate on the "cancelled_by_kind" field. It's identical to CancelledByKindEQ.
+func CancelledByKind(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledByKind, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
+func SizeBytes(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldUserID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldKind, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeIsNil applies the IsNil predicate on the "mode" field.
+func ModeIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldMode))
+}
+
+// ModeNotNil applies the NotNil predicate on the "mode" field.
+func ModeNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldMode))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldMode, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByIsNil applies the IsNil predicate on the "requested_by" field.
+func RequestedByIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldRequestedBy))
+}
+
+// RequestedByNotNil applies the NotNil predicate on the "requested_by" field.
+func RequestedByNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldRequestedBy))
+}
+
+// RequestedByKindEQ applies the EQ predicate on the "requested_by_kind" field.
+func RequestedByKindEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldRequestedByKind, v))
+}
+
+// RequestedByKindNEQ applies the NEQ predicate on the "requested_by_kind" field.
+func RequestedByKindNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldRequestedByKind, v))
+}
+
+// RequestedByKindIn applies the In predicate on the "requested_by_kind" field.
+func RequestedByKindIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldRequestedByKind, vs...))
+}
+
+// RequestedByKindNotIn applies the NotIn predicate on the "requested_by_kind" field.
+func RequestedByKindNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldRequestedByKind, vs...))
+}
+
+// RequestedByKindGT applies the GT predicate on the "requested_by_kind" field.
+func RequestedByKindGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldRequestedByKind, v))
+}
+
+// RequestedByKindGTE applies the GTE predicate on the "requested_by_kind" field.
+func RequestedByKindGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldRequestedByKind, v))
+}
+
+// RequestedByKindLT applies the LT predicate on the "requested_by_kind" field.
+func RequestedByKindLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldRequestedByKind, v))
+}
+
+// RequestedByKindLTE applies the LTE predicate on the "requested_by_kind" field.
+func RequestedByKindLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldRequestedByKind, v))
+}
+
+// RequestedByKindContains applies the Contains predicate on the "requested_by_kind" field.
+func RequestedByKindContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldRequestedByKind, v))
+}
+
+// RequestedByKindHasPrefix applies the HasPrefix predicate on the "requested_by_kind" field.
+func RequestedByKindHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldRequestedByKind, v))
+}
+
+// RequestedByKindHasSuffix applies the HasSuffix predicate on the "requested_by_kind" field.
+func RequestedByKindHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldRequestedByKind, v))
+}
+
+// RequestedByKindIsNil applies the IsNil predicate on the "requested_by_kind" field.
+func RequestedByKindIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldRequestedByKind))
+}
+
+// RequestedByKindNotNil applies the NotNil predicate on the "requested_by_kind" field.
+func RequestedByKindNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldRequestedByKind))
+}
+
+// RequestedByKindEqualFold applies the EqualFold predicate on the "requested_by_kind" field.
+func RequestedByKindEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldRequestedByKind, v))
+}
+
+// RequestedByKindContainsFold applies the ContainsFold predicate on the "requested_by_kind" field.
+func RequestedByKindContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldRequestedByKind, v))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancelledByEQ applies the EQ predicate on the "cancelled_by" field.
+func CancelledByEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelledByNEQ applies the NEQ predicate on the "cancelled_by" field.
+func CancelledByNEQ(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldCancelledBy, v))
+}
+
+// CancelledByIn applies the In predicate on the "cancelled_by" field.
+func CancelledByIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByNotIn applies the NotIn predicate on the "cancelled_by" field.
+func CancelledByNotIn(vs ...uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByGT applies the GT predicate on the "cancelled_by" field.
+func CancelledByGT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldCancelledBy, v))
+}
+
+// CancelledByGTE applies the GTE predicate on the "cancelled_by" field.
+func CancelledByGTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldCancelledBy, v))
+}
+
+// CancelledByLT applies the LT predicate on the "cancelled_by" field.
+func CancelledByLT(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldCancelledBy, v))
+}
+
+// CancelledByLTE applies the LTE predicate on the "cancelled_by" field.
+func CancelledByLTE(v uuid.UUID) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldCancelledBy, v))
+}
+
+// CancelledByIsNil applies the IsNil predicate on the "cancelled_by" field.
+func CancelledByIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldCancelledBy))
+}
+
+// CancelledByNotNil applies the NotNil predicate on the "cancelled_by" field.
+func CancelledByNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldCancelledBy))
+}
+
+// CancelledByKindEQ applies the EQ predicate on the "cancelled_by_kind" field.
+func CancelledByKindEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCancelledByKind, v))
+}
+
+// CancelledByKindNEQ applies the NEQ predicate on the "cancelled_by_kind" field.
+func CancelledByKindNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldCancelledByKind, v))
+}
+
+// CancelledByKindIn applies the In predicate on the "cancelled_by_kind" field.
+func CancelledByKindIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldCancelledByKind, vs...))
+}
+
+// CancelledByKindNotIn applies the NotIn predicate on the "cancelled_by_kind" field.
+func CancelledByKindNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldCancelledByKind, vs...))
+}
+
+// CancelledByKindGT applies the GT predicate on the "cancelled_by_kind" field.
+func CancelledByKindGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldCancelledByKind, v))
+}
+
+// CancelledByKindGTE applies the GTE predicate on the "cancelled_by_kind" field.
+func CancelledByKindGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldCancelledByKind, v))
+}
+
+// CancelledByKindLT applies the LT predicate on the "cancelled_by_kind" field.
+func CancelledByKindLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldCancelledByKind, v))
+}
+
+// CancelledByKindLTE applies the LTE predicate on the "cancelled_by_kind" field.
+func CancelledByKindLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldCancelledByKind, v))
+}
+
+// CancelledByKindContains applies the Contains predicate on the "cancelled_by_kind" field.
+func CancelledByKindContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldCancelledByKind, v))
+}
+
+// CancelledByKindHasPrefix applies the HasPrefix predicate on the "cancelled_by_kind" field.
+func CancelledByKindHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldCancelledByKind, v))
+}
+
+// CancelledByKindHasSuffix applies the HasSuffix predicate on the "cancelled_by_kind" field.
+func CancelledByKindHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldCancelledByKind, v))
+}
+
+// CancelledByKindIsNil applies the IsNil predicate on the "cancelled_by_kind" field.
+func CancelledByKindIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldCancelledByKind))
+}
+
+// CancelledByKindNotNil applies the NotNil predicate on the "cancelled_by_kind" field.
+func CancelledByKindNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldCancelledByKind))
+}
+
+// CancelledByKindEqualFold applies the EqualFold predicate on the "cancelled_by_kind" field.
+func CancelledByKindEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldCancelledByKind, v))
+}
+
+// CancelledByKindContainsFold applies the ContainsFold predicate on the "cancelled_by_kind" field.
+func CancelledByKindContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldCancelledByKind, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyIsNil applies the IsNil predicate on the "storage_key" field.
+func StorageKeyIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldStorageKey))
+}
+
+// StorageKeyNotNil applies the NotNil predicate on the "storage_key" field.
+func StorageKeyNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldStorageKey))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
+func SizeBytesEQ(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesNEQ applies the NEQ predicate on the "size_bytes" field.
+func SizeBytesNEQ(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesIn applies the In predicate on the "size_bytes" field.
+func SizeBytesIn(vs ...int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesNotIn applies the NotIn predicate on the "size_bytes" field.
+func SizeBytesNotIn(vs ...int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesGT applies the GT predicate on the "size_bytes" field.
+func SizeBytesGT(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldSizeBytes, v))
+}
+
+// SizeBytesGTE applies the GTE predicate on the "size_bytes" field.
+func SizeBytesGTE(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldSizeBytes, v))
+}
+
+// SizeBytesLT applies the LT predicate on the "size_bytes" field.
+func SizeBytesLT(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldSizeBytes, v))
+}
+
+// SizeBytesLTE applies the LTE predicate on the "size_bytes" field.
+func SizeBytesLTE(v int64) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldSizeBytes, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldSummary))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PrivacyRequest) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PrivacyRequest) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PrivacyRequest) predicate.PrivacyRequest {
+	return predicate.PrivacyRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PrivacyRequestCreate is the builder for creating a PrivacyRequest entity.
+type PrivacyRequestCreate struct {
+	config
+	mutation *PrivacyRequestMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (prc *PrivacyRequestCreate) SetOrganizationID(u uuid.UUID) *PrivacyRequestCreate {
+	prc.mutation.SetOrganizationID(u)
+	return prc
+}
+
+// SetUserID sets the "user_id" field.
+func (prc *PrivacyRequestCreate) SetUserID(u uuid.UUID) *PrivacyRequestCreate {
+	prc.mutation.SetUserID(u)
+	return prc
+}
+
+// SetKind sets the "kind" field.
+func (prc *PrivacyRequestCreate) SetKind(s string) *PrivacyRequestCreate {
+	prc.mutation.SetKind(s)
+	return prc
+}
+
+// SetStatus sets the "status" field.
+func (prc *PrivacyRequestCreate) SetStatus(s string) *PrivacyRequestCreate {
+	prc.mutation.SetStatus(s)
+	return prc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableStatus(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetStatus(*s)
+	}
+	return prc
+}
+
+// SetMode sets the "mode" field.
+func (prc *PrivacyRequestCreate) SetMode(s string) *PrivacyRequestCreate {
+	prc.mutation.SetMode(s)
+	return prc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableMode(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetMode(*s)
+	}
+	return prc
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (prc *PrivacyRequestCreate) SetRequestedBy(u uuid.UUID) *PrivacyRequestCreate {
+	prc.mutation.SetRequestedBy(u)
+	return prc
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableRequestedBy(u *uuid.UUID) *PrivacyRequestCreate {
+	if u != nil {
+		prc.SetRequestedBy(*u)
+	}
+	return prc
+}
+
+// SetRequestedByKind sets the "requested_by_kind" field.
+func (prc *PrivacyRequestCreate) SetRequestedByKind(s string) *PrivacyRequestCreate {
+	prc.mutation.SetRequestedByKind(s)
+	return prc
+}
+
+// SetNillableRequestedByKind sets the "requested_by_kind" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableRequestedByKind(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetRequestedByKind(*s)
+	}
+	return prc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (prc *PrivacyRequestCreate) SetScheduledAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetScheduledAt(t)
+	return prc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (prc *PrivacyRequestCreate) SetStartedAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetStartedAt(t)
+	return prc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableStartedAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetStartedAt(*t)
+	}
+	return prc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (prc *PrivacyRequestCreate) SetCompletedAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetCompletedAt(t)
+	return prc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableCompletedAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetCompletedAt(*t)
+	}
+	return prc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (prc *PrivacyRequestCreate) SetCancelledAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetCancelledAt(t)
+	return prc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableCancelledAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetCancelledAt(*t)
+	}
+	return prc
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (prc *PrivacyRequestCreate) SetCancelledBy(u uuid.UUID) *PrivacyRequestCreate {
+	prc.mutation.SetCancelledBy(u)
+	return prc
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableCancelledBy(u *uuid.UUID) *PrivacyRequestCreate {
+	if u != nil {
+		prc.SetCancelledBy(*u)
+	}
+	return prc
+}
+
+// SetCancelledByKind sets the "cancelled_by_kind" field.
+func (prc *PrivacyRequestCreate) SetCancelledByKind(s string) *PrivacyRequestCreate {
+	prc.mutation.SetCancelledByKind(s)
+	return prc
+}
+
+// SetNillableCancelledByKind sets the "cancelled_by_kind" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableCancelledByKind(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetCancelledByKind(*s)
+	}
+	return prc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (prc *PrivacyRequestCreate) SetStorageKey(s string) *PrivacyRequestCreate {
+	prc.mutation.SetStorageKey(s)
+	return prc
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableStorageKey(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetStorageKey(*s)
+	}
+	return prc
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (prc *PrivacyRequestCreate) SetSizeBytes(i int64) *PrivacyRequestCreate {
+	prc.mutation.SetSizeBytes(i)
+	return prc
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableSizeBytes(i *int64) *PrivacyRequestCreate {
+	if i != nil {
+		prc.SetSizeBytes(*i)
+	}
+	return prc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (prc *PrivacyRequestCreate) SetExpiresAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetExpiresAt(t)
+	return prc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableExpiresAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetExpiresAt(*t)
+	}
+	return prc
+}
+
+// SetSummary sets the "summary" field.
+func (prc *PrivacyRequestCreate) SetSummary(m map[string]int) *PrivacyRequestCreate {
+	prc.mutation.SetSummary(m)
+	return prc
+}
+
+// SetError sets the "error" field.
+func (prc *PrivacyRequestCreate) SetError(s string) *PrivacyRequestCreate {
+	prc.mutation.SetError(s)
+	return prc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableError(s *string) *PrivacyRequestCreate {
+	if s != nil {
+		prc.SetError(*s)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PrivacyRequestCreate) SetCreatedAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableCreatedAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *PrivacyRequestCreate) SetUpdatedAt(t time.Time) *PrivacyRequestCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableUpdatedAt(t *time.Time) *PrivacyRequestCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PrivacyRequestCreate) SetID(u uuid.UUID) *PrivacyRequestCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PrivacyRequestCreate) SetNillableID(u *uuid.UUID) *PrivacyRequestCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (prc *PrivacyRequestCreate) SetOrganization(o *Organization) *PrivacyRequestCreate {
+	return prc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the PrivacyRequestMutation object of the builder.
+func (prc *PrivacyRequestCreate) Mutation() *PrivacyRequestMutation {
+	return prc.mutation
+}
+
+// Save creates the PrivacyRequest in the database.
+func (prc *PrivacyRequestCreate) Save(ctx context.Context) (*PrivacyRequest, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PrivacyRequestCreate) SaveX(ctx context.Context) *PrivacyRequest {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PrivacyRequestCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PrivacyRequestCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PrivacyRequestCreate) defaults() {
+	if _, ok := prc.mutation.Status(); !ok {
+		v := privacyrequest.DefaultStatus
+		prc.mutation.SetStatus(v)
+	}
+	if _, ok := prc.mutation.SizeBytes(); !ok {
+		v := privacyrequest.DefaultSizeBytes
+		prc.mutation.SetSizeBytes(v)
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := privacyrequest.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := privacyrequest.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := privacyrequest.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PrivacyRequestCreate) check() error {
+	if _, ok := prc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "PrivacyRequest.organization_id"`)}
+	}
+	if _, ok := prc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PrivacyRequest.user_id"`)}
+	}
+	if _, ok := prc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "PrivacyRequest.kind"`)}
+	}
+	if v, ok := prc.mutation.Kind(); ok {
+		if err := privacyrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PrivacyRequest.kind": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PrivacyRequest.status"`)}
+	}
+	if _, ok := prc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "PrivacyRequest.scheduled_at"`)}
+	}
+	if _, ok := prc.mutation.SizeBytes(); !ok {
+		return &ValidationError{Name: "size_bytes", err: errors.New(`ent: missing required field "PrivacyRequest.size_bytes"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PrivacyRequest.created_at"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PrivacyRequest.updated_at"`)}
+	}
+	if len(prc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "PrivacyRequest.organization"`)}
+	}
+	return nil
+}
+
+func (prc *PrivacyRequestCreate) sqlSave(ctx context.Context) (*PrivacyRequest, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PrivacyRequestCreate) createSpec() (*PrivacyRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PrivacyRequest{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(privacyrequest.Table, sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID))
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.UserID(); ok {
+		_spec.SetField(privacyrequest.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := prc.mutation.Kind(); ok {
+		_spec.SetField(privacyrequest.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := prc.mutation.Status(); ok {
+		_spec.SetField(privacyrequest.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := prc.mutation.Mode(); ok {
+		_spec.SetField(privacyrequest.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := prc.mutation.RequestedBy(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = &value
+	}
+	if value, ok := prc.mutation.RequestedByKind(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedByKind, field.TypeString, value)
+		_node.RequestedByKind = value
+	}
+	if value, ok := prc.mutation.ScheduledAt(); ok {
+		_spec.SetField(privacyrequest.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := prc.mutation.StartedAt(); ok {
+		_spec.SetField(privacyrequest.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := prc.mutation.CompletedAt(); ok {
+		_spec.SetField(privacyrequest.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := prc.mutation.CancelledAt(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := prc.mutation.CancelledBy(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledBy, field.TypeUUID, value)
+		_node.CancelledBy = &value
+	}
+	if value, ok := prc.mutation.CancelledByKind(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledByKind, field.TypeString, value)
+		_node.CancelledByKind = value
+	}
+	if value, ok := prc.mutation.StorageKey(); ok {
+		_spec.SetField(privacyrequest.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = &value
+	}
+	if value, ok := prc.mutation.SizeBytes(); ok {
+		_spec.SetField(privacyrequest.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := prc.mutation.ExpiresAt(); ok {
+		_spec.SetField(privacyrequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := prc.mutation.Summary(); ok {
+		_spec.SetField(privacyrequest.FieldSummary, field.TypeJSON, value)
+		_node.Summary = value
+	}
+	if value, ok := prc.mutation.Error(); ok {
+		_spec.SetField(privacyrequest.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(privacyrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(privacyrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := prc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   privacyrequest.OrganizationTable,
+			Columns: []string{privacyrequest.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PrivacyRequestCreateBulk is the builder for creating many PrivacyRequest entities in bulk.
+type PrivacyRequestCreateBulk struct {
+	config
+	err      error
+	builders []*PrivacyRequestCreate
+}
+
+// Save creates the PrivacyRequest entities in the database.
+func (prcb *PrivacyRequestCreateBulk) Save(ctx context.Context) ([]*PrivacyRequest, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PrivacyRequest, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrivacyRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PrivacyRequestCreateBulk) SaveX(ctx context.Context) []*PrivacyRequest {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PrivacyRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PrivacyRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
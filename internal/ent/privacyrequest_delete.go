@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/privacyrequest"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyRequestDelete is the builder for deleting a PrivacyRequest entity.
+type PrivacyRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *PrivacyRequestMutation
+}
+
+// Where appends a list predicates to the PrivacyRequestDelete builder.
+func (prd *PrivacyRequestDelete) Where(ps ...predicate.PrivacyRequest) *PrivacyRequestDelete {
+	prd.mutation.Where(ps...)
+	return prd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prd *PrivacyRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, prd.sqlExec, prd.mutation, prd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prd *PrivacyRequestDelete) ExecX(ctx context.Context) int {
+	n, err := prd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prd *PrivacyRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(privacyrequest.Table, sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID))
+	if ps := prd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, prd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	prd.mutation.done = true
+	return affected, err
+}
+
+// PrivacyRequestDeleteOne is the builder for deleting a single PrivacyRequest entity.
+type PrivacyRequestDeleteOne struct {
+	prd *PrivacyRequestDelete
+}
+
+// Where appends a list predicates to the PrivacyRequestDelete builder.
+func (prdo *PrivacyRequestDeleteOne) Where(ps ...predicate.PrivacyRequest) *PrivacyRequestDeleteOne {
+	prdo.prd.mutation.Where(ps...)
+	return prdo
+}
+
+// Exec executes the deletion query.
+func (prdo *PrivacyRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := prdo.prd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{privacyrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prdo *PrivacyRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := prdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
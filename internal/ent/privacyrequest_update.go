@@ -0,0 +1,1074 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/privacyrequest"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PrivacyRequestUpdate is the builder for updating PrivacyRequest entities.
+type PrivacyRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrivacyRequestMutation
+}
+
+// Where appends a list predicates to the PrivacyRequestUpdate builder.
+func (pru *PrivacyRequestUpdate) Where(ps ...predicate.PrivacyRequest) *PrivacyRequestUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (pru *PrivacyRequestUpdate) SetOrganizationID(u uuid.UUID) *PrivacyRequestUpdate {
+	pru.mutation.SetOrganizationID(u)
+	return pru
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableOrganizationID(u *uuid.UUID) *PrivacyRequestUpdate {
+	if u != nil {
+		pru.SetOrganizationID(*u)
+	}
+	return pru
+}
+
+// SetStatus sets the "status" field.
+func (pru *PrivacyRequestUpdate) SetStatus(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetStatus(s)
+	return pru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableStatus(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetStatus(*s)
+	}
+	return pru
+}
+
+// SetMode sets the "mode" field.
+func (pru *PrivacyRequestUpdate) SetMode(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetMode(s)
+	return pru
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableMode(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetMode(*s)
+	}
+	return pru
+}
+
+// ClearMode clears the value of the "mode" field.
+func (pru *PrivacyRequestUpdate) ClearMode() *PrivacyRequestUpdate {
+	pru.mutation.ClearMode()
+	return pru
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (pru *PrivacyRequestUpdate) SetRequestedBy(u uuid.UUID) *PrivacyRequestUpdate {
+	pru.mutation.SetRequestedBy(u)
+	return pru
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableRequestedBy(u *uuid.UUID) *PrivacyRequestUpdate {
+	if u != nil {
+		pru.SetRequestedBy(*u)
+	}
+	return pru
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (pru *PrivacyRequestUpdate) ClearRequestedBy() *PrivacyRequestUpdate {
+	pru.mutation.ClearRequestedBy()
+	return pru
+}
+
+// SetRequestedByKind sets the "requested_by_kind" field.
+func (pru *PrivacyRequestUpdate) SetRequestedByKind(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetRequestedByKind(s)
+	return pru
+}
+
+// SetNillableRequestedByKind sets the "requested_by_kind" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableRequestedByKind(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetRequestedByKind(*s)
+	}
+	return pru
+}
+
+// ClearRequestedByKind clears the value of the "requested_by_kind" field.
+func (pru *PrivacyRequestUpdate) ClearRequestedByKind() *PrivacyRequestUpdate {
+	pru.mutation.ClearRequestedByKind()
+	return pru
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pru *PrivacyRequestUpdate) SetScheduledAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetScheduledAt(t)
+	return pru
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableScheduledAt(t *time.Time) *PrivacyRequestUpdate {
+	if t != nil {
+		pru.SetScheduledAt(*t)
+	}
+	return pru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (pru *PrivacyRequestUpdate) SetStartedAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetStartedAt(t)
+	return pru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableStartedAt(t *time.Time) *PrivacyRequestUpdate {
+	if t != nil {
+		pru.SetStartedAt(*t)
+	}
+	return pru
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (pru *PrivacyRequestUpdate) ClearStartedAt() *PrivacyRequestUpdate {
+	pru.mutation.ClearStartedAt()
+	return pru
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (pru *PrivacyRequestUpdate) SetCompletedAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetCompletedAt(t)
+	return pru
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableCompletedAt(t *time.Time) *PrivacyRequestUpdate {
+	if t != nil {
+		pru.SetCompletedAt(*t)
+	}
+	return pru
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (pru *PrivacyRequestUpdate) ClearCompletedAt() *PrivacyRequestUpdate {
+	pru.mutation.ClearCompletedAt()
+	return pru
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (pru *PrivacyRequestUpdate) SetCancelledAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetCancelledAt(t)
+	return pru
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableCancelledAt(t *time.Time) *PrivacyRequestUpdate {
+	if t != nil {
+		pru.SetCancelledAt(*t)
+	}
+	return pru
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (pru *PrivacyRequestUpdate) ClearCancelledAt() *PrivacyRequestUpdate {
+	pru.mutation.ClearCancelledAt()
+	return pru
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (pru *PrivacyRequestUpdate) SetCancelledBy(u uuid.UUID) *PrivacyRequestUpdate {
+	pru.mutation.SetCancelledBy(u)
+	return pru
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableCancelledBy(u *uuid.UUID) *PrivacyRequestUpdate {
+	if u != nil {
+		pru.SetCancelledBy(*u)
+	}
+	return pru
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (pru *PrivacyRequestUpdate) ClearCancelledBy() *PrivacyRequestUpdate {
+	pru.mutation.ClearCancelledBy()
+	return pru
+}
+
+// SetCancelledByKind sets the "cancelled_by_kind" field.
+func (pru *PrivacyRequestUpdate) SetCancelledByKind(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetCancelledByKind(s)
+	return pru
+}
+
+// SetNillableCancelledByKind sets the "cancelled_by_kind" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableCancelledByKind(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetCancelledByKind(*s)
+	}
+	return pru
+}
+
+// ClearCancelledByKind clears the value of the "cancelled_by_kind" field.
+func (pru *PrivacyRequestUpdate) ClearCancelledByKind() *PrivacyRequestUpdate {
+	pru.mutation.ClearCancelledByKind()
+	return pru
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pru *PrivacyRequestUpdate) SetStorageKey(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetStorageKey(s)
+	return pru
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableStorageKey(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetStorageKey(*s)
+	}
+	return pru
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (pru *PrivacyRequestUpdate) ClearStorageKey() *PrivacyRequestUpdate {
+	pru.mutation.ClearStorageKey()
+	return pru
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (pru *PrivacyRequestUpdate) SetSizeBytes(i int64) *PrivacyRequestUpdate {
+	pru.mutation.ResetSizeBytes()
+	pru.mutation.SetSizeBytes(i)
+	return pru
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableSizeBytes(i *int64) *PrivacyRequestUpdate {
+	if i != nil {
+		pru.SetSizeBytes(*i)
+	}
+	return pru
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (pru *PrivacyRequestUpdate) AddSizeBytes(i int64) *PrivacyRequestUpdate {
+	pru.mutation.AddSizeBytes(i)
+	return pru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pru *PrivacyRequestUpdate) SetExpiresAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetExpiresAt(t)
+	return pru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableExpiresAt(t *time.Time) *PrivacyRequestUpdate {
+	if t != nil {
+		pru.SetExpiresAt(*t)
+	}
+	return pru
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pru *PrivacyRequestUpdate) ClearExpiresAt() *PrivacyRequestUpdate {
+	pru.mutation.ClearExpiresAt()
+	return pru
+}
+
+// SetSummary sets the "summary" field.
+func (pru *PrivacyRequestUpdate) SetSummary(m map[string]int) *PrivacyRequestUpdate {
+	pru.mutation.SetSummary(m)
+	return pru
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (pru *PrivacyRequestUpdate) ClearSummary() *PrivacyRequestUpdate {
+	pru.mutation.ClearSummary()
+	return pru
+}
+
+// SetError sets the "error" field.
+func (pru *PrivacyRequestUpdate) SetError(s string) *PrivacyRequestUpdate {
+	pru.mutation.SetError(s)
+	return pru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (pru *PrivacyRequestUpdate) SetNillableError(s *string) *PrivacyRequestUpdate {
+	if s != nil {
+		pru.SetError(*s)
+	}
+	return pru
+}
+
+// ClearError clears the value of the "error" field.
+func (pru *PrivacyRequestUpdate) ClearError() *PrivacyRequestUpdate {
+	pru.mutation.ClearError()
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *PrivacyRequestUpdate) SetUpdatedAt(t time.Time) *PrivacyRequestUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (pru *PrivacyRequestUpdate) SetOrganization(o *Organization) *PrivacyRequestUpdate {
+	return pru.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the PrivacyRequestMutation object of the builder.
+func (pru *PrivacyRequestUpdate) Mutation() *PrivacyRequestMutation {
+	return pru.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (pru *PrivacyRequestUpdate) ClearOrganization() *PrivacyRequestUpdate {
+	pru.mutation.ClearOrganization()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PrivacyRequestUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PrivacyRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PrivacyRequestUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PrivacyRequestUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PrivacyRequestUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := privacyrequest.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PrivacyRequestUpdate) check() error {
+	if pru.mutation.OrganizationCleared() && len(pru.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrivacyRequest.organization"`)
+	}
+	return nil
+}
+
+func (pru *PrivacyRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(privacyrequest.Table, privacyrequest.Columns, sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Status(); ok {
+		_spec.SetField(privacyrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Mode(); ok {
+		_spec.SetField(privacyrequest.FieldMode, field.TypeString, value)
+	}
+	if pru.mutation.ModeCleared() {
+		_spec.ClearField(privacyrequest.FieldMode, field.TypeString)
+	}
+	if value, ok := pru.mutation.RequestedBy(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if pru.mutation.RequestedByCleared() {
+		_spec.ClearField(privacyrequest.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := pru.mutation.RequestedByKind(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedByKind, field.TypeString, value)
+	}
+	if pru.mutation.RequestedByKindCleared() {
+		_spec.ClearField(privacyrequest.FieldRequestedByKind, field.TypeString)
+	}
+	if value, ok := pru.mutation.ScheduledAt(); ok {
+		_spec.SetField(privacyrequest.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.StartedAt(); ok {
+		_spec.SetField(privacyrequest.FieldStartedAt, field.TypeTime, value)
+	}
+	if pru.mutation.StartedAtCleared() {
+		_spec.ClearField(privacyrequest.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.CompletedAt(); ok {
+		_spec.SetField(privacyrequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if pru.mutation.CompletedAtCleared() {
+		_spec.ClearField(privacyrequest.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.CancelledAt(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledAt, field.TypeTime, value)
+	}
+	if pru.mutation.CancelledAtCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.CancelledBy(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledBy, field.TypeUUID, value)
+	}
+	if pru.mutation.CancelledByCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledBy, field.TypeUUID)
+	}
+	if value, ok := pru.mutation.CancelledByKind(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledByKind, field.TypeString, value)
+	}
+	if pru.mutation.CancelledByKindCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledByKind, field.TypeString)
+	}
+	if value, ok := pru.mutation.StorageKey(); ok {
+		_spec.SetField(privacyrequest.FieldStorageKey, field.TypeString, value)
+	}
+	if pru.mutation.StorageKeyCleared() {
+		_spec.ClearField(privacyrequest.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := pru.mutation.SizeBytes(); ok {
+		_spec.SetField(privacyrequest.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(privacyrequest.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.ExpiresAt(); ok {
+		_spec.SetField(privacyrequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pru.mutation.ExpiresAtCleared() {
+		_spec.ClearField(privacyrequest.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.Summary(); ok {
+		_spec.SetField(privacyrequest.FieldSummary, field.TypeJSON, value)
+	}
+	if pru.mutation.SummaryCleared() {
+		_spec.ClearField(privacyrequest.FieldSummary, field.TypeJSON)
+	}
+	if value, ok := pru.mutation.Error(); ok {
+		_spec.SetField(privacyrequest.FieldError, field.TypeString, value)
+	}
+	if pru.mutation.ErrorCleared() {
+		_spec.ClearField(privacyrequest.FieldError, field.TypeString)
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(privacyrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pru.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   privacyrequest.OrganizationTable,
+			Columns: []string{privacyrequest.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   privacyrequest.OrganizationTable,
+			Columns: []string{privacyrequest.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PrivacyRequestUpdateOne is the builder for updating a single PrivacyRequest entity.
+type PrivacyRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrivacyRequestMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (pruo *PrivacyRequestUpdateOne) SetOrganizationID(u uuid.UUID) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetOrganizationID(u)
+	return pruo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *PrivacyRequestUpdateOne {
+	if u != nil {
+		pruo.SetOrganizationID(*u)
+	}
+	return pruo
+}
+
+// SetStatus sets the "status" field.
+func (pruo *PrivacyRequestUpdateOne) SetStatus(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetStatus(s)
+	return pruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableStatus(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetStatus(*s)
+	}
+	return pruo
+}
+
+// SetMode sets the "mode" field.
+func (pruo *PrivacyRequestUpdateOne) SetMode(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetMode(s)
+	return pruo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableMode(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetMode(*s)
+	}
+	return pruo
+}
+
+// ClearMode clears the value of the "mode" field.
+func (pruo *PrivacyRequestUpdateOne) ClearMode() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearMode()
+	return pruo
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (pruo *PrivacyRequestUpdateOne) SetRequestedBy(u uuid.UUID) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetRequestedBy(u)
+	return pruo
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableRequestedBy(u *uuid.UUID) *PrivacyRequestUpdateOne {
+	if u != nil {
+		pruo.SetRequestedBy(*u)
+	}
+	return pruo
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (pruo *PrivacyRequestUpdateOne) ClearRequestedBy() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearRequestedBy()
+	return pruo
+}
+
+// SetRequestedByKind sets the "requested_by_kind" field.
+func (pruo *PrivacyRequestUpdateOne) SetRequestedByKind(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetRequestedByKind(s)
+	return pruo
+}
+
+// SetNillableRequestedByKind sets the "requested_by_kind" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableRequestedByKind(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetRequestedByKind(*s)
+	}
+	return pruo
+}
+
+// ClearRequestedByKind clears the value of the "requested_by_kind" field.
+func (pruo *PrivacyRequestUpdateOne) ClearRequestedByKind() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearRequestedByKind()
+	return pruo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetScheduledAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetScheduledAt(t)
+	return pruo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableScheduledAt(t *time.Time) *PrivacyRequestUpdateOne {
+	if t != nil {
+		pruo.SetScheduledAt(*t)
+	}
+	return pruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetStartedAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetStartedAt(t)
+	return pruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableStartedAt(t *time.Time) *PrivacyRequestUpdateOne {
+	if t != nil {
+		pruo.SetStartedAt(*t)
+	}
+	return pruo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (pruo *PrivacyRequestUpdateOne) ClearStartedAt() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearStartedAt()
+	return pruo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetCompletedAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetCompletedAt(t)
+	return pruo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableCompletedAt(t *time.Time) *PrivacyRequestUpdateOne {
+	if t != nil {
+		pruo.SetCompletedAt(*t)
+	}
+	return pruo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (pruo *PrivacyRequestUpdateOne) ClearCompletedAt() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearCompletedAt()
+	return pruo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetCancelledAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetCancelledAt(t)
+	return pruo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableCancelledAt(t *time.Time) *PrivacyRequestUpdateOne {
+	if t != nil {
+		pruo.SetCancelledAt(*t)
+	}
+	return pruo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (pruo *PrivacyRequestUpdateOne) ClearCancelledAt() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearCancelledAt()
+	return pruo
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (pruo *PrivacyRequestUpdateOne) SetCancelledBy(u uuid.UUID) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetCancelledBy(u)
+	return pruo
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableCancelledBy(u *uuid.UUID) *PrivacyRequestUpdateOne {
+	if u != nil {
+		pruo.SetCancelledBy(*u)
+	}
+	return pruo
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (pruo *PrivacyRequestUpdateOne) ClearCancelledBy() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearCancelledBy()
+	return pruo
+}
+
+// SetCancelledByKind sets the "cancelled_by_kind" field.
+func (pruo *PrivacyRequestUpdateOne) SetCancelledByKind(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetCancelledByKind(s)
+	return pruo
+}
+
+// SetNillableCancelledByKind sets the "cancelled_by_kind" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableCancelledByKind(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetCancelledByKind(*s)
+	}
+	return pruo
+}
+
+// ClearCancelledByKind clears the value of the "cancelled_by_kind" field.
+func (pruo *PrivacyRequestUpdateOne) ClearCancelledByKind() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearCancelledByKind()
+	return pruo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pruo *PrivacyRequestUpdateOne) SetStorageKey(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetStorageKey(s)
+	return pruo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableStorageKey(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetStorageKey(*s)
+	}
+	return pruo
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (pruo *PrivacyRequestUpdateOne) ClearStorageKey() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearStorageKey()
+	return pruo
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (pruo *PrivacyRequestUpdateOne) SetSizeBytes(i int64) *PrivacyRequestUpdateOne {
+	pruo.mutation.ResetSizeBytes()
+	pruo.mutation.SetSizeBytes(i)
+	return pruo
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableSizeBytes(i *int64) *PrivacyRequestUpdateOne {
+	if i != nil {
+		pruo.SetSizeBytes(*i)
+	}
+	return pruo
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (pruo *PrivacyRequestUpdateOne) AddSizeBytes(i int64) *PrivacyRequestUpdateOne {
+	pruo.mutation.AddSizeBytes(i)
+	return pruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetExpiresAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetExpiresAt(t)
+	return pruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableExpiresAt(t *time.Time) *PrivacyRequestUpdateOne {
+	if t != nil {
+		pruo.SetExpiresAt(*t)
+	}
+	return pruo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pruo *PrivacyRequestUpdateOne) ClearExpiresAt() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearExpiresAt()
+	return pruo
+}
+
+// SetSummary sets the "summary" field.
+func (pruo *PrivacyRequestUpdateOne) SetSummary(m map[string]int) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetSummary(m)
+	return pruo
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (pruo *PrivacyRequestUpdateOne) ClearSummary() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearSummary()
+	return pruo
+}
+
+// SetError sets the "error" field.
+func (pruo *PrivacyRequestUpdateOne) SetError(s string) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetError(s)
+	return pruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (pruo *PrivacyRequestUpdateOne) SetNillableError(s *string) *PrivacyRequestUpdateOne {
+	if s != nil {
+		pruo.SetError(*s)
+	}
+	return pruo
+}
+
+// ClearError clears the value of the "error" field.
+func (pruo *PrivacyRequestUpdateOne) ClearError() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearError()
+	return pruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *PrivacyRequestUpdateOne) SetUpdatedAt(t time.Time) *PrivacyRequestUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (pruo *PrivacyRequestUpdateOne) SetOrganization(o *Organization) *PrivacyRequestUpdateOne {
+	return pruo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the PrivacyRequestMutation object of the builder.
+func (pruo *PrivacyRequestUpdateOne) Mutation() *PrivacyRequestMutation {
+	return pruo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (pruo *PrivacyRequestUpdateOne) ClearOrganization() *PrivacyRequestUpdateOne {
+	pruo.mutation.ClearOrganization()
+	return pruo
+}
+
+// Where appends a list predicates to the PrivacyRequestUpdate builder.
+func (pruo *PrivacyRequestUpdateOne) Where(ps ...predicate.PrivacyRequest) *PrivacyRequestUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PrivacyRequestUpdateOne) Select(field string, fields ...string) *PrivacyRequestUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PrivacyRequest entity.
+func (pruo *PrivacyRequestUpdateOne) Save(ctx context.Context) (*PrivacyRequest, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PrivacyRequestUpdateOne) SaveX(ctx context.Context) *PrivacyRequest {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PrivacyRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PrivacyRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PrivacyRequestUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := privacyrequest.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PrivacyRequestUpdateOne) check() error {
+	if pruo.mutation.OrganizationCleared() && len(pruo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrivacyRequest.organization"`)
+	}
+	return nil
+}
+
+func (pruo *PrivacyRequestUpdateOne) sqlSave(ctx context.Context) (_node *PrivacyRequest, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(privacyrequest.Table, privacyrequest.Columns, sqlgraph.NewFieldSpec(privacyrequest.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PrivacyRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, privacyrequest.FieldID)
+		for _, f := range fields {
+			if !privacyrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != privacyrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Status(); ok {
+		_spec.SetField(privacyrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Mode(); ok {
+		_spec.SetField(privacyrequest.FieldMode, field.TypeString, value)
+	}
+	if pruo.mutation.ModeCleared() {
+		_spec.ClearField(privacyrequest.FieldMode, field.TypeString)
+	}
+	if value, ok := pruo.mutation.RequestedBy(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if pruo.mutation.RequestedByCleared() {
+		_spec.ClearField(privacyrequest.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := pruo.mutation.RequestedByKind(); ok {
+		_spec.SetField(privacyrequest.FieldRequestedByKind, field.TypeString, value)
+	}
+	if pruo.mutation.RequestedByKindCleared() {
+		_spec.ClearField(privacyrequest.FieldRequestedByKind, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ScheduledAt(); ok {
+		_spec.SetField(privacyrequest.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.StartedAt(); ok {
+		_spec.SetField(privacyrequest.FieldStartedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.StartedAtCleared() {
+		_spec.ClearField(privacyrequest.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.CompletedAt(); ok {
+		_spec.SetField(privacyrequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.CompletedAtCleared() {
+		_spec.ClearField(privacyrequest.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.CancelledAt(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledAt, field.TypeTime, value)
+	}
+	if pruo.mutation.CancelledAtCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.CancelledBy(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledBy, field.TypeUUID, value)
+	}
+	if pruo.mutation.CancelledByCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledBy, field.TypeUUID)
+	}
+	if value, ok := pruo.mutation.CancelledByKind(); ok {
+		_spec.SetField(privacyrequest.FieldCancelledByKind, field.TypeString, value)
+	}
+	if pruo.mutation.CancelledByKindCleared() {
+		_spec.ClearField(privacyrequest.FieldCancelledByKind, field.TypeString)
+	}
+	if value, ok := pruo.mutation.StorageKey(); ok {
+		_spec.SetField(privacyrequest.FieldStorageKey, field.TypeString, value)
+	}
+	if pruo.mutation.StorageKeyCleared() {
+		_spec.ClearField(privacyrequest.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := pruo.mutation.SizeBytes(); ok {
+		_spec.SetField(privacyrequest.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(privacyrequest.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(privacyrequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pruo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(privacyrequest.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.Summary(); ok {
+		_spec.SetField(privacyrequest.FieldSummary, field.TypeJSON, value)
+	}
+	if pruo.mutation.SummaryCleared() {
+		_spec.ClearField(privacyrequest.FieldSummary, field.TypeJSON)
+	}
+	if value, ok := pruo.mutation.Error(); ok {
+		_spec.SetField(privacyrequest.FieldError, field.TypeString, value)
+	}
+	if pruo.mutation.ErrorCleared() {
+		_spec.ClearField(privacyrequest.FieldError, field.TypeString)
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(privacyrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   privacyrequest.OrganizationTable,
+			Columns: []string{privacyrequest.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   privacyrequest.OrganizationTable,
+			Columns: []string{privacyrequest.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PrivacyRequest{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -3,15 +3,26 @@
 package ent
 
 import (
+	"lms-go/internal/ent/apikey"
 	"lms-go/internal/ent/content"
+	"lms-go/internal/ent/contentdownload"
+	"lms-go/internal/ent/contentrevision"
 	"lms-go/internal/ent/course"
 	"lms-go/internal/ent/enrollment"
 	"lms-go/internal/ent/group"
+	"lms-go/internal/ent/idempotencykey"
 	"lms-go/internal/ent/module"
 	"lms-go/internal/ent/moduleprogress"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/privacyrequest"
+	"lms-go/internal/ent/samlassertion"
 	"lms-go/internal/ent/schema"
+	"lms-go/internal/ent/scimtoken"
+	"lms-go/internal/ent/serviceaccount"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthnceremony"
+	"lms-go/internal/ent/webauthncredential"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +32,32 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[4].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = apikeyDescSecretHash.Validators[0].(func(string) error)
+	// apikeyDescScopes is the schema descriptor for scopes field.
+	apikeyDescScopes := apikeyFields[5].Descriptor()
+	// apikey.DefaultScopes holds the default value on creation for the scopes field.
+	apikey.DefaultScopes = apikeyDescScopes.Default.([]string)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
 	contentFields := schema.Content{}.Fields()
 	_ = contentFields
 	// contentDescName is the schema descriptor for name field.
@@ -35,20 +72,24 @@ func init() {
 	contentDescStorageKey := contentFields[5].Descriptor()
 	// content.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
 	content.StorageKeyValidator = contentDescStorageKey.Validators[0].(func(string) error)
+	// contentDescCurrentRevision is the schema descriptor for current_revision field.
+	contentDescCurrentRevision := contentFields[6].Descriptor()
+	// content.DefaultCurrentRevision holds the default value on creation for the current_revision field.
+	content.DefaultCurrentRevision = contentDescCurrentRevision.Default.(int)
 	// contentDescStatus is the schema descriptor for status field.
-	contentDescStatus := contentFields[6].Descriptor()
+	contentDescStatus := contentFields[9].Descriptor()
 	// content.DefaultStatus holds the default value on creation for the status field.
 	content.DefaultStatus = contentDescStatus.Default.(string)
 	// contentDescMetadata is the schema descriptor for metadata field.
-	contentDescMetadata := contentFields[7].Descriptor()
+	contentDescMetadata := contentFields[13].Descriptor()
 	// content.DefaultMetadata holds the default value on creation for the metadata field.
 	content.DefaultMetadata = contentDescMetadata.Default.(map[string]interface{})
 	// contentDescCreatedAt is the schema descriptor for created_at field.
-	contentDescCreatedAt := contentFields[8].Descriptor()
+	contentDescCreatedAt := contentFields[14].Descriptor()
 	// content.DefaultCreatedAt holds the default value on creation for the created_at field.
 	content.DefaultCreatedAt = contentDescCreatedAt.Default.(func() time.Time)
 	// contentDescUpdatedAt is the schema descriptor for updated_at field.
-	contentDescUpdatedAt := contentFields[9].Descriptor()
+	contentDescUpdatedAt := contentFields[15].Descriptor()
 	// content.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	content.DefaultUpdatedAt = contentDescUpdatedAt.Default.(func() time.Time)
 	// content.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -57,6 +98,48 @@ func init() {
 	contentDescID := contentFields[0].Descriptor()
 	// content.DefaultID holds the default value on creation for the id field.
 	content.DefaultID = contentDescID.Default.(func() uuid.UUID)
+	contentdownloadFields := schema.ContentDownload{}.Fields()
+	_ = contentdownloadFields
+	// contentdownloadDescCreatedAt is the schema descriptor for created_at field.
+	contentdownloadDescCreatedAt := contentdownloadFields[6].Descriptor()
+	// contentdownload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentdownload.DefaultCreatedAt = contentdownloadDescCreatedAt.Default.(func() time.Time)
+	// contentdownloadDescID is the schema descriptor for id field.
+	contentdownloadDescID := contentdownloadFields[0].Descriptor()
+	// contentdownload.DefaultID holds the default value on creation for the id field.
+	contentdownload.DefaultID = contentdownloadDescID.Default.(func() uuid.UUID)
+	contentrevisionFields := schema.ContentRevision{}.Fields()
+	_ = contentrevisionFields
+	// contentrevisionDescNumber is the schema descriptor for number field.
+	contentrevisionDescNumber := contentrevisionFields[3].Descriptor()
+	// contentrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	contentrevision.NumberValidator = contentrevisionDescNumber.Validators[0].(func(int) error)
+	// contentrevisionDescStorageKey is the schema descriptor for storage_key field.
+	contentrevisionDescStorageKey := contentrevisionFields[4].Descriptor()
+	// contentrevision.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	contentrevision.StorageKeyValidator = contentrevisionDescStorageKey.Validators[0].(func(string) error)
+	// contentrevisionDescMimeType is the schema descriptor for mime_type field.
+	contentrevisionDescMimeType := contentrevisionFields[5].Descriptor()
+	// contentrevision.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	contentrevision.MimeTypeValidator = contentrevisionDescMimeType.Validators[0].(func(string) error)
+	// contentrevisionDescStatus is the schema descriptor for status field.
+	contentrevisionDescStatus := contentrevisionFields[9].Descriptor()
+	// contentrevision.DefaultStatus holds the default value on creation for the status field.
+	contentrevision.DefaultStatus = contentrevisionDescStatus.Default.(string)
+	// contentrevisionDescCreatedAt is the schema descriptor for created_at field.
+	contentrevisionDescCreatedAt := contentrevisionFields[10].Descriptor()
+	// contentrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentrevision.DefaultCreatedAt = contentrevisionDescCreatedAt.Default.(func() time.Time)
+	// contentrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	contentrevisionDescUpdatedAt := contentrevisionFields[11].Descriptor()
+	// contentrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contentrevision.DefaultUpdatedAt = contentrevisionDescUpdatedAt.Default.(func() time.Time)
+	// contentrevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contentrevision.UpdateDefaultUpdatedAt = contentrevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contentrevisionDescID is the schema descriptor for id field.
+	contentrevisionDescID := contentrevisionFields[0].Descriptor()
+	// contentrevision.DefaultID holds the default value on creation for the id field.
+	contentrevision.DefaultID = contentrevisionDescID.Default.(func() uuid.UUID)
 	courseFields := schema.Course{}.Fields()
 	_ = courseFields
 	// courseDescTitle is the schema descriptor for title field.
@@ -128,15 +211,19 @@ func init() {
 	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	group.NameValidator = groupDescName.Validators[0].(func(string) error)
 	// groupDescMetadata is the schema descriptor for metadata field.
-	groupDescMetadata := groupFields[6].Descriptor()
+	groupDescMetadata := groupFields[7].Descriptor()
 	// group.DefaultMetadata holds the default value on creation for the metadata field.
 	group.DefaultMetadata = groupDescMetadata.Default.(map[string]interface{})
+	// groupDescVersion is the schema descriptor for version field.
+	groupDescVersion := groupFields[8].Descriptor()
+	// group.DefaultVersion holds the default value on creation for the version field.
+	group.DefaultVersion = groupDescVersion.Default.(int)
 	// groupDescCreatedAt is the schema descriptor for created_at field.
-	groupDescCreatedAt := groupFields[7].Descriptor()
+	groupDescCreatedAt := groupFields[9].Descriptor()
 	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
 	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
 	// groupDescUpdatedAt is the schema descriptor for updated_at field.
-	groupDescUpdatedAt := groupFields[8].Descriptor()
+	groupDescUpdatedAt := groupFields[10].Descriptor()
 	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
 	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -145,34 +232,60 @@ func init() {
 	groupDescID := groupFields[0].Descriptor()
 	// group.DefaultID holds the default value on creation for the id field.
 	group.DefaultID = groupDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencykeyDescFingerprint := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencykey.FingerprintValidator = idempotencykeyDescFingerprint.Validators[0].(func(string) error)
+	// idempotencykeyDescStatusCode is the schema descriptor for status_code field.
+	idempotencykeyDescStatusCode := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.DefaultStatusCode holds the default value on creation for the status_code field.
+	idempotencykey.DefaultStatusCode = idempotencykeyDescStatusCode.Default.(int)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[6].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
 	moduleFields := schema.Module{}.Fields()
 	_ = moduleFields
 	// moduleDescTitle is the schema descriptor for title field.
-	moduleDescTitle := moduleFields[3].Descriptor()
+	moduleDescTitle := moduleFields[4].Descriptor()
 	// module.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	module.TitleValidator = moduleDescTitle.Validators[0].(func(string) error)
 	// moduleDescModuleType is the schema descriptor for module_type field.
-	moduleDescModuleType := moduleFields[4].Descriptor()
+	moduleDescModuleType := moduleFields[5].Descriptor()
 	// module.ModuleTypeValidator is a validator for the "module_type" field. It is called by the builders before save.
 	module.ModuleTypeValidator = moduleDescModuleType.Validators[0].(func(string) error)
 	// moduleDescPosition is the schema descriptor for position field.
-	moduleDescPosition := moduleFields[5].Descriptor()
+	moduleDescPosition := moduleFields[6].Descriptor()
 	// module.DefaultPosition holds the default value on creation for the position field.
 	module.DefaultPosition = moduleDescPosition.Default.(int)
 	// moduleDescStatus is the schema descriptor for status field.
-	moduleDescStatus := moduleFields[7].Descriptor()
+	moduleDescStatus := moduleFields[8].Descriptor()
 	// module.DefaultStatus holds the default value on creation for the status field.
 	module.DefaultStatus = moduleDescStatus.Default.(string)
 	// moduleDescData is the schema descriptor for data field.
-	moduleDescData := moduleFields[8].Descriptor()
+	moduleDescData := moduleFields[9].Descriptor()
 	// module.DefaultData holds the default value on creation for the data field.
 	module.DefaultData = moduleDescData.Default.(map[string]interface{})
+	// moduleDescVersion is the schema descriptor for version field.
+	moduleDescVersion := moduleFields[10].Descriptor()
+	// module.DefaultVersion holds the default value on creation for the version field.
+	module.DefaultVersion = moduleDescVersion.Default.(int)
 	// moduleDescCreatedAt is the schema descriptor for created_at field.
-	moduleDescCreatedAt := moduleFields[9].Descriptor()
+	moduleDescCreatedAt := moduleFields[11].Descriptor()
 	// module.DefaultCreatedAt holds the default value on creation for the created_at field.
 	module.DefaultCreatedAt = moduleDescCreatedAt.Default.(func() time.Time)
 	// moduleDescUpdatedAt is the schema descriptor for updated_at field.
-	moduleDescUpdatedAt := moduleFields[10].Descriptor()
+	moduleDescUpdatedAt := moduleFields[12].Descriptor()
 	// module.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	module.DefaultUpdatedAt = moduleDescUpdatedAt.Default.(func() time.Time)
 	// module.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -225,12 +338,28 @@ func init() {
 	organizationDescSettings := organizationFields[4].Descriptor()
 	// organization.DefaultSettings holds the default value on creation for the settings field.
 	organization.DefaultSettings = organizationDescSettings.Default.(map[string]interface{})
+	// organizationDescStorageBytesUsed is the schema descriptor for storage_bytes_used field.
+	organizationDescStorageBytesUsed := organizationFields[5].Descriptor()
+	// organization.DefaultStorageBytesUsed holds the default value on creation for the storage_bytes_used field.
+	organization.DefaultStorageBytesUsed = organizationDescStorageBytesUsed.Default.(int64)
+	// organizationDescStorageObjectsUsed is the schema descriptor for storage_objects_used field.
+	organizationDescStorageObjectsUsed := organizationFields[6].Descriptor()
+	// organization.DefaultStorageObjectsUsed holds the default value on creation for the storage_objects_used field.
+	organization.DefaultStorageObjectsUsed = organizationDescStorageObjectsUsed.Default.(int)
+	// organizationDescStorageAlertLevel is the schema descriptor for storage_alert_level field.
+	organizationDescStorageAlertLevel := organizationFields[7].Descriptor()
+	// organization.DefaultStorageAlertLevel holds the default value on creation for the storage_alert_level field.
+	organization.DefaultStorageAlertLevel = organizationDescStorageAlertLevel.Default.(int)
+	// organizationDescVersion is the schema descriptor for version field.
+	organizationDescVersion := organizationFields[8].Descriptor()
+	// organization.DefaultVersion holds the default value on creation for the version field.
+	organization.DefaultVersion = organizationDescVersion.Default.(int)
 	// organizationDescCreatedAt is the schema descriptor for created_at field.
-	organizationDescCreatedAt := organizationFields[5].Descriptor()
+	organizationDescCreatedAt := organizationFields[9].Descriptor()
 	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
 	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
 	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
-	organizationDescUpdatedAt := organizationFields[6].Descriptor()
+	organizationDescUpdatedAt := organizationFields[10].Descriptor()
 	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -239,6 +368,112 @@ func init() {
 	organizationDescID := organizationFields[0].Descriptor()
 	// organization.DefaultID holds the default value on creation for the id field.
 	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
+	privacyrequestFields := schema.PrivacyRequest{}.Fields()
+	_ = privacyrequestFields
+	// privacyrequestDescKind is the schema descriptor for kind field.
+	privacyrequestDescKind := privacyrequestFields[3].Descriptor()
+	// privacyrequest.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	privacyrequest.KindValidator = privacyrequestDescKind.Validators[0].(func(string) error)
+	// privacyrequestDescStatus is the schema descriptor for status field.
+	privacyrequestDescStatus := privacyrequestFields[4].Descriptor()
+	// privacyrequest.DefaultStatus holds the default value on creation for the status field.
+	privacyrequest.DefaultStatus = privacyrequestDescStatus.Default.(string)
+	// privacyrequestDescSizeBytes is the schema descriptor for size_bytes field.
+	privacyrequestDescSizeBytes := privacyrequestFields[15].Descriptor()
+	// privacyrequest.DefaultSizeBytes holds the default value on creation for the size_bytes field.
+	privacyrequest.DefaultSizeBytes = privacyrequestDescSizeBytes.Default.(int64)
+	// privacyrequestDescCreatedAt is the schema descriptor for created_at field.
+	privacyrequestDescCreatedAt := privacyrequestFields[19].Descriptor()
+	// privacyrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	privacyrequest.DefaultCreatedAt = privacyrequestDescCreatedAt.Default.(func() time.Time)
+	// privacyrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	privacyrequestDescUpdatedAt := privacyrequestFields[20].Descriptor()
+	// privacyrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	privacyrequest.DefaultUpdatedAt = privacyrequestDescUpdatedAt.Default.(func() time.Time)
+	// privacyrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	privacyrequest.UpdateDefaultUpdatedAt = privacyrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// privacyrequestDescID is the schema descriptor for id field.
+	privacyrequestDescID := privacyrequestFields[0].Descriptor()
+	// privacyrequest.DefaultID holds the default value on creation for the id field.
+	privacyrequest.DefaultID = privacyrequestDescID.Default.(func() uuid.UUID)
+	ssoidentityFields := schema.SSOIdentity{}.Fields()
+	_ = ssoidentityFields
+	// ssoidentityDescProtocol is the schema descriptor for protocol field.
+	ssoidentityDescProtocol := ssoidentityFields[3].Descriptor()
+	// ssoidentity.ProtocolValidator is a validator for the "protocol" field. It is called by the builders before save.
+	ssoidentity.ProtocolValidator = ssoidentityDescProtocol.Validators[0].(func(string) error)
+	// ssoidentityDescIssuer is the schema descriptor for issuer field.
+	ssoidentityDescIssuer := ssoidentityFields[4].Descriptor()
+	// ssoidentity.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	ssoidentity.IssuerValidator = ssoidentityDescIssuer.Validators[0].(func(string) error)
+	// ssoidentityDescSubject is the schema descriptor for subject field.
+	ssoidentityDescSubject := ssoidentityFields[5].Descriptor()
+	// ssoidentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	ssoidentity.SubjectValidator = ssoidentityDescSubject.Validators[0].(func(string) error)
+	// ssoidentityDescCreatedAt is the schema descriptor for created_at field.
+	ssoidentityDescCreatedAt := ssoidentityFields[6].Descriptor()
+	// ssoidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ssoidentity.DefaultCreatedAt = ssoidentityDescCreatedAt.Default.(func() time.Time)
+	// ssoidentityDescID is the schema descriptor for id field.
+	ssoidentityDescID := ssoidentityFields[0].Descriptor()
+	// ssoidentity.DefaultID holds the default value on creation for the id field.
+	ssoidentity.DefaultID = ssoidentityDescID.Default.(func() uuid.UUID)
+	samlassertionFields := schema.SamlAssertion{}.Fields()
+	_ = samlassertionFields
+	// samlassertionDescAssertionID is the schema descriptor for assertion_id field.
+	samlassertionDescAssertionID := samlassertionFields[1].Descriptor()
+	// samlassertion.AssertionIDValidator is a validator for the "assertion_id" field. It is called by the builders before save.
+	samlassertion.AssertionIDValidator = samlassertionDescAssertionID.Validators[0].(func(string) error)
+	// samlassertionDescCreatedAt is the schema descriptor for created_at field.
+	samlassertionDescCreatedAt := samlassertionFields[3].Descriptor()
+	// samlassertion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	samlassertion.DefaultCreatedAt = samlassertionDescCreatedAt.Default.(func() time.Time)
+	// samlassertionDescID is the schema descriptor for id field.
+	samlassertionDescID := samlassertionFields[0].Descriptor()
+	// samlassertion.DefaultID holds the default value on creation for the id field.
+	samlassertion.DefaultID = samlassertionDescID.Default.(func() uuid.UUID)
+	scimtokenFields := schema.ScimToken{}.Fields()
+	_ = scimtokenFields
+	// scimtokenDescName is the schema descriptor for name field.
+	scimtokenDescName := scimtokenFields[2].Descriptor()
+	// scimtoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scimtoken.NameValidator = scimtokenDescName.Validators[0].(func(string) error)
+	// scimtokenDescTokenHash is the schema descriptor for token_hash field.
+	scimtokenDescTokenHash := scimtokenFields[3].Descriptor()
+	// scimtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	scimtoken.TokenHashValidator = scimtokenDescTokenHash.Validators[0].(func(string) error)
+	// scimtokenDescCreatedAt is the schema descriptor for created_at field.
+	scimtokenDescCreatedAt := scimtokenFields[5].Descriptor()
+	// scimtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scimtoken.DefaultCreatedAt = scimtokenDescCreatedAt.Default.(func() time.Time)
+	// scimtokenDescID is the schema descriptor for id field.
+	scimtokenDescID := scimtokenFields[0].Descriptor()
+	// scimtoken.DefaultID holds the default value on creation for the id field.
+	scimtoken.DefaultID = scimtokenDescID.Default.(func() uuid.UUID)
+	serviceaccountFields := schema.ServiceAccount{}.Fields()
+	_ = serviceaccountFields
+	// serviceaccountDescName is the schema descriptor for name field.
+	serviceaccountDescName := serviceaccountFields[2].Descriptor()
+	// serviceaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	serviceaccount.NameValidator = serviceaccountDescName.Validators[0].(func(string) error)
+	// serviceaccountDescStatus is the schema descriptor for status field.
+	serviceaccountDescStatus := serviceaccountFields[4].Descriptor()
+	// serviceaccount.DefaultStatus holds the default value on creation for the status field.
+	serviceaccount.DefaultStatus = serviceaccountDescStatus.Default.(string)
+	// serviceaccountDescCreatedAt is the schema descriptor for created_at field.
+	serviceaccountDescCreatedAt := serviceaccountFields[5].Descriptor()
+	// serviceaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	serviceaccount.DefaultCreatedAt = serviceaccountDescCreatedAt.Default.(func() time.Time)
+	// serviceaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	serviceaccountDescUpdatedAt := serviceaccountFields[6].Descriptor()
+	// serviceaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	serviceaccount.DefaultUpdatedAt = serviceaccountDescUpdatedAt.Default.(func() time.Time)
+	// serviceaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	serviceaccount.UpdateDefaultUpdatedAt = serviceaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// serviceaccountDescID is the schema descriptor for id field.
+	serviceaccountDescID := serviceaccountFields[0].Descriptor()
+	// serviceaccount.DefaultID holds the default value on creation for the id field.
+	serviceaccount.DefaultID = serviceaccountDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -257,16 +492,28 @@ func init() {
 	userDescStatus := userFields[5].Descriptor()
 	// user.DefaultStatus holds the default value on creation for the status field.
 	user.DefaultStatus = userDescStatus.Default.(string)
+	// userDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
+	userDescFailedLoginAttempts := userFields[8].Descriptor()
+	// user.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
+	user.DefaultFailedLoginAttempts = userDescFailedLoginAttempts.Default.(int)
+	// userDescMfaLastStep is the schema descriptor for mfa_last_step field.
+	userDescMfaLastStep := userFields[13].Descriptor()
+	// user.DefaultMfaLastStep holds the default value on creation for the mfa_last_step field.
+	user.DefaultMfaLastStep = userDescMfaLastStep.Default.(int64)
 	// userDescMetadata is the schema descriptor for metadata field.
-	userDescMetadata := userFields[8].Descriptor()
+	userDescMetadata := userFields[16].Descriptor()
 	// user.DefaultMetadata holds the default value on creation for the metadata field.
 	user.DefaultMetadata = userDescMetadata.Default.(map[string]interface{})
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userFields[17].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[9].Descriptor()
+	userDescCreatedAt := userFields[18].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[10].Descriptor()
+	userDescUpdatedAt := userFields[19].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -275,4 +522,56 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webauthnceremonyFields := schema.WebauthnCeremony{}.Fields()
+	_ = webauthnceremonyFields
+	// webauthnceremonyDescSessionID is the schema descriptor for session_id field.
+	webauthnceremonyDescSessionID := webauthnceremonyFields[1].Descriptor()
+	// webauthnceremony.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	webauthnceremony.SessionIDValidator = webauthnceremonyDescSessionID.Validators[0].(func(string) error)
+	// webauthnceremonyDescCreatedAt is the schema descriptor for created_at field.
+	webauthnceremonyDescCreatedAt := webauthnceremonyFields[3].Descriptor()
+	// webauthnceremony.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthnceremony.DefaultCreatedAt = webauthnceremonyDescCreatedAt.Default.(func() time.Time)
+	// webauthnceremonyDescID is the schema descriptor for id field.
+	webauthnceremonyDescID := webauthnceremonyFields[0].Descriptor()
+	// webauthnceremony.DefaultID holds the default value on creation for the id field.
+	webauthnceremony.DefaultID = webauthnceremonyDescID.Default.(func() uuid.UUID)
+	webauthncredentialFields := schema.WebauthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescCredentialID is the schema descriptor for credential_id field.
+	webauthncredentialDescCredentialID := webauthncredentialFields[2].Descriptor()
+	// webauthncredential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	webauthncredential.CredentialIDValidator = webauthncredentialDescCredentialID.Validators[0].(func([]byte) error)
+	// webauthncredentialDescPublicKey is the schema descriptor for public_key field.
+	webauthncredentialDescPublicKey := webauthncredentialFields[3].Descriptor()
+	// webauthncredential.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	webauthncredential.PublicKeyValidator = webauthncredentialDescPublicKey.Validators[0].(func([]byte) error)
+	// webauthncredentialDescAttestationType is the schema descriptor for attestation_type field.
+	webauthncredentialDescAttestationType := webauthncredentialFields[4].Descriptor()
+	// webauthncredential.DefaultAttestationType holds the default value on creation for the attestation_type field.
+	webauthncredential.DefaultAttestationType = webauthncredentialDescAttestationType.Default.(string)
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	webauthncredentialDescBackupEligible := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	webauthncredential.DefaultBackupEligible = webauthncredentialDescBackupEligible.Default.(bool)
+	// webauthncredentialDescBackupState is the schema descriptor for backup_state field.
+	webauthncredentialDescBackupState := webauthncredentialFields[9].Descriptor()
+	// webauthncredential.DefaultBackupState holds the default value on creation for the backup_state field.
+	webauthncredential.DefaultBackupState = webauthncredentialDescBackupState.Default.(bool)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[10].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialFields[12].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.DefaultID holds the default value on creation for the id field.
+	webauthncredential.DefaultID = webauthncredentialDescID.Default.(func() uuid.UUID)
 }
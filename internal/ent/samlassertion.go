@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/samlassertion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SamlAssertion is the model entity for the SamlAssertion schema.
+type SamlAssertion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AssertionID holds the value of the "assertion_id" field.
+	AssertionID string `json:"assertion_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SamlAssertion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlassertion.FieldAssertionID:
+			values[i] = new(sql.NullString)
+		case samlassertion.FieldExpiresAt, samlassertion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case samlassertion.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SamlAssertion fields.
+func (sa *SamlAssertion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlassertion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sa.ID = *value
+			}
+		case samlassertion.FieldAssertionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assertion_id", values[i])
+			} else if value.Valid {
+				sa.AssertionID = value.String
+			}
+		case samlassertion.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sa.ExpiresAt = value.Time
+			}
+		case samlassertion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SamlAssertion.
+// This includes values selected through modifiers, order, etc.
+func (sa *SamlAssertion) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SamlAssertion.
+// Note that you need to call SamlAssertion.Unwrap() before calling this method if this SamlAssertion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SamlAssertion) Update() *SamlAssertionUpdateOne {
+	return NewSamlAssertionClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SamlAssertion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SamlAssertion) Unwrap() *SamlAssertion {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SamlAssertion is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SamlAssertion) String() string {
+	var builder strings.Builder
+	builder.WriteString("SamlAssertion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("assertion_id=")
+	builder.WriteString(sa.AssertionID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(sa.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SamlAssertions is a parsable slice of SamlAssertion.
+type SamlAssertions []*SamlAssertion
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlassertion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the samlassertion type in the database.
+	Label = "saml_assertion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAssertionID holds the string denoting the assertion_id field in the database.
+	FieldAssertionID = "assertion_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the samlassertion in the database.
+	Table = "saml_assertions"
+)
+
+// Columns holds all SQL columns for samlassertion fields.
+var Columns = []string{
+	FieldID,
+	FieldAssertionID,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AssertionIDValidator is a validator for the "assertion_id" field. It is called by the builders before save.
+	AssertionIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SamlAssertion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAssertionID orders the results by the assertion_id field.
+func ByAssertionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssertionID, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlassertion
+
+import (
+	"lms-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLTE(FieldID, id))
+}
+
+// AssertionID applies equality check predicate on the "assertion_id" field. It's identical to AssertionIDEQ.
+func AssertionID(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldAssertionID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AssertionIDEQ applies the EQ predicate on the "assertion_id" field.
+func AssertionIDEQ(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldAssertionID, v))
+}
+
+// AssertionIDNEQ applies the NEQ predicate on the "assertion_id" field.
+func AssertionIDNEQ(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNEQ(FieldAssertionID, v))
+}
+
+// AssertionIDIn applies the In predicate on the "assertion_id" field.
+func AssertionIDIn(vs ...string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldIn(FieldAssertionID, vs...))
+}
+
+// AssertionIDNotIn applies the NotIn predicate on the "assertion_id" field.
+func AssertionIDNotIn(vs ...string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNotIn(FieldAssertionID, vs...))
+}
+
+// AssertionIDGT applies the GT predicate on the "assertion_id" field.
+func AssertionIDGT(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGT(FieldAssertionID, v))
+}
+
+// AssertionIDGTE applies the GTE predicate on the "assertion_id" field.
+func AssertionIDGTE(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGTE(FieldAssertionID, v))
+}
+
+// AssertionIDLT applies the LT predicate on the "assertion_id" field.
+func AssertionIDLT(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLT(FieldAssertionID, v))
+}
+
+// AssertionIDLTE applies the LTE predicate on the "assertion_id" field.
+func AssertionIDLTE(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLTE(FieldAssertionID, v))
+}
+
+// AssertionIDContains applies the Contains predicate on the "assertion_id" field.
+func AssertionIDContains(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldContains(FieldAssertionID, v))
+}
+
+// AssertionIDHasPrefix applies the HasPrefix predicate on the "assertion_id" field.
+func AssertionIDHasPrefix(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldHasPrefix(FieldAssertionID, v))
+}
+
+// AssertionIDHasSuffix applies the HasSuffix predicate on the "assertion_id" field.
+func AssertionIDHasSuffix(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldHasSuffix(FieldAssertionID, v))
+}
+
+// AssertionIDEqualFold applies the EqualFold predicate on the "assertion_id" field.
+func AssertionIDEqualFold(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEqualFold(FieldAssertionID, v))
+}
+
+// AssertionIDContainsFold applies the ContainsFold predicate on the "assertion_id" field.
+func AssertionIDContainsFold(v string) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldContainsFold(FieldAssertionID, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SamlAssertion) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SamlAssertion) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SamlAssertion) predicate.SamlAssertion {
+	return predicate.SamlAssertion(sql.NotPredicates(p))
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/samlassertion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SamlAssertionCreate is the builder for creating a SamlAssertion entity.
+type SamlAssertionCreate struct {
+	config
+	mutation *SamlAssertionMutation
+	hooks    []Hook
+}
+
+// SetAssertionID sets the "assertion_id" field.
+func (sac *SamlAssertionCreate) SetAssertionID(s string) *SamlAssertionCreate {
+	sac.mutation.SetAssertionID(s)
+	return sac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sac *SamlAssertionCreate) SetExpiresAt(t time.Time) *SamlAssertionCreate {
+	sac.mutation.SetExpiresAt(t)
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SamlAssertionCreate) SetCreatedAt(t time.Time) *SamlAssertionCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SamlAssertionCreate) SetNillableCreatedAt(t *time.Time) *SamlAssertionCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SamlAssertionCreate) SetID(u uuid.UUID) *SamlAssertionCreate {
+	sac.mutation.SetID(u)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SamlAssertionCreate) SetNillableID(u *uuid.UUID) *SamlAssertionCreate {
+	if u != nil {
+		sac.SetID(*u)
+	}
+	return sac
+}
+
+// Mutation returns the SamlAssertionMutation object of the builder.
+func (sac *SamlAssertionCreate) Mutation() *SamlAssertionMutation {
+	return sac.mutation
+}
+
+// Save creates the SamlAssertion in the database.
+func (sac *SamlAssertionCreate) Save(ctx context.Context) (*SamlAssertion, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SamlAssertionCreate) SaveX(ctx context.Context) *SamlAssertion {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SamlAssertionCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SamlAssertionCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SamlAssertionCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := samlassertion.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := samlassertion.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SamlAssertionCreate) check() error {
+	if _, ok := sac.mutation.AssertionID(); !ok {
+		return &ValidationError{Name: "assertion_id", err: errors.New(`ent: missing required field "SamlAssertion.assertion_id"`)}
+	}
+	if v, ok := sac.mutation.AssertionID(); ok {
+		if err := samlassertion.AssertionIDValidator(v); err != nil {
+			return &ValidationError{Name: "assertion_id", err: fmt.Errorf(`ent: validator failed for field "SamlAssertion.assertion_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SamlAssertion.expires_at"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SamlAssertion.created_at"`)}
+	}
+	return nil
+}
+
+func (sac *SamlAssertionCreate) sqlSave(ctx context.Context) (*SamlAssertion, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SamlAssertionCreate) createSpec() (*SamlAssertion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SamlAssertion{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(samlassertion.Table, sqlgraph.NewFieldSpec(samlassertion.FieldID, field.TypeUUID))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sac.mutation.AssertionID(); ok {
+		_spec.SetField(samlassertion.FieldAssertionID, field.TypeString, value)
+		_node.AssertionID = value
+	}
+	if value, ok := sac.mutation.ExpiresAt(); ok {
+		_spec.SetField(samlassertion.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(samlassertion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SamlAssertionCreateBulk is the builder for creating many SamlAssertion entities in bulk.
+type SamlAssertionCreateBulk struct {
+	config
+	err      error
+	builders []*SamlAssertionCreate
+}
+
+// Save creates the SamlAssertion entities in the database.
+func (sacb *SamlAssertionCreateBulk) Save(ctx context.Context) ([]*SamlAssertion, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SamlAssertion, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SamlAssertionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SamlAssertionCreateBulk) SaveX(ctx context.Context) []*SamlAssertion {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SamlAssertionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SamlAssertionCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/samlassertion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlAssertionDelete is the builder for deleting a SamlAssertion entity.
+type SamlAssertionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SamlAssertionMutation
+}
+
+// Where appends a list predicates to the SamlAssertionDelete builder.
+func (sad *SamlAssertionDelete) Where(ps ...predicate.SamlAssertion) *SamlAssertionDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SamlAssertionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SamlAssertionDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SamlAssertionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(samlassertion.Table, sqlgraph.NewFieldSpec(samlassertion.FieldID, field.TypeUUID))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// SamlAssertionDeleteOne is the builder for deleting a single SamlAssertion entity.
+type SamlAssertionDeleteOne struct {
+	sad *SamlAssertionDelete
+}
+
+// Where appends a list predicates to the SamlAssertionDelete builder.
+func (sado *SamlAssertionDeleteOne) Where(ps ...predicate.SamlAssertion) *SamlAssertionDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *SamlAssertionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{samlassertion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SamlAssertionDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/samlassertion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlAssertionUpdate is the builder for updating SamlAssertion entities.
+type SamlAssertionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SamlAssertionMutation
+}
+
+// Where appends a list predicates to the SamlAssertionUpdate builder.
+func (sau *SamlAssertionUpdate) Where(ps ...predicate.SamlAssertion) *SamlAssertionUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// Mutation returns the SamlAssertionMutation object of the builder.
+func (sau *SamlAssertionUpdate) Mutation() *SamlAssertionMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SamlAssertionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SamlAssertionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SamlAssertionUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SamlAssertionUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sau *SamlAssertionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(samlassertion.Table, samlassertion.Columns, sqlgraph.NewFieldSpec(samlassertion.FieldID, field.TypeUUID))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SamlAssertionUpdateOne is the builder for updating a single SamlAssertion entity.
+type SamlAssertionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SamlAssertionMutation
+}
+
+// Mutation returns the SamlAssertionMutation object of the builder.
+func (sauo *SamlAssertionUpdateOne) Mutation() *SamlAssertionMutation {
+	return sauo.mutation
+}
+
+// Where appends a list predicates to the SamlAssertionUpdate builder.
+func (sauo *SamlAssertionUpdateOne) Where(ps ...predicate.SamlAssertion) *SamlAssertionUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SamlAssertionUpdateOne) Select(field string, fields ...string) *SamlAssertionUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SamlAssertion entity.
+func (sauo *SamlAssertionUpdateOne) Save(ctx context.Context) (*SamlAssertion, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SamlAssertionUpdateOne) SaveX(ctx context.Context) *SamlAssertion {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SamlAssertionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SamlAssertionUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sauo *SamlAssertionUpdateOne) sqlSave(ctx context.Context) (_node *SamlAssertion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(samlassertion.Table, samlassertion.Columns, sqlgraph.NewFieldSpec(samlassertion.FieldID, field.TypeUUID))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SamlAssertion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlassertion.FieldID)
+		for _, f := range fields {
+			if !samlassertion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlassertion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &SamlAssertion{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// APIKey est une clé d'API d'un compte de service. La clé se compose d'un préfixe public,
+// conservé pour la recherche et l'affichage, et d'un secret dont seule l'empreinte SHA-256 est stockée.
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("service_account_id", uuid.UUID{}),
+		field.String("name").
+			NotEmpty(),
+		field.String("prefix").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("secret_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Strings("scopes").
+			Default([]string{}),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service_account", ServiceAccount.Type).
+			Ref("api_keys").
+			Field("service_account_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Annotations exclut APIKey du schéma GraphQL.
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -27,10 +29,27 @@ func (Content) Fields() []ent.Field {
 			NotEmpty(),
 		field.Int64("size_bytes").
 			Optional(),
+		// storage_key, mime_type, size_bytes, etag et checksum_sha256 reflètent la révision courante.
 		field.String("storage_key").
 			NotEmpty(),
+		field.Int("current_revision").
+			Default(1),
+		// etag et checksum_sha256 sont relevés sur l'objet stocké lors de la finalisation.
+		field.String("etag").
+			Optional(),
+		field.String("checksum_sha256").
+			Optional(),
 		field.String("status").
 			Default("pending"),
+		// upload_id identifie le dépôt multipart en cours d'un contenu en attente.
+		field.String("upload_id").
+			Optional().
+			Nillable(),
+		field.Int64("upload_part_size").
+			Optional(),
+		field.Time("upload_started_at").
+			Optional().
+			Nillable(),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
 			Default(map[string]any{}),
@@ -51,6 +70,8 @@ func (Content) Edges() []ent.Edge {
 			Unique().
 			Required(),
 		edge.To("modules", Module.Type),
+		edge.To("revisions", ContentRevision.Type),
+		edge.To("downloads", ContentDownload.Type),
 	}
 }
 
@@ -61,3 +82,8 @@ func (Content) Indexes() []ent.Index {
 		index.Fields("organization_id", "name"),
 	}
 }
+
+// Annotations exclut Content du schéma GraphQL.
+func (Content) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ContentDownload trace la délivrance d'un lien de téléchargement et la révision servie.
+type ContentDownload struct {
+	ent.Schema
+}
+
+func (ContentDownload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("content_id", uuid.UUID{}),
+		field.Int("revision"),
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("module_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ContentDownload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("content", Content.Type).
+			Ref("downloads").
+			Field("content_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ContentDownload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("content_id", "created_at"),
+		index.Fields("user_id", "content_id"),
+	}
+}
+
+// Annotations exclut ContentDownload du schéma GraphQL.
+func (ContentDownload) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
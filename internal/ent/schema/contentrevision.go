@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ContentRevision est une version du fichier d'un contenu. Chaque dépôt d'un nouveau binaire
+// crée la révision N+1 sous sa propre clé de stockage ; les révisions antérieures sont conservées.
+type ContentRevision struct {
+	ent.Schema
+}
+
+func (ContentRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("content_id", uuid.UUID{}),
+		field.Int("number").
+			Positive().
+			Immutable(),
+		field.String("storage_key").
+			NotEmpty().
+			Immutable(),
+		field.String("mime_type").
+			NotEmpty(),
+		field.Int64("size_bytes").
+			Optional(),
+		field.String("etag").
+			Optional(),
+		field.String("checksum_sha256").
+			Optional(),
+		field.String("status").
+			Default("pending"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ContentRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("content", Content.Type).
+			Ref("revisions").
+			Field("content_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ContentRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("content_id", "number").
+			Unique(),
+		index.Fields("organization_id", "storage_key").
+			Unique(),
+	}
+}
+
+// Annotations exclut ContentRevision du schéma GraphQL.
+func (ContentRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,29 +22,36 @@ func (Course) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("organization_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
 		field.String("title").
-			NotEmpty(),
+			NotEmpty().
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("slug").
 			NotEmpty(),
 		field.String("description").
 			Optional(),
 		field.String("status").
-			Default("draft"),
+			Default("draft").
+			Annotations(entgql.Type("CourseStatus")),
+		// version est incrémentée à chaque modification du cours ou de ses modules (ETag, If-Match).
 		field.Int("version").
 			Default(1),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
-			Default(map[string]any{}),
+			Default(map[string]any{}).
+			Annotations(entgql.Type("Map")),
 		field.Time("published_at").
 			Optional().
 			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
-			Immutable(),
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
-			UpdateDefault(time.Now),
+			UpdateDefault(time.Now).
+			Annotations(entgql.OrderField("UPDATED_AT")),
 	}
 }
 
@@ -53,9 +62,20 @@ func (Course) Edges() []ent.Edge {
 			Field("organization_id").
 			Unique().
 			Required(),
-		edge.To("modules", Module.Type),
-		edge.To("enrollments", Enrollment.Type),
-		edge.To("groups", Group.Type),
+		edge.To("modules", Module.Type).
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("enrollments", Enrollment.Type).
+			Annotations(entgql.Skip()),
+		edge.To("groups", Group.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations expose les cours en connexion Relay (Query.courses).
+func (Course) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
 
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,16 +22,22 @@ func (Enrollment) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("organization_id", uuid.UUID{}),
-		field.UUID("course_id", uuid.UUID{}),
-		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("organization_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
+		field.UUID("course_id", uuid.UUID{}).
+			Annotations(entgql.Skip(entgql.SkipType)),
+		field.UUID("user_id", uuid.UUID{}).
+			Annotations(entgql.Skip(entgql.SkipType)),
 		field.UUID("group_id", uuid.UUID{}).
 			Optional().
-			Nillable(),
+			Nillable().
+			Annotations(entgql.Skip(entgql.SkipType)),
 		field.String("status").
-			Default("pending"),
+			Default("pending").
+			Annotations(entgql.Type("EnrollmentStatus")),
 		field.Float32("progress").
-			Default(0),
+			Default(0).
+			Annotations(entgql.OrderField("PROGRESS")),
 		field.Time("started_at").
 			Optional().
 			Nillable(),
@@ -38,13 +46,16 @@ func (Enrollment) Fields() []ent.Field {
 			Nillable(),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
-			Default(map[string]any{}),
+			Default(map[string]any{}).
+			Annotations(entgql.Type("Map")),
 		field.Time("created_at").
 			Default(time.Now).
-			Immutable(),
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
-			UpdateDefault(time.Now),
+			UpdateDefault(time.Now).
+			Annotations(entgql.OrderField("UPDATED_AT")),
 	}
 }
 
@@ -59,17 +70,29 @@ func (Enrollment) Edges() []ent.Edge {
 			Ref("enrollments").
 			Field("course_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
 		edge.From("user", User.Type).
 			Ref("enrollments").
 			Field("user_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
 		edge.From("group", Group.Type).
 			Ref("enrollments").
 			Field("group_id").
-			Unique(),
-		edge.To("progress_entries", ModuleProgress.Type),
+			Unique().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("progress_entries", ModuleProgress.Type).
+			Annotations(entgql.MapsTo("moduleProgress"), entgql.Skip(entgql.SkipWhereInput)),
+	}
+}
+
+// Annotations expose les inscriptions en connexion Relay (Query.enrollments).
+func (Enrollment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
 
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,23 +22,36 @@ func (Group) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("organization_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
 		field.UUID("course_id", uuid.UUID{}).
 			Optional().
-			Nillable(),
+			Nillable().
+			Annotations(entgql.Skip(entgql.SkipType)),
 		field.String("name").
-			NotEmpty(),
+			NotEmpty().
+			Annotations(entgql.OrderField("NAME")),
 		field.String("description").
 			Optional(),
 		field.Int("capacity").
 			Optional().
 			Nillable(),
+		// external_id est l'identifiant attribué par l'annuaire source (SCIM).
+		field.String("external_id").
+			Optional().
+			Nillable().
+			Annotations(entgql.MapsTo("externalId")),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
-			Default(map[string]any{}),
+			Default(map[string]any{}).
+			Annotations(entgql.Type("Map")),
+		// version est incrémentée à chaque modification (ETag, If-Match).
+		field.Int("version").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now).
-			Immutable(),
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -53,8 +68,20 @@ func (Group) Edges() []ent.Edge {
 		edge.From("course", Course.Type).
 			Ref("groups").
 			Field("course_id").
-			Unique(),
-		edge.To("enrollments", Enrollment.Type),
+			Unique().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("enrollments", Enrollment.Type).
+			Annotations(entgql.Skip()),
+		edge.To("members", User.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations expose les groupes en connexion Relay (Query.groups).
+func (Group) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
 
@@ -62,5 +89,7 @@ func (Group) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("organization_id", "name"),
 		index.Fields("organization_id", "course_id"),
+		index.Fields("organization_id", "external_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey conserve la réponse d'une requête POST rejouable (entête Idempotency-Key).
+// key associe le principal à la clé fournie par le client ; la ligne expire après expires_at.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		// fingerprint est l'empreinte SHA-256 de la requête (méthode, chemin, organisation, corps).
+		field.String("fingerprint").
+			NotEmpty().
+			Immutable(),
+		// status_code vaut 0 tant que la première requête est en cours.
+		field.Int("status_code").
+			Default(0),
+		field.JSON("header", map[string][]string{}).
+			Optional(),
+		// body peut contenir des URL signées : jamais journalisé.
+		field.Bytes("body").
+			Optional().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations exclut IdempotencyKey du schéma GraphQL.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
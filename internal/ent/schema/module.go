@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,8 +22,14 @@ func (Module) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("course_id", uuid.UUID{}),
+		field.UUID("course_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
 		field.UUID("content_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(entgql.MapsTo("contentId")),
+		// content_revision épingle une révision du contenu ; nil suit la révision courante.
+		field.Int("content_revision").
 			Optional().
 			Nillable(),
 		field.String("title").
@@ -36,7 +44,11 @@ func (Module) Fields() []ent.Field {
 			Default("active"),
 		field.JSON("data", map[string]any{}).
 			Optional().
-			Default(map[string]any{}),
+			Default(map[string]any{}).
+			Annotations(entgql.Type("Map")),
+		// version est incrémentée à chaque modification (ETag, If-Match).
+		field.Int("version").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -52,15 +64,22 @@ func (Module) Edges() []ent.Edge {
 			Ref("modules").
 			Field("course_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
 		edge.From("content", Content.Type).
 			Ref("modules").
 			Field("content_id").
 			Unique(),
-		edge.To("progress_entries", ModuleProgress.Type),
+		edge.To("progress_entries", ModuleProgress.Type).
+			Annotations(entgql.Skip()),
 	}
 }
 
+// Annotations retire Module des filtres where : les modules ne sont lus que par leur cours.
+func (Module) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipWhereInput)}
+}
+
 func (Module) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("course_id", "position"),
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,10 +22,13 @@ func (ModuleProgress) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("enrollment_id", uuid.UUID{}),
-		field.UUID("module_id", uuid.UUID{}),
+		field.UUID("enrollment_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
+		field.UUID("module_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
 		field.String("status").
-			Default("not_started"),
+			Default("not_started").
+			Annotations(entgql.Type("ProgressStatus")),
 		field.Float32("score").
 			Optional(),
 		field.Int("attempts").
@@ -49,15 +54,22 @@ func (ModuleProgress) Edges() []ent.Edge {
 			Ref("progress_entries").
 			Field("enrollment_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip()),
 		edge.From("module", Module.Type).
 			Ref("progress_entries").
 			Field("module_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
 	}
 }
 
+// Annotations retire ModuleProgress des filtres where.
+func (ModuleProgress) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipWhereInput)}
+}
+
 func (ModuleProgress) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("enrollment_id", "module_id").
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -32,6 +34,18 @@ func (Organization) Fields() []ent.Field {
 		field.JSON("settings", map[string]any{}).
 			Optional().
 			Default(map[string]any{}),
+		// Compteurs de stockage tenus par le service contenus : réservés à la création d'un
+		// dépôt, ajustés à la finalisation et libérés à l'archivage. Le quota vit dans settings["storage"].
+		field.Int64("storage_bytes_used").
+			Default(0),
+		field.Int("storage_objects_used").
+			Default(0),
+		// storage_alert_level est le dernier seuil d'alerte notifié (0, 80 ou 100 %).
+		field.Int("storage_alert_level").
+			Default(0),
+		// version est incrémentée à chaque modification (ETag, If-Match).
+		field.Int("version").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -49,5 +63,13 @@ func (Organization) Edges() []ent.Edge {
 		edge.To("courses", Course.Type),
 		edge.To("groups", Group.Type),
 		edge.To("enrollments", Enrollment.Type),
+		edge.To("scim_tokens", ScimToken.Type),
+		edge.To("service_accounts", ServiceAccount.Type),
+		edge.To("privacy_requests", PrivacyRequest.Type),
 	}
 }
+
+// Annotations exclut Organization du schéma GraphQL.
+func (Organization) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PrivacyRequest est une demande RGPD (export ou effacement) sur un utilisateur. Elle sert aussi
+// de trace d'audit : user_id n'est pas une clé étrangère et survit à la suppression du compte,
+// et la ligne ne contient aucune donnée personnelle.
+type PrivacyRequest struct {
+	ent.Schema
+}
+
+func (PrivacyRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+		field.String("status").
+			Default("pending"),
+		// mode est le traitement retenu pour un effacement (anonymize ou delete), figé à la demande.
+		field.String("mode").
+			Optional(),
+		// requested_by et cancelled_by identifient l'utilisateur ou le compte de service à l'origine
+		// de l'action ; requested_by_kind et cancelled_by_kind précisent lequel (user, service_account).
+		field.UUID("requested_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("requested_by_kind").
+			Optional(),
+		// scheduled_at est l'échéance de traitement : immédiate pour un export, fin du délai de grâce pour un effacement.
+		field.Time("scheduled_at"),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.UUID("cancelled_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("cancelled_by_kind").
+			Optional(),
+		// storage_key, size_bytes et expires_at décrivent l'archive d'un export terminé.
+		field.String("storage_key").
+			Optional().
+			Nillable(),
+		field.Int64("size_bytes").
+			Default(0),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		// summary compte les éléments exportés ou effacés par catégorie.
+		field.JSON("summary", map[string]int{}).
+			Optional(),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (PrivacyRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("privacy_requests").
+			Field("organization_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (PrivacyRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "user_id"),
+		index.Fields("status", "scheduled_at"),
+	}
+}
+
+// Annotations exclut PrivacyRequest du schéma GraphQL.
+func (PrivacyRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
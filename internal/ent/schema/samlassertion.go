@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SamlAssertion mémorise une assertion SAML consommée jusqu'à la fin de sa fenêtre de validité,
+// pour en refuser le rejeu quelle que soit l'instance qui la reçoit.
+type SamlAssertion struct {
+	ent.Schema
+}
+
+func (SamlAssertion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("assertion_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (SamlAssertion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations exclut SamlAssertion du schéma GraphQL.
+func (SamlAssertion) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
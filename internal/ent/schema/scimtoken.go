@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScimToken est un jeton bearer de provisioning SCIM émis pour une organisation.
+// Seule l'empreinte SHA-256 du jeton est conservée.
+type ScimToken struct {
+	ent.Schema
+}
+
+func (ScimToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}),
+		field.String("name").
+			NotEmpty(),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ScimToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("scim_tokens").
+			Field("organization_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Annotations exclut ScimToken du schéma GraphQL.
+func (ScimToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
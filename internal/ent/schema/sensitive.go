@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// all recense les schémas dont les champs Sensitive() sont masqués dans les journaux.
+var all = []ent.Interface{
+	APIKey{},
+	Content{},
+	ContentDownload{},
+	ContentRevision{},
+	Course{},
+	Enrollment{},
+	Group{},
+	IdempotencyKey{},
+	Module{},
+	ModuleProgress{},
+	Organization{},
+	PrivacyRequest{},
+	SamlAssertion{},
+	ScimToken{},
+	ServiceAccount{},
+	SSOIdentity{},
+	User{},
+	WebauthnCeremony{},
+	WebauthnCredential{},
+}
+
+// SensitiveFields renvoie le nom des champs Sensitive(), à masquer dans les journaux. Les champs
+// binaires (corps de réponse rejouables) portent des noms trop génériques pour servir de clé de
+// masquage et n'apparaissent jamais tels quels dans un journal : ils sont ignorés.
+func SensitiveFields() []string {
+	var names []string
+	for _, s := range all {
+		for _, f := range s.Fields() {
+			desc := f.Descriptor()
+			if desc.Sensitive && desc.Info.Type != field.TypeBytes {
+				names = append(names, strings.ToLower(desc.Name))
+			}
+		}
+	}
+	return names
+}
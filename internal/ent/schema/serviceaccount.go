@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ServiceAccount est un principal non humain d'une organisation (intégrations, scripts de synchronisation).
+// Il s'authentifie uniquement par clé d'API.
+type ServiceAccount struct {
+	ent.Schema
+}
+
+func (ServiceAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}),
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.String("status").
+			Default("active"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ServiceAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("service_accounts").
+			Field("organization_id").
+			Unique().
+			Required(),
+		edge.To("api_keys", APIKey.Type),
+	}
+}
+
+func (ServiceAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name").
+			Unique(),
+	}
+}
+
+// Annotations exclut ServiceAccount du schéma GraphQL.
+func (ServiceAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SSOIdentity lie un compte à l'identité (issuer, subject) d'un fournisseur SSO de son organisation.
+// Les connexions suivantes retrouvent le compte par ce couple et non par l'email.
+type SSOIdentity struct {
+	ent.Schema
+}
+
+func (SSOIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.String("protocol").
+			NotEmpty().
+			Immutable(),
+		field.String("issuer").
+			NotEmpty().
+			Immutable(),
+		field.String("subject").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (SSOIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sso_identities").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (SSOIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "issuer", "subject").
+			Unique(),
+	}
+}
+
+// Annotations exclut SSOIdentity du schéma GraphQL.
+func (SSOIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,10 +23,13 @@ func (User) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.UUID("organization_id", uuid.UUID{}),
+		field.UUID("organization_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
+		// email, sensible, est ajouté au type User par le schéma GraphQL écrit à la main.
 		field.String("email").
 			NotEmpty().
-			Sensitive(),
+			Sensitive().
+			Annotations(entgql.OrderField("EMAIL")),
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(),
@@ -39,15 +44,55 @@ func (User) Fields() []ent.Field {
 		field.Time("last_login_at").
 			Optional().
 			Nillable(),
+		field.Int("failed_login_attempts").
+			Default(0).
+			Annotations(entgql.Skip()),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
+		// mfa_secret est le secret TOTP chiffré (AES-GCM) ; mfa_enabled_at est renseigné à la confirmation.
+		field.String("mfa_secret").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Time("mfa_enabled_at").
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
+		// mfa_recovery_codes contient les empreintes des codes de secours non utilisés.
+		field.Strings("mfa_recovery_codes").
+			Optional().
+			Sensitive(),
+		// mfa_last_step mémorise le dernier pas TOTP accepté pour empêcher le rejeu d'un code.
+		field.Int64("mfa_last_step").
+			Default(0).
+			Annotations(entgql.Skip()),
+		// magic_link_hash est l'empreinte du dernier lien de connexion émis ; vidée à la consommation.
+		field.String("magic_link_hash").
+			Optional().
+			Nillable().
+			Sensitive(),
+		// external_id est l'identifiant attribué par l'annuaire source (SCIM).
+		field.String("external_id").
+			Optional().
+			Nillable().
+			Annotations(entgql.MapsTo("externalId")),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
-			Default(map[string]any{}),
+			Default(map[string]any{}).
+			Annotations(entgql.Skip()),
+		// version est incrémentée à chaque modification (ETag, If-Match).
+		field.Int("version").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now).
-			Immutable(),
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
-			UpdateDefault(time.Now),
+			UpdateDefault(time.Now).
+			Annotations(entgql.OrderField("UPDATED_AT")),
 	}
 }
 
@@ -57,6 +102,16 @@ func (User) Indexes() []ent.Index {
 		// Email unique par organisation.
 		index.Fields("organization_id", "email").
 			Unique(),
+		index.Fields("organization_id", "external_id").
+			Unique(),
+	}
+}
+
+// Annotations expose les utilisateurs en connexion Relay (Query.users).
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
 
@@ -68,6 +123,12 @@ func (User) Edges() []ent.Edge {
 			Field("organization_id").
 			Unique().
 			Required(),
-		edge.To("enrollments", Enrollment.Type),
+		edge.To("enrollments", Enrollment.Type).
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+		edge.From("groups", Group.Type).
+			Ref("members").
+			Annotations(entgql.Skip()),
+		edge.To("webauthn_credentials", WebauthnCredential.Type),
+		edge.To("sso_identities", SSOIdentity.Type),
 	}
 }
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebauthnCeremony mémorise une cérémonie WebAuthn terminée jusqu'à l'expiration de son état signé,
+// pour en refuser le rejeu quelle que soit l'instance qui le reçoit.
+type WebauthnCeremony struct {
+	ent.Schema
+}
+
+func (WebauthnCeremony) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("session_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (WebauthnCeremony) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations exclut WebauthnCeremony du schéma GraphQL.
+func (WebauthnCeremony) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebauthnCredential est une passkey (identifiant WebAuthn) enregistrée par un utilisateur.
+type WebauthnCredential struct {
+	ent.Schema
+}
+
+func (WebauthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}),
+		// credential_id est l'identifiant attribué par l'authentificateur (rawId).
+		field.Bytes("credential_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		// public_key est la clé publique COSE de l'identifiant.
+		field.Bytes("public_key").
+			NotEmpty().
+			Immutable(),
+		field.String("attestation_type").
+			Default("none"),
+		field.Bytes("aaguid").
+			Optional(),
+		// sign_count est le dernier compteur de signatures reçu ; une régression signale un clone.
+		field.Uint32("sign_count").
+			Default(0),
+		field.Strings("transports").
+			Optional(),
+		field.Bool("backup_eligible").
+			Default(false),
+		field.Bool("backup_state").
+			Default(false),
+		field.String("name").
+			Default("Passkey"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (WebauthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webauthn_credentials").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Annotations exclut WebauthnCredential du schéma GraphQL.
+func (WebauthnCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip()}
+}
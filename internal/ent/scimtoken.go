@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/scimtoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ScimToken is the model entity for the ScimToken schema.
+type ScimToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScimTokenQuery when eager-loading is set.
+	Edges        ScimTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScimTokenEdges holds the relations/edges for other nodes in the graph.
+type ScimTokenEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScimTokenEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScimToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scimtoken.FieldName, scimtoken.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case scimtoken.FieldLastUsedAt, scimtoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case scimtoken.FieldID, scimtoken.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScimToken fields.
+func (st *ScimToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scimtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				st.ID = *value
+			}
+		case scimtoken.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				st.OrganizationID = *value
+			}
+		case scimtoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				st.Name = value.String
+			}
+		case scimtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				st.TokenHash = value.String
+			}
+		case scimtoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				st.LastUsedAt = new(time.Time)
+				*st.LastUsedAt = value.Time
+			}
+		case scimtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				st.CreatedAt = value.Time
+			}
+		default:
+			st.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScimToken.
+// This includes values selected through modifiers, order, etc.
+func (st *ScimToken) Value(name string) (ent.Value, error) {
+	return st.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the ScimToken entity.
+func (st *ScimToken) QueryOrganization() *OrganizationQuery {
+	return NewScimTokenClient(st.config).QueryOrganization(st)
+}
+
+// Update returns a builder for updating this ScimToken.
+// Note that you need to call ScimToken.Unwrap() before calling this method if this ScimToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *ScimToken) Update() *ScimTokenUpdateOne {
+	return NewScimTokenClient(st.config).UpdateOne(st)
+}
+
+// Unwrap unwraps the ScimToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *ScimToken) Unwrap() *ScimToken {
+	_tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScimToken is not a transactional entity")
+	}
+	st.config.driver = _tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *ScimToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScimToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", st.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", st.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(st.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := st.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(st.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScimTokens is a parsable slice of ScimToken.
+type ScimTokens []*ScimToken
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/scimtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScimTokenCreate is the builder for creating a ScimToken entity.
+type ScimTokenCreate struct {
+	config
+	mutation *ScimTokenMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (stc *ScimTokenCreate) SetOrganizationID(u uuid.UUID) *ScimTokenCreate {
+	stc.mutation.SetOrganizationID(u)
+	return stc
+}
+
+// SetName sets the "name" field.
+func (stc *ScimTokenCreate) SetName(s string) *ScimTokenCreate {
+	stc.mutation.SetName(s)
+	return stc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (stc *ScimTokenCreate) SetTokenHash(s string) *ScimTokenCreate {
+	stc.mutation.SetTokenHash(s)
+	return stc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (stc *ScimTokenCreate) SetLastUsedAt(t time.Time) *ScimTokenCreate {
+	stc.mutation.SetLastUsedAt(t)
+	return stc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (stc *ScimTokenCreate) SetNillableLastUsedAt(t *time.Time) *ScimTokenCreate {
+	if t != nil {
+		stc.SetLastUsedAt(*t)
+	}
+	return stc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (stc *ScimTokenCreate) SetCreatedAt(t time.Time) *ScimTokenCreate {
+	stc.mutation.SetCreatedAt(t)
+	return stc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (stc *ScimTokenCreate) SetNillableCreatedAt(t *time.Time) *ScimTokenCreate {
+	if t != nil {
+		stc.SetCreatedAt(*t)
+	}
+	return stc
+}
+
+// SetID sets the "id" field.
+func (stc *ScimTokenCreate) SetID(u uuid.UUID) *ScimTokenCreate {
+	stc.mutation.SetID(u)
+	return stc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (stc *ScimTokenCreate) SetNillableID(u *uuid.UUID) *ScimTokenCreate {
+	if u != nil {
+		stc.SetID(*u)
+	}
+	return stc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (stc *ScimTokenCreate) SetOrganization(o *Organization) *ScimTokenCreate {
+	return stc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (stc *ScimTokenCreate) Mutation() *ScimTokenMutation {
+	return stc.mutation
+}
+
+// Save creates the ScimToken in the database.
+func (stc *ScimTokenCreate) Save(ctx context.Context) (*ScimToken, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *ScimTokenCreate) SaveX(ctx context.Context) *ScimToken {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *ScimTokenCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *ScimTokenCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *ScimTokenCreate) defaults() {
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		v := scimtoken.DefaultCreatedAt()
+		stc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := stc.mutation.ID(); !ok {
+		v := scimtoken.DefaultID()
+		stc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *ScimTokenCreate) check() error {
+	if _, ok := stc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "ScimToken.organization_id"`)}
+	}
+	if _, ok := stc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScimToken.name"`)}
+	}
+	if v, ok := stc.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ScimToken.token_hash"`)}
+	}
+	if v, ok := stc.mutation.TokenHash(); ok {
+		if err := scimtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScimToken.created_at"`)}
+	}
+	if len(stc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "ScimToken.organization"`)}
+	}
+	return nil
+}
+
+func (stc *ScimTokenCreate) sqlSave(ctx context.Context) (*ScimToken, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *ScimTokenCreate) createSpec() (*ScimToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScimToken{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(scimtoken.Table, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := stc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := stc.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := stc.mutation.TokenHash(); ok {
+		_spec.SetField(scimtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := stc.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := stc.mutation.CreatedAt(); ok {
+		_spec.SetField(scimtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := stc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scimtoken.OrganizationTable,
+			Columns: []string{scimtoken.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScimTokenCreateBulk is the builder for creating many ScimToken entities in bulk.
+type ScimTokenCreateBulk struct {
+	config
+	err      error
+	builders []*ScimTokenCreate
+}
+
+// Save creates the ScimToken entities in the database.
+func (stcb *ScimTokenCreateBulk) Save(ctx context.Context) ([]*ScimToken, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*ScimToken, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScimTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *ScimTokenCreateBulk) SaveX(ctx context.Context) []*ScimToken {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *ScimTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *ScimTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/scimtoken"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScimTokenQuery is the builder for querying ScimToken entities.
+type ScimTokenQuery struct {
+	config
+	ctx              *QueryContext
+	order            []scimtoken.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ScimToken
+	withOrganization *OrganizationQuery
+	modifiers        []func(*sql.Selector)
+	loadTotal        []func(context.Context, []*ScimToken) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScimTokenQuery builder.
+func (stq *ScimTokenQuery) Where(ps ...predicate.ScimToken) *ScimTokenQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *ScimTokenQuery) Limit(limit int) *ScimTokenQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *ScimTokenQuery) Offset(offset int) *ScimTokenQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *ScimTokenQuery) Unique(unique bool) *ScimTokenQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *ScimTokenQuery) Order(o ...scimtoken.OrderOption) *ScimTokenQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (stq *ScimTokenQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: stq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scimtoken.Table, scimtoken.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scimtoken.OrganizationTable, scimtoken.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScimToken entity from the query.
+// Returns a *NotFoundError when no ScimToken was found.
+func (stq *ScimTokenQuery) First(ctx context.Context) (*ScimToken, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scimtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *ScimTokenQuery) FirstX(ctx context.Context) *ScimToken {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScimToken ID from the query.
+// Returns a *NotFoundError when no ScimToken ID was found.
+func (stq *ScimTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scimtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *ScimTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScimToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScimToken entity is found.
+// Returns a *NotFoundError when no ScimToken entities are found.
+func (stq *ScimTokenQuery) Only(ctx context.Context) (*ScimToken, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scimtoken.Label}
+	default:
+		return nil, &NotSingularError{scimtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *ScimTokenQuery) OnlyX(ctx context.Context) *ScimToken {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScimToken ID in the query.
+// Returns a *NotSingularError when more than one ScimToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *ScimTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scimtoken.Label}
+	default:
+		err = &NotSingularError{scimtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *ScimTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScimTokens.
+func (stq *ScimTokenQuery) All(ctx context.Context) ([]*ScimToken, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryAll)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScimToken, *ScimTokenQuery]()
+	return withInterceptors[[]*ScimToken](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *ScimTokenQuery) AllX(ctx context.Context) []*ScimToken {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScimToken IDs.
+func (stq *ScimTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryIDs)
+	if err = stq.Select(scimtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *ScimTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *ScimTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryCount)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*ScimTokenQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *ScimTokenQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *ScimTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryExist)
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *ScimTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScimTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *ScimTokenQuery) Clone() *ScimTokenQuery {
+	if stq == nil {
+		return nil
+	}
+	return &ScimTokenQuery{
+		config:           stq.config,
+		ctx:              stq.ctx.Clone(),
+		order:            append([]scimtoken.OrderOption{}, stq.order...),
+		inters:           append([]Interceptor{}, stq.inters...),
+		predicates:       append([]predicate.ScimToken{}, stq.predicates...),
+		withOrganization: stq.withOrganization.Clone(),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *ScimTokenQuery) WithOrganization(opts ...func(*OrganizationQuery)) *ScimTokenQuery {
+	query := (&OrganizationClient{config: stq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withOrganization = query
+	return stq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScimToken.Query().
+//		GroupBy(scimtoken.FieldOrganizationID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *ScimTokenQuery) GroupBy(field string, fields ...string) *ScimTokenGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScimTokenGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = scimtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//	}
+//
+//	client.ScimToken.Query().
+//		Select(scimtoken.FieldOrganizationID).
+//		Scan(ctx, &v)
+func (stq *ScimTokenQuery) Select(fields ...string) *ScimTokenSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &ScimTokenSelect{ScimTokenQuery: stq}
+	sbuild.label = scimtoken.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScimTokenSelect configured with the given aggregations.
+func (stq *ScimTokenQuery) Aggregate(fns ...AggregateFunc) *ScimTokenSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *ScimTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !scimtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *ScimTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScimToken, error) {
+	var (
+		nodes       = []*ScimToken{}
+		_spec       = stq.querySpec()
+		loadedTypes = [1]bool{
+			stq.withOrganization != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScimToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScimToken{config: stq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := stq.withOrganization; query != nil {
+		if err := stq.loadOrganization(ctx, query, nodes, nil,
+			func(n *ScimToken, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range stq.loadTotal {
+		if err := stq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (stq *ScimTokenQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*ScimToken, init func(*ScimToken), assign func(*ScimToken, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScimToken)
+	for i := range nodes {
+		fk := nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (stq *ScimTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *ScimTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scimtoken.Table, scimtoken.Columns, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scimtoken.FieldID)
+		for i := range fields {
+			if fields[i] != scimtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if stq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(scimtoken.FieldOrganizationID)
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *ScimTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(scimtoken.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scimtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScimTokenGroupBy is the group-by builder for ScimToken entities.
+type ScimTokenGroupBy struct {
+	selector
+	build *ScimTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *ScimTokenGroupBy) Aggregate(fns ...AggregateFunc) *ScimTokenGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *ScimTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, ent.OpQueryGroupBy)
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScimTokenQuery, *ScimTokenGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *ScimTokenGroupBy) sqlScan(ctx context.Context, root *ScimTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScimTokenSelect is the builder for selecting fields of ScimToken entities.
+type ScimTokenSelect struct {
+	*ScimTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *ScimTokenSelect) Aggregate(fns ...AggregateFunc) *ScimTokenSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *ScimTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, ent.OpQuerySelect)
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScimTokenQuery, *ScimTokenSelect](ctx, sts.ScimTokenQuery, sts, sts.inters, v)
+}
+
+func (sts *ScimTokenSelect) sqlScan(ctx context.Context, root *ScimTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/scimtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScimTokenUpdate is the builder for updating ScimToken entities.
+type ScimTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScimTokenMutation
+}
+
+// Where appends a list predicates to the ScimTokenUpdate builder.
+func (stu *ScimTokenUpdate) Where(ps ...predicate.ScimToken) *ScimTokenUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (stu *ScimTokenUpdate) SetOrganizationID(u uuid.UUID) *ScimTokenUpdate {
+	stu.mutation.SetOrganizationID(u)
+	return stu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (stu *ScimTokenUpdate) SetNillableOrganizationID(u *uuid.UUID) *ScimTokenUpdate {
+	if u != nil {
+		stu.SetOrganizationID(*u)
+	}
+	return stu
+}
+
+// SetName sets the "name" field.
+func (stu *ScimTokenUpdate) SetName(s string) *ScimTokenUpdate {
+	stu.mutation.SetName(s)
+	return stu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (stu *ScimTokenUpdate) SetNillableName(s *string) *ScimTokenUpdate {
+	if s != nil {
+		stu.SetName(*s)
+	}
+	return stu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (stu *ScimTokenUpdate) SetTokenHash(s string) *ScimTokenUpdate {
+	stu.mutation.SetTokenHash(s)
+	return stu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (stu *ScimTokenUpdate) SetNillableTokenHash(s *string) *ScimTokenUpdate {
+	if s != nil {
+		stu.SetTokenHash(*s)
+	}
+	return stu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (stu *ScimTokenUpdate) SetLastUsedAt(t time.Time) *ScimTokenUpdate {
+	stu.mutation.SetLastUsedAt(t)
+	return stu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (stu *ScimTokenUpdate) SetNillableLastUsedAt(t *time.Time) *ScimTokenUpdate {
+	if t != nil {
+		stu.SetLastUsedAt(*t)
+	}
+	return stu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (stu *ScimTokenUpdate) ClearLastUsedAt() *ScimTokenUpdate {
+	stu.mutation.ClearLastUsedAt()
+	return stu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (stu *ScimTokenUpdate) SetOrganization(o *Organization) *ScimTokenUpdate {
+	return stu.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (stu *ScimTokenUpdate) Mutation() *ScimTokenMutation {
+	return stu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (stu *ScimTokenUpdate) ClearOrganization() *ScimTokenUpdate {
+	stu.mutation.ClearOrganization()
+	return stu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *ScimTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *ScimTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *ScimTokenUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *ScimTokenUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *ScimTokenUpdate) check() error {
+	if v, ok := stu.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if v, ok := stu.mutation.TokenHash(); ok {
+		if err := scimtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_hash": %w`, err)}
+		}
+	}
+	if stu.mutation.OrganizationCleared() && len(stu.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScimToken.organization"`)
+	}
+	return nil
+}
+
+func (stu *ScimTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimtoken.Table, scimtoken.Columns, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.TokenHash(); ok {
+		_spec.SetField(scimtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if stu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if stu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scimtoken.OrganizationTable,
+			Columns: []string{scimtoken.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scimtoken.OrganizationTable,
+			Columns: []string{scimtoken.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// ScimTokenUpdateOne is the builder for updating a single ScimToken entity.
+type ScimTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScimTokenMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (stuo *ScimTokenUpdateOne) SetOrganizationID(u uuid.UUID) *ScimTokenUpdateOne {
+	stuo.mutation.SetOrganizationID(u)
+	return stuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (stuo *ScimTokenUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *ScimTokenUpdateOne {
+	if u != nil {
+		stuo.SetOrganizationID(*u)
+	}
+	return stuo
+}
+
+// SetName sets the "name" field.
+func (stuo *ScimTokenUpdateOne) SetName(s string) *ScimTokenUpdateOne {
+	stuo.mutation.SetName(s)
+	return stuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (stuo *ScimTokenUpdateOne) SetNillableName(s *string) *ScimTokenUpdateOne {
+	if s != nil {
+		stuo.SetName(*s)
+	}
+	return stuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (stuo *ScimTokenUpdateOne) SetTokenHash(s string) *ScimTokenUpdateOne {
+	stuo.mutation.SetTokenHash(s)
+	return stuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (stuo *ScimTokenUpdateOne) SetNillableTokenHash(s *string) *ScimTokenUpdateOne {
+	if s != nil {
+		stuo.SetTokenHash(*s)
+	}
+	return stuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (stuo *ScimTokenUpdateOne) SetLastUsedAt(t time.Time) *ScimTokenUpdateOne {
+	stuo.mutation.SetLastUsedAt(t)
+	return stuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (stuo *ScimTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *ScimTokenUpdateOne {
+	if t != nil {
+		stuo.SetLastUsedAt(*t)
+	}
+	return stuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (stuo *ScimTokenUpdateOne) ClearLastUsedAt() *ScimTokenUpdateOne {
+	stuo.mutation.ClearLastUsedAt()
+	return stuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (stuo *ScimTokenUpdateOne) SetOrganization(o *Organization) *ScimTokenUpdateOne {
+	return stuo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (stuo *ScimTokenUpdateOne) Mutation() *ScimTokenMutation {
+	return stuo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (stuo *ScimTokenUpdateOne) ClearOrganization() *ScimTokenUpdateOne {
+	stuo.mutation.ClearOrganization()
+	return stuo
+}
+
+// Where appends a list predicates to the ScimTokenUpdate builder.
+func (stuo *ScimTokenUpdateOne) Where(ps ...predicate.ScimToken) *ScimTokenUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *ScimTokenUpdateOne) Select(field string, fields ...string) *ScimTokenUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated ScimToken entity.
+func (stuo *ScimTokenUpdateOne) Save(ctx context.Context) (*ScimToken, error) {
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *ScimTokenUpdateOne) SaveX(ctx context.Context) *ScimToken {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *ScimTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *ScimTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *ScimTokenUpdateOne) check() error {
+	if v, ok := stuo.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if v, ok := stuo.mutation.TokenHash(); ok {
+		if err := scimtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_hash": %w`, err)}
+		}
+	}
+	if stuo.mutation.OrganizationCleared() && len(stuo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScimToken.organization"`)
+	}
+	return nil
+}
+
+func (stuo *ScimTokenUpdateOne) sqlSave(ctx context.Context) (_node *ScimToken, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimtoken.Table, scimtoken.Columns, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUUID))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScimToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scimtoken.FieldID)
+		for _, f := range fields {
+			if !scimtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scimtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.TokenHash(); ok {
+		_spec.SetField(scimtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if stuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if stuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scimtoken.OrganizationTable,
+			Columns: []string{scimtoken.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scimtoken.OrganizationTable,
+			Columns: []string{scimtoken.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScimToken{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/serviceaccount"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ServiceAccount is the model entity for the ServiceAccount schema.
+type ServiceAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ServiceAccountQuery when eager-loading is set.
+	Edges        ServiceAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ServiceAccountEdges holds the relations/edges for other nodes in the graph.
+type ServiceAccountEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+
+	namedAPIKeys map[string][]*APIKey
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceAccountEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceAccountEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ServiceAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldName, serviceaccount.FieldDescription, serviceaccount.FieldStatus:
+			values[i] = new(sql.NullString)
+		case serviceaccount.FieldCreatedAt, serviceaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case serviceaccount.FieldID, serviceaccount.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ServiceAccount fields.
+func (sa *ServiceAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sa.ID = *value
+			}
+		case serviceaccount.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				sa.OrganizationID = *value
+			}
+		case serviceaccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sa.Name = value.String
+			}
+		case serviceaccount.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sa.Description = value.String
+			}
+		case serviceaccount.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sa.Status = value.String
+			}
+		case serviceaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case serviceaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ServiceAccount.
+// This includes values selected through modifiers, order, etc.
+func (sa *ServiceAccount) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryOrganization() *OrganizationQuery {
+	return NewServiceAccountClient(sa.config).QueryOrganization(sa)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryAPIKeys() *APIKeyQuery {
+	return NewServiceAccountClient(sa.config).QueryAPIKeys(sa)
+}
+
+// Update returns a builder for updating this ServiceAccount.
+// Note that you need to call ServiceAccount.Unwrap() before calling this method if this ServiceAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ServiceAccount) Update() *ServiceAccountUpdateOne {
+	return NewServiceAccountClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ServiceAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ServiceAccount) Unwrap() *ServiceAccount {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ServiceAccount is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ServiceAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ServiceAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sa.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sa.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedAPIKeys returns the APIKeys named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (sa *ServiceAccount) NamedAPIKeys(name string) ([]*APIKey, error) {
+	if sa.Edges.namedAPIKeys == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := sa.Edges.namedAPIKeys[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (sa *ServiceAccount) appendNamedAPIKeys(name string, edges ...*APIKey) {
+	if sa.Edges.namedAPIKeys == nil {
+		sa.Edges.namedAPIKeys = make(map[string][]*APIKey)
+	}
+	if len(edges) == 0 {
+		sa.Edges.namedAPIKeys[name] = []*APIKey{}
+	} else {
+		sa.Edges.namedAPIKeys[name] = append(sa.Edges.namedAPIKeys[name], edges...)
+	}
+}
+
+// ServiceAccounts is a parsable slice of ServiceAccount.
+type ServiceAccounts []*ServiceAccount
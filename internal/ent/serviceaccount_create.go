@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/apikey"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/serviceaccount"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ServiceAccountCreate is the builder for creating a ServiceAccount entity.
+type ServiceAccountCreate struct {
+	config
+	mutation *ServiceAccountMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (sac *ServiceAccountCreate) SetOrganizationID(u uuid.UUID) *ServiceAccountCreate {
+	sac.mutation.SetOrganizationID(u)
+	return sac
+}
+
+// SetName sets the "name" field.
+func (sac *ServiceAccountCreate) SetName(s string) *ServiceAccountCreate {
+	sac.mutation.SetName(s)
+	return sac
+}
+
+// SetDescription sets the "description" field.
+func (sac *ServiceAccountCreate) SetDescription(s string) *ServiceAccountCreate {
+	sac.mutation.SetDescription(s)
+	return sac
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableDescription(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetDescription(*s)
+	}
+	return sac
+}
+
+// SetStatus sets the "status" field.
+func (sac *ServiceAccountCreate) SetStatus(s string) *ServiceAccountCreate {
+	sac.mutation.SetStatus(s)
+	return sac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableStatus(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetStatus(*s)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ServiceAccountCreate) SetCreatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableCreatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *ServiceAccountCreate) SetUpdatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableUpdatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *ServiceAccountCreate) SetID(u uuid.UUID) *ServiceAccountCreate {
+	sac.mutation.SetID(u)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableID(u *uuid.UUID) *ServiceAccountCreate {
+	if u != nil {
+		sac.SetID(*u)
+	}
+	return sac
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sac *ServiceAccountCreate) SetOrganization(o *Organization) *ServiceAccountCreate {
+	return sac.SetOrganizationID(o.ID)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (sac *ServiceAccountCreate) AddAPIKeyIDs(ids ...uuid.UUID) *ServiceAccountCreate {
+	sac.mutation.AddAPIKeyIDs(ids...)
+	return sac
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (sac *ServiceAccountCreate) AddAPIKeys(a ...*APIKey) *ServiceAccountCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sac.AddAPIKeyIDs(ids...)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sac *ServiceAccountCreate) Mutation() *ServiceAccountMutation {
+	return sac.mutation
+}
+
+// Save creates the ServiceAccount in the database.
+func (sac *ServiceAccountCreate) Save(ctx context.Context) (*ServiceAccount, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ServiceAccountCreate) SaveX(ctx context.Context) *ServiceAccount {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ServiceAccountCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ServiceAccountCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ServiceAccountCreate) defaults() {
+	if _, ok := sac.mutation.Status(); !ok {
+		v := serviceaccount.DefaultStatus
+		sac.mutation.SetStatus(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := serviceaccount.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := serviceaccount.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ServiceAccountCreate) check() error {
+	if _, ok := sac.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "ServiceAccount.organization_id"`)}
+	}
+	if _, ok := sac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ServiceAccount.name"`)}
+	}
+	if v, ok := sac.mutation.Name(); ok {
+		if err := serviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServiceAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ServiceAccount.status"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ServiceAccount.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ServiceAccount.updated_at"`)}
+	}
+	if len(sac.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "ServiceAccount.organization"`)}
+	}
+	return nil
+}
+
+func (sac *ServiceAccountCreate) sqlSave(ctx context.Context) (*ServiceAccount, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ServiceAccount{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(serviceaccount.Table, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sac.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sac.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sac.mutation.Status(); ok {
+		_spec.SetField(serviceaccount.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sac.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OrganizationTable,
+			Columns: []string{serviceaccount.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ServiceAccountCreateBulk is the builder for creating many ServiceAccount entities in bulk.
+type ServiceAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ServiceAccountCreate
+}
+
+// Save creates the ServiceAccount entities in the database.
+func (sacb *ServiceAccountCreateBulk) Save(ctx context.Context) ([]*ServiceAccount, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ServiceAccount, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ServiceAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) SaveX(ctx context.Context) []*ServiceAccount {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ServiceAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
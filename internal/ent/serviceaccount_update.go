@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/apikey"
+	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/serviceaccount"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ServiceAccountUpdate is the builder for updating ServiceAccount entities.
+type ServiceAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sau *ServiceAccountUpdate) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (sau *ServiceAccountUpdate) SetOrganizationID(u uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.SetOrganizationID(u)
+	return sau
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableOrganizationID(u *uuid.UUID) *ServiceAccountUpdate {
+	if u != nil {
+		sau.SetOrganizationID(*u)
+	}
+	return sau
+}
+
+// SetName sets the "name" field.
+func (sau *ServiceAccountUpdate) SetName(s string) *ServiceAccountUpdate {
+	sau.mutation.SetName(s)
+	return sau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableName(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetName(*s)
+	}
+	return sau
+}
+
+// SetDescription sets the "description" field.
+func (sau *ServiceAccountUpdate) SetDescription(s string) *ServiceAccountUpdate {
+	sau.mutation.SetDescription(s)
+	return sau
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableDescription(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetDescription(*s)
+	}
+	return sau
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sau *ServiceAccountUpdate) ClearDescription() *ServiceAccountUpdate {
+	sau.mutation.ClearDescription()
+	return sau
+}
+
+// SetStatus sets the "status" field.
+func (sau *ServiceAccountUpdate) SetStatus(s string) *ServiceAccountUpdate {
+	sau.mutation.SetStatus(s)
+	return sau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableStatus(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetStatus(*s)
+	}
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *ServiceAccountUpdate) SetUpdatedAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sau *ServiceAccountUpdate) SetOrganization(o *Organization) *ServiceAccountUpdate {
+	return sau.SetOrganizationID(o.ID)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (sau *ServiceAccountUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.AddAPIKeyIDs(ids...)
+	return sau
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (sau *ServiceAccountUpdate) AddAPIKeys(a ...*APIKey) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sau.AddAPIKeyIDs(ids...)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sau *ServiceAccountUpdate) Mutation() *ServiceAccountMutation {
+	return sau.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (sau *ServiceAccountUpdate) ClearOrganization() *ServiceAccountUpdate {
+	sau.mutation.ClearOrganization()
+	return sau
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (sau *ServiceAccountUpdate) ClearAPIKeys() *ServiceAccountUpdate {
+	sau.mutation.ClearAPIKeys()
+	return sau
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (sau *ServiceAccountUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.RemoveAPIKeyIDs(ids...)
+	return sau
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (sau *ServiceAccountUpdate) RemoveAPIKeys(a ...*APIKey) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sau.RemoveAPIKeyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ServiceAccountUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ServiceAccountUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *ServiceAccountUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ServiceAccountUpdate) check() error {
+	if v, ok := sau.mutation.Name(); ok {
+		if err := serviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServiceAccount.name": %w`, err)}
+		}
+	}
+	if sau.mutation.OrganizationCleared() && len(sau.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.organization"`)
+	}
+	return nil
+}
+
+func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+	}
+	if sau.mutation.DescriptionCleared() {
+		_spec.ClearField(serviceaccount.FieldDescription, field.TypeString)
+	}
+	if value, ok := sau.mutation.Status(); ok {
+		_spec.SetField(serviceaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sau.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OrganizationTable,
+			Columns: []string{serviceaccount.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OrganizationTable,
+			Columns: []string{serviceaccount.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sau.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !sau.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ServiceAccountUpdateOne is the builder for updating a single ServiceAccount entity.
+type ServiceAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (sauo *ServiceAccountUpdateOne) SetOrganizationID(u uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.SetOrganizationID(u)
+	return sauo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *ServiceAccountUpdateOne {
+	if u != nil {
+		sauo.SetOrganizationID(*u)
+	}
+	return sauo
+}
+
+// SetName sets the "name" field.
+func (sauo *ServiceAccountUpdateOne) SetName(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetName(s)
+	return sauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableName(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetName(*s)
+	}
+	return sauo
+}
+
+// SetDescription sets the "description" field.
+func (sauo *ServiceAccountUpdateOne) SetDescription(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetDescription(s)
+	return sauo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableDescription(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetDescription(*s)
+	}
+	return sauo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sauo *ServiceAccountUpdateOne) ClearDescription() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearDescription()
+	return sauo
+}
+
+// SetStatus sets the "status" field.
+func (sauo *ServiceAccountUpdateOne) SetStatus(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetStatus(s)
+	return sauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableStatus(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetStatus(*s)
+	}
+	return sauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *ServiceAccountUpdateOne) SetUpdatedAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sauo *ServiceAccountUpdateOne) SetOrganization(o *Organization) *ServiceAccountUpdateOne {
+	return sauo.SetOrganizationID(o.ID)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (sauo *ServiceAccountUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.AddAPIKeyIDs(ids...)
+	return sauo
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (sauo *ServiceAccountUpdateOne) AddAPIKeys(a ...*APIKey) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sauo.AddAPIKeyIDs(ids...)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sauo *ServiceAccountUpdateOne) Mutation() *ServiceAccountMutation {
+	return sauo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (sauo *ServiceAccountUpdateOne) ClearOrganization() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearOrganization()
+	return sauo
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (sauo *ServiceAccountUpdateOne) ClearAPIKeys() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearAPIKeys()
+	return sauo
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (sauo *ServiceAccountUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.RemoveAPIKeyIDs(ids...)
+	return sauo
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (sauo *ServiceAccountUpdateOne) RemoveAPIKeys(a ...*APIKey) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sauo.RemoveAPIKeyIDs(ids...)
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sauo *ServiceAccountUpdateOne) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ServiceAccountUpdateOne) Select(field string, fields ...string) *ServiceAccountUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ServiceAccount entity.
+func (sauo *ServiceAccountUpdateOne) Save(ctx context.Context) (*ServiceAccount, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) SaveX(ctx context.Context) *ServiceAccount {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ServiceAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *ServiceAccountUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ServiceAccountUpdateOne) check() error {
+	if v, ok := sauo.mutation.Name(); ok {
+		if err := serviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServiceAccount.name": %w`, err)}
+		}
+	}
+	if sauo.mutation.OrganizationCleared() && len(sauo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.organization"`)
+	}
+	return nil
+}
+
+func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *ServiceAccount, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUUID))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ServiceAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, serviceaccount.FieldID)
+		for _, f := range fields {
+			if !serviceaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != serviceaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+	}
+	if sauo.mutation.DescriptionCleared() {
+		_spec.ClearField(serviceaccount.FieldDescription, field.TypeString)
+	}
+	if value, ok := sauo.mutation.Status(); ok {
+		_spec.SetField(serviceaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OrganizationTable,
+			Columns: []string{serviceaccount.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OrganizationTable,
+			Columns: []string{serviceaccount.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sauo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !sauo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.APIKeysTable,
+			Columns: []string{serviceaccount.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ServiceAccount{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/ssoidentity"
+	"lms-go/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SSOIdentity is the model entity for the SSOIdentity schema.
+type SSOIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Protocol holds the value of the "protocol" field.
+	Protocol string `json:"protocol,omitempty"`
+	// Issuer holds the value of the "issuer" field.
+	Issuer string `json:"issuer,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SSOIdentityQuery when eager-loading is set.
+	Edges        SSOIdentityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SSOIdentityEdges holds the relations/edges for other nodes in the graph.
+type SSOIdentityEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SSOIdentityEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSOIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ssoidentity.FieldProtocol, ssoidentity.FieldIssuer, ssoidentity.FieldSubject:
+			values[i] = new(sql.NullString)
+		case ssoidentity.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ssoidentity.FieldID, ssoidentity.FieldOrganizationID, ssoidentity.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSOIdentity fields.
+func (si *SSOIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ssoidentity.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				si.ID = *value
+			}
+		case ssoidentity.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				si.OrganizationID = *value
+			}
+		case ssoidentity.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				si.UserID = *value
+			}
+		case ssoidentity.FieldProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[i])
+			} else if value.Valid {
+				si.Protocol = value.String
+			}
+		case ssoidentity.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				si.Issuer = value.String
+			}
+		case ssoidentity.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				si.Subject = value.String
+			}
+		case ssoidentity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				si.CreatedAt = value.Time
+			}
+		default:
+			si.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSOIdentity.
+// This includes values selected through modifiers, order, etc.
+func (si *SSOIdentity) Value(name string) (ent.Value, error) {
+	return si.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SSOIdentity entity.
+func (si *SSOIdentity) QueryUser() *UserQuery {
+	return NewSSOIdentityClient(si.config).QueryUser(si)
+}
+
+// Update returns a builder for updating this SSOIdentity.
+// Note that you need to call SSOIdentity.Unwrap() before calling this method if this SSOIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *SSOIdentity) Update() *SSOIdentityUpdateOne {
+	return NewSSOIdentityClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the SSOIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *SSOIdentity) Unwrap() *SSOIdentity {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSOIdentity is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *SSOIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSOIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("protocol=")
+	builder.WriteString(si.Protocol)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(si.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(si.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(si.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSOIdentities is a parsable slice of SSOIdentity.
+type SSOIdentities []*SSOIdentity
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/ssoidentity"
+	"lms-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SSOIdentityCreate is the builder for creating a SSOIdentity entity.
+type SSOIdentityCreate struct {
+	config
+	mutation *SSOIdentityMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (sic *SSOIdentityCreate) SetOrganizationID(u uuid.UUID) *SSOIdentityCreate {
+	sic.mutation.SetOrganizationID(u)
+	return sic
+}
+
+// SetUserID sets the "user_id" field.
+func (sic *SSOIdentityCreate) SetUserID(u uuid.UUID) *SSOIdentityCreate {
+	sic.mutation.SetUserID(u)
+	return sic
+}
+
+// SetProtocol sets the "protocol" field.
+func (sic *SSOIdentityCreate) SetProtocol(s string) *SSOIdentityCreate {
+	sic.mutation.SetProtocol(s)
+	return sic
+}
+
+// SetIssuer sets the "issuer" field.
+func (sic *SSOIdentityCreate) SetIssuer(s string) *SSOIdentityCreate {
+	sic.mutation.SetIssuer(s)
+	return sic
+}
+
+// SetSubject sets the "subject" field.
+func (sic *SSOIdentityCreate) SetSubject(s string) *SSOIdentityCreate {
+	sic.mutation.SetSubject(s)
+	return sic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sic *SSOIdentityCreate) SetCreatedAt(t time.Time) *SSOIdentityCreate {
+	sic.mutation.SetCreatedAt(t)
+	return sic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sic *SSOIdentityCreate) SetNillableCreatedAt(t *time.Time) *SSOIdentityCreate {
+	if t != nil {
+		sic.SetCreatedAt(*t)
+	}
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *SSOIdentityCreate) SetID(u uuid.UUID) *SSOIdentityCreate {
+	sic.mutation.SetID(u)
+	return sic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sic *SSOIdentityCreate) SetNillableID(u *uuid.UUID) *SSOIdentityCreate {
+	if u != nil {
+		sic.SetID(*u)
+	}
+	return sic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sic *SSOIdentityCreate) SetUser(u *User) *SSOIdentityCreate {
+	return sic.SetUserID(u.ID)
+}
+
+// Mutation returns the SSOIdentityMutation object of the builder.
+func (sic *SSOIdentityCreate) Mutation() *SSOIdentityMutation {
+	return sic.mutation
+}
+
+// Save creates the SSOIdentity in the database.
+func (sic *SSOIdentityCreate) Save(ctx context.Context) (*SSOIdentity, error) {
+	sic.defaults()
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *SSOIdentityCreate) SaveX(ctx context.Context) *SSOIdentity {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *SSOIdentityCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *SSOIdentityCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *SSOIdentityCreate) defaults() {
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		v := ssoidentity.DefaultCreatedAt()
+		sic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sic.mutation.ID(); !ok {
+		v := ssoidentity.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *SSOIdentityCreate) check() error {
+	if _, ok := sic.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "SSOIdentity.organization_id"`)}
+	}
+	if _, ok := sic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SSOIdentity.user_id"`)}
+	}
+	if _, ok := sic.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "SSOIdentity.protocol"`)}
+	}
+	if v, ok := sic.mutation.Protocol(); ok {
+		if err := ssoidentity.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "SSOIdentity.protocol": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "SSOIdentity.issuer"`)}
+	}
+	if v, ok := sic.mutation.Issuer(); ok {
+		if err := ssoidentity.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "SSOIdentity.issuer": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "SSOIdentity.subject"`)}
+	}
+	if v, ok := sic.mutation.Subject(); ok {
+		if err := ssoidentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "SSOIdentity.subject": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSOIdentity.created_at"`)}
+	}
+	if len(sic.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SSOIdentity.user"`)}
+	}
+	return nil
+}
+
+func (sic *SSOIdentityCreate) sqlSave(ctx context.Context) (*SSOIdentity, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *SSOIdentityCreate) createSpec() (*SSOIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSOIdentity{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(ssoidentity.Table, sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID))
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sic.mutation.OrganizationID(); ok {
+		_spec.SetField(ssoidentity.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := sic.mutation.Protocol(); ok {
+		_spec.SetField(ssoidentity.FieldProtocol, field.TypeString, value)
+		_node.Protocol = value
+	}
+	if value, ok := sic.mutation.Issuer(); ok {
+		_spec.SetField(ssoidentity.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := sic.mutation.Subject(); ok {
+		_spec.SetField(ssoidentity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := sic.mutation.CreatedAt(); ok {
+		_spec.SetField(ssoidentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssoidentity.UserTable,
+			Columns: []string{ssoidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SSOIdentityCreateBulk is the builder for creating many SSOIdentity entities in bulk.
+type SSOIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*SSOIdentityCreate
+}
+
+// Save creates the SSOIdentity entities in the database.
+func (sicb *SSOIdentityCreateBulk) Save(ctx context.Context) ([]*SSOIdentity, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*SSOIdentity, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSOIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *SSOIdentityCreateBulk) SaveX(ctx context.Context) []*SSOIdentity {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *SSOIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *SSOIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
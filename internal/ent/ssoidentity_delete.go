@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/ssoidentity"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SSOIdentityDelete is the builder for deleting a SSOIdentity entity.
+type SSOIdentityDelete struct {
+	config
+	hooks    []Hook
+	mutation *SSOIdentityMutation
+}
+
+// Where appends a list predicates to the SSOIdentityDelete builder.
+func (sid *SSOIdentityDelete) Where(ps ...predicate.SSOIdentity) *SSOIdentityDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *SSOIdentityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sid.sqlExec, sid.mutation, sid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *SSOIdentityDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *SSOIdentityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ssoidentity.Table, sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID))
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sid.mutation.done = true
+	return affected, err
+}
+
+// SSOIdentityDeleteOne is the builder for deleting a single SSOIdentity entity.
+type SSOIdentityDeleteOne struct {
+	sid *SSOIdentityDelete
+}
+
+// Where appends a list predicates to the SSOIdentityDelete builder.
+func (sido *SSOIdentityDeleteOne) Where(ps ...predicate.SSOIdentity) *SSOIdentityDeleteOne {
+	sido.sid.mutation.Where(ps...)
+	return sido
+}
+
+// Exec executes the deletion query.
+func (sido *SSOIdentityDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ssoidentity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *SSOIdentityDeleteOne) ExecX(ctx context.Context) {
+	if err := sido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
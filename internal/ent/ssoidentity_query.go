@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/ssoidentity"
+	"lms-go/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SSOIdentityQuery is the builder for querying SSOIdentity entities.
+type SSOIdentityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ssoidentity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SSOIdentity
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*SSOIdentity) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SSOIdentityQuery builder.
+func (siq *SSOIdentityQuery) Where(ps ...predicate.SSOIdentity) *SSOIdentityQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *SSOIdentityQuery) Limit(limit int) *SSOIdentityQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *SSOIdentityQuery) Offset(offset int) *SSOIdentityQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *SSOIdentityQuery) Unique(unique bool) *SSOIdentityQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *SSOIdentityQuery) Order(o ...ssoidentity.OrderOption) *SSOIdentityQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (siq *SSOIdentityQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ssoidentity.Table, ssoidentity.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ssoidentity.UserTable, ssoidentity.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SSOIdentity entity from the query.
+// Returns a *NotFoundError when no SSOIdentity was found.
+func (siq *SSOIdentityQuery) First(ctx context.Context) (*SSOIdentity, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ssoidentity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *SSOIdentityQuery) FirstX(ctx context.Context) *SSOIdentity {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SSOIdentity ID from the query.
+// Returns a *NotFoundError when no SSOIdentity ID was found.
+func (siq *SSOIdentityQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ssoidentity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *SSOIdentityQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SSOIdentity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SSOIdentity entity is found.
+// Returns a *NotFoundError when no SSOIdentity entities are found.
+func (siq *SSOIdentityQuery) Only(ctx context.Context) (*SSOIdentity, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ssoidentity.Label}
+	default:
+		return nil, &NotSingularError{ssoidentity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *SSOIdentityQuery) OnlyX(ctx context.Context) *SSOIdentity {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SSOIdentity ID in the query.
+// Returns a *NotSingularError when more than one SSOIdentity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *SSOIdentityQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ssoidentity.Label}
+	default:
+		err = &NotSingularError{ssoidentity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *SSOIdentityQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SSOIdentities.
+func (siq *SSOIdentityQuery) All(ctx context.Context) ([]*SSOIdentity, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryAll)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SSOIdentity, *SSOIdentityQuery]()
+	return withInterceptors[[]*SSOIdentity](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *SSOIdentityQuery) AllX(ctx context.Context) []*SSOIdentity {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SSOIdentity IDs.
+func (siq *SSOIdentityQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryIDs)
+	if err = siq.Select(ssoidentity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *SSOIdentityQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *SSOIdentityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryCount)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*SSOIdentityQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *SSOIdentityQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *SSOIdentityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryExist)
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *SSOIdentityQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SSOIdentityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *SSOIdentityQuery) Clone() *SSOIdentityQuery {
+	if siq == nil {
+		return nil
+	}
+	return &SSOIdentityQuery{
+		config:     siq.config,
+		ctx:        siq.ctx.Clone(),
+		order:      append([]ssoidentity.OrderOption{}, siq.order...),
+		inters:     append([]Interceptor{}, siq.inters...),
+		predicates: append([]predicate.SSOIdentity{}, siq.predicates...),
+		withUser:   siq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *SSOIdentityQuery) WithUser(opts ...func(*UserQuery)) *SSOIdentityQuery {
+	query := (&UserClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withUser = query
+	return siq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SSOIdentity.Query().
+//		GroupBy(ssoidentity.FieldOrganizationID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *SSOIdentityQuery) GroupBy(field string, fields ...string) *SSOIdentityGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SSOIdentityGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = ssoidentity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//	}
+//
+//	client.SSOIdentity.Query().
+//		Select(ssoidentity.FieldOrganizationID).
+//		Scan(ctx, &v)
+func (siq *SSOIdentityQuery) Select(fields ...string) *SSOIdentitySelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &SSOIdentitySelect{SSOIdentityQuery: siq}
+	sbuild.label = ssoidentity.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SSOIdentitySelect configured with the given aggregations.
+func (siq *SSOIdentityQuery) Aggregate(fns ...AggregateFunc) *SSOIdentitySelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *SSOIdentityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !ssoidentity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *SSOIdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SSOIdentity, error) {
+	var (
+		nodes       = []*SSOIdentity{}
+		_spec       = siq.querySpec()
+		loadedTypes = [1]bool{
+			siq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SSOIdentity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SSOIdentity{config: siq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := siq.withUser; query != nil {
+		if err := siq.loadUser(ctx, query, nodes, nil,
+			func(n *SSOIdentity, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range siq.loadTotal {
+		if err := siq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (siq *SSOIdentityQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*SSOIdentity, init func(*SSOIdentity), assign func(*SSOIdentity, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SSOIdentity)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (siq *SSOIdentityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *SSOIdentityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ssoidentity.Table, ssoidentity.Columns, sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssoidentity.FieldID)
+		for i := range fields {
+			if fields[i] != ssoidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if siq.withUser != nil {
+			_spec.Node.AddColumnOnce(ssoidentity.FieldUserID)
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *SSOIdentityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(ssoidentity.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ssoidentity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SSOIdentityGroupBy is the group-by builder for SSOIdentity entities.
+type SSOIdentityGroupBy struct {
+	selector
+	build *SSOIdentityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *SSOIdentityGroupBy) Aggregate(fns ...AggregateFunc) *SSOIdentityGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *SSOIdentityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, ent.OpQueryGroupBy)
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SSOIdentityQuery, *SSOIdentityGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *SSOIdentityGroupBy) sqlScan(ctx context.Context, root *SSOIdentityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SSOIdentitySelect is the builder for selecting fields of SSOIdentity entities.
+type SSOIdentitySelect struct {
+	*SSOIdentityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *SSOIdentitySelect) Aggregate(fns ...AggregateFunc) *SSOIdentitySelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *SSOIdentitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, ent.OpQuerySelect)
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SSOIdentityQuery, *SSOIdentitySelect](ctx, sis.SSOIdentityQuery, sis, sis.inters, v)
+}
+
+func (sis *SSOIdentitySelect) sqlScan(ctx context.Context, root *SSOIdentityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
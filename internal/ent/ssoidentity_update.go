@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/ssoidentity"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SSOIdentityUpdate is the builder for updating SSOIdentity entities.
+type SSOIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSOIdentityMutation
+}
+
+// Where appends a list predicates to the SSOIdentityUpdate builder.
+func (siu *SSOIdentityUpdate) Where(ps ...predicate.SSOIdentity) *SSOIdentityUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// Mutation returns the SSOIdentityMutation object of the builder.
+func (siu *SSOIdentityUpdate) Mutation() *SSOIdentityMutation {
+	return siu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *SSOIdentityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *SSOIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *SSOIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *SSOIdentityUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *SSOIdentityUpdate) check() error {
+	if siu.mutation.UserCleared() && len(siu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOIdentity.user"`)
+	}
+	return nil
+}
+
+func (siu *SSOIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoidentity.Table, ssoidentity.Columns, sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// SSOIdentityUpdateOne is the builder for updating a single SSOIdentity entity.
+type SSOIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSOIdentityMutation
+}
+
+// Mutation returns the SSOIdentityMutation object of the builder.
+func (siuo *SSOIdentityUpdateOne) Mutation() *SSOIdentityMutation {
+	return siuo.mutation
+}
+
+// Where appends a list predicates to the SSOIdentityUpdate builder.
+func (siuo *SSOIdentityUpdateOne) Where(ps ...predicate.SSOIdentity) *SSOIdentityUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *SSOIdentityUpdateOne) Select(field string, fields ...string) *SSOIdentityUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated SSOIdentity entity.
+func (siuo *SSOIdentityUpdateOne) Save(ctx context.Context) (*SSOIdentity, error) {
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *SSOIdentityUpdateOne) SaveX(ctx context.Context) *SSOIdentity {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *SSOIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *SSOIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *SSOIdentityUpdateOne) check() error {
+	if siuo.mutation.UserCleared() && len(siuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOIdentity.user"`)
+	}
+	return nil
+}
+
+func (siuo *SSOIdentityUpdateOne) sqlSave(ctx context.Context) (_node *SSOIdentity, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoidentity.Table, ssoidentity.Columns, sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSOIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssoidentity.FieldID)
+		for _, f := range fields {
+			if !ssoidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ssoidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &SSOIdentity{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
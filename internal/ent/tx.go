@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,22 +14,44 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Content is the client for interacting with the Content builders.
 	Content *ContentClient
+	// ContentDownload is the client for interacting with the ContentDownload builders.
+	ContentDownload *ContentDownloadClient
+	// ContentRevision is the client for interacting with the ContentRevision builders.
+	ContentRevision *ContentRevisionClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
 	// Enrollment is the client for interacting with the Enrollment builders.
 	Enrollment *EnrollmentClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Module is the client for interacting with the Module builders.
 	Module *ModuleClient
 	// ModuleProgress is the client for interacting with the ModuleProgress builders.
 	ModuleProgress *ModuleProgressClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// PrivacyRequest is the client for interacting with the PrivacyRequest builders.
+	PrivacyRequest *PrivacyRequestClient
+	// SSOIdentity is the client for interacting with the SSOIdentity builders.
+	SSOIdentity *SSOIdentityClient
+	// SamlAssertion is the client for interacting with the SamlAssertion builders.
+	SamlAssertion *SamlAssertionClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
+	// ServiceAccount is the client for interacting with the ServiceAccount builders.
+	ServiceAccount *ServiceAccountClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebauthnCeremony is the client for interacting with the WebauthnCeremony builders.
+	WebauthnCeremony *WebauthnCeremonyClient
+	// WebauthnCredential is the client for interacting with the WebauthnCredential builders.
+	WebauthnCredential *WebauthnCredentialClient
 
 	// lazily loaded.
 	client     *Client
@@ -159,14 +183,25 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
 	tx.Content = NewContentClient(tx.config)
+	tx.ContentDownload = NewContentDownloadClient(tx.config)
+	tx.ContentRevision = NewContentRevisionClient(tx.config)
 	tx.Course = NewCourseClient(tx.config)
 	tx.Enrollment = NewEnrollmentClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
 	tx.Module = NewModuleClient(tx.config)
 	tx.ModuleProgress = NewModuleProgressClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
+	tx.PrivacyRequest = NewPrivacyRequestClient(tx.config)
+	tx.SSOIdentity = NewSSOIdentityClient(tx.config)
+	tx.SamlAssertion = NewSamlAssertionClient(tx.config)
+	tx.ScimToken = NewScimTokenClient(tx.config)
+	tx.ServiceAccount = NewServiceAccountClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WebauthnCeremony = NewWebauthnCeremonyClient(tx.config)
+	tx.WebauthnCredential = NewWebauthnCredentialClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -176,7 +211,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Content.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -229,3 +264,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
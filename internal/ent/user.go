@@ -34,8 +34,26 @@ type User struct {
 	RefreshTokenID *string `json:"-"`
 	// LastLoginAt holds the value of the "last_login_at" field.
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	// FailedLoginAttempts holds the value of the "failed_login_attempts" field.
+	FailedLoginAttempts int `json:"failed_login_attempts,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// MfaSecret holds the value of the "mfa_secret" field.
+	MfaSecret *string `json:"-"`
+	// MfaEnabledAt holds the value of the "mfa_enabled_at" field.
+	MfaEnabledAt *time.Time `json:"mfa_enabled_at,omitempty"`
+	// MfaRecoveryCodes holds the value of the "mfa_recovery_codes" field.
+	MfaRecoveryCodes []string `json:"-"`
+	// MfaLastStep holds the value of the "mfa_last_step" field.
+	MfaLastStep int64 `json:"mfa_last_step,omitempty"`
+	// MagicLinkHash holds the value of the "magic_link_hash" field.
+	MagicLinkHash *string `json:"-"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID *string `json:"external_id,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -52,9 +70,22 @@ type UserEdges struct {
 	Organization *Organization `json:"organization,omitempty"`
 	// Enrollments holds the value of the enrollments edge.
 	Enrollments []*Enrollment `json:"enrollments,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*WebauthnCredential `json:"webauthn_credentials,omitempty"`
+	// SSOIdentities holds the value of the sso_identities edge.
+	SSOIdentities []*SSOIdentity `json:"sso_identities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedEnrollments         map[string][]*Enrollment
+	namedGroups              map[string][]*Group
+	namedWebauthnCredentials map[string][]*WebauthnCredential
+	namedSSOIdentities       map[string][]*SSOIdentity
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -77,16 +108,45 @@ func (e UserEdges) EnrollmentsOrErr() ([]*Enrollment, error) {
 	return nil, &NotLoadedError{edge: "enrollments"}
 }
 
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[2] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*WebauthnCredential, error) {
+	if e.loadedTypes[3] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// SSOIdentitiesOrErr returns the SSOIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SSOIdentitiesOrErr() ([]*SSOIdentity, error) {
+	if e.loadedTypes[4] {
+		return e.SSOIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "sso_identities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldMetadata:
+		case user.FieldMfaRecoveryCodes, user.FieldMetadata:
 			values[i] = new([]byte)
-		case user.FieldEmail, user.FieldPasswordHash, user.FieldRole, user.FieldStatus, user.FieldRefreshTokenID:
+		case user.FieldFailedLoginAttempts, user.FieldMfaLastStep, user.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldRole, user.FieldStatus, user.FieldRefreshTokenID, user.FieldMfaSecret, user.FieldMagicLinkHash, user.FieldExternalID:
 			values[i] = new(sql.NullString)
-		case user.FieldLastLoginAt, user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldLastLoginAt, user.FieldLockedUntil, user.FieldMfaEnabledAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID, user.FieldOrganizationID:
 			values[i] = new(uuid.UUID)
@@ -155,6 +215,61 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.LastLoginAt = new(time.Time)
 				*u.LastLoginAt = value.Time
 			}
+		case user.FieldFailedLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_attempts", values[i])
+			} else if value.Valid {
+				u.FailedLoginAttempts = int(value.Int64)
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				u.LockedUntil = new(time.Time)
+				*u.LockedUntil = value.Time
+			}
+		case user.FieldMfaSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_secret", values[i])
+			} else if value.Valid {
+				u.MfaSecret = new(string)
+				*u.MfaSecret = value.String
+			}
+		case user.FieldMfaEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_enabled_at", values[i])
+			} else if value.Valid {
+				u.MfaEnabledAt = new(time.Time)
+				*u.MfaEnabledAt = value.Time
+			}
+		case user.FieldMfaRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.MfaRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field mfa_recovery_codes: %w", err)
+				}
+			}
+		case user.FieldMfaLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_last_step", values[i])
+			} else if value.Valid {
+				u.MfaLastStep = value.Int64
+			}
+		case user.FieldMagicLinkHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field magic_link_hash", values[i])
+			} else if value.Valid {
+				u.MagicLinkHash = new(string)
+				*u.MagicLinkHash = value.String
+			}
+		case user.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				u.ExternalID = new(string)
+				*u.ExternalID = value.String
+			}
 		case user.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -163,6 +278,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case user.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				u.Version = int(value.Int64)
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -198,6 +319,21 @@ func (u *User) QueryEnrollments() *EnrollmentQuery {
 	return NewUserClient(u.config).QueryEnrollments(u)
 }
 
+// QueryGroups queries the "groups" edge of the User entity.
+func (u *User) QueryGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryGroups(u)
+}
+
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (u *User) QueryWebauthnCredentials() *WebauthnCredentialQuery {
+	return NewUserClient(u.config).QueryWebauthnCredentials(u)
+}
+
+// QuerySSOIdentities queries the "sso_identities" edge of the User entity.
+func (u *User) QuerySSOIdentities() *SSOIdentityQuery {
+	return NewUserClient(u.config).QuerySSOIdentities(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -241,9 +377,39 @@ func (u *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("failed_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", u.FailedLoginAttempts))
+	builder.WriteString(", ")
+	if v := u.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.MfaEnabledAt; v != nil {
+		builder.WriteString("mfa_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mfa_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.MfaLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("magic_link_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", u.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", u.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -253,5 +419,101 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// NamedEnrollments returns the Enrollments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedEnrollments(name string) ([]*Enrollment, error) {
+	if u.Edges.namedEnrollments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedEnrollments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedEnrollments(name string, edges ...*Enrollment) {
+	if u.Edges.namedEnrollments == nil {
+		u.Edges.namedEnrollments = make(map[string][]*Enrollment)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedEnrollments[name] = []*Enrollment{}
+	} else {
+		u.Edges.namedEnrollments[name] = append(u.Edges.namedEnrollments[name], edges...)
+	}
+}
+
+// NamedGroups returns the Groups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedGroups(name string) ([]*Group, error) {
+	if u.Edges.namedGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedGroups(name string, edges ...*Group) {
+	if u.Edges.namedGroups == nil {
+		u.Edges.namedGroups = make(map[string][]*Group)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedGroups[name] = []*Group{}
+	} else {
+		u.Edges.namedGroups[name] = append(u.Edges.namedGroups[name], edges...)
+	}
+}
+
+// NamedWebauthnCredentials returns the WebauthnCredentials named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedWebauthnCredentials(name string) ([]*WebauthnCredential, error) {
+	if u.Edges.namedWebauthnCredentials == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedWebauthnCredentials[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedWebauthnCredentials(name string, edges ...*WebauthnCredential) {
+	if u.Edges.namedWebauthnCredentials == nil {
+		u.Edges.namedWebauthnCredentials = make(map[string][]*WebauthnCredential)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedWebauthnCredentials[name] = []*WebauthnCredential{}
+	} else {
+		u.Edges.namedWebauthnCredentials[name] = append(u.Edges.namedWebauthnCredentials[name], edges...)
+	}
+}
+
+// NamedSSOIdentities returns the SSOIdentities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSSOIdentities(name string) ([]*SSOIdentity, error) {
+	if u.Edges.namedSSOIdentities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSSOIdentities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSSOIdentities(name string, edges ...*SSOIdentity) {
+	if u.Edges.namedSSOIdentities == nil {
+		u.Edges.namedSSOIdentities = make(map[string][]*SSOIdentity)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSSOIdentities[name] = []*SSOIdentity{}
+	} else {
+		u.Edges.namedSSOIdentities[name] = append(u.Edges.namedSSOIdentities[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -7,8 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"lms-go/internal/ent/enrollment"
+	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthncredential"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -97,12 +100,130 @@ func (uc *UserCreate) SetNillableLastLoginAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (uc *UserCreate) SetFailedLoginAttempts(i int) *UserCreate {
+	uc.mutation.SetFailedLoginAttempts(i)
+	return uc
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFailedLoginAttempts(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFailedLoginAttempts(*i)
+	}
+	return uc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uc *UserCreate) SetLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLockedUntil(t)
+	return uc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLockedUntil(*t)
+	}
+	return uc
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (uc *UserCreate) SetMfaSecret(s string) *UserCreate {
+	uc.mutation.SetMfaSecret(s)
+	return uc
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetMfaSecret(*s)
+	}
+	return uc
+}
+
+// SetMfaEnabledAt sets the "mfa_enabled_at" field.
+func (uc *UserCreate) SetMfaEnabledAt(t time.Time) *UserCreate {
+	uc.mutation.SetMfaEnabledAt(t)
+	return uc
+}
+
+// SetNillableMfaEnabledAt sets the "mfa_enabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaEnabledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetMfaEnabledAt(*t)
+	}
+	return uc
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uc *UserCreate) SetMfaRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetMfaRecoveryCodes(s)
+	return uc
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uc *UserCreate) SetMfaLastStep(i int64) *UserCreate {
+	uc.mutation.SetMfaLastStep(i)
+	return uc
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetMfaLastStep(*i)
+	}
+	return uc
+}
+
+// SetMagicLinkHash sets the "magic_link_hash" field.
+func (uc *UserCreate) SetMagicLinkHash(s string) *UserCreate {
+	uc.mutation.SetMagicLinkHash(s)
+	return uc
+}
+
+// SetNillableMagicLinkHash sets the "magic_link_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMagicLinkHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetMagicLinkHash(*s)
+	}
+	return uc
+}
+
+// SetExternalID sets the "external_id" field.
+func (uc *UserCreate) SetExternalID(s string) *UserCreate {
+	uc.mutation.SetExternalID(s)
+	return uc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExternalID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetExternalID(*s)
+	}
+	return uc
+}
+
 // SetMetadata sets the "metadata" field.
 func (uc *UserCreate) SetMetadata(m map[string]interface{}) *UserCreate {
 	uc.mutation.SetMetadata(m)
 	return uc
 }
 
+// SetVersion sets the "version" field.
+func (uc *UserCreate) SetVersion(i int) *UserCreate {
+	uc.mutation.SetVersion(i)
+	return uc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetVersion(*i)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -165,6 +286,51 @@ func (uc *UserCreate) AddEnrollments(e ...*Enrollment) *UserCreate {
 	return uc.AddEnrollmentIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddGroupIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddGroupIDs(ids...)
+	return uc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebauthnCredential entity by IDs.
+func (uc *UserCreate) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddWebauthnCredentialIDs(ids...)
+	return uc
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebauthnCredential entity.
+func (uc *UserCreate) AddWebauthnCredentials(w ...*WebauthnCredential) *UserCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uc.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddSSOIdentityIDs adds the "sso_identities" edge to the SSOIdentity entity by IDs.
+func (uc *UserCreate) AddSSOIdentityIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSSOIdentityIDs(ids...)
+	return uc
+}
+
+// AddSSOIdentities adds the "sso_identities" edges to the SSOIdentity entity.
+func (uc *UserCreate) AddSSOIdentities(s ...*SSOIdentity) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSSOIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -208,10 +374,22 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultStatus
 		uc.mutation.SetStatus(v)
 	}
+	if _, ok := uc.mutation.FailedLoginAttempts(); !ok {
+		v := user.DefaultFailedLoginAttempts
+		uc.mutation.SetFailedLoginAttempts(v)
+	}
+	if _, ok := uc.mutation.MfaLastStep(); !ok {
+		v := user.DefaultMfaLastStep
+		uc.mutation.SetMfaLastStep(v)
+	}
 	if _, ok := uc.mutation.Metadata(); !ok {
 		v := user.DefaultMetadata
 		uc.mutation.SetMetadata(v)
 	}
+	if _, ok := uc.mutation.Version(); !ok {
+		v := user.DefaultVersion
+		uc.mutation.SetVersion(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -253,13 +431,22 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
+	if _, ok := uc.mutation.FailedLoginAttempts(); !ok {
+		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`ent: missing required field "User.failed_login_attempts"`)}
+	}
+	if _, ok := uc.mutation.MfaLastStep(); !ok {
+		return &ValidationError{Name: "mfa_last_step", err: errors.New(`ent: missing required field "User.mfa_last_step"`)}
+	}
+	if _, ok := uc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "User.version"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
-	if _, ok := uc.mutation.OrganizationID(); !ok {
+	if len(uc.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "User.organization"`)}
 	}
 	return nil
@@ -321,10 +508,46 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = &value
 	}
+	if value, ok := uc.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+		_node.FailedLoginAttempts = value
+	}
+	if value, ok := uc.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := uc.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+		_node.MfaSecret = &value
+	}
+	if value, ok := uc.mutation.MfaEnabledAt(); ok {
+		_spec.SetField(user.FieldMfaEnabledAt, field.TypeTime, value)
+		_node.MfaEnabledAt = &value
+	}
+	if value, ok := uc.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+		_node.MfaRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+		_node.MfaLastStep = value
+	}
+	if value, ok := uc.mutation.MagicLinkHash(); ok {
+		_spec.SetField(user.FieldMagicLinkHash, field.TypeString, value)
+		_node.MagicLinkHash = &value
+	}
+	if value, ok := uc.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
 	if value, ok := uc.mutation.Metadata(); ok {
 		_spec.SetField(user.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := uc.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -366,6 +589,54 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SSOIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,13 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"lms-go/internal/ent/enrollment"
+	"lms-go/internal/ent/group"
 	"lms-go/internal/ent/organization"
 	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/ssoidentity"
 	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthncredential"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -141,6 +145,166 @@ func (uu *UserUpdate) ClearLastLoginAt() *UserUpdate {
 	return uu
 }
 
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (uu *UserUpdate) SetFailedLoginAttempts(i int) *UserUpdate {
+	uu.mutation.ResetFailedLoginAttempts()
+	uu.mutation.SetFailedLoginAttempts(i)
+	return uu
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFailedLoginAttempts(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFailedLoginAttempts(*i)
+	}
+	return uu
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (uu *UserUpdate) AddFailedLoginAttempts(i int) *UserUpdate {
+	uu.mutation.AddFailedLoginAttempts(i)
+	return uu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uu *UserUpdate) SetLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetLockedUntil(t)
+	return uu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uu *UserUpdate) ClearLockedUntil() *UserUpdate {
+	uu.mutation.ClearLockedUntil()
+	return uu
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (uu *UserUpdate) SetMfaSecret(s string) *UserUpdate {
+	uu.mutation.SetMfaSecret(s)
+	return uu
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetMfaSecret(*s)
+	}
+	return uu
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (uu *UserUpdate) ClearMfaSecret() *UserUpdate {
+	uu.mutation.ClearMfaSecret()
+	return uu
+}
+
+// SetMfaEnabledAt sets the "mfa_enabled_at" field.
+func (uu *UserUpdate) SetMfaEnabledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetMfaEnabledAt(t)
+	return uu
+}
+
+// SetNillableMfaEnabledAt sets the "mfa_enabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaEnabledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetMfaEnabledAt(*t)
+	}
+	return uu
+}
+
+// ClearMfaEnabledAt clears the value of the "mfa_enabled_at" field.
+func (uu *UserUpdate) ClearMfaEnabledAt() *UserUpdate {
+	uu.mutation.ClearMfaEnabledAt()
+	return uu
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uu *UserUpdate) SetMfaRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.SetMfaRecoveryCodes(s)
+	return uu
+}
+
+// AppendMfaRecoveryCodes appends s to the "mfa_recovery_codes" field.
+func (uu *UserUpdate) AppendMfaRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.AppendMfaRecoveryCodes(s)
+	return uu
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (uu *UserUpdate) ClearMfaRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearMfaRecoveryCodes()
+	return uu
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uu *UserUpdate) SetMfaLastStep(i int64) *UserUpdate {
+	uu.mutation.ResetMfaLastStep()
+	uu.mutation.SetMfaLastStep(i)
+	return uu
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMfaLastStep(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetMfaLastStep(*i)
+	}
+	return uu
+}
+
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (uu *UserUpdate) AddMfaLastStep(i int64) *UserUpdate {
+	uu.mutation.AddMfaLastStep(i)
+	return uu
+}
+
+// SetMagicLinkHash sets the "magic_link_hash" field.
+func (uu *UserUpdate) SetMagicLinkHash(s string) *UserUpdate {
+	uu.mutation.SetMagicLinkHash(s)
+	return uu
+}
+
+// SetNillableMagicLinkHash sets the "magic_link_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMagicLinkHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetMagicLinkHash(*s)
+	}
+	return uu
+}
+
+// ClearMagicLinkHash clears the value of the "magic_link_hash" field.
+func (uu *UserUpdate) ClearMagicLinkHash() *UserUpdate {
+	uu.mutation.ClearMagicLinkHash()
+	return uu
+}
+
+// SetExternalID sets the "external_id" field.
+func (uu *UserUpdate) SetExternalID(s string) *UserUpdate {
+	uu.mutation.SetExternalID(s)
+	return uu
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableExternalID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetExternalID(*s)
+	}
+	return uu
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (uu *UserUpdate) ClearExternalID() *UserUpdate {
+	uu.mutation.ClearExternalID()
+	return uu
+}
+
 // SetMetadata sets the "metadata" field.
 func (uu *UserUpdate) SetMetadata(m map[string]interface{}) *UserUpdate {
 	uu.mutation.SetMetadata(m)
@@ -153,6 +317,27 @@ func (uu *UserUpdate) ClearMetadata() *UserUpdate {
 	return uu
 }
 
+// SetVersion sets the "version" field.
+func (uu *UserUpdate) SetVersion(i int) *UserUpdate {
+	uu.mutation.ResetVersion()
+	uu.mutation.SetVersion(i)
+	return uu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetVersion(*i)
+	}
+	return uu
+}
+
+// AddVersion adds i to the "version" field.
+func (uu *UserUpdate) AddVersion(i int) *UserUpdate {
+	uu.mutation.AddVersion(i)
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -179,6 +364,51 @@ func (uu *UserUpdate) AddEnrollments(e ...*Enrollment) *UserUpdate {
 	return uu.AddEnrollmentIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uu *UserUpdate) AddGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddGroupIDs(ids...)
+	return uu
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uu *UserUpdate) AddGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebauthnCredential entity by IDs.
+func (uu *UserUpdate) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebauthnCredential entity.
+func (uu *UserUpdate) AddWebauthnCredentials(w ...*WebauthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddSSOIdentityIDs adds the "sso_identities" edge to the SSOIdentity entity by IDs.
+func (uu *UserUpdate) AddSSOIdentityIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSSOIdentityIDs(ids...)
+	return uu
+}
+
+// AddSSOIdentities adds the "sso_identities" edges to the SSOIdentity entity.
+func (uu *UserUpdate) AddSSOIdentities(s ...*SSOIdentity) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSSOIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -211,6 +441,69 @@ func (uu *UserUpdate) RemoveEnrollments(e ...*Enrollment) *UserUpdate {
 	return uu.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearGroups clears all "groups" edges to the Group entity.
+func (uu *UserUpdate) ClearGroups() *UserUpdate {
+	uu.mutation.ClearGroups()
+	return uu
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (uu *UserUpdate) RemoveGroupIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveGroupIDs(ids...)
+	return uu
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (uu *UserUpdate) RemoveGroups(g ...*Group) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupIDs(ids...)
+}
+
+// ClearWebauthnCredentials clears all "webauthn_credentials" edges to the WebauthnCredential entity.
+func (uu *UserUpdate) ClearWebauthnCredentials() *UserUpdate {
+	uu.mutation.ClearWebauthnCredentials()
+	return uu
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to WebauthnCredential entities by IDs.
+func (uu *UserUpdate) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// RemoveWebauthnCredentials removes "webauthn_credentials" edges to WebauthnCredential entities.
+func (uu *UserUpdate) RemoveWebauthnCredentials(w ...*WebauthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.RemoveWebauthnCredentialIDs(ids...)
+}
+
+// ClearSSOIdentities clears all "sso_identities" edges to the SSOIdentity entity.
+func (uu *UserUpdate) ClearSSOIdentities() *UserUpdate {
+	uu.mutation.ClearSSOIdentities()
+	return uu
+}
+
+// RemoveSSOIdentityIDs removes the "sso_identities" edge to SSOIdentity entities by IDs.
+func (uu *UserUpdate) RemoveSSOIdentityIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSSOIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveSSOIdentities removes "sso_identities" edges to SSOIdentity entities.
+func (uu *UserUpdate) RemoveSSOIdentities(s ...*SSOIdentity) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSSOIdentityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -259,7 +552,7 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
-	if _, ok := uu.mutation.OrganizationID(); uu.mutation.OrganizationCleared() && !ok {
+	if uu.mutation.OrganizationCleared() && len(uu.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "User.organization"`)
 	}
 	return nil
@@ -301,12 +594,71 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if uu.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := uu.mutation.MfaEnabledAt(); ok {
+		_spec.SetField(user.FieldMfaEnabledAt, field.TypeTime, value)
+	}
+	if uu.mutation.MfaEnabledAtCleared() {
+		_spec.ClearField(user.FieldMfaEnabledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if uu.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedMfaLastStep(); ok {
+		_spec.AddField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.MagicLinkHash(); ok {
+		_spec.SetField(user.FieldMagicLinkHash, field.TypeString, value)
+	}
+	if uu.mutation.MagicLinkHashCleared() {
+		_spec.ClearField(user.FieldMagicLinkHash, field.TypeString)
+	}
+	if value, ok := uu.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+	}
+	if uu.mutation.ExternalIDCleared() {
+		_spec.ClearField(user.FieldExternalID, field.TypeString)
+	}
 	if value, ok := uu.mutation.Metadata(); ok {
 		_spec.SetField(user.FieldMetadata, field.TypeJSON, value)
 	}
 	if uu.mutation.MetadataCleared() {
 		_spec.ClearField(user.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := uu.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -384,6 +736,141 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !uu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedWebauthnCredentialsIDs(); len(nodes) > 0 && !uu.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SSOIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSSOIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.SSOIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SSOIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -514,6 +1001,166 @@ func (uuo *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (uuo *UserUpdateOne) SetFailedLoginAttempts(i int) *UserUpdateOne {
+	uuo.mutation.ResetFailedLoginAttempts()
+	uuo.mutation.SetFailedLoginAttempts(i)
+	return uuo
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFailedLoginAttempts(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFailedLoginAttempts(*i)
+	}
+	return uuo
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (uuo *UserUpdateOne) AddFailedLoginAttempts(i int) *UserUpdateOne {
+	uuo.mutation.AddFailedLoginAttempts(i)
+	return uuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uuo *UserUpdateOne) SetLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLockedUntil(t)
+	return uuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uuo *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearLockedUntil()
+	return uuo
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (uuo *UserUpdateOne) SetMfaSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetMfaSecret(s)
+	return uuo
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetMfaSecret(*s)
+	}
+	return uuo
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (uuo *UserUpdateOne) ClearMfaSecret() *UserUpdateOne {
+	uuo.mutation.ClearMfaSecret()
+	return uuo
+}
+
+// SetMfaEnabledAt sets the "mfa_enabled_at" field.
+func (uuo *UserUpdateOne) SetMfaEnabledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetMfaEnabledAt(t)
+	return uuo
+}
+
+// SetNillableMfaEnabledAt sets the "mfa_enabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaEnabledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetMfaEnabledAt(*t)
+	}
+	return uuo
+}
+
+// ClearMfaEnabledAt clears the value of the "mfa_enabled_at" field.
+func (uuo *UserUpdateOne) ClearMfaEnabledAt() *UserUpdateOne {
+	uuo.mutation.ClearMfaEnabledAt()
+	return uuo
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) SetMfaRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.SetMfaRecoveryCodes(s)
+	return uuo
+}
+
+// AppendMfaRecoveryCodes appends s to the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) AppendMfaRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.AppendMfaRecoveryCodes(s)
+	return uuo
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (uuo *UserUpdateOne) ClearMfaRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearMfaRecoveryCodes()
+	return uuo
+}
+
+// SetMfaLastStep sets the "mfa_last_step" field.
+func (uuo *UserUpdateOne) SetMfaLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.ResetMfaLastStep()
+	uuo.mutation.SetMfaLastStep(i)
+	return uuo
+}
+
+// SetNillableMfaLastStep sets the "mfa_last_step" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMfaLastStep(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetMfaLastStep(*i)
+	}
+	return uuo
+}
+
+// AddMfaLastStep adds i to the "mfa_last_step" field.
+func (uuo *UserUpdateOne) AddMfaLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.AddMfaLastStep(i)
+	return uuo
+}
+
+// SetMagicLinkHash sets the "magic_link_hash" field.
+func (uuo *UserUpdateOne) SetMagicLinkHash(s string) *UserUpdateOne {
+	uuo.mutation.SetMagicLinkHash(s)
+	return uuo
+}
+
+// SetNillableMagicLinkHash sets the "magic_link_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMagicLinkHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetMagicLinkHash(*s)
+	}
+	return uuo
+}
+
+// ClearMagicLinkHash clears the value of the "magic_link_hash" field.
+func (uuo *UserUpdateOne) ClearMagicLinkHash() *UserUpdateOne {
+	uuo.mutation.ClearMagicLinkHash()
+	return uuo
+}
+
+// SetExternalID sets the "external_id" field.
+func (uuo *UserUpdateOne) SetExternalID(s string) *UserUpdateOne {
+	uuo.mutation.SetExternalID(s)
+	return uuo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableExternalID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetExternalID(*s)
+	}
+	return uuo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (uuo *UserUpdateOne) ClearExternalID() *UserUpdateOne {
+	uuo.mutation.ClearExternalID()
+	return uuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (uuo *UserUpdateOne) SetMetadata(m map[string]interface{}) *UserUpdateOne {
 	uuo.mutation.SetMetadata(m)
@@ -526,6 +1173,27 @@ func (uuo *UserUpdateOne) ClearMetadata() *UserUpdateOne {
 	return uuo
 }
 
+// SetVersion sets the "version" field.
+func (uuo *UserUpdateOne) SetVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetVersion()
+	uuo.mutation.SetVersion(i)
+	return uuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetVersion(*i)
+	}
+	return uuo
+}
+
+// AddVersion adds i to the "version" field.
+func (uuo *UserUpdateOne) AddVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddVersion(i)
+	return uuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetUpdatedAt(t)
@@ -552,6 +1220,51 @@ func (uuo *UserUpdateOne) AddEnrollments(e ...*Enrollment) *UserUpdateOne {
 	return uuo.AddEnrollmentIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uuo *UserUpdateOne) AddGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddGroupIDs(ids...)
+	return uuo
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uuo *UserUpdateOne) AddGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebauthnCredential entity by IDs.
+func (uuo *UserUpdateOne) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebauthnCredential entity.
+func (uuo *UserUpdateOne) AddWebauthnCredentials(w ...*WebauthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddSSOIdentityIDs adds the "sso_identities" edge to the SSOIdentity entity by IDs.
+func (uuo *UserUpdateOne) AddSSOIdentityIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSSOIdentityIDs(ids...)
+	return uuo
+}
+
+// AddSSOIdentities adds the "sso_identities" edges to the SSOIdentity entity.
+func (uuo *UserUpdateOne) AddSSOIdentities(s ...*SSOIdentity) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSSOIdentityIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -584,6 +1297,69 @@ func (uuo *UserUpdateOne) RemoveEnrollments(e ...*Enrollment) *UserUpdateOne {
 	return uuo.RemoveEnrollmentIDs(ids...)
 }
 
+// ClearGroups clears all "groups" edges to the Group entity.
+func (uuo *UserUpdateOne) ClearGroups() *UserUpdateOne {
+	uuo.mutation.ClearGroups()
+	return uuo
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (uuo *UserUpdateOne) RemoveGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveGroupIDs(ids...)
+	return uuo
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (uuo *UserUpdateOne) RemoveGroups(g ...*Group) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupIDs(ids...)
+}
+
+// ClearWebauthnCredentials clears all "webauthn_credentials" edges to the WebauthnCredential entity.
+func (uuo *UserUpdateOne) ClearWebauthnCredentials() *UserUpdateOne {
+	uuo.mutation.ClearWebauthnCredentials()
+	return uuo
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to WebauthnCredential entities by IDs.
+func (uuo *UserUpdateOne) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// RemoveWebauthnCredentials removes "webauthn_credentials" edges to WebauthnCredential entities.
+func (uuo *UserUpdateOne) RemoveWebauthnCredentials(w ...*WebauthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.RemoveWebauthnCredentialIDs(ids...)
+}
+
+// ClearSSOIdentities clears all "sso_identities" edges to the SSOIdentity entity.
+func (uuo *UserUpdateOne) ClearSSOIdentities() *UserUpdateOne {
+	uuo.mutation.ClearSSOIdentities()
+	return uuo
+}
+
+// RemoveSSOIdentityIDs removes the "sso_identities" edge to SSOIdentity entities by IDs.
+func (uuo *UserUpdateOne) RemoveSSOIdentityIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSSOIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveSSOIdentities removes "sso_identities" edges to SSOIdentity entities.
+func (uuo *UserUpdateOne) RemoveSSOIdentities(s ...*SSOIdentity) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSSOIdentityIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -645,7 +1421,7 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
-	if _, ok := uuo.mutation.OrganizationID(); uuo.mutation.OrganizationCleared() && !ok {
+	if uuo.mutation.OrganizationCleared() && len(uuo.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "User.organization"`)
 	}
 	return nil
@@ -704,12 +1480,71 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if uuo.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.MfaEnabledAt(); ok {
+		_spec.SetField(user.FieldMfaEnabledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.MfaEnabledAtCleared() {
+		_spec.ClearField(user.FieldMfaEnabledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if uuo.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.MfaLastStep(); ok {
+		_spec.SetField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedMfaLastStep(); ok {
+		_spec.AddField(user.FieldMfaLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.MagicLinkHash(); ok {
+		_spec.SetField(user.FieldMagicLinkHash, field.TypeString, value)
+	}
+	if uuo.mutation.MagicLinkHashCleared() {
+		_spec.ClearField(user.FieldMagicLinkHash, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ExternalID(); ok {
+		_spec.SetField(user.FieldExternalID, field.TypeString, value)
+	}
+	if uuo.mutation.ExternalIDCleared() {
+		_spec.ClearField(user.FieldExternalID, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Metadata(); ok {
 		_spec.SetField(user.FieldMetadata, field.TypeJSON, value)
 	}
 	if uuo.mutation.MetadataCleared() {
 		_spec.ClearField(user.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := uuo.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := uuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -787,6 +1622,141 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !uuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedWebauthnCredentialsIDs(); len(nodes) > 0 && !uuo.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SSOIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSSOIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.SSOIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SSOIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOIdentitiesTable,
+			Columns: []string{user.SSOIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"lms-go/internal/ent/webauthnceremony"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WebauthnCeremony is the model entity for the WebauthnCeremony schema.
+type WebauthnCeremony struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebauthnCeremony) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthnceremony.FieldSessionID:
+			values[i] = new(sql.NullString)
+		case webauthnceremony.FieldExpiresAt, webauthnceremony.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case webauthnceremony.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebauthnCeremony fields.
+func (wc *WebauthnCeremony) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthnceremony.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wc.ID = *value
+			}
+		case webauthnceremony.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				wc.SessionID = value.String
+			}
+		case webauthnceremony.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				wc.ExpiresAt = value.Time
+			}
+		case webauthnceremony.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wc.CreatedAt = value.Time
+			}
+		default:
+			wc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebauthnCeremony.
+// This includes values selected through modifiers, order, etc.
+func (wc *WebauthnCeremony) Value(name string) (ent.Value, error) {
+	return wc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebauthnCeremony.
+// Note that you need to call WebauthnCeremony.Unwrap() before calling this method if this WebauthnCeremony
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wc *WebauthnCeremony) Update() *WebauthnCeremonyUpdateOne {
+	return NewWebauthnCeremonyClient(wc.config).UpdateOne(wc)
+}
+
+// Unwrap unwraps the WebauthnCeremony entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wc *WebauthnCeremony) Unwrap() *WebauthnCeremony {
+	_tx, ok := wc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebauthnCeremony is not a transactional entity")
+	}
+	wc.config.driver = _tx.drv
+	return wc
+}
+
+// String implements the fmt.Stringer.
+func (wc *WebauthnCeremony) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebauthnCeremony(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wc.ID))
+	builder.WriteString("session_id=")
+	builder.WriteString(wc.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(wc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebauthnCeremonies is a parsable slice of WebauthnCeremony.
+type WebauthnCeremonies []*WebauthnCeremony
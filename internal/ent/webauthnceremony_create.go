@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/webauthnceremony"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebauthnCeremonyCreate is the builder for creating a WebauthnCeremony entity.
+type WebauthnCeremonyCreate struct {
+	config
+	mutation *WebauthnCeremonyMutation
+	hooks    []Hook
+}
+
+// SetSessionID sets the "session_id" field.
+func (wcc *WebauthnCeremonyCreate) SetSessionID(s string) *WebauthnCeremonyCreate {
+	wcc.mutation.SetSessionID(s)
+	return wcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wcc *WebauthnCeremonyCreate) SetExpiresAt(t time.Time) *WebauthnCeremonyCreate {
+	wcc.mutation.SetExpiresAt(t)
+	return wcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wcc *WebauthnCeremonyCreate) SetCreatedAt(t time.Time) *WebauthnCeremonyCreate {
+	wcc.mutation.SetCreatedAt(t)
+	return wcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wcc *WebauthnCeremonyCreate) SetNillableCreatedAt(t *time.Time) *WebauthnCeremonyCreate {
+	if t != nil {
+		wcc.SetCreatedAt(*t)
+	}
+	return wcc
+}
+
+// SetID sets the "id" field.
+func (wcc *WebauthnCeremonyCreate) SetID(u uuid.UUID) *WebauthnCeremonyCreate {
+	wcc.mutation.SetID(u)
+	return wcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wcc *WebauthnCeremonyCreate) SetNillableID(u *uuid.UUID) *WebauthnCeremonyCreate {
+	if u != nil {
+		wcc.SetID(*u)
+	}
+	return wcc
+}
+
+// Mutation returns the WebauthnCeremonyMutation object of the builder.
+func (wcc *WebauthnCeremonyCreate) Mutation() *WebauthnCeremonyMutation {
+	return wcc.mutation
+}
+
+// Save creates the WebauthnCeremony in the database.
+func (wcc *WebauthnCeremonyCreate) Save(ctx context.Context) (*WebauthnCeremony, error) {
+	wcc.defaults()
+	return withHooks(ctx, wcc.sqlSave, wcc.mutation, wcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wcc *WebauthnCeremonyCreate) SaveX(ctx context.Context) *WebauthnCeremony {
+	v, err := wcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcc *WebauthnCeremonyCreate) Exec(ctx context.Context) error {
+	_, err := wcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcc *WebauthnCeremonyCreate) ExecX(ctx context.Context) {
+	if err := wcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcc *WebauthnCeremonyCreate) defaults() {
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		v := webauthnceremony.DefaultCreatedAt()
+		wcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wcc.mutation.ID(); !ok {
+		v := webauthnceremony.DefaultID()
+		wcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcc *WebauthnCeremonyCreate) check() error {
+	if _, ok := wcc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "WebauthnCeremony.session_id"`)}
+	}
+	if v, ok := wcc.mutation.SessionID(); ok {
+		if err := webauthnceremony.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "WebauthnCeremony.session_id": %w`, err)}
+		}
+	}
+	if _, ok := wcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WebauthnCeremony.expires_at"`)}
+	}
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebauthnCeremony.created_at"`)}
+	}
+	return nil
+}
+
+func (wcc *WebauthnCeremonyCreate) sqlSave(ctx context.Context) (*WebauthnCeremony, error) {
+	if err := wcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wcc.mutation.id = &_node.ID
+	wcc.mutation.done = true
+	return _node, nil
+}
+
+func (wcc *WebauthnCeremonyCreate) createSpec() (*WebauthnCeremony, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebauthnCeremony{config: wcc.config}
+		_spec = sqlgraph.NewCreateSpec(webauthnceremony.Table, sqlgraph.NewFieldSpec(webauthnceremony.FieldID, field.TypeUUID))
+	)
+	if id, ok := wcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wcc.mutation.SessionID(); ok {
+		_spec.SetField(webauthnceremony.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := wcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnceremony.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := wcc.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnceremony.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebauthnCeremonyCreateBulk is the builder for creating many WebauthnCeremony entities in bulk.
+type WebauthnCeremonyCreateBulk struct {
+	config
+	err      error
+	builders []*WebauthnCeremonyCreate
+}
+
+// Save creates the WebauthnCeremony entities in the database.
+func (wccb *WebauthnCeremonyCreateBulk) Save(ctx context.Context) ([]*WebauthnCeremony, error) {
+	if wccb.err != nil {
+		return nil, wccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wccb.builders))
+	nodes := make([]*WebauthnCeremony, len(wccb.builders))
+	mutators := make([]Mutator, len(wccb.builders))
+	for i := range wccb.builders {
+		func(i int, root context.Context) {
+			builder := wccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebauthnCeremonyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wccb *WebauthnCeremonyCreateBulk) SaveX(ctx context.Context) []*WebauthnCeremony {
+	v, err := wccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wccb *WebauthnCeremonyCreateBulk) Exec(ctx context.Context) error {
+	_, err := wccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wccb *WebauthnCeremonyCreateBulk) ExecX(ctx context.Context) {
+	if err := wccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
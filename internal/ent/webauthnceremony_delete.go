@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/webauthnceremony"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebauthnCeremonyDelete is the builder for deleting a WebauthnCeremony entity.
+type WebauthnCeremonyDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCeremonyMutation
+}
+
+// Where appends a list predicates to the WebauthnCeremonyDelete builder.
+func (wcd *WebauthnCeremonyDelete) Where(ps ...predicate.WebauthnCeremony) *WebauthnCeremonyDelete {
+	wcd.mutation.Where(ps...)
+	return wcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wcd *WebauthnCeremonyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wcd.sqlExec, wcd.mutation, wcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcd *WebauthnCeremonyDelete) ExecX(ctx context.Context) int {
+	n, err := wcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wcd *WebauthnCeremonyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webauthnceremony.Table, sqlgraph.NewFieldSpec(webauthnceremony.FieldID, field.TypeUUID))
+	if ps := wcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wcd.mutation.done = true
+	return affected, err
+}
+
+// WebauthnCeremonyDeleteOne is the builder for deleting a single WebauthnCeremony entity.
+type WebauthnCeremonyDeleteOne struct {
+	wcd *WebauthnCeremonyDelete
+}
+
+// Where appends a list predicates to the WebauthnCeremonyDelete builder.
+func (wcdo *WebauthnCeremonyDeleteOne) Where(ps ...predicate.WebauthnCeremony) *WebauthnCeremonyDeleteOne {
+	wcdo.wcd.mutation.Where(ps...)
+	return wcdo
+}
+
+// Exec executes the deletion query.
+func (wcdo *WebauthnCeremonyDeleteOne) Exec(ctx context.Context) error {
+	n, err := wcdo.wcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webauthnceremony.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcdo *WebauthnCeremonyDeleteOne) ExecX(ctx context.Context) {
+	if err := wcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
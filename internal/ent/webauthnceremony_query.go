@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/webauthnceremony"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebauthnCeremonyQuery is the builder for querying WebauthnCeremony entities.
+type WebauthnCeremonyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []webauthnceremony.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WebauthnCeremony
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*WebauthnCeremony) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebauthnCeremonyQuery builder.
+func (wcq *WebauthnCeremonyQuery) Where(ps ...predicate.WebauthnCeremony) *WebauthnCeremonyQuery {
+	wcq.predicates = append(wcq.predicates, ps...)
+	return wcq
+}
+
+// Limit the number of records to be returned by this query.
+func (wcq *WebauthnCeremonyQuery) Limit(limit int) *WebauthnCeremonyQuery {
+	wcq.ctx.Limit = &limit
+	return wcq
+}
+
+// Offset to start from.
+func (wcq *WebauthnCeremonyQuery) Offset(offset int) *WebauthnCeremonyQuery {
+	wcq.ctx.Offset = &offset
+	return wcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wcq *WebauthnCeremonyQuery) Unique(unique bool) *WebauthnCeremonyQuery {
+	wcq.ctx.Unique = &unique
+	return wcq
+}
+
+// Order specifies how the records should be ordered.
+func (wcq *WebauthnCeremonyQuery) Order(o ...webauthnceremony.OrderOption) *WebauthnCeremonyQuery {
+	wcq.order = append(wcq.order, o...)
+	return wcq
+}
+
+// First returns the first WebauthnCeremony entity from the query.
+// Returns a *NotFoundError when no WebauthnCeremony was found.
+func (wcq *WebauthnCeremonyQuery) First(ctx context.Context) (*WebauthnCeremony, error) {
+	nodes, err := wcq.Limit(1).All(setContextOp(ctx, wcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webauthnceremony.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) FirstX(ctx context.Context) *WebauthnCeremony {
+	node, err := wcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebauthnCeremony ID from the query.
+// Returns a *NotFoundError when no WebauthnCeremony ID was found.
+func (wcq *WebauthnCeremonyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wcq.Limit(1).IDs(setContextOp(ctx, wcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webauthnceremony.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebauthnCeremony entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebauthnCeremony entity is found.
+// Returns a *NotFoundError when no WebauthnCeremony entities are found.
+func (wcq *WebauthnCeremonyQuery) Only(ctx context.Context) (*WebauthnCeremony, error) {
+	nodes, err := wcq.Limit(2).All(setContextOp(ctx, wcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webauthnceremony.Label}
+	default:
+		return nil, &NotSingularError{webauthnceremony.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) OnlyX(ctx context.Context) *WebauthnCeremony {
+	node, err := wcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebauthnCeremony ID in the query.
+// Returns a *NotSingularError when more than one WebauthnCeremony ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wcq *WebauthnCeremonyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wcq.Limit(2).IDs(setContextOp(ctx, wcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webauthnceremony.Label}
+	default:
+		err = &NotSingularError{webauthnceremony.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebauthnCeremonies.
+func (wcq *WebauthnCeremonyQuery) All(ctx context.Context) ([]*WebauthnCeremony, error) {
+	ctx = setContextOp(ctx, wcq.ctx, ent.OpQueryAll)
+	if err := wcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebauthnCeremony, *WebauthnCeremonyQuery]()
+	return withInterceptors[[]*WebauthnCeremony](ctx, wcq, qr, wcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) AllX(ctx context.Context) []*WebauthnCeremony {
+	nodes, err := wcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebauthnCeremony IDs.
+func (wcq *WebauthnCeremonyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wcq.ctx.Unique == nil && wcq.path != nil {
+		wcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wcq.ctx, ent.OpQueryIDs)
+	if err = wcq.Select(webauthnceremony.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wcq *WebauthnCeremonyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wcq.ctx, ent.OpQueryCount)
+	if err := wcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wcq, querierCount[*WebauthnCeremonyQuery](), wcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) CountX(ctx context.Context) int {
+	count, err := wcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wcq *WebauthnCeremonyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wcq.ctx, ent.OpQueryExist)
+	switch _, err := wcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wcq *WebauthnCeremonyQuery) ExistX(ctx context.Context) bool {
+	exist, err := wcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebauthnCeremonyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wcq *WebauthnCeremonyQuery) Clone() *WebauthnCeremonyQuery {
+	if wcq == nil {
+		return nil
+	}
+	return &WebauthnCeremonyQuery{
+		config:     wcq.config,
+		ctx:        wcq.ctx.Clone(),
+		order:      append([]webauthnceremony.OrderOption{}, wcq.order...),
+		inters:     append([]Interceptor{}, wcq.inters...),
+		predicates: append([]predicate.WebauthnCeremony{}, wcq.predicates...),
+		// clone intermediate query.
+		sql:  wcq.sql.Clone(),
+		path: wcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SessionID string `json:"session_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebauthnCeremony.Query().
+//		GroupBy(webauthnceremony.FieldSessionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wcq *WebauthnCeremonyQuery) GroupBy(field string, fields ...string) *WebauthnCeremonyGroupBy {
+	wcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebauthnCeremonyGroupBy{build: wcq}
+	grbuild.flds = &wcq.ctx.Fields
+	grbuild.label = webauthnceremony.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SessionID string `json:"session_id,omitempty"`
+//	}
+//
+//	client.WebauthnCeremony.Query().
+//		Select(webauthnceremony.FieldSessionID).
+//		Scan(ctx, &v)
+func (wcq *WebauthnCeremonyQuery) Select(fields ...string) *WebauthnCeremonySelect {
+	wcq.ctx.Fields = append(wcq.ctx.Fields, fields...)
+	sbuild := &WebauthnCeremonySelect{WebauthnCeremonyQuery: wcq}
+	sbuild.label = webauthnceremony.Label
+	sbuild.flds, sbuild.scan = &wcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebauthnCeremonySelect configured with the given aggregations.
+func (wcq *WebauthnCeremonyQuery) Aggregate(fns ...AggregateFunc) *WebauthnCeremonySelect {
+	return wcq.Select().Aggregate(fns...)
+}
+
+func (wcq *WebauthnCeremonyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wcq.ctx.Fields {
+		if !webauthnceremony.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wcq.path != nil {
+		prev, err := wcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wcq.sql = prev
+	}
+	return nil
+}
+
+func (wcq *WebauthnCeremonyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebauthnCeremony, error) {
+	var (
+		nodes = []*WebauthnCeremony{}
+		_spec = wcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebauthnCeremony).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebauthnCeremony{config: wcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(wcq.modifiers) > 0 {
+		_spec.Modifiers = wcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range wcq.loadTotal {
+		if err := wcq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wcq *WebauthnCeremonyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wcq.querySpec()
+	if len(wcq.modifiers) > 0 {
+		_spec.Modifiers = wcq.modifiers
+	}
+	_spec.Node.Columns = wcq.ctx.Fields
+	if len(wcq.ctx.Fields) > 0 {
+		_spec.Unique = wcq.ctx.Unique != nil && *wcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wcq.driver, _spec)
+}
+
+func (wcq *WebauthnCeremonyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webauthnceremony.Table, webauthnceremony.Columns, sqlgraph.NewFieldSpec(webauthnceremony.FieldID, field.TypeUUID))
+	_spec.From = wcq.sql
+	if unique := wcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthnceremony.FieldID)
+		for i := range fields {
+			if fields[i] != webauthnceremony.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wcq *WebauthnCeremonyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wcq.driver.Dialect())
+	t1 := builder.Table(webauthnceremony.Table)
+	columns := wcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webauthnceremony.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wcq.sql != nil {
+		selector = wcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wcq.ctx.Unique != nil && *wcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wcq.predicates {
+		p(selector)
+	}
+	for _, p := range wcq.order {
+		p(selector)
+	}
+	if offset := wcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebauthnCeremonyGroupBy is the group-by builder for WebauthnCeremony entities.
+type WebauthnCeremonyGroupBy struct {
+	selector
+	build *WebauthnCeremonyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wcgb *WebauthnCeremonyGroupBy) Aggregate(fns ...AggregateFunc) *WebauthnCeremonyGroupBy {
+	wcgb.fns = append(wcgb.fns, fns...)
+	return wcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wcgb *WebauthnCeremonyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebauthnCeremonyQuery, *WebauthnCeremonyGroupBy](ctx, wcgb.build, wcgb, wcgb.build.inters, v)
+}
+
+func (wcgb *WebauthnCeremonyGroupBy) sqlScan(ctx context.Context, root *WebauthnCeremonyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wcgb.fns))
+	for _, fn := range wcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wcgb.flds)+len(wcgb.fns))
+		for _, f := range *wcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebauthnCeremonySelect is the builder for selecting fields of WebauthnCeremony entities.
+type WebauthnCeremonySelect struct {
+	*WebauthnCeremonyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wcs *WebauthnCeremonySelect) Aggregate(fns ...AggregateFunc) *WebauthnCeremonySelect {
+	wcs.fns = append(wcs.fns, fns...)
+	return wcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wcs *WebauthnCeremonySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wcs.ctx, ent.OpQuerySelect)
+	if err := wcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebauthnCeremonyQuery, *WebauthnCeremonySelect](ctx, wcs.WebauthnCeremonyQuery, wcs, wcs.inters, v)
+}
+
+func (wcs *WebauthnCeremonySelect) sqlScan(ctx context.Context, root *WebauthnCeremonyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wcs.fns))
+	for _, fn := range wcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/webauthnceremony"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebauthnCeremonyUpdate is the builder for updating WebauthnCeremony entities.
+type WebauthnCeremonyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCeremonyMutation
+}
+
+// Where appends a list predicates to the WebauthnCeremonyUpdate builder.
+func (wcu *WebauthnCeremonyUpdate) Where(ps ...predicate.WebauthnCeremony) *WebauthnCeremonyUpdate {
+	wcu.mutation.Where(ps...)
+	return wcu
+}
+
+// Mutation returns the WebauthnCeremonyMutation object of the builder.
+func (wcu *WebauthnCeremonyUpdate) Mutation() *WebauthnCeremonyMutation {
+	return wcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wcu *WebauthnCeremonyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wcu.sqlSave, wcu.mutation, wcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcu *WebauthnCeremonyUpdate) SaveX(ctx context.Context) int {
+	affected, err := wcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wcu *WebauthnCeremonyUpdate) Exec(ctx context.Context) error {
+	_, err := wcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcu *WebauthnCeremonyUpdate) ExecX(ctx context.Context) {
+	if err := wcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wcu *WebauthnCeremonyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webauthnceremony.Table, webauthnceremony.Columns, sqlgraph.NewFieldSpec(webauthnceremony.FieldID, field.TypeUUID))
+	if ps := wcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnceremony.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wcu.mutation.done = true
+	return n, nil
+}
+
+// WebauthnCeremonyUpdateOne is the builder for updating a single WebauthnCeremony entity.
+type WebauthnCeremonyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebauthnCeremonyMutation
+}
+
+// Mutation returns the WebauthnCeremonyMutation object of the builder.
+func (wcuo *WebauthnCeremonyUpdateOne) Mutation() *WebauthnCeremonyMutation {
+	return wcuo.mutation
+}
+
+// Where appends a list predicates to the WebauthnCeremonyUpdate builder.
+func (wcuo *WebauthnCeremonyUpdateOne) Where(ps ...predicate.WebauthnCeremony) *WebauthnCeremonyUpdateOne {
+	wcuo.mutation.Where(ps...)
+	return wcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wcuo *WebauthnCeremonyUpdateOne) Select(field string, fields ...string) *WebauthnCeremonyUpdateOne {
+	wcuo.fields = append([]string{field}, fields...)
+	return wcuo
+}
+
+// Save executes the query and returns the updated WebauthnCeremony entity.
+func (wcuo *WebauthnCeremonyUpdateOne) Save(ctx context.Context) (*WebauthnCeremony, error) {
+	return withHooks(ctx, wcuo.sqlSave, wcuo.mutation, wcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcuo *WebauthnCeremonyUpdateOne) SaveX(ctx context.Context) *WebauthnCeremony {
+	node, err := wcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wcuo *WebauthnCeremonyUpdateOne) Exec(ctx context.Context) error {
+	_, err := wcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcuo *WebauthnCeremonyUpdateOne) ExecX(ctx context.Context) {
+	if err := wcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wcuo *WebauthnCeremonyUpdateOne) sqlSave(ctx context.Context) (_node *WebauthnCeremony, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webauthnceremony.Table, webauthnceremony.Columns, sqlgraph.NewFieldSpec(webauthnceremony.FieldID, field.TypeUUID))
+	id, ok := wcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebauthnCeremony.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthnceremony.FieldID)
+		for _, f := range fields {
+			if !webauthnceremony.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthnceremony.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &WebauthnCeremony{config: wcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnceremony.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wcuo.mutation.done = true
+	return _node, nil
+}
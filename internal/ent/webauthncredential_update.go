@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lms-go/internal/ent/predicate"
+	"lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthncredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebauthnCredentialUpdate is the builder for updating WebauthnCredential entities.
+type WebauthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (wcu *WebauthnCredentialUpdate) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdate {
+	wcu.mutation.Where(ps...)
+	return wcu
+}
+
+// SetUserID sets the "user_id" field.
+func (wcu *WebauthnCredentialUpdate) SetUserID(u uuid.UUID) *WebauthnCredentialUpdate {
+	wcu.mutation.SetUserID(u)
+	return wcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableUserID(u *uuid.UUID) *WebauthnCredentialUpdate {
+	if u != nil {
+		wcu.SetUserID(*u)
+	}
+	return wcu
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wcu *WebauthnCredentialUpdate) SetAttestationType(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetAttestationType(s)
+	return wcu
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableAttestationType(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetAttestationType(*s)
+	}
+	return wcu
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wcu *WebauthnCredentialUpdate) SetAaguid(b []byte) *WebauthnCredentialUpdate {
+	wcu.mutation.SetAaguid(b)
+	return wcu
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wcu *WebauthnCredentialUpdate) ClearAaguid() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearAaguid()
+	return wcu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) SetSignCount(u uint32) *WebauthnCredentialUpdate {
+	wcu.mutation.ResetSignCount()
+	wcu.mutation.SetSignCount(u)
+	return wcu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableSignCount(u *uint32) *WebauthnCredentialUpdate {
+	if u != nil {
+		wcu.SetSignCount(*u)
+	}
+	return wcu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) AddSignCount(u int32) *WebauthnCredentialUpdate {
+	wcu.mutation.AddSignCount(u)
+	return wcu
+}
+
+// SetTransports sets the "transports" field.
+func (wcu *WebauthnCredentialUpdate) SetTransports(s []string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetTransports(s)
+	return wcu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wcu *WebauthnCredentialUpdate) AppendTransports(s []string) *WebauthnCredentialUpdate {
+	wcu.mutation.AppendTransports(s)
+	return wcu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcu *WebauthnCredentialUpdate) ClearTransports() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearTransports()
+	return wcu
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupEligible(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupEligible(b)
+	return wcu
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupEligible(*b)
+	}
+	return wcu
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupState(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupState(b)
+	return wcu
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupState(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupState(*b)
+	}
+	return wcu
+}
+
+// SetName sets the "name" field.
+func (wcu *WebauthnCredentialUpdate) SetName(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetName(s)
+	return wcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableName(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetName(*s)
+	}
+	return wcu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetLastUsedAt(t)
+	return wcu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdate {
+	if t != nil {
+		wcu.SetLastUsedAt(*t)
+	}
+	return wcu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) ClearLastUsedAt() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearLastUsedAt()
+	return wcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) SetUser(u *User) *WebauthnCredentialUpdate {
+	return wcu.SetUserID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcu *WebauthnCredentialUpdate) Mutation() *WebauthnCredentialMutation {
+	return wcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) ClearUser() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearUser()
+	return wcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wcu *WebauthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wcu.sqlSave, wcu.mutation, wcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := wcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wcu *WebauthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := wcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := wcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcu *WebauthnCredentialUpdate) check() error {
+	if wcu.mutation.UserCleared() && len(wcu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.user"`)
+	}
+	return nil
+}
+
+func (wcu *WebauthnCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID))
+	if ps := wcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcu.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if value, ok := wcu.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if wcu.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := wcu.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wcu.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wcu.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wcu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wcu.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wcu.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := wcu.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wcu.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := wcu.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wcu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if wcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wcu.mutation.done = true
+	return n, nil
+}
+
+// WebauthnCredentialUpdateOne is the builder for updating a single WebauthnCredential entity.
+type WebauthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetUserID(u uuid.UUID) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetUserID(u)
+	return wcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableUserID(u *uuid.UUID) *WebauthnCredentialUpdateOne {
+	if u != nil {
+		wcuo.SetUserID(*u)
+	}
+	return wcuo
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetAttestationType(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetAttestationType(s)
+	return wcuo
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableAttestationType(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetAttestationType(*s)
+	}
+	return wcuo
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetAaguid(b []byte) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetAaguid(b)
+	return wcuo
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearAaguid() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearAaguid()
+	return wcuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetSignCount(u uint32) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ResetSignCount()
+	wcuo.mutation.SetSignCount(u)
+	return wcuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableSignCount(u *uint32) *WebauthnCredentialUpdateOne {
+	if u != nil {
+		wcuo.SetSignCount(*u)
+	}
+	return wcuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) AddSignCount(u int32) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.AddSignCount(u)
+	return wcuo
+}
+
+// SetTransports sets the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetTransports(s []string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetTransports(s)
+	return wcuo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) AppendTransports(s []string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.AppendTransports(s)
+	return wcuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearTransports() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearTransports()
+	return wcuo
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupEligible(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupEligible(b)
+	return wcuo
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupEligible(*b)
+	}
+	return wcuo
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupState(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupState(b)
+	return wcuo
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupState(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupState(*b)
+	}
+	return wcuo
+}
+
+// SetName sets the "name" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetName(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetName(s)
+	return wcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableName(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetName(*s)
+	}
+	return wcuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetLastUsedAt(t)
+	return wcuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdateOne {
+	if t != nil {
+		wcuo.SetLastUsedAt(*t)
+	}
+	return wcuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearLastUsedAt() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearLastUsedAt()
+	return wcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) SetUser(u *User) *WebauthnCredentialUpdateOne {
+	return wcuo.SetUserID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcuo *WebauthnCredentialUpdateOne) Mutation() *WebauthnCredentialMutation {
+	return wcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) ClearUser() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearUser()
+	return wcuo
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (wcuo *WebauthnCredentialUpdateOne) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.Where(ps...)
+	return wcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wcuo *WebauthnCredentialUpdateOne) Select(field string, fields ...string) *WebauthnCredentialUpdateOne {
+	wcuo.fields = append([]string{field}, fields...)
+	return wcuo
+}
+
+// Save executes the query and returns the updated WebauthnCredential entity.
+func (wcuo *WebauthnCredentialUpdateOne) Save(ctx context.Context) (*WebauthnCredential, error) {
+	return withHooks(ctx, wcuo.sqlSave, wcuo.mutation, wcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) SaveX(ctx context.Context) *WebauthnCredential {
+	node, err := wcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wcuo *WebauthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := wcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := wcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcuo *WebauthnCredentialUpdateOne) check() error {
+	if wcuo.mutation.UserCleared() && len(wcuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.user"`)
+	}
+	return nil
+}
+
+func (wcuo *WebauthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebauthnCredential, err error) {
+	if err := wcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID))
+	id, ok := wcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebauthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcuo.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if value, ok := wcuo.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if wcuo.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := wcuo.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wcuo.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wcuo.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wcuo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wcuo.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wcuo.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := wcuo.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wcuo.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := wcuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wcuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if wcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebauthnCredential{config: wcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"fmt"
+)
+
+// Taille de page des connexions.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageSize renvoie la taille demandée, pour la pagination comme pour le calcul de complexité.
+func pageSize(first, last *int) int {
+	switch {
+	case first != nil:
+		return *first
+	case last != nil:
+		return *last
+	}
+	return defaultPageSize
+}
+
+// pageBounds borne first et last ; sans l'un ni l'autre, la première page a la taille par
+// défaut. La combinaison first et last est refusée par entgql.
+func pageBounds(first, last *int) (*int, error) {
+	if (first != nil && *first > maxPageSize) || (last != nil && *last > maxPageSize) {
+		return nil, newError(CodeBadUserInput, fmt.Sprintf("au plus %d éléments par page", maxPageSize))
+	}
+	if first == nil && last == nil {
+		n := defaultPageSize
+		return &n, nil
+	}
+	return first, nil
+}
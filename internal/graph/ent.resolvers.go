@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"lms-go/internal/ent"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+)
+
+// Modules is the resolver for the modules field.
+func (r *courseResolver) Modules(ctx context.Context, obj *ent.Course) ([]*ent.Module, error) {
+	modules, err := obj.Modules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(modules, func(a, b *ent.Module) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), compareIDs(a.ID, b.ID))
+	})
+	return modules, nil
+}
+
+// ModuleProgress is the resolver for the moduleProgress field.
+func (r *enrollmentResolver) ModuleProgress(ctx context.Context, obj *ent.Enrollment) ([]*ent.ModuleProgress, error) {
+	entries, err := obj.ProgressEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b *ent.ModuleProgress) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	return entries, nil
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	return r.node(ctx, id)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
+	nodes := make([]ent.Noder, len(ids))
+	for i, id := range ids {
+		n, err := r.node(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = n
+	}
+	return nodes, nil
+}
+
+// Courses is the resolver for the courses field.
+func (r *queryResolver) Courses(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.CourseOrder, where *ent.CourseWhereInput) (*ent.CourseConnection, error) {
+	first, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ent.CourseOrder{Direction: entgql.OrderDirectionAsc, Field: ent.CourseOrderFieldCreatedAt}
+	}
+	return r.client.Course.Query().Paginate(ctx, after, first, before, last,
+		ent.WithCourseOrder(orderBy), ent.WithCourseFilter(where.Filter))
+}
+
+// Enrollments is the resolver for the enrollments field.
+func (r *queryResolver) Enrollments(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.EnrollmentOrder, where *ent.EnrollmentWhereInput) (*ent.EnrollmentConnection, error) {
+	first, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ent.EnrollmentOrder{Direction: entgql.OrderDirectionAsc, Field: ent.EnrollmentOrderFieldCreatedAt}
+	}
+	return r.client.Enrollment.Query().Paginate(ctx, after, first, before, last,
+		ent.WithEnrollmentOrder(orderBy), ent.WithEnrollmentFilter(where.Filter))
+}
+
+// Groups is the resolver for the groups field.
+func (r *queryResolver) Groups(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.GroupOrder, where *ent.GroupWhereInput) (*ent.GroupConnection, error) {
+	first, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ent.GroupOrder{Direction: entgql.OrderDirectionAsc, Field: ent.GroupOrderFieldCreatedAt}
+	}
+	return r.client.Group.Query().Paginate(ctx, after, first, before, last,
+		ent.WithGroupOrder(orderBy), ent.WithGroupFilter(where.Filter))
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
+	first, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ent.UserOrder{Direction: entgql.OrderDirectionAsc, Field: ent.UserOrderFieldCreatedAt}
+	}
+	return r.client.User.Query().Paginate(ctx, after, first, before, last,
+		ent.WithUserOrder(orderBy), ent.WithUserFilter(where.Filter))
+}
+
+// Enrollments is the resolver for the enrollments field.
+func (r *userResolver) Enrollments(ctx context.Context, obj *ent.User) ([]*ent.Enrollment, error) {
+	enrollments, err := obj.Enrollments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(enrollments, func(a, b *ent.Enrollment) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	return enrollments, nil
+}
+
+// Course returns CourseResolver implementation.
+func (r *Resolver) Course() CourseResolver { return &courseResolver{r} }
+
+// Enrollment returns EnrollmentResolver implementation.
+func (r *Resolver) Enrollment() EnrollmentResolver { return &enrollmentResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type courseResolver struct{ *Resolver }
+type enrollmentResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/progress"
+)
+
+// Codes d'erreur publiés dans extensions.code. Les erreurs d'analyse et de validation portent
+// ceux de gqlgen (GRAPHQL_PARSE_FAILED, GRAPHQL_VALIDATION_FAILED).
+const (
+	CodeValidationFailed   = errcode.ValidationFailed
+	CodeQueryTooComplex    = "QUERY_TOO_COMPLEX"
+	CodeBadUserInput       = "BAD_USER_INPUT"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodeFailedPrecondition = "FAILED_PRECONDITION"
+	CodeInternal           = "INTERNAL_SERVER_ERROR"
+)
+
+// newError crée une erreur publiable portant le code donné.
+func newError(code, message string) *gqlerror.Error {
+	return &gqlerror.Error{Message: message, Extensions: map[string]any{"code": code}}
+}
+
+func notFound(message string) error {
+	return newError(CodeNotFound, message)
+}
+
+// publish traduit les erreurs des services en erreurs GraphQL publiables ; les autres restent
+// internes.
+func publish(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, course.ErrInvalidInput),
+		errors.Is(err, enrollment.ErrInvalidInput),
+		errors.Is(err, progress.ErrInvalidInput):
+		return newError(CodeBadUserInput, "données invalides")
+	case errors.Is(err, course.ErrNotFound):
+		return notFound("cours ou module introuvable")
+	case errors.Is(err, enrollment.ErrNotFound):
+		return notFound("inscription, cours ou utilisateur introuvable")
+	case errors.Is(err, progress.ErrNotFound):
+		return notFound("inscription ou module introuvable")
+	case errors.Is(err, course.ErrSlugTaken):
+		return newError(CodeConflict, "slug déjà utilisé")
+	case errors.Is(err, enrollment.ErrAlreadyEnrolled):
+		return newError(CodeConflict, "utilisateur déjà inscrit")
+	case errors.Is(err, enrollment.ErrGroupConflict):
+		return newError(CodeConflict, "groupe en conflit")
+	case errors.Is(err, course.ErrVersionMismatch):
+		return newError(CodeFailedPrecondition, "cours ou module modifié entre-temps")
+	case errors.Is(err, progress.ErrBlocked):
+		return newError(CodeFailedPrecondition, "modules précédents non complétés")
+	}
+	return err
+}
+
+// presentError publie les *gqlerror.Error ; les autres erreurs sont journalisées et masquées.
+// Les erreurs sans code viennent de gqlgen ou d'entgql (argument ou curseur invalide) et relèvent
+// de l'appelant.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		slog.ErrorContext(ctx, "graphql resolver error", "path", graphql.GetPath(ctx).String(), "error", err)
+		gqlErr = newError(CodeInternal, "erreur interne")
+	}
+	if gqlErr.Path == nil {
+		gqlErr.Path = graphql.GetPath(ctx)
+	}
+	if _, ok := gqlErr.Extensions["code"]; !ok {
+		errcode.Set(gqlErr, CodeBadUserInput)
+	}
+	return gqlErr
+}
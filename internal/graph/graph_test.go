@@ -0,0 +1,360 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/principal"
+	"lms-go/internal/progress"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/tenant"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+// countingDriver compte les requêtes de lecture pour vérifier le chargement par lot.
+type countingDriver struct {
+	dialect.Driver
+	queries int
+}
+
+func (d *countingDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.queries++
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+type fixture struct {
+	schema  *Schema
+	client  *ent.Client
+	driver  *countingDriver
+	orgID   uuid.UUID
+	admin   *ent.User
+	tutor   *ent.User
+	learner *ent.User
+	other   *ent.User
+	// published a deux modules (le second archivé) ; draft est un brouillon.
+	published, draft *ent.Course
+	modules          []*ent.Module
+	enrollment       *ent.Enrollment
+	otherEnrollment  *ent.Enrollment
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name()))
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	driver := &countingDriver{Driver: entsql.OpenDB(dialect.SQLite, db)}
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	f := &fixture{client: client, driver: driver}
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+	f.orgID = org.ID
+	newUser := func(email, role string) *ent.User {
+		u, err := client.User.Create().SetOrganizationID(org.ID).SetEmail(email).SetPasswordHash("x").SetRole(role).Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	f.admin = newUser("admin@example.com", "admin")
+	f.tutor = newUser("tutor@example.com", "tutor")
+	f.learner = newUser("learner@example.com", "learner")
+	f.other = newUser("other@example.com", "learner")
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.published, err = client.Course.Create().SetOrganizationID(org.ID).SetTitle("Sécurité").SetSlug("securite").
+		SetStatus(course.StatusPublished).SetCreatedAt(base).Save(ctx)
+	require.NoError(t, err)
+	f.draft, err = client.Course.Create().SetOrganizationID(org.ID).SetTitle("Brouillon").SetSlug("brouillon").
+		SetCreatedAt(base.Add(time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	for i, status := range []string{"active", "archived"} {
+		m, err := client.Module.Create().SetCourseID(f.published.ID).SetTitle(fmt.Sprintf("Module %d", i+1)).
+			SetModuleType("article").SetPosition(i).SetStatus(status).Save(ctx)
+		require.NoError(t, err)
+		f.modules = append(f.modules, m)
+	}
+	_, err = client.Module.Create().SetCourseID(f.draft.ID).SetTitle("Ébauche").SetModuleType("article").Save(ctx)
+	require.NoError(t, err)
+
+	f.enrollment, err = client.Enrollment.Create().SetOrganizationID(org.ID).SetCourseID(f.published.ID).
+		SetUserID(f.learner.ID).SetStatus(enrollment.StatusActive).Save(ctx)
+	require.NoError(t, err)
+	f.otherEnrollment, err = client.Enrollment.Create().SetOrganizationID(org.ID).SetCourseID(f.published.ID).
+		SetUserID(f.other.ID).SetStatus(enrollment.StatusActive).Save(ctx)
+	require.NoError(t, err)
+
+	f.schema = NewSchema(client, course.NewService(client), enrollment.NewService(client), progress.NewService(client))
+	return f
+}
+
+func (f *fixture) as(u *ent.User) context.Context {
+	ctx := tenant.WithOrganization(context.Background(), f.orgID)
+	return principal.WithPrincipal(ctx, principal.Principal{Kind: principal.KindUser, ID: u.ID, OrganizationID: f.orgID, Role: u.Role})
+}
+
+func (f *fixture) asServiceAccount(scopes ...string) context.Context {
+	ctx := tenant.WithOrganization(context.Background(), f.orgID)
+	return principal.WithPrincipal(ctx, principal.Principal{Kind: principal.KindServiceAccount, ID: uuid.New(), OrganizationID: f.orgID, Scopes: scopes})
+}
+
+// run exécute la requête et renvoie data et les codes d'erreur.
+func (f *fixture) run(t *testing.T, ctx context.Context, query string, vars map[string]any) (map[string]any, []string) {
+	t.Helper()
+	resp := f.schema.Execute(ctx, Request{Query: query, Variables: vars}, Options{MaxDepth: 10, MaxComplexity: 5000})
+	body, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var out struct {
+		Data   map[string]any `json:"data"`
+		Errors []struct {
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(body, &out), string(body))
+	var codes []string
+	for _, e := range out.Errors {
+		codes = append(codes, e.Extensions.Code)
+	}
+	return out.Data, codes
+}
+
+func titles(t *testing.T, conn any) []string {
+	t.Helper()
+	var out []string
+	for _, edge := range conn.(map[string]any)["edges"].([]any) {
+		out = append(out, edge.(map[string]any)["node"].(map[string]any)["title"].(string))
+	}
+	return out
+}
+
+func TestQueryVisibility(t *testing.T) {
+	f := newFixture(t)
+	const query = `{
+		courses { totalCount edges { node { title modules { title } } } }
+		enrollments { totalCount }
+		users { totalCount }
+		viewer { email }
+	}`
+
+	data, errs := f.run(t, f.as(f.admin), query, nil)
+	require.Empty(t, errs)
+	require.Equal(t, []string{"Sécurité", "Brouillon"}, titles(t, data["courses"]))
+	require.EqualValues(t, 2, data["enrollments"].(map[string]any)["totalCount"])
+	require.EqualValues(t, 4, data["users"].(map[string]any)["totalCount"])
+	require.Equal(t, "admin@example.com", data["viewer"].(map[string]any)["email"])
+
+	// Un apprenant ne voit que le catalogue publié, les modules actifs de ses cours, ses
+	// inscriptions et lui-même.
+	data, errs = f.run(t, f.as(f.learner), query, nil)
+	require.Empty(t, errs)
+	require.Equal(t, []string{"Sécurité"}, titles(t, data["courses"]))
+	node := data["courses"].(map[string]any)["edges"].([]any)[0].(map[string]any)["node"].(map[string]any)
+	require.Equal(t, []any{map[string]any{"title": "Module 1"}}, node["modules"])
+	require.EqualValues(t, 1, data["enrollments"].(map[string]any)["totalCount"])
+	require.EqualValues(t, 1, data["users"].(map[string]any)["totalCount"])
+
+	// Sans inscription active, les modules restent invisibles.
+	_, err := f.client.Enrollment.UpdateOne(f.enrollment).SetStatus(enrollment.StatusCancelled).Save(context.Background())
+	require.NoError(t, err)
+	data, errs = f.run(t, f.as(f.learner), `{ courses { edges { node { modules { title } } } } }`, nil)
+	require.Empty(t, errs)
+	node = data["courses"].(map[string]any)["edges"].([]any)[0].(map[string]any)["node"].(map[string]any)
+	require.Equal(t, []any{}, node["modules"])
+
+	// node et nodes appliquent les mêmes règles.
+	vars := map[string]any{"own": f.enrollment.ID.String(), "foreign": f.otherEnrollment.ID.String(), "draft": f.draft.ID.String()}
+	data, errs = f.run(t, f.as(f.learner), `query($own: ID!, $foreign: ID!, $draft: ID!) {
+		own: node(id: $own) { __typename id }
+		foreign: node(id: $foreign) { id }
+		nodes(ids: [$draft, $own]) { id }
+	}`, vars)
+	require.Empty(t, errs)
+	require.Equal(t, map[string]any{"__typename": "Enrollment", "id": f.enrollment.ID.String()}, data["own"])
+	require.Nil(t, data["foreign"])
+	require.Equal(t, []any{nil, map[string]any{"id": f.enrollment.ID.String()}}, data["nodes"])
+
+	// Un tuteur voit toutes les inscriptions mais pas les brouillons.
+	data, errs = f.run(t, f.as(f.tutor), query, nil)
+	require.Empty(t, errs)
+	require.Equal(t, []string{"Sécurité"}, titles(t, data["courses"]))
+	require.EqualValues(t, 2, data["enrollments"].(map[string]any)["totalCount"])
+}
+
+func TestQueryServiceAccountScopes(t *testing.T) {
+	f := newFixture(t)
+	data, errs := f.run(t, f.asServiceAccount(serviceaccount.ScopeCoursesRead), `{
+		courses { totalCount }
+		users { totalCount }
+		viewer { id }
+	}`, nil)
+	require.Equal(t, []string{CodeForbidden}, errs)
+	require.EqualValues(t, 2, data["courses"].(map[string]any)["totalCount"])
+	require.Nil(t, data["users"])
+	require.Nil(t, data["viewer"])
+
+	// Une autre organisation est refusée, même avec une clé valide.
+	ctx := tenant.WithOrganization(f.asServiceAccount(serviceaccount.ScopeCoursesRead), uuid.New())
+	_, errs = f.run(t, ctx, `{ courses { totalCount } }`, nil)
+	require.Equal(t, []string{CodeForbidden}, errs)
+}
+
+func TestQueryPagination(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		_, err := f.client.Course.Create().SetOrganizationID(f.orgID).SetTitle(fmt.Sprintf("Cours %d", i)).
+			SetSlug(fmt.Sprintf("cours-%d", i)).SetCreatedAt(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)).Save(ctx)
+		require.NoError(t, err)
+	}
+	const query = `query($first: Int, $after: Cursor, $last: Int, $before: Cursor) {
+		courses(first: $first, after: $after, last: $last, before: $before, orderBy: {field: TITLE, direction: DESC}) {
+			totalCount
+			edges { node { title } }
+			pageInfo { hasNextPage hasPreviousPage startCursor endCursor }
+		}
+	}`
+
+	var seen []string
+	after := any(nil)
+	for {
+		data, errs := f.run(t, f.as(f.admin), query, map[string]any{"first": 3, "after": after})
+		require.Empty(t, errs)
+		conn := data["courses"].(map[string]any)
+		require.EqualValues(t, 7, conn["totalCount"])
+		seen = append(seen, titles(t, conn)...)
+		info := conn["pageInfo"].(map[string]any)
+		require.Equal(t, after != nil, info["hasPreviousPage"])
+		if !info["hasNextPage"].(bool) {
+			break
+		}
+		after = info["endCursor"]
+	}
+	require.Equal(t, []string{"Sécurité", "Cours 4", "Cours 3", "Cours 2", "Cours 1", "Cours 0", "Brouillon"}, seen)
+
+	data, errs := f.run(t, f.as(f.admin), query, map[string]any{"last": 2})
+	require.Empty(t, errs)
+	conn := data["courses"].(map[string]any)
+	require.Equal(t, []string{"Cours 0", "Brouillon"}, titles(t, conn))
+	info := conn["pageInfo"].(map[string]any)
+	require.Equal(t, true, info["hasPreviousPage"])
+
+	data, errs = f.run(t, f.as(f.admin), query, map[string]any{"last": 2, "before": info["startCursor"]})
+	require.Empty(t, errs)
+	require.Equal(t, []string{"Cours 2", "Cours 1"}, titles(t, data["courses"]))
+
+	_, errs = f.run(t, f.as(f.admin), query, map[string]any{"first": 1, "last": 1})
+	require.Equal(t, []string{CodeBadUserInput}, errs)
+	_, errs = f.run(t, f.as(f.admin), query, map[string]any{"after": "pas-un-curseur"})
+	require.Equal(t, []string{CodeBadUserInput}, errs)
+}
+
+func TestQueryBatchesEdges(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		u, err := f.client.User.Create().SetOrganizationID(f.orgID).SetEmail(fmt.Sprintf("u%d@example.com", i)).SetPasswordHash("x").Save(ctx)
+		require.NoError(t, err)
+		_, err = f.client.Enrollment.Create().SetOrganizationID(f.orgID).SetCourseID(f.published.ID).SetUserID(u.ID).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	f.driver.queries = 0
+	data, errs := f.run(t, f.as(f.admin), `{
+		enrollments(first: 50) {
+			edges { node { user { email } course { title modules { course { slug } } } moduleProgress { status } } }
+		}
+	}`, nil)
+	require.Empty(t, errs)
+	require.Len(t, data["enrollments"].(map[string]any)["edges"], 12)
+	// Une requête pour la page, puis une par arête : user, course, moduleProgress, modules et
+	// modules.course, quel que soit le nombre d'inscriptions.
+	require.Equal(t, 6, f.driver.queries)
+}
+
+func TestQueryLimits(t *testing.T) {
+	f := newFixture(t)
+	resp := f.schema.Execute(f.as(f.admin), Request{Query: `{ courses(first: 100) { edges { node { modules { title } } } } }`},
+		Options{MaxDepth: 10, MaxComplexity: 1000})
+	require.True(t, resp.Rejected())
+	require.Equal(t, CodeQueryTooComplex, resp.Errors[0].Extensions["code"])
+}
+
+func TestMutations(t *testing.T) {
+	f := newFixture(t)
+
+	// Le catalogue est réservé aux administrateurs et concepteurs.
+	const create = `mutation($input: CreateCourseInput!) { createCourse(input: $input) { id slug status } }`
+	input := map[string]any{"input": map[string]any{"title": "Réseaux", "slug": "reseaux", "metadata": map[string]any{"level": 2}}}
+	data, errs := f.run(t, f.as(f.tutor), create, input)
+	require.Equal(t, []string{CodeForbidden}, errs)
+	require.Nil(t, data["createCourse"])
+
+	data, errs = f.run(t, f.as(f.admin), create, input)
+	require.Empty(t, errs)
+	created := data["createCourse"].(map[string]any)
+	require.Equal(t, "DRAFT", created["status"])
+	_, errs = f.run(t, f.as(f.admin), create, input)
+	require.Equal(t, []string{CodeConflict}, errs)
+
+	data, errs = f.run(t, f.asServiceAccount(serviceaccount.ScopeCoursesWrite), `mutation($id: ID!) {
+		addModule(courseId: $id, input: {title: "Intro", moduleType: "article", durationSeconds: 60}) { position course { slug } }
+		publishCourse(id: $id) { status publishedAt }
+	}`, map[string]any{"id": created["id"]})
+	require.Equal(t, []string{CodeForbidden}, errs, "course { slug } exige courses:read")
+	require.Equal(t, map[string]any{"position": float64(0), "course": nil}, data["addModule"])
+	require.Equal(t, "PUBLISHED", data["publishCourse"].(map[string]any)["status"])
+
+	// expectedVersion refuse une modification fondée sur une version dépassée.
+	const rename = `mutation($id: ID!, $version: Int) { updateCourse(id: $id, input: {title: "Réseaux 2", expectedVersion: $version}) { version } }`
+	_, errs = f.run(t, f.as(f.admin), rename, map[string]any{"id": created["id"], "version": 1})
+	require.Equal(t, []string{CodeFailedPrecondition}, errs)
+	data, errs = f.run(t, f.as(f.admin), rename, map[string]any{"id": created["id"], "version": 3})
+	require.Empty(t, errs)
+	require.Equal(t, map[string]any{"version": float64(4)}, data["updateCourse"])
+
+	// Les inscriptions relèvent de l'équipe pédagogique.
+	const enroll = `mutation($course: ID!, $user: ID!) { enroll(input: {courseId: $course, userId: $user}) { status user { email } } }`
+	vars := map[string]any{"course": created["id"], "user": f.learner.ID.String()}
+	_, errs = f.run(t, f.as(f.learner), enroll, vars)
+	require.Equal(t, []string{CodeForbidden}, errs)
+	data, errs = f.run(t, f.as(f.tutor), enroll, vars)
+	require.Empty(t, errs)
+	require.Equal(t, "learner@example.com", data["enroll"].(map[string]any)["user"].(map[string]any)["email"])
+	_, errs = f.run(t, f.as(f.tutor), enroll, vars)
+	require.Equal(t, []string{CodeConflict}, errs)
+
+	// L'apprenant fait progresser sa propre inscription, pas celle des autres.
+	const complete = `mutation($enrollment: ID!, $module: ID!) {
+		completeModule(enrollmentId: $enrollment, moduleId: $module, score: 0.8) { status score module { title } }
+	}`
+	data, errs = f.run(t, f.as(f.learner), complete, map[string]any{"enrollment": f.enrollment.ID.String(), "module": f.modules[0].ID.String()})
+	require.Empty(t, errs)
+	require.Equal(t, map[string]any{"status": "COMPLETED", "score": 0.8, "module": map[string]any{"title": "Module 1"}}, data["completeModule"])
+	_, errs = f.run(t, f.as(f.learner), complete, map[string]any{"enrollment": f.otherEnrollment.ID.String(), "module": f.modules[0].ID.String()})
+	require.Equal(t, []string{CodeNotFound}, errs)
+
+	data, errs = f.run(t, f.as(f.admin), `mutation($id: ID!) { cancelEnrollment(id: $id) { status } }`, map[string]any{"id": f.otherEnrollment.ID.String()})
+	require.Empty(t, errs)
+	require.Equal(t, map[string]any{"status": "CANCELLED"}, data["cancelEnrollment"])
+}
@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"context"
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	entcourse "lms-go/internal/ent/course"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entuser "lms-go/internal/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// CreateCourse is the resolver for the createCourse field.
+func (r *mutationResolver) CreateCourse(ctx context.Context, input CreateCourseInput) (*ent.Course, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.courses.Create(ctx, course.CreateCourseInput{
+		OrganizationID: v.orgID,
+		Title:          input.Title,
+		Slug:           deref(input.Slug),
+		Description:    deref(input.Description),
+		Metadata:       input.Metadata,
+	})
+	return created, publish(err)
+}
+
+// UpdateCourse is the resolver for the updateCourse field.
+func (r *mutationResolver) UpdateCourse(ctx context.Context, id uuid.UUID, input UpdateCourseInput) (*ent.Course, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.courses.Update(ctx, v.orgID, id, course.UpdateCourseInput{
+		Title:       input.Title,
+		Description: input.Description,
+		Metadata:    input.Metadata,
+		IfMatch:     ifMatch(input.ExpectedVersion),
+	})
+	return updated, publish(err)
+}
+
+// PublishCourse is the resolver for the publishCourse field.
+func (r *mutationResolver) PublishCourse(ctx context.Context, id uuid.UUID, expectedVersion *int) (*ent.Course, error) {
+	return r.setCourseStatus(ctx, id, expectedVersion, r.courses.Publish)
+}
+
+// UnpublishCourse is the resolver for the unpublishCourse field.
+func (r *mutationResolver) UnpublishCourse(ctx context.Context, id uuid.UUID, expectedVersion *int) (*ent.Course, error) {
+	return r.setCourseStatus(ctx, id, expectedVersion, r.courses.Unpublish)
+}
+
+// ArchiveCourse is the resolver for the archiveCourse field.
+func (r *mutationResolver) ArchiveCourse(ctx context.Context, id uuid.UUID, expectedVersion *int) (*ent.Course, error) {
+	return r.setCourseStatus(ctx, id, expectedVersion, r.courses.Archive)
+}
+
+// AddModule is the resolver for the addModule field.
+func (r *mutationResolver) AddModule(ctx context.Context, courseID uuid.UUID, input ModuleInput) (*ent.Module, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.courses.AddModule(ctx, v.orgID, courseID, moduleFromInput(input))
+	return created, publish(err)
+}
+
+// UpdateModule is the resolver for the updateModule field.
+func (r *mutationResolver) UpdateModule(ctx context.Context, id uuid.UUID, input ModuleInput) (*ent.Module, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.courses.UpdateModule(ctx, v.orgID, id, moduleFromInput(input))
+	return updated, publish(err)
+}
+
+// ReorderModules is the resolver for the reorderModules field.
+func (r *mutationResolver) ReorderModules(ctx context.Context, courseID uuid.UUID, moduleIds []uuid.UUID, expectedVersion *int) (*ent.Course, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.courses.ReorderModules(ctx, v.orgID, courseID, moduleIds, ifMatch(expectedVersion)...); err != nil {
+		return nil, publish(err)
+	}
+	reordered, err := r.courses.Get(ctx, v.orgID, courseID)
+	return reordered, publish(err)
+}
+
+// RemoveModule is the resolver for the removeModule field.
+func (r *mutationResolver) RemoveModule(ctx context.Context, id uuid.UUID, expectedVersion *int) (*uuid.UUID, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.courses.RemoveModule(ctx, v.orgID, id, ifMatch(expectedVersion)...); err != nil {
+		return nil, publish(err)
+	}
+	return &id, nil
+}
+
+// Enroll is the resolver for the enroll field.
+func (r *mutationResolver) Enroll(ctx context.Context, input EnrollInput) (*ent.Enrollment, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.enrollments.Enroll(ctx, enrollment.EnrollInput{
+		OrganizationID: v.orgID,
+		CourseID:       input.CourseID,
+		UserID:         input.UserID,
+		GroupID:        input.GroupID,
+		Metadata:       input.Metadata,
+	})
+	return created, publish(err)
+}
+
+// UpdateEnrollment is the resolver for the updateEnrollment field.
+func (r *mutationResolver) UpdateEnrollment(ctx context.Context, id uuid.UUID, input UpdateEnrollmentInput) (*ent.Enrollment, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	update := enrollment.UpdateInput{Status: input.Status, GroupID: input.GroupID, Metadata: input.Metadata}
+	if input.Progress != nil {
+		p := float32(*input.Progress)
+		update.Progress = &p
+	}
+	updated, err := r.enrollments.Update(ctx, v.orgID, id, update)
+	return updated, publish(err)
+}
+
+// CancelEnrollment is the resolver for the cancelEnrollment field.
+func (r *mutationResolver) CancelEnrollment(ctx context.Context, id uuid.UUID) (*ent.Enrollment, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.enrollments.Cancel(ctx, v.orgID, id); err != nil {
+		return nil, publish(err)
+	}
+	return r.client.Enrollment.Query().
+		Where(entenrollment.IDEQ(id), entenrollment.OrganizationIDEQ(v.orgID)).
+		Only(ctx)
+}
+
+// StartModule is the resolver for the startModule field.
+func (r *mutationResolver) StartModule(ctx context.Context, enrollmentID uuid.UUID, moduleID uuid.UUID) (*ent.ModuleProgress, error) {
+	orgID, err := r.progressTarget(ctx, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+	started, err := r.progress.Start(ctx, orgID, enrollmentID, moduleID)
+	return started, publish(err)
+}
+
+// CompleteModule is the resolver for the completeModule field.
+func (r *mutationResolver) CompleteModule(ctx context.Context, enrollmentID uuid.UUID, moduleID uuid.UUID, score *float64) (*ent.ModuleProgress, error) {
+	orgID, err := r.progressTarget(ctx, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+	var s *float32
+	if score != nil {
+		value := float32(*score)
+		s = &value
+	}
+	completed, err := r.progress.Complete(ctx, orgID, enrollmentID, moduleID, s)
+	return completed, publish(err)
+}
+
+// Viewer is the resolver for the viewer field.
+func (r *queryResolver) Viewer(ctx context.Context) (*ent.User, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil || v.principal.IsServiceAccount() {
+		return nil, err
+	}
+	u, err := r.client.User.Query().Where(entuser.ID(v.principal.ID)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return onlyVisible(u.Only(ctx))
+}
+
+// Course is the resolver for the course field.
+func (r *queryResolver) Course(ctx context.Context, id uuid.UUID) (*ent.Course, error) {
+	q, err := r.client.Course.Query().Where(entcourse.ID(id)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return onlyVisible(q.Only(ctx))
+}
+
+// Enrollment is the resolver for the enrollment field.
+func (r *queryResolver) Enrollment(ctx context.Context, id uuid.UUID) (*ent.Enrollment, error) {
+	q, err := r.client.Enrollment.Query().Where(entenrollment.ID(id)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return onlyVisible(q.Only(ctx))
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
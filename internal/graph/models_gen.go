@@ -0,0 +1,48 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateCourseInput struct {
+	Title string `json:"title"`
+	// Déduit du titre s'il est omis.
+	Slug        *string        `json:"slug,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+}
+
+type EnrollInput struct {
+	CourseID uuid.UUID      `json:"courseId"`
+	UserID   uuid.UUID      `json:"userId"`
+	GroupID  *uuid.UUID     `json:"groupId,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+type ModuleInput struct {
+	Title      string     `json:"title"`
+	ModuleType string     `json:"moduleType"`
+	ContentID  *uuid.UUID `json:"contentId,omitempty"`
+	// Révision épinglée du contenu ; la révision courante si omise.
+	ContentRevision *int           `json:"contentRevision,omitempty"`
+	DurationSeconds *int           `json:"durationSeconds,omitempty"`
+	Data            map[string]any `json:"data,omitempty"`
+	// Version attendue du module, à la mise à jour ; ignorée à l'ajout.
+	ExpectedVersion *int `json:"expectedVersion,omitempty"`
+}
+
+type UpdateCourseInput struct {
+	Title           *string        `json:"title,omitempty"`
+	Description     *string        `json:"description,omitempty"`
+	Metadata        map[string]any `json:"metadata,omitempty"`
+	ExpectedVersion *int           `json:"expectedVersion,omitempty"`
+}
+
+type UpdateEnrollmentInput struct {
+	Status   *string        `json:"status,omitempty"`
+	Progress *float64       `json:"progress,omitempty"`
+	GroupID  *uuid.UUID     `json:"groupId,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/course"
+	"lms-go/internal/ent"
+	entenrollment "lms-go/internal/ent/enrollment"
+)
+
+// Les mutations délèguent aux services du domaine, qui portent les règles métier ; le graphe
+// n'ajoute que le contrôle des droits de l'appelant (voir authorize et mutationRights).
+
+func (r *mutationResolver) setCourseStatus(ctx context.Context, id uuid.UUID, expectedVersion *int, set func(context.Context, uuid.UUID, uuid.UUID, ...int) (*ent.Course, error)) (*ent.Course, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := set(ctx, v.orgID, id, ifMatch(expectedVersion)...)
+	return updated, publish(err)
+}
+
+// ifMatch traduit l'argument expectedVersion en versions attendues par les services.
+func ifMatch(expectedVersion *int) []int {
+	if expectedVersion == nil {
+		return nil
+	}
+	return []int{*expectedVersion}
+}
+
+func moduleFromInput(input ModuleInput) course.ModuleInput {
+	m := course.ModuleInput{
+		Title:           input.Title,
+		ModuleType:      input.ModuleType,
+		ContentID:       input.ContentID,
+		ContentRevision: input.ContentRevision,
+		Data:            input.Data,
+		IfMatch:         ifMatch(input.ExpectedVersion),
+	}
+	if input.DurationSeconds != nil {
+		m.DurationSecs = *input.DurationSeconds
+	}
+	return m
+}
+
+// progressTarget contrôle l'accès à la progression d'une inscription et renvoie l'organisation :
+// l'équipe pédagogique et les comptes de service autorisés, ou l'apprenant titulaire. Une
+// inscription d'autrui est signalée introuvable.
+func (r *mutationResolver) progressTarget(ctx context.Context, enrollmentID uuid.UUID) (uuid.UUID, error) {
+	v, err := viewerFrom(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := v.canEditEnrollments(); err != nil {
+		if v.principal.IsServiceAccount() {
+			return uuid.Nil, err
+		}
+		owned, err := r.client.Enrollment.Query().
+			Where(
+				entenrollment.IDEQ(enrollmentID),
+				entenrollment.OrganizationIDEQ(v.orgID),
+				entenrollment.UserIDEQ(v.principal.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if !owned {
+			return uuid.Nil, notFound("inscription introuvable")
+		}
+	}
+	return v.orgID, nil
+}
+
+// onlyVisible renvoie nil pour une entité introuvable ou invisible de l'appelant.
+func onlyVisible[T any](v *T, err error) (*T, error) {
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return v, err
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
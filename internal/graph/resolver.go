@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	entcourse "lms-go/internal/ent/course"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entgroup "lms-go/internal/ent/group"
+	entmodule "lms-go/internal/ent/module"
+	entmoduleprogress "lms-go/internal/ent/moduleprogress"
+	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/progress"
+)
+
+//go:generate go tool gqlgen generate
+
+// Resolver regroupe les dépendances des resolvers : ent pour la lecture, les services du domaine
+// pour les mutations.
+type Resolver struct {
+	client      *ent.Client
+	courses     *course.Service
+	enrollments *enrollment.Service
+	progress    *progress.Service
+}
+
+// node charge une entité de n'importe quel type ; une entité introuvable ou invisible de
+// l'appelant donne null, sans erreur.
+func (r *Resolver) node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	n, err := r.client.Noder(ctx, id, ent.WithNodeType(r.nodeType))
+	var gqlErr *gqlerror.Error
+	switch {
+	case err == nil:
+		return n, nil
+	case ent.IsNotFound(err),
+		errors.As(err, &gqlErr) && gqlErr.Extensions["code"] == CodeForbidden:
+		return nil, nil
+	}
+	return nil, err
+}
+
+// nodeType cherche la table de l'identifiant, sans restriction : la visibilité est appliquée au
+// chargement de l'entité.
+func (r *Resolver) nodeType(ctx context.Context, id uuid.UUID) (string, error) {
+	ctx = unrestricted(ctx)
+	for _, t := range []struct {
+		table string
+		exist func(context.Context) (bool, error)
+	}{
+		{entcourse.Table, r.client.Course.Query().Where(entcourse.ID(id)).Exist},
+		{entmodule.Table, r.client.Module.Query().Where(entmodule.ID(id)).Exist},
+		{entenrollment.Table, r.client.Enrollment.Query().Where(entenrollment.ID(id)).Exist},
+		{entmoduleprogress.Table, r.client.ModuleProgress.Query().Where(entmoduleprogress.ID(id)).Exist},
+		{entgroup.Table, r.client.Group.Query().Where(entgroup.ID(id)).Exist},
+		{entuser.Table, r.client.User.Query().Where(entuser.ID(id)).Exist},
+	} {
+		found, err := t.exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return t.table, nil
+		}
+	}
+	return "", &ent.NotFoundError{}
+}
+
+// compareIDs départage les éléments d'une liste triée.
+func compareIDs(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
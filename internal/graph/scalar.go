@@ -0,0 +1,22 @@
+package graph
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalFloat32 sérialise les champs float32 des entités (progression, score) au plus court,
+// sans les décimales parasites de la conversion en float64.
+func MarshalFloat32(f float32) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.FormatFloat(float64(f), 'g', -1, 32))
+	})
+}
+
+// UnmarshalFloat32 lit un Float GraphQL vers un champ float32.
+func UnmarshalFloat32(v any) (float32, error) {
+	f, err := graphql.UnmarshalFloat(v)
+	return float32(f), err
+}
@@ -0,0 +1,218 @@
+// Package graph expose le graphe cours, modules, inscriptions et progression en GraphQL. Le
+// schéma des entités (types, connexions Relay, tris et filtres where) est généré par entgql à
+// partir des schémas ent, l'exécution par gqlgen ; les mutations et les champs propres à l'API
+// sont décrits dans lms.graphql. Le middleware authorize contrôle l'appelant et restreint chaque
+// lecture ent à ce qu'il peut voir ; les arêtes sont chargées par lot (CollectFields), une
+// requête par niveau de la réponse.
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/progress"
+)
+
+// Estimation du nombre d'éléments d'une liste non paginée, pour le calcul de complexité.
+const listEstimate = 20
+
+// Request est une requête GraphQL telle que reçue par HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Options borne l'exécution ; une limite nulle est ignorée. QueryOnly refuse les mutations
+// (requêtes GET).
+type Options struct {
+	MaxDepth      int
+	MaxComplexity int
+	QueryOnly     bool
+}
+
+// Response est le résultat d'une requête. Data est absent lorsque la requête a été refusée
+// avant exécution (syntaxe, validation, limites).
+type Response struct {
+	Data       json.RawMessage `json:"data,omitempty"`
+	Errors     gqlerror.List   `json:"errors,omitempty"`
+	Extensions map[string]any  `json:"extensions,omitempty"`
+	rejected   bool
+}
+
+// Rejected indique que la requête a été refusée avant exécution.
+func (r *Response) Rejected() bool {
+	return r.rejected
+}
+
+// Schema exécute les requêtes sur le schéma généré.
+type Schema struct {
+	exec *executor.Executor
+	sdl  string
+}
+
+// NewSchema construit le schéma GraphQL de l'API et installe sur client le filtre de
+// visibilité des lectures faites par le graphe.
+func NewSchema(client *ent.Client, courses *course.Service, enrollments *enrollment.Service, progressService *progress.Service) *Schema {
+	client.Intercept(restrict())
+	cfg := Config{Resolvers: &Resolver{client: client, courses: courses, enrollments: enrollments, progress: progressService}}
+	cfg.Complexity.Query.Courses = connectionComplexity
+	cfg.Complexity.Query.Enrollments = connectionComplexity
+	cfg.Complexity.Query.Groups = connectionComplexity
+	cfg.Complexity.Query.Users = connectionComplexity
+	cfg.Complexity.Query.Nodes = func(child int, ids []uuid.UUID) int {
+		return 1 + len(ids)*child
+	}
+	cfg.Complexity.Course.Modules = listComplexity
+	cfg.Complexity.Enrollment.ModuleProgress = listComplexity
+	cfg.Complexity.User.Enrollments = listComplexity
+
+	es := NewExecutableSchema(cfg)
+	exec := executor.New(es)
+	exec.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	exec.SetErrorPresenter(presentError)
+	exec.SetRecoverFunc(recoverPanic)
+	exec.Use(limits{es: es})
+	exec.AroundFields(authorize)
+	return &Schema{exec: exec, sdl: printSDL()}
+}
+
+// Execute analyse, valide puis exécute la requête.
+func (s *Schema) Execute(ctx context.Context, req Request, opts Options) *Response {
+	ctx = graphql.StartOperationTrace(ctx)
+	ctx = context.WithValue(ctx, optionsKey{}, opts)
+	rc, errs := s.exec.CreateOperationContext(ctx, &graphql.RawParams{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+		ReadTime:      graphql.TraceTiming{Start: graphql.Now(), End: graphql.Now()},
+	})
+	if errs == nil && opts.QueryOnly && rc.Operation.Operation != ast.Query {
+		errs = gqlerror.List{newError(CodeValidationFailed, "mutation interdite en GET, utiliser POST")}
+	}
+	if errs != nil {
+		resp := s.exec.DispatchError(graphql.WithOperationContext(ctx, rc), errs)
+		return &Response{Errors: resp.Errors, rejected: true}
+	}
+	handler, ctx := s.exec.DispatchOperation(ctx, rc)
+	resp := handler(ctx)
+	return &Response{Data: resp.Data, Errors: resp.Errors, Extensions: resp.Extensions}
+}
+
+// SDL renvoie le schéma au format SDL, sans les directives de génération.
+func (s *Schema) SDL() string {
+	return s.sdl
+}
+
+func printSDL() string {
+	schema := gqlparser.MustLoadSchema(sources...)
+	for _, name := range []string{"goField", "goModel"} {
+		delete(schema.Directives, name)
+	}
+	for _, def := range schema.Types {
+		def.Directives = withoutGoDirectives(def.Directives)
+		for _, f := range def.Fields {
+			f.Directives = withoutGoDirectives(f.Directives)
+		}
+	}
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchema(schema)
+	return buf.String()
+}
+
+func withoutGoDirectives(list ast.DirectiveList) ast.DirectiveList {
+	var out ast.DirectiveList
+	for _, d := range list {
+		if d.Name != "goField" && d.Name != "goModel" {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+type optionsKey struct{}
+
+// limits refuse avant exécution les requêtes trop profondes ou trop coûteuses, selon les
+// Options passées à Execute, et publie la complexité calculée dans extensions.
+type limits struct {
+	es graphql.ExecutableSchema
+}
+
+var (
+	_ graphql.OperationContextMutator = limits{}
+	_ graphql.ResponseInterceptor     = limits{}
+)
+
+func (limits) ExtensionName() string { return "Limits" }
+
+func (limits) Validate(graphql.ExecutableSchema) error { return nil }
+
+func (l limits) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	opts, _ := ctx.Value(optionsKey{}).(Options)
+	if d := depth(rc.Operation.SelectionSet); opts.MaxDepth > 0 && d > opts.MaxDepth {
+		return newError(CodeQueryTooComplex, fmt.Sprintf("profondeur %d supérieure à la limite de %d", d, opts.MaxDepth))
+	}
+	c := complexity.Calculate(ctx, l.es, rc.Operation, rc.Variables)
+	if opts.MaxComplexity > 0 && c > opts.MaxComplexity {
+		return newError(CodeQueryTooComplex, fmt.Sprintf("complexité %d supérieure à la limite de %d", c, opts.MaxComplexity))
+	}
+	rc.Stats.SetExtension("complexity", c)
+	return nil
+}
+
+func (limits) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if rc := graphql.GetOperationContext(ctx); rc.Stats.GetExtension("complexity") != nil {
+		graphql.RegisterExtension(ctx, "complexity", rc.Stats.GetExtension("complexity"))
+	}
+	return next(ctx)
+}
+
+// depth renvoie la profondeur d'une sélection, fragments compris.
+func depth(set ast.SelectionSet) int {
+	deepest := 0
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			d = 1 + depth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			d = depth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = depth(sel.Definition.SelectionSet)
+			}
+		}
+		deepest = max(deepest, d)
+	}
+	return deepest
+}
+
+func connectionComplexity[C, O, W any](child int, _ C, first *int, _ C, last *int, _ O, _ W) int {
+	return 1 + pageSize(first, last)*child
+}
+
+func listComplexity(child int) int {
+	return 1 + listEstimate*child
+}
+
+// recoverPanic journalise la panique d'un resolver, publiée comme erreur interne.
+func recoverPanic(ctx context.Context, v any) error {
+	slog.ErrorContext(ctx, "graphql resolver panic", "path", graphql.GetPath(ctx).String(), "panic", v)
+	return newError(CodeInternal, "erreur interne")
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"lms-go/internal/content"
+	"lms-go/internal/ent"
+	entcourse "lms-go/internal/ent/course"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entgroup "lms-go/internal/ent/group"
+	entmodule "lms-go/internal/ent/module"
+	entmoduleprogress "lms-go/internal/ent/moduleprogress"
+	"lms-go/internal/ent/predicate"
+	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/principal"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/tenant"
+)
+
+// Statuts visibles des utilisateurs restreints (voir les packages course et enrollment).
+const (
+	courseStatusPublished  = "published"
+	moduleStatusActive     = "active"
+	enrollmentStatusActive = "active"
+)
+
+// viewer est l'appelant d'une requête : son organisation et ses droits.
+type viewer struct {
+	orgID     uuid.UUID
+	principal principal.Principal
+}
+
+// viewerFrom lit l'appelant dans le contexte ; chaque resolver le consulte, le handler HTTP
+// n'étant pas la seule barrière.
+func viewerFrom(ctx context.Context) (viewer, error) {
+	p, ok := principal.FromContext(ctx)
+	if !ok {
+		return viewer{}, newError(CodeUnauthenticated, "authentification requise")
+	}
+	orgID, err := tenant.OrganizationID(ctx)
+	if err != nil {
+		return viewer{}, newError(CodeBadUserInput, "organisation manquante")
+	}
+	if p.OrganizationID != orgID {
+		return viewer{}, newError(CodeForbidden, "accès refusé")
+	}
+	return viewer{orgID: orgID, principal: p}, nil
+}
+
+// need exige un scope des comptes de service ; les utilisateurs ne sont pas concernés.
+func (v viewer) need(scope string) error {
+	if !v.principal.HasScope(scope) {
+		return newError(CodeForbidden, "scope "+scope+" requis pour la clé d'API")
+	}
+	return nil
+}
+
+// fullAccess indique un accès à tout le catalogue : administrateurs, concepteurs et comptes de
+// service.
+func (v viewer) fullAccess() bool {
+	return v.principal.IsServiceAccount() || content.FullAccess(v.principal.Role)
+}
+
+// staff indique un accès à toutes les inscriptions, utilisateurs et groupes : accès complet ou
+// tuteur.
+func (v viewer) staff() bool {
+	return v.fullAccess() || strings.EqualFold(strings.TrimSpace(v.principal.Role), "tutor")
+}
+
+// Les prédicats de visibilité reprennent la règle de la recherche : les utilisateurs restreints
+// ne voient que les cours publiés, et les modules actifs des cours où ils sont inscrits.
+
+func (v viewer) courses() ([]predicate.Course, error) {
+	if err := v.need(serviceaccount.ScopeCoursesRead); err != nil {
+		return nil, err
+	}
+	preds := []predicate.Course{entcourse.OrganizationIDEQ(v.orgID)}
+	if !v.fullAccess() {
+		preds = append(preds, entcourse.StatusEQ(courseStatusPublished))
+	}
+	return preds, nil
+}
+
+func (v viewer) modules() ([]predicate.Module, error) {
+	if err := v.need(serviceaccount.ScopeCoursesRead); err != nil {
+		return nil, err
+	}
+	if v.fullAccess() {
+		return []predicate.Module{entmodule.HasCourseWith(entcourse.OrganizationIDEQ(v.orgID))}, nil
+	}
+	return []predicate.Module{
+		entmodule.StatusEQ(moduleStatusActive),
+		entmodule.HasCourseWith(
+			entcourse.OrganizationIDEQ(v.orgID),
+			entcourse.StatusEQ(courseStatusPublished),
+			entcourse.HasEnrollmentsWith(
+				entenrollment.UserIDEQ(v.principal.ID),
+				entenrollment.StatusEQ(enrollmentStatusActive),
+			),
+		),
+	}, nil
+}
+
+func (v viewer) enrollments() ([]predicate.Enrollment, error) {
+	if err := v.need(serviceaccount.ScopeEnrollmentsRead); err != nil {
+		return nil, err
+	}
+	preds := []predicate.Enrollment{entenrollment.OrganizationIDEQ(v.orgID)}
+	if !v.staff() {
+		preds = append(preds, entenrollment.UserIDEQ(v.principal.ID))
+	}
+	return preds, nil
+}
+
+func (v viewer) groups() ([]predicate.Group, error) {
+	if err := v.need(serviceaccount.ScopeEnrollmentsRead); err != nil {
+		return nil, err
+	}
+	preds := []predicate.Group{entgroup.OrganizationIDEQ(v.orgID)}
+	if !v.staff() {
+		preds = append(preds, entgroup.Or(
+			entgroup.HasMembersWith(entuser.IDEQ(v.principal.ID)),
+			entgroup.HasEnrollmentsWith(entenrollment.UserIDEQ(v.principal.ID)),
+		))
+	}
+	return preds, nil
+}
+
+func (v viewer) users() ([]predicate.User, error) {
+	if err := v.need(serviceaccount.ScopeUsersRead); err != nil {
+		return nil, err
+	}
+	preds := []predicate.User{entuser.OrganizationIDEQ(v.orgID)}
+	if !v.staff() {
+		preds = append(preds, entuser.IDEQ(v.principal.ID))
+	}
+	return preds, nil
+}
+
+// progress couvre la progression par module, qui relève du reporting pour les comptes de service.
+func (v viewer) progress() ([]predicate.ModuleProgress, error) {
+	if err := v.need(serviceaccount.ScopeReportsRead); err != nil {
+		return nil, err
+	}
+	enrollments := []predicate.Enrollment{entenrollment.OrganizationIDEQ(v.orgID)}
+	if !v.staff() {
+		enrollments = append(enrollments, entenrollment.UserIDEQ(v.principal.ID))
+	}
+	return []predicate.ModuleProgress{entmoduleprogress.HasEnrollmentWith(enrollments...)}, nil
+}
+
+// Droits d'écriture : le catalogue est réservé aux administrateurs et concepteurs, les
+// inscriptions à l'équipe pédagogique.
+
+func (v viewer) canEditCourses() error {
+	if v.principal.IsServiceAccount() {
+		return v.need(serviceaccount.ScopeCoursesWrite)
+	}
+	if !content.FullAccess(v.principal.Role) {
+		return newError(CodeForbidden, "rôle insuffisant")
+	}
+	return nil
+}
+
+func (v viewer) canEditEnrollments() error {
+	if v.principal.IsServiceAccount() {
+		return v.need(serviceaccount.ScopeEnrollmentsWrite)
+	}
+	if !v.staff() {
+		return newError(CodeForbidden, "rôle insuffisant")
+	}
+	return nil
+}
+
+// mutationRights associe à chaque mutation le droit d'écriture exigé. La progression est
+// contrôlée par progressTarget, l'apprenant pouvant faire progresser sa propre inscription.
+var mutationRights = map[string]func(viewer) error{
+	"createCourse":     viewer.canEditCourses,
+	"updateCourse":     viewer.canEditCourses,
+	"publishCourse":    viewer.canEditCourses,
+	"unpublishCourse":  viewer.canEditCourses,
+	"archiveCourse":    viewer.canEditCourses,
+	"addModule":        viewer.canEditCourses,
+	"updateModule":     viewer.canEditCourses,
+	"reorderModules":   viewer.canEditCourses,
+	"removeModule":     viewer.canEditCourses,
+	"enroll":           viewer.canEditEnrollments,
+	"updateEnrollment": viewer.canEditEnrollments,
+	"cancelEnrollment": viewer.canEditEnrollments,
+}
+
+// visibilityKey marque les lectures faites pour le compte de l'appelant.
+type visibilityKey struct{}
+
+// authorize est le middleware des champs : les champs racine contrôlent l'appelant et les droits
+// d'écriture, puis chaque lecture ent est restreinte à ce que l'appelant peut voir. Seules les
+// mutations, qui délèguent aux services, lisent sans restriction ; les champs de leur résultat
+// sont de nouveau restreints.
+func authorize(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	switch fc.Object {
+	case "Query", "Mutation":
+		v, err := viewerFrom(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if fc.Object == "Mutation" {
+			if right, ok := mutationRights[fc.Field.Name]; ok {
+				if err := right(v); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	res, err := next(context.WithValue(ctx, visibilityKey{}, fc.Object != "Mutation"))
+	if ent.IsNotFound(err) {
+		// Arête vers une entité invisible de l'appelant.
+		return nil, nil
+	}
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return res, gqlErr
+	}
+	return res, err
+}
+
+// restrict applique les prédicats de visibilité de l'appelant aux lectures ent marquées par
+// authorize ; il est installé sur le client ent par NewSchema. Les lectures des services (REST,
+// mutations) ne sont pas marquées et restent inchangées.
+func restrict() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if visible, _ := ctx.Value(visibilityKey{}).(bool); !visible {
+			return nil
+		}
+		v, err := viewerFrom(ctx)
+		if err != nil {
+			return err
+		}
+		switch q := q.(type) {
+		case *ent.CourseQuery:
+			preds, err := v.courses()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		case *ent.ModuleQuery:
+			preds, err := v.modules()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		case *ent.EnrollmentQuery:
+			preds, err := v.enrollments()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		case *ent.GroupQuery:
+			preds, err := v.groups()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		case *ent.UserQuery:
+			preds, err := v.users()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		case *ent.ModuleProgressQuery:
+			preds, err := v.progress()
+			if err != nil {
+				return err
+			}
+			q.Where(preds...)
+		}
+		return nil
+	})
+}
+
+// unrestricted retire la marque de visibilité, pour les contrôles faits par le graphe lui-même.
+func unrestricted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, visibilityKey{}, false)
+}
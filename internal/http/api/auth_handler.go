@@ -3,19 +3,23 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"log/slog"
+	"math"
 	"net/http"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
 	"lms-go/internal/auth"
+	httpmiddleware "lms-go/internal/http/middleware"
 )
 
 // AuthHandler gère les endpoints d'authentification.
 type AuthHandler struct {
-	service *auth.Service
+	service           *auth.Service
+	credentialLimiter []func(http.Handler) http.Handler
 }
 
 // NewAuthHandler crée un AuthHandler.
@@ -23,30 +27,46 @@ func NewAuthHandler(service *auth.Service) *AuthHandler {
 	return &AuthHandler{service: service}
 }
 
+// LimitCredentialRoutes applique les middlewares donnés (limitation de débit) aux routes
+// recevant des identifiants : signup, register, login et vérification du second facteur.
+func (h *AuthHandler) LimitCredentialRoutes(middlewares ...func(http.Handler) http.Handler) {
+	h.credentialLimiter = append(h.credentialLimiter, middlewares...)
+}
+
 // Mount enregistre les routes d'authentification sur le routeur chi donné.
 func (h *AuthHandler) Mount(r chi.Router) {
-	r.Post("/signup", h.handleSignup)
-	r.Post("/register", h.handleRegister)
-	r.Post("/login", h.handleLogin)
+	limited := r.With(h.credentialLimiter...)
+	limited.Post("/signup", h.handleSignup)
+	limited.Post("/register", h.handleRegister)
+	limited.Post("/login", h.handleLogin)
 	r.Post("/refresh", h.handleRefresh)
 	r.Post("/forgot-password", h.handleForgotPassword)
 	r.Get("/me", h.handleMe)
 	r.Post("/logout", h.handleLogout)
+	h.mountMFA(r)
 }
 
 type registerRequest struct {
-	OrganizationID string         `json:"organization_id"`
-	Email          string         `json:"email"`
-	Password       string         `json:"password"`
+	OrganizationID string         `json:"organization_id" openapi:"required"`
+	Email          string         `json:"email" openapi:"required"`
+	Password       string         `json:"password" openapi:"required"`
 	Role           string         `json:"role"`
 	Metadata       map[string]any `json:"metadata"`
 }
 
+type registerResponse struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	Status         string    `json:"status"`
+}
+
 type signupRequest struct {
-	OrgName  string         `json:"org_name"`
+	OrgName  string         `json:"org_name" openapi:"required"`
 	OrgSlug  string         `json:"org_slug,omitempty"`
-	Email    string         `json:"email"`
-	Password string         `json:"password"`
+	Email    string         `json:"email" openapi:"required"`
+	Password string         `json:"password" openapi:"required"`
 	Metadata map[string]any `json:"metadata,omitempty"`
 }
 
@@ -57,23 +77,36 @@ type authResponse struct {
 }
 
 type signupResponse struct {
-	Organization map[string]any `json:"organization"`
-	User         map[string]any `json:"user"`
-	AccessToken  string         `json:"access_token"`
-	RefreshToken string         `json:"refresh_token"`
-	ExpiresAt    string         `json:"expires_at"`
+	Organization organizationSummary `json:"organization"`
+	User         userSummary         `json:"user"`
+	AccessToken  string              `json:"access_token"`
+	RefreshToken string              `json:"refresh_token"`
+	ExpiresAt    string              `json:"expires_at"`
+}
+
+type organizationSummary struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+}
+
+type userSummary struct {
+	ID     uuid.UUID `json:"id"`
+	Email  string    `json:"email"`
+	Role   string    `json:"role"`
+	Status string    `json:"status,omitempty"`
 }
 
 func (h *AuthHandler) handleRegister(w http.ResponseWriter, r *http.Request) {
 	var req registerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
 	orgID, err := uuid.Parse(req.OrganizationID)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organization_id invalide")
+		respondError(w, r, http.StatusBadRequest, "organization_id invalide", err)
 		return
 	}
 
@@ -87,27 +120,27 @@ func (h *AuthHandler) handleRegister(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrEmailAlreadyUsed):
-			respondError(w, http.StatusConflict, "email déjà utilisé")
+			respondError(w, r, http.StatusConflict, "email déjà utilisé", err)
 		case errors.Is(err, auth.ErrInvalidCredentials):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
 
-	respondJSON(w, http.StatusCreated, map[string]any{
-		"id":              user.ID,
-		"organization_id": user.OrganizationID,
-		"email":           user.Email,
-		"role":            user.Role,
-		"status":          user.Status,
+	respondJSON(w, http.StatusCreated, registerResponse{
+		ID:             user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email,
+		Role:           user.Role,
+		Status:         user.Status,
 	})
 }
 
 type loginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" openapi:"required"`
+	Password string `json:"password" openapi:"required"`
 }
 
 type refreshRequest struct {
@@ -117,21 +150,27 @@ type refreshRequest struct {
 func (h *AuthHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 	var req loginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
 	tokens, err := h.service.Login(r.Context(), req.Email, req.Password)
 	if err != nil {
+		var challenge *auth.MFAChallengeError
 		switch {
+		case errors.As(err, &challenge):
+			respondMFAChallenge(w, challenge)
 		case errors.Is(err, auth.ErrInvalidCredentials):
-			respondError(w, http.StatusUnauthorized, "identifiants invalides")
+			respondError(w, r, http.StatusUnauthorized, "identifiants invalides", err)
 		case errors.Is(err, auth.ErrUserInactive):
-			respondError(w, http.StatusForbidden, "compte inactif")
+			respondError(w, r, http.StatusForbidden, "compte inactif", err)
+		case errors.Is(err, auth.ErrAccountLocked):
+			setRetryAfter(w, err)
+			respondError(w, r, http.StatusLocked, "compte temporairement verrouillé suite à trop d'échecs", err)
 		case errors.Is(err, auth.ErrAmbiguousIdentity):
-			respondError(w, http.StatusConflict, "plusieurs organisations associées à ce compte, contactez votre administrateur")
+			respondError(w, r, http.StatusConflict, "plusieurs organisations associées à ce compte, contactez votre administrateur", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
@@ -152,7 +191,7 @@ func (h *AuthHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
 	var req refreshRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -165,7 +204,7 @@ func (h *AuthHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if refreshToken == "" {
-		respondError(w, http.StatusBadRequest, "refresh_token manquant")
+		respondError(w, r, http.StatusBadRequest, "refresh_token manquant", nil)
 		return
 	}
 
@@ -173,9 +212,9 @@ func (h *AuthHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrInvalidToken):
-			respondError(w, http.StatusUnauthorized, "refresh token invalide")
+			respondError(w, r, http.StatusUnauthorized, "refresh token invalide", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
@@ -199,8 +238,34 @@ func respondJSON(w http.ResponseWriter, status int, payload any) {
 	_ = json.NewEncoder(w).Encode(payload)
 }
 
-func respondError(w http.ResponseWriter, status int, message string) {
-	respondJSON(w, status, map[string]string{"error": message})
+// respondError écrit la réponse d'erreur JSON et journalise l'erreur sous-jacente avec le contexte de la requête.
+func respondError(w http.ResponseWriter, r *http.Request, status int, message string, err error) {
+	level := slog.LevelWarn
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{
+		slog.Int("status", status),
+		slog.String("message", message),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	slog.LogAttrs(r.Context(), level, "http error response", attrs...)
+	respondJSON(w, status, errorResponse{Error: message})
+}
+
+// setRetryAfter positionne l'entête Retry-After à partir d'une erreur de verrouillage.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	var lockErr *auth.LockoutError
+	if !errors.As(err, &lockErr) {
+		return
+	}
+	seconds := int(math.Ceil(time.Until(lockErr.Until).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
 }
 
 // setAuthCookies définit les cookies httpOnly pour les tokens d'authentification
@@ -252,7 +317,7 @@ func clearAuthCookies(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) handleSignup(w http.ResponseWriter, r *http.Request) {
 	var req signupRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -266,11 +331,11 @@ func (h *AuthHandler) handleSignup(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrEmailAlreadyUsed):
-			respondError(w, http.StatusConflict, "email ou organisation déjà utilisé")
+			respondError(w, r, http.StatusConflict, "email ou organisation déjà utilisé", err)
 		case errors.Is(err, auth.ErrInvalidCredentials):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
@@ -282,16 +347,8 @@ func (h *AuthHandler) handleSignup(w http.ResponseWriter, r *http.Request) {
 	}
 
 	respondJSON(w, http.StatusCreated, signupResponse{
-		Organization: map[string]any{
-			"id":   org.ID,
-			"name": org.Name,
-			"slug": org.Slug,
-		},
-		User: map[string]any{
-			"id":    user.ID,
-			"email": user.Email,
-			"role":  user.Role,
-		},
+		Organization: organizationSummary{ID: org.ID, Name: org.Name, Slug: org.Slug},
+		User:         userSummary{ID: user.ID, Email: user.Email, Role: user.Role},
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresAt:    tokens.ExpiresAt.Format(time.RFC3339),
@@ -301,25 +358,31 @@ func (h *AuthHandler) handleSignup(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) handleForgotPassword(w http.ResponseWriter, r *http.Request) {
 	// Placeholder pour forgot password
 	// TODO: Implémenter l'envoi d'email avec token de réinitialisation
-	var req struct {
-		Email string `json:"email"`
-	}
+	var req forgotPasswordRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
 	// Pour l'instant, retourne toujours un succès (même si l'email n'existe pas)
 	// pour éviter de divulguer l'existence des comptes
-	respondJSON(w, http.StatusOK, map[string]string{
-		"message": "Si un compte existe avec cet email, un lien de réinitialisation sera envoyé",
+	respondJSON(w, http.StatusOK, messageResponse{
+		Message: "Si un compte existe avec cet email, un lien de réinitialisation sera envoyé",
 	})
 }
 
+type forgotPasswordRequest struct {
+	Email string `json:"email" openapi:"required"`
+}
+
+type messageResponse struct {
+	Message string `json:"message"`
+}
+
 func (h *AuthHandler) handleMe(w http.ResponseWriter, r *http.Request) {
 	token := extractAccessToken(r)
 	if token == "" {
-		respondError(w, http.StatusUnauthorized, "token manquant")
+		respondError(w, r, http.StatusUnauthorized, "token manquant", nil)
 		return
 	}
 
@@ -327,28 +390,24 @@ func (h *AuthHandler) handleMe(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrInvalidToken):
-			respondError(w, http.StatusUnauthorized, "token invalide")
+			respondError(w, r, http.StatusUnauthorized, "token invalide", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
 
-	respondJSON(w, http.StatusOK, map[string]any{
-		"user": map[string]any{
-			"id":     user.ID,
-			"email":  user.Email,
-			"role":   user.Role,
-			"status": user.Status,
-		},
-		"organization": map[string]any{
-			"id":   org.ID,
-			"name": org.Name,
-			"slug": org.Slug,
-		},
+	respondJSON(w, http.StatusOK, meResponse{
+		User:         userSummary{ID: user.ID, Email: user.Email, Role: user.Role, Status: user.Status},
+		Organization: organizationSummary{ID: org.ID, Name: org.Name, Slug: org.Slug},
 	})
 }
 
+type meResponse struct {
+	User         userSummary         `json:"user"`
+	Organization organizationSummary `json:"organization"`
+}
+
 func (h *AuthHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
 	token := extractAccessToken(r)
 	if token != "" {
@@ -362,16 +421,5 @@ func (h *AuthHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
 }
 
 func extractAccessToken(r *http.Request) string {
-	authHeader := r.Header.Get("Authorization")
-	if len(authHeader) >= 7 && strings.EqualFold(authHeader[:7], "Bearer ") {
-		if token := strings.TrimSpace(authHeader[7:]); token != "" {
-			return token
-		}
-	}
-
-	if cookie, err := r.Cookie("access_token"); err == nil && cookie.Value != "" {
-		return cookie.Value
-	}
-
-	return ""
+	return httpmiddleware.AccessToken(r)
 }
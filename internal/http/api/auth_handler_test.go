@@ -19,6 +19,8 @@ import (
 
 	"lms-go/internal/auth"
 	"lms-go/internal/ent"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/platform/ratelimit"
 
 	_ "github.com/glebarez/go-sqlite"
 )
@@ -498,3 +500,83 @@ func TestAuthHandler_MeAndLogout(t *testing.T) {
 		require.ErrorIs(t, err, auth.ErrInvalidToken)
 	})
 }
+
+func TestAuthHandler_LoginRateLimited(t *testing.T) {
+	_, svc, orgID := setupAuthTest(t)
+	handler := NewAuthHandler(svc)
+	handler.LimitCredentialRoutes(httpmiddleware.RateLimit(
+		ratelimit.NewMemoryLimiter(),
+		ratelimit.PerMinute(1, 2),
+		httpmiddleware.ByIP("auth"),
+		httpmiddleware.ByJSONField("auth", "email"),
+	))
+	r := chi.NewRouter()
+	handler.Mount(r)
+
+	_, err := svc.Register(context.Background(), auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "limited@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	login := func(email, remote string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]string{"email": email, "password": "supersecret"})
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+		req.RemoteAddr = remote
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusOK, login("limited@example.com", "10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusOK, login("LIMITED@example.com", "10.0.0.2:1234").Code)
+
+	// Le seau de l'email est vide, même depuis une nouvelle IP.
+	rec := login("limited@example.com", "10.0.0.3:1234")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "60", rec.Header().Get("Retry-After"))
+
+	// Les routes sans identifiants ne sont pas limitées.
+	meReq := httptest.NewRequest(http.MethodGet, "/me", nil)
+	meRec := httptest.NewRecorder()
+	r.ServeHTTP(meRec, meReq)
+	require.Equal(t, http.StatusUnauthorized, meRec.Code)
+}
+
+func TestAuthHandler_LoginLockedAccount(t *testing.T) {
+	client, _, orgID := setupAuthTest(t)
+	svc := auth.NewService(client, auth.Config{
+		JWTSecret:         "handler-secret",
+		AccessTokenTTL:    time.Minute,
+		RefreshTokenTTL:   time.Hour,
+		MaxFailedAttempts: 2,
+		LockoutDuration:   time.Minute,
+	})
+	handler := NewAuthHandler(svc)
+	r := chi.NewRouter()
+	handler.Mount(r)
+
+	_, err := svc.Register(context.Background(), auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "lockme@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	attempt := func(password string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]string{"email": "lockme@example.com", "password": password})
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusUnauthorized, attempt("wrongpass").Code)
+	rec := attempt("wrongpass")
+	require.Equal(t, http.StatusLocked, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+	rec = attempt("supersecret")
+	require.Equal(t, http.StatusLocked, rec.Code)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Concurrence optimiste : l'ETag d'un cours, module, groupe, utilisateur ou organisation est sa
+// version. Les versions désignées par If-Match sont passées aux services, qui les vérifient par une
+// mise à jour conditionnelle (412 si la ressource a changé) ; If-None-Match évite de renvoyer une
+// représentation inchangée (304).
+
+const msgStale = "ressource modifiée entre-temps"
+
+// etag renvoie l'ETag fort d'une version.
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", etag(version))
+}
+
+// parseETag lit un ETag fort produit par etag.
+func parseETag(tag string) (int, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	return version, err == nil && version > 0
+}
+
+// ifMatch renvoie les versions désignées par If-Match, à transmettre au service. `*` ou l'absence
+// d'en-tête n'imposent aucune version ; un en-tête qu'aucune version ne peut satisfaire (ETag
+// faible ou étranger) reçoit d'emblée une réponse 412 et ok vaut false.
+func ifMatch(w http.ResponseWriter, r *http.Request) (versions []int, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, true
+	}
+	for _, tag := range strings.Split(header, ",") {
+		if version, ok := parseETag(strings.TrimSpace(tag)); ok {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) == 0 {
+		respondError(w, r, http.StatusPreconditionFailed, msgStale, nil)
+		return nil, false
+	}
+	return versions, true
+}
+
+// notModified pose l'ETag de la ressource et répond 304 si If-None-Match le désigne déjà
+// (comparaison faible).
+func notModified(w http.ResponseWriter, r *http.Request, version int) bool {
+	setETag(w, version)
+	header := r.Header.Get("If-None-Match")
+	if header == "" {
+		return false
+	}
+	current := etag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == current {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
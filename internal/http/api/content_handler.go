@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -12,38 +13,62 @@ import (
 
 	"lms-go/internal/content"
 	"lms-go/internal/ent"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/principal"
 	"lms-go/internal/tenant"
 )
 
 type ContentHandler struct {
-	service *content.Service
+	service    *content.Service
+	idempotent []func(http.Handler) http.Handler
 }
 
 func NewContentHandler(service *content.Service) *ContentHandler {
 	return &ContentHandler{service: service}
 }
 
+// Idempotent applique les middlewares donnés (clés d'idempotence) à la création d'un contenu (POST /contents).
+func (h *ContentHandler) Idempotent(middlewares ...func(http.Handler) http.Handler) {
+	h.idempotent = append(h.idempotent, middlewares...)
+}
+
 func (h *ContentHandler) Mount(r chi.Router) {
 	r.Get("/", h.list)
-	r.Post("/", h.create)
+	r.With(h.idempotent...).Post("/", h.create)
 	r.Route("/{id}", func(r chi.Router) {
 		r.Get("/", h.get)
 		r.Post("/finalize", h.finalize)
 		r.Delete("/", h.archive)
 		r.Get("/download", h.download)
+		r.Get("/revisions", h.listRevisions)
+		r.Post("/revisions", h.createRevision)
+		r.Post("/revisions/{revision}/finalize", h.finalize)
+		r.Post("/rollback", h.rollback)
+		r.Post("/multipart", h.initiateMultipart)
+		r.Get("/multipart", h.resumeMultipart)
+		r.Post("/multipart/complete", h.completeMultipart)
+		r.Delete("/multipart", h.abortMultipart)
 	})
 }
 
+// MountOrganization enregistre le suivi de l'occupation du stockage sur le routeur /orgs.
+func (h *ContentHandler) MountOrganization(r chi.Router) {
+	r.Get("/{id}/usage", h.usage)
+}
+
 type contentResponse struct {
 	ID         uuid.UUID      `json:"id"`
 	Name       string         `json:"name"`
 	MimeType   string         `json:"mime_type"`
 	SizeBytes  int64          `json:"size_bytes"`
+	ETag       string         `json:"etag,omitempty"`
+	Checksum   string         `json:"checksum_sha256,omitempty"`
 	Status     string         `json:"status"`
 	Metadata   map[string]any `json:"metadata"`
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	StorageKey string         `json:"storage_key"`
+	Revision   int            `json:"revision"`
 }
 
 func toContentResponse(c *ent.Content) contentResponse {
@@ -52,17 +77,26 @@ func toContentResponse(c *ent.Content) contentResponse {
 		Name:       c.Name,
 		MimeType:   c.MimeType,
 		SizeBytes:  c.SizeBytes,
+		ETag:       c.Etag,
+		Checksum:   c.ChecksumSha256,
 		Status:     c.Status,
 		Metadata:   c.Metadata,
 		CreatedAt:  c.CreatedAt,
 		UpdatedAt:  c.UpdatedAt,
 		StorageKey: c.StorageKey,
+		Revision:   c.CurrentRevision,
 	}
 }
 
+type uploadLinkResponse struct {
+	Content   contentResponse `json:"content"`
+	UploadURL string          `json:"upload_url"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
 type createContentRequest struct {
-	Name      string         `json:"name"`
-	MimeType  string         `json:"mime_type"`
+	Name      string         `json:"name" openapi:"required"`
+	MimeType  string         `json:"mime_type" openapi:"required"`
 	SizeBytes int64          `json:"size_bytes"`
 	Metadata  map[string]any `json:"metadata"`
 }
@@ -70,13 +104,13 @@ type createContentRequest struct {
 func (h *ContentHandler) create(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 
 	var req createContentRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -88,30 +122,35 @@ func (h *ContentHandler) create(w http.ResponseWriter, r *http.Request) {
 		Metadata:       req.Metadata,
 	})
 	if err != nil {
-		if errors.Is(err, content.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
-		} else {
-			respondError(w, http.StatusInternalServerError, "erreur création contenu")
+		switch {
+		case errors.Is(err, content.ErrInvalidInput):
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
+		case errors.Is(err, content.ErrMimeNotAllowed):
+			respondError(w, r, http.StatusUnsupportedMediaType, "type de fichier non autorisé par l'organisation", err)
+		case errors.Is(err, content.ErrQuotaExceeded):
+			respondError(w, r, http.StatusRequestEntityTooLarge, "quota de stockage dépassé", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur création contenu", err)
 		}
 		return
 	}
 
-	respondJSON(w, http.StatusCreated, map[string]any{
-		"content":    toContentResponse(link.Content),
-		"upload_url": link.UploadURL,
-		"expires_at": link.ExpiresAt,
+	respondJSON(w, http.StatusCreated, uploadLinkResponse{
+		Content:   toContentResponse(link.Content),
+		UploadURL: link.UploadURL,
+		ExpiresAt: link.ExpiresAt,
 	})
 }
 
 func (h *ContentHandler) list(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	contents, err := h.service.List(r.Context(), orgID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les contenus")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les contenus", err)
 		return
 	}
 	out := make([]contentResponse, 0, len(contents))
@@ -124,20 +163,20 @@ func (h *ContentHandler) list(w http.ResponseWriter, r *http.Request) {
 func (h *ContentHandler) get(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 	entity, err := h.service.Get(r.Context(), orgID, contentID)
 	if err != nil {
 		if errors.Is(err, content.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "contenu introuvable")
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
@@ -145,68 +184,95 @@ func (h *ContentHandler) get(w http.ResponseWriter, r *http.Request) {
 }
 
 type finalizeRequest struct {
-	Name      *string        `json:"name"`
-	MimeType  *string        `json:"mime_type"`
-	SizeBytes *int64         `json:"size_bytes"`
-	Metadata  map[string]any `json:"metadata"`
+	Name           *string        `json:"name"`
+	MimeType       *string        `json:"mime_type"`
+	SizeBytes      *int64         `json:"size_bytes"`
+	ChecksumSHA256 *string        `json:"checksum_sha256"`
+	Metadata       map[string]any `json:"metadata"`
 }
 
 func (h *ContentHandler) finalize(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
 	var req finalizeRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
-	entity, err := h.service.Finalize(r.Context(), orgID, contentID, content.FinalizeInput{
-		Name:      req.Name,
-		MimeType:  req.MimeType,
-		SizeBytes: req.SizeBytes,
-		Metadata:  req.Metadata,
-	})
-	if err != nil {
-		switch {
-		case errors.Is(err, content.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
-		case errors.Is(err, content.ErrNotFound):
-			respondError(w, http.StatusNotFound, "contenu introuvable")
-		default:
-			respondError(w, http.StatusInternalServerError, "erreur de finalisation")
+	input := content.FinalizeInput{
+		Name:           req.Name,
+		MimeType:       req.MimeType,
+		SizeBytes:      req.SizeBytes,
+		ChecksumSHA256: req.ChecksumSHA256,
+		Metadata:       req.Metadata,
+	}
+	var entity *ent.Content
+	if raw := chi.URLParam(r, "revision"); raw != "" {
+		number, convErr := strconv.Atoi(raw)
+		if convErr != nil {
+			respondError(w, r, http.StatusBadRequest, "révision invalide", convErr)
+			return
 		}
+		entity, err = h.service.FinalizeRevision(r.Context(), orgID, contentID, number, input)
+	} else {
+		entity, err = h.service.Finalize(r.Context(), orgID, contentID, input)
+	}
+	if err != nil {
+		respondFinalizeError(w, r, err)
 		return
 	}
 
 	respondJSON(w, http.StatusOK, toContentResponse(entity))
 }
 
+func respondFinalizeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, content.ErrInvalidInput):
+		respondError(w, r, http.StatusBadRequest, "données invalides", err)
+	case errors.Is(err, content.ErrNotFound):
+		respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
+	case errors.Is(err, content.ErrObjectMissing):
+		respondError(w, r, http.StatusConflict, "aucun fichier déposé pour ce contenu", err)
+	case errors.Is(err, content.ErrMimeNotAllowed):
+		respondError(w, r, http.StatusUnsupportedMediaType, "type de fichier non autorisé par l'organisation", err)
+	case errors.Is(err, content.ErrMimeMismatch):
+		respondError(w, r, http.StatusUnprocessableEntity, "le fichier déposé ne correspond pas au type déclaré", err)
+	case errors.Is(err, content.ErrSizeMismatch), errors.Is(err, content.ErrChecksumMismatch):
+		respondError(w, r, http.StatusUnprocessableEntity, "le fichier déposé ne correspond pas à la taille ou à l'empreinte déclarée", err)
+	case errors.Is(err, content.ErrQuotaExceeded):
+		respondError(w, r, http.StatusRequestEntityTooLarge, "quota de stockage dépassé", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur de finalisation", err)
+	}
+}
+
 func (h *ContentHandler) archive(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
 	if err := h.service.Archive(r.Context(), orgID, contentID); err != nil {
 		if errors.Is(err, content.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "contenu introuvable")
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible d'archiver")
+			respondError(w, r, http.StatusInternalServerError, "impossible d'archiver", err)
 		}
 		return
 	}
@@ -216,26 +282,437 @@ func (h *ContentHandler) archive(w http.ResponseWriter, r *http.Request) {
 func (h *ContentHandler) download(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
-	url, expires, err := h.service.PresignDownload(r.Context(), orgID, contentID)
+	var input content.DownloadInput
+	if raw := r.URL.Query().Get("revision"); raw != "" {
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			respondError(w, r, http.StatusBadRequest, "révision invalide", err)
+			return
+		}
+		input.Revision = &number
+	}
+	if raw := r.URL.Query().Get("module_id"); raw != "" {
+		moduleID, err := uuid.Parse(raw)
+		if err != nil {
+			respondError(w, r, http.StatusBadRequest, "module invalide", err)
+			return
+		}
+		input.ModuleID = &moduleID
+	}
+	// Les apprenants n'accèdent qu'aux contenus de leurs inscriptions ; les comptes de service
+	// sont limités par leurs scopes.
+	p, ok := principal.FromContext(r.Context())
+	if !ok {
+		respondError(w, r, http.StatusUnauthorized, "authentification requise", nil)
+		return
+	}
+	if p.OrganizationID != orgID {
+		respondError(w, r, http.StatusForbidden, "accès refusé", nil)
+		return
+	}
+	if p.Kind == principal.KindUser {
+		input.UserID = &p.ID
+		input.Restricted = !content.FullAccess(p.Role)
+	}
+
+	link, err := h.service.Download(r.Context(), orgID, contentID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, content.ErrNotFound):
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
+		case errors.Is(err, content.ErrNotAvailable):
+			respondError(w, r, http.StatusConflict, "révision non disponible", err)
+		case errors.Is(err, content.ErrForbidden):
+			respondError(w, r, http.StatusForbidden, "contenu non accessible", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur téléchargement", err)
+		}
+		return
+	}
+	respondJSON(w, http.StatusOK, downloadLinkResponse{
+		DownloadURL: link.URL,
+		ExpiresAt:   link.ExpiresAt,
+		Revision:    link.Revision,
+	})
+}
+
+type downloadLinkResponse struct {
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Revision    int       `json:"revision"`
+}
+
+type revisionResponse struct {
+	Number     int       `json:"number"`
+	Status     string    `json:"status"`
+	MimeType   string    `json:"mime_type"`
+	SizeBytes  int64     `json:"size_bytes"`
+	ETag       string    `json:"etag,omitempty"`
+	Checksum   string    `json:"checksum_sha256,omitempty"`
+	StorageKey string    `json:"storage_key"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func toRevisionResponse(rev *ent.ContentRevision, current int) revisionResponse {
+	return revisionResponse{
+		Number:     rev.Number,
+		Status:     rev.Status,
+		MimeType:   rev.MimeType,
+		SizeBytes:  rev.SizeBytes,
+		ETag:       rev.Etag,
+		Checksum:   rev.ChecksumSha256,
+		StorageKey: rev.StorageKey,
+		Current:    rev.Number == current,
+		CreatedAt:  rev.CreatedAt,
+	}
+}
+
+func (h *ContentHandler) listRevisions(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	entity, err := h.service.Get(r.Context(), orgID, contentID)
 	if err != nil {
 		if errors.Is(err, content.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "contenu introuvable")
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur téléchargement")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+		}
+		return
+	}
+	revisions, err := h.service.Revisions(r.Context(), orgID, contentID)
+	if err != nil {
+		respondError(w, r, http.StatusInternalServerError, "historique indisponible", err)
+		return
+	}
+	resp := make([]revisionResponse, 0, len(revisions))
+	for _, rev := range revisions {
+		resp = append(resp, toRevisionResponse(rev, entity.CurrentRevision))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+type revisionUploadResponse struct {
+	Revision  revisionResponse `json:"revision"`
+	UploadURL string           `json:"upload_url"`
+	ExpiresAt time.Time        `json:"expires_at"`
+}
+
+type createRevisionRequest struct {
+	MimeType  string `json:"mime_type" openapi:"required"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+func (h *ContentHandler) createRevision(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	var req createRevisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	upload, err := h.service.CreateRevision(r.Context(), orgID, contentID, content.CreateRevisionInput{
+		MimeType:  req.MimeType,
+		SizeBytes: req.SizeBytes,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, content.ErrInvalidInput):
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
+		case errors.Is(err, content.ErrNotFound):
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
+		case errors.Is(err, content.ErrNotAvailable):
+			respondError(w, r, http.StatusConflict, "le contenu doit être finalisé avant une nouvelle révision", err)
+		case errors.Is(err, content.ErrConflict):
+			respondError(w, r, http.StatusConflict, "révision créée simultanément, réessayez", err)
+		case errors.Is(err, content.ErrMimeNotAllowed):
+			respondError(w, r, http.StatusUnsupportedMediaType, "type de fichier non autorisé par l'organisation", err)
+		case errors.Is(err, content.ErrQuotaExceeded):
+			respondError(w, r, http.StatusRequestEntityTooLarge, "quota de stockage dépassé", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur création révision", err)
 		}
 		return
 	}
-	respondJSON(w, http.StatusOK, map[string]any{
-		"download_url": url,
-		"expires_at":   expires,
+	respondJSON(w, http.StatusCreated, revisionUploadResponse{
+		Revision:  toRevisionResponse(upload.Revision, upload.Content.CurrentRevision),
+		UploadURL: upload.UploadURL,
+		ExpiresAt: upload.ExpiresAt,
 	})
 }
+
+type rollbackRequest struct {
+	Revision int `json:"revision" openapi:"required"`
+}
+
+func (h *ContentHandler) rollback(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	var req rollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Revision <= 0 {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	entity, err := h.service.Rollback(r.Context(), orgID, contentID, req.Revision)
+	if err != nil {
+		switch {
+		case errors.Is(err, content.ErrNotFound):
+			respondError(w, r, http.StatusNotFound, "révision introuvable", err)
+		case errors.Is(err, content.ErrNotAvailable):
+			respondError(w, r, http.StatusConflict, "révision non finalisée", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur de retour arrière", err)
+		}
+		return
+	}
+	respondJSON(w, http.StatusOK, toContentResponse(entity))
+}
+
+type partResponse struct {
+	Number int    `json:"number"`
+	ETag   string `json:"etag"`
+	Size   int64  `json:"size_bytes"`
+}
+
+type partURLResponse struct {
+	Number int    `json:"number"`
+	URL    string `json:"upload_url"`
+}
+
+type multipartResponse struct {
+	Content   contentResponse   `json:"content"`
+	UploadID  string            `json:"upload_id"`
+	PartSize  int64             `json:"part_size"`
+	PartCount int               `json:"part_count"`
+	Uploaded  []partResponse    `json:"uploaded_parts"`
+	Parts     []partURLResponse `json:"parts"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+func toMultipartResponse(upload *content.MultipartUpload) multipartResponse {
+	resp := multipartResponse{
+		Content:   toContentResponse(upload.Content),
+		UploadID:  upload.UploadID,
+		PartSize:  upload.PartSize,
+		PartCount: upload.PartCount,
+		Uploaded:  make([]partResponse, 0, len(upload.Uploaded)),
+		Parts:     make([]partURLResponse, 0, len(upload.Parts)),
+		ExpiresAt: upload.ExpiresAt,
+	}
+	for _, p := range upload.Uploaded {
+		resp.Uploaded = append(resp.Uploaded, partResponse{Number: p.Number, ETag: p.ETag, Size: p.Size})
+	}
+	for _, p := range upload.Parts {
+		resp.Parts = append(resp.Parts, partURLResponse{Number: p.Number, URL: p.URL})
+	}
+	return resp
+}
+
+func respondMultipartError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, content.ErrMultipartUnsupported):
+		respondError(w, r, http.StatusNotImplemented, "dépôt multipart non pris en charge par le stockage", err)
+	case errors.Is(err, content.ErrNoUpload):
+		respondError(w, r, http.StatusNotFound, "aucun dépôt multipart en cours", err)
+	case errors.Is(err, content.ErrNotPending):
+		respondError(w, r, http.StatusConflict, "le contenu n'est plus en attente de dépôt", err)
+	case errors.Is(err, content.ErrInvalidPart):
+		respondError(w, r, http.StatusBadRequest, "parties absentes ou ETag incorrects", err)
+	default:
+		respondFinalizeError(w, r, err)
+	}
+}
+
+type initiateMultipartRequest struct {
+	PartSize int64 `json:"part_size"`
+}
+
+func (h *ContentHandler) initiateMultipart(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	var req initiateMultipartRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	upload, err := h.service.InitiateMultipart(r.Context(), orgID, contentID, content.InitiateMultipartInput{PartSize: req.PartSize})
+	if err != nil {
+		respondMultipartError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, toMultipartResponse(upload))
+}
+
+func (h *ContentHandler) resumeMultipart(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	upload, err := h.service.ResumeMultipart(r.Context(), orgID, contentID)
+	if err != nil {
+		respondMultipartError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toMultipartResponse(upload))
+}
+
+type completeMultipartRequest struct {
+	finalizeRequest
+	Parts []struct {
+		Number int    `json:"number"`
+		ETag   string `json:"etag"`
+	} `json:"parts"`
+}
+
+func (h *ContentHandler) completeMultipart(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	var req completeMultipartRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Parts) == 0 {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	parts := make([]storage.Part, 0, len(req.Parts))
+	for _, p := range req.Parts {
+		parts = append(parts, storage.Part{Number: p.Number, ETag: p.ETag})
+	}
+	entity, err := h.service.CompleteMultipart(r.Context(), orgID, contentID, parts, content.FinalizeInput{
+		Name:           req.Name,
+		MimeType:       req.MimeType,
+		SizeBytes:      req.SizeBytes,
+		ChecksumSHA256: req.ChecksumSHA256,
+		Metadata:       req.Metadata,
+	})
+	if err != nil {
+		respondMultipartError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toContentResponse(entity))
+}
+
+func (h *ContentHandler) abortMultipart(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	contentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	if err := h.service.AbortMultipart(r.Context(), orgID, contentID); err != nil {
+		respondMultipartError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type categoryUsageResponse struct {
+	Category string `json:"category"`
+	Bytes    int64  `json:"bytes"`
+	Objects  int    `json:"objects"`
+}
+
+type usageResponse struct {
+	QuotaBytes   int64                   `json:"quota_bytes"`
+	QuotaObjects int                     `json:"quota_objects"`
+	BytesUsed    int64                   `json:"bytes_used"`
+	ObjectsUsed  int                     `json:"objects_used"`
+	Percent      float64                 `json:"percent"`
+	PendingBytes int64                   `json:"pending_bytes"`
+	Categories   []categoryUsageResponse `json:"categories"`
+}
+
+func (h *ContentHandler) usage(w http.ResponseWriter, r *http.Request) {
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	usage, err := h.service.Usage(r.Context(), orgID)
+	if err != nil {
+		if errors.Is(err, content.ErrNotFound) {
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+			return
+		}
+		respondError(w, r, http.StatusInternalServerError, "erreur de calcul de l'occupation", err)
+		return
+	}
+
+	resp := usageResponse{
+		QuotaBytes:   usage.Quota.Bytes,
+		QuotaObjects: usage.Quota.Objects,
+		BytesUsed:    usage.BytesUsed,
+		ObjectsUsed:  usage.ObjectsUsed,
+		Percent:      usage.Percent,
+		PendingBytes: usage.PendingBytes,
+		Categories:   make([]categoryUsageResponse, 0, len(usage.Categories)),
+	}
+	for _, c := range usage.Categories {
+		resp.Categories = append(resp.Categories, categoryUsageResponse{Category: c.Category, Bytes: c.Bytes, Objects: c.Objects})
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
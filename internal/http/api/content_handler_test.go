@@ -3,7 +3,9 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -21,13 +23,15 @@ import (
 	"lms-go/internal/ent"
 	httpmiddleware "lms-go/internal/http/middleware"
 	"lms-go/internal/organization"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/principal"
 
 	_ "github.com/glebarez/go-sqlite"
 )
 
-type stubStorage struct{}
+type stubStorage struct{ *storage.Memory }
 
-func (s stubStorage) PresignUpload(ctx context.Context, object string, contentType string, expires time.Duration) (string, error) {
+func (s stubStorage) PresignUpload(ctx context.Context, object string, contentType string, size int64, expires time.Duration) (string, error) {
 	return "https://upload/" + object, nil
 }
 
@@ -37,7 +41,7 @@ func (s stubStorage) PresignDownload(ctx context.Context, object string, expires
 
 func (s stubStorage) Remove(ctx context.Context, object string) error { return nil }
 
-func newContentHandlerEnv(t *testing.T) (*content.Service, uuid.UUID, func()) {
+func newContentHandlerEnv(t *testing.T) (*content.Service, stubStorage, uuid.UUID, func()) {
 	db, err := sql.Open("sqlite", "file:contenthandler?mode=memory&cache=shared")
 	require.NoError(t, err)
 	_, err = db.Exec("PRAGMA foreign_keys = ON")
@@ -52,22 +56,35 @@ func newContentHandlerEnv(t *testing.T) (*content.Service, uuid.UUID, func()) {
 	org, err := orgSvc.Create(ctx, organization.CreateInput{Name: "Org", Slug: "org"})
 	require.NoError(t, err)
 
-	svc := content.NewService(client, stubStorage{}, content.Config{})
+	store := stubStorage{storage.NewMemory()}
+	svc := content.NewService(client, store, content.Config{})
 	cleanup := func() {
 		_ = client.Close()
 		_ = db.Close()
 	}
-	return svc, org.ID, cleanup
+	return svc, store, org.ID, cleanup
 }
 
-func setupContentRouter(t *testing.T) (*chi.Mux, uuid.UUID) {
-	svc, orgID, cleanup := newContentHandlerEnv(t)
+func setupContentRouter(t *testing.T) (*chi.Mux, stubStorage, uuid.UUID) {
+	svc, store, orgID, cleanup := newContentHandlerEnv(t)
 	t.Cleanup(cleanup)
 	handler := NewContentHandler(svc)
 	router := chi.NewRouter()
-	router.Use(httpmiddleware.TenantFromHeader)
+	router.Use(httpmiddleware.TenantFromHeader, asPrincipal(principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: orgID, Role: "admin"}))
 	handler.Mount(router)
-	return router, orgID
+	return router, store, orgID
+}
+
+// asPrincipal simule l'authentification, sauf si la requête porte déjà un principal.
+func asPrincipal(p principal.Principal) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := principal.FromContext(r.Context()); !ok {
+				r = r.WithContext(principal.WithPrincipal(r.Context(), p))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 func reqWithOrg(method, target string, orgID uuid.UUID, body []byte) *http.Request {
@@ -78,7 +95,7 @@ func reqWithOrg(method, target string, orgID uuid.UUID, body []byte) *http.Reque
 }
 
 func TestContentHandler_CreateAndList(t *testing.T) {
-	router, orgID := setupContentRouter(t)
+	router, _, orgID := setupContentRouter(t)
 
 	payload := map[string]any{
 		"name":       "Demo.pdf",
@@ -98,7 +115,7 @@ func TestContentHandler_CreateAndList(t *testing.T) {
 }
 
 func TestContentHandler_FinalizeAndDownload(t *testing.T) {
-	router, orgID := setupContentRouter(t)
+	router, store, orgID := setupContentRouter(t)
 
 	createPayload := map[string]any{
 		"name":      "file.txt",
@@ -115,14 +132,185 @@ func TestContentHandler_FinalizeAndDownload(t *testing.T) {
 	contentResp := resp["content"].(map[string]any)
 	id := contentResp["id"].(string)
 
-	finalizeBody, _ := json.Marshal(map[string]any{"size_bytes": 2048})
+	finalizeBody, _ := json.Marshal(map[string]any{"size_bytes": 11})
 	finReq := reqWithOrg(http.MethodPost, "/"+id+"/finalize", orgID, finalizeBody)
 	finRec := httptest.NewRecorder()
 	router.ServeHTTP(finRec, finReq)
+	require.Equal(t, http.StatusConflict, finRec.Code)
+
+	store.Put(contentResp["storage_key"].(string), []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}, "text/plain")
+	finRec = httptest.NewRecorder()
+	router.ServeHTTP(finRec, reqWithOrg(http.MethodPost, "/"+id+"/finalize", orgID, finalizeBody))
+	require.Equal(t, http.StatusUnprocessableEntity, finRec.Code)
+
+	store.Put(contentResp["storage_key"].(string), []byte("hello world"), "text/plain")
+	finRec = httptest.NewRecorder()
+	router.ServeHTTP(finRec, reqWithOrg(http.MethodPost, "/"+id+"/finalize", orgID, finalizeBody))
 	require.Equal(t, http.StatusOK, finRec.Code)
+	var finalized contentResponse
+	require.NoError(t, json.Unmarshal(finRec.Body.Bytes(), &finalized))
+	require.Equal(t, int64(11), finalized.SizeBytes)
+	require.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", finalized.Checksum)
 
 	dlReq := reqWithOrg(http.MethodGet, "/"+id+"/download", orgID, nil)
 	dlRec := httptest.NewRecorder()
 	router.ServeHTTP(dlRec, dlReq)
 	require.Equal(t, http.StatusOK, dlRec.Code)
 }
+
+func TestContentHandler_Usage(t *testing.T) {
+	svc, _, orgID, cleanup := newContentHandlerEnv(t)
+	t.Cleanup(cleanup)
+	handler := NewContentHandler(svc)
+	router := chi.NewRouter()
+	router.Route("/contents", func(r chi.Router) {
+		r.Use(httpmiddleware.TenantFromHeader)
+		handler.Mount(r)
+	})
+	router.Route("/orgs", handler.MountOrganization)
+
+	body, _ := json.Marshal(map[string]any{"name": "Demo.pdf", "mime_type": "application/pdf", "size_bytes": 1024})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/contents/", orgID, body))
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orgs/"+orgID.String()+"/usage", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var usage usageResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &usage))
+	require.Equal(t, int64(1024), usage.BytesUsed)
+	require.Equal(t, 1, usage.ObjectsUsed)
+	require.Equal(t, int64(1024), usage.PendingBytes)
+	require.Equal(t, []categoryUsageResponse{{Category: "document", Bytes: 1024, Objects: 1}}, usage.Categories)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orgs/"+uuid.NewString()+"/usage", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestContentHandler_Revisions(t *testing.T) {
+	router, store, orgID := setupContentRouter(t)
+
+	body, _ := json.Marshal(map[string]any{"name": "notes.txt", "mime_type": "text/plain"})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/", orgID, body))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created struct {
+		Content contentResponse `json:"content"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	id := created.Content.ID.String()
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/revisions", orgID, nil))
+	require.Equal(t, http.StatusConflict, rec.Code, "contenu non finalisé")
+
+	store.Put(created.Content.StorageKey, []byte("version one"), "text/plain")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/finalize", orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/revisions", orgID, nil))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var revision struct {
+		Revision  revisionResponse `json:"revision"`
+		UploadURL string           `json:"upload_url"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revision))
+	require.Equal(t, 2, revision.Revision.Number)
+	require.False(t, revision.Revision.Current)
+
+	store.Put(revision.Revision.StorageKey, []byte("version two"), "text/plain")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/revisions/2/finalize", orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var finalized contentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &finalized))
+	require.Equal(t, 2, finalized.Revision)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/"+id+"/revisions", orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var history []revisionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &history))
+	require.Len(t, history, 2)
+	require.True(t, history[0].Current)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/"+id+"/download?revision=1", orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), created.Content.StorageKey)
+
+	rollback, _ := json.Marshal(map[string]any{"revision": 1})
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/rollback", orgID, rollback))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var rolled contentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rolled))
+	require.Equal(t, 1, rolled.Revision)
+	require.Equal(t, created.Content.StorageKey, rolled.StorageKey)
+}
+
+func TestContentHandler_Multipart(t *testing.T) {
+	router, store, orgID := setupContentRouter(t)
+
+	body, _ := json.Marshal(map[string]any{"name": "notes.txt", "mime_type": "text/plain", "size_bytes": 11})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/", orgID, body))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created struct {
+		Content contentResponse `json:"content"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	id := created.Content.ID.String()
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/"+id+"/multipart", orgID, nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/multipart", orgID, nil))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var upload multipartResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &upload))
+	require.Equal(t, 1, upload.PartCount)
+	require.Len(t, upload.Parts, 1)
+
+	etag, err := store.PutPart(created.Content.StorageKey, upload.UploadID, 1, []byte("hello world"))
+	require.NoError(t, err)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/"+id+"/multipart", orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &upload))
+	require.Len(t, upload.Uploaded, 1)
+	require.Empty(t, upload.Parts)
+
+	sum := sha256.Sum256([]byte("hello world"))
+	checksum := hex.EncodeToString(sum[:])
+	bad, _ := json.Marshal(map[string]any{"parts": []map[string]any{{"number": 1, "etag": "nope"}}, "checksum_sha256": checksum})
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/multipart/complete", orgID, bad))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	complete, _ := json.Marshal(map[string]any{"parts": []map[string]any{{"number": 1, "etag": etag}}, "size_bytes": 11, "checksum_sha256": checksum})
+	for range 2 {
+		// La seconde tentative (réponse perdue) renvoie le même contenu.
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/multipart/complete", orgID, complete))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var finalized contentResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &finalized))
+		require.Equal(t, content.StatusAvailable, finalized.Status)
+		require.Equal(t, checksum, finalized.Checksum)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/"+id+"/multipart", orgID, nil))
+	require.Equal(t, http.StatusConflict, rec.Code)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodDelete, "/"+id+"/multipart", orgID, nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -84,7 +84,7 @@ func toCourseResponse(c *ent.Course) courseResponse {
 }
 
 type createCourseRequest struct {
-	Title       string         `json:"title"`
+	Title       string         `json:"title" openapi:"required"`
 	Slug        string         `json:"slug"`
 	Description string         `json:"description"`
 	Metadata    map[string]any `json:"metadata"`
@@ -93,13 +93,13 @@ type createCourseRequest struct {
 func (h *CourseHandler) create(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 
 	var req createCourseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -113,28 +113,29 @@ func (h *CourseHandler) create(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, course.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, course.ErrSlugTaken):
-			respondError(w, http.StatusConflict, "slug déjà utilisé")
+			respondError(w, r, http.StatusConflict, "slug déjà utilisé", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur création cours")
+			respondError(w, r, http.StatusInternalServerError, "erreur création cours", err)
 		}
 		return
 	}
 
+	setETag(w, courseEntity.Version)
 	respondJSON(w, http.StatusCreated, toCourseResponse(courseEntity))
 }
 
 func (h *CourseHandler) list(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	filter := course.CourseFilter{Status: r.URL.Query().Get("status")}
 	courses, err := h.service.List(r.Context(), orgID, filter)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les cours")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les cours", err)
 		return
 	}
 	resp := make([]courseResponse, 0, len(courses))
@@ -147,24 +148,27 @@ func (h *CourseHandler) list(w http.ResponseWriter, r *http.Request) {
 func (h *CourseHandler) get(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
 	entity, err := h.service.Get(r.Context(), orgID, courseID)
 	if err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "cours introuvable")
+			respondError(w, r, http.StatusNotFound, "cours introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
+	if notModified(w, r, entity.Version) {
+		return
+	}
 	respondJSON(w, http.StatusOK, toCourseResponse(entity))
 }
 
@@ -177,18 +181,23 @@ type updateCourseRequest struct {
 func (h *CourseHandler) update(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
 	var req updateCourseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -196,17 +205,21 @@ func (h *CourseHandler) update(w http.ResponseWriter, r *http.Request) {
 		Title:       req.Title,
 		Description: req.Description,
 		Metadata:    req.Metadata,
+		IfMatch:     versions,
 	})
 	if err != nil {
 		if errors.Is(err, course.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		} else if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "cours introuvable")
+			respondError(w, r, http.StatusNotFound, "cours introuvable", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur mise à jour")
+			respondError(w, r, http.StatusInternalServerError, "erreur mise à jour", err)
 		}
 		return
 	}
+	setETag(w, entity.Version)
 	respondJSON(w, http.StatusOK, toCourseResponse(entity))
 }
 
@@ -225,47 +238,60 @@ func (h *CourseHandler) archive(w http.ResponseWriter, r *http.Request) {
 func (h *CourseHandler) deletePermanent(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
-	if err := h.service.Delete(r.Context(), orgID, courseID); err != nil {
+	versions, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+	if err := h.service.Delete(r.Context(), orgID, courseID, versions...); err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "cours introuvable")
+			respondError(w, r, http.StatusNotFound, "cours introuvable", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "suppression définitive impossible")
+			respondError(w, r, http.StatusInternalServerError, "suppression définitive impossible", err)
 		}
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
-type courseStatusFunc func(ctx context.Context, orgID, courseID uuid.UUID) (*ent.Course, error)
+type courseStatusFunc func(ctx context.Context, orgID, courseID uuid.UUID, ifMatch ...int) (*ent.Course, error)
 
 func (h *CourseHandler) updateStatus(w http.ResponseWriter, r *http.Request, fn courseStatusFunc) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
-	entity, err := fn(r.Context(), orgID, courseID)
+	entity, err := fn(r.Context(), orgID, courseID, versions...)
 	if err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "cours introuvable")
+			respondError(w, r, http.StatusNotFound, "cours introuvable", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur mise à jour statut")
+			respondError(w, r, http.StatusInternalServerError, "erreur mise à jour statut", err)
 		}
 		return
 	}
+	setETag(w, entity.Version)
 	respondJSON(w, http.StatusOK, toCourseResponse(entity))
 }
 
@@ -275,10 +301,12 @@ type moduleResponse struct {
 	Title           string         `json:"title"`
 	ModuleType      string         `json:"module_type"`
 	ContentID       *uuid.UUID     `json:"content_id,omitempty"`
+	ContentRevision *int           `json:"content_revision,omitempty"`
 	Position        int            `json:"position"`
 	OrderIndex      int            `json:"order_index"`
 	DurationSeconds int            `json:"duration_seconds"`
 	Status          string         `json:"status"`
+	Version         int            `json:"version"`
 	Data            map[string]any `json:"data"`
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
@@ -291,10 +319,12 @@ func toModuleResponse(m *ent.Module) moduleResponse {
 		Title:           m.Title,
 		ModuleType:      m.ModuleType,
 		ContentID:       m.ContentID,
+		ContentRevision: m.ContentRevision,
 		Position:        m.Position,
 		OrderIndex:      m.Position,
 		DurationSeconds: m.DurationSeconds,
 		Status:          m.Status,
+		Version:         m.Version,
 		Data:            m.Data,
 		CreatedAt:       m.CreatedAt,
 		UpdatedAt:       m.UpdatedAt,
@@ -302,11 +332,13 @@ func toModuleResponse(m *ent.Module) moduleResponse {
 }
 
 type moduleRequest struct {
-	Title        string         `json:"title"`
-	ModuleType   string         `json:"module_type"`
-	ContentID    *uuid.UUID     `json:"content_id"`
-	DurationSecs int            `json:"duration_seconds"`
-	Data         map[string]any `json:"data"`
+	Title      string     `json:"title"`
+	ModuleType string     `json:"module_type"`
+	ContentID  *uuid.UUID `json:"content_id"`
+	// ContentRevision épingle une révision du contenu ; 0 suit la révision courante.
+	ContentRevision *int           `json:"content_revision"`
+	DurationSecs    int            `json:"duration_seconds"`
+	Data            map[string]any `json:"data"`
 }
 
 func (h *CourseHandler) addModule(w http.ResponseWriter, r *http.Request) {
@@ -316,24 +348,26 @@ func (h *CourseHandler) addModule(w http.ResponseWriter, r *http.Request) {
 	}
 	var req moduleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	module, err := h.service.AddModule(r.Context(), orgID, courseID, course.ModuleInput{
-		Title:        req.Title,
-		ModuleType:   req.ModuleType,
-		ContentID:    req.ContentID,
-		DurationSecs: req.DurationSecs,
-		Data:         req.Data,
+		Title:           req.Title,
+		ModuleType:      req.ModuleType,
+		ContentID:       req.ContentID,
+		ContentRevision: req.ContentRevision,
+		DurationSecs:    req.DurationSecs,
+		Data:            req.Data,
 	})
 	if err != nil {
 		if errors.Is(err, course.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur module")
+			respondError(w, r, http.StatusInternalServerError, "erreur module", err)
 		}
 		return
 	}
+	setETag(w, module.Version)
 	respondJSON(w, http.StatusCreated, toModuleResponse(module))
 }
 
@@ -344,7 +378,7 @@ func (h *CourseHandler) listModules(w http.ResponseWriter, r *http.Request) {
 	}
 	modules, err := h.service.ListModules(r.Context(), orgID, courseID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "liste modules impossible")
+		respondError(w, r, http.StatusInternalServerError, "liste modules impossible", err)
 		return
 	}
 	resp := make([]moduleResponse, 0, len(modules))
@@ -357,56 +391,72 @@ func (h *CourseHandler) listModules(w http.ResponseWriter, r *http.Request) {
 func (h *CourseHandler) updateModule(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	moduleID, err := uuid.Parse(chi.URLParam(r, "moduleId"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
 	var req moduleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	module, err := h.service.UpdateModule(r.Context(), orgID, moduleID, course.ModuleInput{
-		Title:        req.Title,
-		ModuleType:   req.ModuleType,
-		ContentID:    req.ContentID,
-		DurationSecs: req.DurationSecs,
-		Data:         req.Data,
+		Title:           req.Title,
+		ModuleType:      req.ModuleType,
+		ContentID:       req.ContentID,
+		ContentRevision: req.ContentRevision,
+		DurationSecs:    req.DurationSecs,
+		Data:            req.Data,
+		IfMatch:         versions,
 	})
 	if err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "module introuvable")
+			respondError(w, r, http.StatusNotFound, "module introuvable", err)
 		} else if errors.Is(err, course.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur module")
+			respondError(w, r, http.StatusInternalServerError, "erreur module", err)
 		}
 		return
 	}
+	setETag(w, module.Version)
 	respondJSON(w, http.StatusOK, toModuleResponse(module))
 }
 
 func (h *CourseHandler) removeModule(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	moduleID, err := uuid.Parse(chi.URLParam(r, "moduleId"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
-	if err := h.service.RemoveModule(r.Context(), orgID, moduleID); err != nil {
+	if err := h.service.RemoveModule(r.Context(), orgID, moduleID, versions...); err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "module introuvable")
+			respondError(w, r, http.StatusNotFound, "module introuvable", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "suppression impossible")
+			respondError(w, r, http.StatusInternalServerError, "suppression impossible", err)
 		}
 		return
 	}
@@ -414,7 +464,7 @@ func (h *CourseHandler) removeModule(w http.ResponseWriter, r *http.Request) {
 }
 
 type reorderRequest struct {
-	ModuleIDs []uuid.UUID `json:"module_ids"`
+	ModuleIDs []uuid.UUID `json:"module_ids" openapi:"required"`
 }
 
 func (h *CourseHandler) reorderModules(w http.ResponseWriter, r *http.Request) {
@@ -422,16 +472,24 @@ func (h *CourseHandler) reorderModules(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
 	var req reorderRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
-	if err := h.service.ReorderModules(r.Context(), orgID, courseID, req.ModuleIDs); err != nil {
+	if err := h.service.ReorderModules(r.Context(), orgID, courseID, req.ModuleIDs, versions...); err != nil {
 		if errors.Is(err, course.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "ordre invalide")
+			respondError(w, r, http.StatusBadRequest, "ordre invalide", err)
+		} else if errors.Is(err, course.ErrNotFound) {
+			respondError(w, r, http.StatusNotFound, "cours introuvable", err)
+		} else if errors.Is(err, course.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "réordonnancement impossible")
+			respondError(w, r, http.StatusInternalServerError, "réordonnancement impossible", err)
 		}
 		return
 	}
@@ -441,12 +499,12 @@ func (h *CourseHandler) reorderModules(w http.ResponseWriter, r *http.Request) {
 func (h *CourseHandler) parseCourseContext(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return uuid.Nil, uuid.Nil, false
 	}
 	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return uuid.Nil, uuid.Nil, false
 	}
 	return orgID, courseID, true
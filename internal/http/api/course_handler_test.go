@@ -145,3 +145,76 @@ func TestCourseHandler_DeletePermanent(t *testing.T) {
 	router.ServeHTTP(getRec, getReq)
 	require.Equal(t, http.StatusNotFound, getRec.Code)
 }
+
+func TestCourseHandler_ConditionalRequests(t *testing.T) {
+	router, orgID := setupCourseRouter(t)
+
+	body, _ := json.Marshal(map[string]any{"title": "Wizard", "slug": "wizard"})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, reqOrg(http.MethodPost, "/", orgID, body))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, `"1"`, rec.Header().Get("ETag"))
+	var created courseResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	target := "/" + created.ID.String()
+
+	req := reqOrg(http.MethodGet, target, orgID, nil)
+	req.Header.Set("If-None-Match", `W/"1"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+	require.Empty(t, rec.Body.Bytes())
+
+	// Deux éditeurs partent de la version 1 : le second est refusé.
+	patch := func(etag, title string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]any{"title": title})
+		req := reqOrg(http.MethodPatch, target, orgID, body)
+		req.Header.Set("If-Match", etag)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+	rec = patch(`"1"`, "Wizard A")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	rec = patch(`"1"`, "Wizard B")
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	rec = patch(`W/"2"`, "Wizard B")
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code, "comparaison forte")
+
+	req = reqOrg(http.MethodGet, target, orgID, nil)
+	req.Header.Set("If-None-Match", `"1"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	var current courseResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &current))
+	require.Equal(t, "Wizard A", current.Title)
+	require.Equal(t, 2, current.Version)
+
+	moduleBody, _ := json.Marshal(map[string]any{"title": "Intro", "module_type": "article"})
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqOrg(http.MethodPost, target+"/modules", orgID, moduleBody))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var mod moduleResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &mod))
+
+	req = reqOrg(http.MethodDelete, "/modules/"+mod.ID.String(), orgID, nil)
+	req.Header.Set("If-Match", `"7"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	// L'ajout du module a changé la version du cours.
+	req = reqOrg(http.MethodDelete, target, orgID, nil)
+	req.Header.Set("If-Match", `"2"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	req.Header.Set("If-Match", `"2", "3"`)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `"4"`, rec.Header().Get("ETag"))
+}
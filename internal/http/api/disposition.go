@@ -0,0 +1,49 @@
+package api
+
+import (
+	"mime"
+	"net/http"
+)
+
+// Les fichiers déposés par les utilisateurs sont servis depuis l'origine de l'API : seuls les types
+// incapables d'exécuter du script sont affichés inline, tous les autres (HTML, SVG, XML, PDF…)
+// sont forcés en pièce jointe. nosniff et le bac à sable CSP couvrent un type mal déclaré.
+var inlineMediaTypes = map[string]bool{
+	"image/avif":      true,
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"audio/aac":       true,
+	"audio/mp4":       true,
+	"audio/mpeg":      true,
+	"audio/ogg":       true,
+	"audio/wav":       true,
+	"audio/webm":      true,
+	"video/mp4":       true,
+	"video/ogg":       true,
+	"video/quicktime": true,
+	"video/webm":      true,
+	"text/plain":      true,
+}
+
+// setFileHeaders fixe le type, la disposition et les protections d'un fichier servi par l'API.
+// Un type vide est servi en application/octet-stream pour que rien ne soit deviné.
+func setFileHeaders(w http.ResponseWriter, mediaType, filename string) {
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	disposition := "attachment"
+	if base, _, err := mime.ParseMediaType(mediaType); err == nil && inlineMediaTypes[base] {
+		disposition = "inline"
+	}
+	header := w.Header()
+	header.Set("Content-Type", mediaType)
+	if value := mime.FormatMediaType(disposition, map[string]string{"filename": filename}); value != "" {
+		header.Set("Content-Disposition", value)
+	} else {
+		header.Set("Content-Disposition", disposition)
+	}
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("Content-Security-Policy", "sandbox")
+}
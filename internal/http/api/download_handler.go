@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/content"
+)
+
+// DownloadHandler sert les contenus en mode proxy : le jeton court signé par le service de contenus
+// tient lieu d'authentification, ces routes sont donc montées hors des routes multi-tenant.
+type DownloadHandler struct {
+	service *content.Service
+}
+
+func NewDownloadHandler(service *content.Service) *DownloadHandler {
+	return &DownloadHandler{service: service}
+}
+
+func (h *DownloadHandler) Mount(r chi.Router) {
+	r.Get("/{token}", h.stream)
+	r.Head("/{token}", h.stream)
+}
+
+func (h *DownloadHandler) stream(w http.ResponseWriter, r *http.Request) {
+	// Les requêtes Range de reprise ou de lecture vidéo ne sont pas tracées une seconde fois.
+	rangeHeader := r.Header.Get("Range")
+	record := r.Method == http.MethodGet && (rangeHeader == "" || strings.HasPrefix(rangeHeader, "bytes=0-"))
+
+	stream, err := h.service.OpenStream(r.Context(), chi.URLParam(r, "token"), record)
+	if err != nil {
+		switch {
+		case errors.Is(err, content.ErrInvalidToken):
+			respondError(w, r, http.StatusForbidden, "lien invalide ou expiré", err)
+		case errors.Is(err, content.ErrNotFound), errors.Is(err, content.ErrObjectMissing):
+			respondError(w, r, http.StatusNotFound, "contenu introuvable", err)
+		case errors.Is(err, content.ErrNotAvailable):
+			respondError(w, r, http.StatusConflict, "révision non disponible", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "lecture impossible", err)
+		}
+		return
+	}
+	defer stream.Body.Close()
+
+	setFileHeaders(w, stream.MimeType, stream.Name)
+	w.Header().Set("Cache-Control", "private, no-store")
+	if stream.ETag != "" {
+		w.Header().Set("ETag", `"`+stream.ETag+`"`)
+	}
+	if seeker, ok := stream.Body.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, stream.Name, stream.ModTime, seeker)
+		return
+	}
+	w.Header().Set("Accept-Ranges", "none")
+	w.Header().Set("Last-Modified", stream.ModTime.UTC().Format(http.TimeFormat))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.Copy(w, stream.Body)
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/content"
+	"lms-go/internal/ent"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/principal"
+)
+
+func TestDownloadHandler_ProxyStream(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:downloadhandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+
+	store := storage.NewMemory()
+	svc := content.NewService(client, store, content.Config{
+		Stream: content.StreamConfig{BaseURL: "/downloads", Secret: []byte("secret")},
+	})
+	res, err := svc.CreateUpload(ctx, content.CreateUploadInput{OrganizationID: org.ID, Name: "notes.txt", MimeType: "text/plain"})
+	require.NoError(t, err)
+	store.Put(res.Content.StorageKey, []byte("hello streaming world"), "text/plain")
+	_, err = svc.Finalize(ctx, org.ID, res.Content.ID, content.FinalizeInput{})
+	require.NoError(t, err)
+
+	learner := principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "learner"}
+	admin := principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "admin"}
+	router := chi.NewRouter()
+	router.Route("/contents", func(r chi.Router) {
+		r.Use(httpmiddleware.TenantFromHeader, asPrincipal(admin))
+		NewContentHandler(svc).Mount(r)
+	})
+	router.Route("/downloads", NewDownloadHandler(svc).Mount)
+
+	target := "/contents/" + res.Content.ID.String() + "/download"
+	req := reqWithOrg(http.MethodGet, target, org.ID, nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req.WithContext(principal.WithPrincipal(req.Context(), learner)))
+	require.Equal(t, http.StatusForbidden, rec.Code, "apprenant non inscrit")
+
+	anonymous := chi.NewRouter()
+	anonymous.Use(httpmiddleware.TenantFromHeader)
+	NewContentHandler(svc).Mount(anonymous)
+	rec = httptest.NewRecorder()
+	anonymous.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/"+res.Content.ID.String()+"/download", org.ID, nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, target, org.ID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var link struct {
+		DownloadURL string `json:"download_url"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &link))
+	parsed, err := url.Parse(link.DownloadURL)
+	require.NoError(t, err)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, parsed.Path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "hello streaming world", rec.Body.String())
+	require.Equal(t, "inline; filename=notes.txt", rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, "sandbox", rec.Header().Get("Content-Security-Policy"))
+
+	partial := httptest.NewRequest(http.MethodGet, parsed.Path, nil)
+	partial.Header.Set("Range", "bytes=6-14")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, partial)
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	require.Equal(t, "streaming", rec.Body.String())
+
+	// Seule la première requête est tracée ; la reprise par Range ne l'est pas.
+	downloads, err := client.ContentDownload.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, downloads)
+
+	// Un SVG peut porter du script : il est livré en pièce jointe, jamais inline.
+	svg, err := svc.CreateUpload(ctx, content.CreateUploadInput{OrganizationID: org.ID, Name: "logo.svg", MimeType: "image/svg+xml"})
+	require.NoError(t, err)
+	store.Put(svg.Content.StorageKey, []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), "image/svg+xml")
+	_, err = svc.Finalize(ctx, org.ID, svg.Content.ID, content.FinalizeInput{})
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/contents/"+svg.Content.ID.String()+"/download", org.ID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &link))
+	parsed, err = url.Parse(link.DownloadURL)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, parsed.Path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+	require.Equal(t, "attachment; filename=logo.svg", rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "sandbox", rec.Header().Get("Content-Security-Policy"))
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/downloads/forged.token", nil))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
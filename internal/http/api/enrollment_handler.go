@@ -15,16 +15,22 @@ import (
 )
 
 type EnrollmentHandler struct {
-	service *enrollment.Service
+	service    *enrollment.Service
+	idempotent []func(http.Handler) http.Handler
 }
 
 func NewEnrollmentHandler(service *enrollment.Service) *EnrollmentHandler {
 	return &EnrollmentHandler{service: service}
 }
 
+// Idempotent applique les middlewares donnés (clés d'idempotence) à l'inscription (POST /enrollments).
+func (h *EnrollmentHandler) Idempotent(middlewares ...func(http.Handler) http.Handler) {
+	h.idempotent = append(h.idempotent, middlewares...)
+}
+
 func (h *EnrollmentHandler) Mount(r chi.Router) {
 	r.Get("/", h.list)
-	r.Post("/", h.create)
+	r.With(h.idempotent...).Post("/", h.create)
 	r.Route("/{id}", func(r chi.Router) {
 		r.Patch("/", h.update)
 		r.Delete("/", h.cancel)
@@ -40,8 +46,8 @@ func (h *EnrollmentHandler) Mount(r chi.Router) {
 }
 
 type enrollRequest struct {
-	CourseID uuid.UUID      `json:"course_id"`
-	UserID   uuid.UUID      `json:"user_id"`
+	CourseID uuid.UUID      `json:"course_id" openapi:"required"`
+	UserID   uuid.UUID      `json:"user_id" openapi:"required"`
 	GroupID  *uuid.UUID     `json:"group_id"`
 	Metadata map[string]any `json:"metadata"`
 }
@@ -49,12 +55,12 @@ type enrollRequest struct {
 func (h *EnrollmentHandler) create(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	var req enrollRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.Enroll(r.Context(), enrollment.EnrollInput{
@@ -67,11 +73,11 @@ func (h *EnrollmentHandler) create(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, enrollment.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, enrollment.ErrAlreadyEnrolled):
-			respondError(w, http.StatusConflict, "utilisateur déjà inscrit")
+			respondError(w, r, http.StatusConflict, "utilisateur déjà inscrit", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur inscription")
+			respondError(w, r, http.StatusInternalServerError, "erreur inscription", err)
 		}
 		return
 	}
@@ -81,7 +87,7 @@ func (h *EnrollmentHandler) create(w http.ResponseWriter, r *http.Request) {
 func (h *EnrollmentHandler) list(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	filter := enrollment.EnrollmentFilter{}
@@ -104,7 +110,7 @@ func (h *EnrollmentHandler) list(w http.ResponseWriter, r *http.Request) {
 
 	enrollments, err := h.service.List(r.Context(), orgID, filter)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les inscriptions")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les inscriptions", err)
 		return
 	}
 	resp := make([]enrollmentResponse, 0, len(enrollments))
@@ -126,17 +132,17 @@ type updateEnrollmentRequest struct {
 func (h *EnrollmentHandler) update(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	enrollmentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 	var req updateEnrollmentRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.Update(r.Context(), orgID, enrollmentID, enrollment.UpdateInput{
@@ -149,11 +155,11 @@ func (h *EnrollmentHandler) update(w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		if errors.Is(err, enrollment.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		} else if errors.Is(err, enrollment.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "inscription introuvable")
+			respondError(w, r, http.StatusNotFound, "inscription introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur mise à jour")
+			respondError(w, r, http.StatusInternalServerError, "erreur mise à jour", err)
 		}
 		return
 	}
@@ -163,19 +169,19 @@ func (h *EnrollmentHandler) update(w http.ResponseWriter, r *http.Request) {
 func (h *EnrollmentHandler) cancel(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	enrollmentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 	if err := h.service.Cancel(r.Context(), orgID, enrollmentID); err != nil {
 		if errors.Is(err, enrollment.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "inscription introuvable")
+			respondError(w, r, http.StatusNotFound, "inscription introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "annulation impossible")
+			respondError(w, r, http.StatusInternalServerError, "annulation impossible", err)
 		}
 		return
 	}
@@ -188,6 +194,8 @@ type groupResponse struct {
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
 	Capacity    *int           `json:"capacity,omitempty"`
+	ExternalID  *string        `json:"external_id,omitempty"`
+	Version     int            `json:"version"`
 	Metadata    map[string]any `json:"metadata"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
@@ -200,6 +208,8 @@ func toGroupResponse(g *ent.Group) groupResponse {
 		Name:        g.Name,
 		Description: g.Description,
 		Capacity:    g.Capacity,
+		ExternalID:  g.ExternalID,
+		Version:     g.Version,
 		Metadata:    g.Metadata,
 		CreatedAt:   g.CreatedAt,
 		UpdatedAt:   g.UpdatedAt,
@@ -208,7 +218,7 @@ func toGroupResponse(g *ent.Group) groupResponse {
 
 type createGroupRequest struct {
 	CourseID    *uuid.UUID     `json:"course_id"`
-	Name        string         `json:"name"`
+	Name        string         `json:"name" openapi:"required"`
 	Description string         `json:"description"`
 	Capacity    *int           `json:"capacity"`
 	Metadata    map[string]any `json:"metadata"`
@@ -217,12 +227,12 @@ type createGroupRequest struct {
 func (h *EnrollmentHandler) createGroup(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	var req createGroupRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.CreateGroup(r.Context(), enrollment.CreateGroupInput{
@@ -235,19 +245,20 @@ func (h *EnrollmentHandler) createGroup(w http.ResponseWriter, r *http.Request)
 	})
 	if err != nil {
 		if errors.Is(err, enrollment.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur création groupe")
+			respondError(w, r, http.StatusInternalServerError, "erreur création groupe", err)
 		}
 		return
 	}
+	setETag(w, entity.Version)
 	respondJSON(w, http.StatusCreated, toGroupResponse(entity))
 }
 
 func (h *EnrollmentHandler) listGroups(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	filter := enrollment.GroupFilter{}
@@ -258,7 +269,7 @@ func (h *EnrollmentHandler) listGroups(w http.ResponseWriter, r *http.Request) {
 	}
 	groups, err := h.service.ListGroups(r.Context(), orgID, filter)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les groupes")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les groupes", err)
 		return
 	}
 	resp := make([]groupResponse, 0, len(groups))
@@ -278,17 +289,21 @@ type updateGroupRequest struct {
 func (h *EnrollmentHandler) updateGroup(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	groupID, err := uuid.Parse(chi.URLParam(r, "groupId"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 	var req updateGroupRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.UpdateGroup(r.Context(), orgID, groupID, enrollment.UpdateGroupInput{
@@ -296,36 +311,46 @@ func (h *EnrollmentHandler) updateGroup(w http.ResponseWriter, r *http.Request)
 		Description: req.Description,
 		Capacity:    req.Capacity,
 		Metadata:    req.Metadata,
+		IfMatch:     versions,
 	})
 	if err != nil {
 		if errors.Is(err, enrollment.ErrInvalidInput) {
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		} else if errors.Is(err, enrollment.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "groupe introuvable")
+			respondError(w, r, http.StatusNotFound, "groupe introuvable", err)
+		} else if errors.Is(err, enrollment.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur mise à jour groupe")
+			respondError(w, r, http.StatusInternalServerError, "erreur mise à jour groupe", err)
 		}
 		return
 	}
+	setETag(w, entity.Version)
 	respondJSON(w, http.StatusOK, toGroupResponse(entity))
 }
 
 func (h *EnrollmentHandler) deleteGroup(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	groupID, err := uuid.Parse(chi.URLParam(r, "groupId"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
-	if err := h.service.DeleteGroup(r.Context(), orgID, groupID); err != nil {
+	if err := h.service.DeleteGroup(r.Context(), orgID, groupID, versions...); err != nil {
 		if errors.Is(err, enrollment.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "groupe introuvable")
+			respondError(w, r, http.StatusNotFound, "groupe introuvable", err)
+		} else if errors.Is(err, enrollment.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "suppression impossible")
+			respondError(w, r, http.StatusInternalServerError, "suppression impossible", err)
 		}
 		return
 	}
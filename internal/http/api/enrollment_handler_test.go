@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -21,6 +23,8 @@ import (
 	"lms-go/internal/ent"
 	httpmiddleware "lms-go/internal/http/middleware"
 	"lms-go/internal/organization"
+	"lms-go/internal/platform/idempotency"
+	"lms-go/internal/principal"
 	"lms-go/internal/user"
 
 	_ "github.com/glebarez/go-sqlite"
@@ -149,3 +153,51 @@ func TestEnrollmentHandler_GroupLifecycle(t *testing.T) {
 	router.ServeHTTP(cancelRec, cancelReq)
 	require.Equal(t, http.StatusNoContent, cancelRec.Code)
 }
+
+func TestEnrollmentHandler_IdempotencyKey(t *testing.T) {
+	handler, orgID, userID, courseID, cleanup := newEnrollmentHandlerEnv(t)
+	t.Cleanup(cleanup)
+	handler.Idempotent(httpmiddleware.Idempotency(idempotency.NewMemoryStore(), time.Hour))
+	admin := principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: orgID, Role: "admin"}
+	router := chi.NewRouter()
+	router.Use(httpmiddleware.TenantFromHeader, asPrincipal(admin))
+	router.Route("/enrollments", handler.Mount)
+
+	enroll := func(key string, body map[string]any) *httptest.ResponseRecorder {
+		payload, _ := json.Marshal(body)
+		req := reqWithOrg(http.MethodPost, "/enrollments", orgID, payload)
+		req.Header.Set("Idempotency-Key", key)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+	body := map[string]any{"course_id": courseID, "user_id": userID}
+
+	first := enroll("retry-1", body)
+	require.Equal(t, http.StatusCreated, first.Code)
+	require.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+	// La nouvelle tentative rejoue la réponse sans créer de seconde inscription.
+	retry := enroll("retry-1", body)
+	require.Equal(t, http.StatusCreated, retry.Code)
+	require.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+	require.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+	require.JSONEq(t, first.Body.String(), retry.Body.String())
+
+	listRec := httptest.NewRecorder()
+	router.ServeHTTP(listRec, reqWithOrg(http.MethodGet, "/enrollments", orgID, nil))
+	var list []map[string]any
+	require.NoError(t, json.Unmarshal(listRec.Body.Bytes(), &list))
+	require.Len(t, list, 1)
+
+	rec := enroll("retry-1", map[string]any{"course_id": courseID, "user_id": userID, "metadata": map[string]any{"source": "mobile"}})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Sans clé, la requête est exécutée de nouveau.
+	rec = enroll("", body)
+	require.NotEqual(t, http.StatusCreated, rec.Code)
+	require.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+
+	rec = enroll(strings.Repeat("k", 256), body)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/graph"
+	"lms-go/internal/principal"
+	"lms-go/internal/tenant"
+)
+
+// maxGraphQLBody borne la taille d'une requête GraphQL.
+const maxGraphQLBody = 1 << 20
+
+// GraphQLHandler sert le schéma GraphQL : POST pour les requêtes et mutations, GET pour les
+// requêtes seules, et le SDL du schéma.
+type GraphQLHandler struct {
+	schema  *graph.Schema
+	options graph.Options
+}
+
+// NewGraphQLHandler borne chaque requête par options (profondeur et complexité).
+func NewGraphQLHandler(schema *graph.Schema, options graph.Options) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema, options: options}
+}
+
+// Mount monte l'exécution des requêtes, derrière l'authentification et le tenant.
+func (h *GraphQLHandler) Mount(r chi.Router) {
+	r.Post("/", h.post)
+	r.Get("/", h.get)
+}
+
+// MountSchema monte le SDL, public comme la description OpenAPI.
+func (h *GraphQLHandler) MountSchema(r chi.Router) {
+	r.Get("/schema", h.sdl)
+}
+
+type graphqlRequest struct {
+	Query         string         `json:"query" openapi:"required"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	// Extensions est accepté pour les clients qui l'envoient (requêtes persistées) mais ignoré.
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// graphqlResponse documente la réponse ; data est absent lorsque la requête est refusée avant
+// exécution.
+type graphqlResponse struct {
+	Data       map[string]any `json:"data,omitempty"`
+	Errors     []graphqlError `json:"errors,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+type graphqlLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type graphqlError struct {
+	Message   string            `json:"message"`
+	Locations []graphqlLocation `json:"locations,omitempty"`
+	Path      []any             `json:"path,omitempty"`
+	// Extensions porte le code de l'erreur (code), par exemple FORBIDDEN ou BAD_USER_INPUT.
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+func (h *GraphQLHandler) post(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxGraphQLBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	h.execute(w, r, req, h.options)
+}
+
+func (h *GraphQLHandler) get(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+	req := graphqlRequest{Query: values.Get("query"), OperationName: values.Get("operationName")}
+	if raw := values.Get("variables"); raw != "" {
+		decoder := json.NewDecoder(strings.NewReader(raw))
+		decoder.UseNumber()
+		if err := decoder.Decode(&req.Variables); err != nil {
+			respondError(w, r, http.StatusBadRequest, "paramètre variables invalide", err)
+			return
+		}
+	}
+	options := h.options
+	options.QueryOnly = true
+	h.execute(w, r, req, options)
+}
+
+// execute contrôle l'appelant puis exécute la requête. Une requête refusée avant exécution
+// (syntaxe, validation, limites) reçoit 400 ; une fois exécutée, la réponse est 200 et les
+// erreurs des champs figurent dans errors.
+func (h *GraphQLHandler) execute(w http.ResponseWriter, r *http.Request, req graphqlRequest, options graph.Options) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	p, ok := principal.FromContext(r.Context())
+	if !ok {
+		respondError(w, r, http.StatusUnauthorized, "authentification requise", nil)
+		return
+	}
+	if p.OrganizationID != orgID {
+		respondError(w, r, http.StatusForbidden, "accès refusé", nil)
+		return
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		respondError(w, r, http.StatusBadRequest, "query requis", nil)
+		return
+	}
+
+	resp := h.schema.Execute(r.Context(), graph.Request{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	}, options)
+	status := http.StatusOK
+	if resp.Rejected() {
+		status = http.StatusBadRequest
+	}
+	respondJSON(w, status, resp)
+}
+
+func (h *GraphQLHandler) sdl(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_, _ = io.WriteString(w, h.schema.SDL())
+}
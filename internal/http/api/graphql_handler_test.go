@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/graph"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/principal"
+	"lms-go/internal/progress"
+)
+
+func setupGraphQLRouter(t *testing.T) (*chi.Mux, *GraphQLHandler, uuid.UUID) {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:graphqlhandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+	u, err := client.User.Create().SetOrganizationID(org.ID).SetEmail("admin@example.com").SetPasswordHash("x").SetRole("admin").Save(ctx)
+	require.NoError(t, err)
+
+	schema := graph.NewSchema(client, course.NewService(client), enrollment.NewService(client), progress.NewService(client))
+	admin := principal.Principal{Kind: principal.KindUser, ID: u.ID, OrganizationID: org.ID, Role: "admin"}
+	h := NewGraphQLHandler(schema, graph.Options{MaxDepth: 3})
+	router := chi.NewRouter()
+	router.Route("/graphql", func(r chi.Router) {
+		h.MountSchema(r)
+		r.Group(func(cr chi.Router) {
+			cr.Use(httpmiddleware.TenantFromHeader, asPrincipal(admin))
+			h.Mount(cr)
+		})
+	})
+	return router, h, org.ID
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	router, h, orgID := setupGraphQLRouter(t)
+
+	post := func(query string) (*httptest.ResponseRecorder, map[string]any) {
+		body, _ := json.Marshal(map[string]any{"query": query})
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/graphql", orgID, body))
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return rec, resp
+	}
+
+	rec, resp := post("{ viewer { role } }")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, map[string]any{"viewer": map[string]any{"role": "admin"}}, resp["data"])
+
+	rec, resp = post(`mutation { createCourse(input: {title: "Réseaux"}) { title } }`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, map[string]any{"createCourse": map[string]any{"title": "Réseaux"}}, resp["data"])
+
+	rec, resp = post("{ viewer")
+	require.Equal(t, http.StatusBadRequest, rec.Code, "erreur de syntaxe")
+	require.NotEmpty(t, resp["errors"])
+	require.NotContains(t, resp, "data")
+
+	rec, _ = post("  ")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, resp = post("{ viewer { enrollments { course { title } } } }")
+	require.Equal(t, http.StatusBadRequest, rec.Code, "profondeur")
+	require.Equal(t, "QUERY_TOO_COMPLEX", resp["errors"].([]any)[0].(map[string]any)["extensions"].(map[string]any)["code"])
+
+	// GET n'accepte que les requêtes, pas les mutations.
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/graphql?query="+url.QueryEscape(`mutation { createCourse(input: {title: "Réseaux"}) { title } }`), orgID, nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, reqWithOrg(http.MethodGet, "/graphql?query="+url.QueryEscape("{ viewer { role } }"), orgID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Le principal doit appartenir à l'organisation demandée.
+	rec = httptest.NewRecorder()
+	body, _ := json.Marshal(map[string]any{"query": "{ viewer { role } }"})
+	router.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/graphql", uuid.New(), body))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql/schema", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	require.Contains(t, rec.Body.String(), "type Query")
+
+	anonymous := chi.NewRouter()
+	anonymous.Use(httpmiddleware.TenantFromHeader)
+	h.Mount(anonymous)
+	rec = httptest.NewRecorder()
+	anonymous.ServeHTTP(rec, reqWithOrg(http.MethodPost, "/", orgID, body))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/magiclink"
+)
+
+// MagicLinkHandler expose la connexion par lien magique envoyé par email.
+type MagicLinkHandler struct {
+	service   *magiclink.Service
+	publicURL string
+	limiter   []func(http.Handler) http.Handler
+}
+
+// NewMagicLinkHandler crée un MagicLinkHandler. publicURL sert à construire l'URL de consommation
+// incluse dans l'email ; elle n'est jamais déduite de la requête (entêtes Host falsifiables), et le
+// service doit alors disposer de sa propre LinkURL.
+func NewMagicLinkHandler(service *magiclink.Service, publicURL string) *MagicLinkHandler {
+	return &MagicLinkHandler{service: service, publicURL: strings.TrimRight(publicURL, "/")}
+}
+
+// LimitRequests applique les middlewares donnés (limitation par email et IP) à la demande de lien.
+func (h *MagicLinkHandler) LimitRequests(middlewares ...func(http.Handler) http.Handler) {
+	h.limiter = append(h.limiter, middlewares...)
+}
+
+// Mount enregistre les routes sur le routeur /auth.
+func (h *MagicLinkHandler) Mount(r chi.Router) {
+	r.With(h.limiter...).Post("/magic-link", h.request)
+	r.Get("/magic-link/consume", h.confirm)
+	r.Post("/magic-link/consume", h.consume)
+}
+
+type magicLinkRequest struct {
+	Email   string `json:"email" openapi:"required"`
+	OrgSlug string `json:"org_slug,omitempty"`
+}
+
+type magicLinkConsumeRequest struct {
+	Token string `json:"token" openapi:"required"`
+}
+
+// confirmPage est servie par le GET du lien : les scanners de messagerie qui suivent les liens ne
+// consomment pas le jeton, seul l'envoi du formulaire (POST) ouvre la session.
+var confirmPage = template.Must(template.New("magic-link").Parse(`<!doctype html>
+<html lang="fr">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Connexion</title></head>
+<body>
+<form method="post" action="consume{{if .UseCookies}}?use_cookies=true{{end}}">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit">Se connecter</button>
+</form>
+</body>
+</html>
+`))
+
+func (h *MagicLinkHandler) request(w http.ResponseWriter, r *http.Request) {
+	var req magicLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	err := h.service.Request(r.Context(), magiclink.RequestInput{
+		Email:      req.Email,
+		OrgSlug:    req.OrgSlug,
+		ConsumeURL: h.consumeURL(),
+		UseCookies: r.URL.Query().Get("use_cookies") == "true",
+	})
+	if errors.Is(err, magiclink.ErrInvalidEmail) {
+		respondError(w, r, http.StatusBadRequest, "email invalide", err)
+		return
+	}
+	if err != nil {
+		// La réponse reste identique pour ne pas révéler l'existence du compte.
+		slog.ErrorContext(r.Context(), "magic link request failed", "error", err)
+	}
+
+	respondJSON(w, http.StatusAccepted, messageResponse{
+		Message: "Si un compte existe avec cet email, un lien de connexion a été envoyé",
+	})
+}
+
+// consumeURL renvoie l'endpoint de consommation, vide sans URL publique configurée.
+func (h *MagicLinkHandler) consumeURL() string {
+	if h.publicURL == "" {
+		return ""
+	}
+	return h.publicURL + "/auth/magic-link/consume"
+}
+
+func (h *MagicLinkHandler) confirm(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		respondError(w, r, http.StatusBadRequest, "token manquant", nil)
+		return
+	}
+	// Le jeton figure dans l'URL : ni cache ni Referer.
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = confirmPage.Execute(w, struct {
+		Token      string
+		UseCookies bool
+	}{token, r.URL.Query().Get("use_cookies") == "true"})
+}
+
+func (h *MagicLinkHandler) consume(w http.ResponseWriter, r *http.Request) {
+	var token string
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var req magicLinkConsumeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+			return
+		}
+		token = req.Token
+	} else {
+		token = r.PostFormValue("token")
+	}
+	if token == "" {
+		respondError(w, r, http.StatusBadRequest, "token manquant", nil)
+		return
+	}
+
+	_, tokens, err := h.service.Consume(r.Context(), token)
+	if err != nil {
+		var challenge *auth.MFAChallengeError
+		switch {
+		case errors.As(err, &challenge):
+			respondMFAChallenge(w, challenge)
+		case errors.Is(err, magiclink.ErrInvalidToken):
+			respondError(w, r, http.StatusUnauthorized, "lien invalide ou expiré", err)
+		case errors.Is(err, magiclink.ErrDisabled):
+			respondError(w, r, http.StatusForbidden, "connexion par lien désactivée pour cette organisation", err)
+		case errors.Is(err, auth.ErrUserInactive):
+			respondError(w, r, http.StatusForbidden, "compte inactif", err)
+		case errors.Is(err, auth.ErrAccountLocked):
+			setRetryAfter(w, err)
+			respondError(w, r, http.StatusLocked, "compte temporairement verrouillé suite à trop d'échecs", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+		}
+		return
+	}
+
+	if r.URL.Query().Get("use_cookies") == "true" {
+		setAuthCookies(w, r, tokens)
+	}
+
+	respondJSON(w, http.StatusOK, authResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/auth"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/magiclink"
+	"lms-go/internal/platform/mail"
+	"lms-go/internal/platform/ratelimit"
+)
+
+func TestMagicLinkHandler_RequestAndConsume(t *testing.T) {
+	client, svc, orgID := setupAuthTest(t)
+	ctx := context.Background()
+	_, err := client.Organization.UpdateOneID(orgID).
+		SetSettings(map[string]any{"magic_link": map[string]any{"enabled": true}}).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = svc.Register(ctx, auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "magic@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	sender := mail.NewMemorySender()
+	handler := NewMagicLinkHandler(magiclink.NewService(client, svc, sender, magiclink.Config{Secret: "link-secret"}), "https://api.lms.test")
+	handler.LimitRequests(httpmiddleware.RateLimit(
+		ratelimit.NewMemoryLimiter(),
+		ratelimit.PerMinute(1, 2),
+		httpmiddleware.ByIP("magic-link"),
+		httpmiddleware.ByJSONField("magic-link", "email"),
+	))
+	r := chi.NewRouter()
+	r.Route("/auth", handler.Mount)
+
+	request := func(email, remote string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(magicLinkRequest{Email: email})
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link?use_cookies=true", bytes.NewReader(body))
+		req.RemoteAddr = remote
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusAccepted, request("unknown@example.com", "10.0.0.1:1").Code)
+	require.Empty(t, sender.Messages())
+
+	require.Equal(t, http.StatusAccepted, request("magic@example.com", "10.0.0.2:1").Code)
+	messages := sender.Messages()
+	require.Len(t, messages, 1)
+
+	var link *url.URL
+	for _, line := range strings.Split(messages[0].Text, "\n") {
+		if strings.HasPrefix(line, "https://api.lms.test/auth/magic-link/consume?") {
+			link, err = url.Parse(line)
+			require.NoError(t, err)
+		}
+	}
+	require.NotNil(t, link)
+
+	// Le GET (suivi par les scanners de messagerie) n'affiche qu'une confirmation.
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, link.RequestURI(), nil)
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+		require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+		require.Contains(t, rec.Body.String(), `method="post"`)
+		require.Contains(t, rec.Body.String(), `action="consume?use_cookies=true"`)
+	}
+
+	consume := func() *httptest.ResponseRecorder {
+		form := url.Values{"token": {link.Query().Get("token")}}
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link/consume?use_cookies=true", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+	rec := consume()
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var session authResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	require.NotEmpty(t, session.AccessToken)
+	require.Len(t, rec.Result().Cookies(), 2)
+
+	require.Equal(t, http.StatusUnauthorized, consume().Code)
+
+	// Le seau de l'email est vide, même depuis une nouvelle IP.
+	require.Equal(t, http.StatusAccepted, request("magic@example.com", "10.0.0.3:1").Code)
+	rec = request("MAGIC@example.com", "10.0.0.4:1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestMagicLinkHandler_LinkIgnoresRequestHost(t *testing.T) {
+	client, svc, orgID := setupAuthTest(t)
+	ctx := context.Background()
+	_, err := client.Organization.UpdateOneID(orgID).
+		SetSettings(map[string]any{"magic_link": map[string]any{"enabled": true}}).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = svc.Register(ctx, auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "spoof@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	request := func(handler *MagicLinkHandler) *httptest.ResponseRecorder {
+		r := chi.NewRouter()
+		r.Route("/auth", handler.Mount)
+		body, _ := json.Marshal(magicLinkRequest{Email: "spoof@example.com"})
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link", bytes.NewReader(body))
+		req.Host = "evil.example"
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "evil.example")
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	sender := mail.NewMemorySender()
+	service := magiclink.NewService(client, svc, sender, magiclink.Config{Secret: "link-secret"})
+	require.Equal(t, http.StatusAccepted, request(NewMagicLinkHandler(service, "https://api.lms.test/")).Code)
+	messages := sender.Messages()
+	require.Len(t, messages, 1)
+	require.NotContains(t, messages[0].Text, "evil.example")
+	require.Contains(t, messages[0].Text, "https://api.lms.test/auth/magic-link/consume?token=")
+
+	// Sans URL configurée, aucun lien n'est envoyé plutôt que de se fier à l'entête Host.
+	sender = mail.NewMemorySender()
+	service = magiclink.NewService(client, svc, sender, magiclink.Config{Secret: "link-secret"})
+	require.Equal(t, http.StatusAccepted, request(NewMagicLinkHandler(service, "")).Code)
+	require.Empty(t, sender.Messages())
+}
@@ -0,0 +1,247 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+)
+
+// mountMFA enregistre les routes du second facteur sous /mfa.
+func (h *AuthHandler) mountMFA(r chi.Router) {
+	r.With(h.credentialLimiter...).Post("/mfa/verify", h.handleMFAVerify)
+	r.Get("/mfa", h.handleMFAStatus)
+	r.Post("/mfa/totp/enroll", h.handleTOTPEnroll)
+	r.Post("/mfa/totp/confirm", h.handleTOTPConfirm)
+	// Régénération et désactivation exigent une session : le token de défi ne suffit pas.
+	r.With(h.credentialLimiter...).Post("/mfa/recovery-codes", h.handleRecoveryCodes)
+	r.With(h.credentialLimiter...).Post("/mfa/disable", h.handleMFADisable)
+}
+
+type mfaChallengeResponse struct {
+	MFARequired        bool   `json:"mfa_required"`
+	MFAToken           string `json:"mfa_token"`
+	ExpiresAt          string `json:"expires_at"`
+	EnrollmentRequired bool   `json:"enrollment_required"`
+}
+
+type mfaVerifyRequest struct {
+	MFAToken string `json:"mfa_token" openapi:"required"`
+	Code     string `json:"code" openapi:"required"`
+}
+
+type mfaCodeRequest struct {
+	Code string `json:"code" openapi:"required"`
+}
+
+type mfaStatusResponse struct {
+	Enabled                bool    `json:"enabled"`
+	EnabledAt              *string `json:"enabled_at,omitempty"`
+	Required               bool    `json:"required"`
+	RecoveryCodesRemaining int     `json:"recovery_codes_remaining"`
+}
+
+type totpEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type recoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// respondMFAChallenge répond à un login valide dont le second facteur reste à fournir.
+func respondMFAChallenge(w http.ResponseWriter, challenge *auth.MFAChallengeError) {
+	respondJSON(w, http.StatusOK, mfaChallengeResponse{
+		MFARequired:        true,
+		MFAToken:           challenge.Token,
+		ExpiresAt:          challenge.ExpiresAt.Format(time.RFC3339),
+		EnrollmentRequired: challenge.EnrollmentRequired,
+	})
+}
+
+func (h *AuthHandler) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
+	var req mfaVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	if req.MFAToken == "" || req.Code == "" {
+		respondError(w, r, http.StatusBadRequest, "mfa_token et code requis", nil)
+		return
+	}
+
+	tokens, err := h.service.VerifyMFA(r.Context(), req.MFAToken, req.Code)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+
+	if r.URL.Query().Get("use_cookies") == "true" {
+		setAuthCookies(w, r, tokens)
+	}
+
+	respondJSON(w, http.StatusOK, authResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Format(time.RFC3339),
+	})
+}
+
+func (h *AuthHandler) handleMFAStatus(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.mfaSubject(w, r)
+	if !ok {
+		return
+	}
+	status, err := h.service.MFAStatus(r.Context(), user.ID)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+	resp := mfaStatusResponse{
+		Enabled:                status.Enabled,
+		Required:               status.Required,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}
+	if status.EnabledAt != nil {
+		enabledAt := status.EnabledAt.Format(time.RFC3339)
+		resp.EnabledAt = &enabledAt
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) handleTOTPEnroll(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.mfaSubject(w, r)
+	if !ok {
+		return
+	}
+	enrollment, err := h.service.BeginTOTPEnrollment(r.Context(), user.ID)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, totpEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.URI,
+	})
+}
+
+func (h *AuthHandler) handleTOTPConfirm(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.mfaSubject(w, r)
+	if !ok {
+		return
+	}
+	code, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+	codes, err := h.service.ConfirmTOTPEnrollment(r.Context(), user.ID, code)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (h *AuthHandler) handleRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.accessSubject(w, r)
+	if !ok {
+		return
+	}
+	code, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+	codes, err := h.service.RegenerateRecoveryCodes(r.Context(), user.ID, code)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (h *AuthHandler) handleMFADisable(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.accessSubject(w, r)
+	if !ok {
+		return
+	}
+	code, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+	if err := h.service.DisableMFA(r.Context(), user.ID, code); err != nil {
+		respondMFAError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mfaSubject authentifie la requête par access token ou token de défi MFA.
+func (h *AuthHandler) mfaSubject(w http.ResponseWriter, r *http.Request) (*ent.User, bool) {
+	token := extractAccessToken(r)
+	if token == "" {
+		respondError(w, r, http.StatusUnauthorized, "token manquant", nil)
+		return nil, false
+	}
+	user, err := h.service.MFASubject(r.Context(), token)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return nil, false
+	}
+	return user, true
+}
+
+// accessSubject authentifie la requête par access token uniquement.
+func (h *AuthHandler) accessSubject(w http.ResponseWriter, r *http.Request) (*ent.User, bool) {
+	token := extractAccessToken(r)
+	if token == "" {
+		respondError(w, r, http.StatusUnauthorized, "token manquant", nil)
+		return nil, false
+	}
+	user, err := h.service.AccessSubject(r.Context(), token)
+	if err != nil {
+		respondMFAError(w, r, err)
+		return nil, false
+	}
+	return user, true
+}
+
+func decodeMFACode(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req mfaCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return "", false
+	}
+	if req.Code == "" {
+		respondError(w, r, http.StatusBadRequest, "code requis", nil)
+		return "", false
+	}
+	return req.Code, true
+}
+
+func respondMFAError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, auth.ErrInvalidToken):
+		respondError(w, r, http.StatusUnauthorized, "token invalide", err)
+	case errors.Is(err, auth.ErrInvalidMFACode):
+		respondError(w, r, http.StatusUnauthorized, "code de vérification invalide", err)
+	case errors.Is(err, auth.ErrUserInactive):
+		respondError(w, r, http.StatusForbidden, "compte inactif", err)
+	case errors.Is(err, auth.ErrAccountLocked):
+		setRetryAfter(w, err)
+		respondError(w, r, http.StatusLocked, "compte temporairement verrouillé suite à trop d'échecs", err)
+	case errors.Is(err, auth.ErrMFAAlreadyEnabled):
+		respondError(w, r, http.StatusConflict, "second facteur déjà activé", err)
+	case errors.Is(err, auth.ErrMFANotEnabled):
+		respondError(w, r, http.StatusBadRequest, "second facteur non activé", err)
+	case errors.Is(err, auth.ErrMFARequired):
+		respondError(w, r, http.StatusForbidden, "second facteur imposé par l'organisation", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/auth"
+)
+
+// testTOTPCode calcule le code courant comme le ferait une application d'authentification.
+func testTOTPCode(t *testing.T, secret string) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(time.Now().Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
+
+func TestAuthHandler_MFAFlow(t *testing.T) {
+	_, svc, orgID := setupAuthTest(t)
+	handler := NewAuthHandler(svc)
+	r := chi.NewRouter()
+	handler.Mount(r)
+
+	ctx := context.Background()
+	_, err := svc.Register(ctx, auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "mfa@example.com",
+		Password:       "supersecret",
+		Role:           "admin",
+	})
+	require.NoError(t, err)
+	tokens, err := svc.Login(ctx, "mfa@example.com", "supersecret")
+	require.NoError(t, err)
+
+	call := func(method, target, token string, payload any) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(&body).Encode(payload))
+		}
+		req := httptest.NewRequest(method, target, &body)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := call(http.MethodGet, "/mfa", "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodPost, "/mfa/totp/enroll", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var enrollment totpEnrollmentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &enrollment))
+	require.Contains(t, enrollment.ProvisioningURI, "otpauth://totp/")
+
+	rec = call(http.MethodPost, "/mfa/totp/confirm", tokens.AccessToken, mfaCodeRequest{Code: "abc"})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodPost, "/mfa/totp/confirm", tokens.AccessToken, mfaCodeRequest{Code: testTOTPCode(t, enrollment.Secret)})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var recovery recoveryCodesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &recovery))
+	require.Len(t, recovery.RecoveryCodes, 10)
+
+	rec = call(http.MethodPost, "/mfa/totp/enroll", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = call(http.MethodPost, "/login", "", loginRequest{Email: "mfa@example.com", Password: "supersecret"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var challenge mfaChallengeResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &challenge))
+	require.True(t, challenge.MFARequired)
+	require.False(t, challenge.EnrollmentRequired)
+	require.NotEmpty(t, challenge.MFAToken)
+
+	// Le token de défi ne donne pas accès au profil.
+	rec = call(http.MethodGet, "/me", challenge.MFAToken, nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodPost, "/mfa/verify?use_cookies=true", "", mfaVerifyRequest{MFAToken: challenge.MFAToken, Code: recovery.RecoveryCodes[0]})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var session authResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	require.NotEmpty(t, session.AccessToken)
+	require.Len(t, rec.Result().Cookies(), 2)
+
+	rec = call(http.MethodPost, "/mfa/verify", "", mfaVerifyRequest{MFAToken: challenge.MFAToken, Code: recovery.RecoveryCodes[0]})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodGet, "/mfa", session.AccessToken, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status mfaStatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.True(t, status.Enabled)
+	require.Equal(t, 9, status.RecoveryCodesRemaining)
+
+	// Régénération et désactivation refusent le token de défi.
+	rec = call(http.MethodPost, "/mfa/recovery-codes", challenge.MFAToken, mfaCodeRequest{Code: recovery.RecoveryCodes[1]})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = call(http.MethodPost, "/mfa/disable", challenge.MFAToken, mfaCodeRequest{Code: recovery.RecoveryCodes[1]})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodPost, "/mfa/disable", session.AccessToken, mfaCodeRequest{Code: recovery.RecoveryCodes[1]})
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = call(http.MethodPost, "/login", "", loginRequest{Email: "mfa@example.com", Password: "supersecret"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	require.NotEmpty(t, session.AccessToken)
+}
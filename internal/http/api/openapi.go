@@ -0,0 +1,520 @@
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/scim"
+)
+
+// OpenAPIHandler sert la description OpenAPI 3.1 de l'API, construite à partir des structures
+// de requête et de réponse des handlers, et une page de documentation autonome.
+type OpenAPIHandler struct {
+	document  []byte
+	schemas   map[string]*schema
+	validated []validatedRoute
+}
+
+//go:embed openapi_docs.html
+var openAPIDocsPage []byte
+
+func NewOpenAPIHandler(publicURL string) *OpenAPIHandler {
+	h := &OpenAPIHandler{}
+	b := newSchemaBuilder()
+	paths := map[string]map[string]any{}
+	for _, op := range apiOperations {
+		item := paths[op.path]
+		if item == nil {
+			item = map[string]any{}
+			paths[op.path] = item
+		}
+		item[strings.ToLower(op.method)] = op.describe(b)
+		if op.request != nil && op.requestType == "" {
+			h.validated = append(h.validated, validatedRoute{
+				method:   op.method,
+				segments: strings.Split(strings.Trim(op.path, "/"), "/"),
+				body:     b.of(op.request),
+			})
+		}
+	}
+	h.schemas = b.components
+
+	doc := map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":       "lms-go API",
+			"version":     "1.0",
+			"description": "API REST de la plateforme LMS. Les routes multi-tenant lisent l'organisation dans X-Org-ID ; une clé d'API de compte de service la fixe d'elle-même.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": b.components,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "Access token JWT, ou clé d'API d'un compte de service sur les routes multi-tenant.",
+				},
+				"cookieAuth": map[string]any{
+					"type": "apiKey",
+					"in":   "cookie",
+					"name": "access_token",
+				},
+				"scimToken": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "Jeton SCIM émis par organisation via /orgs/{id}/scim/tokens.",
+				},
+			},
+		},
+		"tags": openAPITags,
+	}
+	if publicURL != "" {
+		doc["servers"] = []map[string]string{{"url": strings.TrimSuffix(publicURL, "/")}}
+	}
+	h.document, _ = json.MarshalIndent(doc, "", "  ")
+	return h
+}
+
+func (h *OpenAPIHandler) Mount(r chi.Router) {
+	r.Get("/openapi.json", h.spec)
+	r.Get("/docs", h.docs)
+}
+
+func (h *OpenAPIHandler) spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_, _ = w.Write(h.document)
+}
+
+func (h *OpenAPIHandler) docs(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(openAPIDocsPage)
+}
+
+// authMode désigne le schéma d'authentification d'une opération.
+type authMode int
+
+const (
+	authUser   authMode = iota // access token (entête ou cookie)
+	authTenant                 // access token ou clé d'API, organisation via X-Org-ID
+	authPublic
+	authSCIM
+)
+
+// conditional désigne les en-têtes de concurrence optimiste acceptés par une opération.
+type conditional int
+
+const (
+	condNone  conditional = iota
+	condRead              // ETag renvoyé, If-None-Match (304)
+	condWrite             // If-Match (412)
+)
+
+type queryParam struct {
+	name, kind, description string
+}
+
+// oneOf documente une réponse dont la forme dépend du cas (par exemple un défi MFA à la place
+// des jetons).
+type oneOf []any
+
+// operation décrit une route montée par cmd/api. La table apiOperations est la source de la
+// description servie et du middleware de validation.
+type operation struct {
+	method, path string
+	tag, summary string
+	auth         authMode
+	query        []queryParam
+	conditional  conditional
+	// idempotent : l'entête Idempotency-Key rejoue la réponse d'une tentative précédente.
+	idempotent bool
+	// request est décodé en JSON, sauf si requestType précise un autre type de corps (non validé).
+	request     any
+	requestType string
+	status      int
+	// response est encodé en JSON, sauf si responseType précise un autre type ; nil : pas de corps.
+	response     any
+	responseType string
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type validationErrorResponse struct {
+	Error   string   `json:"error"`
+	Details []string `json:"details"`
+}
+
+func (op operation) describe(b *schemaBuilder) map[string]any {
+	out := map[string]any{
+		"tags":        []string{op.tag},
+		"summary":     op.summary,
+		"operationId": operationID(op.method, op.path),
+	}
+	var params []map[string]any
+	for _, name := range pathParams(op.path) {
+		params = append(params, map[string]any{
+			"name": name, "in": "path", "required": true, "schema": pathParamSchema(name),
+		})
+	}
+	for _, q := range op.query {
+		params = append(params, map[string]any{
+			"name": q.name, "in": "query", "description": q.description,
+			"schema": &schema{Types: []string{q.kind}},
+		})
+	}
+
+	errorType := "application/json"
+	errorSchema := b.of(errorResponse{})
+	switch op.auth {
+	case authUser:
+		out["security"] = []map[string][]string{{"bearerAuth": {}}, {"cookieAuth": {}}}
+	case authTenant:
+		out["security"] = []map[string][]string{{"bearerAuth": {}}, {"cookieAuth": {}}}
+		params = append(params, map[string]any{
+			"name": "X-Org-ID", "in": "header",
+			"description": "Organisation ciblée ; facultatif avec une clé d'API, qui porte la sienne.",
+			"schema":      &schema{Types: []string{"string"}, Format: "uuid"},
+		})
+	case authPublic:
+		out["security"] = []map[string][]string{}
+	case authSCIM:
+		out["security"] = []map[string][]string{{"scimToken": {}}}
+		errorType, errorSchema = scimContentType, b.of(scimErrorResponse{})
+	}
+	switch op.conditional {
+	case condRead:
+		params = append(params, map[string]any{
+			"name": "If-None-Match", "in": "header",
+			"description": "ETag déjà détenu : 304 sans corps s'il est toujours courant.",
+			"schema":      &schema{Types: []string{"string"}},
+		})
+	case condWrite:
+		params = append(params, map[string]any{
+			"name": "If-Match", "in": "header",
+			"description": "ETag de la version modifiée : 412 si la ressource a changé depuis.",
+			"schema":      &schema{Types: []string{"string"}},
+		})
+	}
+	if op.idempotent {
+		params = append(params, map[string]any{
+			"name": "Idempotency-Key", "in": "header",
+			"description": "Clé choisie par le client : une nouvelle tentative avec la même clé et le même corps renvoie la réponse d'origine (24 h par défaut, 255 caractères au plus).",
+			"schema":      &schema{Types: []string{"string"}},
+		})
+	}
+	if params != nil {
+		out["parameters"] = params
+	}
+
+	responses := map[string]any{
+		"default": map[string]any{
+			"description": "Erreur",
+			"content":     map[string]any{errorType: map[string]any{"schema": errorSchema}},
+		},
+	}
+	if op.request != nil {
+		mediaType := op.requestType
+		if mediaType == "" {
+			mediaType = "application/json"
+			responses["400"] = map[string]any{
+				"description": "Requête invalide ; avec la validation activée, details liste les champs en défaut.",
+				"content":     map[string]any{"application/json": map[string]any{"schema": b.of(validationErrorResponse{})}},
+			}
+		}
+		out["requestBody"] = map[string]any{
+			"required": true,
+			"content":  map[string]any{mediaType: map[string]any{"schema": requestSchema(b, op.request)}},
+		}
+	}
+	success := map[string]any{"description": http.StatusText(op.status)}
+	if op.response != nil {
+		mediaType := op.responseType
+		if mediaType == "" {
+			mediaType = "application/json"
+		}
+		success["content"] = map[string]any{mediaType: map[string]any{"schema": responseSchema(b, op.response)}}
+	}
+	switch op.conditional {
+	case condRead:
+		responses["304"] = map[string]any{"description": "Représentation inchangée"}
+	case condWrite:
+		responses["412"] = map[string]any{
+			"description": "La ressource a changé depuis l'ETag fourni par If-Match.",
+			"content":     map[string]any{errorType: map[string]any{"schema": errorSchema}},
+		}
+	}
+	headers := map[string]any{}
+	if op.conditional != condNone && op.response != nil {
+		headers["ETag"] = map[string]any{
+			"description": "Version de la ressource",
+			"schema":      &schema{Types: []string{"string"}},
+		}
+	}
+	if op.idempotent {
+		responses["409"] = map[string]any{
+			"description": "Une requête portant la même clé d'idempotence est en cours.",
+			"content":     map[string]any{errorType: map[string]any{"schema": errorSchema}},
+		}
+		responses["422"] = map[string]any{
+			"description": "La clé d'idempotence a déjà servi pour une autre requête.",
+			"content":     map[string]any{errorType: map[string]any{"schema": errorSchema}},
+		}
+		headers["Idempotent-Replayed"] = map[string]any{
+			"description": "Présent (true) lorsque la réponse est rejouée.",
+			"schema":      &schema{Types: []string{"string"}},
+		}
+	}
+	if len(headers) > 0 {
+		success["headers"] = headers
+	}
+	responses[strconv.Itoa(op.status)] = success
+	out["responses"] = responses
+	return out
+}
+
+func requestSchema(b *schemaBuilder, v any) *schema {
+	if s, ok := v.(*schema); ok {
+		return s
+	}
+	return b.of(v)
+}
+
+func responseSchema(b *schemaBuilder, v any) *schema {
+	switch v := v.(type) {
+	case *schema:
+		return v
+	case oneOf:
+		s := &schema{}
+		for _, alt := range v {
+			s.OneOf = append(s.OneOf, b.of(alt))
+		}
+		return s
+	}
+	return b.of(v)
+}
+
+func pathParams(p string) []string {
+	var names []string
+	for _, segment := range strings.Split(p, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, segment[1:len(segment)-1])
+		}
+	}
+	return names
+}
+
+func pathParamSchema(name string) *schema {
+	switch {
+	case name == "revision":
+		return &schema{Types: []string{"integer"}}
+	case name == "id" || strings.HasSuffix(name, "Id"):
+		return &schema{Types: []string{"string"}, Format: "uuid"}
+	}
+	return &schema{Types: []string{"string"}}
+}
+
+// operationID dérive un identifiant stable de la méthode et du chemin :
+// POST /courses/{id}/publish → postCoursesIdPublish.
+func operationID(method, p string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.FieldsFunc(p, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '.'
+	}) {
+		b.WriteString(capitalize(segment))
+	}
+	return b.String()
+}
+
+var (
+	binaryBody = &schema{Types: []string{"string"}, Format: "binary"}
+	textBody   = &schema{Types: []string{"string"}}
+	freeObject = &schema{Types: []string{"object"}}
+)
+
+type statusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type samlACSForm struct {
+	SAMLResponse string `json:"SAMLResponse"`
+	RelayState   string `json:"RelayState"`
+}
+
+var useCookies = queryParam{"use_cookies", "boolean", "true : jetons posés en cookies httpOnly"}
+
+var openAPITags = []map[string]string{
+	{"name": "système", "description": "Santé du service et documentation"},
+	{"name": "auth", "description": "Authentification par mot de passe, MFA, SSO, lien magique et passkeys"},
+	{"name": "organisations", "description": "Organisations, SSO, SCIM et comptes de service"},
+	{"name": "utilisateurs", "description": "Utilisateurs et demandes RGPD"},
+	{"name": "contenus", "description": "Fichiers, révisions et dépôts multipart"},
+	{"name": "cours", "description": "Cours et modules"},
+	{"name": "inscriptions", "description": "Inscriptions, groupes et progression"},
+	{"name": "recherche", "description": "Recherche plein texte"},
+	{"name": "graphql", "description": "Graphe cours, modules, inscriptions et progression"},
+	{"name": "stockage", "description": "URL signées du stockage local et du mode proxy"},
+	{"name": "scim", "description": "Provisioning SCIM 2.0"},
+}
+
+var apiOperations = []operation{
+	{method: "GET", path: "/", tag: "système", summary: "Identité du service", auth: authPublic, status: 200, response: map[string]string{}},
+	{method: "GET", path: "/healthz", tag: "système", summary: "Sonde de vie", auth: authPublic, status: 200, response: statusResponse{}},
+	{method: "GET", path: "/readyz", tag: "système", summary: "Sonde de disponibilité (base de données)", auth: authPublic, status: 200, response: statusResponse{}},
+	{method: "GET", path: "/openapi.json", tag: "système", summary: "Cette description OpenAPI", auth: authPublic, status: 200, response: freeObject},
+	{method: "GET", path: "/docs", tag: "système", summary: "Documentation interactive", auth: authPublic, status: 200, response: textBody, responseType: "text/html"},
+
+	{method: "POST", path: "/auth/register", tag: "auth", summary: "Crée un utilisateur dans une organisation existante", auth: authPublic, request: registerRequest{}, status: 201, response: registerResponse{}},
+	{method: "POST", path: "/auth/signup", tag: "auth", summary: "Crée une organisation et son administrateur", auth: authPublic, query: []queryParam{useCookies}, request: signupRequest{}, status: 201, response: signupResponse{}},
+	{method: "POST", path: "/auth/login", tag: "auth", summary: "Connexion par mot de passe", auth: authPublic, query: []queryParam{useCookies}, request: loginRequest{}, status: 200, response: oneOf{authResponse{}, mfaChallengeResponse{}}},
+	{method: "POST", path: "/auth/refresh", tag: "auth", summary: "Renouvelle les jetons (corps ou cookie refresh_token)", auth: authPublic, query: []queryParam{useCookies}, request: refreshRequest{}, status: 200, response: authResponse{}},
+	{method: "POST", path: "/auth/forgot-password", tag: "auth", summary: "Demande de réinitialisation du mot de passe", auth: authPublic, request: forgotPasswordRequest{}, status: 200, response: messageResponse{}},
+	{method: "GET", path: "/auth/me", tag: "auth", summary: "Utilisateur et organisation courants", status: 200, response: meResponse{}},
+	{method: "POST", path: "/auth/logout", tag: "auth", summary: "Efface les cookies de session", auth: authPublic, status: 204},
+	{method: "POST", path: "/auth/mfa/verify", tag: "auth", summary: "Termine une connexion avec le second facteur", auth: authPublic, query: []queryParam{useCookies}, request: mfaVerifyRequest{}, status: 200, response: authResponse{}},
+	{method: "GET", path: "/auth/mfa", tag: "auth", summary: "État de la MFA", status: 200, response: mfaStatusResponse{}},
+	{method: "POST", path: "/auth/mfa/totp/enroll", tag: "auth", summary: "Génère un secret TOTP", status: 200, response: totpEnrollmentResponse{}},
+	{method: "POST", path: "/auth/mfa/totp/confirm", tag: "auth", summary: "Active la MFA et renvoie les codes de secours", request: mfaCodeRequest{}, status: 200, response: recoveryCodesResponse{}},
+	{method: "POST", path: "/auth/mfa/recovery-codes", tag: "auth", summary: "Régénère les codes de secours", request: mfaCodeRequest{}, status: 200, response: recoveryCodesResponse{}},
+	{method: "POST", path: "/auth/mfa/disable", tag: "auth", summary: "Désactive la MFA", request: mfaCodeRequest{}, status: 204},
+	{method: "GET", path: "/auth/oidc/{orgSlug}/login", tag: "auth", summary: "Redirige vers le fournisseur OIDC", auth: authPublic, query: []queryParam{{"return_to", "string", "URL de retour après connexion"}, useCookies}, status: 302},
+	{method: "GET", path: "/auth/oidc/{orgSlug}/callback", tag: "auth", summary: "Retour du fournisseur OIDC", auth: authPublic, query: []queryParam{{"code", "string", ""}, {"state", "string", ""}, {"error", "string", ""}}, status: 200, response: authResponse{}},
+	{method: "GET", path: "/auth/saml/{orgSlug}/metadata", tag: "auth", summary: "Métadonnées du fournisseur de service SAML", auth: authPublic, status: 200, response: textBody, responseType: "application/samlmetadata+xml"},
+	{method: "GET", path: "/auth/saml/{orgSlug}/login", tag: "auth", summary: "Redirige vers le fournisseur SAML", auth: authPublic, query: []queryParam{{"return_to", "string", "URL de retour après connexion"}, useCookies}, status: 302},
+	{method: "POST", path: "/auth/saml/{orgSlug}/acs", tag: "auth", summary: "Assertion Consumer Service SAML", auth: authPublic, request: samlACSForm{}, requestType: "application/x-www-form-urlencoded", status: 200, response: authResponse{}},
+	{method: "POST", path: "/auth/magic-link", tag: "auth", summary: "Envoie un lien de connexion par e-mail", auth: authPublic, query: []queryParam{useCookies}, request: magicLinkRequest{}, status: 202, response: messageResponse{}},
+	{method: "GET", path: "/auth/magic-link/consume", tag: "auth", summary: "Page de confirmation du lien magique (ne consomme pas le jeton)", auth: authPublic, query: []queryParam{{"token", "string", "jeton reçu par e-mail"}, useCookies}, status: 200, response: textBody, responseType: "text/html"},
+	{method: "POST", path: "/auth/magic-link/consume", tag: "auth", summary: "Connexion par lien magique (JSON ou formulaire)", auth: authPublic, query: []queryParam{useCookies}, request: magicLinkConsumeRequest{}, status: 200, response: oneOf{authResponse{}, mfaChallengeResponse{}}},
+	{method: "POST", path: "/auth/webauthn/register/begin", tag: "auth", summary: "Débute l'enregistrement d'une passkey", status: 200, response: ceremonyResponse{}},
+	{method: "POST", path: "/auth/webauthn/register/finish", tag: "auth", summary: "Enregistre la passkey", request: registerFinishRequest{}, status: 201, response: passkeyResponse{}},
+	{method: "POST", path: "/auth/webauthn/login/begin", tag: "auth", summary: "Débute une connexion par passkey", auth: authPublic, status: 200, response: ceremonyResponse{}},
+	{method: "POST", path: "/auth/webauthn/login/finish", tag: "auth", summary: "Connexion par passkey", auth: authPublic, query: []queryParam{useCookies}, request: loginFinishRequest{}, status: 200, response: authResponse{}},
+	{method: "GET", path: "/auth/webauthn/credentials", tag: "auth", summary: "Passkeys de l'utilisateur", status: 200, response: []passkeyResponse{}},
+	{method: "DELETE", path: "/auth/webauthn/credentials/{credentialId}", tag: "auth", summary: "Supprime une passkey", status: 204},
+
+	{method: "PUT", path: "/storage/{key}", tag: "stockage", summary: "Dépôt sur URL signée (stockage local)", auth: authPublic, request: binaryBody, requestType: "application/octet-stream", status: 200},
+	{method: "GET", path: "/storage/{key}", tag: "stockage", summary: "Lecture sur URL signée (stockage local)", auth: authPublic, status: 200, response: binaryBody, responseType: "application/octet-stream"},
+	{method: "HEAD", path: "/storage/{key}", tag: "stockage", summary: "Métadonnées d'un objet sur URL signée (signée comme GET)", auth: authPublic, status: 200},
+	{method: "GET", path: "/downloads/{token}", tag: "stockage", summary: "Téléchargement en mode proxy (Range accepté)", auth: authPublic, status: 200, response: binaryBody, responseType: "application/octet-stream"},
+	{method: "HEAD", path: "/downloads/{token}", tag: "stockage", summary: "Taille et type d'un téléchargement en mode proxy", auth: authPublic, status: 200},
+
+	{method: "GET", path: "/scim/v2/ServiceProviderConfig", tag: "scim", summary: "Capacités du fournisseur SCIM", auth: authSCIM, status: 200, response: freeObject, responseType: scimContentType},
+	{method: "GET", path: "/scim/v2/Users", tag: "scim", summary: "Recherche d'utilisateurs", auth: authSCIM, query: scimListQuery, status: 200, response: scim.ListResponse[scim.User]{}, responseType: scimContentType},
+	{method: "POST", path: "/scim/v2/Users", tag: "scim", summary: "Provisionne un utilisateur", auth: authSCIM, request: scim.User{}, requestType: scimContentType, status: 201, response: scim.User{}, responseType: scimContentType},
+	{method: "GET", path: "/scim/v2/Users/{id}", tag: "scim", summary: "Lit un utilisateur", auth: authSCIM, status: 200, response: scim.User{}, responseType: scimContentType},
+	{method: "PUT", path: "/scim/v2/Users/{id}", tag: "scim", summary: "Remplace un utilisateur", auth: authSCIM, request: scim.User{}, requestType: scimContentType, status: 200, response: scim.User{}, responseType: scimContentType},
+	{method: "PATCH", path: "/scim/v2/Users/{id}", tag: "scim", summary: "Modifie un utilisateur", auth: authSCIM, request: scim.PatchRequest{}, requestType: scimContentType, status: 200, response: scim.User{}, responseType: scimContentType},
+	{method: "DELETE", path: "/scim/v2/Users/{id}", tag: "scim", summary: "Désactive un utilisateur", auth: authSCIM, status: 204},
+	{method: "GET", path: "/scim/v2/Groups", tag: "scim", summary: "Recherche de groupes", auth: authSCIM, query: scimListQuery, status: 200, response: scim.ListResponse[scim.Group]{}, responseType: scimContentType},
+	{method: "POST", path: "/scim/v2/Groups", tag: "scim", summary: "Crée un groupe", auth: authSCIM, request: scim.Group{}, requestType: scimContentType, status: 201, response: scim.Group{}, responseType: scimContentType},
+	{method: "GET", path: "/scim/v2/Groups/{id}", tag: "scim", summary: "Lit un groupe", auth: authSCIM, status: 200, response: scim.Group{}, responseType: scimContentType},
+	{method: "PUT", path: "/scim/v2/Groups/{id}", tag: "scim", summary: "Remplace un groupe", auth: authSCIM, request: scim.Group{}, requestType: scimContentType, status: 200, response: scim.Group{}, responseType: scimContentType},
+	{method: "PATCH", path: "/scim/v2/Groups/{id}", tag: "scim", summary: "Modifie un groupe", auth: authSCIM, request: scim.PatchRequest{}, requestType: scimContentType, status: 200, response: scim.Group{}, responseType: scimContentType},
+	{method: "DELETE", path: "/scim/v2/Groups/{id}", tag: "scim", summary: "Supprime un groupe", auth: authSCIM, status: 204},
+
+	{method: "GET", path: "/orgs", tag: "organisations", summary: "Liste les organisations", query: []queryParam{{"status", "string", "active ou inactive"}}, status: 200, response: []orgResponse{}},
+	{method: "POST", path: "/orgs", tag: "organisations", summary: "Crée une organisation", request: createOrgRequest{}, status: 201, response: orgResponse{}},
+	{method: "GET", path: "/orgs/{id}", tag: "organisations", summary: "Lit une organisation", conditional: condRead, status: 200, response: orgResponse{}},
+	{method: "PATCH", path: "/orgs/{id}", tag: "organisations", summary: "Modifie une organisation", conditional: condWrite, request: updateOrgRequest{}, status: 200, response: orgResponse{}},
+	{method: "DELETE", path: "/orgs/{id}", tag: "organisations", summary: "Désactive une organisation", conditional: condWrite, status: 204},
+	{method: "POST", path: "/orgs/{id}/activate", tag: "organisations", summary: "Réactive une organisation", conditional: condWrite, status: 204},
+	{method: "PUT", path: "/orgs/{id}/saml/metadata", tag: "organisations", summary: "Dépose les métadonnées SAML du fournisseur d'identité", request: textBody, requestType: "application/xml", status: 200, response: orgResponse{}},
+	{method: "GET", path: "/orgs/{id}/scim/tokens", tag: "organisations", summary: "Liste les jetons SCIM", status: 200, response: []scimTokenResponse{}},
+	{method: "POST", path: "/orgs/{id}/scim/tokens", tag: "organisations", summary: "Émet un jeton SCIM (affiché une seule fois)", request: issueSCIMTokenRequest{}, status: 201, response: scimTokenResponse{}},
+	{method: "DELETE", path: "/orgs/{id}/scim/tokens/{tokenId}", tag: "organisations", summary: "Révoque un jeton SCIM", status: 204},
+	{method: "GET", path: "/orgs/{id}/service-accounts", tag: "organisations", summary: "Liste les comptes de service", status: 200, response: []serviceAccountResponse{}},
+	{method: "POST", path: "/orgs/{id}/service-accounts", tag: "organisations", summary: "Crée un compte de service", request: serviceAccountRequest{}, status: 201, response: serviceAccountResponse{}},
+	{method: "GET", path: "/orgs/{id}/service-accounts/{accountId}", tag: "organisations", summary: "Lit un compte de service", status: 200, response: serviceAccountResponse{}},
+	{method: "DELETE", path: "/orgs/{id}/service-accounts/{accountId}", tag: "organisations", summary: "Désactive un compte de service", status: 200, response: serviceAccountResponse{}},
+	{method: "POST", path: "/orgs/{id}/service-accounts/{accountId}/activate", tag: "organisations", summary: "Réactive un compte de service", status: 200, response: serviceAccountResponse{}},
+	{method: "GET", path: "/orgs/{id}/service-accounts/{accountId}/keys", tag: "organisations", summary: "Liste les clés d'API", status: 200, response: []apiKeyResponse{}},
+	{method: "POST", path: "/orgs/{id}/service-accounts/{accountId}/keys", tag: "organisations", summary: "Émet une clé d'API (affichée une seule fois)", request: issueAPIKeyRequest{}, status: 201, response: apiKeyResponse{}},
+	{method: "DELETE", path: "/orgs/{id}/service-accounts/{accountId}/keys/{keyId}", tag: "organisations", summary: "Révoque une clé d'API", status: 204},
+	{method: "GET", path: "/orgs/{id}/usage", tag: "organisations", summary: "Consommation du stockage", status: 200, response: usageResponse{}},
+
+	{method: "GET", path: "/users", tag: "utilisateurs", summary: "Liste les utilisateurs", auth: authTenant, query: []queryParam{{"role", "string", ""}, {"status", "string", ""}}, status: 200, response: []userResponse{}},
+	{method: "POST", path: "/users", tag: "utilisateurs", summary: "Crée un utilisateur", auth: authTenant, request: createUserRequest{}, status: 201, response: userResponse{}},
+	{method: "GET", path: "/users/{id}", tag: "utilisateurs", summary: "Lit un utilisateur", auth: authTenant, conditional: condRead, status: 200, response: userResponse{}},
+	{method: "PATCH", path: "/users/{id}", tag: "utilisateurs", summary: "Modifie un utilisateur", auth: authTenant, conditional: condWrite, request: updateUserRequest{}, status: 200, response: userResponse{}},
+	{method: "DELETE", path: "/users/{id}", tag: "utilisateurs", summary: "Désactive un utilisateur", auth: authTenant, conditional: condWrite, status: 204},
+	{method: "POST", path: "/users/{id}/activate", tag: "utilisateurs", summary: "Réactive un utilisateur", auth: authTenant, conditional: condWrite, status: 204},
+	{method: "POST", path: "/users/{id}/export", tag: "utilisateurs", summary: "Demande l'export RGPD des données", auth: authTenant, status: 202, response: privacyRequestResponse{}},
+	{method: "POST", path: "/users/{id}/erase", tag: "utilisateurs", summary: "Demande l'effacement RGPD (délai de grâce)", auth: authTenant, status: 202, response: privacyRequestResponse{}},
+	{method: "GET", path: "/users/{id}/privacy-requests", tag: "utilisateurs", summary: "Liste les demandes RGPD", auth: authTenant, status: 200, response: []privacyRequestResponse{}},
+	{method: "GET", path: "/users/{id}/privacy-requests/{requestId}", tag: "utilisateurs", summary: "Lit une demande RGPD (lien de l'archive si prête)", auth: authTenant, status: 200, response: privacyRequestResponse{}},
+	{method: "POST", path: "/users/{id}/privacy-requests/{requestId}/cancel", tag: "utilisateurs", summary: "Annule un effacement programmé", auth: authTenant, status: 200, response: privacyRequestResponse{}},
+
+	{method: "GET", path: "/contents", tag: "contenus", summary: "Liste les contenus", auth: authTenant, status: 200, response: []contentResponse{}},
+	{method: "POST", path: "/contents", tag: "contenus", summary: "Crée un contenu et son URL de dépôt", auth: authTenant, idempotent: true, request: createContentRequest{}, status: 201, response: uploadLinkResponse{}},
+	{method: "GET", path: "/contents/{id}", tag: "contenus", summary: "Lit un contenu", auth: authTenant, status: 200, response: contentResponse{}},
+	{method: "DELETE", path: "/contents/{id}", tag: "contenus", summary: "Archive un contenu", auth: authTenant, status: 204},
+	{method: "POST", path: "/contents/{id}/finalize", tag: "contenus", summary: "Finalise le dépôt", auth: authTenant, request: finalizeRequest{}, status: 200, response: contentResponse{}},
+	{method: "GET", path: "/contents/{id}/download", tag: "contenus", summary: "URL de téléchargement", auth: authTenant, query: []queryParam{{"revision", "integer", "révision servie (courante par défaut)"}, {"module_id", "string", "module consulté, pour le suivi"}}, status: 200, response: downloadLinkResponse{}},
+	{method: "GET", path: "/contents/{id}/revisions", tag: "contenus", summary: "Historique des révisions", auth: authTenant, status: 200, response: []revisionResponse{}},
+	{method: "POST", path: "/contents/{id}/revisions", tag: "contenus", summary: "Crée une révision et son URL de dépôt", auth: authTenant, request: createRevisionRequest{}, status: 201, response: revisionUploadResponse{}},
+	{method: "POST", path: "/contents/{id}/revisions/{revision}/finalize", tag: "contenus", summary: "Finalise une révision", auth: authTenant, request: finalizeRequest{}, status: 200, response: contentResponse{}},
+	{method: "POST", path: "/contents/{id}/rollback", tag: "contenus", summary: "Revient à une révision antérieure", auth: authTenant, request: rollbackRequest{}, status: 200, response: contentResponse{}},
+	{method: "POST", path: "/contents/{id}/multipart", tag: "contenus", summary: "Ouvre un dépôt multipart", auth: authTenant, request: initiateMultipartRequest{}, status: 201, response: multipartResponse{}},
+	{method: "GET", path: "/contents/{id}/multipart", tag: "contenus", summary: "Reprend un dépôt multipart", auth: authTenant, status: 200, response: multipartResponse{}},
+	{method: "POST", path: "/contents/{id}/multipart/complete", tag: "contenus", summary: "Termine un dépôt multipart (checksum_sha256 obligatoire)", auth: authTenant, request: completeMultipartRequest{}, status: 200, response: contentResponse{}},
+	{method: "DELETE", path: "/contents/{id}/multipart", tag: "contenus", summary: "Abandonne un dépôt multipart", auth: authTenant, status: 204},
+
+	{method: "GET", path: "/courses", tag: "cours", summary: "Liste les cours", auth: authTenant, query: []queryParam{{"status", "string", "draft, published ou archived"}}, status: 200, response: []courseResponse{}},
+	{method: "POST", path: "/courses", tag: "cours", summary: "Crée un cours", auth: authTenant, request: createCourseRequest{}, status: 201, response: courseResponse{}},
+	{method: "GET", path: "/courses/{id}", tag: "cours", summary: "Lit un cours et ses modules", auth: authTenant, conditional: condRead, status: 200, response: courseResponse{}},
+	{method: "PATCH", path: "/courses/{id}", tag: "cours", summary: "Modifie un cours", auth: authTenant, conditional: condWrite, request: updateCourseRequest{}, status: 200, response: courseResponse{}},
+	{method: "DELETE", path: "/courses/{id}", tag: "cours", summary: "Archive un cours", auth: authTenant, conditional: condWrite, status: 200, response: courseResponse{}},
+	{method: "DELETE", path: "/courses/{id}/hard", tag: "cours", summary: "Supprime définitivement un cours", auth: authTenant, conditional: condWrite, status: 204},
+	{method: "POST", path: "/courses/{id}/publish", tag: "cours", summary: "Publie un cours", auth: authTenant, conditional: condWrite, status: 200, response: courseResponse{}},
+	{method: "POST", path: "/courses/{id}/unpublish", tag: "cours", summary: "Repasse un cours en brouillon", auth: authTenant, conditional: condWrite, status: 200, response: courseResponse{}},
+	{method: "GET", path: "/courses/{id}/modules", tag: "cours", summary: "Liste les modules", auth: authTenant, status: 200, response: []moduleResponse{}},
+	{method: "POST", path: "/courses/{id}/modules", tag: "cours", summary: "Ajoute un module", auth: authTenant, request: moduleRequest{}, status: 201, response: moduleResponse{}},
+	{method: "POST", path: "/courses/{id}/modules/reorder", tag: "cours", summary: "Réordonne les modules", auth: authTenant, conditional: condWrite, request: reorderRequest{}, status: 204},
+	{method: "PATCH", path: "/courses/modules/{moduleId}", tag: "cours", summary: "Modifie un module", auth: authTenant, conditional: condWrite, request: moduleRequest{}, status: 200, response: moduleResponse{}},
+	{method: "DELETE", path: "/courses/modules/{moduleId}", tag: "cours", summary: "Supprime un module", auth: authTenant, conditional: condWrite, status: 204},
+
+	{method: "GET", path: "/enrollments", tag: "inscriptions", summary: "Liste les inscriptions", auth: authTenant, query: []queryParam{{"course_id", "string", ""}, {"user_id", "string", ""}, {"group_id", "string", ""}, {"status", "string", ""}}, status: 200, response: []enrollmentResponse{}},
+	{method: "POST", path: "/enrollments", tag: "inscriptions", summary: "Inscrit un utilisateur à un cours", auth: authTenant, idempotent: true, request: enrollRequest{}, status: 201, response: enrollmentResponse{}},
+	{method: "PATCH", path: "/enrollments/{id}", tag: "inscriptions", summary: "Modifie une inscription", auth: authTenant, request: updateEnrollmentRequest{}, status: 200, response: enrollmentResponse{}},
+	{method: "DELETE", path: "/enrollments/{id}", tag: "inscriptions", summary: "Annule une inscription", auth: authTenant, status: 204},
+	{method: "GET", path: "/enrollments/groups", tag: "inscriptions", summary: "Liste les groupes", auth: authTenant, query: []queryParam{{"course_id", "string", ""}}, status: 200, response: []groupResponse{}},
+	{method: "POST", path: "/enrollments/groups", tag: "inscriptions", summary: "Crée un groupe", auth: authTenant, request: createGroupRequest{}, status: 201, response: groupResponse{}},
+	{method: "PATCH", path: "/enrollments/groups/{groupId}", tag: "inscriptions", summary: "Modifie un groupe", auth: authTenant, conditional: condWrite, request: updateGroupRequest{}, status: 200, response: groupResponse{}},
+	{method: "DELETE", path: "/enrollments/groups/{groupId}", tag: "inscriptions", summary: "Supprime un groupe", auth: authTenant, conditional: condWrite, status: 204},
+	{method: "GET", path: "/enrollments/{id}/progress", tag: "inscriptions", summary: "Progression module par module", auth: authTenant, status: 200, response: []progressResponse{}},
+	{method: "POST", path: "/enrollments/{id}/progress/start", tag: "inscriptions", summary: "Démarre un module", auth: authTenant, request: progressRequest{}, status: 200, response: moduleProgressResponse{}},
+	{method: "POST", path: "/enrollments/{id}/progress/complete", tag: "inscriptions", summary: "Complète un module", auth: authTenant, idempotent: true, request: progressRequest{}, status: 200, response: moduleProgressResponse{}},
+
+	{method: "GET", path: "/search", tag: "recherche", summary: "Recherche dans les cours, modules et contenus", auth: authTenant, query: []queryParam{
+		{"q", "string", "texte recherché"},
+		{"type", "string", "course, module, content (séparés par des virgules)"},
+		{"status", "string", ""},
+		{"lang", "string", ""},
+		{"limit", "integer", ""},
+		{"offset", "integer", ""},
+	}, status: 200, response: searchResponse{}},
+
+	{method: "POST", path: "/graphql", tag: "graphql", summary: "Exécute une requête ou une mutation GraphQL", auth: authTenant, request: graphqlRequest{}, status: 200, response: graphqlResponse{}},
+	{method: "GET", path: "/graphql", tag: "graphql", summary: "Exécute une requête GraphQL (mutations refusées)", auth: authTenant, query: []queryParam{
+		{"query", "string", "document GraphQL"},
+		{"operationName", "string", ""},
+		{"variables", "string", "variables encodées en JSON"},
+	}, status: 200, response: graphqlResponse{}},
+	{method: "GET", path: "/graphql/schema", tag: "graphql", summary: "Schéma GraphQL (SDL)", auth: authPublic, status: 200, response: textBody, responseType: "text/plain"},
+}
+
+var scimListQuery = []queryParam{
+	{"filter", "string", "filtre SCIM (eq, co, sw…)"},
+	{"startIndex", "integer", "index du premier résultat, à partir de 1"},
+	{"count", "integer", "taille de page"},
+	{"excludedAttributes", "string", ""},
+}
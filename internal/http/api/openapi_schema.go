@@ -0,0 +1,177 @@
+package api
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// schema est le sous-ensemble de JSON Schema (draft 2020-12, celui d'OpenAPI 3.1) produit à
+// partir des structures de requête et de réponse, et vérifié par le middleware de validation.
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Types                []string           `json:"-"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	OneOf                []*schema          `json:"oneOf,omitempty"`
+}
+
+// MarshalJSON écrit type sous forme de chaîne, ou de tableau pour un type nullable.
+func (s *schema) MarshalJSON() ([]byte, error) {
+	type plain schema
+	out := struct {
+		Type any `json:"type,omitempty"`
+		*plain
+	}{plain: (*plain)(s)}
+	switch len(s.Types) {
+	case 0:
+	case 1:
+		out.Type = s.Types[0]
+	default:
+		out.Type = s.Types
+	}
+	return json.Marshal(out)
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+	apiPkg   = reflect.TypeOf(schema{}).PkgPath()
+)
+
+// schemaBuilder convertit des types Go en schémas ; chaque structure nommée devient un
+// composant référencé par $ref.
+type schemaBuilder struct {
+	components map[string]*schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{components: map[string]*schema{}, names: map[reflect.Type]string{}}
+}
+
+func (b *schemaBuilder) of(v any) *schema {
+	return b.build(reflect.TypeOf(v))
+}
+
+func (b *schemaBuilder) build(t reflect.Type) *schema {
+	switch t {
+	case timeType:
+		return &schema{Types: []string{"string"}, Format: "date-time"}
+	case uuidType:
+		return &schema{Types: []string{"string"}, Format: "uuid"}
+	case rawType:
+		return &schema{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(b.build(t.Elem()))
+	case reflect.String:
+		return &schema{Types: []string{"string"}}
+	case reflect.Bool:
+		return &schema{Types: []string{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &schema{Types: []string{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &schema{Types: []string{"number"}}
+	case reflect.Interface:
+		return &schema{}
+	case reflect.Slice, reflect.Array:
+		return nullable(&schema{Types: []string{"array"}, Items: b.build(t.Elem())})
+	case reflect.Map:
+		s := &schema{Types: []string{"object"}}
+		if t.Elem().Kind() != reflect.Interface {
+			s.AdditionalProperties = b.build(t.Elem())
+		}
+		return nullable(s)
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		name, ok := b.names[t]
+		if !ok {
+			name = componentName(t)
+			b.names[t] = name
+			// Réservé avant la construction pour les types récursifs.
+			b.components[name] = nil
+			b.components[name] = b.object(t)
+		}
+		return &schema{Ref: "#/components/schemas/" + name}
+	}
+	return &schema{}
+}
+
+// object décrit une structure ; les champs inconnus sont refusés.
+func (b *schemaBuilder) object(t reflect.Type) *schema {
+	s := &schema{Types: []string{"object"}, Properties: map[string]*schema{}, AdditionalProperties: false}
+	b.fields(s, t)
+	return s
+}
+
+func (b *schemaBuilder) fields(s *schema, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			b.fields(s, f.Type)
+			continue
+		}
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Properties[name] = b.build(f.Type)
+		if f.Tag.Get("openapi") == "required" {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nullable ajoute null aux types acceptés, ce que le décodage JSON tolère pour les pointeurs,
+// tranches et maps.
+func nullable(s *schema) *schema {
+	if s.Ref != "" {
+		return &schema{OneOf: []*schema{s, {Types: []string{"null"}}}}
+	}
+	if len(s.Types) == 0 {
+		return s
+	}
+	s.Types = append(s.Types, "null")
+	return s
+}
+
+// componentName met en majuscule le nom du type (courseResponse → CourseResponse) et préfixe
+// les types d'autres paquets par leur paquet (scim.User → ScimUser).
+func componentName(t reflect.Type) string {
+	name := t.Name()
+	if base, args, ok := strings.Cut(name, "["); ok {
+		name = base
+		for _, arg := range strings.Split(strings.TrimSuffix(args, "]"), ",") {
+			name += arg[strings.LastIndex(arg, ".")+1:]
+		}
+	}
+	if t.PkgPath() != apiPkg {
+		name = capitalize(path.Base(t.PkgPath())) + capitalize(name)
+	}
+	return capitalize(name)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
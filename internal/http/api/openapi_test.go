@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	router := chi.NewRouter()
+	NewOpenAPIHandler("https://lms.example.com/").Mount(router)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	raw := rec.Body.String()
+
+	var doc struct {
+		OpenAPI    string                               `json:"openapi"`
+		Servers    []struct{ URL string }               `json:"servers"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Type                 any            `json:"type"`
+				Properties           map[string]any `json:"properties"`
+				Required             []string       `json:"required"`
+				AdditionalProperties *bool          `json:"additionalProperties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(raw), &doc))
+	require.Equal(t, "3.1.0", doc.OpenAPI)
+	require.Equal(t, "https://lms.example.com", doc.Servers[0].URL)
+
+	course := doc.Components.Schemas["CourseResponse"]
+	require.Equal(t, "object", course.Type)
+	require.Contains(t, course.Properties, "published_at")
+	modules := course.Properties["modules"].(map[string]any)
+	require.Equal(t, []any{"array", "null"}, modules["type"])
+	require.Equal(t, map[string]any{"$ref": "#/components/schemas/ModuleResponse"}, modules["items"])
+	require.ElementsMatch(t, []string{"course_id", "user_id"}, doc.Components.Schemas["EnrollRequest"].Required)
+	require.False(t, *doc.Components.Schemas["EnrollRequest"].AdditionalProperties)
+	require.Contains(t, doc.Components.Schemas, "ScimListResponseUser")
+	require.Contains(t, doc.Components.Schemas["CompleteMultipartRequest"].Properties, "checksum_sha256", "champs du type embarqué aplatis")
+
+	enroll := doc.Paths["/enrollments"]["post"]
+	require.Contains(t, enroll, "requestBody")
+	require.Contains(t, enroll["responses"], "201")
+	require.Equal(t, "postCoursesIdModulesReorder", doc.Paths["/courses/{id}/modules/reorder"]["post"]["operationId"])
+
+	// Toutes les références pointent vers un composant décrit.
+	for _, ref := range strings.Split(raw, `"$ref": "#/components/schemas/`)[1:] {
+		name := ref[:strings.IndexByte(ref, '"')]
+		require.Contains(t, doc.Components.Schemas, name)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+	require.Contains(t, rec.Body.String(), "openapi.json")
+}
+
+func TestOpenAPIValidate(t *testing.T) {
+	h := NewOpenAPIHandler("")
+	router := chi.NewRouter()
+	router.Use(h.Validate)
+	var received string
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}
+	router.Post("/enrollments", echo)
+	router.Post("/enrollments/groups", echo)
+	router.Post("/contents/{id}/multipart/complete", echo)
+	router.Put("/storage/*", echo)
+
+	do := func(method, path, contentType, body string) (int, []string) {
+		t.Helper()
+		received = ""
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		var resp validationErrorResponse
+		if rec.Code == http.StatusBadRequest {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			require.Equal(t, "payload invalide", resp.Error)
+		}
+		return rec.Code, resp.Details
+	}
+
+	valid := `{"course_id":"1b4e28ba-2fa1-11d2-883f-0016d3cca427","user_id":"1b4e28ba-2fa1-11d2-883f-0016d3cca428","group_id":null,"metadata":{"source":"csv"}}`
+	code, _ := do(http.MethodPost, "/enrollments", "application/json; charset=utf-8", valid)
+	require.Equal(t, http.StatusNoContent, code)
+	require.Equal(t, valid, received, "le handler relit le corps intact")
+
+	code, details := do(http.MethodPost, "/enrollments", "", `{"course_id":12,"user_id":"nope","grup_id":"x"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, []string{
+		"course_id : string attendu, integer reçu",
+		"grup_id : champ inconnu",
+		"user_id : uuid invalide",
+	}, details)
+	require.Empty(t, received)
+
+	// /enrollments/groups est une route littérale, pas /enrollments/{id}.
+	code, details = do(http.MethodPost, "/enrollments/groups", "application/json", `{"capacity":"10"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, []string{"capacity : integer attendu, string reçu", "name : champ requis"}, details)
+
+	code, details = do(http.MethodPost, "/contents/abc/multipart/complete", "application/json",
+		`{"checksum_sha256":"x","parts":[{"number":1,"etag":"a"},{"number":1.5,"etag":"b","size":3}]}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, []string{"parts[1].number : integer attendu, number reçu", "parts[1].size : champ inconnu"}, details)
+
+	code, details = do(http.MethodPost, "/enrollments", "application/json", `{"course_id":`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Len(t, details, 1)
+	require.Contains(t, details[0], "JSON invalide")
+
+	// Corps vides, binaires et routes sans corps décrit passent tels quels.
+	code, _ = do(http.MethodPost, "/enrollments", "application/json", "")
+	require.Equal(t, http.StatusNoContent, code)
+	code, _ = do(http.MethodPut, "/storage/a/b.bin", "application/octet-stream", `{"x":1}`)
+	require.Equal(t, http.StatusNoContent, code)
+	require.Equal(t, `{"x":1}`, received)
+}
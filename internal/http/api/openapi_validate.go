@@ -0,0 +1,244 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxValidatedBody borne le corps lu par la validation ; au-delà, la requête passe telle quelle
+// et le handler applique ses propres limites.
+const maxValidatedBody = 1 << 20
+
+type validatedRoute struct {
+	method   string
+	segments []string
+	body     *schema
+}
+
+// Validate vérifie les corps JSON des requêtes au regard de la description : champs inconnus,
+// champs requis, types et formats (uuid, date-time). Une requête refusée reçoit un 400 dont
+// details nomme chaque champ en défaut ; les routes non décrites ne sont pas concernées.
+func (h *OpenAPIHandler) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := h.route(r.Method, r.URL.Path)
+		if route == nil || !jsonRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatedBody+1))
+		if err != nil {
+			respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+			return
+		}
+		if len(body) > maxValidatedBody {
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		// Un corps vide est laissé au handler, certains l'acceptent.
+		if len(bytes.TrimSpace(body)) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var problems []string
+		var value any
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			problems = []string{"corps : JSON invalide (" + err.Error() + ")"}
+		} else if decoder.More() {
+			problems = []string{"corps : une seule valeur JSON attendue"}
+		} else {
+			problems = h.check(route.body, value, "", problems)
+		}
+		if len(problems) > 0 {
+			slog.LogAttrs(r.Context(), slog.LevelWarn, "http error response",
+				slog.Int("status", http.StatusBadRequest),
+				slog.String("message", "payload invalide"),
+				slog.Any("details", problems),
+			)
+			respondJSON(w, http.StatusBadRequest, validationErrorResponse{Error: "payload invalide", Details: problems})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// route renvoie l'opération décrite pour la requête ; un segment littéral l'emporte sur un
+// paramètre (/enrollments/groups plutôt que /enrollments/{id}).
+func (h *OpenAPIHandler) route(method, p string) *validatedRoute {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	var (
+		best      *validatedRoute
+		bestScore = -1
+	)
+	for i := range h.validated {
+		candidate := &h.validated[i]
+		if candidate.method != method || len(candidate.segments) != len(segments) {
+			continue
+		}
+		score := 0
+		for j, segment := range candidate.segments {
+			if strings.HasPrefix(segment, "{") {
+				if segments[j] == "" {
+					score = -1
+					break
+				}
+				continue
+			}
+			if segment != segments[j] {
+				score = -1
+				break
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+	return best
+}
+
+func jsonRequest(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+// check compare value au schéma et ajoute à problems un message par écart, préfixé du chemin
+// du champ (modules[0].title).
+func (h *OpenAPIHandler) check(s *schema, value any, at string, problems []string) []string {
+	if s.Ref != "" {
+		return h.check(h.schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")], value, at, problems)
+	}
+	if len(s.OneOf) > 0 {
+		var first []string
+		for _, alt := range s.OneOf {
+			found := h.check(alt, value, at, nil)
+			if len(found) == 0 {
+				return problems
+			}
+			if first == nil && !(len(alt.Types) == 1 && alt.Types[0] == "null") {
+				first = found
+			}
+		}
+		return append(problems, first...)
+	}
+	if len(s.Types) == 0 {
+		return problems
+	}
+
+	kind := jsonKind(value)
+	if !slices.Contains(s.Types, kind) && !(kind == "integer" && slices.Contains(s.Types, "number")) {
+		return append(problems, fmt.Sprintf("%s : %s attendu, %s reçu", label(at), expected(s.Types), kind))
+	}
+	switch v := value.(type) {
+	case string:
+		switch s.Format {
+		case "uuid":
+			if _, err := uuid.Parse(v); err != nil {
+				problems = append(problems, label(at)+" : uuid invalide")
+			}
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				problems = append(problems, label(at)+" : date RFC 3339 attendue")
+			}
+		}
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				problems = h.check(s.Items, item, fmt.Sprintf("%s[%d]", at, i), problems)
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field := key
+			if at != "" {
+				field = at + "." + key
+			}
+			if prop, ok := s.Properties[key]; ok {
+				problems = h.check(prop, v[key], field, problems)
+				continue
+			}
+			switch extra := s.AdditionalProperties.(type) {
+			case bool:
+				if !extra {
+					problems = append(problems, field+" : champ inconnu")
+				}
+			case *schema:
+				problems = h.check(extra, v[key], field, problems)
+			}
+		}
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				field := name
+				if at != "" {
+					field = at + "." + name
+				}
+				problems = append(problems, field+" : champ requis")
+			}
+		}
+	}
+	return problems
+}
+
+func jsonKind(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func expected(types []string) string {
+	var names []string
+	for _, t := range types {
+		if t != "null" {
+			names = append(names, t)
+		}
+	}
+	return strings.Join(names, " ou ")
+}
+
+func label(at string) string {
+	if at == "" {
+		return "corps"
+	}
+	return at
+}
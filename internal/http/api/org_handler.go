@@ -11,6 +11,8 @@ import (
 
 	"lms-go/internal/ent"
 	"lms-go/internal/organization"
+	"lms-go/internal/principal"
+	"lms-go/internal/sso"
 )
 
 // OrgHandler gère les routes CRUD des organisations.
@@ -33,12 +35,36 @@ func (h *OrgHandler) Mount(r chi.Router) {
 	})
 }
 
+// requireOrganizationAdmin réserve une route /orgs/{id}/... à un administrateur de l'organisation {id}
+// authentifié par access token ; les clés d'API ne peuvent pas administrer leur organisation.
+func requireOrganizationAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := principal.FromContext(r.Context())
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+			respondError(w, r, http.StatusUnauthorized, "authentification requise", nil)
+			return
+		}
+		orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+			return
+		}
+		if p.Kind != principal.KindUser || p.Role != "admin" || p.OrganizationID != orgID {
+			respondError(w, r, http.StatusForbidden, "réservé aux administrateurs de l'organisation", nil)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 type orgResponse struct {
 	ID        uuid.UUID      `json:"id"`
 	Name      string         `json:"name"`
 	Slug      string         `json:"slug"`
 	Status    string         `json:"status"`
 	Settings  map[string]any `json:"settings"`
+	Version   int            `json:"version"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 }
@@ -49,14 +75,15 @@ func toOrgResponse(org *ent.Organization) orgResponse {
 		Name:      org.Name,
 		Slug:      org.Slug,
 		Status:    org.Status,
-		Settings:  org.Settings,
+		Settings:  sso.MaskSecrets(org.Settings),
+		Version:   org.Version,
 		CreatedAt: org.CreatedAt,
 		UpdatedAt: org.UpdatedAt,
 	}
 }
 
 type createOrgRequest struct {
-	Name     string         `json:"name"`
+	Name     string         `json:"name" openapi:"required"`
 	Slug     string         `json:"slug"`
 	Settings map[string]any `json:"settings"`
 }
@@ -72,7 +99,7 @@ func (h *OrgHandler) list(w http.ResponseWriter, r *http.Request) {
 	status := r.URL.Query().Get("status")
 	orgs, err := h.service.List(r.Context(), status)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les organisations")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les organisations", err)
 		return
 	}
 
@@ -86,7 +113,7 @@ func (h *OrgHandler) list(w http.ResponseWriter, r *http.Request) {
 func (h *OrgHandler) create(w http.ResponseWriter, r *http.Request) {
 	var req createOrgRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -98,34 +125,38 @@ func (h *OrgHandler) create(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, organization.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, organization.ErrSlugAlreadyUsed):
-			respondError(w, http.StatusConflict, "slug déjà utilisé")
+			respondError(w, r, http.StatusConflict, "slug déjà utilisé", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur lors de la création")
+			respondError(w, r, http.StatusInternalServerError, "erreur lors de la création", err)
 		}
 		return
 	}
 
+	setETag(w, org.Version)
 	respondJSON(w, http.StatusCreated, toOrgResponse(org))
 }
 
 func (h *OrgHandler) get(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
 	org, err := h.service.Get(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, organization.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "organisation introuvable")
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
+	if notModified(w, r, org.Version) {
+		return
+	}
 
 	respondJSON(w, http.StatusOK, toOrgResponse(org))
 }
@@ -133,51 +164,73 @@ func (h *OrgHandler) get(w http.ResponseWriter, r *http.Request) {
 func (h *OrgHandler) update(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
 	var req updateOrgRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
+	if req.Settings != nil {
+		// Les secrets SSO sont masqués en lecture : une valeur masquée renvoyée telle quelle conserve le secret stocké.
+		if current, err := h.service.Get(r.Context(), id); err == nil {
+			req.Settings = sso.PreserveSecrets(req.Settings, current.Settings)
+		}
+	}
+
 	org, err := h.service.Update(r.Context(), id, organization.UpdateInput{
 		Name:     req.Name,
 		Slug:     req.Slug,
 		Status:   req.Status,
 		Settings: req.Settings,
+		IfMatch:  versions,
 	})
 	if err != nil {
 		switch {
 		case errors.Is(err, organization.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, organization.ErrSlugAlreadyUsed):
-			respondError(w, http.StatusConflict, "slug déjà utilisé")
+			respondError(w, r, http.StatusConflict, "slug déjà utilisé", err)
 		case errors.Is(err, organization.ErrNotFound):
-			respondError(w, http.StatusNotFound, "organisation introuvable")
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+		case errors.Is(err, organization.ErrVersionMismatch):
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
 
+	setETag(w, org.Version)
 	respondJSON(w, http.StatusOK, toOrgResponse(org))
 }
 
 func (h *OrgHandler) archive(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
-	if err := h.service.Archive(r.Context(), id); err != nil {
+	versions, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Archive(r.Context(), id, versions...); err != nil {
 		if errors.Is(err, organization.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "organisation introuvable")
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+		} else if errors.Is(err, organization.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible d'archiver")
+			respondError(w, r, http.StatusInternalServerError, "impossible d'archiver", err)
 		}
 		return
 	}
@@ -188,15 +241,22 @@ func (h *OrgHandler) archive(w http.ResponseWriter, r *http.Request) {
 func (h *OrgHandler) activate(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
-	if err := h.service.Activate(r.Context(), id); err != nil {
+	if err := h.service.Activate(r.Context(), id, versions...); err != nil {
 		if errors.Is(err, organization.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "organisation introuvable")
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+		} else if errors.Is(err, organization.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible de réactiver")
+			respondError(w, r, http.StatusInternalServerError, "impossible de réactiver", err)
 		}
 		return
 	}
@@ -102,3 +102,39 @@ func TestOrgHandler_GetUpdateArchive(t *testing.T) {
 	router.ServeHTTP(delRec, delReq)
 	require.Equal(t, http.StatusNoContent, delRec.Code)
 }
+
+func TestOrgHandler_MasksSSOSecrets(t *testing.T) {
+	router, service := setupOrgRouter(t)
+	ctx := context.Background()
+
+	org, err := service.Create(ctx, organization.CreateInput{
+		Name: "Secure",
+		Settings: map[string]any{
+			"oidc": map[string]any{"client_id": "lms", "client_secret": "s3cret"},
+		},
+	})
+	require.NoError(t, err)
+
+	getReq := httptest.NewRequest(http.MethodGet, "/"+org.ID.String(), nil)
+	getRec := httptest.NewRecorder()
+	router.ServeHTTP(getRec, getReq)
+	require.Equal(t, http.StatusOK, getRec.Code)
+	require.NotContains(t, getRec.Body.String(), "s3cret")
+
+	var payload orgResponse
+	require.NoError(t, json.Unmarshal(getRec.Body.Bytes(), &payload))
+
+	// Renvoyer les settings lus (secret masqué) ne doit pas écraser le secret stocké.
+	payload.Settings["oidc"].(map[string]any)["client_id"] = "lms-v2"
+	updateBody, _ := json.Marshal(map[string]any{"settings": payload.Settings})
+	updateReq := httptest.NewRequest(http.MethodPatch, "/"+org.ID.String(), bytes.NewReader(updateBody))
+	updateRec := httptest.NewRecorder()
+	router.ServeHTTP(updateRec, updateReq)
+	require.Equal(t, http.StatusOK, updateRec.Code)
+
+	stored, err := service.Get(ctx, org.ID)
+	require.NoError(t, err)
+	oidc := stored.Settings["oidc"].(map[string]any)
+	require.Equal(t, "s3cret", oidc["client_secret"])
+	require.Equal(t, "lms-v2", oidc["client_id"])
+}
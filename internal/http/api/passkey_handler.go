@@ -0,0 +1,237 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/passkey"
+)
+
+const maxWebAuthnBodySize = 64 << 10
+
+// PasskeyHandler expose les cérémonies WebAuthn et la gestion des passkeys de l'utilisateur connecté.
+type PasskeyHandler struct {
+	service *passkey.Service
+	auth    *auth.Service
+}
+
+// NewPasskeyHandler crée un PasskeyHandler.
+func NewPasskeyHandler(service *passkey.Service, authService *auth.Service) *PasskeyHandler {
+	return &PasskeyHandler{service: service, auth: authService}
+}
+
+// Mount enregistre les routes WebAuthn sur le routeur /auth/webauthn.
+func (h *PasskeyHandler) Mount(r chi.Router) {
+	r.Post("/register/begin", h.registerBegin)
+	r.Post("/register/finish", h.registerFinish)
+	r.Post("/login/begin", h.loginBegin)
+	r.Post("/login/finish", h.loginFinish)
+	r.Get("/credentials", h.listCredentials)
+	r.Delete("/credentials/{credentialId}", h.deleteCredential)
+}
+
+type ceremonyResponse struct {
+	Options   any    `json:"options"`
+	Session   string `json:"session"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type registerFinishRequest struct {
+	Session    string          `json:"session" openapi:"required"`
+	Name       string          `json:"name"`
+	Credential json.RawMessage `json:"credential" openapi:"required"`
+}
+
+type loginFinishRequest struct {
+	Session    string          `json:"session" openapi:"required"`
+	Credential json.RawMessage `json:"credential" openapi:"required"`
+}
+
+type passkeyResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	Transports     []string   `json:"transports"`
+	BackupEligible bool       `json:"backup_eligible"`
+	BackupState    bool       `json:"backup_state"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func toPasskeyResponse(c *ent.WebauthnCredential) passkeyResponse {
+	transports := c.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+	return passkeyResponse{
+		ID:             c.ID,
+		Name:           c.Name,
+		Transports:     transports,
+		BackupEligible: c.BackupEligible,
+		BackupState:    c.BackupState,
+		LastUsedAt:     c.LastUsedAt,
+		CreatedAt:      c.CreatedAt,
+	}
+}
+
+func toCeremonyResponse(c passkey.Ceremony) ceremonyResponse {
+	return ceremonyResponse{
+		Options:   c.Options,
+		Session:   c.Session,
+		ExpiresAt: c.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func (h *PasskeyHandler) registerBegin(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	ceremony, err := h.service.BeginRegistration(r.Context(), user.ID)
+	if err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toCeremonyResponse(ceremony))
+}
+
+func (h *PasskeyHandler) registerFinish(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	var req registerFinishRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebAuthnBodySize)).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	if req.Session == "" || len(req.Credential) == 0 {
+		respondError(w, r, http.StatusBadRequest, "session et credential requis", nil)
+		return
+	}
+
+	credential, err := h.service.FinishRegistration(r.Context(), user.ID, req.Session, req.Name, req.Credential)
+	if err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, toPasskeyResponse(credential))
+}
+
+func (h *PasskeyHandler) loginBegin(w http.ResponseWriter, r *http.Request) {
+	ceremony, err := h.service.BeginLogin(r.Context())
+	if err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toCeremonyResponse(ceremony))
+}
+
+func (h *PasskeyHandler) loginFinish(w http.ResponseWriter, r *http.Request) {
+	var req loginFinishRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebAuthnBodySize)).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	if req.Session == "" || len(req.Credential) == 0 {
+		respondError(w, r, http.StatusBadRequest, "session et credential requis", nil)
+		return
+	}
+
+	_, tokens, err := h.service.FinishLogin(r.Context(), req.Session, req.Credential)
+	if err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+
+	if r.URL.Query().Get("use_cookies") == "true" {
+		setAuthCookies(w, r, tokens)
+	}
+
+	respondJSON(w, http.StatusOK, authResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Format(time.RFC3339),
+	})
+}
+
+func (h *PasskeyHandler) listCredentials(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	credentials, err := h.service.List(r.Context(), user.ID)
+	if err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+	resp := make([]passkeyResponse, 0, len(credentials))
+	for _, c := range credentials {
+		resp = append(resp, toPasskeyResponse(c))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *PasskeyHandler) deleteCredential(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	credentialID, err := uuid.Parse(chi.URLParam(r, "credentialId"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	if err := h.service.Delete(r.Context(), user.ID, credentialID); err != nil {
+		respondPasskeyError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// currentUser authentifie la requête par access token.
+func (h *PasskeyHandler) currentUser(w http.ResponseWriter, r *http.Request) (*ent.User, bool) {
+	token := extractAccessToken(r)
+	if token == "" {
+		respondError(w, r, http.StatusUnauthorized, "token manquant", nil)
+		return nil, false
+	}
+	user, _, err := h.auth.Profile(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			respondError(w, r, http.StatusUnauthorized, "token invalide", err)
+			return nil, false
+		}
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+		return nil, false
+	}
+	return user, true
+}
+
+func respondPasskeyError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, passkey.ErrNotConfigured):
+		respondError(w, r, http.StatusNotFound, "passkeys non configurées", err)
+	case errors.Is(err, passkey.ErrInvalidSession):
+		respondError(w, r, http.StatusBadRequest, "session WebAuthn invalide ou expirée", err)
+	case errors.Is(err, passkey.ErrInvalidResponse), errors.Is(err, passkey.ErrCloneDetected):
+		respondError(w, r, http.StatusUnauthorized, "passkey refusée", err)
+	case errors.Is(err, passkey.ErrAlreadyExists):
+		respondError(w, r, http.StatusConflict, "passkey déjà enregistrée", err)
+	case errors.Is(err, passkey.ErrNotFound):
+		respondError(w, r, http.StatusNotFound, "passkey introuvable", err)
+	case errors.Is(err, auth.ErrUserInactive):
+		respondError(w, r, http.StatusForbidden, "compte inactif", err)
+	case errors.Is(err, auth.ErrAccountLocked):
+		setRetryAfter(w, err)
+		respondError(w, r, http.StatusLocked, "compte temporairement verrouillé suite à trop d'échecs", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/passkey"
+	"lms-go/internal/passkey/passkeytest"
+)
+
+func TestPasskeyHandler_RegisterLoginAndManage(t *testing.T) {
+	client, svc, orgID := setupAuthTest(t)
+	service, err := passkey.NewService(client, svc, passkey.Config{
+		RPID:          "lms.test",
+		RPOrigins:     []string{"https://lms.test"},
+		SessionSecret: "session-secret",
+	})
+	require.NoError(t, err)
+	r := chi.NewRouter()
+	r.Route("/auth/webauthn", NewPasskeyHandler(service, svc).Mount)
+
+	ctx := context.Background()
+	_, err = svc.Register(ctx, auth.RegisterInput{
+		OrganizationID: orgID,
+		Email:          "passkey@example.com",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+	tokens, err := svc.Login(ctx, "passkey@example.com", "supersecret")
+	require.NoError(t, err)
+
+	call := func(method, target, token string, payload any) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(&body).Encode(payload))
+		}
+		req := httptest.NewRequest(method, target, &body)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+	authenticator := passkeytest.NewAuthenticator("https://lms.test")
+
+	rec := call(http.MethodPost, "/auth/webauthn/register/begin", "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodPost, "/auth/webauthn/register/begin", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var registration struct {
+		Options json.RawMessage `json:"options"`
+		Session string          `json:"session"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registration))
+
+	rec = call(http.MethodPost, "/auth/webauthn/register/finish", tokens.AccessToken, registerFinishRequest{
+		Session:    registration.Session,
+		Name:       "YubiKey",
+		Credential: authenticator.Register(t, registration.Options),
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created passkeyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, "YubiKey", created.Name)
+
+	rec = call(http.MethodPost, "/auth/webauthn/login/begin", "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var login struct {
+		Options json.RawMessage `json:"options"`
+		Session string          `json:"session"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &login))
+
+	rec = call(http.MethodPost, "/auth/webauthn/login/finish?use_cookies=true", "", loginFinishRequest{
+		Session:    login.Session,
+		Credential: authenticator.Login(t, login.Options),
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var session authResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	require.NotEmpty(t, session.AccessToken)
+	require.Len(t, rec.Result().Cookies(), 2)
+
+	rec = call(http.MethodPost, "/auth/webauthn/login/finish", "", loginFinishRequest{
+		Session:    login.Session,
+		Credential: authenticator.Login(t, login.Options),
+	})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = call(http.MethodGet, "/auth/webauthn/credentials", session.AccessToken, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed []passkeyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	require.Len(t, listed, 1)
+	require.NotNil(t, listed[0].LastUsedAt)
+
+	rec = call(http.MethodDelete, "/auth/webauthn/credentials/"+created.ID.String(), session.AccessToken, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = call(http.MethodDelete, "/auth/webauthn/credentials/"+created.ID.String(), session.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPasskeyHandler_NotConfigured(t *testing.T) {
+	client, svc, _ := setupAuthTest(t)
+	service, err := passkey.NewService(client, svc, passkey.Config{})
+	require.NoError(t, err)
+	r := chi.NewRouter()
+	r.Route("/auth/webauthn", NewPasskeyHandler(service, svc).Mount)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/webauthn/login/begin", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/principal"
+	"lms-go/internal/privacy"
+	"lms-go/internal/tenant"
+)
+
+// PrivacyHandler expose les demandes RGPD (export et effacement) d'un utilisateur. Il se monte
+// sur le routeur /users, à côté de UserHandler.
+type PrivacyHandler struct {
+	service *privacy.Service
+}
+
+func NewPrivacyHandler(service *privacy.Service) *PrivacyHandler {
+	return &PrivacyHandler{service: service}
+}
+
+func (h *PrivacyHandler) Mount(r chi.Router) {
+	r.Post("/{id}/export", h.export)
+	r.Post("/{id}/erase", h.erase)
+	r.Get("/{id}/privacy-requests", h.list)
+	r.Get("/{id}/privacy-requests/{requestId}", h.get)
+	r.Post("/{id}/privacy-requests/{requestId}/cancel", h.cancel)
+}
+
+type privacyRequestResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Kind        string     `json:"kind"`
+	Status      string     `json:"status"`
+	Mode        string     `json:"mode,omitempty"`
+	RequestedBy *uuid.UUID `json:"requested_by,omitempty"`
+	// RequestedByKind et CancelledByKind valent user ou service_account.
+	RequestedByKind string         `json:"requested_by_kind,omitempty"`
+	ScheduledAt     time.Time      `json:"scheduled_at"`
+	StartedAt       *time.Time     `json:"started_at,omitempty"`
+	CompletedAt     *time.Time     `json:"completed_at,omitempty"`
+	CancelledAt     *time.Time     `json:"cancelled_at,omitempty"`
+	CancelledBy     *uuid.UUID     `json:"cancelled_by,omitempty"`
+	CancelledByKind string         `json:"cancelled_by_kind,omitempty"`
+	SizeBytes       int64          `json:"size_bytes,omitempty"`
+	ExpiresAt       *time.Time     `json:"expires_at,omitempty"`
+	Summary         map[string]int `json:"summary,omitempty"`
+	Error           string         `json:"error,omitempty"`
+	CreatedAt       time.Time      `json:"created_at"`
+	// DownloadURL n'est renseignée que pour un export prêt, avec sa date d'expiration.
+	DownloadURL       string     `json:"download_url,omitempty"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+}
+
+func toPrivacyRequestResponse(req *ent.PrivacyRequest) privacyRequestResponse {
+	return privacyRequestResponse{
+		ID:              req.ID,
+		UserID:          req.UserID,
+		Kind:            req.Kind,
+		Status:          req.Status,
+		Mode:            req.Mode,
+		RequestedBy:     req.RequestedBy,
+		RequestedByKind: req.RequestedByKind,
+		ScheduledAt:     req.ScheduledAt,
+		StartedAt:       req.StartedAt,
+		CompletedAt:     req.CompletedAt,
+		CancelledAt:     req.CancelledAt,
+		CancelledBy:     req.CancelledBy,
+		CancelledByKind: req.CancelledByKind,
+		SizeBytes:       req.SizeBytes,
+		ExpiresAt:       req.ExpiresAt,
+		Summary:         req.Summary,
+		Error:           req.Error,
+		CreatedAt:       req.CreatedAt,
+	}
+}
+
+// authorize vérifie que l'appelant peut agir sur les données de l'utilisateur : lui-même, un
+// administrateur de l'organisation ou un compte de service. Il renvoie l'organisation, l'utilisateur
+// visé et l'auteur de la demande.
+func (h *PrivacyHandler) authorize(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, *privacy.Actor, bool) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return uuid.Nil, uuid.Nil, nil, false
+	}
+	p, ok := principal.FromContext(r.Context())
+	if !ok {
+		respondError(w, r, http.StatusUnauthorized, "authentification requise", nil)
+		return uuid.Nil, uuid.Nil, nil, false
+	}
+	if p.OrganizationID != orgID {
+		respondError(w, r, http.StatusForbidden, "accès refusé", nil)
+		return uuid.Nil, uuid.Nil, nil, false
+	}
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return uuid.Nil, uuid.Nil, nil, false
+	}
+	actor := &privacy.Actor{Kind: privacy.ActorUser, ID: p.ID}
+	if p.IsServiceAccount() {
+		actor.Kind = privacy.ActorServiceAccount
+		return orgID, userID, actor, true
+	}
+	if p.ID != userID && p.Role != "admin" {
+		respondError(w, r, http.StatusForbidden, "accès refusé", nil)
+		return uuid.Nil, uuid.Nil, nil, false
+	}
+	return orgID, userID, actor, true
+}
+
+func (h *PrivacyHandler) export(w http.ResponseWriter, r *http.Request) {
+	orgID, userID, actor, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+	req, err := h.service.RequestExport(r.Context(), orgID, userID, actor)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusAccepted, toPrivacyRequestResponse(req))
+}
+
+func (h *PrivacyHandler) erase(w http.ResponseWriter, r *http.Request) {
+	orgID, userID, actor, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+	req, err := h.service.RequestErasure(r.Context(), orgID, userID, actor)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusAccepted, toPrivacyRequestResponse(req))
+}
+
+func (h *PrivacyHandler) list(w http.ResponseWriter, r *http.Request) {
+	orgID, userID, _, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+	requests, err := h.service.List(r.Context(), orgID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	resp := make([]privacyRequestResponse, 0, len(requests))
+	for _, req := range requests {
+		resp = append(resp, toPrivacyRequestResponse(req))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// get renvoie une demande ; pour un export terminé, la réponse porte un lien de téléchargement
+// à durée limitée.
+func (h *PrivacyHandler) get(w http.ResponseWriter, r *http.Request) {
+	orgID, userID, _, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+	requestID, err := uuid.Parse(chi.URLParam(r, "requestId"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	req, err := h.service.Get(r.Context(), orgID, userID, requestID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	resp := toPrivacyRequestResponse(req)
+	if req.Kind == privacy.KindExport && req.Status == privacy.StatusCompleted {
+		url, expiresAt, err := h.service.ExportLink(r.Context(), orgID, userID, requestID)
+		switch {
+		case err == nil:
+			resp.DownloadURL = url
+			resp.DownloadExpiresAt = &expiresAt
+		case errors.Is(err, privacy.ErrExpired):
+			respondError(w, r, http.StatusGone, "archive d'export expirée", err)
+			return
+		default:
+			h.handleError(w, r, err)
+			return
+		}
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *PrivacyHandler) cancel(w http.ResponseWriter, r *http.Request) {
+	orgID, userID, actor, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+	requestID, err := uuid.Parse(chi.URLParam(r, "requestId"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	req, err := h.service.Cancel(r.Context(), orgID, userID, requestID, actor)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toPrivacyRequestResponse(req))
+}
+
+func (h *PrivacyHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, privacy.ErrInvalidInput):
+		respondError(w, r, http.StatusBadRequest, "demande invalide", err)
+	case errors.Is(err, privacy.ErrUserNotFound):
+		respondError(w, r, http.StatusNotFound, "utilisateur introuvable", err)
+	case errors.Is(err, privacy.ErrNotFound):
+		respondError(w, r, http.StatusNotFound, "demande introuvable", err)
+	case errors.Is(err, privacy.ErrAlreadyErased):
+		respondError(w, r, http.StatusConflict, "utilisateur déjà effacé", err)
+	case errors.Is(err, privacy.ErrNotCancellable):
+		respondError(w, r, http.StatusConflict, "la demande n'est plus annulable", err)
+	case errors.Is(err, privacy.ErrNotReady):
+		respondError(w, r, http.StatusConflict, "export pas encore prêt", err)
+	case errors.Is(err, privacy.ErrExpired):
+		respondError(w, r, http.StatusGone, "archive d'export expirée", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur demande RGPD", err)
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/platform/storage"
+	"lms-go/internal/principal"
+	"lms-go/internal/privacy"
+	"lms-go/internal/user"
+)
+
+func TestPrivacyHandler(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:privacyhandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+	learner, err := client.User.Create().SetOrganizationID(org.ID).SetEmail("learner@example.com").SetPasswordHash("x").Save(ctx)
+	require.NoError(t, err)
+
+	svc := privacy.NewService(client, storage.NewMemory(), privacy.Config{})
+	router := chi.NewRouter()
+	router.Use(httpmiddleware.TenantFromHeader)
+	NewUserHandler(user.NewService(client)).Mount(router)
+	NewPrivacyHandler(svc).Mount(router)
+
+	do := func(p *principal.Principal, method, target string) (int, []byte) {
+		req := reqWithOrg(method, target, org.ID, nil)
+		if p != nil {
+			req = req.WithContext(principal.WithPrincipal(req.Context(), *p))
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code, rec.Body.Bytes()
+	}
+	base := "/" + learner.ID.String()
+
+	code, _ := do(nil, http.MethodPost, base+"/export")
+	require.Equal(t, http.StatusUnauthorized, code)
+	other := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "instructor"}
+	code, _ = do(other, http.MethodPost, base+"/export")
+	require.Equal(t, http.StatusForbidden, code)
+
+	self := &principal.Principal{Kind: principal.KindUser, ID: learner.ID, OrganizationID: org.ID, Role: "learner"}
+	code, body := do(self, http.MethodPost, base+"/export")
+	require.Equal(t, http.StatusAccepted, code)
+	var export privacyRequestResponse
+	require.NoError(t, json.Unmarshal(body, &export))
+	require.Equal(t, privacy.KindExport, export.Kind)
+	require.Equal(t, &learner.ID, export.RequestedBy)
+	require.Equal(t, privacy.ActorUser, export.RequestedByKind)
+
+	code, body = do(self, http.MethodGet, base+"/privacy-requests/"+export.ID.String())
+	require.Equal(t, http.StatusOK, code)
+	require.NoError(t, json.Unmarshal(body, &export))
+	require.Empty(t, export.DownloadURL)
+
+	_, err = svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	code, body = do(self, http.MethodGet, base+"/privacy-requests/"+export.ID.String())
+	require.Equal(t, http.StatusOK, code)
+	require.NoError(t, json.Unmarshal(body, &export))
+	require.Equal(t, privacy.StatusCompleted, export.Status)
+	require.NotEmpty(t, export.DownloadURL)
+	require.NotNil(t, export.DownloadExpiresAt)
+
+	admin := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "admin"}
+	code, body = do(admin, http.MethodPost, base+"/erase")
+	require.Equal(t, http.StatusAccepted, code)
+	var erasure privacyRequestResponse
+	require.NoError(t, json.Unmarshal(body, &erasure))
+	require.Equal(t, privacy.ModeAnonymize, erasure.Mode)
+
+	code, body = do(self, http.MethodGet, base+"/privacy-requests")
+	require.Equal(t, http.StatusOK, code)
+	var history []privacyRequestResponse
+	require.NoError(t, json.Unmarshal(body, &history))
+	require.Len(t, history, 2)
+
+	code, _ = do(self, http.MethodPost, base+"/privacy-requests/"+erasure.ID.String()+"/cancel")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = do(self, http.MethodPost, base+"/privacy-requests/"+erasure.ID.String()+"/cancel")
+	require.Equal(t, http.StatusConflict, code)
+
+	// Un compte de service est enregistré comme auteur de la demande et de l'annulation.
+	robot := &principal.Principal{Kind: principal.KindServiceAccount, ID: uuid.New(), OrganizationID: org.ID}
+	code, body = do(robot, http.MethodPost, base+"/erase")
+	require.Equal(t, http.StatusAccepted, code)
+	require.NoError(t, json.Unmarshal(body, &erasure))
+	require.Equal(t, &robot.ID, erasure.RequestedBy)
+	require.Equal(t, privacy.ActorServiceAccount, erasure.RequestedByKind)
+	code, body = do(robot, http.MethodPost, base+"/privacy-requests/"+erasure.ID.String()+"/cancel")
+	require.Equal(t, http.StatusOK, code)
+	require.NoError(t, json.Unmarshal(body, &erasure))
+	require.Equal(t, &robot.ID, erasure.CancelledBy)
+	require.Equal(t, privacy.ActorServiceAccount, erasure.CancelledByKind)
+	code, _ = do(admin, http.MethodGet, base+"/privacy-requests/"+uuid.NewString())
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = do(admin, http.MethodPost, "/"+uuid.NewString()+"/export")
+	require.Equal(t, http.StatusNotFound, code)
+
+	// Les routes CRUD du même routeur restent servies.
+	code, _ = do(admin, http.MethodGet, base)
+	require.Equal(t, http.StatusOK, code)
+}
@@ -9,22 +9,29 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	"lms-go/internal/ent"
 	"lms-go/internal/progress"
 	"lms-go/internal/tenant"
 )
 
 type ProgressHandler struct {
-	service *progress.Service
+	service    *progress.Service
+	idempotent []func(http.Handler) http.Handler
 }
 
 func NewProgressHandler(service *progress.Service) *ProgressHandler {
 	return &ProgressHandler{service: service}
 }
 
+// Idempotent applique les middlewares donnés (clés d'idempotence) à la complétion d'un module (POST /progress/complete).
+func (h *ProgressHandler) Idempotent(middlewares ...func(http.Handler) http.Handler) {
+	h.idempotent = append(h.idempotent, middlewares...)
+}
+
 func (h *ProgressHandler) Mount(r chi.Router) {
 	r.Get("/", h.list)
 	r.Post("/start", h.start)
-	r.Post("/complete", h.complete)
+	r.With(h.idempotent...).Post("/complete", h.complete)
 }
 
 type progressResponse struct {
@@ -65,9 +72,9 @@ func (h *ProgressHandler) list(w http.ResponseWriter, r *http.Request) {
 	states, err := h.service.Get(r.Context(), orgID, enrollmentID)
 	if err != nil {
 		if errors.Is(err, progress.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "inscription introuvable")
+			respondError(w, r, http.StatusNotFound, "inscription introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible de récupérer la progression")
+			respondError(w, r, http.StatusInternalServerError, "impossible de récupérer la progression", err)
 		}
 		return
 	}
@@ -78,8 +85,37 @@ func (h *ProgressHandler) list(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, resp)
 }
 
+// moduleProgressResponse est l'état d'un module renvoyé après un démarrage ou une complétion.
+type moduleProgressResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	EnrollmentID uuid.UUID  `json:"enrollment_id"`
+	ModuleID     uuid.UUID  `json:"module_id"`
+	Status       string     `json:"status"`
+	Score        float32    `json:"score"`
+	Attempts     int        `json:"attempts"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func toModuleProgressResponse(p *ent.ModuleProgress) moduleProgressResponse {
+	return moduleProgressResponse{
+		ID:           p.ID,
+		EnrollmentID: p.EnrollmentID,
+		ModuleID:     p.ModuleID,
+		Status:       p.Status,
+		Score:        p.Score,
+		Attempts:     p.Attempts,
+		StartedAt:    p.StartedAt,
+		CompletedAt:  p.CompletedAt,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
 type progressRequest struct {
-	ModuleID uuid.UUID `json:"module_id"`
+	ModuleID uuid.UUID `json:"module_id" openapi:"required"`
 	Score    *float32  `json:"score"`
 }
 
@@ -90,24 +126,24 @@ func (h *ProgressHandler) start(w http.ResponseWriter, r *http.Request) {
 	}
 	var req progressRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.Start(r.Context(), orgID, enrollmentID, req.ModuleID)
 	if err != nil {
 		switch {
 		case errors.Is(err, progress.ErrNotFound):
-			respondError(w, http.StatusNotFound, "module ou inscription introuvable")
+			respondError(w, r, http.StatusNotFound, "module ou inscription introuvable", err)
 		case errors.Is(err, progress.ErrBlocked):
-			respondError(w, http.StatusConflict, "modules précédents non complétés")
+			respondError(w, r, http.StatusConflict, "modules précédents non complétés", err)
 		case errors.Is(err, progress.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur progression")
+			respondError(w, r, http.StatusInternalServerError, "erreur progression", err)
 		}
 		return
 	}
-	respondJSON(w, http.StatusOK, entity)
+	respondJSON(w, http.StatusOK, toModuleProgressResponse(entity))
 }
 
 func (h *ProgressHandler) complete(w http.ResponseWriter, r *http.Request) {
@@ -117,35 +153,35 @@ func (h *ProgressHandler) complete(w http.ResponseWriter, r *http.Request) {
 	}
 	var req progressRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 	entity, err := h.service.Complete(r.Context(), orgID, enrollmentID, req.ModuleID, req.Score)
 	if err != nil {
 		switch {
 		case errors.Is(err, progress.ErrNotFound):
-			respondError(w, http.StatusNotFound, "module ou inscription introuvable")
+			respondError(w, r, http.StatusNotFound, "module ou inscription introuvable", err)
 		case errors.Is(err, progress.ErrBlocked):
-			respondError(w, http.StatusConflict, "modules précédents non complétés")
+			respondError(w, r, http.StatusConflict, "modules précédents non complétés", err)
 		case errors.Is(err, progress.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur progression")
+			respondError(w, r, http.StatusInternalServerError, "erreur progression", err)
 		}
 		return
 	}
-	respondJSON(w, http.StatusOK, entity)
+	respondJSON(w, http.StatusOK, toModuleProgressResponse(entity))
 }
 
 func (h *ProgressHandler) parseIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return uuid.Nil, uuid.Nil, false
 	}
 	enrollmentID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return uuid.Nil, uuid.Nil, false
 	}
 	return orgID, enrollmentID, true
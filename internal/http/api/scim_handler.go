@@ -0,0 +1,495 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/logging"
+	"lms-go/internal/scim"
+	"lms-go/internal/tenant"
+)
+
+const scimContentType = "application/scim+json"
+
+// SCIMHandler expose le provisioning SCIM 2.0 (/scim/v2) et la gestion des jetons par organisation.
+type SCIMHandler struct {
+	service   *scim.Service
+	publicURL string
+}
+
+// NewSCIMHandler crée un SCIMHandler ; publicURL préfixe meta.location (chemins relatifs si elle est vide).
+func NewSCIMHandler(service *scim.Service, publicURL string) *SCIMHandler {
+	return &SCIMHandler{service: service, publicURL: strings.TrimRight(publicURL, "/")}
+}
+
+// Mount enregistre les ressources SCIM ; le routeur doit être protégé par Authenticate.
+func (h *SCIMHandler) Mount(r chi.Router) {
+	r.Get("/ServiceProviderConfig", h.serviceProviderConfig)
+	r.Route("/Users", func(r chi.Router) {
+		r.Get("/", h.listUsers)
+		r.Post("/", h.createUser)
+		r.Get("/{id}", h.getUser)
+		r.Put("/{id}", h.replaceUser)
+		r.Patch("/{id}", h.patchUser)
+		r.Delete("/{id}", h.deleteUser)
+	})
+	r.Route("/Groups", func(r chi.Router) {
+		r.Get("/", h.listGroups)
+		r.Post("/", h.createGroup)
+		r.Get("/{id}", h.getGroup)
+		r.Put("/{id}", h.replaceGroup)
+		r.Patch("/{id}", h.patchGroup)
+		r.Delete("/{id}", h.deleteGroup)
+	})
+}
+
+// MountOrganization enregistre la gestion des jetons SCIM sur le routeur /orgs, réservée aux
+// administrateurs de l'organisation ; le principal doit déjà être résolu.
+func (h *SCIMHandler) MountOrganization(r chi.Router) {
+	r.Route("/{id}/scim/tokens", func(r chi.Router) {
+		r.Use(requireOrganizationAdmin)
+		r.Get("/", h.listTokens)
+		r.Post("/", h.issueToken)
+		r.Delete("/{tokenId}", h.revokeToken)
+	})
+}
+
+// Authenticate valide le jeton bearer SCIM et place son organisation dans le contexte.
+func (h *SCIMHandler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var raw string
+		if header := r.Header.Get("Authorization"); len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+			raw = strings.TrimSpace(header[7:])
+		}
+		orgID, err := h.service.Authenticate(r.Context(), raw)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			respondSCIMError(w, r, err)
+			return
+		}
+		ctx := tenant.WithOrganization(r.Context(), orgID)
+		logging.SetOrganization(ctx, orgID.String())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type scimTokenResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func toSCIMTokenResponse(t *ent.ScimToken) scimTokenResponse {
+	return scimTokenResponse{
+		ID:         t.ID,
+		Name:       t.Name,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+type issueSCIMTokenRequest struct {
+	Name string `json:"name"`
+}
+
+func (h *SCIMHandler) issueToken(w http.ResponseWriter, r *http.Request) {
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	var req issueSCIMTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	issued, err := h.service.IssueToken(r.Context(), orgID, req.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, scim.ErrInvalidValue):
+			respondError(w, r, http.StatusBadRequest, "nom du jeton requis", err)
+		case errors.Is(err, scim.ErrNotFound):
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur création jeton", err)
+		}
+		return
+	}
+
+	// La valeur du jeton n'est communiquée qu'à l'émission.
+	resp := toSCIMTokenResponse(issued.Entity)
+	resp.Token = issued.Token
+	respondJSON(w, http.StatusCreated, resp)
+}
+
+func (h *SCIMHandler) listTokens(w http.ResponseWriter, r *http.Request) {
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	tokens, err := h.service.ListTokens(r.Context(), orgID)
+	if err != nil {
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les jetons", err)
+		return
+	}
+	resp := make([]scimTokenResponse, 0, len(tokens))
+	for _, t := range tokens {
+		resp = append(resp, toSCIMTokenResponse(t))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *SCIMHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	tokenID, err := uuid.Parse(chi.URLParam(r, "tokenId"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	if err := h.service.RevokeToken(r.Context(), orgID, tokenID); err != nil {
+		if errors.Is(err, scim.ErrNotFound) {
+			respondError(w, r, http.StatusNotFound, "jeton introuvable", err)
+			return
+		}
+		respondError(w, r, http.StatusInternalServerError, "erreur révocation jeton", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	respondSCIM(w, http.StatusOK, map[string]any{
+		"schemas":        []string{scim.SchemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": 500},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer token",
+			"description": "Jeton émis par organisation via /orgs/{id}/scim/tokens",
+			"primary":     true,
+		}},
+		"meta": map[string]string{
+			"resourceType": "ServiceProviderConfig",
+			"location":     h.publicURL + "/scim/v2/ServiceProviderConfig",
+		},
+	})
+}
+
+func (h *SCIMHandler) listUsers(w http.ResponseWriter, r *http.Request) {
+	orgID, query, ok := h.listContext(w, r)
+	if !ok {
+		return
+	}
+	resp, err := h.service.ListUsers(r.Context(), orgID, query)
+	if err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	for i := range resp.Resources {
+		h.locateUser(&resp.Resources[i])
+	}
+	respondSCIM(w, http.StatusOK, resp)
+}
+
+func (h *SCIMHandler) getUser(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	resource, err := h.service.GetUser(r.Context(), orgID, chi.URLParam(r, "id"))
+	h.respondUser(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) createUser(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var in scim.User
+	if !decodeSCIM(w, r, &in) {
+		return
+	}
+	resource, created, err := h.service.CreateUser(r.Context(), orgID, in)
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	h.respondUser(w, r, status, resource, err)
+}
+
+func (h *SCIMHandler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var in scim.User
+	if !decodeSCIM(w, r, &in) {
+		return
+	}
+	resource, err := h.service.ReplaceUser(r.Context(), orgID, chi.URLParam(r, "id"), in)
+	h.respondUser(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) patchUser(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var req scim.PatchRequest
+	if !decodeSCIM(w, r, &req) {
+		return
+	}
+	resource, err := h.service.PatchUser(r.Context(), orgID, chi.URLParam(r, "id"), req)
+	h.respondUser(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	if err := h.service.DeleteUser(r.Context(), orgID, chi.URLParam(r, "id")); err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) listGroups(w http.ResponseWriter, r *http.Request) {
+	orgID, query, ok := h.listContext(w, r)
+	if !ok {
+		return
+	}
+	resp, err := h.service.ListGroups(r.Context(), orgID, query)
+	if err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	for i := range resp.Resources {
+		h.locateGroup(&resp.Resources[i])
+	}
+	respondSCIM(w, http.StatusOK, resp)
+}
+
+func (h *SCIMHandler) getGroup(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	resource, err := h.service.GetGroup(r.Context(), orgID, chi.URLParam(r, "id"))
+	h.respondGroup(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) createGroup(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var in scim.Group
+	if !decodeSCIM(w, r, &in) {
+		return
+	}
+	resource, created, err := h.service.CreateGroup(r.Context(), orgID, in)
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	h.respondGroup(w, r, status, resource, err)
+}
+
+func (h *SCIMHandler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var in scim.Group
+	if !decodeSCIM(w, r, &in) {
+		return
+	}
+	resource, err := h.service.ReplaceGroup(r.Context(), orgID, chi.URLParam(r, "id"), in)
+	h.respondGroup(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	var req scim.PatchRequest
+	if !decodeSCIM(w, r, &req) {
+		return
+	}
+	resource, err := h.service.PatchGroup(r.Context(), orgID, chi.URLParam(r, "id"), req)
+	h.respondGroup(w, r, http.StatusOK, resource, err)
+}
+
+func (h *SCIMHandler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return
+	}
+	if err := h.service.DeleteGroup(r.Context(), orgID, chi.URLParam(r, "id")); err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listContext lit l'organisation et les paramètres filter, startIndex, count et excludedAttributes.
+func (h *SCIMHandler) listContext(w http.ResponseWriter, r *http.Request) (uuid.UUID, scim.ListQuery, bool) {
+	orgID, ok := scimOrganization(w, r)
+	if !ok {
+		return uuid.Nil, scim.ListQuery{}, false
+	}
+	q := r.URL.Query()
+	query := scim.ListQuery{Filter: q.Get("filter"), StartIndex: 1}
+	if raw := q.Get("startIndex"); raw != "" {
+		start, err := strconv.Atoi(raw)
+		if err != nil {
+			respondSCIMError(w, r, scim.ErrInvalidValue)
+			return uuid.Nil, scim.ListQuery{}, false
+		}
+		query.StartIndex = start
+	}
+	if raw := q.Get("count"); raw != "" {
+		count, err := strconv.Atoi(raw)
+		if err != nil {
+			respondSCIMError(w, r, scim.ErrInvalidValue)
+			return uuid.Nil, scim.ListQuery{}, false
+		}
+		query.Count = &count
+	}
+	for _, attribute := range strings.Split(q.Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attribute), "members") {
+			query.ExcludeMembers = true
+		}
+	}
+	return orgID, query, true
+}
+
+func (h *SCIMHandler) respondUser(w http.ResponseWriter, r *http.Request, status int, resource scim.User, err error) {
+	if err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	h.locateUser(&resource)
+	if status == http.StatusCreated {
+		w.Header().Set("Location", resource.Meta.Location)
+	}
+	respondSCIM(w, status, resource)
+}
+
+func (h *SCIMHandler) respondGroup(w http.ResponseWriter, r *http.Request, status int, resource scim.Group, err error) {
+	if err != nil {
+		respondSCIMError(w, r, err)
+		return
+	}
+	h.locateGroup(&resource)
+	if status == http.StatusCreated {
+		w.Header().Set("Location", resource.Meta.Location)
+	}
+	respondSCIM(w, status, resource)
+}
+
+func (h *SCIMHandler) locateUser(resource *scim.User) {
+	if resource.Meta != nil {
+		resource.Meta.Location = h.publicURL + "/scim/v2/Users/" + resource.ID
+	}
+}
+
+func (h *SCIMHandler) locateGroup(resource *scim.Group) {
+	if resource.Meta != nil {
+		resource.Meta.Location = h.publicURL + "/scim/v2/Groups/" + resource.ID
+	}
+	for i := range resource.Members {
+		resource.Members[i].Ref = h.publicURL + "/scim/v2/Users/" + resource.Members[i].Value
+	}
+}
+
+func scimOrganization(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondSCIMError(w, r, scim.ErrInvalidToken)
+		return uuid.Nil, false
+	}
+	return orgID, true
+}
+
+func decodeSCIM(w http.ResponseWriter, r *http.Request, dst any) bool {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		respondSCIMError(w, r, errors.Join(scim.ErrInvalidSyntax, err))
+		return false
+	}
+	return true
+}
+
+func respondSCIM(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// respondSCIMError écrit une erreur au format SCIM (RFC 7644 §3.12) et la journalise.
+func respondSCIMError(w http.ResponseWriter, r *http.Request, err error) {
+	status, scimType, detail := http.StatusInternalServerError, "", "erreur serveur"
+	switch {
+	case errors.Is(err, scim.ErrInvalidToken):
+		status, detail = http.StatusUnauthorized, "jeton SCIM invalide"
+	case errors.Is(err, scim.ErrNotFound):
+		status, detail = http.StatusNotFound, "ressource introuvable"
+	case errors.Is(err, scim.ErrUniqueness):
+		status, scimType, detail = http.StatusConflict, "uniqueness", "ressource déjà existante"
+	case errors.Is(err, scim.ErrInvalidFilter):
+		status, scimType, detail = http.StatusBadRequest, "invalidFilter", "filtre non supporté"
+	case errors.Is(err, scim.ErrInvalidPath):
+		status, scimType, detail = http.StatusBadRequest, "invalidPath", "chemin PATCH invalide"
+	case errors.Is(err, scim.ErrInvalidSyntax):
+		status, scimType, detail = http.StatusBadRequest, "invalidSyntax", "requête invalide"
+	case errors.Is(err, scim.ErrInvalidValue):
+		status, scimType, detail = http.StatusBadRequest, "invalidValue", "valeur invalide"
+	}
+
+	level := slog.LevelWarn
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	slog.LogAttrs(r.Context(), level, "http error response",
+		slog.Int("status", status),
+		slog.String("message", detail),
+		slog.String("error", err.Error()),
+	)
+	respondSCIM(w, status, scimErrorResponse{
+		Schemas:  []string{scim.SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/scim"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/user"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+// accessToken crée un utilisateur du rôle donné dans l'organisation et renvoie son access token.
+func accessToken(t *testing.T, client *ent.Client, authService *auth.Service, orgID uuid.UUID, role string) string {
+	t.Helper()
+	ctx := context.Background()
+	account, err := user.NewService(client).Create(ctx, user.CreateInput{
+		OrganizationID: orgID,
+		Email:          role + "-" + uuid.NewString() + "@acme.test",
+		Password:       "Secret123!",
+		Role:           role,
+	})
+	require.NoError(t, err)
+	tokens, err := authService.IssueFor(ctx, account.ID)
+	require.NoError(t, err)
+	return tokens.AccessToken
+}
+
+// setupSCIMRouter renvoie aussi les access tokens d'un administrateur et d'un apprenant de l'organisation.
+func setupSCIMRouter(t *testing.T) (*chi.Mux, *ent.Organization, string, string) {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:scimhandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	orgs := organization.NewService(client)
+	org, err := orgs.Create(ctx, organization.CreateInput{Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+
+	authService := auth.NewService(client, auth.Config{JWTSecret: "secret", AccessTokenTTL: time.Minute})
+	service := scim.NewService(client, user.NewService(client), enrollment.NewService(client))
+	handler := NewSCIMHandler(service, "https://lms.test")
+	r := chi.NewRouter()
+	r.Route("/orgs", func(or chi.Router) {
+		or.Use(NewServiceAccountHandler(serviceaccount.NewService(client), authService).Authenticate)
+		NewOrgHandler(orgs).Mount(or)
+		handler.MountOrganization(or)
+	})
+	r.Route("/scim/v2", func(sr chi.Router) {
+		sr.Use(handler.Authenticate)
+		handler.Mount(sr)
+	})
+	return r, org, accessToken(t, client, authService, org.ID, "admin"), accessToken(t, client, authService, org.ID, "learner")
+}
+
+func scimRequest(t *testing.T, r http.Handler, token, method, target string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+	req := httptest.NewRequest(method, target, &payload)
+	req.Header.Set("Content-Type", scimContentType)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestSCIMHandler_UsersAndGroups(t *testing.T) {
+	r, org, admin, learner := setupSCIMRouter(t)
+
+	// Les jetons SCIM ne sont gérés que par un administrateur de l'organisation.
+	rec := scimRequest(t, r, "", http.MethodPost, "/orgs/"+org.ID.String()+"/scim/tokens", map[string]string{"name": "Okta"})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = scimRequest(t, r, learner, http.MethodGet, "/orgs/"+org.ID.String()+"/scim/tokens", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	rec = scimRequest(t, r, admin, http.MethodGet, "/orgs/"+uuid.NewString()+"/scim/tokens", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = scimRequest(t, r, admin, http.MethodPost, "/orgs/"+org.ID.String()+"/scim/tokens", map[string]string{"name": "Okta"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var issued scimTokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &issued))
+	require.NotEmpty(t, issued.Token)
+
+	rec = scimRequest(t, r, admin, http.MethodGet, "/orgs/"+org.ID.String()+"/scim/tokens", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, rec.Body.String(), issued.Token)
+
+	rec = scimRequest(t, r, "", http.MethodGet, "/scim/v2/Users", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, scimContentType, rec.Header().Get("Content-Type"))
+
+	newUser := map[string]any{
+		"schemas":    []string{scim.SchemaUser},
+		"externalId": "00u1",
+		"userName":   "ada@acme.test",
+		"name":       map[string]string{"givenName": "Ada", "familyName": "Lovelace"},
+		"active":     true,
+	}
+	rec = scimRequest(t, r, issued.Token, http.MethodPost, "/scim/v2/Users", newUser)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created scim.User
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, "https://lms.test/scim/v2/Users/"+created.ID, rec.Header().Get("Location"))
+
+	rec = scimRequest(t, r, issued.Token, http.MethodPost, "/scim/v2/Users", newUser)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = scimRequest(t, r, issued.Token, http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(`userName eq "ada@acme.test"`), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list scim.ListResponse[scim.User]
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, created.ID, list.Resources[0].ID)
+
+	rec = scimRequest(t, r, issued.Token, http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(`userName sw "a"`), nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalidFilter")
+
+	rec = scimRequest(t, r, issued.Token, http.MethodPost, "/scim/v2/Groups", map[string]any{
+		"schemas":     []string{scim.SchemaGroup},
+		"displayName": "Engineering",
+		"members":     []map[string]string{{"value": created.ID}},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var group scim.Group
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &group))
+	require.Len(t, group.Members, 1)
+
+	rec = scimRequest(t, r, issued.Token, http.MethodPatch, "/scim/v2/Groups/"+group.ID, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "remove", "path": `members[value eq "` + created.ID + `"]`}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &group))
+	require.Empty(t, group.Members)
+
+	rec = scimRequest(t, r, issued.Token, http.MethodPatch, "/scim/v2/Users/"+created.ID, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "Replace", "path": "active", "value": "False"}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = scimRequest(t, r, issued.Token, http.MethodDelete, "/scim/v2/Users/"+created.ID, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = scimRequest(t, r, issued.Token, http.MethodGet, "/scim/v2/Users/"+created.ID, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var fetched scim.User
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &fetched))
+	require.False(t, *fetched.Active)
+
+	rec = scimRequest(t, r, admin, http.MethodDelete, "/orgs/"+org.ID.String()+"/scim/tokens/"+issued.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = scimRequest(t, r, issued.Token, http.MethodGet, "/scim/v2/Users", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
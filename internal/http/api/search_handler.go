@@ -0,0 +1,151 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/content"
+	"lms-go/internal/principal"
+	"lms-go/internal/search"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/tenant"
+)
+
+type SearchHandler struct {
+	service *search.Service
+}
+
+func NewSearchHandler(service *search.Service) *SearchHandler {
+	return &SearchHandler{service: service}
+}
+
+func (h *SearchHandler) Mount(r chi.Router) {
+	r.Get("/", h.search)
+}
+
+// scopeByKind associe chaque type de document au scope de lecture exigé des comptes de service.
+var scopeByKind = map[string]string{
+	search.KindCourse:  serviceaccount.ScopeCoursesRead,
+	search.KindModule:  serviceaccount.ScopeCoursesRead,
+	search.KindContent: serviceaccount.ScopeContentsRead,
+}
+
+type searchResultResponse struct {
+	Type      string     `json:"type"`
+	ID        uuid.UUID  `json:"id"`
+	CourseID  *uuid.UUID `json:"course_id,omitempty"`
+	Title     string     `json:"title"`
+	Status    string     `json:"status"`
+	Snippet   string     `json:"snippet"`
+	Rank      float64    `json:"rank"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type searchResponse struct {
+	Query   string                 `json:"query"`
+	Results []searchResultResponse `json:"results"`
+	Limit   int                    `json:"limit"`
+	Offset  int                    `json:"offset"`
+	HasMore bool                   `json:"has_more"`
+}
+
+func (h *SearchHandler) search(w http.ResponseWriter, r *http.Request) {
+	orgID, err := tenant.OrganizationID(r.Context())
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
+		return
+	}
+	p, ok := principal.FromContext(r.Context())
+	if !ok {
+		respondError(w, r, http.StatusUnauthorized, "authentification requise", nil)
+		return
+	}
+	if p.OrganizationID != orgID {
+		respondError(w, r, http.StatusForbidden, "accès refusé", nil)
+		return
+	}
+
+	values := r.URL.Query()
+	query := search.Query{
+		OrganizationID: orgID,
+		Text:           values.Get("q"),
+		Status:         values.Get("status"),
+		Language:       values.Get("lang"),
+	}
+	for _, raw := range values["type"] {
+		for _, kind := range strings.Split(raw, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				query.Kinds = append(query.Kinds, kind)
+			}
+		}
+	}
+	for name, dest := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+		if raw := values.Get(name); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				respondError(w, r, http.StatusBadRequest, "paramètre "+name+" invalide", err)
+				return
+			}
+			*dest = n
+		}
+	}
+
+	// Les utilisateurs sans accès complet ne voient que le catalogue publié et leurs inscriptions ;
+	// les comptes de service ne voient que les types couverts par leurs scopes.
+	if p.IsServiceAccount() {
+		requested := query.Kinds
+		if len(requested) == 0 {
+			requested = search.Kinds
+		}
+		query.Kinds = nil
+		for _, kind := range requested {
+			if scope, known := scopeByKind[kind]; !known || p.HasScope(scope) {
+				query.Kinds = append(query.Kinds, kind)
+			}
+		}
+		if len(query.Kinds) == 0 {
+			respondError(w, r, http.StatusForbidden, "scope insuffisant pour la clé d'API", nil)
+			return
+		}
+	} else {
+		query.UserID = p.ID
+		query.Restricted = !content.FullAccess(p.Role)
+	}
+
+	page, err := h.service.Search(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, search.ErrInvalidInput) {
+			respondError(w, r, http.StatusBadRequest, "recherche invalide", err)
+			return
+		}
+		respondError(w, r, http.StatusInternalServerError, "erreur recherche", err)
+		return
+	}
+
+	resp := searchResponse{
+		Query:   strings.TrimSpace(query.Text),
+		Results: make([]searchResultResponse, 0, len(page.Results)),
+		Limit:   page.Limit,
+		Offset:  page.Offset,
+		HasMore: page.HasMore,
+	}
+	for _, result := range page.Results {
+		resp.Results = append(resp.Results, searchResultResponse{
+			Type:      result.Kind,
+			ID:        result.ID,
+			CourseID:  result.CourseID,
+			Title:     result.Title,
+			Status:    result.Status,
+			Snippet:   result.Snippet,
+			Rank:      result.Rank,
+			UpdatedAt: result.UpdatedAt,
+		})
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
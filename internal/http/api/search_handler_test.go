@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/principal"
+	"lms-go/internal/search"
+	"lms-go/internal/serviceaccount"
+)
+
+func TestSearchHandler(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:searchhandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Course.Create().SetOrganizationID(org.ID).SetTitle("Onboarding").SetSlug("onboarding").SetStatus("published").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Course.Create().SetOrganizationID(org.ID).SetTitle("Onboarding avancé").SetSlug("onboarding-2").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Content.Create().SetOrganizationID(org.ID).SetName("onboarding.pdf").SetMimeType("application/pdf").
+		SetStorageKey("org/onboarding.pdf").SetStatus("available").Save(ctx)
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Use(httpmiddleware.TenantFromHeader)
+	NewSearchHandler(search.NewService(client, search.Config{})).Mount(router)
+
+	do := func(p *principal.Principal, query string) (int, searchResponse) {
+		req := reqWithOrg(http.MethodGet, "/?"+query, org.ID, nil)
+		if p != nil {
+			req = req.WithContext(principal.WithPrincipal(req.Context(), *p))
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		var resp searchResponse
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		}
+		return rec.Code, resp
+	}
+	q := "q=" + url.QueryEscape("onboarding")
+
+	code, _ := do(nil, q)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	admin := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "admin"}
+	code, _ = do(admin, "q=")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = do(admin, q+"&limit=abc")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, resp := do(admin, q)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, 20, resp.Limit)
+	code, resp = do(admin, q+"&type=course&status=draft")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "Onboarding avancé", resp.Results[0].Title)
+
+	learner := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "learner"}
+	code, resp = do(learner, q)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "course", resp.Results[0].Type)
+	require.Equal(t, "published", resp.Results[0].Status)
+
+	key := &principal.Principal{Kind: principal.KindServiceAccount, ID: uuid.New(), OrganizationID: org.ID, Scopes: []string{serviceaccount.ScopeContentsRead}}
+	code, resp = do(key, q)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "content", resp.Results[0].Type)
+	code, _ = do(key, q+"&type=course")
+	require.Equal(t, http.StatusForbidden, code)
+
+	outsider := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: uuid.New(), Role: "admin"}
+	code, _ = do(outsider, q)
+	require.Equal(t, http.StatusForbidden, code)
+}
@@ -0,0 +1,323 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/logging"
+	"lms-go/internal/principal"
+	"lms-go/internal/serviceaccount"
+	"lms-go/internal/tenant"
+)
+
+// ServiceAccountHandler expose la gestion des comptes de service et authentifie les requêtes
+// portant une clé d'API ou un access token.
+type ServiceAccountHandler struct {
+	service     *serviceaccount.Service
+	authService *auth.Service
+}
+
+// NewServiceAccountHandler construit le handler.
+func NewServiceAccountHandler(service *serviceaccount.Service, authService *auth.Service) *ServiceAccountHandler {
+	return &ServiceAccountHandler{service: service, authService: authService}
+}
+
+// MountOrganization enregistre la gestion des comptes de service sur le routeur /orgs, réservée aux
+// administrateurs de l'organisation ; le principal doit déjà être résolu (Authenticate).
+func (h *ServiceAccountHandler) MountOrganization(r chi.Router) {
+	r.Route("/{id}/service-accounts", func(r chi.Router) {
+		r.Use(requireOrganizationAdmin)
+		r.Get("/", h.list)
+		r.Post("/", h.create)
+		r.Route("/{accountId}", func(r chi.Router) {
+			r.Get("/", h.get)
+			r.Delete("/", h.disable)
+			r.Post("/activate", h.enable)
+			r.Get("/keys", h.listKeys)
+			r.Post("/keys", h.issueKey)
+			r.Delete("/keys/{keyId}", h.revokeKey)
+		})
+	})
+}
+
+// Authenticate résout le principal de la requête. Une clé d'API invalide est rejetée (401) ;
+// une clé valide fixe l'organisation de la requête, qui doit concorder avec X-Org-ID s'il est présent.
+// Les access tokens JWT valides alimentent le principal utilisateur.
+func (h *ServiceAccountHandler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := extractAccessToken(r)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		if !serviceaccount.IsAPIKey(token) {
+			if p, err := h.authService.IdentifyPrincipal(token); err == nil {
+				ctx = principal.WithPrincipal(ctx, p)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		p, err := h.service.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, serviceaccount.ErrInvalidKey) {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token"`)
+				respondError(w, r, http.StatusUnauthorized, "clé d'API invalide", err)
+				return
+			}
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+			return
+		}
+		if raw := r.Header.Get("X-Org-ID"); raw != "" {
+			if orgID, err := uuid.Parse(raw); err != nil || orgID != p.OrganizationID {
+				respondError(w, r, http.StatusForbidden, "la clé d'API n'appartient pas à cette organisation", err)
+				return
+			}
+		}
+		ctx = tenant.WithOrganization(ctx, p.OrganizationID)
+		ctx = principal.WithPrincipal(ctx, p)
+		logging.SetOrganization(ctx, p.OrganizationID.String())
+		logging.SetServiceAccount(ctx, p.ID.String())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type serviceAccountRequest struct {
+	Name        string `json:"name" openapi:"required"`
+	Description string `json:"description"`
+}
+
+type serviceAccountResponse struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description,omitempty"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func toServiceAccountResponse(a *ent.ServiceAccount) serviceAccountResponse {
+	return serviceAccountResponse{
+		ID:             a.ID,
+		OrganizationID: a.OrganizationID,
+		Name:           a.Name,
+		Description:    a.Description,
+		Status:         a.Status,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+type issueAPIKeyRequest struct {
+	Name      string     `json:"name" openapi:"required"`
+	Scopes    []string   `json:"scopes" openapi:"required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type apiKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func toAPIKeyResponse(k *ent.APIKey) apiKeyResponse {
+	return apiKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     serviceaccount.KeyPrefix + k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func (h *ServiceAccountHandler) list(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := parseServiceAccountParam(w, r, "id")
+	if !ok {
+		return
+	}
+	accounts, err := h.service.List(r.Context(), orgID)
+	if err != nil {
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les comptes de service", err)
+		return
+	}
+	resp := make([]serviceAccountResponse, 0, len(accounts))
+	for _, a := range accounts {
+		resp = append(resp, toServiceAccountResponse(a))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *ServiceAccountHandler) create(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := parseServiceAccountParam(w, r, "id")
+	if !ok {
+		return
+	}
+	var req serviceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	account, err := h.service.Create(r.Context(), orgID, serviceaccount.CreateInput{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, toServiceAccountResponse(account))
+}
+
+func (h *ServiceAccountHandler) get(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	account, err := h.service.Get(r.Context(), orgID, accountID)
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toServiceAccountResponse(account))
+}
+
+func (h *ServiceAccountHandler) disable(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	account, err := h.service.Disable(r.Context(), orgID, accountID)
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toServiceAccountResponse(account))
+}
+
+func (h *ServiceAccountHandler) enable(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	account, err := h.service.Enable(r.Context(), orgID, accountID)
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, toServiceAccountResponse(account))
+}
+
+func (h *ServiceAccountHandler) listKeys(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	keys, err := h.service.ListKeys(r.Context(), orgID, accountID)
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	resp := make([]apiKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, toAPIKeyResponse(k))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *ServiceAccountHandler) issueKey(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	var req issueAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	issued, err := h.service.IssueKey(r.Context(), orgID, accountID, serviceaccount.IssueKeyInput{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+
+	// La clé complète n'est communiquée qu'à l'émission.
+	resp := toAPIKeyResponse(issued.Entity)
+	resp.Key = issued.Key
+	respondJSON(w, http.StatusCreated, resp)
+}
+
+func (h *ServiceAccountHandler) revokeKey(w http.ResponseWriter, r *http.Request) {
+	orgID, accountID, ok := parseServiceAccountIDs(w, r)
+	if !ok {
+		return
+	}
+	keyID, ok := parseServiceAccountParam(w, r, "keyId")
+	if !ok {
+		return
+	}
+	if _, err := h.service.RevokeKey(r.Context(), orgID, accountID, keyID); err != nil {
+		respondServiceAccountError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseServiceAccountParam(w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, name))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func parseServiceAccountIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	orgID, ok := parseServiceAccountParam(w, r, "id")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	accountID, ok := parseServiceAccountParam(w, r, "accountId")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return orgID, accountID, true
+}
+
+func respondServiceAccountError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, serviceaccount.ErrInvalidInput):
+		respondError(w, r, http.StatusBadRequest, "nom requis et expiration future", err)
+	case errors.Is(err, serviceaccount.ErrInvalidScope):
+		respondError(w, r, http.StatusBadRequest, "scopes invalides", err)
+	case errors.Is(err, serviceaccount.ErrNotFound):
+		respondError(w, r, http.StatusNotFound, "compte de service introuvable", err)
+	case errors.Is(err, serviceaccount.ErrConflict):
+		respondError(w, r, http.StatusConflict, "nom de compte de service déjà utilisé", err)
+	case errors.Is(err, serviceaccount.ErrDisabled):
+		respondError(w, r, http.StatusConflict, "compte de service désactivé", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+	}
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	httpmiddleware "lms-go/internal/http/middleware"
+	"lms-go/internal/organization"
+	"lms-go/internal/serviceaccount"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+// setupServiceAccountRouter renvoie aussi l'access token d'un administrateur de l'organisation.
+func setupServiceAccountRouter(t *testing.T) (*chi.Mux, *ent.Organization, string) {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:serviceaccounthandler?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	orgs := organization.NewService(client)
+	org, err := orgs.Create(ctx, organization.CreateInput{Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+
+	authService := auth.NewService(client, auth.Config{JWTSecret: "secret", AccessTokenTTL: time.Minute})
+	handler := NewServiceAccountHandler(serviceaccount.NewService(client), authService)
+	r := chi.NewRouter()
+	r.Route("/orgs", func(or chi.Router) {
+		or.Use(handler.Authenticate)
+		handler.MountOrganization(or)
+	})
+	r.Route("/enrollments", func(er chi.Router) {
+		er.Use(handler.Authenticate, httpmiddleware.TenantFromHeader)
+		er.With(httpmiddleware.RequireScope(serviceaccount.ScopeEnrollmentsRead, serviceaccount.ScopeEnrollmentsWrite)).
+			Group(NewEnrollmentHandler(enrollment.NewService(client)).Mount)
+	})
+	return r, org, accessToken(t, client, authService, org.ID, "admin")
+}
+
+func apiKeyRequest(t *testing.T, r http.Handler, token, orgHeader, method, target string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+	req := httptest.NewRequest(method, target, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if orgHeader != "" {
+		req.Header.Set("X-Org-ID", orgHeader)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServiceAccountHandler_APIKeyLifecycle(t *testing.T) {
+	r, org, admin := setupServiceAccountRouter(t)
+	base := "/orgs/" + org.ID.String() + "/service-accounts"
+
+	// La gestion des comptes de service exige un access token d'administrateur de l'organisation.
+	rec := apiKeyRequest(t, r, "", "", http.MethodPost, base, map[string]string{"name": "hris-sync"})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = apiKeyRequest(t, r, admin, "", http.MethodGet, "/orgs/"+uuid.NewString()+"/service-accounts", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = apiKeyRequest(t, r, admin, "", http.MethodPost, base, map[string]string{"name": "hris-sync"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var account serviceAccountResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&account))
+	require.Equal(t, "active", account.Status)
+
+	keysURL := base + "/" + account.ID.String() + "/keys"
+	rec = apiKeyRequest(t, r, admin, "", http.MethodPost, keysURL, map[string]any{"name": "prod", "scopes": []string{"root"}})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = apiKeyRequest(t, r, admin, "", http.MethodPost, keysURL, map[string]any{
+		"name":   "prod",
+		"scopes": []string{serviceaccount.ScopeEnrollmentsRead},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var issued apiKeyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&issued))
+	require.NotEmpty(t, issued.Key)
+
+	// La clé porte l'organisation : X-Org-ID est facultatif mais doit concorder.
+	rec = apiKeyRequest(t, r, issued.Key, "", http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = apiKeyRequest(t, r, issued.Key, org.ID.String(), http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = apiKeyRequest(t, r, issued.Key, uuid.NewString(), http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Une clé d'API ne peut pas administrer les comptes de service, même de sa propre organisation.
+	rec = apiKeyRequest(t, r, issued.Key, "", http.MethodPost, keysURL, map[string]any{
+		"name":   "escalate",
+		"scopes": []string{serviceaccount.ScopeEnrollmentsWrite},
+	})
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Sans le scope enrollments:write, l'écriture est refusée.
+	rec = apiKeyRequest(t, r, issued.Key, "", http.MethodPost, "/enrollments", map[string]any{})
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Contains(t, rec.Body.String(), serviceaccount.ScopeEnrollmentsWrite)
+
+	rec = apiKeyRequest(t, r, "lms_000000000000_forged", "", http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+
+	rec = apiKeyRequest(t, r, admin, "", http.MethodGet, keysURL, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var keys []apiKeyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&keys))
+	require.Len(t, keys, 1)
+	require.Empty(t, keys[0].Key)
+	require.NotNil(t, keys[0].LastUsedAt)
+
+	rec = apiKeyRequest(t, r, admin, "", http.MethodDelete, keysURL+"/"+issued.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = apiKeyRequest(t, r, issued.Key, "", http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Les requêtes sans clé conservent le comportement existant (X-Org-ID requis).
+	rec = apiKeyRequest(t, r, "", "", http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = apiKeyRequest(t, r, "", org.ID.String(), http.MethodGet, "/enrollments", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,270 @@
+package api
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/sso"
+)
+
+const (
+	ssoStateCookie      = "sso_state"
+	maxSAMLMetadataSize = 1 << 20
+)
+
+// SSOHandler expose les flux d'authentification unique configurés par organisation.
+type SSOHandler struct {
+	service   *sso.Service
+	publicURL string
+}
+
+// NewSSOHandler crée un SSOHandler. publicURL est l'URL publique de l'API utilisée pour construire
+// les URLs de callback ; elle est obligatoire, l'entête Host n'étant jamais utilisé.
+func NewSSOHandler(service *sso.Service, publicURL string) *SSOHandler {
+	return &SSOHandler{service: service, publicURL: strings.TrimRight(publicURL, "/")}
+}
+
+// Mount enregistre les routes SSO sur le routeur /auth.
+func (h *SSOHandler) Mount(r chi.Router) {
+	r.Get("/oidc/{orgSlug}/login", h.oidcLogin)
+	r.Get("/oidc/{orgSlug}/callback", h.oidcCallback)
+	r.Get("/saml/{orgSlug}/metadata", h.samlMetadata)
+	r.Get("/saml/{orgSlug}/login", h.samlLogin)
+	r.Post("/saml/{orgSlug}/acs", h.samlACS)
+}
+
+// MountOrganization enregistre les routes d'administration SSO sur le routeur /orgs, réservées aux
+// administrateurs de l'organisation ; le principal doit déjà être résolu.
+func (h *SSOHandler) MountOrganization(r chi.Router) {
+	r.With(requireOrganizationAdmin).Put("/{id}/saml/metadata", h.uploadSAMLMetadata)
+}
+
+func (h *SSOHandler) oidcLogin(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "orgSlug")
+	redirect, err := h.service.BeginOIDC(r.Context(), sso.OIDCLoginInput{
+		OrgSlug:     slug,
+		RedirectURL: h.callbackURL("/auth/oidc/" + slug + "/callback"),
+		ReturnTo:    r.URL.Query().Get("return_to"),
+		UseCookies:  r.URL.Query().Get("use_cookies") == "true",
+	})
+	if err != nil {
+		respondSSOError(w, r, err)
+		return
+	}
+
+	setSSOStateCookie(w, r, redirect, false)
+	http.Redirect(w, r, redirect.URL, http.StatusFound)
+}
+
+func (h *SSOHandler) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "orgSlug")
+	var stateCookie string
+	if cookie, err := r.Cookie(ssoStateCookie); err == nil {
+		stateCookie = cookie.Value
+	}
+	clearSSOStateCookie(w, r)
+
+	q := r.URL.Query()
+	result, err := h.service.CompleteOIDC(r.Context(), sso.OIDCCallbackInput{
+		OrgSlug:     slug,
+		RedirectURL: h.callbackURL("/auth/oidc/" + slug + "/callback"),
+		Code:        q.Get("code"),
+		State:       q.Get("state"),
+		StateCookie: stateCookie,
+		Error:       q.Get("error"),
+	})
+	if err != nil {
+		respondSSOError(w, r, err)
+		return
+	}
+
+	respondSSOResult(w, r, result)
+}
+
+func (h *SSOHandler) samlEndpoints(slug string) sso.SAMLEndpoints {
+	return sso.SAMLEndpoints{
+		MetadataURL: h.callbackURL("/auth/saml/" + slug + "/metadata"),
+		ACSURL:      h.callbackURL("/auth/saml/" + slug + "/acs"),
+	}
+}
+
+func (h *SSOHandler) samlMetadata(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "orgSlug")
+	metadata, err := h.service.SAMLMetadata(r.Context(), slug, h.samlEndpoints(slug))
+	if err != nil {
+		respondSSOError(w, r, err)
+		return
+	}
+
+	payload, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(payload)
+}
+
+func (h *SSOHandler) samlLogin(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "orgSlug")
+	redirect, err := h.service.BeginSAML(r.Context(), sso.SAMLLoginInput{
+		OrgSlug:    slug,
+		Endpoints:  h.samlEndpoints(slug),
+		ReturnTo:   r.URL.Query().Get("return_to"),
+		UseCookies: r.URL.Query().Get("use_cookies") == "true",
+	})
+	if err != nil {
+		respondSSOError(w, r, err)
+		return
+	}
+
+	setSSOStateCookie(w, r, redirect, true)
+	http.Redirect(w, r, redirect.URL, http.StatusFound)
+}
+
+func (h *SSOHandler) samlACS(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "orgSlug")
+	if err := r.ParseForm(); err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+	var stateCookie string
+	if cookie, err := r.Cookie(ssoStateCookie); err == nil {
+		stateCookie = cookie.Value
+	}
+	clearSSOStateCookie(w, r)
+
+	result, err := h.service.CompleteSAML(r.Context(), sso.SAMLACSInput{
+		OrgSlug:      slug,
+		Endpoints:    h.samlEndpoints(slug),
+		SAMLResponse: r.PostForm.Get("SAMLResponse"),
+		RelayState:   r.PostForm.Get("RelayState"),
+		StateCookie:  stateCookie,
+	})
+	if err != nil {
+		respondSSOError(w, r, err)
+		return
+	}
+
+	respondSSOResult(w, r, result)
+}
+
+func (h *SSOHandler) uploadSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+	metadata, err := io.ReadAll(io.LimitReader(r.Body, maxSAMLMetadataSize))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
+		return
+	}
+
+	org, err := h.service.SetSAMLMetadata(r.Context(), id, metadata)
+	if err != nil {
+		switch {
+		case errors.Is(err, sso.ErrInvalidMetadata):
+			respondError(w, r, http.StatusBadRequest, "métadonnées SAML invalides", err)
+		case errors.Is(err, sso.ErrNotConfigured):
+			respondError(w, r, http.StatusNotFound, "organisation introuvable", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toOrgResponse(org))
+}
+
+// callbackURL construit l'URL absolue d'un callback à partir de l'URL publique.
+func (h *SSOHandler) callbackURL(path string) string {
+	return h.publicURL + path
+}
+
+// respondSSOResult ouvre la session : cookies et redirection vers return_to si demandé, sinon JSON.
+func respondSSOResult(w http.ResponseWriter, r *http.Request, result sso.Result) {
+	if result.UseCookies {
+		setAuthCookies(w, r, result.Tokens)
+		if result.ReturnTo != "" {
+			http.Redirect(w, r, result.ReturnTo, http.StatusFound)
+			return
+		}
+	}
+
+	respondJSON(w, http.StatusOK, authResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+		ExpiresAt:    result.Tokens.ExpiresAt.Format(time.RFC3339),
+	})
+}
+
+func respondSSOError(w http.ResponseWriter, r *http.Request, err error) {
+	var challenge *auth.MFAChallengeError
+	switch {
+	case errors.As(err, &challenge):
+		respondMFAChallenge(w, challenge)
+	case errors.Is(err, auth.ErrAccountLocked):
+		setRetryAfter(w, err)
+		respondError(w, r, http.StatusLocked, "compte temporairement verrouillé suite à trop d'échecs", err)
+	case errors.Is(err, sso.ErrNotConfigured):
+		respondError(w, r, http.StatusNotFound, "SSO non configuré pour cette organisation", err)
+	case errors.Is(err, sso.ErrInvalidState):
+		respondError(w, r, http.StatusBadRequest, "session SSO invalide ou expirée", err)
+	case errors.Is(err, sso.ErrDomainNotAllowed):
+		respondError(w, r, http.StatusForbidden, "domaine email non autorisé", err)
+	case errors.Is(err, sso.ErrEmailUnverified):
+		respondError(w, r, http.StatusForbidden, "email non vérifié par le fournisseur d'identité", err)
+	case errors.Is(err, sso.ErrAccountExists):
+		respondError(w, r, http.StatusConflict, "un compte non lié à cette identité SSO utilise déjà cet email", err)
+	case errors.Is(err, sso.ErrInvalidMetadata):
+		respondError(w, r, http.StatusBadGateway, "métadonnées du fournisseur d'identité invalides", err)
+	case errors.Is(err, sso.ErrInvalidResponse):
+		respondError(w, r, http.StatusBadGateway, "réponse du fournisseur d'identité invalide", err)
+	case errors.Is(err, auth.ErrUserInactive):
+		respondError(w, r, http.StatusForbidden, "compte inactif", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+	}
+}
+
+// setSSOStateCookie conserve l'état du flux ; SameSite=Lax pour qu'il accompagne le retour du fournisseur.
+// Le retour SAML est un POST cross-site : en HTTPS, le cookie passe en SameSite=None pour être transmis.
+func setSSOStateCookie(w http.ResponseWriter, r *http.Request, redirect sso.Redirect, crossSitePost bool) {
+	secure := r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+	sameSite := http.SameSiteLaxMode
+	if crossSitePost && secure {
+		sameSite = http.SameSiteNoneMode
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    redirect.State,
+		Path:     "/auth/",
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: sameSite,
+		Expires:  redirect.ExpiresAt,
+	})
+}
+
+func clearSSOStateCookie(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    "",
+		Path:     "/auth/",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	})
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/principal"
+	"lms-go/internal/sso"
+	"lms-go/internal/sso/ssotest"
+	"lms-go/internal/user"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func setupSSORouter(t *testing.T, name string, settings map[string]any) *chi.Mux {
+	r, _ := setupSSORouterWithOrg(t, name, settings)
+	return r
+}
+
+func setupSSORouterWithOrg(t *testing.T, name string, settings map[string]any) (*chi.Mux, *ent.Organization) {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	orgs := organization.NewService(client)
+	org, err := orgs.Create(ctx, organization.CreateInput{Name: "Acme", Slug: "acme", Settings: settings})
+	require.NoError(t, err)
+
+	authService := auth.NewService(client, auth.Config{
+		JWTSecret:       "handler-secret",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+	service := sso.NewService(client, orgs, user.NewService(client), authService, sso.Config{StateSecret: "handler-secret"})
+
+	handler := NewSSOHandler(service, "http://lms.test")
+	r := chi.NewRouter()
+	r.Route("/auth", handler.Mount)
+	r.Route("/orgs", func(or chi.Router) {
+		NewOrgHandler(orgs).Mount(or)
+		handler.MountOrganization(or)
+	})
+	return r, org
+}
+
+func TestSSOHandler_OIDCLoginAndCallback(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	provider.SetClaims(map[string]any{"sub": "42", "email": "ada@acme.test", "email_verified": true})
+	r := setupSSORouter(t, "ssohandleroidc", map[string]any{"oidc": provider.Settings()})
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/acme/login", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	var stateCookie *http.Cookie
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == ssoStateCookie {
+			stateCookie = c
+		}
+	}
+	require.NotNil(t, stateCookie)
+	require.True(t, stateCookie.HttpOnly)
+
+	authURL := rec.Header().Get("Location")
+	require.Contains(t, authURL, "code_challenge_method=S256")
+	require.Contains(t, authURL, "redirect_uri=http%3A%2F%2Flms.test%2Fauth%2Foidc%2Facme%2Fcallback")
+
+	callback := provider.Authorize(t, authURL)
+	require.Equal(t, "/auth/oidc/acme/callback", callback.Path)
+
+	req = httptest.NewRequest(http.MethodGet, callback.RequestURI(), nil)
+	req.AddCookie(stateCookie)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp authResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotEmpty(t, resp.AccessToken)
+	require.NotEmpty(t, resp.RefreshToken)
+}
+
+func TestSSOHandler_OIDCCallbackWithoutStateCookie(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	r := setupSSORouter(t, "ssohandlernostate", map[string]any{"oidc": provider.Settings()})
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/acme/callback?code=abc&state=xyz", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSSOHandler_OIDCNotConfigured(t *testing.T) {
+	r := setupSSORouter(t, "ssohandlerdisabled", map[string]any{})
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/acme/login", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestSSOHandler_SAMLMetadataUploadAndLogin(t *testing.T) {
+	r, org := setupSSORouterWithOrg(t, "ssohandlersaml", map[string]any{})
+	idp := ssotest.NewSAMLProvider(t, "https://idp.test")
+
+	// Métadonnées SP disponibles avant configuration du fournisseur.
+	req := httptest.NewRequest(http.MethodGet, "/auth/saml/acme/metadata", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var spMetadata saml.EntityDescriptor
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &spMetadata))
+	require.Equal(t, "http://lms.test/auth/saml/acme/metadata", spMetadata.EntityID)
+
+	uploadMetadata := func(p *principal.Principal, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/orgs/"+org.ID.String()+"/saml/metadata", strings.NewReader(body))
+		if p != nil {
+			req = req.WithContext(principal.WithPrincipal(req.Context(), *p))
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+	admin := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "admin"}
+	require.Equal(t, http.StatusUnauthorized, uploadMetadata(nil, string(idp.Metadata(t))).Code)
+	learner := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: org.ID, Role: "learner"}
+	require.Equal(t, http.StatusForbidden, uploadMetadata(learner, string(idp.Metadata(t))).Code)
+	otherAdmin := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: uuid.New(), Role: "admin"}
+	require.Equal(t, http.StatusForbidden, uploadMetadata(otherAdmin, string(idp.Metadata(t))).Code)
+
+	require.Equal(t, http.StatusBadRequest, uploadMetadata(admin, "<invalid").Code)
+	rec = uploadMetadata(admin, string(idp.Metadata(t)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/auth/saml/acme/login?use_cookies=true&return_to=/learn", nil)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code)
+	var stateCookie *http.Cookie
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == ssoStateCookie {
+			stateCookie = c
+		}
+	}
+	require.NotNil(t, stateCookie)
+
+	authnRequest, relayState := idp.ParseAuthnRequest(t, rec.Header().Get("Location"))
+	form := url.Values{
+		"SAMLResponse": {idp.Respond(t, &spMetadata, authnRequest.ID, "linus@acme.test", nil)},
+		"RelayState":   {relayState},
+	}
+	req = httptest.NewRequest(http.MethodPost, "/auth/saml/acme/acs", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(stateCookie)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code, rec.Body.String())
+	require.Equal(t, "/learn", rec.Header().Get("Location"))
+
+	var accessCookie *http.Cookie
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == "access_token" {
+			accessCookie = c
+		}
+	}
+	require.NotNil(t, accessCookie)
+	require.NotEmpty(t, accessCookie.Value)
+}
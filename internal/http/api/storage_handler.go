@@ -0,0 +1,147 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"lms-go/internal/platform/storage"
+)
+
+// StorageHandler sert les URL signées du stockage local : dépôt (PUT) et téléchargement (GET).
+// La signature HMAC tient lieu d'authentification ; ces routes sont montées hors des routes multi-tenant.
+type StorageHandler struct {
+	store *storage.Local
+}
+
+func NewStorageHandler(store *storage.Local) *StorageHandler {
+	return &StorageHandler{store: store}
+}
+
+func (h *StorageHandler) Mount(r chi.Router) {
+	r.Put("/*", h.upload)
+	r.Get("/*", h.download)
+	r.Head("/*", h.download)
+}
+
+func (h *StorageHandler) upload(w http.ResponseWriter, r *http.Request) {
+	grant, ok := h.verify(w, r, http.MethodPut)
+	if !ok {
+		return
+	}
+	if grant.ContentType != "" && !sameMediaType(r.Header.Get("Content-Type"), grant.ContentType) {
+		respondError(w, r, http.StatusForbidden, "type de contenu différent de celui signé", nil)
+		return
+	}
+	if grant.Size > 0 {
+		if r.ContentLength != grant.Size {
+			respondError(w, r, http.StatusForbidden, "taille différente de celle signée", nil)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, grant.Size)
+	}
+
+	if grant.UploadID != "" {
+		h.uploadPart(w, r, grant)
+		return
+	}
+	if err := h.store.Write(r.Context(), grant.Object, r.Body, grant.Size); err != nil {
+		var maxErr *http.MaxBytesError
+		switch {
+		case errors.Is(err, storage.ErrSizeMismatch), errors.As(err, &maxErr):
+			respondError(w, r, http.StatusBadRequest, "taille différente de celle signée", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "écriture impossible", err)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// uploadPart dépose une partie multipart ; l'ETag renvoyé doit être repris à la complétion.
+func (h *StorageHandler) uploadPart(w http.ResponseWriter, r *http.Request, grant storage.Grant) {
+	etag, err := h.store.WritePart(r.Context(), grant.Object, grant.UploadID, grant.Part, r.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUploadNotFound):
+			respondError(w, r, http.StatusNotFound, "dépôt multipart introuvable", err)
+		case errors.Is(err, storage.ErrInvalidPart):
+			respondError(w, r, http.StatusBadRequest, "partie invalide", err)
+		default:
+			respondError(w, r, http.StatusInternalServerError, "écriture impossible", err)
+		}
+		return
+	}
+	w.Header().Set("ETag", `"`+etag+`"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *StorageHandler) download(w http.ResponseWriter, r *http.Request) {
+	grant, ok := h.verify(w, r, http.MethodGet)
+	if !ok {
+		return
+	}
+	reader, err := h.store.Open(r.Context(), grant.Object)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			respondError(w, r, http.StatusNotFound, "objet introuvable", err)
+		} else {
+			respondError(w, r, http.StatusInternalServerError, "lecture impossible", err)
+		}
+		return
+	}
+	defer reader.Close()
+
+	info, err := h.store.Stat(r.Context(), grant.Object)
+	if err != nil {
+		respondError(w, r, http.StatusInternalServerError, "lecture impossible", err)
+		return
+	}
+	setFileHeaders(w, info.ContentType, path.Base(grant.Object))
+	w.Header().Set("ETag", `"`+info.ETag+`"`)
+	w.Header().Set("Cache-Control", "private, max-age=0")
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, path.Base(grant.Object), info.LastModified, seeker)
+		return
+	}
+	w.Header().Set("Last-Modified", info.LastModified.UTC().Format(http.TimeFormat))
+	_, _ = io.Copy(w, reader)
+}
+
+// verify contrôle la signature de l'URL pour la méthode attendue (HEAD est signé comme GET).
+func (h *StorageHandler) verify(w http.ResponseWriter, r *http.Request, method string) (storage.Grant, bool) {
+	object, err := url.PathUnescape(chi.URLParam(r, "*"))
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "chemin invalide", err)
+		return storage.Grant{}, false
+	}
+	grant, err := h.store.Verify(method, object, r.URL.Query())
+	switch {
+	case err == nil:
+		return grant, true
+	case errors.Is(err, storage.ErrInvalidObject):
+		respondError(w, r, http.StatusBadRequest, "chemin invalide", err)
+	case errors.Is(err, storage.ErrURLExpired):
+		respondError(w, r, http.StatusForbidden, "lien expiré", err)
+	case errors.Is(err, storage.ErrInvalidSignature):
+		respondError(w, r, http.StatusForbidden, "signature invalide", err)
+	default:
+		respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
+	}
+	return storage.Grant{}, false
+}
+
+func sameMediaType(a, b string) bool {
+	ma, _, errA := mime.ParseMediaType(a)
+	mb, _, errB := mime.ParseMediaType(b)
+	if errA != nil || errB != nil {
+		return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+	}
+	return ma == mb
+}
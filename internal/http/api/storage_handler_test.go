@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/platform/storage"
+)
+
+func setupStorageRouter(t *testing.T) (*chi.Mux, *storage.Local) {
+	store, err := storage.NewLocal(storage.LocalConfig{
+		Root:    filepath.Join(t.TempDir(), "objects"),
+		BaseURL: "/storage",
+		Secret:  []byte("secret"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	router := chi.NewRouter()
+	router.Route("/storage", NewStorageHandler(store).Mount)
+	return router, store
+}
+
+func TestStorageHandler_UploadAndDownload(t *testing.T) {
+	router, store := setupStorageRouter(t)
+	ctx := context.Background()
+
+	uploadURL, err := store.PresignUpload(ctx, "org/2024/notes v1.txt", "text/plain", 11, time.Minute)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, uploadURL, strings.NewReader("hello"))
+	req.Header.Set("Content-Type", "text/plain")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code, "taille différente de celle signée")
+
+	req = httptest.NewRequest(http.MethodPut, uploadURL, strings.NewReader("hello world"))
+	req.Header.Set("Content-Type", "image/png")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code, "type différent de celui signé")
+
+	req = httptest.NewRequest(http.MethodPut, uploadURL, strings.NewReader("hello world"))
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// L'URL de dépôt ne permet pas de lire l'objet.
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, uploadURL, nil))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	downloadURL, err := store.PresignDownload(ctx, "org/2024/notes v1.txt", time.Minute)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, downloadURL, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "hello world", rec.Body.String())
+	require.NotEmpty(t, rec.Header().Get("ETag"))
+	require.Equal(t, `inline; filename="notes v1.txt"`, rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, "sandbox", rec.Header().Get("Content-Security-Policy"))
+
+	req = httptest.NewRequest(http.MethodGet, downloadURL, nil)
+	req.Header.Set("Range", "bytes=6-")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	require.Equal(t, "world", rec.Body.String())
+
+	// Un document actif (HTML, SVG) n'est jamais affiché dans l'origine de l'API.
+	pageURL, err := store.PresignUpload(ctx, "org/2024/page.html", "text/html", 20, time.Minute)
+	require.NoError(t, err)
+	req = httptest.NewRequest(http.MethodPut, pageURL, strings.NewReader("<script>1</script>\n\n"))
+	req.Header.Set("Content-Type", "text/html")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	pageURL, err = store.PresignDownload(ctx, "org/2024/page.html", time.Minute)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, pageURL, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `attachment; filename=page.html`, rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "sandbox", rec.Header().Get("Content-Security-Policy"))
+
+	u, _ := url.Parse(downloadURL)
+	q := u.Query()
+	q.Set("expires", "1")
+	u.RawQuery = q.Encode()
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.String(), nil))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestStorageHandler_Traversal(t *testing.T) {
+	router, _ := setupStorageRouter(t)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/storage/org/%2e%2e/%2e%2e/etc/passwd?expires=9999999999&signature=x", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestStorageHandler_MultipartPart(t *testing.T) {
+	router, store := setupStorageRouter(t)
+	ctx := context.Background()
+
+	uploadID, err := store.InitiateMultipart(ctx, "org/video.mp4", "video/mp4")
+	require.NoError(t, err)
+	partURL, err := store.PresignPart(ctx, "org/video.mp4", uploadID, 1, time.Minute)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, partURL, strings.NewReader("chunk")))
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	require.NoError(t, store.CompleteMultipart(ctx, "org/video.mp4", uploadID, []storage.Part{{Number: 1, ETag: etag}}))
+	info, err := store.Stat(ctx, "org/video.mp4")
+	require.NoError(t, err)
+	require.EqualValues(t, 5, info.Size)
+
+	// Le dépôt terminé n'accepte plus de parties.
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, partURL, strings.NewReader("chunk")))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
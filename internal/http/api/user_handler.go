@@ -35,30 +35,36 @@ func (h *UserHandler) Mount(r chi.Router) {
 }
 
 type userResponse struct {
-	ID        uuid.UUID      `json:"id"`
-	Email     string         `json:"email"`
-	Role      string         `json:"role"`
-	Status    string         `json:"status"`
-	Metadata  map[string]any `json:"metadata"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID         uuid.UUID      `json:"id"`
+	Email      string         `json:"email"`
+	Role       string         `json:"role"`
+	Status     string         `json:"status"`
+	ExternalID *string        `json:"external_id,omitempty"`
+	MFAEnabled bool           `json:"mfa_enabled"`
+	Version    int            `json:"version"`
+	Metadata   map[string]any `json:"metadata"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
 }
 
 func toUserResponse(u *ent.User) userResponse {
 	return userResponse{
-		ID:        u.ID,
-		Email:     u.Email,
-		Role:      u.Role,
-		Status:    u.Status,
-		Metadata:  u.Metadata,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:         u.ID,
+		Email:      u.Email,
+		Role:       u.Role,
+		Status:     u.Status,
+		ExternalID: u.ExternalID,
+		MFAEnabled: u.MfaEnabledAt != nil,
+		Version:    u.Version,
+		Metadata:   u.Metadata,
+		CreatedAt:  u.CreatedAt,
+		UpdatedAt:  u.UpdatedAt,
 	}
 }
 
 type createUserRequest struct {
-	Email    string         `json:"email"`
-	Password string         `json:"password"`
+	Email    string         `json:"email" openapi:"required"`
+	Password string         `json:"password" openapi:"required"`
 	Role     string         `json:"role"`
 	Status   string         `json:"status"`
 	Metadata map[string]any `json:"metadata"`
@@ -75,7 +81,7 @@ type updateUserRequest struct {
 func (h *UserHandler) list(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	filter := user.Filter{
@@ -84,7 +90,7 @@ func (h *UserHandler) list(w http.ResponseWriter, r *http.Request) {
 	}
 	users, err := h.service.List(r.Context(), orgID, filter)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "impossible de lister les utilisateurs")
+		respondError(w, r, http.StatusInternalServerError, "impossible de lister les utilisateurs", err)
 		return
 	}
 
@@ -98,13 +104,13 @@ func (h *UserHandler) list(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) create(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 
 	var req createUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -119,39 +125,43 @@ func (h *UserHandler) create(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, user.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, user.ErrEmailAlreadyUsed):
-			respondError(w, http.StatusConflict, "email déjà utilisé")
+			respondError(w, r, http.StatusConflict, "email déjà utilisé", err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur lors de la création")
+			respondError(w, r, http.StatusInternalServerError, "erreur lors de la création", err)
 		}
 		return
 	}
 
+	setETag(w, created.Version)
 	respondJSON(w, http.StatusCreated, toUserResponse(created))
 }
 
 func (h *UserHandler) get(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	userID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
 	entity, err := h.service.Get(r.Context(), orgID, userID)
 	if err != nil {
 		if errors.Is(err, user.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "utilisateur introuvable")
+			respondError(w, r, http.StatusNotFound, "utilisateur introuvable", err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
+	if notModified(w, r, entity.Version) {
+		return
+	}
 
 	respondJSON(w, http.StatusOK, toUserResponse(entity))
 }
@@ -159,18 +169,23 @@ func (h *UserHandler) get(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) update(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	userID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
 	var req updateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "payload invalide")
+		respondError(w, r, http.StatusBadRequest, "payload invalide", err)
 		return
 	}
 
@@ -180,41 +195,52 @@ func (h *UserHandler) update(w http.ResponseWriter, r *http.Request) {
 		Role:     req.Role,
 		Status:   req.Status,
 		Metadata: req.Metadata,
+		IfMatch:  versions,
 	})
 	if err != nil {
 		switch {
 		case errors.Is(err, user.ErrInvalidInput):
-			respondError(w, http.StatusBadRequest, "données invalides")
+			respondError(w, r, http.StatusBadRequest, "données invalides", err)
 		case errors.Is(err, user.ErrEmailAlreadyUsed):
-			respondError(w, http.StatusConflict, "email déjà utilisé")
+			respondError(w, r, http.StatusConflict, "email déjà utilisé", err)
 		case errors.Is(err, user.ErrNotFound):
-			respondError(w, http.StatusNotFound, "utilisateur introuvable")
+			respondError(w, r, http.StatusNotFound, "utilisateur introuvable", err)
+		case errors.Is(err, user.ErrVersionMismatch):
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		default:
-			respondError(w, http.StatusInternalServerError, "erreur serveur")
+			respondError(w, r, http.StatusInternalServerError, "erreur serveur", err)
 		}
 		return
 	}
 
+	setETag(w, updated.Version)
 	respondJSON(w, http.StatusOK, toUserResponse(updated))
 }
 
 func (h *UserHandler) deactivate(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	userID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
 		return
 	}
 
-	if err := h.service.Deactivate(r.Context(), orgID, userID); err != nil {
+	versions, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Deactivate(r.Context(), orgID, userID, versions...); err != nil {
 		if errors.Is(err, user.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "utilisateur introuvable")
+			respondError(w, r, http.StatusNotFound, "utilisateur introuvable", err)
+		} else if errors.Is(err, user.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible de désactiver")
+			respondError(w, r, http.StatusInternalServerError, "impossible de désactiver", err)
 		}
 		return
 	}
@@ -225,20 +251,27 @@ func (h *UserHandler) deactivate(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) activate(w http.ResponseWriter, r *http.Request) {
 	orgID, err := tenant.OrganizationID(r.Context())
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "organisation manquante")
+		respondError(w, r, http.StatusBadRequest, "organisation manquante", err)
 		return
 	}
 	userID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "identifiant invalide")
+		respondError(w, r, http.StatusBadRequest, "identifiant invalide", err)
+		return
+	}
+
+	versions, ok := ifMatch(w, r)
+	if !ok {
 		return
 	}
 
-	if err := h.service.Activate(r.Context(), orgID, userID); err != nil {
+	if err := h.service.Activate(r.Context(), orgID, userID, versions...); err != nil {
 		if errors.Is(err, user.ErrNotFound) {
-			respondError(w, http.StatusNotFound, "utilisateur introuvable")
+			respondError(w, r, http.StatusNotFound, "utilisateur introuvable", err)
+		} else if errors.Is(err, user.ErrVersionMismatch) {
+			respondError(w, r, http.StatusPreconditionFailed, msgStale, err)
 		} else {
-			respondError(w, http.StatusInternalServerError, "impossible de réactiver")
+			respondError(w, r, http.StatusInternalServerError, "impossible de réactiver", err)
 		}
 		return
 	}
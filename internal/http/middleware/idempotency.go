@@ -0,0 +1,226 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"lms-go/internal/platform/idempotency"
+	"lms-go/internal/principal"
+	"lms-go/internal/tenant"
+)
+
+const (
+	headerIdempotencyKey = "Idempotency-Key"
+	maxIdempotencyKey    = 255
+	maxIdempotentBody    = 1 << 20
+)
+
+// replayedHeaders sont les entêtes de la réponse conservés avec elle ; les autres (limitation de
+// débit, CORS) sont recalculés à chaque tentative.
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// Idempotency rejoue, pendant ttl, la réponse d'une requête portant un entête Idempotency-Key
+// déjà vu pour le même principal ; les appels anonymes ne sont pas concernés. La clé réutilisée
+// pour une requête différente (méthode, chemin, organisation ou corps) est refusée (422), de même
+// qu'une nouvelle tentative avant la fin de la première (409), aussi longue soit-elle : la
+// réservation est renouvelée pendant l'exécution. Les erreurs serveur ne sont pas conservées ; en
+// cas d'indisponibilité du store, la requête est exécutée et l'incident journalisé.
+func Idempotency(store idempotency.Store, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if store == nil || ttl <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimSpace(r.Header.Get(headerIdempotencyKey))
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKey {
+				writeJSONError(w, http.StatusBadRequest, "entête Idempotency-Key trop long")
+				return
+			}
+			scope := idempotencyScope(r)
+			if scope == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body, err := readBody(r)
+			if err != nil {
+				writeJSONError(w, http.StatusRequestEntityTooLarge, "corps de requête trop volumineux")
+				return
+			}
+			key = scope + ":" + key
+
+			stored, err := store.Begin(r.Context(), key, fingerprint(r, body))
+			switch {
+			case errors.Is(err, idempotency.ErrMismatch):
+				writeJSONError(w, http.StatusUnprocessableEntity, "clé d'idempotence déjà utilisée pour une autre requête")
+				return
+			case errors.Is(err, idempotency.ErrInProgress):
+				writeJSONError(w, http.StatusConflict, "requête en cours de traitement pour cette clé d'idempotence")
+				return
+			case err != nil:
+				slog.WarnContext(r.Context(), "idempotency store error", "error", err)
+				next.ServeHTTP(w, r)
+				return
+			case stored != nil:
+				replay(w, *stored)
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			completed := false
+			defer func() {
+				// Une panique ou une erreur serveur libère la clé pour une nouvelle tentative.
+				if !completed {
+					if err := store.Release(r.Context(), key); err != nil {
+						slog.WarnContext(r.Context(), "idempotency store error", "error", err)
+					}
+				}
+			}()
+			stopRenewing := renewReservation(r, store, key)
+			defer stopRenewing()
+			next.ServeHTTP(rec, r)
+			stopRenewing()
+			if rec.status >= http.StatusInternalServerError {
+				return
+			}
+			header := http.Header{}
+			for _, name := range replayedHeaders {
+				if values := w.Header().Values(name); len(values) > 0 {
+					header[name] = values
+				}
+			}
+			err = store.Complete(r.Context(), key, idempotency.Response{
+				StatusCode: rec.status,
+				Header:     header,
+				Body:       rec.body.Bytes(),
+			}, ttl)
+			if err != nil {
+				slog.WarnContext(r.Context(), "idempotency store error", "error", err)
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// renewInterval espace les renouvellements d'une réservation, bien avant son expiration.
+const renewInterval = idempotency.PendingTimeout / 3
+
+// renewReservation renouvelle la réservation de key jusqu'à l'appel de la fonction renvoyée, qui
+// attend la fin du dernier renouvellement et peut être appelée plusieurs fois.
+func renewReservation(r *http.Request, store idempotency.Store, key string) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(renewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := store.Renew(r.Context(), key); err != nil {
+					slog.WarnContext(r.Context(), "idempotency store error", "error", err)
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-stopped
+	}
+}
+
+// idempotencyScope rattache la clé au principal ; vide pour un appel anonyme, dont la réponse
+// n'est pas conservée : une organisation ne suffit pas à distinguer deux appelants.
+func idempotencyScope(r *http.Request) string {
+	if p, ok := principal.FromContext(r.Context()); ok {
+		return string(p.Kind) + ":" + p.ID.String()
+	}
+	return ""
+}
+
+// readBody lit le corps puis le restaure pour le handler.
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBody+1))
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxIdempotentBody {
+		return nil, errors.New("request body too large")
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// fingerprint résume ce qui distingue deux requêtes : méthode, chemin, organisation et corps.
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	org := ""
+	if orgID, err := tenant.OrganizationID(r.Context()); err == nil {
+		org = orgID.String()
+	}
+	for _, part := range []string{r.Method, r.URL.Path, org} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, resp idempotency.Response) {
+	for name, values := range resp.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(resp.Body)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+// responseRecorder copie la réponse du handler tout en l'écrivant au client.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(p []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	rec.body.Write(p)
+	return rec.ResponseWriter.Write(p)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/platform/idempotency"
+	"lms-go/internal/principal"
+	"lms-go/internal/tenant"
+)
+
+func TestIdempotency_RequiresPrincipal(t *testing.T) {
+	calls := 0
+	handler := Idempotency(idempotency.NewMemoryStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	}))
+	orgID := uuid.New()
+	send := func(p *principal.Principal) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/enrollments", strings.NewReader(`{}`))
+		req.Header.Set("Idempotency-Key", "retry-1")
+		ctx := tenant.WithOrganization(req.Context(), orgID)
+		if p != nil {
+			ctx = principal.WithPrincipal(ctx, *p)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req.WithContext(ctx))
+		return rec
+	}
+
+	// Deux appels anonymes de la même organisation ne partagent pas la réponse.
+	require.Empty(t, send(nil).Header().Get("Idempotent-Replayed"))
+	require.Empty(t, send(nil).Header().Get("Idempotent-Replayed"))
+	require.Equal(t, 2, calls)
+
+	user := &principal.Principal{Kind: principal.KindUser, ID: uuid.New(), OrganizationID: orgID}
+	require.Empty(t, send(user).Header().Get("Idempotent-Replayed"))
+	require.Equal(t, "true", send(user).Header().Get("Idempotent-Replayed"))
+	require.Equal(t, 3, calls)
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"lms-go/internal/platform/logging"
+)
+
+// IdentifyFunc résout l'utilisateur (et son organisation) à partir d'un access token.
+type IdentifyFunc func(token string) (userID string, orgID string, ok bool)
+
+// RequestLogger remplace le logger texte de chi : il initialise les champs de corrélation
+// (request_id, org_id, user_id, route) et écrit une ligne JSON par requête.
+// Il doit être monté après middleware.RequestID.
+func RequestLogger(logger *slog.Logger, identify IdentifyFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ctx, fields := logging.WithFields(r.Context(), chimiddleware.GetReqID(r.Context()))
+			fields.SetRoute("", func() string {
+				if rctx := chi.RouteContext(ctx); rctx != nil {
+					return rctx.RoutePattern()
+				}
+				return ""
+			})
+
+			if identify != nil {
+				if token := AccessToken(r); token != "" {
+					if userID, orgID, ok := identify(token); ok {
+						logging.SetUser(ctx, userID)
+						logging.SetOrganization(ctx, orgID)
+					}
+				}
+			}
+
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if rctx := chi.RouteContext(ctx); rctx != nil {
+				fields.SetRoute(rctx.RoutePattern(), nil)
+			}
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			level := slog.LevelInfo
+			switch {
+			case status >= 500:
+				level = slog.LevelError
+			case status >= 400:
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "http request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Int("bytes", ww.BytesWritten()),
+				slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+				slog.String("remote_ip", r.RemoteAddr),
+				slog.String("user_agent", r.UserAgent()),
+			)
+		})
+	}
+}
+
+// AccessToken extrait l'access token de l'entête Authorization (Bearer) ou du cookie access_token.
+func AccessToken(r *http.Request) string {
+	authHeader := r.Header.Get("Authorization")
+	if len(authHeader) >= 7 && strings.EqualFold(authHeader[:7], "Bearer ") {
+		if token := strings.TrimSpace(authHeader[7:]); token != "" {
+			return token
+		}
+	}
+
+	if cookie, err := r.Cookie("access_token"); err == nil && cookie.Value != "" {
+		return cookie.Value
+	}
+
+	return ""
+}
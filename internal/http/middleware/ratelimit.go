@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/platform/ratelimit"
+	"lms-go/internal/principal"
+	"lms-go/internal/tenant"
+)
+
+const maxPeekBody = 1 << 20
+
+// RateLimitKey construit la clé de limitation d'une requête ; une clé vide désactive la règle.
+type RateLimitKey func(r *http.Request) string
+
+// RateLimit consomme un jeton par clé et répond 429 (avec Retry-After) dès qu'un seau est vide.
+// En cas d'indisponibilité du backend, la requête est laissée passer et l'incident journalisé.
+func RateLimit(limiter ratelimit.Limiter, rule ratelimit.Rule, keys ...RateLimitKey) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil || !rule.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, keyFn := range keys {
+				key := keyFn(r)
+				if key == "" {
+					continue
+				}
+				decision, err := limiter.Allow(r.Context(), key, rule)
+				if err != nil {
+					slog.WarnContext(r.Context(), "rate limit backend error", "error", err)
+					continue
+				}
+				w.Header().Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+				w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+				if !decision.Allowed {
+					retry := int(math.Ceil(decision.RetryAfter.Seconds()))
+					if retry < 1 {
+						retry = 1
+					}
+					w.Header().Set("Retry-After", strconv.Itoa(retry))
+					slog.WarnContext(r.Context(), "rate limit exceeded", "key", key)
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusTooManyRequests)
+					_ = json.NewEncoder(w).Encode(map[string]string{"error": "trop de requêtes, réessayez plus tard"})
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ByIP limite par adresse IP cliente (à combiner avec RealIP derrière un proxy).
+func ByIP(prefix string) RateLimitKey {
+	return func(r *http.Request) string {
+		host := r.RemoteAddr
+		if h, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			host = h
+		}
+		if host == "" {
+			return ""
+		}
+		return prefix + ":ip:" + host
+	}
+}
+
+// ByOrganization limite par organisation : celle du principal authentifié, sinon celle du
+// contexte tenant. Un entête non authentifié ne sert jamais de clé, son client pouvant le varier.
+func ByOrganization(prefix string) RateLimitKey {
+	return func(r *http.Request) string {
+		if p, ok := principal.FromContext(r.Context()); ok && p.OrganizationID != uuid.Nil {
+			return prefix + ":org:" + p.OrganizationID.String()
+		}
+		if orgID, err := tenant.OrganizationID(r.Context()); err == nil {
+			return prefix + ":org:" + orgID.String()
+		}
+		return ""
+	}
+}
+
+// ByJSONField limite selon un champ texte du corps JSON (ex. email), normalisé en minuscules.
+// Le corps est relu puis restauré pour le handler.
+func ByJSONField(prefix, field string) RateLimitKey {
+	return func(r *http.Request) string {
+		body := peekBody(r)
+		if len(body) == 0 {
+			return ""
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return ""
+		}
+		value, _ := payload[field].(string)
+		value = strings.TrimSpace(strings.ToLower(value))
+		if value == "" {
+			return ""
+		}
+		return prefix + ":" + field + ":" + value
+	}
+}
+
+// peekBody lit au plus maxPeekBody octets ; le handler reçoit le corps complet, la partie lue
+// suivie du reste du flux d'origine.
+func peekBody(r *http.Request) []byte {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPeekBody))
+	r.Body = replayedBody{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	if err != nil {
+		return nil
+	}
+	return body
+}
+
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/principal"
+	"lms-go/internal/tenant"
+)
+
+func TestByJSONField_KeepsFullBody(t *testing.T) {
+	payload := `{"email":"Alice@Example.com","blob":"` + strings.Repeat("x", 2*maxPeekBody) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(payload))
+
+	// Au-delà de maxPeekBody, le champ n'est pas lu mais le corps reste intact.
+	require.Empty(t, ByJSONField("auth", "email")(req))
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Equal(t, payload, string(body))
+
+	req = httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"Alice@Example.com"}`))
+	require.Equal(t, "auth:email:alice@example.com", ByJSONField("auth", "email")(req))
+	body, err = io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"email":"Alice@Example.com"}`, string(body))
+}
+
+func TestByOrganization_IgnoresUnauthenticatedHeader(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+	req.Header.Set("X-Org-ID", uuid.NewString())
+	require.Empty(t, ByOrganization("api")(req))
+
+	orgID := uuid.New()
+	ctx := tenant.WithOrganization(req.Context(), uuid.New())
+	ctx = principal.WithPrincipal(ctx, principal.Principal{Kind: principal.KindServiceAccount, ID: uuid.New(), OrganizationID: orgID})
+	require.Equal(t, "api:org:"+orgID.String(), ByOrganization("api")(req.WithContext(ctx)))
+
+	tenantID := uuid.New()
+	require.Equal(t, "api:org:"+tenantID.String(), ByOrganization("api")(req.WithContext(tenant.WithOrganization(req.Context(), tenantID))))
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealIP_TrustsOnlyConfiguredProxies(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	remote := func(peer string, header http.Header) string {
+		var got string
+		handler := RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.RemoteAddr
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = peer
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return got
+	}
+
+	// Un client direct ne peut pas choisir son adresse.
+	require.Equal(t, "203.0.113.7:4000", remote("203.0.113.7:4000", http.Header{"X-Forwarded-For": {"198.51.100.1"}}))
+	// Derrière le proxy, l'adresse ajoutée par celui-ci fait foi, pas celles fournies par le client.
+	require.Equal(t, "203.0.113.7", remote("10.0.0.2:4000", http.Header{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7"}}))
+	require.Equal(t, "203.0.113.7", remote("10.0.0.2:4000", http.Header{"X-Forwarded-For": {"203.0.113.7, 10.0.0.3"}}))
+	require.Equal(t, "203.0.113.9", remote("10.0.0.2:4000", http.Header{"X-Real-Ip": {"203.0.113.9"}}))
+	require.Equal(t, "10.0.0.2:4000", remote("10.0.0.2:4000", nil))
+
+	// Sans proxy configuré, les entêtes sont ignorés.
+	handler := RealIP(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "203.0.113.7:4000", r.RemoteAddr)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.7:4000"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+}
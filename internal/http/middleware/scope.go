@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"lms-go/internal/principal"
+)
+
+// RequireScope restreint les comptes de service au scope read (GET, HEAD) ou write (autres
+// méthodes) ; un scope vide interdit la méthode. Les utilisateurs ne sont pas concernés.
+func RequireScope(read, write string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p, ok := principal.FromContext(r.Context()); ok && p.IsServiceAccount() {
+				scope := write
+				if r.Method == http.MethodGet || r.Method == http.MethodHead {
+					scope = read
+				}
+				if !p.HasScope(scope) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusForbidden)
+					_ = json.NewEncoder(w).Encode(map[string]string{"error": "scope insuffisant pour la clé d'API", "required_scope": scope})
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -5,16 +5,22 @@ import (
 
 	"github.com/google/uuid"
 
+	"lms-go/internal/platform/logging"
 	"lms-go/internal/tenant"
 )
 
 const headerOrgID = "X-Org-ID"
 
 // TenantFromHeader extrait l'identifiant d'organisation depuis l'entête X-Org-ID.
+// L'entête est facultatif lorsque l'organisation est déjà portée par le contexte (clé d'API).
 func TenantFromHeader(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		raw := r.Header.Get(headerOrgID)
 		if raw == "" {
+			if _, err := tenant.OrganizationID(r.Context()); err == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
 			http.Error(w, "missing X-Org-ID header", http.StatusBadRequest)
 			return
 		}
@@ -24,6 +30,7 @@ func TenantFromHeader(next http.Handler) http.Handler {
 			return
 		}
 		ctx := tenant.WithOrganization(r.Context(), orgID)
+		logging.SetOrganization(ctx, orgID.String())
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -187,7 +187,7 @@ func (h *AdminHandler) createOrganization(w http.ResponseWriter, r *http.Request
 	slug := strings.TrimSpace(r.FormValue("slug"))
 	org, err := h.orgService.Create(r.Context(), organization.CreateInput{Name: name, Slug: slug})
 	if err != nil {
-		log.Printf("admin: create organization: %v", err)
+		slog.ErrorContext(r.Context(), "admin: create organization", "error", err)
 		h.redirect(w, r, uuid.Nil, "", friendlyError(err), nil)
 		return
 	}
@@ -211,7 +211,7 @@ func (h *AdminHandler) createUser(w http.ResponseWriter, r *http.Request) {
 		Role:           strings.TrimSpace(r.FormValue("role")),
 	}
 	if _, err := h.userService.Create(r.Context(), input); err != nil {
-		log.Printf("admin: create user: %v", err)
+		slog.ErrorContext(r.Context(), "admin: create user", "error", err)
 		h.redirect(w, r, orgID, "", friendlyError(err), nil)
 		return
 	}
@@ -235,7 +235,7 @@ func (h *AdminHandler) createCourse(w http.ResponseWriter, r *http.Request) {
 		Description:    strings.TrimSpace(r.FormValue("description")),
 	}
 	if _, err := h.courseService.Create(r.Context(), input); err != nil {
-		log.Printf("admin: create course: %v", err)
+		slog.ErrorContext(r.Context(), "admin: create course", "error", err)
 		h.redirect(w, r, orgID, "", friendlyError(err), nil)
 		return
 	}
@@ -279,7 +279,7 @@ func (h *AdminHandler) createModule(w http.ResponseWriter, r *http.Request) {
 		DurationSecs: duration,
 	})
 	if err != nil {
-		log.Printf("admin: create module: %v", err)
+		slog.ErrorContext(r.Context(), "admin: create module", "error", err)
 		h.redirect(w, r, orgID, "", friendlyError(err), nil)
 		return
 	}
@@ -313,7 +313,7 @@ func (h *AdminHandler) createContent(w http.ResponseWriter, r *http.Request) {
 		Metadata:       map[string]any{},
 	})
 	if err != nil {
-		log.Printf("admin: create content: %v", err)
+		slog.ErrorContext(r.Context(), "admin: create content", "error", err)
 		h.redirect(w, r, orgID, "", friendlyError(err), nil)
 		return
 	}
@@ -447,7 +447,7 @@ func (h *AdminHandler) publishCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if _, err := h.courseService.Publish(r.Context(), orgID, courseID); err != nil {
-		log.Printf("admin: publish course: %v", err)
+		slog.ErrorContext(r.Context(), "admin: publish course", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -466,7 +466,7 @@ func (h *AdminHandler) unpublishCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if _, err := h.courseService.Unpublish(r.Context(), orgID, courseID); err != nil {
-		log.Printf("admin: unpublish course: %v", err)
+		slog.ErrorContext(r.Context(), "admin: unpublish course", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -497,7 +497,7 @@ func (h *AdminHandler) updateCourse(w http.ResponseWriter, r *http.Request) {
 		Description: &description,
 	}
 	if _, err := h.courseService.Update(r.Context(), orgID, courseID, input); err != nil {
-		log.Printf("admin: update course: %v", err)
+		slog.ErrorContext(r.Context(), "admin: update course", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -517,7 +517,7 @@ func (h *AdminHandler) deleteCourse(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.courseService.Delete(r.Context(), orgID, courseID); err != nil {
-		log.Printf("admin: delete course: %v", err)
+		slog.ErrorContext(r.Context(), "admin: delete course", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -565,7 +565,7 @@ func (h *AdminHandler) updateModule(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if _, err := h.courseService.UpdateModule(r.Context(), orgID, moduleID, input); err != nil {
-		log.Printf("admin: update module: %v", err)
+		slog.ErrorContext(r.Context(), "admin: update module", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -585,7 +585,7 @@ func (h *AdminHandler) deleteModule(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.courseService.RemoveModule(r.Context(), orgID, moduleID); err != nil {
-		log.Printf("admin: delete module: %v", err)
+		slog.ErrorContext(r.Context(), "admin: delete module", "error", err)
 		h.redirectToCourses(w, r, orgID, "", friendlyError(err))
 		return
 	}
@@ -605,7 +605,7 @@ func (h *AdminHandler) deleteContent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.contentService.Archive(r.Context(), orgID, contentID); err != nil {
-		log.Printf("admin: delete content: %v", err)
+		slog.ErrorContext(r.Context(), "admin: delete content", "error", err)
 		h.redirectToContents(w, r, orgID, "", "Impossible de supprimer le contenu")
 		return
 	}
@@ -709,7 +709,7 @@ func (h *AdminHandler) courseWizardPage(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := wizardTemplates.ExecuteTemplate(w, "admin-course-wizard", vm); err != nil {
-		log.Printf("template error: %v", err)
+		slog.ErrorContext(r.Context(), "template error", "error", err)
 		http.Error(w, "template rendering error", http.StatusInternalServerError)
 	}
 }
@@ -776,7 +776,7 @@ func (h *AdminHandler) createCourseWizard(w http.ResponseWriter, r *http.Request
 
 	newCourse, err := h.courseService.Create(ctx, courseInput)
 	if err != nil {
-		log.Printf("wizard: create course: %v", err)
+		slog.ErrorContext(r.Context(), "wizard: create course", "error", err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error": "%s"}`, friendlyError(err))
@@ -792,7 +792,7 @@ func (h *AdminHandler) createCourseWizard(w http.ResponseWriter, r *http.Request
 			DurationSecs: mod.DurationSecs,
 		}
 		if _, err := h.courseService.AddModule(ctx, orgID, newCourse.ID, moduleInput); err != nil {
-			log.Printf("wizard: add module: %v", err)
+			slog.ErrorContext(r.Context(), "wizard: add module", "error", err)
 			// Continue adding other modules even if one fails
 		}
 	}
@@ -800,7 +800,7 @@ func (h *AdminHandler) createCourseWizard(w http.ResponseWriter, r *http.Request
 	// Auto-publish if requested
 	if req.AutoPublish {
 		if _, err := h.courseService.Publish(ctx, orgID, newCourse.ID); err != nil {
-			log.Printf("wizard: auto-publish: %v", err)
+			slog.ErrorContext(r.Context(), "wizard: auto-publish", "error", err)
 		}
 	}
 
@@ -837,7 +837,7 @@ func (h *AdminHandler) contentsPageV2(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := contentsV2Templates.ExecuteTemplate(w, "admin-contents-v2", vm); err != nil {
-		log.Printf("template error: %v", err)
+		slog.ErrorContext(r.Context(), "template error", "error", err)
 		http.Error(w, "template rendering error", http.StatusInternalServerError)
 	}
 }
@@ -877,7 +877,7 @@ func (h *AdminHandler) createContentUpload(w http.ResponseWriter, r *http.Reques
 	})
 
 	if err != nil {
-		log.Printf("upload: create content: %v", err)
+		slog.ErrorContext(r.Context(), "upload: create content", "error", err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error": "%s"}`, friendlyError(err))
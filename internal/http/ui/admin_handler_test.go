@@ -21,14 +21,15 @@ import (
 	"lms-go/internal/enrollment"
 	"lms-go/internal/ent"
 	"lms-go/internal/organization"
+	"lms-go/internal/platform/storage"
 	"lms-go/internal/user"
 
 	_ "github.com/glebarez/go-sqlite"
 )
 
-type stubStorage struct{}
+type stubStorage struct{ *storage.Memory }
 
-func (s *stubStorage) PresignUpload(_ context.Context, object string, _ string, _ time.Duration) (string, error) {
+func (s *stubStorage) PresignUpload(_ context.Context, object string, _ string, _ int64, _ time.Duration) (string, error) {
 	return "https://example.com/upload/" + object, nil
 }
 
@@ -65,7 +66,7 @@ func newAdminTestEnv(t *testing.T) *adminTestEnv {
 
 	orgSvc := organization.NewService(client)
 	userSvc := user.NewService(client)
-	contentSvc := content.NewService(client, &stubStorage{}, content.Config{})
+	contentSvc := content.NewService(client, &stubStorage{storage.NewMemory()}, content.Config{})
 	courseSvc := course.NewService(client)
 	enrollmentSvc := enrollment.NewService(client)
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"sort"
 	"strings"
@@ -165,7 +165,7 @@ func (h *LearnerHandler) catalog(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := learnerCatalogTmpl.ExecuteTemplate(w, "learn_catalog", vm); err != nil {
-		log.Printf("learner: render catalog: %v", err)
+		slog.ErrorContext(r.Context(), "learner: render catalog", "error", err)
 		http.Error(w, "template rendering error", http.StatusInternalServerError)
 	}
 }
@@ -332,7 +332,7 @@ func (h *LearnerHandler) courseDetail(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := learnerCourseTmpl.ExecuteTemplate(w, "learn_course", vm); err != nil {
-		log.Printf("learner: render course: %v", err)
+		slog.ErrorContext(r.Context(), "learner: render course", "error", err)
 		http.Error(w, "template rendering error", http.StatusInternalServerError)
 	}
 }
@@ -355,10 +355,15 @@ func (h *LearnerHandler) buildModuleContent(ctx context.Context, orgID uuid.UUID
 			}
 			view.Description = fmt.Sprintf("%s — %s", contentEntity.Name, contentEntity.MimeType)
 			if contentEntity.Status == content.StatusAvailable {
-				url, expires, err := h.contentService.PresignDownload(ctx, orgID, contentEntity.ID)
+				input := content.DownloadInput{Revision: module.ContentRevision, ModuleID: &module.ID}
+				if learner != nil {
+					input.UserID = &learner.ID
+					input.Restricted = !content.FullAccess(learner.Role)
+				}
+				link, err := h.contentService.Download(ctx, orgID, contentEntity.ID, input)
 				if err == nil {
-					view.DownloadURL = url
-					view.DownloadTTL = expires
+					view.DownloadURL = link.URL
+					view.DownloadTTL = link.ExpiresAt
 				}
 			}
 		}
@@ -21,15 +21,16 @@ import (
 	"lms-go/internal/enrollment"
 	"lms-go/internal/ent"
 	"lms-go/internal/organization"
+	"lms-go/internal/platform/storage"
 	"lms-go/internal/progress"
 	"lms-go/internal/user"
 
 	_ "github.com/glebarez/go-sqlite"
 )
 
-type learnerStubStorage struct{}
+type learnerStubStorage struct{ *storage.Memory }
 
-func (s *learnerStubStorage) PresignUpload(_ context.Context, object string, _ string, _ time.Duration) (string, error) {
+func (s *learnerStubStorage) PresignUpload(_ context.Context, object string, _ string, _ int64, _ time.Duration) (string, error) {
 	return "https://example.com/upload/" + object, nil
 }
 
@@ -46,6 +47,7 @@ type learnerTestEnv struct {
 	userSvc       *user.Service
 	courseSvc     *course.Service
 	contentSvc    *content.Service
+	store         *learnerStubStorage
 	enrollmentSvc *enrollment.Service
 	progressSvc   *progress.Service
 	cleanup       func()
@@ -71,7 +73,8 @@ func newLearnerTestEnv(t *testing.T) *learnerTestEnv {
 
 	orgSvc := organization.NewService(client)
 	userSvc := user.NewService(client)
-	contentSvc := content.NewService(client, &learnerStubStorage{}, content.Config{})
+	store := &learnerStubStorage{storage.NewMemory()}
+	contentSvc := content.NewService(client, store, content.Config{})
 	courseSvc := course.NewService(client)
 	enrollmentSvc := enrollment.NewService(client)
 	progressSvc := progress.NewService(client)
@@ -92,6 +95,7 @@ func newLearnerTestEnv(t *testing.T) *learnerTestEnv {
 		userSvc:       userSvc,
 		courseSvc:     courseSvc,
 		contentSvc:    contentSvc,
+		store:         store,
 		enrollmentSvc: enrollmentSvc,
 		progressSvc:   progressSvc,
 		cleanup:       cleanup,
@@ -124,6 +128,7 @@ func (e *learnerTestEnv) seed(t *testing.T) {
 		MimeType:       "application/pdf",
 	})
 	require.NoError(t, err)
+	e.store.Put(upload.Content.StorageKey, []byte("%PDF-1.7\n"), "application/pdf")
 	_, err = e.contentSvc.Finalize(ctx, org.ID, upload.Content.ID, content.FinalizeInput{})
 	require.NoError(t, err)
 
@@ -0,0 +1,10 @@
+package magiclink
+
+import "errors"
+
+var (
+	ErrInvalidEmail = errors.New("magiclink: invalid email")
+	ErrInvalidToken = errors.New("magiclink: invalid or expired link")
+	ErrDisabled     = errors.New("magiclink: disabled for organization")
+	ErrNoLinkURL    = errors.New("magiclink: no public link url configured")
+)
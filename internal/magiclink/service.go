@@ -0,0 +1,262 @@
+// Package magiclink implémente la connexion sans mot de passe par lien à usage unique envoyé par email.
+package magiclink
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	entorg "lms-go/internal/ent/organization"
+	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/platform/mail"
+)
+
+const (
+	defaultTTL    = 15 * time.Minute
+	tokenAudience = "magic-link"
+	// settingsKey regroupe dans Organization.settings l'activation du lien magique ({"enabled": true}).
+	settingsKey = "magic_link"
+)
+
+// Service émet et consomme les liens de connexion.
+type Service struct {
+	client  *ent.Client
+	auth    *auth.Service
+	sender  mail.Sender
+	secret  []byte
+	ttl     time.Duration
+	linkURL string
+	now     func() time.Time
+}
+
+// Config configure le service.
+type Config struct {
+	// Secret signe les liens (réutilise en général JWT_SECRET).
+	Secret string
+	// TTL borne la validité d'un lien.
+	TTL time.Duration
+	// LinkURL est la page (front) vers laquelle pointe le lien ; le token y est ajouté en paramètre
+	// `token`. Vide : l'URL fournie à Request (endpoint de consommation de l'API) est utilisée.
+	// L'une ou l'autre doit venir de la configuration, jamais des entêtes de la requête.
+	LinkURL string
+}
+
+// NewService crée une instance de Service.
+func NewService(client *ent.Client, authService *auth.Service, sender mail.Sender, cfg Config) *Service {
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	return &Service{
+		client:  client,
+		auth:    authService,
+		sender:  sender,
+		secret:  []byte(cfg.Secret),
+		ttl:     ttl,
+		linkURL: cfg.LinkURL,
+		now:     time.Now,
+	}
+}
+
+func (s *Service) withNow(now func() time.Time) {
+	if now != nil {
+		s.now = now
+	}
+}
+
+// RequestInput décrit une demande de lien.
+type RequestInput struct {
+	Email string
+	// OrgSlug restreint la demande à une organisation lorsque l'email y est rattaché à plusieurs.
+	OrgSlug string
+	// ConsumeURL est l'endpoint de consommation utilisé si aucune LinkURL n'est configurée ;
+	// il est construit à partir de l'URL publique configurée de l'API.
+	ConsumeURL string
+	UseCookies bool
+}
+
+type linkClaims struct {
+	jwt.RegisteredClaims
+}
+
+// Enabled indique si l'organisation autorise la connexion par lien magique.
+func Enabled(settings map[string]any) bool {
+	cfg, ok := settings[settingsKey].(map[string]any)
+	if !ok {
+		return false
+	}
+	enabled, _ := cfg["enabled"].(bool)
+	return enabled
+}
+
+// Request envoie un lien de connexion à chaque compte actif associé à l'email dans une organisation
+// ayant activé la fonctionnalité. L'absence de compte n'est pas signalée, pour ne pas révéler
+// l'existence des adresses.
+func (s *Service) Request(ctx context.Context, input RequestInput) error {
+	email := strings.TrimSpace(strings.ToLower(input.Email))
+	if email == "" || !strings.Contains(email, "@") {
+		return ErrInvalidEmail
+	}
+	if s.linkURL == "" && input.ConsumeURL == "" {
+		return ErrNoLinkURL
+	}
+
+	query := s.client.User.Query().
+		Where(
+			entuser.EmailEQ(email),
+			entuser.StatusEQ("active"),
+		).
+		WithOrganization()
+	if slug := strings.TrimSpace(strings.ToLower(input.OrgSlug)); slug != "" {
+		query = query.Where(entuser.HasOrganizationWith(entorg.SlugEQ(slug)))
+	}
+	users, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		org := u.Edges.Organization
+		if org == nil || org.Status != "active" || !Enabled(org.Settings) {
+			continue
+		}
+		link, err := s.issue(ctx, u, input)
+		if err != nil {
+			return err
+		}
+		if err := s.sender.Send(ctx, s.message(u, org, link)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Consume échange un lien contre une session. Le lien est invalidé au premier usage, comme tout
+// lien émis auparavant pour le même compte. Si un second facteur est attendu, l'erreur
+// *auth.MFAChallengeError est renvoyée comme pour Login.
+func (s *Service) Consume(ctx context.Context, token string) (*ent.User, auth.TokenPair, error) {
+	claims := &linkClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return s.secret, nil
+	}, jwt.WithAudience(tokenAudience), jwt.WithTimeFunc(s.now), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !parsed.Valid || claims.ID == "" {
+		return nil, auth.TokenPair{}, ErrInvalidToken
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, auth.TokenPair{}, ErrInvalidToken
+	}
+
+	consumed, err := s.client.User.Update().
+		Where(
+			entuser.ID(userID),
+			entuser.MagicLinkHash(hashNonce(claims.ID)),
+		).
+		ClearMagicLinkHash().
+		Save(ctx)
+	if err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+	if consumed == 0 {
+		return nil, auth.TokenPair{}, ErrInvalidToken
+	}
+
+	u, err := s.client.User.Query().
+		Where(entuser.ID(userID)).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+	if org := u.Edges.Organization; org == nil || org.Status != "active" || !Enabled(org.Settings) {
+		return nil, auth.TokenPair{}, ErrDisabled
+	}
+
+	tokens, err := s.auth.StartSession(ctx, u.ID)
+	if err != nil {
+		return u, auth.TokenPair{}, err
+	}
+	return u, tokens, nil
+}
+
+// issue génère un lien signé et mémorise l'empreinte de son nonce, ce qui révoque le lien précédent.
+func (s *Service) issue(ctx context.Context, u *ent.User, input RequestInput) (string, error) {
+	nonce, err := randomNonce()
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &linkClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        nonce,
+			Subject:   u.ID.String(),
+			Audience:  []string{tokenAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+	}).SignedString(s.secret)
+	if err != nil {
+		return "", err
+	}
+	if err := s.client.User.UpdateOneID(u.ID).
+		SetMagicLinkHash(hashNonce(nonce)).
+		Exec(ctx); err != nil {
+		return "", err
+	}
+
+	base := s.linkURL
+	if base == "" {
+		base = input.ConsumeURL
+	}
+	link, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("magiclink: invalid link url: %w", err)
+	}
+	q := link.Query()
+	q.Set("token", signed)
+	if input.UseCookies {
+		q.Set("use_cookies", "true")
+	}
+	link.RawQuery = q.Encode()
+	return link.String(), nil
+}
+
+func (s *Service) message(u *ent.User, org *ent.Organization, link string) mail.Message {
+	minutes := int(s.ttl.Round(time.Minute) / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	return mail.Message{
+		To:      u.Email,
+		Subject: "Votre lien de connexion à " + org.Name,
+		Text: fmt.Sprintf("Bonjour,\n\n"+
+			"Cliquez sur le lien ci-dessous pour vous connecter à %s. "+
+			"Il est valable %d minutes et ne peut être utilisé qu'une seule fois.\n\n%s\n\n"+
+			"Si vous n'êtes pas à l'origine de cette demande, ignorez ce message.\n",
+			org.Name, minutes, link),
+	}
+}
+
+func hashNonce(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomNonce() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,205 @@
+package magiclink
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/mail"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+const testConsumeURL = "https://api.lms.test/auth/magic-link/consume"
+
+type testEnv struct {
+	svc    *Service
+	client *ent.Client
+	auth   *auth.Service
+	sender *mail.MemorySender
+	org    *ent.Organization
+}
+
+func newTestEnv(t *testing.T, name string, settings map[string]any) testEnv {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	org, err := client.Organization.Create().
+		SetName("Acme").
+		SetSlug("acme").
+		SetSettings(settings).
+		Save(ctx)
+	require.NoError(t, err)
+
+	authService := auth.NewService(client, auth.Config{
+		JWTSecret:       "secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+	sender := mail.NewMemorySender()
+	return testEnv{
+		svc:    NewService(client, authService, sender, Config{Secret: "link-secret"}),
+		client: client,
+		auth:   authService,
+		sender: sender,
+		org:    org,
+	}
+}
+
+func (env testEnv) register(t *testing.T, org *ent.Organization, email string) *ent.User {
+	t.Helper()
+	u, err := env.auth.Register(context.Background(), auth.RegisterInput{
+		OrganizationID: org.ID,
+		Email:          email,
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+	return u
+}
+
+// linkToken extrait le token du lien contenu dans le dernier email envoyé.
+func linkToken(t *testing.T, sender *mail.MemorySender) (string, *url.URL) {
+	t.Helper()
+	messages := sender.Messages()
+	require.NotEmpty(t, messages)
+	for _, line := range strings.Split(messages[len(messages)-1].Text, "\n") {
+		if strings.HasPrefix(line, "https://") {
+			link, err := url.Parse(line)
+			require.NoError(t, err)
+			return link.Query().Get("token"), link
+		}
+	}
+	t.Fatal("no link in message")
+	return "", nil
+}
+
+var enabled = map[string]any{"magic_link": map[string]any{"enabled": true}}
+
+func TestService_RequestAndConsume(t *testing.T) {
+	env := newTestEnv(t, "magiclink_consume", enabled)
+	ctx := context.Background()
+	u := env.register(t, env.org, "ada@acme.test")
+
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: " ADA@acme.test ", ConsumeURL: testConsumeURL, UseCookies: true}))
+	messages := env.sender.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, "ada@acme.test", messages[0].To)
+	require.Contains(t, messages[0].Subject, "Acme")
+
+	token, link := linkToken(t, env.sender)
+	require.Equal(t, "api.lms.test", link.Host)
+	require.Equal(t, "true", link.Query().Get("use_cookies"))
+
+	owner, tokens, err := env.svc.Consume(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, u.ID, owner.ID)
+	require.NotEmpty(t, tokens.AccessToken)
+
+	// Usage unique.
+	_, _, err = env.svc.Consume(ctx, token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, _, err = env.svc.Consume(ctx, "not-a-token")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestService_LinkExpiresAndIsSuperseded(t *testing.T) {
+	env := newTestEnv(t, "magiclink_expiry", enabled)
+	ctx := context.Background()
+	env.register(t, env.org, "ada@acme.test")
+
+	now := time.Now()
+	env.svc.withNow(func() time.Time { return now })
+
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	first, _ := linkToken(t, env.sender)
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	second, _ := linkToken(t, env.sender)
+
+	// Un nouveau lien révoque le précédent.
+	_, _, err := env.svc.Consume(ctx, first)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	now = now.Add(defaultTTL + time.Second)
+	_, _, err = env.svc.Consume(ctx, second)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestService_RespectsOrganizationSetting(t *testing.T) {
+	env := newTestEnv(t, "magiclink_disabled", map[string]any{})
+	ctx := context.Background()
+	env.register(t, env.org, "ada@acme.test")
+
+	// Ni erreur ni email : la réponse ne révèle pas l'existence du compte.
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ghost@acme.test", ConsumeURL: testConsumeURL}))
+	require.Empty(t, env.sender.Messages())
+	require.ErrorIs(t, env.svc.Request(ctx, RequestInput{Email: "nope", ConsumeURL: testConsumeURL}), ErrInvalidEmail)
+
+	// Désactivation entre l'envoi et la consommation.
+	_, err := env.client.Organization.UpdateOneID(env.org.ID).SetSettings(enabled).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	token, _ := linkToken(t, env.sender)
+	_, err = env.client.Organization.UpdateOneID(env.org.ID).SetSettings(map[string]any{}).Save(ctx)
+	require.NoError(t, err)
+	_, _, err = env.svc.Consume(ctx, token)
+	require.ErrorIs(t, err, ErrDisabled)
+}
+
+func TestService_MultipleOrganizations(t *testing.T) {
+	env := newTestEnv(t, "magiclink_multi", enabled)
+	ctx := context.Background()
+	env.register(t, env.org, "ada@acme.test")
+	other, err := env.client.Organization.Create().SetName("Globex").SetSlug("globex").SetSettings(enabled).Save(ctx)
+	require.NoError(t, err)
+	env.register(t, other, "ada@acme.test")
+
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	require.Len(t, env.sender.Messages(), 2)
+
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", OrgSlug: "globex", ConsumeURL: testConsumeURL}))
+	messages := env.sender.Messages()
+	require.Len(t, messages, 3)
+	require.Contains(t, messages[2].Subject, "Globex")
+}
+
+func TestService_ConsumeRequiresSecondFactor(t *testing.T) {
+	env := newTestEnv(t, "magiclink_mfa", map[string]any{
+		"magic_link": map[string]any{"enabled": true},
+		"mfa":        map[string]any{"required_roles": []any{"learner"}},
+	})
+	ctx := context.Background()
+	env.register(t, env.org, "ada@acme.test")
+
+	require.NoError(t, env.svc.Request(ctx, RequestInput{Email: "ada@acme.test", ConsumeURL: testConsumeURL}))
+	token, _ := linkToken(t, env.sender)
+	_, _, err := env.svc.Consume(ctx, token)
+	var challenge *auth.MFAChallengeError
+	require.ErrorAs(t, err, &challenge)
+	require.True(t, challenge.EnrollmentRequired)
+}
@@ -6,4 +6,6 @@ var (
 	ErrSlugAlreadyUsed = errors.New("organization: slug already used")
 	ErrNotFound        = errors.New("organization: not found")
 	ErrInvalidInput    = errors.New("organization: invalid input")
+	// ErrVersionMismatch signale que l'organisation a changé depuis la version attendue.
+	ErrVersionMismatch = errors.New("organization: version modifiée")
 )
@@ -34,6 +34,8 @@ type UpdateInput struct {
 	Slug     *string
 	Status   *string
 	Settings map[string]any
+	// IfMatch liste les versions attendues de l'organisation ; vide, aucune n'est exigée.
+	IfMatch []int
 }
 
 var slugSanitizer = regexp.MustCompile(`[^a-z0-9-]+`)
@@ -118,7 +120,11 @@ func (s *Service) List(ctx context.Context, status string) ([]*ent.Organization,
 
 // Update modifie une organisation existante.
 func (s *Service) Update(ctx context.Context, id uuid.UUID, input UpdateInput) (*ent.Organization, error) {
-	update := s.client.Organization.UpdateOneID(id)
+	update := s.client.Organization.UpdateOneID(id).
+		AddVersion(1)
+	if len(input.IfMatch) > 0 {
+		update.Where(entorg.VersionIn(input.IfMatch...))
+	}
 	if input.Name != nil {
 		update.SetName(strings.TrimSpace(*input.Name))
 	}
@@ -147,33 +153,55 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input UpdateInput) (
 			return nil, ErrSlugAlreadyUsed
 		}
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			return nil, s.updateFailed(ctx, id, input.IfMatch)
 		}
 		return nil, err
 	}
 	return org, nil
 }
 
-// Archive passe l'organisation en statut inactif.
-func (s *Service) Archive(ctx context.Context, id uuid.UUID) error {
-	return s.updateStatus(ctx, id, "inactive")
+// Archive passe l'organisation en statut inactif, si sa version fait partie de ifMatch
+// lorsqu'il est fourni.
+func (s *Service) Archive(ctx context.Context, id uuid.UUID, ifMatch ...int) error {
+	return s.updateStatus(ctx, id, "inactive", ifMatch)
 }
 
-// Activate repasse l'organisation en actif.
-func (s *Service) Activate(ctx context.Context, id uuid.UUID) error {
-	return s.updateStatus(ctx, id, "active")
+// Activate repasse l'organisation en actif, aux mêmes conditions qu'Archive.
+func (s *Service) Activate(ctx context.Context, id uuid.UUID, ifMatch ...int) error {
+	return s.updateStatus(ctx, id, "active", ifMatch)
 }
 
-func (s *Service) updateStatus(ctx context.Context, id uuid.UUID, status string) error {
-	_, err := s.client.Organization.UpdateOneID(id).
+func (s *Service) updateStatus(ctx context.Context, id uuid.UUID, status string, ifMatch []int) error {
+	update := s.client.Organization.UpdateOneID(id).
 		SetStatus(status).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
-	if err != nil {
+		AddVersion(1).
+		SetUpdatedAt(time.Now())
+	if len(ifMatch) > 0 {
+		update.Where(entorg.VersionIn(ifMatch...))
+	}
+	if err := update.Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
-			return ErrNotFound
+			return s.updateFailed(ctx, id, ifMatch)
 		}
 		return err
 	}
 	return nil
 }
+
+// updateFailed qualifie l'échec d'une mise à jour conditionnelle : ErrVersionMismatch si
+// l'organisation existe toujours mais a changé de version, ErrNotFound sinon.
+func (s *Service) updateFailed(ctx context.Context, id uuid.UUID, ifMatch []int) error {
+	if len(ifMatch) == 0 {
+		return ErrNotFound
+	}
+	exists, err := s.client.Organization.Query().
+		Where(entorg.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
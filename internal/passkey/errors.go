@@ -0,0 +1,12 @@
+package passkey
+
+import "errors"
+
+var (
+	ErrNotConfigured   = errors.New("passkey: webauthn not configured")
+	ErrInvalidSession  = errors.New("passkey: invalid or expired ceremony session")
+	ErrInvalidResponse = errors.New("passkey: invalid authenticator response")
+	ErrCloneDetected   = errors.New("passkey: sign counter regression")
+	ErrAlreadyExists   = errors.New("passkey: credential already registered")
+	ErrNotFound        = errors.New("passkey: credential not found")
+)
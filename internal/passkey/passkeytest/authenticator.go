@@ -0,0 +1,280 @@
+// Package passkeytest fournit un authentificateur WebAuthn logiciel pour les tests de passkeys.
+package passkeytest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+// Drapeaux de l'authenticatorData (WebAuthn §6.1).
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+// Credential est une passkey détenue par l'authentificateur logiciel.
+type Credential struct {
+	ID         []byte
+	UserHandle []byte
+	RPID       string
+	// SignCount est incrémenté à chaque assertion ; le modifier permet de simuler un clone.
+	SignCount uint32
+
+	key *ecdsa.PrivateKey
+}
+
+// Authenticator simule un authentificateur de plateforme (clé ES256, attestation "none",
+// vérification de l'utilisateur toujours effectuée) répondant depuis l'origine donnée.
+type Authenticator struct {
+	Origin string
+
+	mu          sync.Mutex
+	credentials []*Credential
+}
+
+// NewAuthenticator crée un authentificateur sans passkey.
+func NewAuthenticator(origin string) *Authenticator {
+	return &Authenticator{Origin: origin}
+}
+
+// Credentials renvoie les passkeys créées, dans l'ordre d'enregistrement.
+func (a *Authenticator) Credentials() []*Credential {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]*Credential(nil), a.credentials...)
+}
+
+type creationOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		ExcludeCredentials []struct {
+			ID string `json:"id"`
+		} `json:"excludeCredentials"`
+	} `json:"publicKey"`
+}
+
+type requestOptions struct {
+	PublicKey struct {
+		Challenge        string `json:"challenge"`
+		RPID             string `json:"rpId"`
+		AllowCredentials []struct {
+			ID string `json:"id"`
+		} `json:"allowCredentials"`
+	} `json:"publicKey"`
+}
+
+// Register exécute navigator.credentials.create avec les options fournies par le serveur
+// et renvoie la réponse JSON à transmettre à l'étape finish.
+func (a *Authenticator) Register(t testing.TB, options any) json.RawMessage {
+	t.Helper()
+	var opts creationOptions
+	decodeOptions(t, options, &opts)
+
+	userHandle := decode(t, opts.PublicKey.User.ID)
+	for _, excluded := range opts.PublicKey.ExcludeCredentials {
+		if a.find(opts.PublicKey.RP.ID, decode(t, excluded.ID)) != nil {
+			t.Fatalf("passkeytest: credential already registered for this user")
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("passkeytest: generate key: %v", err)
+	}
+	credential := &Credential{
+		ID:         randomBytes(t, 32),
+		UserHandle: userHandle,
+		RPID:       opts.PublicKey.RP.ID,
+		key:        key,
+	}
+
+	point, err := key.PublicKey.ECDH()
+	if err != nil {
+		t.Fatalf("passkeytest: public key: %v", err)
+	}
+	raw := point.Bytes()
+	coseKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: raw[1:33],
+		YCoord: raw[33:],
+	})
+	if err != nil {
+		t.Fatalf("passkeytest: encode public key: %v", err)
+	}
+
+	var attested bytes.Buffer
+	attested.Write(make([]byte, 16)) // AAGUID nul
+	_ = binary.Write(&attested, binary.BigEndian, uint16(len(credential.ID)))
+	attested.Write(credential.ID)
+	attested.Write(coseKey)
+	authData := authenticatorData(credential.RPID, flagUserPresent|flagUserVerified|flagAttestedData, 0, attested.Bytes())
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatalf("passkeytest: encode attestation: %v", err)
+	}
+
+	a.mu.Lock()
+	a.credentials = append(a.credentials, credential)
+	a.mu.Unlock()
+
+	clientData := a.clientData(t, "webauthn.create", opts.PublicKey.Challenge)
+	return encodeResponse(t, credential.ID, map[string]any{
+		"clientDataJSON":    encode(clientData),
+		"attestationObject": encode(attestation),
+		"transports":        []string{"internal", "hybrid"},
+	})
+}
+
+// Login exécute navigator.credentials.get avec la première passkey utilisable pour la partie de confiance.
+func (a *Authenticator) Login(t testing.TB, options any) json.RawMessage {
+	t.Helper()
+	var opts requestOptions
+	decodeOptions(t, options, &opts)
+
+	var credential *Credential
+	if len(opts.PublicKey.AllowCredentials) > 0 {
+		for _, allowed := range opts.PublicKey.AllowCredentials {
+			if credential = a.find(opts.PublicKey.RPID, decode(t, allowed.ID)); credential != nil {
+				break
+			}
+		}
+	} else {
+		credential = a.find(opts.PublicKey.RPID, nil)
+	}
+	if credential == nil {
+		t.Fatalf("passkeytest: no credential for %q", opts.PublicKey.RPID)
+	}
+
+	a.mu.Lock()
+	credential.SignCount++
+	counter := credential.SignCount
+	a.mu.Unlock()
+
+	authData := authenticatorData(credential.RPID, flagUserPresent|flagUserVerified, counter, nil)
+	clientData := a.clientData(t, "webauthn.get", opts.PublicKey.Challenge)
+	clientHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, credential.key, digest[:])
+	if err != nil {
+		t.Fatalf("passkeytest: sign assertion: %v", err)
+	}
+
+	return encodeResponse(t, credential.ID, map[string]any{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode(credential.UserHandle),
+	})
+}
+
+func (a *Authenticator) find(rpID string, id []byte) *Credential {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, c := range a.credentials {
+		if c.RPID == rpID && (id == nil || bytes.Equal(c.ID, id)) {
+			return c
+		}
+	}
+	return nil
+}
+
+func (a *Authenticator) clientData(t testing.TB, kind, challenge string) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{
+		"type":        kind,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	if err != nil {
+		t.Fatalf("passkeytest: client data: %v", err)
+	}
+	return data
+}
+
+func authenticatorData(rpID string, flags byte, counter uint32, attested []byte) []byte {
+	rpHash := sha256.Sum256([]byte(rpID))
+	var buf bytes.Buffer
+	buf.Write(rpHash[:])
+	buf.WriteByte(flags)
+	_ = binary.Write(&buf, binary.BigEndian, counter)
+	buf.Write(attested)
+	return buf.Bytes()
+}
+
+func encodeResponse(t testing.TB, id []byte, response map[string]any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{
+		"id":       encode(id),
+		"rawId":    encode(id),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatalf("passkeytest: encode response: %v", err)
+	}
+	return data
+}
+
+func decodeOptions(t testing.TB, options any, target any) {
+	t.Helper()
+	raw, ok := options.(json.RawMessage)
+	if !ok {
+		var err error
+		if raw, err = json.Marshal(options); err != nil {
+			t.Fatalf("passkeytest: encode options: %v", err)
+		}
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		t.Fatalf("passkeytest: decode options: %v", err)
+	}
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(t testing.TB, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("passkeytest: decode %q: %v", s, err)
+	}
+	return b
+}
+
+func randomBytes(t testing.TB, n int) []byte {
+	t.Helper()
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		t.Fatalf("passkeytest: random: %v", err)
+	}
+	return buf
+}
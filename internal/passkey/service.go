@@ -0,0 +1,353 @@
+// Package passkey implémente la connexion sans mot de passe par passkeys (WebAuthn) :
+// enregistrement des identifiants d'un utilisateur et assertion découvrable menant à un couple de tokens.
+package passkey
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	entuser "lms-go/internal/ent/user"
+	"lms-go/internal/ent/webauthnceremony"
+	"lms-go/internal/ent/webauthncredential"
+)
+
+const (
+	defaultSessionTTL    = 5 * time.Minute
+	defaultRPDisplayName = "LMS"
+	maxNameLength        = 64
+)
+
+// Service orchestre les cérémonies WebAuthn et la gestion des passkeys enregistrées.
+type Service struct {
+	client     *ent.Client
+	auth       *auth.Service
+	webauthn   *webauthn.WebAuthn
+	secret     []byte
+	sessionTTL time.Duration
+	now        func() time.Time
+}
+
+// Config configure la partie de confiance (Relying Party) WebAuthn.
+type Config struct {
+	// RPID est le domaine auquel les passkeys sont liées (ex. lms.example.com). Vide : passkeys désactivées.
+	RPID string
+	// RPDisplayName est le nom affiché par l'authentificateur.
+	RPDisplayName string
+	// RPOrigins liste les origines autorisées (défaut : https://<RPID>).
+	RPOrigins []string
+	// SessionSecret signe l'état de cérémonie renvoyé au client (réutilise en général JWT_SECRET).
+	SessionSecret string
+	// SessionTTL borne la durée entre begin et finish.
+	SessionTTL time.Duration
+}
+
+// NewService crée une instance de Service. Sans RPID, le service est créé mais chaque
+// cérémonie renvoie ErrNotConfigured.
+func NewService(client *ent.Client, authService *auth.Service, cfg Config) (*Service, error) {
+	ttl := cfg.SessionTTL
+	if ttl <= 0 {
+		ttl = defaultSessionTTL
+	}
+	s := &Service{
+		client:     client,
+		auth:       authService,
+		secret:     []byte(cfg.SessionSecret),
+		sessionTTL: ttl,
+		now:        time.Now,
+	}
+	if cfg.RPID == "" {
+		return s, nil
+	}
+
+	displayName := cfg.RPDisplayName
+	if displayName == "" {
+		displayName = defaultRPDisplayName
+	}
+	origins := cfg.RPOrigins
+	if len(origins) == 0 {
+		origins = []string{"https://" + cfg.RPID}
+	}
+	wa, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: displayName,
+		RPOrigins:     origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("passkey: %w", err)
+	}
+	s.webauthn = wa
+	return s, nil
+}
+
+func (s *Service) withNow(now func() time.Time) {
+	if now != nil {
+		s.now = now
+	}
+}
+
+// Ceremony regroupe les options à transmettre à navigator.credentials et l'état signé
+// à renvoyer lors de l'étape finish.
+type Ceremony struct {
+	Options   any
+	Session   string
+	ExpiresAt time.Time
+}
+
+// BeginRegistration prépare l'enregistrement d'une nouvelle passkey pour l'utilisateur.
+func (s *Service) BeginRegistration(ctx context.Context, userID uuid.UUID) (Ceremony, error) {
+	if s.webauthn == nil {
+		return Ceremony{}, ErrNotConfigured
+	}
+	owner, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return Ceremony{}, err
+	}
+	creation, data, err := s.webauthn.BeginRegistration(owner,
+		webauthn.WithExclusions(webauthn.Credentials(owner.WebAuthnCredentials()).CredentialDescriptors()),
+	)
+	if err != nil {
+		return Ceremony{}, err
+	}
+	return s.ceremony(ceremonyRegistration, creation, data)
+}
+
+// FinishRegistration vérifie la réponse d'attestation et enregistre la passkey.
+func (s *Service) FinishRegistration(ctx context.Context, userID uuid.UUID, session, name string, response []byte) (*ent.WebauthnCredential, error) {
+	if s.webauthn == nil {
+		return nil, ErrNotConfigured
+	}
+	st, err := parseSession(s.secret, session, ceremonyRegistration, s.now)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(st.Data.UserID, userID[:]) {
+		return nil, ErrInvalidSession
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if err := s.consume(ctx, st); err != nil {
+		return nil, err
+	}
+	owner, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := s.webauthn.CreateCredential(owner, st.Data, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, t := range credential.Transport {
+		transports = append(transports, string(t))
+	}
+	created, err := s.client.WebauthnCredential.Create().
+		SetUserID(userID).
+		SetCredentialID(credential.ID).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetAaguid(credential.Authenticator.AAGUID).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetTransports(transports).
+		SetBackupEligible(credential.Flags.BackupEligible).
+		SetBackupState(credential.Flags.BackupState).
+		SetName(normalizeName(name)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// BeginLogin prépare une assertion découvrable : l'authentificateur propose les passkeys
+// du site sans que l'utilisateur ait à saisir son email.
+func (s *Service) BeginLogin(ctx context.Context) (Ceremony, error) {
+	if s.webauthn == nil {
+		return Ceremony{}, ErrNotConfigured
+	}
+	assertion, data, err := s.webauthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return Ceremony{}, err
+	}
+	return s.ceremony(ceremonyLogin, assertion, data)
+}
+
+// FinishLogin vérifie l'assertion, met à jour le compteur de signatures et ouvre une session.
+// La vérification de l'utilisateur étant exigée, la passkey tient lieu de second facteur.
+func (s *Service) FinishLogin(ctx context.Context, session string, response []byte) (*ent.User, auth.TokenPair, error) {
+	if s.webauthn == nil {
+		return nil, auth.TokenPair{}, ErrNotConfigured
+	}
+	st, err := parseSession(s.secret, session, ceremonyLogin, s.now)
+	if err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, auth.TokenPair{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if err := s.consume(ctx, st); err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+
+	var (
+		owner     *webauthnUser
+		lookupErr error
+	)
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		userID, err := uuid.FromBytes(userHandle)
+		if err != nil {
+			lookupErr = ErrNotFound
+			return nil, lookupErr
+		}
+		owner, lookupErr = s.loadUser(ctx, userID)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return owner, nil
+	}
+	validated, err := s.webauthn.ValidateDiscoverableLogin(handler, st.Data, parsed)
+	if err != nil {
+		// Un user handle inconnu est une assertion invalide ; les autres erreurs (compte inactif) remontent.
+		if lookupErr != nil && !errors.Is(lookupErr, ErrNotFound) {
+			return nil, auth.TokenPair{}, lookupErr
+		}
+		return nil, auth.TokenPair{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if validated.Authenticator.CloneWarning {
+		return nil, auth.TokenPair{}, ErrCloneDetected
+	}
+
+	stored := owner.credential(validated.ID)
+	if stored == nil {
+		return nil, auth.TokenPair{}, ErrInvalidResponse
+	}
+	if err := s.client.WebauthnCredential.UpdateOneID(stored.ID).
+		SetSignCount(validated.Authenticator.SignCount).
+		SetBackupState(validated.Flags.BackupState).
+		SetLastUsedAt(s.now()).
+		Exec(ctx); err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+
+	tokens, err := s.auth.StartVerifiedSession(ctx, owner.user.ID)
+	if err != nil {
+		return nil, auth.TokenPair{}, err
+	}
+	return owner.user, tokens, nil
+}
+
+// List renvoie les passkeys de l'utilisateur, de la plus récente à la plus ancienne.
+func (s *Service) List(ctx context.Context, userID uuid.UUID) ([]*ent.WebauthnCredential, error) {
+	return s.client.WebauthnCredential.Query().
+		Where(webauthncredential.UserID(userID)).
+		Order(ent.Desc(webauthncredential.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Delete supprime une passkey appartenant à l'utilisateur.
+func (s *Service) Delete(ctx context.Context, userID, credentialID uuid.UUID) error {
+	deleted, err := s.client.WebauthnCredential.Delete().
+		Where(
+			webauthncredential.ID(credentialID),
+			webauthncredential.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Service) ceremony(kind string, options any, data *webauthn.SessionData) (Ceremony, error) {
+	id, err := randomID()
+	if err != nil {
+		return Ceremony{}, err
+	}
+	now := s.now()
+	token, err := signSession(s.secret, ceremonySession{Ceremony: kind, Data: *data}, id, now, s.sessionTTL)
+	if err != nil {
+		return Ceremony{}, err
+	}
+	return Ceremony{Options: options, Session: token, ExpiresAt: now.Add(s.sessionTTL)}, nil
+}
+
+// consume garantit qu'un état de cérémonie ne sert qu'une fois (et donc son challenge). Les états
+// consommés sont conservés en base jusqu'à leur expiration : l'unicité vaut pour toutes les instances.
+func (s *Service) consume(ctx context.Context, st *ceremonySession) error {
+	if _, err := s.client.WebauthnCeremony.Delete().
+		Where(webauthnceremony.ExpiresAtLT(s.now())).
+		Exec(ctx); err != nil {
+		return err
+	}
+	err := s.client.WebauthnCeremony.Create().
+		SetSessionID(st.ID).
+		SetExpiresAt(st.ExpiresAt.Time).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return ErrInvalidSession
+	}
+	return err
+}
+
+func (s *Service) loadUser(ctx context.Context, userID uuid.UUID) (*webauthnUser, error) {
+	u, err := s.client.User.Query().
+		Where(entuser.ID(userID)).
+		WithWebauthnCredentials().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if u.Status != "active" {
+		return nil, auth.ErrUserInactive
+	}
+	return &webauthnUser{user: u}, nil
+}
+
+func normalizeName(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "Passkey"
+	}
+	if runes := []rune(name); len(runes) > maxNameLength {
+		name = string(runes[:maxNameLength])
+	}
+	return name
+}
+
+func randomID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,231 @@
+package passkey
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/passkey/passkeytest"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+const (
+	testRPID   = "lms.test"
+	testOrigin = "https://lms.test"
+)
+
+type testEnv struct {
+	svc    *Service
+	client *ent.Client
+	auth   *auth.Service
+	user   *ent.User
+}
+
+func newTestEnv(t *testing.T, name string) testEnv {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	org, err := client.Organization.Create().SetName("Acme").SetSlug("acme").Save(ctx)
+	require.NoError(t, err)
+	authService := auth.NewService(client, auth.Config{
+		JWTSecret:       "secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+	u, err := authService.Register(ctx, auth.RegisterInput{
+		OrganizationID: org.ID,
+		Email:          "ada@acme.test",
+		Password:       "supersecret",
+	})
+	require.NoError(t, err)
+
+	svc, err := NewService(client, authService, Config{
+		RPID:          testRPID,
+		RPOrigins:     []string{testOrigin},
+		SessionSecret: "session-secret",
+	})
+	require.NoError(t, err)
+
+	return testEnv{svc: svc, client: client, auth: authService, user: u}
+}
+
+func register(t *testing.T, env testEnv, authenticator *passkeytest.Authenticator, name string) *ent.WebauthnCredential {
+	t.Helper()
+	ctx := context.Background()
+	ceremony, err := env.svc.BeginRegistration(ctx, env.user.ID)
+	require.NoError(t, err)
+	credential, err := env.svc.FinishRegistration(ctx, env.user.ID, ceremony.Session, name, authenticator.Register(t, ceremony.Options))
+	require.NoError(t, err)
+	return credential
+}
+
+func TestService_RegisterAndLogin(t *testing.T) {
+	env := newTestEnv(t, "passkey_login")
+	ctx := context.Background()
+	authenticator := passkeytest.NewAuthenticator(testOrigin)
+
+	credential := register(t, env, authenticator, "  MacBook  ")
+	require.Equal(t, "MacBook", credential.Name)
+	require.Equal(t, "none", credential.AttestationType)
+	require.Equal(t, []string{"internal", "hybrid"}, credential.Transports)
+	require.Equal(t, authenticator.Credentials()[0].ID, credential.CredentialID)
+
+	ceremony, err := env.svc.BeginLogin(ctx)
+	require.NoError(t, err)
+	response := authenticator.Login(t, ceremony.Options)
+	owner, tokens, err := env.svc.FinishLogin(ctx, ceremony.Session, response)
+	require.NoError(t, err)
+	require.Equal(t, env.user.ID, owner.ID)
+	require.NotEmpty(t, tokens.AccessToken)
+
+	profile, _, err := env.auth.Profile(ctx, tokens.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, env.user.ID, profile.ID)
+
+	stored, err := env.client.WebauthnCredential.Get(ctx, credential.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stored.SignCount)
+	require.NotNil(t, stored.LastUsedAt)
+
+	// L'état de cérémonie n'est utilisable qu'une fois, y compris sur une autre instance.
+	_, _, err = env.svc.FinishLogin(ctx, ceremony.Session, response)
+	require.ErrorIs(t, err, ErrInvalidSession)
+	replica, err := NewService(env.client, env.auth, Config{
+		RPID:          testRPID,
+		RPOrigins:     []string{testOrigin},
+		SessionSecret: "session-secret",
+	})
+	require.NoError(t, err)
+	_, _, err = replica.FinishLogin(ctx, ceremony.Session, response)
+	require.ErrorIs(t, err, ErrInvalidSession)
+
+	// Un compte verrouillé ne peut pas se connecter par passkey.
+	require.NoError(t, env.client.User.UpdateOneID(env.user.ID).
+		SetLockedUntil(time.Now().Add(time.Hour)).
+		Exec(ctx))
+	locked, err := env.svc.BeginLogin(ctx)
+	require.NoError(t, err)
+	_, _, err = env.svc.FinishLogin(ctx, locked.Session, authenticator.Login(t, locked.Options))
+	require.ErrorIs(t, err, auth.ErrAccountLocked)
+	require.NoError(t, env.auth.Unlock(ctx, env.user.ID))
+
+	// Un challenge différent de celui de la session est refusé.
+	other, err := env.svc.BeginLogin(ctx)
+	require.NoError(t, err)
+	_, _, err = env.svc.FinishLogin(ctx, other.Session, authenticator.Login(t, ceremony.Options))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
+
+func TestService_LoginRejectsClonedAuthenticator(t *testing.T) {
+	env := newTestEnv(t, "passkey_clone")
+	ctx := context.Background()
+	authenticator := passkeytest.NewAuthenticator(testOrigin)
+	register(t, env, authenticator, "")
+
+	for range 2 {
+		ceremony, err := env.svc.BeginLogin(ctx)
+		require.NoError(t, err)
+		_, _, err = env.svc.FinishLogin(ctx, ceremony.Session, authenticator.Login(t, ceremony.Options))
+		require.NoError(t, err)
+	}
+
+	authenticator.Credentials()[0].SignCount = 0
+	ceremony, err := env.svc.BeginLogin(ctx)
+	require.NoError(t, err)
+	_, _, err = env.svc.FinishLogin(ctx, ceremony.Session, authenticator.Login(t, ceremony.Options))
+	require.ErrorIs(t, err, ErrCloneDetected)
+}
+
+func TestService_RegistrationChecks(t *testing.T) {
+	env := newTestEnv(t, "passkey_register")
+	ctx := context.Background()
+	authenticator := passkeytest.NewAuthenticator(testOrigin)
+
+	// Mauvaise origine.
+	ceremony, err := env.svc.BeginRegistration(ctx, env.user.ID)
+	require.NoError(t, err)
+	phishing := passkeytest.NewAuthenticator("https://evil.test")
+	_, err = env.svc.FinishRegistration(ctx, env.user.ID, ceremony.Session, "", phishing.Register(t, ceremony.Options))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	// La session est liée à l'utilisateur qui l'a ouverte.
+	ceremony, err = env.svc.BeginRegistration(ctx, env.user.ID)
+	require.NoError(t, err)
+	_, err = env.svc.FinishRegistration(ctx, uuid.New(), ceremony.Session, "", authenticator.Register(t, ceremony.Options))
+	require.ErrorIs(t, err, ErrInvalidSession)
+
+	credential := register(t, env, authenticator, "")
+	require.Equal(t, "Passkey", credential.Name)
+
+	// Les passkeys existantes sont exclues de la cérémonie suivante.
+	ceremony, err = env.svc.BeginRegistration(ctx, env.user.ID)
+	require.NoError(t, err)
+	require.Contains(t, mustJSON(t, ceremony.Options), "excludeCredentials")
+
+	// Session expirée.
+	now := time.Now().Add(time.Hour)
+	env.svc.withNow(func() time.Time { return now })
+	_, err = env.svc.FinishRegistration(ctx, env.user.ID, ceremony.Session, "", []byte("{}"))
+	require.ErrorIs(t, err, ErrInvalidSession)
+}
+
+func TestService_ListAndDelete(t *testing.T) {
+	env := newTestEnv(t, "passkey_manage")
+	ctx := context.Background()
+	authenticator := passkeytest.NewAuthenticator(testOrigin)
+	first := register(t, env, authenticator, "Phone")
+	register(t, env, passkeytest.NewAuthenticator(testOrigin), "Laptop")
+
+	credentials, err := env.svc.List(ctx, env.user.ID)
+	require.NoError(t, err)
+	require.Len(t, credentials, 2)
+
+	require.ErrorIs(t, env.svc.Delete(ctx, uuid.New(), first.ID), ErrNotFound)
+	require.NoError(t, env.svc.Delete(ctx, env.user.ID, first.ID))
+	require.ErrorIs(t, env.svc.Delete(ctx, env.user.ID, first.ID), ErrNotFound)
+
+	// La passkey supprimée ne permet plus de se connecter.
+	ceremony, err := env.svc.BeginLogin(ctx)
+	require.NoError(t, err)
+	_, _, err = env.svc.FinishLogin(ctx, ceremony.Session, authenticator.Login(t, ceremony.Options))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
+
+func TestService_NotConfigured(t *testing.T) {
+	svc, err := NewService(nil, nil, Config{})
+	require.NoError(t, err)
+	_, err = svc.BeginLogin(context.Background())
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,44 @@
+package passkey
+
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	sessionAudience = "webauthn-ceremony"
+
+	ceremonyRegistration = "registration"
+	ceremonyLogin        = "login"
+)
+
+// ceremonySession conserve les données de la cérémonie (challenge, exigences) entre begin et finish.
+// Signé avec le secret du service et renvoyé au client, qui le retransmet tel quel.
+type ceremonySession struct {
+	Ceremony string               `json:"ceremony"`
+	Data     webauthn.SessionData `json:"data"`
+	jwt.RegisteredClaims
+}
+
+func signSession(secret []byte, st ceremonySession, id string, now time.Time, ttl time.Duration) (string, error) {
+	st.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        id,
+		Audience:  []string{sessionAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, &st).SignedString(secret)
+}
+
+func parseSession(secret []byte, raw, ceremony string, now func() time.Time) (*ceremonySession, error) {
+	st := &ceremonySession{}
+	token, err := jwt.ParseWithClaims(raw, st, func(t *jwt.Token) (any, error) {
+		return secret, nil
+	}, jwt.WithAudience(sessionAudience), jwt.WithTimeFunc(now), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid || st.Ceremony != ceremony || st.ID == "" {
+		return nil, ErrInvalidSession
+	}
+	return st, nil
+}
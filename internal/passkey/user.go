@@ -0,0 +1,67 @@
+package passkey
+
+import (
+	"bytes"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"lms-go/internal/ent"
+)
+
+// webauthnUser adapte un utilisateur et ses passkeys à l'interface webauthn.User.
+// L'identifiant WebAuthn (user handle) est l'UUID de l'utilisateur.
+type webauthnUser struct {
+	user *ent.User
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	id := u.user.ID
+	return id[:]
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	if name, ok := u.user.Metadata["name"].(string); ok && name != "" {
+		return name
+	}
+	return u.user.Email
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.user.Edges.WebauthnCredentials))
+	for _, c := range u.user.Edges.WebauthnCredentials {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(c.Transports))
+		for _, t := range c.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.Aaguid,
+				SignCount: c.SignCount,
+			},
+		})
+	}
+	return credentials
+}
+
+// credential renvoie la passkey stockée correspondant à l'identifiant brut.
+func (u *webauthnUser) credential(rawID []byte) *ent.WebauthnCredential {
+	for _, c := range u.user.Edges.WebauthnCredentials {
+		if bytes.Equal(c.CredentialID, rawID) {
+			return c
+		}
+	}
+	return nil
+}
@@ -17,8 +17,8 @@ type Config struct {
 	URL string
 }
 
-// NewClient ouvre un client ent connecté à PostgreSQL.
-func NewClient(ctx context.Context, cfg Config) (*ent.Client, error) {
+// Open ouvre et vérifie une connexion PostgreSQL.
+func Open(ctx context.Context, cfg Config) (*sql.DB, error) {
 	if cfg.URL == "" {
 		return nil, fmt.Errorf("database: missing URL")
 	}
@@ -31,15 +31,20 @@ func NewClient(ctx context.Context, cfg Config) (*ent.Client, error) {
 		_ = db.Close()
 		return nil, fmt.Errorf("database: ping: %w", err)
 	}
-
-	driver := entsql.OpenDB(dialect.Postgres, db)
-	return ent.NewClient(ent.Driver(driver)), nil
+	return db, nil
 }
 
-// Migrate applique les migrations.
-func Migrate(ctx context.Context, client *ent.Client) error {
-	if client == nil {
-		return fmt.Errorf("database: nil client")
+// NewClient ouvre un client ent connecté à PostgreSQL.
+func NewClient(ctx context.Context, cfg Config) (*ent.Client, error) {
+	db, err := Open(ctx, cfg)
+	if err != nil {
+		return nil, err
 	}
-	return client.Schema.Create(ctx)
+	return NewClientFromDB(db), nil
+}
+
+// NewClientFromDB construit un client ent sur une connexion PostgreSQL déjà ouverte.
+func NewClientFromDB(db *sql.DB) *ent.Client {
+	driver := entsql.OpenDB(dialect.Postgres, db)
+	return ent.NewClient(ent.Driver(driver))
 }
@@ -0,0 +1,492 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// Modes d'application des migrations au démarrage (DB_MIGRATE).
+const (
+	// MigrateAuto applique les migrations en attente sous verrou consultatif.
+	MigrateAuto = "auto"
+	// MigrateCheck refuse de démarrer tant que des migrations sont en attente.
+	MigrateCheck = "check"
+	// MigrateOff ne consulte pas l'historique.
+	MigrateOff = "off"
+)
+
+// HistoryTable est la table d'historique des migrations appliquées.
+const HistoryTable = "schema_migrations"
+
+// lockKey identifie le verrou consultatif PostgreSQL pris pendant les migrations.
+const lockKey int64 = 0x6c6d735f6d6967 // "lms_mig"
+
+var (
+	ErrSchemaBehind     = errors.New("database: schema is behind, pending migrations")
+	ErrChecksumMismatch = errors.New("database: applied migration has been modified")
+	ErrNoDownMigration  = errors.New("database: migration has no down script")
+	ErrUnknownMigration = errors.New("database: unknown migration version")
+	ErrSchemaDrift      = errors.New("database: live schema differs from migrations")
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrations renvoie les fichiers de migration embarqués dans le binaire.
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// Migration est une migration versionnée : <version>_<nom>.up.sql et son .down.sql facultatif.
+type Migration struct {
+	Version  string
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus décrit l'état d'une migration connue du binaire ou de la base.
+type MigrationStatus struct {
+	Version   string
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	// Modified signale une migration appliquée dont le fichier a changé depuis.
+	Modified bool
+	// Unknown signale une version appliquée absente de ce binaire (base plus récente).
+	Unknown bool
+}
+
+// Migrator applique les migrations versionnées et tient l'historique dans HistoryTable.
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+}
+
+// NewMigrator lit les migrations de files ; dialect choisit le verrou et les paramètres SQL.
+func NewMigrator(db *sql.DB, dialect string, files fs.FS) (*Migrator, error) {
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+func loadMigrations(files fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[string]*Migration{}
+	for _, name := range names {
+		base, up := strings.CutSuffix(name, ".up.sql")
+		if !up {
+			var down bool
+			if base, down = strings.CutSuffix(name, ".down.sql"); !down {
+				continue
+			}
+		}
+		version, label, ok := strings.Cut(base, "_")
+		if !ok || version == "" || strings.Trim(version, "0123456789") != "" {
+			return nil, fmt.Errorf("database: invalid migration file name %q", name)
+		}
+		data, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: label}
+			byVersion[version] = m
+		}
+		if up {
+			sum := sha256.Sum256(data)
+			m.Up, m.Checksum = string(data), hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(data)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("database: migration %s has no up script", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrate applique la politique de démarrage mode (auto, check ou off) avec les migrations
+// embarquées et renvoie les migrations appliquées.
+func Migrate(ctx context.Context, db *sql.DB, mode string) ([]Migration, error) {
+	if mode == MigrateOff {
+		return nil, nil
+	}
+	m, err := NewMigrator(db, dialect.Postgres, Migrations())
+	if err != nil {
+		return nil, err
+	}
+	if mode == MigrateCheck {
+		return nil, m.Check(ctx)
+	}
+	return m.Up(ctx)
+}
+
+type appliedMigration struct {
+	checksum  string
+	name      string
+	appliedAt time.Time
+}
+
+// Status renvoie l'état de chaque migration, par version croissante.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := m.ensureHistory(ctx, conn); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return m.status(applied), nil
+}
+
+func (m *Migrator) status(applied map[string]appliedMigration) []MigrationStatus {
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	known := make(map[string]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		st := MigrationStatus{Version: mig.Version, Name: mig.Name}
+		if a, ok := applied[mig.Version]; ok {
+			at := a.appliedAt
+			st.Applied, st.AppliedAt = true, &at
+			st.Modified = a.checksum != mig.Checksum
+		}
+		statuses = append(statuses, st)
+	}
+	for version, a := range applied {
+		if !known[version] {
+			at := a.appliedAt
+			statuses = append(statuses, MigrationStatus{Version: version, Name: a.name, Applied: true, AppliedAt: &at, Unknown: true})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses
+}
+
+// Check renvoie ErrSchemaBehind si des migrations sont en attente et ErrChecksumMismatch si une
+// migration appliquée a été modifiée. Une base plus récente que le binaire est acceptée.
+func (m *Migrator) Check(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	return checkStatuses(statuses)
+}
+
+func checkStatuses(statuses []MigrationStatus) error {
+	var pending []string
+	for _, st := range statuses {
+		if st.Modified {
+			return fmt.Errorf("%w: %s_%s", ErrChecksumMismatch, st.Version, st.Name)
+		}
+		if !st.Applied {
+			pending = append(pending, st.Version)
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %s", ErrSchemaBehind, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up applique les migrations en attente, chacune dans sa transaction. Les instances démarrées en
+// même temps se sérialisent sur un verrou consultatif ; la suivante ne trouve plus rien à faire.
+func (m *Migrator) Up(ctx context.Context) (done []Migration, err error) {
+	err = m.locked(ctx, func(conn *sql.Conn, applied map[string]appliedMigration) error {
+		if err := checkStatuses(m.status(applied)); err != nil && !errors.Is(err, ErrSchemaBehind) {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, mig, mig.Up, true); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down annule les n dernières migrations appliquées, de la plus récente à la plus ancienne.
+func (m *Migrator) Down(ctx context.Context, n int) (done []Migration, err error) {
+	err = m.locked(ctx, func(conn *sql.Conn, applied map[string]appliedMigration) error {
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < n; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if strings.TrimSpace(mig.Down) == "" {
+				return fmt.Errorf("%w: %s_%s", ErrNoDownMigration, mig.Version, mig.Name)
+			}
+			if err := m.apply(ctx, conn, mig, mig.Down, false); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline marque comme appliquées, sans les exécuter, les migrations jusqu'à version incluse.
+// Elle sert à adopter une base créée avant les migrations versionnées. Les migrations sont d'abord
+// rejouées sur dev, une base vide du même moteur : si le schéma obtenu diffère de celui de la base,
+// la baseline est refusée (ErrSchemaDrift, avec les instructions qui combleraient l'écart).
+func (m *Migrator) Baseline(ctx context.Context, version string, dev *sql.DB) (done []Migration, err error) {
+	found := false
+	for _, mig := range m.migrations {
+		found = found || mig.Version == version
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMigration, version)
+	}
+	if err := m.verifySchema(ctx, dev, version); err != nil {
+		return nil, err
+	}
+	err = m.locked(ctx, func(conn *sql.Conn, applied map[string]appliedMigration) error {
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := m.record(ctx, conn, mig, 0); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// verifySchema compare le schéma de la base à celui que produisent les migrations jusqu'à version,
+// rejouées sur dev. La table d'historique est ignorée.
+func (m *Migrator) verifySchema(ctx context.Context, dev *sql.DB, version string) error {
+	replay := &Migrator{db: dev, dialect: m.dialect}
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			break
+		}
+		replay.migrations = append(replay.migrations, mig)
+	}
+	if _, err := replay.Up(ctx); err != nil {
+		return fmt.Errorf("database: replay migrations on dev database: %w", err)
+	}
+
+	live, err := m.inspect(ctx, m.db)
+	if err != nil {
+		return err
+	}
+	expected, err := m.inspect(ctx, dev)
+	if err != nil {
+		return err
+	}
+	drv, err := m.atlasDriver(m.db)
+	if err != nil {
+		return err
+	}
+	expected.Name = live.Name
+	changes, err := drv.SchemaDiff(live, expected)
+	if err != nil {
+		return fmt.Errorf("database: diff schema: %w", err)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	plan, err := drv.PlanChanges(ctx, "baseline", changes)
+	if err != nil {
+		return fmt.Errorf("%w: %d change(s)", ErrSchemaDrift, len(changes))
+	}
+	stmts := make([]string, 0, len(plan.Changes))
+	for _, c := range plan.Changes {
+		stmts = append(stmts, c.Cmd+";")
+	}
+	return fmt.Errorf("%w:\n%s", ErrSchemaDrift, strings.Join(stmts, "\n"))
+}
+
+func (m *Migrator) inspect(ctx context.Context, db *sql.DB) (*schema.Schema, error) {
+	drv, err := m.atlasDriver(db)
+	if err != nil {
+		return nil, err
+	}
+	s, err := drv.InspectSchema(ctx, "", &schema.InspectOptions{Exclude: []string{HistoryTable}})
+	if err != nil {
+		return nil, fmt.Errorf("database: inspect schema: %w", err)
+	}
+	return s, nil
+}
+
+func (m *Migrator) atlasDriver(db *sql.DB) (migrate.Driver, error) {
+	switch m.dialect {
+	case dialect.Postgres:
+		return postgres.Open(db)
+	case dialect.SQLite:
+		return sqlite.Open(db)
+	}
+	return nil, fmt.Errorf("database: unsupported dialect %q", m.dialect)
+}
+
+// locked exécute fn sur une connexion dédiée, sous verrou consultatif pour PostgreSQL, avec
+// l'historique relu après l'obtention du verrou.
+func (m *Migrator) locked(ctx context.Context, fn func(*sql.Conn, map[string]appliedMigration) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if m.dialect == dialect.Postgres {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+			return fmt.Errorf("database: acquire migration lock: %w", err)
+		}
+		defer func() {
+			// Le verrou est lié à la session : il est libéré même si le contexte est annulé.
+			if _, uerr := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockKey); uerr != nil && err == nil {
+				err = fmt.Errorf("database: release migration lock: %w", uerr)
+			}
+		}()
+	}
+	if err := m.ensureHistory(ctx, conn); err != nil {
+		return err
+	}
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return err
+	}
+	return fn(conn, applied)
+}
+
+func (m *Migrator) ensureHistory(ctx context.Context, conn *sql.Conn) error {
+	timestamp := "timestamp with time zone"
+	if m.dialect == dialect.SQLite {
+		timestamp = "datetime"
+	}
+	_, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+HistoryTable+` (
+  version varchar(32) NOT NULL PRIMARY KEY,
+  name varchar(255) NOT NULL,
+  checksum varchar(64) NOT NULL,
+  applied_at `+timestamp+` NOT NULL,
+  execution_ms bigint NOT NULL
+)`)
+	if err != nil {
+		return fmt.Errorf("database: create migration history: %w", err)
+	}
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[string]appliedMigration, error) {
+	query, args := entsql.Dialect(m.dialect).
+		Select("version", "name", "checksum", "applied_at").
+		From(entsql.Table(HistoryTable)).
+		Query()
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database: read migration history: %w", err)
+	}
+	defer rows.Close()
+	applied := map[string]appliedMigration{}
+	for rows.Next() {
+		var version string
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = a
+	}
+	return applied, rows.Err()
+}
+
+// apply exécute un script et met l'historique à jour dans la même transaction.
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, mig Migration, script string, up bool) (err error) {
+	stmts, err := migrate.NewLocalFile(mig.Version+"_"+mig.Name+".sql", []byte(script)).Stmts()
+	if err != nil {
+		return fmt.Errorf("database: parse migration %s: %w", mig.Version, err)
+	}
+	start := time.Now()
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, stmt := range stmts {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("database: migration %s_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+	var query string
+	var args []any
+	if up {
+		query, args = m.insertHistory(mig, time.Since(start))
+	} else {
+		query, args = entsql.Dialect(m.dialect).
+			Delete(HistoryTable).
+			Where(entsql.EQ("version", mig.Version)).
+			Query()
+	}
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("database: update migration history: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (m *Migrator) record(ctx context.Context, conn *sql.Conn, mig Migration, elapsed time.Duration) error {
+	query, args := m.insertHistory(mig, elapsed)
+	if _, err := conn.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("database: update migration history: %w", err)
+	}
+	return nil
+}
+
+func (m *Migrator) insertHistory(mig Migration, elapsed time.Duration) (string, []any) {
+	return entsql.Dialect(m.dialect).
+		Insert(HistoryTable).
+		Columns("version", "name", "checksum", "applied_at", "execution_ms").
+		Values(mig.Version, mig.Name, mig.Checksum, time.Now().UTC(), elapsed.Milliseconds()).
+		Query()
+}
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func TestMigrator(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:migrator?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := context.Background()
+
+	files := fstest.MapFS{
+		"20260101000000_init.up.sql":     {Data: []byte("-- create \"notes\" table\nCREATE TABLE notes (id integer PRIMARY KEY, body text NOT NULL DEFAULT 'a;b');\nINSERT INTO notes (id) VALUES (1);\n")},
+		"20260101000000_init.down.sql":   {Data: []byte("DROP TABLE notes;\n")},
+		"20260102000000_title.up.sql":    {Data: []byte("ALTER TABLE notes ADD COLUMN title text;\n")},
+		"20260102000000_title.down.sql":  {Data: []byte("ALTER TABLE notes DROP COLUMN title;\n")},
+		"20260103000000_no_down.up.sql":  {Data: []byte("CREATE INDEX notes_title ON notes (title);\n")},
+		"README.md":                      {Data: []byte("ignoré")},
+		"20260101000000_init.sql.backup": {Data: []byte("ignoré")},
+	}
+	m, err := NewMigrator(db, dialect.SQLite, files)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, m.Check(ctx), ErrSchemaBehind)
+	done, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, done, 3)
+	require.NoError(t, m.Check(ctx))
+	var body string
+	require.NoError(t, db.QueryRow("SELECT body FROM notes WHERE id = 1").Scan(&body))
+	require.Equal(t, "a;b", body)
+
+	done, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.Empty(t, done, "une seconde exécution ne rejoue rien")
+
+	_, err = m.Down(ctx, 1)
+	require.ErrorIs(t, err, ErrNoDownMigration)
+
+	// Une nouvelle migration livrée avec le binaire met la base en retard.
+	files["20260104000000_tags.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE tags (id integer PRIMARY KEY);")}
+	files["20260104000000_tags.down.sql"] = &fstest.MapFile{Data: []byte("DROP TABLE tags;")}
+	files["20260103000000_no_down.down.sql"] = &fstest.MapFile{Data: []byte("DROP INDEX notes_title;")}
+	m, err = NewMigrator(db, dialect.SQLite, files)
+	require.NoError(t, err)
+	require.ErrorIs(t, m.Check(ctx), ErrSchemaBehind)
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 4)
+	require.True(t, statuses[2].Applied)
+	require.NotNil(t, statuses[2].AppliedAt)
+	require.False(t, statuses[3].Applied)
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	done, err = m.Down(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"20260104000000", "20260103000000", "20260102000000"}, versions(done))
+	_, err = db.Exec("SELECT title FROM notes")
+	require.Error(t, err, "la colonne a été retirée")
+	_, err = db.Exec("SELECT id FROM tags")
+	require.Error(t, err)
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Applied)
+	require.False(t, statuses[1].Applied)
+
+	// Une migration appliquée puis modifiée bloque le démarrage et les nouvelles migrations.
+	files["20260101000000_init.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE notes (id integer PRIMARY KEY);")}
+	m, err = NewMigrator(db, dialect.SQLite, files)
+	require.NoError(t, err)
+	require.ErrorIs(t, m.Check(ctx), ErrChecksumMismatch)
+	_, err = m.Up(ctx)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Modified)
+
+	// Une version appliquée par un binaire plus récent n'empêche pas le démarrage.
+	delete(files, "20260104000000_tags.up.sql")
+	delete(files, "20260104000000_tags.down.sql")
+	files["20260101000000_init.up.sql"] = &fstest.MapFile{Data: []byte("-- create \"notes\" table\nCREATE TABLE notes (id integer PRIMARY KEY, body text NOT NULL DEFAULT 'a;b');\nINSERT INTO notes (id) VALUES (1);\n")}
+	m, err = NewMigrator(db, dialect.SQLite, files)
+	require.NoError(t, err)
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO schema_migrations (version, name, checksum, applied_at, execution_ms) VALUES ('20990101000000', 'future', 'x', CURRENT_TIMESTAMP, 0)")
+	require.NoError(t, err)
+	require.NoError(t, m.Check(ctx))
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[len(statuses)-1].Unknown)
+}
+
+func TestMigrator_Baseline(t *testing.T) {
+	open := func(name string) *sql.DB {
+		db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		return db
+	}
+	db := open("migratorbaseline")
+	ctx := context.Background()
+
+	// Base créée avant les migrations versionnées.
+	_, err := db.Exec("CREATE TABLE notes (id integer PRIMARY KEY, body text NOT NULL)")
+	require.NoError(t, err)
+	files := fstest.MapFS{
+		"20260101000000_init.up.sql":  {Data: []byte("CREATE TABLE notes (id integer PRIMARY KEY, body text NOT NULL);\nCREATE INDEX notes_body ON notes (body);")},
+		"20260102000000_title.up.sql": {Data: []byte("ALTER TABLE notes ADD COLUMN title text;")},
+	}
+	m, err := NewMigrator(db, dialect.SQLite, files)
+	require.NoError(t, err)
+	_, err = m.Baseline(ctx, "20250101000000", open("migratorbaselinedev0"))
+	require.ErrorIs(t, err, ErrUnknownMigration)
+
+	// Un schéma qui ne correspond pas aux migrations (index manquant) est refusé.
+	_, err = m.Baseline(ctx, "20260101000000", open("migratorbaselinedev1"))
+	require.ErrorIs(t, err, ErrSchemaDrift)
+	require.Contains(t, err.Error(), "notes_body")
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.False(t, statuses[0].Applied)
+
+	_, err = db.Exec("CREATE INDEX notes_body ON notes (body)")
+	require.NoError(t, err)
+	done, err := m.Baseline(ctx, "20260101000000", open("migratorbaselinedev2"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"20260101000000"}, versions(done))
+	done, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"20260102000000"}, versions(done))
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	_, err := loadMigrations(fstest.MapFS{"init.up.sql": {Data: []byte("SELECT 1;")}})
+	require.Error(t, err)
+	_, err = loadMigrations(fstest.MapFS{"20260101000000_init.down.sql": {Data: []byte("SELECT 1;")}})
+	require.Error(t, err)
+}
+
+// TestEmbeddedMigrations vérifie les migrations livrées : chaque migration a son script de retour
+// et atlas.sum est à jour (sinon `go run ./cmd/migrate hash`).
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := loadMigrations(Migrations())
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	for _, m := range migrations {
+		require.NotEmpty(t, strings.TrimSpace(m.Down), m.Version)
+		_, err := migrate.NewLocalFile(m.Version+".sql", []byte(m.Up)).Stmts()
+		require.NoError(t, err, m.Version)
+	}
+	dir, err := sqltool.NewGolangMigrateDir("migrations")
+	require.NoError(t, err)
+	require.NoError(t, migrate.Validate(dir))
+}
+
+func versions(migrations []Migration) []string {
+	out := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		out = append(out, m.Version)
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+-- reverse: create index "webauthn_credentials_credential_id_key" to table: "webauthn_credentials"
+DROP INDEX "webauthn_credentials_credential_id_key";
+-- reverse: create "webauthn_credentials" table
+DROP TABLE "webauthn_credentials";
+-- reverse: create index "scim_tokens_token_hash_key" to table: "scim_tokens"
+DROP INDEX "scim_tokens_token_hash_key";
+-- reverse: create "scim_tokens" table
+DROP TABLE "scim_tokens";
+-- reverse: create index "privacyrequest_status_scheduled_at" to table: "privacy_requests"
+DROP INDEX "privacyrequest_status_scheduled_at";
+-- reverse: create index "privacyrequest_organization_id_user_id" to table: "privacy_requests"
+DROP INDEX "privacyrequest_organization_id_user_id";
+-- reverse: create "privacy_requests" table
+DROP TABLE "privacy_requests";
+-- reverse: create index "moduleprogress_module_id_status" to table: "module_progresses"
+DROP INDEX "moduleprogress_module_id_status";
+-- reverse: create index "moduleprogress_enrollment_id_module_id" to table: "module_progresses"
+DROP INDEX "moduleprogress_enrollment_id_module_id";
+-- reverse: create "module_progresses" table
+DROP TABLE "module_progresses";
+-- reverse: create index "module_course_id_status" to table: "modules"
+DROP INDEX "module_course_id_status";
+-- reverse: create index "module_course_id_position" to table: "modules"
+DROP INDEX "module_course_id_position";
+-- reverse: create "modules" table
+DROP TABLE "modules";
+-- reverse: create "group_members" table
+DROP TABLE "group_members";
+-- reverse: create index "enrollment_organization_id_status" to table: "enrollments"
+DROP INDEX "enrollment_organization_id_status";
+-- reverse: create index "enrollment_organization_id_course_id_user_id" to table: "enrollments"
+DROP INDEX "enrollment_organization_id_course_id_user_id";
+-- reverse: create "enrollments" table
+DROP TABLE "enrollments";
+-- reverse: create index "user_organization_id_external_id" to table: "users"
+DROP INDEX "user_organization_id_external_id";
+-- reverse: create index "user_organization_id_email" to table: "users"
+DROP INDEX "user_organization_id_email";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create index "group_organization_id_external_id" to table: "groups"
+DROP INDEX "group_organization_id_external_id";
+-- reverse: create index "group_organization_id_course_id" to table: "groups"
+DROP INDEX "group_organization_id_course_id";
+-- reverse: create index "group_organization_id_name" to table: "groups"
+DROP INDEX "group_organization_id_name";
+-- reverse: create "groups" table
+DROP TABLE "groups";
+-- reverse: create index "course_organization_id_status" to table: "courses"
+DROP INDEX "course_organization_id_status";
+-- reverse: create index "course_organization_id_slug" to table: "courses"
+DROP INDEX "course_organization_id_slug";
+-- reverse: create "courses" table
+DROP TABLE "courses";
+-- reverse: create index "contentrevision_organization_id_storage_key" to table: "content_revisions"
+DROP INDEX "contentrevision_organization_id_storage_key";
+-- reverse: create index "contentrevision_content_id_number" to table: "content_revisions"
+DROP INDEX "contentrevision_content_id_number";
+-- reverse: create "content_revisions" table
+DROP TABLE "content_revisions";
+-- reverse: create index "contentdownload_user_id_content_id" to table: "content_downloads"
+DROP INDEX "contentdownload_user_id_content_id";
+-- reverse: create index "contentdownload_content_id_created_at" to table: "content_downloads"
+DROP INDEX "contentdownload_content_id_created_at";
+-- reverse: create "content_downloads" table
+DROP TABLE "content_downloads";
+-- reverse: create index "content_organization_id_name" to table: "contents"
+DROP INDEX "content_organization_id_name";
+-- reverse: create index "content_organization_id_storage_key" to table: "contents"
+DROP INDEX "content_organization_id_storage_key";
+-- reverse: create "contents" table
+DROP TABLE "contents";
+-- reverse: create index "api_keys_prefix_key" to table: "api_keys"
+DROP INDEX "api_keys_prefix_key";
+-- reverse: create "api_keys" table
+DROP TABLE "api_keys";
+-- reverse: create index "serviceaccount_organization_id_name" to table: "service_accounts"
+DROP INDEX "serviceaccount_organization_id_name";
+-- reverse: create "service_accounts" table
+DROP TABLE "service_accounts";
+-- reverse: create index "organizations_slug_key" to table: "organizations"
+DROP INDEX "organizations_slug_key";
+-- reverse: create index "organizations_name_key" to table: "organizations"
+DROP INDEX "organizations_name_key";
+-- reverse: create "organizations" table
+DROP TABLE "organizations";
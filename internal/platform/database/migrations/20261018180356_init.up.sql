@@ -0,0 +1,316 @@
+-- create "organizations" table
+CREATE TABLE "organizations" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "slug" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'active',
+  "settings" jsonb NULL,
+  "storage_bytes_used" bigint NOT NULL DEFAULT 0,
+  "storage_objects_used" bigint NOT NULL DEFAULT 0,
+  "storage_alert_level" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "organizations_name_key" to table: "organizations"
+CREATE UNIQUE INDEX "organizations_name_key" ON "organizations" ("name");
+-- create index "organizations_slug_key" to table: "organizations"
+CREATE UNIQUE INDEX "organizations_slug_key" ON "organizations" ("slug");
+-- create "service_accounts" table
+CREATE TABLE "service_accounts" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "service_accounts_organizations_service_accounts" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "serviceaccount_organization_id_name" to table: "service_accounts"
+CREATE UNIQUE INDEX "serviceaccount_organization_id_name" ON "service_accounts" ("organization_id", "name");
+-- create "api_keys" table
+CREATE TABLE "api_keys" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "prefix" character varying NOT NULL,
+  "secret_hash" character varying NOT NULL,
+  "scopes" jsonb NOT NULL,
+  "expires_at" timestamptz NULL,
+  "last_used_at" timestamptz NULL,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "service_account_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "api_keys_service_accounts_api_keys" FOREIGN KEY ("service_account_id") REFERENCES "service_accounts" ("id") ON DELETE NO ACTION
+);
+-- create index "api_keys_prefix_key" to table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_prefix_key" ON "api_keys" ("prefix");
+-- create "contents" table
+CREATE TABLE "contents" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "mime_type" character varying NOT NULL,
+  "size_bytes" bigint NULL,
+  "storage_key" character varying NOT NULL,
+  "current_revision" bigint NOT NULL DEFAULT 1,
+  "etag" character varying NULL,
+  "checksum_sha256" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "upload_id" character varying NULL,
+  "upload_part_size" bigint NULL,
+  "upload_started_at" timestamptz NULL,
+  "metadata" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "contents_organizations_contents" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "content_organization_id_storage_key" to table: "contents"
+CREATE UNIQUE INDEX "content_organization_id_storage_key" ON "contents" ("organization_id", "storage_key");
+-- create index "content_organization_id_name" to table: "contents"
+CREATE INDEX "content_organization_id_name" ON "contents" ("organization_id", "name");
+-- create "content_downloads" table
+CREATE TABLE "content_downloads" (
+  "id" uuid NOT NULL,
+  "organization_id" uuid NOT NULL,
+  "revision" bigint NOT NULL,
+  "user_id" uuid NULL,
+  "module_id" uuid NULL,
+  "created_at" timestamptz NOT NULL,
+  "content_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "content_downloads_contents_downloads" FOREIGN KEY ("content_id") REFERENCES "contents" ("id") ON DELETE NO ACTION
+);
+-- create index "contentdownload_content_id_created_at" to table: "content_downloads"
+CREATE INDEX "contentdownload_content_id_created_at" ON "content_downloads" ("content_id", "created_at");
+-- create index "contentdownload_user_id_content_id" to table: "content_downloads"
+CREATE INDEX "contentdownload_user_id_content_id" ON "content_downloads" ("user_id", "content_id");
+-- create "content_revisions" table
+CREATE TABLE "content_revisions" (
+  "id" uuid NOT NULL,
+  "organization_id" uuid NOT NULL,
+  "number" bigint NOT NULL,
+  "storage_key" character varying NOT NULL,
+  "mime_type" character varying NOT NULL,
+  "size_bytes" bigint NULL,
+  "etag" character varying NULL,
+  "checksum_sha256" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "content_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "content_revisions_contents_revisions" FOREIGN KEY ("content_id") REFERENCES "contents" ("id") ON DELETE NO ACTION
+);
+-- create index "contentrevision_content_id_number" to table: "content_revisions"
+CREATE UNIQUE INDEX "contentrevision_content_id_number" ON "content_revisions" ("content_id", "number");
+-- create index "contentrevision_organization_id_storage_key" to table: "content_revisions"
+CREATE UNIQUE INDEX "contentrevision_organization_id_storage_key" ON "content_revisions" ("organization_id", "storage_key");
+-- create "courses" table
+CREATE TABLE "courses" (
+  "id" uuid NOT NULL,
+  "title" character varying NOT NULL,
+  "slug" character varying NOT NULL,
+  "description" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'draft',
+  "version" bigint NOT NULL DEFAULT 1,
+  "metadata" jsonb NULL,
+  "published_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "courses_organizations_courses" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "course_organization_id_slug" to table: "courses"
+CREATE UNIQUE INDEX "course_organization_id_slug" ON "courses" ("organization_id", "slug");
+-- create index "course_organization_id_status" to table: "courses"
+CREATE INDEX "course_organization_id_status" ON "courses" ("organization_id", "status");
+-- create "groups" table
+CREATE TABLE "groups" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "capacity" bigint NULL,
+  "external_id" character varying NULL,
+  "metadata" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "course_id" uuid NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "groups_courses_groups" FOREIGN KEY ("course_id") REFERENCES "courses" ("id") ON DELETE SET NULL,
+  CONSTRAINT "groups_organizations_groups" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "group_organization_id_name" to table: "groups"
+CREATE INDEX "group_organization_id_name" ON "groups" ("organization_id", "name");
+-- create index "group_organization_id_course_id" to table: "groups"
+CREATE INDEX "group_organization_id_course_id" ON "groups" ("organization_id", "course_id");
+-- create index "group_organization_id_external_id" to table: "groups"
+CREATE UNIQUE INDEX "group_organization_id_external_id" ON "groups" ("organization_id", "external_id");
+-- create "users" table
+CREATE TABLE "users" (
+  "id" uuid NOT NULL,
+  "email" character varying NOT NULL,
+  "password_hash" character varying NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'learner',
+  "status" character varying NOT NULL DEFAULT 'active',
+  "refresh_token_id" character varying NULL,
+  "last_login_at" timestamptz NULL,
+  "failed_login_attempts" bigint NOT NULL DEFAULT 0,
+  "locked_until" timestamptz NULL,
+  "mfa_secret" character varying NULL,
+  "mfa_enabled_at" timestamptz NULL,
+  "mfa_recovery_codes" jsonb NULL,
+  "mfa_last_step" bigint NOT NULL DEFAULT 0,
+  "magic_link_hash" character varying NULL,
+  "external_id" character varying NULL,
+  "metadata" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "users_organizations_users" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "user_organization_id_email" to table: "users"
+CREATE UNIQUE INDEX "user_organization_id_email" ON "users" ("organization_id", "email");
+-- create index "user_organization_id_external_id" to table: "users"
+CREATE UNIQUE INDEX "user_organization_id_external_id" ON "users" ("organization_id", "external_id");
+-- create "enrollments" table
+CREATE TABLE "enrollments" (
+  "id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "progress" real NOT NULL DEFAULT 0,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "metadata" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "course_id" uuid NOT NULL,
+  "group_id" uuid NULL,
+  "organization_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "enrollments_courses_enrollments" FOREIGN KEY ("course_id") REFERENCES "courses" ("id") ON DELETE NO ACTION,
+  CONSTRAINT "enrollments_groups_enrollments" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE SET NULL,
+  CONSTRAINT "enrollments_organizations_enrollments" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION,
+  CONSTRAINT "enrollments_users_enrollments" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- create index "enrollment_organization_id_course_id_user_id" to table: "enrollments"
+CREATE UNIQUE INDEX "enrollment_organization_id_course_id_user_id" ON "enrollments" ("organization_id", "course_id", "user_id");
+-- create index "enrollment_organization_id_status" to table: "enrollments"
+CREATE INDEX "enrollment_organization_id_status" ON "enrollments" ("organization_id", "status");
+-- create "group_members" table
+CREATE TABLE "group_members" (
+  "group_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("group_id", "user_id"),
+  CONSTRAINT "group_members_group_id" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE CASCADE,
+  CONSTRAINT "group_members_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+-- create "modules" table
+CREATE TABLE "modules" (
+  "id" uuid NOT NULL,
+  "content_revision" bigint NULL,
+  "title" character varying NOT NULL,
+  "module_type" character varying NOT NULL,
+  "position" bigint NOT NULL DEFAULT 0,
+  "duration_seconds" bigint NULL,
+  "status" character varying NOT NULL DEFAULT 'active',
+  "data" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "content_id" uuid NULL,
+  "course_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "modules_contents_modules" FOREIGN KEY ("content_id") REFERENCES "contents" ("id") ON DELETE SET NULL,
+  CONSTRAINT "modules_courses_modules" FOREIGN KEY ("course_id") REFERENCES "courses" ("id") ON DELETE NO ACTION
+);
+-- create index "module_course_id_position" to table: "modules"
+CREATE INDEX "module_course_id_position" ON "modules" ("course_id", "position");
+-- create index "module_course_id_status" to table: "modules"
+CREATE INDEX "module_course_id_status" ON "modules" ("course_id", "status");
+-- create "module_progresses" table
+CREATE TABLE "module_progresses" (
+  "id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'not_started',
+  "score" real NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "enrollment_id" uuid NOT NULL,
+  "module_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "module_progresses_enrollments_progress_entries" FOREIGN KEY ("enrollment_id") REFERENCES "enrollments" ("id") ON DELETE NO ACTION,
+  CONSTRAINT "module_progresses_modules_progress_entries" FOREIGN KEY ("module_id") REFERENCES "modules" ("id") ON DELETE NO ACTION
+);
+-- create index "moduleprogress_enrollment_id_module_id" to table: "module_progresses"
+CREATE UNIQUE INDEX "moduleprogress_enrollment_id_module_id" ON "module_progresses" ("enrollment_id", "module_id");
+-- create index "moduleprogress_module_id_status" to table: "module_progresses"
+CREATE INDEX "moduleprogress_module_id_status" ON "module_progresses" ("module_id", "status");
+-- create "privacy_requests" table
+CREATE TABLE "privacy_requests" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "kind" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "mode" character varying NULL,
+  "requested_by" uuid NULL,
+  "scheduled_at" timestamptz NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "cancelled_at" timestamptz NULL,
+  "cancelled_by" uuid NULL,
+  "storage_key" character varying NULL,
+  "size_bytes" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NULL,
+  "summary" jsonb NULL,
+  "error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "privacy_requests_organizations_privacy_requests" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "privacyrequest_organization_id_user_id" to table: "privacy_requests"
+CREATE INDEX "privacyrequest_organization_id_user_id" ON "privacy_requests" ("organization_id", "user_id");
+-- create index "privacyrequest_status_scheduled_at" to table: "privacy_requests"
+CREATE INDEX "privacyrequest_status_scheduled_at" ON "privacy_requests" ("status", "scheduled_at");
+-- create "scim_tokens" table
+CREATE TABLE "scim_tokens" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "last_used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "scim_tokens_organizations_scim_tokens" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION
+);
+-- create index "scim_tokens_token_hash_key" to table: "scim_tokens"
+CREATE UNIQUE INDEX "scim_tokens_token_hash_key" ON "scim_tokens" ("token_hash");
+-- create "webauthn_credentials" table
+CREATE TABLE "webauthn_credentials" (
+  "id" uuid NOT NULL,
+  "credential_id" bytea NOT NULL,
+  "public_key" bytea NOT NULL,
+  "attestation_type" character varying NOT NULL DEFAULT 'none',
+  "aaguid" bytea NULL,
+  "sign_count" integer NOT NULL DEFAULT 0,
+  "transports" jsonb NULL,
+  "backup_eligible" boolean NOT NULL DEFAULT false,
+  "backup_state" boolean NOT NULL DEFAULT false,
+  "name" character varying NOT NULL DEFAULT 'Passkey',
+  "last_used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webauthn_credentials_users_webauthn_credentials" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- create index "webauthn_credentials_credential_id_key" to table: "webauthn_credentials"
+CREATE UNIQUE INDEX "webauthn_credentials_credential_id_key" ON "webauthn_credentials" ("credential_id");
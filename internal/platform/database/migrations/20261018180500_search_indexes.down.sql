@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "search_contents_english";
+DROP INDEX IF EXISTS "search_contents_french";
+DROP INDEX IF EXISTS "search_modules_english";
+DROP INDEX IF EXISTS "search_modules_french";
+DROP INDEX IF EXISTS "search_courses_english";
+DROP INDEX IF EXISTS "search_courses_french";
@@ -0,0 +1,7 @@
+-- Index GIN des expressions tsvector interrogées par la recherche plein texte (internal/search).
+CREATE INDEX IF NOT EXISTS "search_courses_french" ON "courses" USING GIN (((setweight(to_tsvector('french'::regconfig, COALESCE("title", '')), 'A') || setweight(to_tsvector('french'::regconfig, COALESCE("description", '')), 'B'))));
+CREATE INDEX IF NOT EXISTS "search_courses_english" ON "courses" USING GIN (((setweight(to_tsvector('english'::regconfig, COALESCE("title", '')), 'A') || setweight(to_tsvector('english'::regconfig, COALESCE("description", '')), 'B'))));
+CREATE INDEX IF NOT EXISTS "search_modules_french" ON "modules" USING GIN (((setweight(to_tsvector('french'::regconfig, COALESCE("title", '')), 'A') || setweight(to_tsvector('french'::regconfig, COALESCE("data" ->> 'body', '')), 'B'))));
+CREATE INDEX IF NOT EXISTS "search_modules_english" ON "modules" USING GIN (((setweight(to_tsvector('english'::regconfig, COALESCE("title", '')), 'A') || setweight(to_tsvector('english'::regconfig, COALESCE("data" ->> 'body', '')), 'B'))));
+CREATE INDEX IF NOT EXISTS "search_contents_french" ON "contents" USING GIN (((setweight(to_tsvector('french'::regconfig, COALESCE("name", '')), 'A') || setweight(jsonb_to_tsvector('french'::regconfig, COALESCE("metadata", '{}'::jsonb), '["string"]'), 'B'))));
+CREATE INDEX IF NOT EXISTS "search_contents_english" ON "contents" USING GIN (((setweight(to_tsvector('english'::regconfig, COALESCE("name", '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, COALESCE("metadata", '{}'::jsonb), '["string"]'), 'B'))));
@@ -0,0 +1,8 @@
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "version";
+-- reverse: modify "organizations" table
+ALTER TABLE "organizations" DROP COLUMN "version";
+-- reverse: modify "modules" table
+ALTER TABLE "modules" DROP COLUMN "version";
+-- reverse: modify "groups" table
+ALTER TABLE "groups" DROP COLUMN "version";
@@ -0,0 +1,8 @@
+-- modify "groups" table
+ALTER TABLE "groups" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "modules" table
+ALTER TABLE "modules" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "organizations" table
+ALTER TABLE "organizations" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
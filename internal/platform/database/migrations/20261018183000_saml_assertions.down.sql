@@ -0,0 +1,6 @@
+-- reverse: create index "samlassertion_expires_at" to table: "saml_assertions"
+DROP INDEX "samlassertion_expires_at";
+-- reverse: create index "saml_assertions_assertion_id_key" to table: "saml_assertions"
+DROP INDEX "saml_assertions_assertion_id_key";
+-- reverse: create "saml_assertions" table
+DROP TABLE "saml_assertions";
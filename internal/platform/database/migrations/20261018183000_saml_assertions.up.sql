@@ -0,0 +1,12 @@
+-- create "saml_assertions" table
+CREATE TABLE "saml_assertions" (
+  "id" uuid NOT NULL,
+  "assertion_id" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "saml_assertions_assertion_id_key" to table: "saml_assertions"
+CREATE UNIQUE INDEX "saml_assertions_assertion_id_key" ON "saml_assertions" ("assertion_id");
+-- create index "samlassertion_expires_at" to table: "saml_assertions"
+CREATE INDEX "samlassertion_expires_at" ON "saml_assertions" ("expires_at");
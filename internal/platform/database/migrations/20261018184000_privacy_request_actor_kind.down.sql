@@ -0,0 +1,2 @@
+-- reverse: modify "privacy_requests" table
+ALTER TABLE "privacy_requests" DROP COLUMN "cancelled_by_kind", DROP COLUMN "requested_by_kind";
@@ -0,0 +1,5 @@
+-- modify "privacy_requests" table
+ALTER TABLE "privacy_requests" ADD COLUMN "requested_by_kind" character varying NULL, ADD COLUMN "cancelled_by_kind" character varying NULL;
+-- backfill: only users were recorded as actors so far
+UPDATE "privacy_requests" SET "requested_by_kind" = 'user' WHERE "requested_by" IS NOT NULL;
+UPDATE "privacy_requests" SET "cancelled_by_kind" = 'user' WHERE "cancelled_by" IS NOT NULL;
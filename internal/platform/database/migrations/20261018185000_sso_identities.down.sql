@@ -0,0 +1,4 @@
+-- reverse: create index "ssoidentity_organization_id_issuer_subject" to table: "sso_identities"
+DROP INDEX "ssoidentity_organization_id_issuer_subject";
+-- reverse: create "sso_identities" table
+DROP TABLE "sso_identities";
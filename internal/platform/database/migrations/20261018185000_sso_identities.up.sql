@@ -0,0 +1,23 @@
+-- create "sso_identities" table
+CREATE TABLE "sso_identities" (
+  "id" uuid NOT NULL,
+  "organization_id" uuid NOT NULL,
+  "protocol" character varying NOT NULL,
+  "issuer" character varying NOT NULL,
+  "subject" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sso_identities_users_sso_identities" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- create index "ssoidentity_organization_id_issuer_subject" to table: "sso_identities"
+CREATE UNIQUE INDEX "ssoidentity_organization_id_issuer_subject" ON "sso_identities" ("organization_id", "issuer", "subject");
+-- backfill: accounts already provisioned by SSO keep the identity recorded in their metadata
+INSERT INTO "sso_identities" ("id", "organization_id", "protocol", "issuer", "subject", "created_at", "user_id")
+SELECT DISTINCT ON ("organization_id", "metadata"->'sso'->>'issuer', "metadata"->'sso'->>'subject')
+  gen_random_uuid(), "organization_id", "metadata"->'sso'->>'protocol', "metadata"->'sso'->>'issuer', "metadata"->'sso'->>'subject', now(), "id"
+FROM "users"
+WHERE coalesce("metadata"->'sso'->>'protocol', '') <> ''
+  AND coalesce("metadata"->'sso'->>'issuer', '') <> ''
+  AND coalesce("metadata"->'sso'->>'subject', '') <> ''
+ORDER BY "organization_id", "metadata"->'sso'->>'issuer', "metadata"->'sso'->>'subject', "created_at";
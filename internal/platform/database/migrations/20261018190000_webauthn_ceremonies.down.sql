@@ -0,0 +1,6 @@
+-- reverse: create index "webauthnceremony_expires_at" to table: "webauthn_ceremonies"
+DROP INDEX "webauthnceremony_expires_at";
+-- reverse: create index "webauthn_ceremonies_session_id_key" to table: "webauthn_ceremonies"
+DROP INDEX "webauthn_ceremonies_session_id_key";
+-- reverse: create "webauthn_ceremonies" table
+DROP TABLE "webauthn_ceremonies";
@@ -0,0 +1,12 @@
+-- create "webauthn_ceremonies" table
+CREATE TABLE "webauthn_ceremonies" (
+  "id" uuid NOT NULL,
+  "session_id" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "webauthn_ceremonies_session_id_key" to table: "webauthn_ceremonies"
+CREATE UNIQUE INDEX "webauthn_ceremonies_session_id_key" ON "webauthn_ceremonies" ("session_id");
+-- create index "webauthnceremony_expires_at" to table: "webauthn_ceremonies"
+CREATE INDEX "webauthnceremony_expires_at" ON "webauthn_ceremonies" ("expires_at");
@@ -0,0 +1,116 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/ent/idempotencykey"
+)
+
+// DBStore conserve les réponses dans la table idempotency_keys, partagée entre instances.
+type DBStore struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// NewDBStore crée un store adossé à la base.
+func NewDBStore(client *ent.Client) *DBStore {
+	return &DBStore{client: client, now: time.Now}
+}
+
+func (s *DBStore) withNow(now func() time.Time) {
+	if now != nil {
+		s.now = now
+	}
+}
+
+// Begin réserve la clé ou renvoie la réponse enregistrée. La contrainte d'unicité sur key
+// départage deux requêtes concurrentes.
+func (s *DBStore) Begin(ctx context.Context, key, fingerprint string) (*Response, error) {
+	now := s.now()
+	if _, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.KeyEQ(key), idempotencykey.ExpiresAtLTE(now)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("idempotency: purge key: %w", err)
+	}
+	err := s.client.IdempotencyKey.Create().
+		SetKey(key).
+		SetFingerprint(fingerprint).
+		SetCreatedAt(now).
+		SetExpiresAt(now.Add(PendingTimeout)).
+		Exec(ctx)
+	if err == nil {
+		return nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("idempotency: reserve key: %w", err)
+	}
+
+	existing, err := s.client.IdempotencyKey.Query().
+		Where(idempotencykey.KeyEQ(key)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: load key: %w", err)
+	}
+	switch {
+	case existing.Fingerprint != fingerprint:
+		return nil, ErrMismatch
+	case existing.StatusCode == 0:
+		return nil, ErrInProgress
+	}
+	return &Response{
+		StatusCode: existing.StatusCode,
+		Header:     http.Header(existing.Header),
+		Body:       existing.Body,
+	}, nil
+}
+
+// Renew prolonge la réservation en cours de la clé.
+func (s *DBStore) Renew(ctx context.Context, key string) error {
+	if err := s.client.IdempotencyKey.Update().
+		Where(idempotencykey.KeyEQ(key), idempotencykey.StatusCodeEQ(0)).
+		SetExpiresAt(s.now().Add(PendingTimeout)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("idempotency: renew key: %w", err)
+	}
+	return nil
+}
+
+// Complete enregistre la réponse de la clé réservée.
+func (s *DBStore) Complete(ctx context.Context, key string, resp Response, ttl time.Duration) error {
+	err := s.client.IdempotencyKey.Update().
+		Where(idempotencykey.KeyEQ(key), idempotencykey.StatusCodeEQ(0)).
+		SetStatusCode(resp.StatusCode).
+		SetHeader(resp.Header).
+		SetBody(resp.Body).
+		SetExpiresAt(s.now().Add(ttl)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("idempotency: store response: %w", err)
+	}
+	return nil
+}
+
+// Release libère une réservation en cours.
+func (s *DBStore) Release(ctx context.Context, key string) error {
+	if _, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.KeyEQ(key), idempotencykey.StatusCodeEQ(0)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("idempotency: release key: %w", err)
+	}
+	return nil
+}
+
+// Purge supprime les clés expirées et renvoie leur nombre.
+func (s *DBStore) Purge(ctx context.Context) (int, error) {
+	n, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLTE(s.now())).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("idempotency: purge: %w", err)
+	}
+	return n, nil
+}
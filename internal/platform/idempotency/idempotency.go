@@ -0,0 +1,43 @@
+// Package idempotency conserve les réponses des requêtes rejouables (entête Idempotency-Key) afin
+// qu'une nouvelle tentative du client renvoie la réponse d'origine sans réexécuter l'opération.
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrMismatch signale une clé déjà utilisée pour une requête différente.
+	ErrMismatch = errors.New("idempotency: key reused with a different request")
+	// ErrInProgress signale une clé dont la première requête n'est pas terminée.
+	ErrInProgress = errors.New("idempotency: request in progress")
+)
+
+// PendingTimeout est la durée d'une réservation. Elle est renouvelée tant que la première
+// requête s'exécute ; une réservation qui n'est plus renouvelée (arrêt du processus) expire et
+// une nouvelle tentative est alors exécutée.
+const PendingTimeout = time.Minute
+
+// Response est une réponse enregistrée, rejouée à l'identique.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Store conserve les réponses par clé.
+//
+// Begin réserve la clé pour la requête d'empreinte fingerprint et renvoie nil, nil si elle doit
+// être exécutée. Une clé connue renvoie la réponse enregistrée, ErrInProgress tant que la
+// première requête est en cours, ou ErrMismatch si l'empreinte diffère. Renew prolonge de
+// PendingTimeout une réservation en cours. Complete enregistre la réponse pour ttl ; Release
+// libère une réservation sans réponse à conserver.
+type Store interface {
+	Begin(ctx context.Context, key, fingerprint string) (*Response, error)
+	Renew(ctx context.Context, key string) error
+	Complete(ctx context.Context, key string, resp Response, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
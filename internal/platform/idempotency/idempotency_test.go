@@ -0,0 +1,104 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/ent"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time          { return c.now }
+func (c *clock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func exerciseStore(t *testing.T, store Store, c *clock) {
+	t.Helper()
+	ctx := context.Background()
+
+	resp, err := store.Begin(ctx, "user:1:a", "f1")
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	_, err = store.Begin(ctx, "user:1:a", "f1")
+	require.ErrorIs(t, err, ErrInProgress)
+	_, err = store.Begin(ctx, "user:1:a", "f2")
+	require.ErrorIs(t, err, ErrMismatch)
+
+	created := Response{
+		StatusCode: http.StatusCreated,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{"id":1}`),
+	}
+	require.NoError(t, store.Complete(ctx, "user:1:a", created, 24*time.Hour))
+
+	resp, err = store.Begin(ctx, "user:1:a", "f1")
+	require.NoError(t, err)
+	require.Equal(t, &created, resp)
+	_, err = store.Begin(ctx, "user:1:a", "f2")
+	require.ErrorIs(t, err, ErrMismatch)
+
+	// Une clé relâchée peut être réutilisée, y compris pour une autre requête.
+	_, err = store.Begin(ctx, "user:1:b", "f1")
+	require.NoError(t, err)
+	require.NoError(t, store.Release(ctx, "user:1:b"))
+	resp, err = store.Begin(ctx, "user:1:b", "f2")
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	// Une réservation renouvelée reste acquise au-delà de PendingTimeout ; abandonnée, elle
+	// expire après PendingTimeout, une réponse après son ttl.
+	c.Advance(PendingTimeout / 2)
+	require.NoError(t, store.Renew(ctx, "user:1:b"))
+	c.Advance(PendingTimeout / 2)
+	_, err = store.Begin(ctx, "user:1:b", "f2")
+	require.ErrorIs(t, err, ErrInProgress)
+	c.Advance(PendingTimeout / 2)
+	resp, err = store.Begin(ctx, "user:1:b", "f3")
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	c.Advance(24 * time.Hour)
+	resp, err = store.Begin(ctx, "user:1:a", "f2")
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func TestMemoryStore(t *testing.T) {
+	c := &clock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.withNow(c.Now)
+	exerciseStore(t, store, c)
+}
+
+func TestDBStore(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:idempotency?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background()))
+
+	c := &clock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewDBStore(client)
+	store.withNow(c.Now)
+	exerciseStore(t, store, c)
+
+	c.Advance(PendingTimeout)
+	purged, err := store.Purge(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+	require.Zero(t, client.IdempotencyKey.Query().CountX(context.Background()))
+}
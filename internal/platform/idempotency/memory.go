@@ -0,0 +1,109 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	fingerprint string
+	response    *Response
+	expiresAt   time.Time
+}
+
+// MemoryStore conserve les réponses en mémoire ; adapté à une instance unique et aux tests.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	now     func() time.Time
+	sweepAt time.Time
+}
+
+// NewMemoryStore crée un store en mémoire.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: map[string]*entry{},
+		now:     time.Now,
+	}
+}
+
+func (m *MemoryStore) withNow(now func() time.Time) {
+	if now != nil {
+		m.now = now
+	}
+}
+
+// Begin réserve la clé ou renvoie la réponse enregistrée.
+func (m *MemoryStore) Begin(_ context.Context, key, fingerprint string) (*Response, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	if e, ok := m.entries[key]; ok && now.Before(e.expiresAt) {
+		return e.replay(fingerprint)
+	}
+	m.entries[key] = &entry{fingerprint: fingerprint, expiresAt: now.Add(PendingTimeout)}
+	return nil, nil
+}
+
+// Renew prolonge la réservation en cours de la clé.
+func (m *MemoryStore) Renew(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok && e.response == nil {
+		e.expiresAt = m.now().Add(PendingTimeout)
+	}
+	return nil
+}
+
+// Complete enregistre la réponse de la clé réservée.
+func (m *MemoryStore) Complete(_ context.Context, key string, resp Response, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok {
+		e.response = &resp
+		e.expiresAt = m.now().Add(ttl)
+	}
+	return nil
+}
+
+// Release libère une réservation en cours.
+func (m *MemoryStore) Release(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok && e.response == nil {
+		delete(m.entries, key)
+	}
+	return nil
+}
+
+func (e *entry) replay(fingerprint string) (*Response, error) {
+	switch {
+	case e.fingerprint != fingerprint:
+		return nil, ErrMismatch
+	case e.response == nil:
+		return nil, ErrInProgress
+	}
+	resp := *e.response
+	resp.Header = resp.Header.Clone()
+	return &resp, nil
+}
+
+// sweep supprime périodiquement les entrées expirées pour borner la mémoire.
+func (m *MemoryStore) sweep(now time.Time) {
+	if now.Before(m.sweepAt) {
+		return
+	}
+	m.sweepAt = now.Add(time.Minute)
+	for key, e := range m.entries {
+		if !now.Before(e.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+type contextKey string
+
+const fieldsKey contextKey = "logging:fields"
+
+// Fields porte les identifiants de corrélation d'une requête. Il est créé en tête de chaîne
+// HTTP puis complété par les middlewares suivants (tenant, identité), si bien que toutes les
+// lignes émises avec le contexte de la requête partagent les mêmes valeurs.
+type Fields struct {
+	mu        sync.RWMutex
+	requestID string
+	orgID     string
+	userID    string
+	serviceID string
+	route     string
+	routeFunc func() string
+}
+
+// WithFields attache un nouveau porteur de champs au contexte.
+func WithFields(ctx context.Context, requestID string) (context.Context, *Fields) {
+	fields := &Fields{requestID: requestID}
+	return context.WithValue(ctx, fieldsKey, fields), fields
+}
+
+// FieldsFromContext renvoie le porteur de champs du contexte, ou nil s'il est absent.
+func FieldsFromContext(ctx context.Context) *Fields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey).(*Fields)
+	return fields
+}
+
+// SetOrganization renseigne l'organisation courante si un porteur est présent dans le contexte.
+func SetOrganization(ctx context.Context, orgID string) {
+	if fields := FieldsFromContext(ctx); fields != nil {
+		fields.mu.Lock()
+		fields.orgID = orgID
+		fields.mu.Unlock()
+	}
+}
+
+// SetUser renseigne l'utilisateur authentifié si un porteur est présent dans le contexte.
+func SetUser(ctx context.Context, userID string) {
+	if fields := FieldsFromContext(ctx); fields != nil {
+		fields.mu.Lock()
+		fields.userID = userID
+		fields.mu.Unlock()
+	}
+}
+
+// SetServiceAccount renseigne le compte de service authentifié par clé d'API.
+func SetServiceAccount(ctx context.Context, accountID string) {
+	if fields := FieldsFromContext(ctx); fields != nil {
+		fields.mu.Lock()
+		fields.serviceID = accountID
+		fields.mu.Unlock()
+	}
+}
+
+// SetRoute fixe la route ; resolve est consulté tant que la route n'est pas figée.
+func (f *Fields) SetRoute(route string, resolve func() string) {
+	f.mu.Lock()
+	f.route = route
+	f.routeFunc = resolve
+	f.mu.Unlock()
+}
+
+// OrganizationID renvoie l'organisation enregistrée.
+func (f *Fields) OrganizationID() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.orgID
+}
+
+func (f *Fields) attrs() []slog.Attr {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	route := f.route
+	if route == "" && f.routeFunc != nil {
+		route = f.routeFunc()
+	}
+
+	attrs := make([]slog.Attr, 0, 5)
+	if f.requestID != "" {
+		attrs = append(attrs, slog.String("request_id", f.requestID))
+	}
+	if f.orgID != "" {
+		attrs = append(attrs, slog.String("org_id", f.orgID))
+	}
+	if f.userID != "" {
+		attrs = append(attrs, slog.String("user_id", f.userID))
+	}
+	if f.serviceID != "" {
+		attrs = append(attrs, slog.String("service_account_id", f.serviceID))
+	}
+	if route != "" {
+		attrs = append(attrs, slog.String("route", route))
+	}
+	return attrs
+}
@@ -0,0 +1,46 @@
+package logging
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+type levelPayload struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler expose le niveau courant (GET) et permet de le modifier sans redémarrage (PUT).
+func LevelHandler(level *slog.LevelVar) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req levelPayload
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeLevelError(w, http.StatusBadRequest, "payload invalide")
+				return
+			}
+			parsed, err := ParseLevel(req.Level)
+			if err != nil {
+				writeLevelError(w, http.StatusBadRequest, "niveau invalide")
+				return
+			}
+			previous := level.Level()
+			level.Set(parsed)
+			slog.InfoContext(r.Context(), "log level changed", "from", previous.String(), "to", parsed.String())
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			writeLevelError(w, http.StatusMethodNotAllowed, "méthode non supportée")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelPayload{Level: level.Level().String()})
+	})
+}
+
+func writeLevelError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
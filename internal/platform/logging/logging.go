@@ -0,0 +1,77 @@
+// Package logging configure le logger structuré (log/slog) partagé par les binaires.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Config regroupe les options du logger.
+type Config struct {
+	Level  string
+	Output io.Writer
+	// SensitiveKeys complète la liste des clés d'attribut toujours masquées (mot de passe,
+	// jetons, secrets), par exemple avec les champs sensibles des schémas.
+	SensitiveKeys []string
+}
+
+// New construit un logger JSON dont le niveau reste modifiable à chaud via le LevelVar retourné.
+// Les attributs sensibles sont masqués et les champs de corrélation du contexte sont ajoutés à chaque ligne.
+func New(cfg Config) (*slog.Logger, *slog.LevelVar, error) {
+	level := new(slog.LevelVar)
+	if cfg.Level != "" {
+		parsed, err := ParseLevel(cfg.Level)
+		if err != nil {
+			return nil, nil, err
+		}
+		level.Set(parsed)
+	}
+
+	out := cfg.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	handler := slog.NewJSONHandler(out, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: newRedactor(cfg.SensitiveKeys).redactAttr,
+	})
+	return slog.New(&contextHandler{next: handler}), level, nil
+}
+
+// ParseLevel convertit un niveau textuel (debug, info, warn, error) en slog.Level.
+func ParseLevel(raw string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(raw))); err != nil {
+		return 0, fmt.Errorf("logging: invalid level %q", raw)
+	}
+	return level, nil
+}
+
+// contextHandler enrichit chaque enregistrement avec les champs de requête portés par le contexte.
+type contextHandler struct {
+	next slog.Handler
+}
+
+func (h *contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if fields := FieldsFromContext(ctx); fields != nil {
+		record.AddAttrs(fields.attrs()...)
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,98 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLine(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	buf.Reset()
+	return line
+}
+
+func TestLogger_RedactsSensitiveAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _, err := New(Config{Output: &buf, SensitiveKeys: []string{"email", "Password_Hash"}})
+	require.NoError(t, err)
+
+	logger.Info("login",
+		"password", "supersecret",
+		"email", "user@example.com",
+		"password_hash", "$2a$10$abc",
+		"header", "Bearer abc.def.ghi",
+		"payload", map[string]any{
+			"refresh_token": "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig",
+			"nested":        map[string]any{"secret": "s3cr3t", "name": "ok"},
+		},
+		"status", "active",
+	)
+
+	line := decodeLine(t, &buf)
+	require.Equal(t, redacted, line["password"])
+	require.Equal(t, redacted, line["email"])
+	require.Equal(t, redacted, line["password_hash"])
+	require.Equal(t, redacted, line["header"])
+	require.Equal(t, "active", line["status"])
+
+	payload := line["payload"].(map[string]any)
+	require.Equal(t, redacted, payload["refresh_token"])
+	nested := payload["nested"].(map[string]any)
+	require.Equal(t, redacted, nested["secret"])
+	require.Equal(t, "ok", nested["name"])
+}
+
+func TestLogger_SensitiveKeys(t *testing.T) {
+	r := newRedactor([]string{"refresh_token_id", "Password_Hash"})
+	require.True(t, r.isSensitive("refresh_token_id"))
+	require.True(t, r.isSensitive("password_hash"))
+	require.True(t, r.isSensitive("token"))
+	require.False(t, r.isSensitive("body"))
+	require.False(t, r.isSensitive("role"))
+	require.False(t, newRedactor(nil).isSensitive("email"))
+}
+
+func TestLogger_ContextFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _, err := New(Config{Output: &buf})
+	require.NoError(t, err)
+
+	ctx, fields := WithFields(context.Background(), "req-1")
+	fields.SetRoute("", func() string { return "/courses/{id}" })
+	SetOrganization(ctx, "org-1")
+	SetUser(ctx, "user-1")
+
+	logger.InfoContext(ctx, "hello")
+	line := decodeLine(t, &buf)
+	require.Equal(t, "req-1", line["request_id"])
+	require.Equal(t, "org-1", line["org_id"])
+	require.Equal(t, "user-1", line["user_id"])
+	require.Equal(t, "/courses/{id}", line["route"])
+
+	logger.Info("no context")
+	line = decodeLine(t, &buf)
+	require.NotContains(t, line, "request_id")
+}
+
+func TestLogger_RuntimeLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, level, err := New(Config{Output: &buf, Level: "warn"})
+	require.NoError(t, err)
+
+	logger.Info("hidden")
+	require.Zero(t, buf.Len())
+
+	level.Set(slog.LevelDebug)
+	logger.Debug("visible")
+	require.NotZero(t, buf.Len())
+
+	_, _, err = New(Config{Level: "verbose"})
+	require.Error(t, err)
+}
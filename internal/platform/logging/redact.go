@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"log/slog"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// baseSensitiveKeys liste les clés toujours masquées ; Config.SensitiveKeys les complète.
+var baseSensitiveKeys = []string{
+	"password",
+	"new_password",
+	"token",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"authorization",
+	"cookie",
+	"set-cookie",
+	"secret",
+	"client_secret",
+	"api_key",
+}
+
+// redactor masque les attributs dont la clé est sensible.
+type redactor struct {
+	keys map[string]struct{}
+}
+
+func newRedactor(extra []string) *redactor {
+	keys := make(map[string]struct{}, len(baseSensitiveKeys)+len(extra))
+	for _, list := range [][]string{baseSensitiveKeys, extra} {
+		for _, k := range list {
+			keys[strings.ToLower(strings.TrimSpace(k))] = struct{}{}
+		}
+	}
+	return &redactor{keys: keys}
+}
+
+// isSensitive indique si une clé d'attribut doit être masquée.
+func (r *redactor) isSensitive(key string) bool {
+	_, ok := r.keys[strings.ToLower(strings.TrimSpace(key))]
+	return ok
+}
+
+func (r *redactor) redactAttr(_ []string, attr slog.Attr) slog.Attr {
+	if r.isSensitive(attr.Key) {
+		return slog.String(attr.Key, redacted)
+	}
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		if looksLikeToken(attr.Value.String()) {
+			return slog.String(attr.Key, redacted)
+		}
+	case slog.KindAny:
+		if m, ok := attr.Value.Any().(map[string]any); ok {
+			return slog.Any(attr.Key, r.redactMap(m))
+		}
+	}
+	return attr
+}
+
+func (r *redactor) redactMap(in map[string]any) map[string]any {
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		switch {
+		case r.isSensitive(k):
+			out[k] = redacted
+		default:
+			switch typed := v.(type) {
+			case map[string]any:
+				out[k] = r.redactMap(typed)
+			case string:
+				if looksLikeToken(typed) {
+					out[k] = redacted
+				} else {
+					out[k] = typed
+				}
+			default:
+				out[k] = v
+			}
+		}
+	}
+	return out
+}
+
+// looksLikeToken détecte les JWT et entêtes Bearer glissés dans des valeurs libres.
+func looksLikeToken(value string) bool {
+	v := strings.TrimSpace(value)
+	if len(v) >= 7 && strings.EqualFold(v[:7], "bearer ") {
+		return true
+	}
+	return strings.HasPrefix(v, "eyJ") && strings.Count(v, ".") == 2
+}
@@ -0,0 +1,64 @@
+// Package mail définit l'envoi d'emails transactionnels et ses implémentations (SMTP, journal, mémoire).
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Message est un email transactionnel. HTML est optionnel.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender envoie un message.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogSender journalise les messages au lieu de les envoyer (développement, SMTP non configuré).
+// Le corps, susceptible de contenir des liens de connexion, n'est journalisé qu'au niveau debug.
+type LogSender struct {
+	Logger *slog.Logger
+}
+
+// Send implémente Sender.
+func (s LogSender) Send(ctx context.Context, msg Message) error {
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.InfoContext(ctx, "mail not sent (no smtp configured)", "to", msg.To, "subject", msg.Subject)
+	logger.DebugContext(ctx, "mail body", "to", msg.To, "text", msg.Text)
+	return nil
+}
+
+// MemorySender conserve les messages envoyés (tests).
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewMemorySender crée un MemorySender vide.
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send implémente Sender.
+func (s *MemorySender) Send(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// Messages renvoie une copie des messages envoyés.
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	var (
+		gotAddr string
+		gotFrom string
+		gotTo   []string
+		gotBody string
+		gotAuth smtp.Auth
+	)
+	sender := NewSMTPSender(SMTPConfig{
+		Addr:     "smtp.example.com:587",
+		From:     "LMS <no-reply@example.com>",
+		Username: "user",
+		Password: "pass",
+	})
+	sender.now = func() time.Time { return time.Date(2024, 10, 6, 12, 0, 0, 0, time.UTC) }
+	sender.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotAuth, gotFrom, gotTo, gotBody = addr, a, from, to, string(msg)
+		return nil
+	}
+
+	err := sender.Send(context.Background(), Message{
+		To:      "ada@example.com",
+		Subject: "Votre accès",
+		Text:    "Bonjour,\nCliquez ici.",
+		HTML:    "<p>Bonjour</p>",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com:587", gotAddr)
+	require.NotNil(t, gotAuth)
+	require.Equal(t, "no-reply@example.com", gotFrom)
+	require.Equal(t, []string{"ada@example.com"}, gotTo)
+	require.Contains(t, gotBody, "Subject: =?utf-8?q?Votre_acc=C3=A8s?=\r\n")
+	require.Contains(t, gotBody, "Content-Type: multipart/alternative; boundary=")
+	require.Contains(t, gotBody, "Bonjour,\r\nCliquez ici.")
+	require.Contains(t, gotBody, "<p>Bonjour</p>")
+
+	err = sender.Send(context.Background(), Message{To: "not an address"})
+	require.Error(t, err)
+}
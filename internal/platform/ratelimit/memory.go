@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	rule   Rule
+}
+
+// MemoryLimiter conserve les seaux en mémoire ; adapté à une instance unique et aux tests.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+	sweepAt time.Time
+}
+
+// NewMemoryLimiter crée un limiteur en mémoire.
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (m *MemoryLimiter) withNow(now func() time.Time) {
+	if now != nil {
+		m.now = now
+	}
+}
+
+// Allow consomme un jeton pour la clé.
+func (m *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (Decision, error) {
+	if !rule.Enabled() {
+		return Decision{}, ErrInvalidRule
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rule.capacity(), last: now, rule: rule}
+		m.buckets[key] = b
+	}
+	tokens, decision := refill(b.tokens, b.last, now, rule)
+	b.tokens = tokens
+	b.last = now
+	b.rule = rule
+	return decision, nil
+}
+
+// sweep supprime périodiquement les seaux pleins pour borner la mémoire.
+func (m *MemoryLimiter) sweep(now time.Time) {
+	if now.Before(m.sweepAt) {
+		return
+	}
+	m.sweepAt = now.Add(time.Minute)
+	for key, b := range m.buckets {
+		full := b.rule.capacity() / b.rule.ratePerSecond()
+		if now.Sub(b.last).Seconds() >= full {
+			delete(m.buckets, key)
+		}
+	}
+}
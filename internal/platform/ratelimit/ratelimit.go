@@ -0,0 +1,81 @@
+// Package ratelimit fournit un limiteur à seau de jetons (token bucket) avec des backends interchangeables.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("ratelimit: invalid rule")
+
+// Rule décrit un seau : Burst jetons au maximum, rechargés à raison de Limit jetons par Period.
+type Rule struct {
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// PerMinute construit une règle de n requêtes par minute avec la capacité de rafale donnée.
+func PerMinute(n, burst int) Rule {
+	return Rule{Limit: n, Period: time.Minute, Burst: burst}
+}
+
+// PerHour construit une règle de n requêtes par heure avec la capacité de rafale donnée.
+func PerHour(n, burst int) Rule {
+	return Rule{Limit: n, Period: time.Hour, Burst: burst}
+}
+
+// Enabled indique si la règle limite effectivement le trafic.
+func (r Rule) Enabled() bool {
+	return r.Limit > 0 && r.Period > 0
+}
+
+func (r Rule) capacity() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+	return float64(r.Limit)
+}
+
+// ratePerSecond renvoie le débit de recharge en jetons par seconde.
+func (r Rule) ratePerSecond() float64 {
+	return float64(r.Limit) / r.Period.Seconds()
+}
+
+// Decision est le résultat d'une consommation de jeton.
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Limiter consomme un jeton pour la clé donnée selon la règle.
+type Limiter interface {
+	Allow(ctx context.Context, key string, rule Rule) (Decision, error)
+}
+
+// refill calcule l'état du seau après recharge puis tente de consommer un jeton.
+// Partagé entre backends pour garantir un comportement identique.
+func refill(tokens float64, last, now time.Time, rule Rule) (float64, Decision) {
+	capacity := rule.capacity()
+	rate := rule.ratePerSecond()
+
+	if elapsed := now.Sub(last).Seconds(); elapsed > 0 {
+		tokens = math.Min(capacity, tokens+elapsed*rate)
+	}
+
+	decision := Decision{Limit: int(capacity)}
+	if tokens >= 1 {
+		tokens--
+		decision.Allowed = true
+		decision.Remaining = int(math.Floor(tokens))
+		return tokens, decision
+	}
+
+	missing := 1 - tokens
+	decision.RetryAfter = time.Duration(math.Ceil(missing/rate*1000)) * time.Millisecond
+	return tokens, decision
+}
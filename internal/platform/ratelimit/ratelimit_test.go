@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct {
+	now time.Time
+	// onAdvance propage l'heure à un backend doté de sa propre horloge (Redis).
+	onAdvance func(time.Time)
+}
+
+func (c *clock) Now() time.Time { return c.now }
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	if c.onAdvance != nil {
+		c.onAdvance(c.now)
+	}
+}
+
+func exerciseLimiter(t *testing.T, limiter Limiter, c *clock) {
+	t.Helper()
+	ctx := context.Background()
+	rule := PerMinute(60, 3)
+
+	for i := 0; i < 3; i++ {
+		d, err := limiter.Allow(ctx, "k", rule)
+		require.NoError(t, err)
+		require.True(t, d.Allowed, "request %d should pass", i)
+		require.Equal(t, 3, d.Limit)
+		require.Equal(t, 2-i, d.Remaining)
+	}
+
+	d, err := limiter.Allow(ctx, "k", rule)
+	require.NoError(t, err)
+	require.False(t, d.Allowed)
+	require.Equal(t, time.Second, d.RetryAfter)
+
+	// Une autre clé dispose de son propre seau.
+	d, err = limiter.Allow(ctx, "other", rule)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+
+	c.Advance(time.Second)
+	d, err = limiter.Allow(ctx, "k", rule)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+
+	_, err = limiter.Allow(ctx, "k", Rule{})
+	require.ErrorIs(t, err, ErrInvalidRule)
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	c := &clock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := NewMemoryLimiter()
+	limiter.withNow(c.Now)
+	exerciseLimiter(t, limiter, c)
+}
+
+func TestMemoryLimiter_SweepsIdleBuckets(t *testing.T) {
+	c := &clock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := NewMemoryLimiter()
+	limiter.withNow(c.Now)
+
+	_, err := limiter.Allow(context.Background(), "idle", PerMinute(60, 3))
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+
+	c.Advance(2 * time.Minute)
+	_, err = limiter.Allow(context.Background(), "fresh", PerMinute(60, 3))
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+	require.Contains(t, limiter.buckets, "fresh")
+}
+
+func TestRedisLimiter(t *testing.T) {
+	srv := miniredis.RunT(t)
+	// Les seaux suivent l'horloge du serveur Redis, pas celle de l'instance.
+	c := &clock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), onAdvance: srv.SetTime}
+	srv.SetTime(c.now)
+
+	limiter, err := NewRedisLimiter(context.Background(), RedisConfig{Addr: srv.Addr()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = limiter.Close() })
+
+	exerciseLimiter(t, limiter, c)
+	require.True(t, srv.Exists("lms:ratelimit:k"))
+}
+
+func TestNewRedisLimiter_RequiresAddr(t *testing.T) {
+	_, err := NewRedisLimiter(context.Background(), RedisConfig{})
+	require.Error(t, err)
+}
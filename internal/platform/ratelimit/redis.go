@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript applique la recharge et la consommation de façon atomique côté Redis.
+// KEYS[1] = clé du seau ; ARGV = capacité, jetons/ms, TTL (ms). L'horloge est celle du serveur
+// Redis (TIME), commune à toutes les instances quel que soit leur décalage.
+// Retourne {autorisé, jetons restants (arrondi inférieur), attente (ms)}.
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local ttl = tonumber(ARGV[3])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil then
+  tokens = capacity
+  ts = now
+end
+
+local elapsed = now - ts
+if elapsed > 0 then
+  tokens = math.min(capacity, tokens + elapsed * rate)
+end
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], ttl)
+return {allowed, math.floor(tokens), wait}
+`)
+
+// RedisLimiter partage les seaux entre instances via Redis.
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// RedisConfig configure la connexion Redis du limiteur.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+	Prefix   string
+}
+
+// NewRedisLimiter crée un limiteur Redis et vérifie la connexion.
+func NewRedisLimiter(ctx context.Context, cfg RedisConfig) (*RedisLimiter, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("ratelimit: redis address required")
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("ratelimit: redis ping: %w", err)
+	}
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = "lms:ratelimit:"
+	}
+	return &RedisLimiter{client: client, prefix: prefix}, nil
+}
+
+// Close ferme la connexion Redis.
+func (l *RedisLimiter) Close() error {
+	return l.client.Close()
+}
+
+// Allow consomme un jeton pour la clé.
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (Decision, error) {
+	if !rule.Enabled() {
+		return Decision{}, ErrInvalidRule
+	}
+	capacity := rule.capacity()
+	ratePerMs := rule.ratePerSecond() / 1000
+	ttl := int64(math.Ceil(capacity/ratePerMs)) + 1000
+
+	res, err := tokenBucketScript.Run(ctx, l.client, []string{l.prefix + key},
+		capacity, ratePerMs, ttl,
+	).Int64Slice()
+	if err != nil {
+		return Decision{}, fmt.Errorf("ratelimit: redis script: %w", err)
+	}
+	if len(res) != 3 {
+		return Decision{}, fmt.Errorf("ratelimit: unexpected redis reply")
+	}
+	return Decision{
+		Allowed:    res[0] == 1,
+		Limit:      int(capacity),
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
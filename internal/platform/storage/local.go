@@ -0,0 +1,337 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidObject signale une clé d'objet hors de la racine (chemin absolu, « .. »).
+	ErrInvalidObject = errors.New("storage: invalid object key")
+	// ErrInvalidSignature signale une URL signée altérée ou incomplète.
+	ErrInvalidSignature = errors.New("storage: invalid signature")
+	// ErrURLExpired signale une URL signée dont l'échéance est dépassée.
+	ErrURLExpired = errors.New("storage: signed url expired")
+	// ErrSizeMismatch signale un dépôt dont la taille diffère de celle signée.
+	ErrSizeMismatch = errors.New("storage: size does not match signed length")
+)
+
+// LocalConfig paramètre le stockage sur disque.
+type LocalConfig struct {
+	// Root est le répertoire racine des objets ; il est créé au besoin.
+	Root string
+	// BaseURL est l'URL publique sous laquelle l'API sert les objets (ex. https://lms.example.com/storage).
+	BaseURL string
+	// Secret signe les URL de dépôt et de téléchargement (HMAC-SHA256).
+	Secret []byte
+}
+
+// Local stocke les objets sur le système de fichiers. Les URL pré-signées pointent vers l'API,
+// qui vérifie la signature HMAC et l'échéance avant de servir ou d'écrire l'objet.
+type Local struct {
+	root    *os.Root
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// Grant décrit l'opération autorisée par une URL signée.
+type Grant struct {
+	Method      string
+	Object      string
+	Size        int64
+	ContentType string
+	ExpiresAt   time.Time
+	// UploadID et Part sont renseignés pour le dépôt d'une partie multipart.
+	UploadID string
+	Part     int
+}
+
+// NewLocal ouvre (ou crée) la racine de stockage.
+func NewLocal(cfg LocalConfig) (*Local, error) {
+	if strings.TrimSpace(cfg.Root) == "" {
+		return nil, fmt.Errorf("storage: local root required")
+	}
+	if len(cfg.Secret) == 0 {
+		return nil, fmt.Errorf("storage: signing secret required")
+	}
+	if err := os.MkdirAll(cfg.Root, 0o750); err != nil {
+		return nil, fmt.Errorf("storage: create local root: %w", err)
+	}
+	root, err := os.OpenRoot(cfg.Root)
+	if err != nil {
+		return nil, fmt.Errorf("storage: open local root: %w", err)
+	}
+	return &Local{
+		root:    root,
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		secret:  cfg.Secret,
+		now:     time.Now,
+	}, nil
+}
+
+// Close libère le descripteur de la racine.
+func (l *Local) Close() error {
+	return l.root.Close()
+}
+
+// PresignUpload renvoie une URL PUT signée. Lorsque size est positif, le dépôt doit avoir exactement cette taille.
+func (l *Local) PresignUpload(_ context.Context, object string, contentType string, size int64, expires time.Duration) (string, error) {
+	return l.sign(http.MethodPut, object, contentType, size, expires, "", 0)
+}
+
+// PresignDownload renvoie une URL GET signée.
+func (l *Local) PresignDownload(_ context.Context, object string, expires time.Duration) (string, error) {
+	return l.sign(http.MethodGet, object, "", 0, expires, "", 0)
+}
+
+func (l *Local) sign(method, object, contentType string, size int64, expires time.Duration, uploadID string, part int) (string, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return "", err
+	}
+	if size < 0 {
+		size = 0
+	}
+	expiresAt := l.now().Add(expires).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt, 10))
+	if size > 0 {
+		query.Set("size", strconv.FormatInt(size, 10))
+	}
+	if contentType != "" {
+		query.Set("type", contentType)
+	}
+	if uploadID != "" {
+		query.Set("upload_id", uploadID)
+		query.Set("part", strconv.Itoa(part))
+	}
+	query.Set("signature", l.signature(method, name, expiresAt, size, contentType, uploadID, part))
+	return l.baseURL + "/" + escapeObject(name) + "?" + query.Encode(), nil
+}
+
+// Verify contrôle la signature et l'échéance d'une URL produite par PresignUpload ou PresignDownload.
+func (l *Local) Verify(method, object string, query url.Values) (Grant, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return Grant{}, err
+	}
+	expiresAt, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return Grant{}, ErrInvalidSignature
+	}
+	var size int64
+	if raw := query.Get("size"); raw != "" {
+		if size, err = strconv.ParseInt(raw, 10, 64); err != nil || size <= 0 {
+			return Grant{}, ErrInvalidSignature
+		}
+	}
+	contentType := query.Get("type")
+	uploadID := query.Get("upload_id")
+	var part int
+	if uploadID != "" {
+		if part, err = strconv.Atoi(query.Get("part")); err != nil || part <= 0 {
+			return Grant{}, ErrInvalidSignature
+		}
+	}
+
+	expected := l.signature(method, name, expiresAt, size, contentType, uploadID, part)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("signature"))) {
+		return Grant{}, ErrInvalidSignature
+	}
+	grant := Grant{Method: method, Object: name, Size: size, ContentType: contentType, ExpiresAt: time.Unix(expiresAt, 0), UploadID: uploadID, Part: part}
+	if !l.now().Before(grant.ExpiresAt) {
+		return grant, ErrURLExpired
+	}
+	return grant, nil
+}
+
+func (l *Local) signature(method, object string, expiresAt, size int64, contentType, uploadID string, part int) string {
+	mac := hmac.New(sha256.New, l.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%d\n%d\n%s\n%s\n%d", method, object, expiresAt, size, contentType, uploadID, part)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Write dépose un objet de manière atomique : les données sont écrites dans un fichier temporaire
+// du même répertoire, synchronisées puis renommées. Avec size positif, une taille différente est refusée.
+func (l *Local) Write(_ context.Context, object string, r io.Reader, size int64) error {
+	name, err := cleanObject(object)
+	if err != nil {
+		return err
+	}
+	return l.writeFile(name, r, size)
+}
+
+// writeFile écrit atomiquement name, chemin déjà validé relatif à la racine.
+func (l *Local) writeFile(name string, r io.Reader, size int64) (err error) {
+	dir := path.Dir(name)
+	if err := l.root.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("storage: create directory: %w", err)
+	}
+
+	tmp := path.Join(dir, ".upload-"+randomHex(8))
+	file, err := l.root.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return fmt.Errorf("storage: create temp file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = l.root.Remove(tmp)
+		}
+	}()
+
+	source := r
+	if size > 0 {
+		source = io.LimitReader(r, size+1)
+	}
+	written, err := io.Copy(file, source)
+	if err != nil {
+		return fmt.Errorf("storage: write object: %w", err)
+	}
+	if size > 0 && written != size {
+		return ErrSizeMismatch
+	}
+	if err = file.Sync(); err != nil {
+		return fmt.Errorf("storage: sync object: %w", err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("storage: close object: %w", err)
+	}
+	if err = l.root.Rename(tmp, name); err != nil {
+		return fmt.Errorf("storage: rename object: %w", err)
+	}
+	return nil
+}
+
+// Remove supprime un objet ; un objet absent n'est pas une erreur.
+func (l *Local) Remove(_ context.Context, object string) error {
+	name, err := cleanObject(object)
+	if err != nil {
+		return err
+	}
+	if err := l.root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("storage: remove object: %w", err)
+	}
+	return nil
+}
+
+// Stat renvoie la taille d'un objet.
+func (l *Local) Stat(_ context.Context, object string) (ObjectInfo, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	info, err := l.root.Stat(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+		return ObjectInfo{}, fmt.Errorf("storage: stat object: %w", err)
+	}
+	if info.IsDir() {
+		return ObjectInfo{}, ErrObjectNotFound
+	}
+	return localInfo(name, info), nil
+}
+
+// Walk parcourt les objets stockés, hors fichiers temporaires et dépôts multipart en cours.
+func (l *Local) Walk(_ context.Context, fn func(ObjectInfo) error) error {
+	return fs.WalkDir(l.root.FS(), ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("storage: walk objects: %w", err)
+		}
+		if entry.IsDir() {
+			if name == multipartDir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("storage: walk objects: %w", err)
+		}
+		object := localInfo(name, info)
+		object.Key = name
+		return fn(object)
+	})
+}
+
+// localInfo dérive l'ETag de la date de modification et de la taille.
+func localInfo(name string, info fs.FileInfo) ObjectInfo {
+	return ObjectInfo{
+		Size:         info.Size(),
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		ContentType:  mime.TypeByExtension(path.Ext(name)),
+		LastModified: info.ModTime(),
+	}
+}
+
+// Open renvoie le fichier d'un objet ; il implémente io.ReadSeeker pour les requêtes Range.
+func (l *Local) Open(_ context.Context, object string) (io.ReadCloser, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return nil, err
+	}
+	file, err := l.root.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("storage: open object: %w", err)
+	}
+	if info, err := file.Stat(); err != nil || info.IsDir() {
+		_ = file.Close()
+		return nil, ErrObjectNotFound
+	}
+	return file, nil
+}
+
+// cleanObject normalise une clé d'objet en chemin relatif à la racine. Les chemins absolus,
+// les segments « .. », les fichiers temporaires et les dépôts multipart sont refusés ; os.Root bloque en outre
+// les liens symboliques sortant de la racine.
+func cleanObject(object string) (string, error) {
+	if object == "" || strings.ContainsAny(object, "\\\x00") || strings.HasPrefix(object, "/") {
+		return "", ErrInvalidObject
+	}
+	segments := strings.Split(object, "/")
+	if segments[0] == multipartDir {
+		return "", ErrInvalidObject
+	}
+	for _, segment := range segments {
+		if segment == ".." || strings.HasPrefix(segment, ".upload-") {
+			return "", ErrInvalidObject
+		}
+	}
+	name := path.Clean(object)
+	if name == "." {
+		return "", ErrInvalidObject
+	}
+	return name, nil
+}
+
+func escapeObject(name string) string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
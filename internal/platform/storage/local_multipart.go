@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// multipartDir regroupe, sous la racine, un répertoire par dépôt multipart en cours :
+// le fichier « object » y mémorise la clé cible et chaque partie est stockée sous « <numéro>.<md5> ».
+const multipartDir = ".multipart"
+
+// InitiateMultipart ouvre un dépôt multipart dont les parties sont stockées en fichiers séparés.
+func (l *Local) InitiateMultipart(_ context.Context, object string, _ string) (string, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return "", err
+	}
+	uploadID := randomHex(16)
+	if err := l.writeFile(path.Join(multipartDir, uploadID, "object"), strings.NewReader(name), 0); err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+// PresignPart renvoie une URL PUT signée pour la partie number.
+func (l *Local) PresignPart(_ context.Context, object, uploadID string, number int, expires time.Duration) (string, error) {
+	if number <= 0 {
+		return "", ErrInvalidPart
+	}
+	return l.sign(http.MethodPut, object, "", 0, expires, uploadID, number)
+}
+
+// WritePart dépose atomiquement une partie et renvoie son ETag (MD5, comme S3).
+func (l *Local) WritePart(_ context.Context, object, uploadID string, number int, r io.Reader) (string, error) {
+	dir, err := l.uploadDir(object, uploadID)
+	if err != nil {
+		return "", err
+	}
+	if number <= 0 {
+		return "", ErrInvalidPart
+	}
+	previous, err := l.partFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	hash := md5.New()
+	staging := path.Join(dir, "part-"+randomHex(8))
+	if err := l.writeFile(staging, io.TeeReader(r, hash), 0); err != nil {
+		return "", err
+	}
+	etag := hex.EncodeToString(hash.Sum(nil))
+	if err := l.root.Rename(staging, path.Join(dir, partName(number, etag))); err != nil {
+		_ = l.root.Remove(staging)
+		return "", fmt.Errorf("storage: store part: %w", err)
+	}
+	// Une partie redéposée remplace la précédente.
+	for _, p := range previous {
+		if p.Number == number && p.ETag != etag {
+			_ = l.root.Remove(path.Join(dir, partName(p.Number, p.ETag)))
+		}
+	}
+	return etag, nil
+}
+
+// ListParts renvoie les parties déposées, par numéro croissant.
+func (l *Local) ListParts(_ context.Context, object, uploadID string) ([]Part, error) {
+	dir, err := l.uploadDir(object, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	return l.partFiles(dir)
+}
+
+// CompleteMultipart concatène les parties listées, par numéro croissant, en un objet écrit atomiquement.
+func (l *Local) CompleteMultipart(ctx context.Context, object, uploadID string, parts []Part) error {
+	dir, err := l.uploadDir(object, uploadID)
+	if err != nil {
+		return err
+	}
+	stored, err := l.partFiles(dir)
+	if err != nil {
+		return err
+	}
+	available := make(map[Part]bool, len(stored))
+	for _, p := range stored {
+		available[Part{Number: p.Number, ETag: p.ETag}] = true
+	}
+
+	readers := make([]io.Reader, 0, len(parts))
+	for i, p := range parts {
+		etag := strings.Trim(p.ETag, `"`)
+		if (i > 0 && p.Number <= parts[i-1].Number) || !available[Part{Number: p.Number, ETag: etag}] {
+			closeAll(readers)
+			return ErrInvalidPart
+		}
+		file, err := l.root.Open(path.Join(dir, partName(p.Number, etag)))
+		if err != nil {
+			closeAll(readers)
+			return fmt.Errorf("storage: open part: %w", err)
+		}
+		readers = append(readers, file)
+	}
+	defer closeAll(readers)
+
+	if err := l.Write(ctx, object, io.MultiReader(readers...), 0); err != nil {
+		return err
+	}
+	return l.root.RemoveAll(dir)
+}
+
+// AbortMultipart supprime les parties d'un dépôt ; un dépôt inconnu n'est pas une erreur.
+func (l *Local) AbortMultipart(_ context.Context, object, uploadID string) error {
+	dir, err := l.uploadDir(object, uploadID)
+	if errors.Is(err, ErrUploadNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return l.root.RemoveAll(dir)
+}
+
+// uploadDir vérifie que le dépôt existe et cible bien object.
+func (l *Local) uploadDir(object, uploadID string) (string, error) {
+	name, err := cleanObject(object)
+	if err != nil {
+		return "", err
+	}
+	if len(uploadID) != 32 || strings.Trim(uploadID, "0123456789abcdef") != "" {
+		return "", ErrUploadNotFound
+	}
+	dir := path.Join(multipartDir, uploadID)
+	target, err := l.root.ReadFile(path.Join(dir, "object"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", ErrUploadNotFound
+		}
+		return "", fmt.Errorf("storage: read upload: %w", err)
+	}
+	if string(target) != name {
+		return "", ErrUploadNotFound
+	}
+	return dir, nil
+}
+
+func (l *Local) partFiles(dir string) ([]Part, error) {
+	entries, err := fs.ReadDir(l.root.FS(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("storage: list parts: %w", err)
+	}
+	parts := make([]Part, 0, len(entries))
+	for _, entry := range entries {
+		rawNumber, etag, ok := strings.Cut(entry.Name(), ".")
+		number, err := strconv.Atoi(rawNumber)
+		if !ok || err != nil || entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("storage: list parts: %w", err)
+		}
+		parts = append(parts, Part{Number: number, ETag: etag, Size: info.Size()})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+func partName(number int, etag string) string {
+	return fmt.Sprintf("%05d.%s", number, etag)
+}
+
+func closeAll(readers []io.Reader) {
+	for _, r := range readers {
+		if c, ok := r.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocal(t *testing.T) (*Local, string) {
+	dir := t.TempDir()
+	store, err := NewLocal(LocalConfig{Root: filepath.Join(dir, "objects"), BaseURL: "https://lms.test/storage/", Secret: []byte("secret")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store, dir
+}
+
+func signedQuery(t *testing.T, raw string) (string, url.Values) {
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	object, err := url.PathUnescape(strings.TrimPrefix(u.EscapedPath(), "/storage/"))
+	require.NoError(t, err)
+	return object, u.Query()
+}
+
+func TestLocal_SignedURLs(t *testing.T) {
+	store, _ := newTestLocal(t)
+	ctx := context.Background()
+
+	raw, err := store.PresignUpload(ctx, "org/2024/a b.pdf", "application/pdf", 42, time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(raw, "https://lms.test/storage/org/2024/a%20b.pdf?"), raw)
+
+	object, query := signedQuery(t, raw)
+	grant, err := store.Verify(http.MethodPut, object, query)
+	require.NoError(t, err)
+	require.Equal(t, "org/2024/a b.pdf", grant.Object)
+	require.EqualValues(t, 42, grant.Size)
+	require.Equal(t, "application/pdf", grant.ContentType)
+
+	_, err = store.Verify(http.MethodGet, object, query)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = store.Verify(http.MethodPut, "org/other.pdf", query)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	tampered, _ := url.ParseQuery(query.Encode())
+	tampered.Set("size", "4200")
+	_, err = store.Verify(http.MethodPut, object, tampered)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	_, err = store.Verify(http.MethodPut, object, query)
+	require.ErrorIs(t, err, ErrURLExpired)
+}
+
+func TestLocal_WriteStatOpenRemove(t *testing.T) {
+	store, dir := newTestLocal(t)
+	ctx := context.Background()
+
+	require.ErrorIs(t, store.Write(ctx, "org/doc.txt", strings.NewReader("hello"), 10), ErrSizeMismatch)
+	_, err := store.Stat(ctx, "org/doc.txt")
+	require.ErrorIs(t, err, ErrObjectNotFound, "un dépôt incomplet n'est pas visible")
+	entries, err := os.ReadDir(filepath.Join(dir, "objects", "org"))
+	require.NoError(t, err)
+	require.Empty(t, entries, "aucun fichier temporaire ne subsiste")
+
+	require.NoError(t, store.Write(ctx, "org/doc.txt", strings.NewReader("hello"), 5))
+	info, err := store.Stat(ctx, "org/doc.txt")
+	require.NoError(t, err)
+	require.EqualValues(t, 5, info.Size)
+	require.NotEmpty(t, info.ETag)
+	require.True(t, strings.HasPrefix(info.ContentType, "text/plain"))
+
+	reader, err := store.Open(ctx, "org/doc.txt")
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "hello", string(data))
+
+	require.NoError(t, store.Remove(ctx, "org/doc.txt"))
+	require.NoError(t, store.Remove(ctx, "org/doc.txt"))
+	_, err = store.Open(ctx, "org/doc.txt")
+	require.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocal_PathTraversal(t *testing.T) {
+	store, dir := newTestLocal(t)
+	ctx := context.Background()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("x"), 0o600))
+	require.NoError(t, os.Symlink(dir, filepath.Join(dir, "objects", "escape")))
+
+	for _, object := range []string{"../secret.txt", "org/../../secret.txt", "/etc/passwd", `org\..\x`, "", "org/.upload-123"} {
+		_, err := store.Open(ctx, object)
+		require.ErrorIs(t, err, ErrInvalidObject, object)
+		require.ErrorIs(t, store.Write(ctx, object, strings.NewReader("x"), 0), ErrInvalidObject, object)
+		_, err = store.PresignDownload(ctx, object, time.Minute)
+		require.ErrorIs(t, err, ErrInvalidObject, object)
+	}
+	_, err := store.Open(ctx, "escape/secret.txt")
+	require.Error(t, err, "un lien symbolique sortant de la racine est refusé")
+}
+
+func TestLocal_Multipart(t *testing.T) {
+	store, _ := newTestLocal(t)
+	ctx := context.Background()
+
+	uploadID, err := store.InitiateMultipart(ctx, "org/video.mp4", "video/mp4")
+	require.NoError(t, err)
+
+	raw, err := store.PresignPart(ctx, "org/video.mp4", uploadID, 2, time.Minute)
+	require.NoError(t, err)
+	object, query := signedQuery(t, raw)
+	grant, err := store.Verify(http.MethodPut, object, query)
+	require.NoError(t, err)
+	require.Equal(t, uploadID, grant.UploadID)
+	require.Equal(t, 2, grant.Part)
+	tampered, _ := url.ParseQuery(query.Encode())
+	tampered.Set("part", "3")
+	_, err = store.Verify(http.MethodPut, object, tampered)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	first, err := store.WritePart(ctx, "org/video.mp4", uploadID, 1, strings.NewReader("hello "))
+	require.NoError(t, err)
+	_, err = store.WritePart(ctx, "org/video.mp4", uploadID, 2, strings.NewReader("earth"))
+	require.NoError(t, err)
+	// Une partie redéposée remplace la précédente.
+	second, err := store.WritePart(ctx, "org/video.mp4", uploadID, 2, strings.NewReader("world"))
+	require.NoError(t, err)
+
+	parts, err := store.ListParts(ctx, "org/video.mp4", uploadID)
+	require.NoError(t, err)
+	require.Equal(t, []Part{{Number: 1, ETag: first, Size: 6}, {Number: 2, ETag: second, Size: 5}}, parts)
+
+	_, err = store.ListParts(ctx, "org/other.mp4", uploadID)
+	require.ErrorIs(t, err, ErrUploadNotFound)
+	_, err = store.ListParts(ctx, "org/video.mp4", "../../etc")
+	require.ErrorIs(t, err, ErrUploadNotFound)
+	_, err = store.Open(ctx, ".multipart/"+uploadID+"/object")
+	require.ErrorIs(t, err, ErrInvalidObject)
+
+	require.ErrorIs(t, store.CompleteMultipart(ctx, "org/video.mp4", uploadID, []Part{{Number: 2, ETag: second}, {Number: 1, ETag: first}}), ErrInvalidPart)
+	require.ErrorIs(t, store.CompleteMultipart(ctx, "org/video.mp4", uploadID, []Part{{Number: 1, ETag: first}, {Number: 2, ETag: first}}), ErrInvalidPart)
+	require.NoError(t, store.CompleteMultipart(ctx, "org/video.mp4", uploadID, []Part{{Number: 1, ETag: `"` + first + `"`}, {Number: 2, ETag: second}}))
+
+	reader, err := store.Open(ctx, "org/video.mp4")
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "hello world", string(data))
+
+	_, err = store.ListParts(ctx, "org/video.mp4", uploadID)
+	require.ErrorIs(t, err, ErrUploadNotFound, "le dépôt terminé est supprimé")
+	require.NoError(t, store.AbortMultipart(ctx, "org/video.mp4", uploadID))
+}
+
+func TestLocal_Walk(t *testing.T) {
+	store, _ := newTestLocal(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Write(ctx, "org/b.txt", strings.NewReader("bb"), 0))
+	require.NoError(t, store.Write(ctx, "org/sub/a.txt", strings.NewReader("a"), 0))
+	uploadID, err := store.InitiateMultipart(ctx, "org/c.bin", "")
+	require.NoError(t, err)
+	_, err = store.WritePart(ctx, "org/c.bin", uploadID, 1, strings.NewReader("part"))
+	require.NoError(t, err)
+
+	var seen []ObjectInfo
+	require.NoError(t, store.Walk(ctx, func(obj ObjectInfo) error {
+		seen = append(seen, obj)
+		return nil
+	}))
+	require.Len(t, seen, 2, "les dépôts multipart en cours ne sont pas des objets")
+	require.Equal(t, "org/b.txt", seen[0].Key)
+	require.EqualValues(t, 2, seen[0].Size)
+	require.Equal(t, "org/sub/a.txt", seen[1].Key)
+}
@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Memory est un stockage en mémoire, destiné aux tests et au développement.
+// Les URL pré-signées qu'il renvoie ne sont pas servies.
+type Memory struct {
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+	uploads map[string]*memoryUpload
+	nextID  int
+	now     func() time.Time
+}
+
+type memoryUpload struct {
+	object      string
+	contentType string
+	parts       map[int][]byte
+}
+
+type memoryObject struct {
+	data        []byte
+	contentType string
+	modified    time.Time
+}
+
+// NewMemory crée un stockage en mémoire vide.
+func NewMemory() *Memory {
+	return &Memory{objects: map[string]memoryObject{}, uploads: map[string]*memoryUpload{}, now: time.Now}
+}
+
+// Put dépose un objet, comme le ferait un client via l'URL pré-signée.
+func (m *Memory) Put(object string, data []byte, contentType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[object] = memoryObject{data: bytes.Clone(data), contentType: contentType, modified: m.now()}
+}
+
+// Write dépose un objet lu depuis r ; avec size positif, une taille différente est refusée.
+func (m *Memory) Write(_ context.Context, object string, r io.Reader, size int64) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("storage: write object: %w", err)
+	}
+	if size > 0 && int64(len(data)) != size {
+		return ErrSizeMismatch
+	}
+	m.Put(object, data, "")
+	return nil
+}
+
+// PresignUpload renvoie une URL factice de dépôt.
+func (m *Memory) PresignUpload(_ context.Context, object string, _ string, _ int64, _ time.Duration) (string, error) {
+	return "memory://upload/" + url.PathEscape(object), nil
+}
+
+// PresignDownload renvoie une URL factice de téléchargement.
+func (m *Memory) PresignDownload(_ context.Context, object string, _ time.Duration) (string, error) {
+	return "memory://download/" + url.PathEscape(object), nil
+}
+
+// Remove supprime un objet ; un objet absent n'est pas une erreur.
+func (m *Memory) Remove(_ context.Context, object string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, object)
+	return nil
+}
+
+// Stat renvoie la taille et l'ETag (MD5, comme S3 pour un dépôt simple) d'un objet.
+func (m *Memory) Stat(_ context.Context, object string) (ObjectInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	obj, ok := m.objects[object]
+	if !ok {
+		return ObjectInfo{}, ErrObjectNotFound
+	}
+	sum := md5.Sum(obj.data)
+	return ObjectInfo{
+		Size:         int64(len(obj.data)),
+		ETag:         hex.EncodeToString(sum[:]),
+		ContentType:  obj.contentType,
+		LastModified: obj.modified,
+	}, nil
+}
+
+// Open renvoie le contenu d'un objet.
+func (m *Memory) Open(_ context.Context, object string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	obj, ok := m.objects[object]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return memoryReader{bytes.NewReader(obj.data)}, nil
+}
+
+// Walk parcourt les objets par clé croissante ; fn peut supprimer des objets.
+func (m *Memory) Walk(_ context.Context, fn func(ObjectInfo) error) error {
+	m.mu.RLock()
+	objects := make([]ObjectInfo, 0, len(m.objects))
+	for key, obj := range m.objects {
+		objects = append(objects, ObjectInfo{Key: key, Size: int64(len(obj.data)), ContentType: obj.contentType, LastModified: obj.modified})
+	}
+	m.mu.RUnlock()
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	for _, obj := range objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// memoryReader permet les lectures partielles (io.ReadSeeker), comme un fichier.
+type memoryReader struct{ *bytes.Reader }
+
+func (memoryReader) Close() error { return nil }
+
+// InitiateMultipart ouvre un dépôt multipart en mémoire.
+func (m *Memory) InitiateMultipart(_ context.Context, object string, contentType string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	uploadID := fmt.Sprintf("upload-%d", m.nextID)
+	m.uploads[uploadID] = &memoryUpload{object: object, contentType: contentType, parts: map[int][]byte{}}
+	return uploadID, nil
+}
+
+// PresignPart renvoie une URL factice de dépôt de partie.
+func (m *Memory) PresignPart(_ context.Context, object, uploadID string, number int, _ time.Duration) (string, error) {
+	return fmt.Sprintf("memory://upload/%s?uploadId=%s&partNumber=%d", url.PathEscape(object), uploadID, number), nil
+}
+
+// PutPart dépose une partie, comme le ferait un client via l'URL pré-signée, et renvoie son ETag.
+func (m *Memory) PutPart(object, uploadID string, number int, data []byte) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	upload, ok := m.uploads[uploadID]
+	if !ok || upload.object != object {
+		return "", ErrUploadNotFound
+	}
+	upload.parts[number] = bytes.Clone(data)
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ListParts renvoie les parties déposées, par numéro croissant.
+func (m *Memory) ListParts(_ context.Context, object, uploadID string) ([]Part, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	upload, ok := m.uploads[uploadID]
+	if !ok || upload.object != object {
+		return nil, ErrUploadNotFound
+	}
+	parts := make([]Part, 0, len(upload.parts))
+	for number, data := range upload.parts {
+		sum := md5.Sum(data)
+		parts = append(parts, Part{Number: number, ETag: hex.EncodeToString(sum[:]), Size: int64(len(data))})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+// CompleteMultipart assemble les parties listées en un objet.
+func (m *Memory) CompleteMultipart(_ context.Context, object, uploadID string, parts []Part) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	upload, ok := m.uploads[uploadID]
+	if !ok || upload.object != object {
+		return ErrUploadNotFound
+	}
+	var buf bytes.Buffer
+	for i, p := range parts {
+		data, ok := upload.parts[p.Number]
+		sum := md5.Sum(data)
+		if !ok || (i > 0 && p.Number <= parts[i-1].Number) || hex.EncodeToString(sum[:]) != strings.Trim(p.ETag, `"`) {
+			return ErrInvalidPart
+		}
+		buf.Write(data)
+	}
+	m.objects[object] = memoryObject{data: buf.Bytes(), contentType: upload.contentType, modified: m.now()}
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+// AbortMultipart abandonne un dépôt multipart ; un dépôt inconnu n'est pas une erreur.
+func (m *Memory) AbortMultipart(_ context.Context, _ string, uploadID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.uploads, uploadID)
+	return nil
+}
@@ -3,9 +3,11 @@ package storage
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -153,13 +155,16 @@ func (c *Client) ensureBucket(ctx context.Context) error {
 	return nil
 }
 
-// PresignUpload renvoie une URL pré-signée pour PUT un objet.
-func (c *Client) PresignUpload(ctx context.Context, object string, contentType string, expires time.Duration) (string, error) {
-	var headers http.Header
+// PresignUpload renvoie une URL pré-signée pour PUT un objet. Lorsque size est positif,
+// l'entête Content-Length fait partie de la signature : le dépôt doit avoir exactement cette taille.
+func (c *Client) PresignUpload(ctx context.Context, object string, contentType string, size int64, expires time.Duration) (string, error) {
+	headers := make(http.Header)
 	if contentType != "" {
-		headers = make(http.Header)
 		headers.Set("Content-Type", contentType)
 	}
+	if size > 0 {
+		headers.Set("Content-Length", strconv.FormatInt(size, 10))
+	}
 
 	u, err := c.minio.PresignHeader(ctx, http.MethodPut, c.bucket, object, expires, nil, headers)
 	if err != nil {
@@ -179,6 +184,17 @@ func (c *Client) PresignDownload(ctx context.Context, object string, expires tim
 	return u.String(), nil
 }
 
+// Write dépose un objet lu depuis r ; size négatif ou nul signale une taille inconnue.
+func (c *Client) Write(ctx context.Context, object string, r io.Reader, size int64) error {
+	if size <= 0 {
+		size = -1
+	}
+	if _, err := c.minio.PutObject(ctx, c.bucket, object, r, size, minio.PutObjectOptions{}); err != nil {
+		return fmt.Errorf("storage: put object: %w", err)
+	}
+	return nil
+}
+
 // Remove supprime un objet du bucket (utilisé lors d'archivage).
 func (c *Client) Remove(ctx context.Context, object string) error {
 	if err := c.minio.RemoveObject(ctx, c.bucket, object, minio.RemoveObjectOptions{}); err != nil {
@@ -187,6 +203,66 @@ func (c *Client) Remove(ctx context.Context, object string) error {
 	return nil
 }
 
+// Stat interroge le bucket (HEAD) pour obtenir la taille et l'ETag d'un objet.
+func (c *Client) Stat(ctx context.Context, object string) (ObjectInfo, error) {
+	info, err := c.minio.StatObject(ctx, c.bucket, object, minio.StatObjectOptions{})
+	if err != nil {
+		if isNoSuchKey(err) {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+		return ObjectInfo{}, fmt.Errorf("storage: stat object: %w", err)
+	}
+	return ObjectInfo{
+		Size:         info.Size,
+		ETag:         strings.Trim(info.ETag, `"`),
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+// Walk parcourt tous les objets du bucket.
+func (c *Client) Walk(ctx context.Context, fn func(ObjectInfo) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range c.minio.ListObjects(ctx, c.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return fmt.Errorf("storage: list objects: %w", obj.Err)
+		}
+		if err := fn(ObjectInfo{
+			Key:          obj.Key,
+			Size:         obj.Size,
+			ETag:         strings.Trim(obj.ETag, `"`),
+			ContentType:  obj.ContentType,
+			LastModified: obj.LastModified,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Open renvoie un flux de lecture sur un objet.
+func (c *Client) Open(ctx context.Context, object string) (io.ReadCloser, error) {
+	obj, err := c.minio.GetObject(ctx, c.bucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("storage: get object: %w", err)
+	}
+	// GetObject est paresseux : Stat révèle un objet absent avant la première lecture.
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if isNoSuchKey(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("storage: get object: %w", err)
+	}
+	return obj, nil
+}
+
+func isNoSuchKey(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NotFound"
+}
+
 func (c *Client) applyPublicEndpoint(u *url.URL) {
 	if c.publicEndpoint == nil {
 		return
@@ -263,3 +339,81 @@ func normalizeAddr(addr string) string {
 func addressesEqual(a, b string) bool {
 	return normalizeAddr(a) == normalizeAddr(b)
 }
+
+// InitiateMultipart ouvre un dépôt multipart S3 et renvoie son identifiant.
+func (c *Client) InitiateMultipart(ctx context.Context, object string, contentType string) (string, error) {
+	core := minio.Core{Client: c.minio}
+	uploadID, err := core.NewMultipartUpload(ctx, c.bucket, object, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return "", fmt.Errorf("storage: initiate multipart: %w", err)
+	}
+	return uploadID, nil
+}
+
+// PresignPart renvoie une URL PUT pré-signée pour la partie number d'un dépôt multipart.
+func (c *Client) PresignPart(ctx context.Context, object, uploadID string, number int, expires time.Duration) (string, error) {
+	params := url.Values{}
+	params.Set("partNumber", strconv.Itoa(number))
+	params.Set("uploadId", uploadID)
+	u, err := c.minio.Presign(ctx, http.MethodPut, c.bucket, object, expires, params)
+	if err != nil {
+		return "", fmt.Errorf("storage: presign part: %w", err)
+	}
+	c.applyPublicEndpoint(u)
+	return u.String(), nil
+}
+
+// ListParts renvoie les parties déjà déposées, par numéro croissant.
+func (c *Client) ListParts(ctx context.Context, object, uploadID string) ([]Part, error) {
+	core := minio.Core{Client: c.minio}
+	var parts []Part
+	marker := 0
+	for {
+		result, err := core.ListObjectParts(ctx, c.bucket, object, uploadID, marker, 1000)
+		if err != nil {
+			if isNoSuchUpload(err) {
+				return nil, ErrUploadNotFound
+			}
+			return nil, fmt.Errorf("storage: list parts: %w", err)
+		}
+		for _, p := range result.ObjectParts {
+			parts = append(parts, Part{Number: p.PartNumber, ETag: strings.Trim(p.ETag, `"`), Size: p.Size})
+		}
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// CompleteMultipart assemble les parties, qui doivent être fournies par numéro croissant.
+func (c *Client) CompleteMultipart(ctx context.Context, object, uploadID string, parts []Part) error {
+	core := minio.Core{Client: c.minio}
+	complete := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		complete = append(complete, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+	if _, err := core.CompleteMultipartUpload(ctx, c.bucket, object, uploadID, complete, minio.PutObjectOptions{}); err != nil {
+		switch code := minio.ToErrorResponse(err).Code; {
+		case isNoSuchUpload(err):
+			return ErrUploadNotFound
+		case code == "InvalidPart", code == "InvalidPartOrder", code == "EntityTooSmall":
+			return fmt.Errorf("%w: %s", ErrInvalidPart, code)
+		}
+		return fmt.Errorf("storage: complete multipart: %w", err)
+	}
+	return nil
+}
+
+// AbortMultipart abandonne un dépôt multipart et libère ses parties ; un dépôt inconnu n'est pas une erreur.
+func (c *Client) AbortMultipart(ctx context.Context, object, uploadID string) error {
+	core := minio.Core{Client: c.minio}
+	if err := core.AbortMultipartUpload(ctx, c.bucket, object, uploadID); err != nil && !isNoSuchUpload(err) {
+		return fmt.Errorf("storage: abort multipart: %w", err)
+	}
+	return nil
+}
+
+func isNoSuchUpload(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchUpload"
+}
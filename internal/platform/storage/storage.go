@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrObjectNotFound signale un objet absent du stockage.
+	ErrObjectNotFound = errors.New("storage: object not found")
+	// ErrUploadNotFound signale un dépôt multipart inconnu, terminé ou abandonné.
+	ErrUploadNotFound = errors.New("storage: multipart upload not found")
+	// ErrInvalidPart signale une partie absente ou dont l'ETag ne correspond pas.
+	ErrInvalidPart = errors.New("storage: invalid multipart part")
+)
+
+// ObjectInfo décrit un objet stocké tel que rapporté par le backend.
+type ObjectInfo struct {
+	// Key n'est renseigné que par Walk.
+	Key          string
+	Size         int64
+	ETag         string
+	ContentType  string
+	LastModified time.Time
+}
+
+// Part décrit une partie déposée d'un dépôt multipart.
+type Part struct {
+	Number int
+	ETag   string
+	Size   int64
+}
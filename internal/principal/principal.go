@@ -0,0 +1,60 @@
+// Package principal décrit l'identité authentifiée à l'origine d'une requête : un utilisateur
+// (access token JWT) ou un compte de service (clé d'API).
+package principal
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Kind distingue les types de principal.
+type Kind string
+
+const (
+	KindUser           Kind = "user"
+	KindServiceAccount Kind = "service_account"
+)
+
+// Principal est l'identité authentifiée d'une requête.
+type Principal struct {
+	Kind           Kind
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	// Role n'est renseigné que pour les utilisateurs.
+	Role string
+	// Scopes n'est renseigné que pour les comptes de service.
+	Scopes []string
+	// KeyID identifie la clé d'API utilisée par un compte de service.
+	KeyID uuid.UUID
+}
+
+// IsServiceAccount indique si le principal est un compte de service.
+func (p Principal) IsServiceAccount() bool {
+	return p.Kind == KindServiceAccount
+}
+
+// HasScope indique si le principal dispose du scope demandé. Les utilisateurs ne sont pas
+// limités par des scopes : leurs droits découlent de leur rôle.
+func (p Principal) HasScope(scope string) bool {
+	if p.Kind != KindServiceAccount {
+		return true
+	}
+	return scope != "" && slices.Contains(p.Scopes, scope)
+}
+
+type contextKey string
+
+const principalKey contextKey = "principal"
+
+// WithPrincipal injecte le principal dans le contexte.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey, p)
+}
+
+// FromContext extrait le principal du contexte.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey).(Principal)
+	return p, ok
+}
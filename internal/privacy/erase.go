@@ -0,0 +1,143 @@
+package privacy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lms-go/internal/ent"
+	entdownload "lms-go/internal/ent/contentdownload"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entgroup "lms-go/internal/ent/group"
+	entmoduleprogress "lms-go/internal/ent/moduleprogress"
+	entprivacy "lms-go/internal/ent/privacyrequest"
+	entssoidentity "lms-go/internal/ent/ssoidentity"
+	entuser "lms-go/internal/ent/user"
+	entwebauthn "lms-go/internal/ent/webauthncredential"
+)
+
+// erase applique l'effacement demandé. En mode anonymize, le compte et la progression sont
+// conservés pour les statistiques mais tout identifiant personnel est retiré ; en mode delete,
+// le compte, ses inscriptions et sa progression sont supprimés. Dans les deux cas les exports en
+// attente sont annulés et les archives déjà produites supprimées.
+func (s *Service) erase(ctx context.Context, request *ent.PrivacyRequest) (summary map[string]int, err error) {
+	user, err := s.client.User.Query().
+		Where(entuser.IDEQ(request.UserID), entuser.OrganizationIDEQ(request.OrganizationID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if user.Status == UserStatusErased {
+		return nil, ErrAlreadyErased
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	summary = map[string]int{}
+	ownEnrollments := entenrollment.UserIDEQ(user.ID)
+	if summary["passkeys"], err = tx.WebauthnCredential.Delete().Where(entwebauthn.UserIDEQ(user.ID)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if summary["sso_identities"], err = tx.SSOIdentity.Delete().Where(entssoidentity.UserIDEQ(user.ID)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if summary["events"], err = tx.ContentDownload.Update().
+		Where(entdownload.UserIDEQ(user.ID)).
+		ClearUserID().
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	if summary["groups"], err = tx.Group.Query().Where(entgroup.HasMembersWith(entuser.IDEQ(user.ID))).Count(ctx); err != nil {
+		return nil, err
+	}
+	if summary["pending_exports"], err = tx.PrivacyRequest.Update().
+		Where(
+			entprivacy.UserIDEQ(user.ID),
+			entprivacy.KindEQ(KindExport),
+			entprivacy.StatusEQ(StatusPending),
+		).
+		SetStatus(StatusCancelled).
+		SetCancelledAt(time.Now()).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	switch request.Mode {
+	case ModeDelete:
+		if summary["module_progress"], err = tx.ModuleProgress.Delete().
+			Where(entmoduleprogress.HasEnrollmentWith(ownEnrollments)).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		if summary["enrollments"], err = tx.Enrollment.Delete().Where(ownEnrollments).Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err = tx.User.UpdateOneID(user.ID).ClearGroups().Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err = tx.User.DeleteOneID(user.ID).Exec(ctx); err != nil {
+			return nil, err
+		}
+		summary["users_deleted"] = 1
+	default:
+		if summary["enrollments"], err = tx.Enrollment.Update().
+			Where(ownEnrollments).
+			SetMetadata(map[string]any{}).
+			Save(ctx); err != nil {
+			return nil, err
+		}
+		if err = tx.User.UpdateOneID(user.ID).
+			SetEmail(fmt.Sprintf("erased+%s@invalid", user.ID)).
+			SetPasswordHash("!").
+			SetStatus(UserStatusErased).
+			SetMetadata(map[string]any{}).
+			AddVersion(1).
+			SetFailedLoginAttempts(0).
+			ClearRefreshTokenID().
+			ClearLastLoginAt().
+			ClearLockedUntil().
+			ClearMfaSecret().
+			ClearMfaEnabledAt().
+			ClearMfaRecoveryCodes().
+			ClearMagicLinkHash().
+			ClearExternalID().
+			ClearGroups().
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		summary["users_anonymized"] = 1
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// Les archives sont supprimées après la validation : un échec du stockage laisse l'effacement
+	// acquis et l'archive sera retirée à son expiration.
+	exports, qerr := s.client.PrivacyRequest.Query().
+		Where(
+			entprivacy.UserIDEQ(user.ID),
+			entprivacy.KindEQ(KindExport),
+			entprivacy.StatusEQ(StatusCompleted),
+		).
+		All(ctx)
+	if qerr != nil {
+		return summary, nil
+	}
+	for _, export := range exports {
+		if s.discardExport(ctx, export) == nil {
+			summary["export_archives"]++
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,14 @@
+package privacy
+
+import "errors"
+
+var (
+	ErrInvalidInput   = errors.New("privacy: invalid input")
+	ErrNotFound       = errors.New("privacy: not found")
+	ErrUserNotFound   = errors.New("privacy: user not found")
+	ErrAlreadyErased  = errors.New("privacy: user already erased")
+	ErrNotCancellable = errors.New("privacy: request can no longer be cancelled")
+	// ErrNotReady et ErrExpired concernent le téléchargement d'une archive d'export.
+	ErrNotReady = errors.New("privacy: export not ready")
+	ErrExpired  = errors.New("privacy: export expired")
+)
@@ -0,0 +1,307 @@
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entdownload "lms-go/internal/ent/contentdownload"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entgroup "lms-go/internal/ent/group"
+	entmoduleprogress "lms-go/internal/ent/moduleprogress"
+	entorg "lms-go/internal/ent/organization"
+	entuser "lms-go/internal/ent/user"
+	entwebauthn "lms-go/internal/ent/webauthncredential"
+)
+
+// moduleTypeQuiz identifie les modules dont la progression constitue les tentatives de quiz.
+const moduleTypeQuiz = "quiz"
+
+// section est un jeu de données exporté en JSON (tableau d'objets) et en CSV (une ligne par élément).
+type section struct {
+	name    string
+	columns []string
+	rows    [][]any
+}
+
+// export rassemble les données de l'utilisateur, dépose l'archive et renvoie la mise à jour qui
+// l'enregistre sur la demande.
+func (s *Service) export(ctx context.Context, request *ent.PrivacyRequest) (map[string]int, func(*ent.PrivacyRequestUpdateOne) *ent.PrivacyRequestUpdateOne, error) {
+	org, err := s.client.Organization.Query().Where(entorg.IDEQ(request.OrganizationID)).Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sections, err := s.collect(ctx, request.OrganizationID, request.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	archive, err := buildArchive(request, sections)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := fmt.Sprintf("%s%s/%s.zip", StoragePrefix, request.OrganizationID, request.ID)
+	if err := s.storage.Write(ctx, key, bytes.NewReader(archive), int64(len(archive))); err != nil {
+		return nil, nil, fmt.Errorf("privacy: store export: %w", err)
+	}
+
+	summary := make(map[string]int, len(sections))
+	for _, sec := range sections {
+		summary[sec.name] = len(sec.rows)
+	}
+	expiresAt := time.Now().Add(PolicyFromSettings(org.Settings).ExportRetention)
+	return summary, func(update *ent.PrivacyRequestUpdateOne) *ent.PrivacyRequestUpdateOne {
+		return update.
+			SetStorageKey(key).
+			SetSizeBytes(int64(len(archive))).
+			SetExpiresAt(expiresAt)
+	}, nil
+}
+
+// collect lit toutes les données personnelles de l'utilisateur. Les secrets (mot de passe,
+// TOTP, clés publiques des passkeys) ne sont pas exportés.
+func (s *Service) collect(ctx context.Context, orgID, userID uuid.UUID) ([]section, error) {
+	user, err := s.client.User.Query().
+		Where(entuser.IDEQ(userID), entuser.OrganizationIDEQ(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	profile := section{
+		name:    "profile",
+		columns: []string{"id", "email", "role", "status", "external_id", "mfa_enabled", "last_login_at", "metadata", "created_at", "updated_at"},
+		rows: [][]any{{
+			user.ID, user.Email, user.Role, user.Status, user.ExternalID, user.MfaEnabledAt != nil,
+			user.LastLoginAt, user.Metadata, user.CreatedAt, user.UpdatedAt,
+		}},
+	}
+
+	enrollments, err := s.client.Enrollment.Query().
+		Where(entenrollment.UserIDEQ(userID), entenrollment.OrganizationIDEQ(orgID)).
+		WithCourse().
+		Order(ent.Asc(entenrollment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrollmentSection := section{
+		name:    "enrollments",
+		columns: []string{"id", "course_id", "course_title", "group_id", "status", "progress", "started_at", "completed_at", "metadata", "created_at"},
+	}
+	for _, e := range enrollments {
+		enrollmentSection.rows = append(enrollmentSection.rows, []any{
+			e.ID, e.CourseID, courseTitle(e.Edges.Course), e.GroupID, e.Status, e.Progress,
+			e.StartedAt, e.CompletedAt, e.Metadata, e.CreatedAt,
+		})
+	}
+
+	entries, err := s.client.ModuleProgress.Query().
+		Where(entmoduleprogress.HasEnrollmentWith(entenrollment.UserIDEQ(userID), entenrollment.OrganizationIDEQ(orgID))).
+		WithModule().
+		WithEnrollment().
+		Order(ent.Asc(entmoduleprogress.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	columns := []string{"id", "enrollment_id", "course_id", "module_id", "module_title", "module_type", "status", "score", "attempts", "started_at", "completed_at"}
+	progress := section{name: "module_progress", columns: columns}
+	quizzes := section{name: "quiz_attempts", columns: columns}
+	for _, p := range entries {
+		var courseID uuid.UUID
+		if p.Edges.Enrollment != nil {
+			courseID = p.Edges.Enrollment.CourseID
+		}
+		var title, moduleType string
+		if m := p.Edges.Module; m != nil {
+			title, moduleType = m.Title, m.ModuleType
+		}
+		row := []any{p.ID, p.EnrollmentID, courseID, p.ModuleID, title, moduleType, p.Status, p.Score, p.Attempts, p.StartedAt, p.CompletedAt}
+		progress.rows = append(progress.rows, row)
+		if moduleType == moduleTypeQuiz {
+			quizzes.rows = append(quizzes.rows, row)
+		}
+	}
+
+	downloads, err := s.client.ContentDownload.Query().
+		Where(entdownload.UserIDEQ(userID), entdownload.OrganizationIDEQ(orgID)).
+		WithContent(func(q *ent.ContentQuery) { q.Select(entcontent.FieldID, entcontent.FieldName) }).
+		Order(ent.Asc(entdownload.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := section{
+		name:    "events",
+		columns: []string{"id", "type", "content_id", "content_name", "revision", "module_id", "created_at"},
+	}
+	for _, d := range downloads {
+		var name string
+		if d.Edges.Content != nil {
+			name = d.Edges.Content.Name
+		}
+		events.rows = append(events.rows, []any{d.ID, "content_download", d.ContentID, name, d.Revision, d.ModuleID, d.CreatedAt})
+	}
+
+	groups, err := s.client.Group.Query().
+		Where(entgroup.OrganizationIDEQ(orgID), entgroup.HasMembersWith(entuser.IDEQ(userID))).
+		Order(ent.Asc(entgroup.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupSection := section{name: "groups", columns: []string{"id", "name", "course_id"}}
+	for _, g := range groups {
+		groupSection.rows = append(groupSection.rows, []any{g.ID, g.Name, g.CourseID})
+	}
+
+	credentials, err := s.client.WebauthnCredential.Query().
+		Where(entwebauthn.UserIDEQ(userID)).
+		Order(ent.Asc(entwebauthn.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passkeys := section{name: "passkeys", columns: []string{"id", "name", "created_at", "last_used_at"}}
+	for _, c := range credentials {
+		passkeys.rows = append(passkeys.rows, []any{c.ID, c.Name, c.CreatedAt, c.LastUsedAt})
+	}
+
+	return []section{profile, enrollmentSection, progress, quizzes, events, groupSection, passkeys}, nil
+}
+
+func courseTitle(c *ent.Course) string {
+	if c == nil {
+		return ""
+	}
+	return c.Title
+}
+
+// buildArchive écrit un manifeste puis chaque section en <nom>.json et <nom>.csv.
+func buildArchive(request *ent.PrivacyRequest, sections []section) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	counts := make(map[string]int, len(sections))
+	for _, sec := range sections {
+		counts[sec.name] = len(sec.rows)
+	}
+	manifest := map[string]any{
+		"request_id":      request.ID,
+		"organization_id": request.OrganizationID,
+		"user_id":         request.UserID,
+		"generated_at":    time.Now().UTC(),
+		"sections":        counts,
+		// La plateforme ne délivre pas de certificats ; les tentatives de quiz sont la progression des modules quiz.
+		"notes": []string{
+			"quiz_attempts reprend la progression des modules de type quiz (score, nombre de tentatives).",
+			"events recense les téléchargements de contenus.",
+			"Aucun certificat n'est émis par la plateforme : aucune section certificates.",
+		},
+	}
+	if err := writeJSON(zw, "manifest.json", manifest); err != nil {
+		return nil, err
+	}
+	for _, sec := range sections {
+		records := make([]map[string]any, 0, len(sec.rows))
+		for _, row := range sec.rows {
+			record := make(map[string]any, len(sec.columns))
+			for i, column := range sec.columns {
+				record[column] = row[i]
+			}
+			records = append(records, record)
+		}
+		if err := writeJSON(zw, sec.name+".json", records); err != nil {
+			return nil, err
+		}
+		if err := writeCSV(zw, sec.name+".csv", sec); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("privacy: build archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("privacy: build archive: %w", err)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func writeCSV(zw *zip.Writer, name string, sec section) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("privacy: build archive: %w", err)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(sec.columns); err != nil {
+		return err
+	}
+	for _, row := range sec.rows {
+		record := make([]string, len(row))
+		for i, v := range row {
+			record[i] = csvValue(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvValue met en forme une valeur pour le CSV : dates RFC 3339, pointeurs nuls vides, objets en JSON.
+func csvValue(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case *string:
+		if x == nil {
+			return ""
+		}
+		return *x
+	case bool:
+		return strconv.FormatBool(x)
+	case int:
+		return strconv.Itoa(x)
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', -1, 32)
+	case uuid.UUID:
+		return x.String()
+	case *uuid.UUID:
+		if x == nil {
+			return ""
+		}
+		return x.String()
+	case time.Time:
+		return x.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if x == nil {
+			return ""
+		}
+		return x.UTC().Format(time.RFC3339)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
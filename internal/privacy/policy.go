@@ -0,0 +1,63 @@
+package privacy
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Valeurs par défaut de la politique RGPD d'une organisation.
+const (
+	defaultGracePeriod     = 30 * 24 * time.Hour
+	defaultExportRetention = 7 * 24 * time.Hour
+)
+
+// Policy est la politique RGPD d'une organisation, lue dans settings["privacy"] :
+// erasure_mode (anonymize ou delete), erasure_grace_days et export_retention_days.
+type Policy struct {
+	// ErasureMode est le traitement appliqué à l'effacement : anonymisation (par défaut), qui
+	// conserve la progression agrégée pour le reporting, ou suppression définitive.
+	ErasureMode string
+	// GracePeriod sépare la demande d'effacement de son exécution ; la demande reste annulable
+	// pendant ce délai (30 jours par défaut, 0 pour un traitement au prochain passage du worker).
+	GracePeriod time.Duration
+	// ExportRetention est la durée de conservation d'une archive d'export (7 jours par défaut).
+	ExportRetention time.Duration
+}
+
+// PolicyFromSettings lit la politique RGPD dans les réglages d'une organisation.
+func PolicyFromSettings(settings map[string]any) Policy {
+	policy := Policy{
+		ErasureMode:     ModeAnonymize,
+		GracePeriod:     defaultGracePeriod,
+		ExportRetention: defaultExportRetention,
+	}
+	section, ok := settings["privacy"].(map[string]any)
+	if !ok {
+		return policy
+	}
+	if mode, _ := section["erasure_mode"].(string); mode == ModeDelete {
+		policy.ErasureMode = ModeDelete
+	}
+	if days, ok := settingNumber(section["erasure_grace_days"]); ok && days >= 0 {
+		policy.GracePeriod = time.Duration(days * float64(24*time.Hour))
+	}
+	if days, ok := settingNumber(section["export_retention_days"]); ok && days > 0 {
+		policy.ExportRetention = time.Duration(days * float64(24*time.Hour))
+	}
+	return policy
+}
+
+func settingNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,368 @@
+// Package privacy traite les demandes RGPD des utilisateurs : export de leurs données dans une
+// archive ZIP et effacement (anonymisation ou suppression) après un délai de grâce. Les demandes
+// sont exécutées en arrière-plan par le worker.
+package privacy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entorg "lms-go/internal/ent/organization"
+	entprivacy "lms-go/internal/ent/privacyrequest"
+	entuser "lms-go/internal/ent/user"
+)
+
+const (
+	KindExport  = "export"
+	KindErasure = "erasure"
+
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+	StatusCancelled  = "cancelled"
+	// StatusExpired marque un export dont l'archive a été supprimée au terme de sa rétention.
+	StatusExpired = "expired"
+
+	ModeAnonymize = "anonymize"
+	ModeDelete    = "delete"
+
+	// UserStatusErased est le statut d'un compte anonymisé ; il interdit toute connexion.
+	UserStatusErased = "erased"
+
+	// StoragePrefix préfixe les clés des archives d'export ; le nettoyage du stockage doit l'ignorer.
+	StoragePrefix = "privacy-exports/"
+
+	ActorUser           = "user"
+	ActorServiceAccount = "service_account"
+)
+
+// Actor identifie l'auteur d'une demande ou d'une annulation : un utilisateur ou un compte de service.
+type Actor struct {
+	Kind string
+	ID   uuid.UUID
+}
+
+func (a *Actor) id() *uuid.UUID {
+	if a == nil {
+		return nil
+	}
+	return &a.ID
+}
+
+func (a *Actor) kind() *string {
+	if a == nil {
+		return nil
+	}
+	return &a.Kind
+}
+
+// Storage est le sous-ensemble du stockage objet utilisé pour les archives d'export.
+type Storage interface {
+	Write(ctx context.Context, object string, r io.Reader, size int64) error
+	PresignDownload(ctx context.Context, object string, expires time.Duration) (string, error)
+	Remove(ctx context.Context, object string) error
+}
+
+type Config struct {
+	// DownloadExpiry est la durée de validité des liens de téléchargement d'une archive (15 min par défaut).
+	DownloadExpiry time.Duration
+	// StaleAfter est la durée au-delà de laquelle une demande restée en cours est reprise (1 h par défaut).
+	StaleAfter time.Duration
+}
+
+type Service struct {
+	client         *ent.Client
+	storage        Storage
+	downloadExpiry time.Duration
+	staleAfter     time.Duration
+}
+
+func NewService(client *ent.Client, storage Storage, cfg Config) *Service {
+	download := cfg.DownloadExpiry
+	if download == 0 {
+		download = 15 * time.Minute
+	}
+	stale := cfg.StaleAfter
+	if stale == 0 {
+		stale = time.Hour
+	}
+	return &Service{client: client, storage: storage, downloadExpiry: download, staleAfter: stale}
+}
+
+// RequestExport programme l'export des données d'un utilisateur. Un export déjà en attente ou en
+// cours est renvoyé tel quel.
+func (s *Service) RequestExport(ctx context.Context, orgID, userID uuid.UUID, requestedBy *Actor) (*ent.PrivacyRequest, error) {
+	if _, err := s.activeUser(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+	if existing, err := s.open(ctx, orgID, userID, KindExport); err != nil || existing != nil {
+		return existing, err
+	}
+	return s.client.PrivacyRequest.Create().
+		SetOrganizationID(orgID).
+		SetUserID(userID).
+		SetKind(KindExport).
+		SetNillableRequestedBy(requestedBy.id()).
+		SetNillableRequestedByKind(requestedBy.kind()).
+		SetScheduledAt(time.Now()).
+		Save(ctx)
+}
+
+// RequestErasure programme l'effacement d'un utilisateur selon la politique de son organisation,
+// à l'issue du délai de grâce. Une demande déjà en attente est renvoyée telle quelle.
+func (s *Service) RequestErasure(ctx context.Context, orgID, userID uuid.UUID, requestedBy *Actor) (*ent.PrivacyRequest, error) {
+	if _, err := s.activeUser(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+	if existing, err := s.open(ctx, orgID, userID, KindErasure); err != nil || existing != nil {
+		return existing, err
+	}
+	org, err := s.client.Organization.Query().Where(entorg.IDEQ(orgID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := PolicyFromSettings(org.Settings)
+	return s.client.PrivacyRequest.Create().
+		SetOrganizationID(orgID).
+		SetUserID(userID).
+		SetKind(KindErasure).
+		SetMode(policy.ErasureMode).
+		SetNillableRequestedBy(requestedBy.id()).
+		SetNillableRequestedByKind(requestedBy.kind()).
+		SetScheduledAt(time.Now().Add(policy.GracePeriod)).
+		Save(ctx)
+}
+
+// Cancel annule une demande qui n'a pas encore été traitée.
+func (s *Service) Cancel(ctx context.Context, orgID, userID, requestID uuid.UUID, cancelledBy *Actor) (*ent.PrivacyRequest, error) {
+	if _, err := s.Get(ctx, orgID, userID, requestID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	n, err := s.client.PrivacyRequest.Update().
+		Where(entprivacy.IDEQ(requestID), entprivacy.StatusEQ(StatusPending)).
+		SetStatus(StatusCancelled).
+		SetCancelledAt(now).
+		SetNillableCancelledBy(cancelledBy.id()).
+		SetNillableCancelledByKind(cancelledBy.kind()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrNotCancellable
+	}
+	return s.Get(ctx, orgID, userID, requestID)
+}
+
+func (s *Service) Get(ctx context.Context, orgID, userID, requestID uuid.UUID) (*ent.PrivacyRequest, error) {
+	request, err := s.client.PrivacyRequest.Query().
+		Where(
+			entprivacy.IDEQ(requestID),
+			entprivacy.OrganizationIDEQ(orgID),
+			entprivacy.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// List renvoie l'historique des demandes d'un utilisateur, y compris après son effacement.
+func (s *Service) List(ctx context.Context, orgID, userID uuid.UUID) ([]*ent.PrivacyRequest, error) {
+	return s.client.PrivacyRequest.Query().
+		Where(entprivacy.OrganizationIDEQ(orgID), entprivacy.UserIDEQ(userID)).
+		Order(ent.Desc(entprivacy.FieldCreatedAt)).
+		All(ctx)
+}
+
+// ExportLink renvoie une URL de téléchargement de l'archive d'un export terminé.
+func (s *Service) ExportLink(ctx context.Context, orgID, userID, requestID uuid.UUID) (string, time.Time, error) {
+	request, err := s.Get(ctx, orgID, userID, requestID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if request.Kind != KindExport {
+		return "", time.Time{}, ErrNotFound
+	}
+	switch {
+	case request.Status == StatusExpired,
+		request.Status == StatusCompleted && request.ExpiresAt != nil && time.Now().After(*request.ExpiresAt):
+		return "", time.Time{}, ErrExpired
+	case request.Status != StatusCompleted || request.StorageKey == nil:
+		return "", time.Time{}, ErrNotReady
+	}
+	url, err := s.storage.PresignDownload(ctx, *request.StorageKey, s.downloadExpiry)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("privacy: presign export: %w", err)
+	}
+	return url, time.Now().Add(s.downloadExpiry), nil
+}
+
+// Report résume un passage du worker.
+type Report struct {
+	Exported int
+	Erased   int
+	Failed   int
+	// Expired compte les archives d'export supprimées au terme de leur rétention.
+	Expired int
+}
+
+// ProcessDue exécute les demandes arrivées à échéance (et reprend celles restées en cours depuis
+// plus de StaleAfter), puis supprime les archives d'export expirées. L'échec d'une demande est
+// consigné sur celle-ci sans interrompre les suivantes.
+func (s *Service) ProcessDue(ctx context.Context) (*Report, error) {
+	report := &Report{}
+	now := time.Now()
+	due, err := s.client.PrivacyRequest.Query().
+		Where(entprivacy.Or(
+			entprivacy.And(entprivacy.StatusEQ(StatusPending), entprivacy.ScheduledAtLTE(now)),
+			entprivacy.And(entprivacy.StatusEQ(StatusProcessing), entprivacy.StartedAtLT(now.Add(-s.staleAfter))),
+		)).
+		Order(ent.Asc(entprivacy.FieldScheduledAt)).
+		All(ctx)
+	if err != nil {
+		return report, err
+	}
+	for _, request := range due {
+		claimed, err := s.claim(ctx, request, now)
+		if err != nil {
+			return report, err
+		}
+		if !claimed {
+			continue
+		}
+		var summary map[string]int
+		var done func(*ent.PrivacyRequestUpdateOne) *ent.PrivacyRequestUpdateOne
+		switch request.Kind {
+		case KindExport:
+			summary, done, err = s.export(ctx, request)
+		case KindErasure:
+			summary, err = s.erase(ctx, request)
+		default:
+			err = fmt.Errorf("privacy: unknown request kind %q", request.Kind)
+		}
+		if err != nil {
+			report.Failed++
+			if uerr := s.client.PrivacyRequest.UpdateOneID(request.ID).
+				SetStatus(StatusFailed).
+				SetError(err.Error()).
+				Exec(ctx); uerr != nil {
+				return report, uerr
+			}
+			continue
+		}
+		update := s.client.PrivacyRequest.UpdateOneID(request.ID).
+			SetStatus(StatusCompleted).
+			SetCompletedAt(time.Now()).
+			SetSummary(summary).
+			SetError("")
+		if done != nil {
+			update = done(update)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return report, err
+		}
+		if request.Kind == KindExport {
+			report.Exported++
+		} else {
+			report.Erased++
+		}
+	}
+
+	expired, err := s.expireExports(ctx, now)
+	report.Expired = expired
+	return report, err
+}
+
+// claim passe une demande en cours ; faux si un autre worker l'a prise entre-temps.
+func (s *Service) claim(ctx context.Context, request *ent.PrivacyRequest, now time.Time) (bool, error) {
+	update := s.client.PrivacyRequest.Update().
+		Where(entprivacy.IDEQ(request.ID), entprivacy.StatusEQ(request.Status))
+	if request.StartedAt != nil {
+		update = update.Where(entprivacy.StartedAtEQ(*request.StartedAt))
+	}
+	n, err := update.SetStatus(StatusProcessing).SetStartedAt(now).Save(ctx)
+	return n > 0, err
+}
+
+// expireExports supprime les archives dont la rétention est échue.
+func (s *Service) expireExports(ctx context.Context, now time.Time) (int, error) {
+	requests, err := s.client.PrivacyRequest.Query().
+		Where(
+			entprivacy.KindEQ(KindExport),
+			entprivacy.StatusEQ(StatusCompleted),
+			entprivacy.ExpiresAtLT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	expired := 0
+	for _, request := range requests {
+		if err := s.discardExport(ctx, request); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// discardExport supprime l'archive d'un export et le marque expiré.
+func (s *Service) discardExport(ctx context.Context, request *ent.PrivacyRequest) error {
+	if request.StorageKey != nil {
+		if err := s.storage.Remove(ctx, *request.StorageKey); err != nil {
+			return fmt.Errorf("privacy: remove export %s: %w", request.ID, err)
+		}
+	}
+	return s.client.PrivacyRequest.UpdateOneID(request.ID).
+		SetStatus(StatusExpired).
+		ClearStorageKey().
+		Exec(ctx)
+}
+
+// open renvoie la demande du type donné encore en attente ou en cours pour l'utilisateur.
+func (s *Service) open(ctx context.Context, orgID, userID uuid.UUID, kind string) (*ent.PrivacyRequest, error) {
+	request, err := s.client.PrivacyRequest.Query().
+		Where(
+			entprivacy.OrganizationIDEQ(orgID),
+			entprivacy.UserIDEQ(userID),
+			entprivacy.KindEQ(kind),
+			entprivacy.StatusIn(StatusPending, StatusProcessing),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return request, err
+}
+
+// activeUser renvoie l'utilisateur s'il existe et n'a pas déjà été anonymisé.
+func (s *Service) activeUser(ctx context.Context, orgID, userID uuid.UUID) (*ent.User, error) {
+	if orgID == uuid.Nil || userID == uuid.Nil {
+		return nil, ErrInvalidInput
+	}
+	user, err := s.client.User.Query().
+		Where(entuser.IDEQ(userID), entuser.OrganizationIDEQ(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if user.Status == UserStatusErased {
+		return nil, ErrAlreadyErased
+	}
+	return user, nil
+}
@@ -0,0 +1,276 @@
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/storage"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+type fixture struct {
+	svc     *Service
+	client  *ent.Client
+	store   *storage.Memory
+	org     *ent.Organization
+	learner *ent.User
+	course  *ent.Course
+}
+
+func newFixture(t *testing.T, name string, settings map[string]any) fixture {
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").SetSettings(settings).Save(ctx)
+	require.NoError(t, err)
+	learner, err := client.User.Create().SetOrganizationID(org.ID).SetEmail("learner@example.com").SetPasswordHash("hash").
+		SetExternalID("ext-1").SetMetadata(map[string]any{"phone": "0600000000"}).Save(ctx)
+	require.NoError(t, err)
+	course, err := client.Course.Create().SetOrganizationID(org.ID).SetTitle("Sécurité").SetSlug("securite").SetStatus("published").Save(ctx)
+	require.NoError(t, err)
+	quiz, err := client.Module.Create().SetCourseID(course.ID).SetTitle("Quiz final").SetModuleType("quiz").Save(ctx)
+	require.NoError(t, err)
+	article, err := client.Module.Create().SetCourseID(course.ID).SetTitle("Introduction").SetModuleType("article").SetPosition(1).Save(ctx)
+	require.NoError(t, err)
+	enrollment, err := client.Enrollment.Create().SetOrganizationID(org.ID).SetCourseID(course.ID).SetUserID(learner.ID).
+		SetStatus("active").SetMetadata(map[string]any{"note": "prénom nom"}).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.ModuleProgress.Create().SetEnrollmentID(enrollment.ID).SetModuleID(quiz.ID).SetStatus("completed").SetScore(80).SetAttempts(2).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.ModuleProgress.Create().SetEnrollmentID(enrollment.ID).SetModuleID(article.ID).SetStatus("in_progress").Save(ctx)
+	require.NoError(t, err)
+	guide, err := client.Content.Create().SetOrganizationID(org.ID).SetName("guide.pdf").SetMimeType("application/pdf").
+		SetStorageKey("org/guide.pdf").SetStatus("available").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.ContentDownload.Create().SetOrganizationID(org.ID).SetContentID(guide.ID).SetRevision(1).SetUserID(learner.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Group.Create().SetOrganizationID(org.ID).SetName("Promo 2026").AddMembers(learner).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.WebauthnCredential.Create().SetUserID(learner.ID).SetCredentialID([]byte("cred")).SetPublicKey([]byte("key")).
+		SetName("Clé USB").Save(ctx)
+	require.NoError(t, err)
+
+	store := storage.NewMemory()
+	return fixture{
+		svc:     NewService(client, store, Config{}),
+		client:  client,
+		store:   store,
+		org:     org,
+		learner: learner,
+		course:  course,
+	}
+}
+
+func readArchive(t *testing.T, store *storage.Memory, key string) map[string][]byte {
+	rc, err := store.Open(context.Background(), key)
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		files[f.Name], err = io.ReadAll(r)
+		require.NoError(t, err)
+		_ = r.Close()
+	}
+	return files
+}
+
+func TestService_Export(t *testing.T) {
+	f := newFixture(t, "privacyexport", nil)
+	ctx := context.Background()
+
+	request, err := f.svc.RequestExport(ctx, f.org.ID, f.learner.ID, &Actor{Kind: ActorUser, ID: f.learner.ID})
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, request.Status)
+	again, err := f.svc.RequestExport(ctx, f.org.ID, f.learner.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, request.ID, again.ID, "un export en attente n'est pas dupliqué")
+	_, err = f.svc.RequestExport(ctx, f.org.ID, uuid.New(), nil)
+	require.ErrorIs(t, err, ErrUserNotFound)
+	_, _, err = f.svc.ExportLink(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.ErrorIs(t, err, ErrNotReady)
+
+	report, err := f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Exported)
+
+	request, err = f.svc.Get(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusCompleted, request.Status)
+	require.NotNil(t, request.StorageKey)
+	require.True(t, strings.HasPrefix(*request.StorageKey, StoragePrefix+f.org.ID.String()+"/"))
+	require.Positive(t, request.SizeBytes)
+	require.WithinDuration(t, time.Now().Add(7*24*time.Hour), *request.ExpiresAt, time.Minute)
+	require.Equal(t, 2, request.Summary["module_progress"])
+	require.Equal(t, 1, request.Summary["quiz_attempts"])
+
+	url, _, err := f.svc.ExportLink(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.NoError(t, err)
+	require.Contains(t, url, "privacy-exports")
+
+	files := readArchive(t, f.store, *request.StorageKey)
+	for _, name := range []string{"manifest.json", "profile", "enrollments", "module_progress", "quiz_attempts", "events", "groups", "passkeys"} {
+		if name != "manifest.json" {
+			require.Contains(t, files, name+".csv")
+			name += ".json"
+		}
+		require.Contains(t, files, name)
+	}
+	require.Contains(t, string(files["manifest.json"]), "certificat")
+	require.NotContains(t, string(files["profile.json"]), "hash")
+	var enrollments []map[string]any
+	require.NoError(t, json.Unmarshal(files["enrollments.json"], &enrollments))
+	require.Len(t, enrollments, 1)
+	require.Equal(t, "Sécurité", enrollments[0]["course_title"])
+	require.Contains(t, string(files["quiz_attempts.csv"]), "Quiz final,quiz,completed,80,2")
+	require.Contains(t, string(files["events.csv"]), "guide.pdf")
+	require.Contains(t, string(files["passkeys.csv"]), "Clé USB")
+
+	// À l'échéance de la rétention, l'archive est supprimée et le lien n'est plus délivré.
+	_, err = f.client.PrivacyRequest.UpdateOneID(request.ID).SetExpiresAt(time.Now().Add(-time.Minute)).Save(ctx)
+	require.NoError(t, err)
+	_, _, err = f.svc.ExportLink(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.ErrorIs(t, err, ErrExpired)
+	report, err = f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Expired)
+	_, err = f.store.Stat(ctx, *request.StorageKey)
+	require.ErrorIs(t, err, storage.ErrObjectNotFound)
+	request, err = f.svc.Get(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusExpired, request.Status)
+	require.Nil(t, request.StorageKey)
+}
+
+func TestService_ErasureGraceAndCancel(t *testing.T) {
+	f := newFixture(t, "privacygrace", nil)
+	ctx := context.Background()
+
+	request, err := f.svc.RequestErasure(ctx, f.org.ID, f.learner.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, ModeAnonymize, request.Mode)
+	require.WithinDuration(t, time.Now().Add(30*24*time.Hour), request.ScheduledAt, time.Minute)
+
+	report, err := f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	require.Zero(t, report.Erased, "le délai de grâce n'est pas écoulé")
+
+	cancelled, err := f.svc.Cancel(ctx, f.org.ID, f.learner.ID, request.ID, &Actor{Kind: ActorUser, ID: f.learner.ID})
+	require.NoError(t, err)
+	require.Equal(t, StatusCancelled, cancelled.Status)
+	require.NotNil(t, cancelled.CancelledAt)
+	_, err = f.svc.Cancel(ctx, f.org.ID, f.learner.ID, request.ID, nil)
+	require.ErrorIs(t, err, ErrNotCancellable)
+	_, err = f.svc.Cancel(ctx, f.org.ID, uuid.New(), request.ID, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	user, err := f.client.User.Get(ctx, f.learner.ID)
+	require.NoError(t, err)
+	require.Equal(t, "active", user.Status)
+}
+
+func TestService_ErasureAnonymize(t *testing.T) {
+	f := newFixture(t, "privacyanonymize", map[string]any{"privacy": map[string]any{"erasure_grace_days": 0}})
+	ctx := context.Background()
+
+	export, err := f.svc.RequestExport(ctx, f.org.ID, f.learner.ID, nil)
+	require.NoError(t, err)
+	_, err = f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	export, err = f.svc.Get(ctx, f.org.ID, f.learner.ID, export.ID)
+	require.NoError(t, err)
+	key := *export.StorageKey
+
+	request, err := f.svc.RequestErasure(ctx, f.org.ID, f.learner.ID, nil)
+	require.NoError(t, err)
+	report, err := f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Erased)
+
+	request, err = f.svc.Get(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusCompleted, request.Status)
+	require.Equal(t, 1, request.Summary["users_anonymized"])
+	require.Equal(t, 1, request.Summary["export_archives"])
+
+	user, err := f.client.User.Get(ctx, f.learner.ID)
+	require.NoError(t, err)
+	require.Equal(t, UserStatusErased, user.Status)
+	require.Equal(t, "erased+"+f.learner.ID.String()+"@invalid", user.Email)
+	require.Nil(t, user.ExternalID)
+	require.Empty(t, user.Metadata)
+	require.Zero(t, f.client.WebauthnCredential.Query().CountX(ctx))
+	require.Empty(t, user.QueryGroups().AllX(ctx))
+	require.Equal(t, 2, f.client.ModuleProgress.Query().CountX(ctx), "la progression est conservée")
+	enrollment := f.client.Enrollment.Query().OnlyX(ctx)
+	require.Empty(t, enrollment.Metadata)
+	require.Nil(t, f.client.ContentDownload.Query().OnlyX(ctx).UserID)
+	_, err = f.store.Stat(ctx, key)
+	require.ErrorIs(t, err, storage.ErrObjectNotFound)
+
+	_, err = f.svc.RequestErasure(ctx, f.org.ID, f.learner.ID, nil)
+	require.ErrorIs(t, err, ErrAlreadyErased)
+	history, err := f.svc.List(ctx, f.org.ID, f.learner.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2, "l'historique survit à l'effacement")
+}
+
+func TestService_ErasureDelete(t *testing.T) {
+	f := newFixture(t, "privacydelete", map[string]any{"privacy": map[string]any{"erasure_mode": "delete", "erasure_grace_days": 0}})
+	ctx := context.Background()
+
+	request, err := f.svc.RequestErasure(ctx, f.org.ID, f.learner.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, ModeDelete, request.Mode)
+	report, err := f.svc.ProcessDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Erased)
+
+	require.Zero(t, f.client.User.Query().CountX(ctx))
+	require.Zero(t, f.client.Enrollment.Query().CountX(ctx))
+	require.Zero(t, f.client.ModuleProgress.Query().CountX(ctx))
+	require.Nil(t, f.client.ContentDownload.Query().OnlyX(ctx).UserID)
+	request, err = f.svc.Get(ctx, f.org.ID, f.learner.ID, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusCompleted, request.Status)
+	require.Equal(t, 2, request.Summary["module_progress"])
+}
+
+func TestPolicyFromSettings(t *testing.T) {
+	policy := PolicyFromSettings(nil)
+	require.Equal(t, Policy{ErasureMode: ModeAnonymize, GracePeriod: 30 * 24 * time.Hour, ExportRetention: 7 * 24 * time.Hour}, policy)
+	policy = PolicyFromSettings(map[string]any{"privacy": map[string]any{
+		"erasure_mode": "delete", "erasure_grace_days": float64(0), "export_retention_days": float64(-1),
+	}})
+	require.Equal(t, ModeDelete, policy.ErasureMode)
+	require.Zero(t, policy.GracePeriod)
+	require.Equal(t, 7*24*time.Hour, policy.ExportRetention)
+}
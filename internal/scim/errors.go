@@ -0,0 +1,13 @@
+package scim
+
+import "errors"
+
+var (
+	ErrInvalidToken  = errors.New("scim: invalid bearer token")
+	ErrNotFound      = errors.New("scim: resource not found")
+	ErrInvalidValue  = errors.New("scim: invalid value")
+	ErrInvalidFilter = errors.New("scim: invalid filter")
+	ErrInvalidPath   = errors.New("scim: invalid patch path")
+	ErrInvalidSyntax = errors.New("scim: invalid request syntax")
+	ErrUniqueness    = errors.New("scim: resource already exists")
+)
@@ -0,0 +1,59 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+)
+
+// filter est une expression de comparaison simple « attribut eq valeur », seule forme
+// utilisée par les annuaires (Azure AD, Okta) pour rapprocher les ressources.
+type filter struct {
+	Attribute string
+	Value     string
+}
+
+// parseFilter analyse une expression de filtre ; les noms d'attributs sont insensibles à la casse.
+func parseFilter(raw string) (*filter, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	attribute, rest, ok := strings.Cut(raw, " ")
+	if !ok {
+		return nil, ErrInvalidFilter
+	}
+	op, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(op, "eq") {
+		return nil, ErrInvalidFilter
+	}
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+		value = unquoted
+	} else if value != "true" && value != "false" {
+		return nil, ErrInvalidFilter
+	}
+	return &filter{Attribute: strings.ToLower(attribute), Value: value}, nil
+}
+
+// pagination normalise startIndex (base 1) et count selon la RFC 7644 §3.4.2.4.
+func pagination(query ListQuery) (offset, limit int) {
+	start := query.StartIndex
+	if start < 1 {
+		start = 1
+	}
+	limit = defaultPageSize
+	if query.Count != nil {
+		limit = *query.Count
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	return start - 1, limit
+}
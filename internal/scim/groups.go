@@ -0,0 +1,395 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+)
+
+// ListGroups recherche les groupes (filtres displayName, externalId, id).
+func (s *Service) ListGroups(ctx context.Context, orgID uuid.UUID, query ListQuery) (ListResponse[Group], error) {
+	offset, limit := pagination(query)
+	resp := ListResponse[Group]{
+		Schemas:    []string{SchemaListResponse},
+		StartIndex: offset + 1,
+		Resources:  []Group{},
+	}
+
+	f, err := parseFilter(query.Filter)
+	if err != nil {
+		return resp, err
+	}
+	groupFilter := enrollment.GroupFilter{WithMembers: !query.ExcludeMembers}
+	if f != nil {
+		if f.Value == "" {
+			return resp, nil
+		}
+		switch f.Attribute {
+		case "displayname":
+			groupFilter.Name = f.Value
+		case "externalid":
+			groupFilter.ExternalID = f.Value
+		case "id":
+			found, err := s.GetGroup(ctx, orgID, f.Value)
+			if errors.Is(err, ErrNotFound) {
+				return resp, nil
+			}
+			if err != nil {
+				return resp, err
+			}
+			resp.TotalResults = 1
+			if offset == 0 && limit > 0 {
+				if query.ExcludeMembers {
+					found.Members = nil
+				}
+				resp.Resources = append(resp.Resources, found)
+				resp.ItemsPerPage = 1
+			}
+			return resp, nil
+		default:
+			return resp, ErrInvalidFilter
+		}
+	}
+
+	total, err := s.enrollments.CountGroups(ctx, orgID, groupFilter)
+	if err != nil {
+		return resp, err
+	}
+	resp.TotalResults = total
+	if limit == 0 {
+		return resp, nil
+	}
+
+	groupFilter.Offset = offset
+	groupFilter.Limit = limit
+	groups, err := s.enrollments.ListGroups(ctx, orgID, groupFilter)
+	if err != nil {
+		return resp, err
+	}
+	for _, g := range groups {
+		resp.Resources = append(resp.Resources, toGroup(g))
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp, nil
+}
+
+// GetGroup renvoie un groupe et ses membres.
+func (s *Service) GetGroup(ctx context.Context, orgID uuid.UUID, id string) (Group, error) {
+	existing, err := s.getGroup(ctx, orgID, id)
+	if err != nil {
+		return Group{}, err
+	}
+	return toGroup(existing), nil
+}
+
+// CreateGroup provisionne un groupe d'inscription. Un groupe existant portant l'externalId,
+// ou le même nom sans externalId (créé au préalable par un administrateur, éventuellement
+// rattaché à un cours), est repris : created vaut alors false.
+func (s *Service) CreateGroup(ctx context.Context, orgID uuid.UUID, in Group) (Group, bool, error) {
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return Group{}, false, ErrInvalidValue
+	}
+	memberIDs, err := parseMembers(in.Members)
+	if err != nil {
+		return Group{}, false, err
+	}
+	externalID := strings.TrimSpace(in.ExternalID)
+
+	existing, err := s.findGroup(ctx, orgID, name, externalID)
+	if err != nil {
+		return Group{}, false, err
+	}
+	if existing != nil {
+		updated, err := s.applyGroup(ctx, orgID, existing.ID, name, externalID, memberIDs, false)
+		if err != nil {
+			return Group{}, false, err
+		}
+		return toGroup(updated), false, nil
+	}
+
+	created, err := s.enrollments.CreateGroup(ctx, enrollment.CreateGroupInput{
+		OrganizationID: orgID,
+		Name:           name,
+		ExternalID:     externalID,
+	})
+	if err != nil {
+		return Group{}, false, groupError(err)
+	}
+	if err := s.enrollments.SetGroupMembers(ctx, orgID, created.ID, memberIDs); err != nil {
+		return Group{}, false, groupError(err)
+	}
+	group, err := s.enrollments.GetGroup(ctx, orgID, created.ID)
+	if err != nil {
+		return Group{}, false, groupError(err)
+	}
+	return toGroup(group), true, nil
+}
+
+// ReplaceGroup remplace le nom, l'externalId et la liste des membres (PUT).
+func (s *Service) ReplaceGroup(ctx context.Context, orgID uuid.UUID, id string, in Group) (Group, error) {
+	existing, err := s.getGroup(ctx, orgID, id)
+	if err != nil {
+		return Group{}, err
+	}
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return Group{}, ErrInvalidValue
+	}
+	memberIDs, err := parseMembers(in.Members)
+	if err != nil {
+		return Group{}, err
+	}
+	updated, err := s.applyGroup(ctx, orgID, existing.ID, name, strings.TrimSpace(in.ExternalID), memberIDs, true)
+	if err != nil {
+		return Group{}, err
+	}
+	return toGroup(updated), nil
+}
+
+// PatchGroup applique les opérations PATCH : nom, externalId et ajout/retrait de membres.
+func (s *Service) PatchGroup(ctx context.Context, orgID uuid.UUID, id string, req PatchRequest) (Group, error) {
+	existing, err := s.getGroup(ctx, orgID, id)
+	if err != nil {
+		return Group{}, err
+	}
+	if len(req.Operations) == 0 {
+		return Group{}, ErrInvalidSyntax
+	}
+
+	update := enrollment.UpdateGroupInput{}
+	for _, op := range req.Operations {
+		name, err := operationName(op)
+		if err != nil {
+			return Group{}, err
+		}
+		path := strings.ToLower(strings.TrimSpace(op.Path))
+		if path == "" {
+			if name == opRemove {
+				return Group{}, ErrInvalidPath
+			}
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return Group{}, ErrInvalidValue
+			}
+			for attribute, value := range values {
+				if err := s.patchGroupAttribute(ctx, orgID, existing.ID, name, strings.ToLower(attribute), value, &update); err != nil {
+					return Group{}, err
+				}
+			}
+			continue
+		}
+		if err := s.patchGroupAttribute(ctx, orgID, existing.ID, name, path, op.Value, &update); err != nil {
+			return Group{}, err
+		}
+	}
+
+	if update.Name != nil || update.ExternalID != nil {
+		if _, err := s.enrollments.UpdateGroup(ctx, orgID, existing.ID, update); err != nil {
+			return Group{}, groupError(err)
+		}
+	}
+	group, err := s.enrollments.GetGroup(ctx, orgID, existing.ID)
+	if err != nil {
+		return Group{}, groupError(err)
+	}
+	return toGroup(group), nil
+}
+
+// DeleteGroup supprime le groupe ; les comptes membres ne sont pas modifiés.
+func (s *Service) DeleteGroup(ctx context.Context, orgID uuid.UUID, id string) error {
+	existing, err := s.getGroup(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.enrollments.DeleteGroup(ctx, orgID, existing.ID); err != nil {
+		return groupError(err)
+	}
+	return nil
+}
+
+func (s *Service) patchGroupAttribute(ctx context.Context, orgID, groupID uuid.UUID, op, path string, value any, update *enrollment.UpdateGroupInput) error {
+	switch {
+	case path == "displayname":
+		name, err := stringValue(value)
+		if err != nil || name == "" || op == opRemove {
+			return ErrInvalidValue
+		}
+		update.Name = &name
+	case path == "externalid":
+		externalID, err := stringValue(value)
+		if err != nil {
+			return err
+		}
+		if op == opRemove {
+			externalID = ""
+		}
+		update.ExternalID = &externalID
+	case path == "members":
+		var memberIDs []uuid.UUID
+		if value != nil {
+			members, err := memberValues(value)
+			if err != nil {
+				return err
+			}
+			if memberIDs, err = parseMembers(members); err != nil {
+				return err
+			}
+		}
+		var err error
+		switch {
+		case op == opAdd:
+			err = s.enrollments.AddGroupMembers(ctx, orgID, groupID, memberIDs)
+		case op == opReplace || (op == opRemove && value == nil):
+			err = s.enrollments.SetGroupMembers(ctx, orgID, groupID, memberIDs)
+		default:
+			err = s.enrollments.RemoveGroupMembers(ctx, orgID, groupID, memberIDs)
+		}
+		return groupError(err)
+	case strings.HasPrefix(path, "members["):
+		if op != opRemove {
+			return ErrInvalidPath
+		}
+		member, err := memberFilterValue(path)
+		if err != nil {
+			return err
+		}
+		memberIDs, err := parseMembers([]Member{{Value: member}})
+		if err != nil {
+			return err
+		}
+		return groupError(s.enrollments.RemoveGroupMembers(ctx, orgID, groupID, memberIDs))
+	}
+	return nil
+}
+
+func (s *Service) getGroup(ctx context.Context, orgID uuid.UUID, id string) (*ent.Group, error) {
+	groupID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	group, err := s.enrollments.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return group, nil
+}
+
+// findGroup retrouve le groupe à rattacher : par externalId, sinon par nom s'il n'en porte pas d'autre.
+func (s *Service) findGroup(ctx context.Context, orgID uuid.UUID, name, externalID string) (*ent.Group, error) {
+	if externalID != "" {
+		groups, err := s.enrollments.ListGroups(ctx, orgID, enrollment.GroupFilter{ExternalID: externalID})
+		if err != nil {
+			return nil, err
+		}
+		if len(groups) > 0 {
+			return groups[0], nil
+		}
+	}
+	groups, err := s.enrollments.ListGroups(ctx, orgID, enrollment.GroupFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(groups) == 0:
+		return nil, nil
+	case len(groups) > 1:
+		return nil, ErrUniqueness
+	case groups[0].ExternalID != nil && externalID != "" && *groups[0].ExternalID != externalID:
+		return nil, ErrUniqueness
+	default:
+		return groups[0], nil
+	}
+}
+
+// applyGroup persiste nom, externalId et membres. replace efface l'externalId absent.
+func (s *Service) applyGroup(ctx context.Context, orgID, groupID uuid.UUID, name, externalID string, memberIDs []uuid.UUID, replace bool) (*ent.Group, error) {
+	update := enrollment.UpdateGroupInput{Name: &name}
+	if externalID != "" || replace {
+		update.ExternalID = &externalID
+	}
+	if _, err := s.enrollments.UpdateGroup(ctx, orgID, groupID, update); err != nil {
+		return nil, groupError(err)
+	}
+	if err := s.enrollments.SetGroupMembers(ctx, orgID, groupID, memberIDs); err != nil {
+		return nil, groupError(err)
+	}
+	group, err := s.enrollments.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return nil, groupError(err)
+	}
+	return group, nil
+}
+
+func toGroup(g *ent.Group) Group {
+	resource := Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          g.ID.String(),
+		DisplayName: g.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      g.CreatedAt,
+			LastModified: g.UpdatedAt,
+		},
+	}
+	if g.ExternalID != nil {
+		resource.ExternalID = *g.ExternalID
+	}
+	for _, member := range g.Edges.Members {
+		resource.Members = append(resource.Members, Member{Value: member.ID.String(), Display: member.Email})
+	}
+	return resource
+}
+
+// memberValues décode la valeur d'une opération sur members : objet isolé ou liste d'objets.
+func memberValues(value any) ([]Member, error) {
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+	members := make([]Member, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			return nil, ErrInvalidValue
+		}
+		id, err := stringValue(entry["value"])
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, Member{Value: id})
+	}
+	return members, nil
+}
+
+func parseMembers(members []Member) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(strings.TrimSpace(member.Value))
+		if err != nil {
+			return nil, ErrInvalidValue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func groupError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, enrollment.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, enrollment.ErrGroupConflict):
+		return ErrUniqueness
+	case errors.Is(err, enrollment.ErrInvalidInput):
+		return ErrInvalidValue
+	default:
+		return err
+	}
+}
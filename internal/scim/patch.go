@@ -0,0 +1,202 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	opAdd     = "add"
+	opReplace = "replace"
+	opRemove  = "remove"
+)
+
+// operationName normalise op ; Azure AD envoie par exemple « Replace ».
+func operationName(op PatchOperation) (string, error) {
+	switch name := strings.ToLower(strings.TrimSpace(op.Op)); name {
+	case opAdd, opReplace, opRemove:
+		return name, nil
+	default:
+		return "", ErrInvalidSyntax
+	}
+}
+
+// applyUserOperation applique une opération PATCH à la représentation SCIM d'un utilisateur.
+// Les attributs non gérés par le LMS (adresses, extension entreprise…) sont ignorés.
+func applyUserOperation(resource *User, op PatchOperation) error {
+	name, err := operationName(op)
+	if err != nil {
+		return err
+	}
+	path := strings.TrimSpace(op.Path)
+	if path == "" {
+		if name == opRemove {
+			return ErrInvalidPath
+		}
+		values, ok := op.Value.(map[string]any)
+		if !ok {
+			return ErrInvalidValue
+		}
+		for attribute, value := range values {
+			if err := setUserAttribute(resource, attribute, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if name == opRemove {
+		return setUserAttribute(resource, path, nil)
+	}
+	return setUserAttribute(resource, path, op.Value)
+}
+
+// setUserAttribute modifie un attribut ; value nil correspond à une suppression.
+func setUserAttribute(resource *User, path string, value any) error {
+	path = strings.ToLower(strings.TrimPrefix(path, SchemaUser+":"))
+	switch {
+	case path == "username":
+		userName, err := stringValue(value)
+		if err != nil || userName == "" {
+			return ErrInvalidValue
+		}
+		resource.UserName = userName
+	case path == "externalid":
+		externalID, err := stringValue(value)
+		if err != nil {
+			return err
+		}
+		resource.ExternalID = externalID
+	case path == "displayname":
+		displayName, err := stringValue(value)
+		if err != nil {
+			return err
+		}
+		resource.DisplayName = displayName
+	case path == "active":
+		if value == nil {
+			return ErrInvalidValue
+		}
+		active, err := boolValue(value)
+		if err != nil {
+			return err
+		}
+		resource.Active = &active
+	case path == "name":
+		if value == nil {
+			resource.Name = nil
+			return nil
+		}
+		parts, ok := value.(map[string]any)
+		if !ok {
+			return ErrInvalidValue
+		}
+		for sub, v := range parts {
+			if err := setUserAttribute(resource, "name."+sub, v); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(path, "name."):
+		part, err := stringValue(value)
+		if err != nil {
+			return err
+		}
+		if resource.Name == nil {
+			resource.Name = &Name{}
+		}
+		switch strings.TrimPrefix(path, "name.") {
+		case "givenname":
+			resource.Name.GivenName = part
+		case "familyname":
+			resource.Name.FamilyName = part
+		case "formatted":
+			resource.Name.Formatted = part
+		}
+	case path == "emails" || strings.HasPrefix(path, "emails[") || strings.HasPrefix(path, "emails."):
+		email, err := emailValue(value)
+		if err != nil {
+			return err
+		}
+		resource.Emails = nil
+		if email != "" {
+			resource.Emails = []Email{{Value: email, Type: "work", Primary: true}}
+		}
+	}
+	return nil
+}
+
+func stringValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(v), nil
+	default:
+		return "", ErrInvalidValue
+	}
+}
+
+// boolValue accepte aussi les booléens transmis en chaîne (« False » chez Azure AD).
+func boolValue(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		parsed, err := strconv.ParseBool(strings.ToLower(strings.TrimSpace(v)))
+		if err != nil {
+			return false, ErrInvalidValue
+		}
+		return parsed, nil
+	default:
+		return false, ErrInvalidValue
+	}
+}
+
+// emailValue extrait l'adresse d'une valeur scalaire, d'un objet email ou d'une liste d'emails.
+func emailValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(v), nil
+	case map[string]any:
+		return stringValue(v["value"])
+	case []any:
+		var fallback string
+		for _, item := range v {
+			entry, ok := item.(map[string]any)
+			if !ok {
+				return "", ErrInvalidValue
+			}
+			email, err := stringValue(entry["value"])
+			if err != nil {
+				return "", err
+			}
+			if primary, _ := entry["primary"].(bool); primary {
+				return email, nil
+			}
+			if fallback == "" {
+				fallback = email
+			}
+		}
+		return fallback, nil
+	default:
+		return "", ErrInvalidValue
+	}
+}
+
+// memberFilterValue extrait l'identifiant d'un chemin « members[value eq "id"] ».
+func memberFilterValue(path string) (string, error) {
+	inner, ok := strings.CutPrefix(path, "members[")
+	if !ok {
+		return "", ErrInvalidPath
+	}
+	inner, ok = strings.CutSuffix(inner, "]")
+	if !ok {
+		return "", ErrInvalidPath
+	}
+	f, err := parseFilter(inner)
+	if err != nil || f == nil || f.Attribute != "value" {
+		return "", ErrInvalidPath
+	}
+	return f.Value, nil
+}
@@ -0,0 +1,307 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/user"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+type testEnv struct {
+	svc         *Service
+	client      *ent.Client
+	orgs        *organization.Service
+	users       *user.Service
+	enrollments *enrollment.Service
+	orgID       uuid.UUID
+}
+
+func newTestEnv(t *testing.T, name string) testEnv {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	orgs := organization.NewService(client)
+	org, err := orgs.Create(ctx, organization.CreateInput{Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+
+	users := user.NewService(client)
+	enrollments := enrollment.NewService(client)
+	return testEnv{
+		svc:         NewService(client, users, enrollments),
+		client:      client,
+		orgs:        orgs,
+		users:       users,
+		enrollments: enrollments,
+		orgID:       org.ID,
+	}
+}
+
+func TestService_Tokens(t *testing.T) {
+	env := newTestEnv(t, "scimtokens")
+	ctx := context.Background()
+
+	issued, err := env.svc.IssueToken(ctx, env.orgID, "Azure AD")
+	require.NoError(t, err)
+	require.Contains(t, issued.Token, tokenPrefix)
+	require.NotEqual(t, issued.Token, issued.Entity.TokenHash)
+
+	orgID, err := env.svc.Authenticate(ctx, issued.Token)
+	require.NoError(t, err)
+	require.Equal(t, env.orgID, orgID)
+
+	tokens, err := env.svc.ListTokens(ctx, env.orgID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.NotNil(t, tokens[0].LastUsedAt)
+
+	_, err = env.svc.Authenticate(ctx, "scim_forged")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	require.NoError(t, env.orgs.Archive(ctx, env.orgID))
+	_, err = env.svc.Authenticate(ctx, issued.Token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.NoError(t, env.orgs.Activate(ctx, env.orgID))
+
+	require.NoError(t, env.svc.RevokeToken(ctx, env.orgID, issued.Entity.ID))
+	_, err = env.svc.Authenticate(ctx, issued.Token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.ErrorIs(t, env.svc.RevokeToken(ctx, env.orgID, issued.Entity.ID), ErrNotFound)
+}
+
+func TestService_UserProvisioningIsIdempotent(t *testing.T) {
+	env := newTestEnv(t, "scimusers")
+	ctx := context.Background()
+
+	in := User{
+		Schemas:    []string{SchemaUser},
+		ExternalID: "00u1",
+		UserName:   "Ada@Acme.test",
+		Name:       &Name{GivenName: "Ada", FamilyName: "Lovelace"},
+	}
+	created, isNew, err := env.svc.CreateUser(ctx, env.orgID, in)
+	require.NoError(t, err)
+	require.True(t, isNew)
+	require.Equal(t, "ada@acme.test", created.UserName)
+	require.True(t, *created.Active)
+	require.Equal(t, "Ada", created.Name.GivenName)
+
+	// Nouvelle synchronisation : même externalId, pas de doublon.
+	in.Name.GivenName = "Augusta"
+	again, isNew, err := env.svc.CreateUser(ctx, env.orgID, in)
+	require.NoError(t, err)
+	require.False(t, isNew)
+	require.Equal(t, created.ID, again.ID)
+	require.Equal(t, "Augusta", again.Name.GivenName)
+
+	// Même email mais externalId divergent : conflit.
+	_, _, err = env.svc.CreateUser(ctx, env.orgID, User{ExternalID: "00u2", UserName: "ada@acme.test"})
+	require.ErrorIs(t, err, ErrUniqueness)
+
+	// Un compte local existant est rattaché à l'annuaire.
+	_, err = env.users.Create(ctx, user.CreateInput{OrganizationID: env.orgID, Email: "bob@acme.test", Password: "supersecret"})
+	require.NoError(t, err)
+	linked, isNew, err := env.svc.CreateUser(ctx, env.orgID, User{ExternalID: "00u3", UserName: "bob@acme.test"})
+	require.NoError(t, err)
+	require.False(t, isNew)
+	require.Equal(t, "00u3", linked.ExternalID)
+
+	list, err := env.svc.ListUsers(ctx, env.orgID, ListQuery{Filter: `userName eq "ADA@acme.test"`})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, created.ID, list.Resources[0].ID)
+
+	list, err = env.svc.ListUsers(ctx, env.orgID, ListQuery{Filter: `externalId eq "00u3"`})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalResults)
+
+	_, err = env.svc.ListUsers(ctx, env.orgID, ListQuery{Filter: `title co "x"`})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestService_UserPagination(t *testing.T) {
+	env := newTestEnv(t, "scimpaging")
+	ctx := context.Background()
+	for _, email := range []string{"a@acme.test", "b@acme.test", "c@acme.test"} {
+		_, _, err := env.svc.CreateUser(ctx, env.orgID, User{UserName: email})
+		require.NoError(t, err)
+	}
+
+	count := 2
+	page, err := env.svc.ListUsers(ctx, env.orgID, ListQuery{StartIndex: 2, Count: &count})
+	require.NoError(t, err)
+	require.Equal(t, 3, page.TotalResults)
+	require.Equal(t, 2, page.StartIndex)
+	require.Equal(t, 2, page.ItemsPerPage)
+
+	zero := 0
+	page, err = env.svc.ListUsers(ctx, env.orgID, ListQuery{Count: &zero})
+	require.NoError(t, err)
+	require.Equal(t, 3, page.TotalResults)
+	require.Empty(t, page.Resources)
+}
+
+func TestService_PatchAndDeleteUser(t *testing.T) {
+	env := newTestEnv(t, "scimpatch")
+	ctx := context.Background()
+	created, _, err := env.svc.CreateUser(ctx, env.orgID, User{ExternalID: "e1", UserName: "grace@acme.test"})
+	require.NoError(t, err)
+
+	// Forme envoyée par Azure AD : op capitalisée, booléen en chaîne.
+	patched, err := env.svc.PatchUser(ctx, env.orgID, created.ID, PatchRequest{Operations: []PatchOperation{
+		{Op: "Replace", Path: "active", Value: "False"},
+		{Op: "add", Path: "name.familyName", Value: "Hopper"},
+		{Op: "replace", Value: map[string]any{"displayName": "Grace H.", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department": "R&D"}},
+	}})
+	require.NoError(t, err)
+	require.False(t, *patched.Active)
+	require.Equal(t, "Hopper", patched.Name.FamilyName)
+	require.Equal(t, "Grace H.", patched.DisplayName)
+
+	_, err = env.svc.PatchUser(ctx, env.orgID, created.ID, PatchRequest{Operations: []PatchOperation{{Op: "move", Path: "active"}}})
+	require.ErrorIs(t, err, ErrInvalidSyntax)
+
+	_, err = env.svc.PatchUser(ctx, env.orgID, created.ID, PatchRequest{Operations: []PatchOperation{{Op: "replace", Path: "active", Value: true}}})
+	require.NoError(t, err)
+
+	require.NoError(t, env.svc.DeleteUser(ctx, env.orgID, created.ID))
+	fetched, err := env.svc.GetUser(ctx, env.orgID, created.ID)
+	require.NoError(t, err)
+	require.False(t, *fetched.Active)
+
+	_, err = env.svc.GetUser(ctx, env.orgID, uuid.NewString())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestService_GroupsMapToEnrollmentGroups(t *testing.T) {
+	env := newTestEnv(t, "scimgroups")
+	ctx := context.Background()
+
+	crs, err := course.NewService(env.client).Create(ctx, course.CreateCourseInput{OrganizationID: env.orgID, Title: "Onboarding", Slug: "onboarding"})
+	require.NoError(t, err)
+	// Groupe préparé par un administrateur et rattaché au cours.
+	prepared, err := env.enrollments.CreateGroup(ctx, enrollment.CreateGroupInput{OrganizationID: env.orgID, CourseID: &crs.ID, Name: "New hires"})
+	require.NoError(t, err)
+
+	ada, _, err := env.svc.CreateUser(ctx, env.orgID, User{UserName: "ada@acme.test"})
+	require.NoError(t, err)
+	bob, _, err := env.svc.CreateUser(ctx, env.orgID, User{UserName: "bob@acme.test"})
+	require.NoError(t, err)
+
+	group, isNew, err := env.svc.CreateGroup(ctx, env.orgID, Group{
+		ExternalID:  "g1",
+		DisplayName: "New hires",
+		Members:     []Member{{Value: ada.ID}},
+	})
+	require.NoError(t, err)
+	require.False(t, isNew)
+	require.Equal(t, prepared.ID.String(), group.ID)
+	require.Equal(t, "g1", group.ExternalID)
+	require.Len(t, group.Members, 1)
+
+	enrollments, err := env.enrollments.List(ctx, env.orgID, enrollment.EnrollmentFilter{GroupID: prepared.ID})
+	require.NoError(t, err)
+	require.Len(t, enrollments, 1)
+	require.Equal(t, enrollment.StatusActive, enrollments[0].Status)
+
+	group, err = env.svc.PatchGroup(ctx, env.orgID, group.ID, PatchRequest{Operations: []PatchOperation{
+		{Op: "add", Path: "members", Value: []any{map[string]any{"value": bob.ID}}},
+		{Op: "remove", Path: `members[value eq "` + ada.ID + `"]`},
+	}})
+	require.NoError(t, err)
+	require.Len(t, group.Members, 1)
+	require.Equal(t, bob.ID, group.Members[0].Value)
+
+	enrollments, err = env.enrollments.List(ctx, env.orgID, enrollment.EnrollmentFilter{GroupID: prepared.ID})
+	require.NoError(t, err)
+	statuses := map[string]string{}
+	for _, e := range enrollments {
+		statuses[e.UserID.String()] = e.Status
+	}
+	require.Equal(t, enrollment.StatusCancelled, statuses[ada.ID])
+	require.Equal(t, enrollment.StatusActive, statuses[bob.ID])
+
+	// Synchronisation répétée : même groupe, membres remplacés.
+	again, isNew, err := env.svc.CreateGroup(ctx, env.orgID, Group{ExternalID: "g1", DisplayName: "New hires", Members: []Member{{Value: ada.ID}, {Value: bob.ID}}})
+	require.NoError(t, err)
+	require.False(t, isNew)
+	require.Equal(t, group.ID, again.ID)
+	require.Len(t, again.Members, 2)
+
+	list, err := env.svc.ListGroups(ctx, env.orgID, ListQuery{Filter: `displayName eq "New hires"`, ExcludeMembers: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalResults)
+	require.Empty(t, list.Resources[0].Members)
+
+	other, isNew, err := env.svc.CreateGroup(ctx, env.orgID, Group{ExternalID: "g2", DisplayName: "Alumni"})
+	require.NoError(t, err)
+	require.True(t, isNew)
+	_, err = env.svc.PatchGroup(ctx, env.orgID, other.ID, PatchRequest{Operations: []PatchOperation{
+		{Op: "add", Path: "members", Value: []any{map[string]any{"value": "not-a-uuid"}}},
+	}})
+	require.ErrorIs(t, err, ErrInvalidValue)
+
+	require.NoError(t, env.svc.DeleteGroup(ctx, env.orgID, other.ID))
+	_, err = env.svc.GetGroup(ctx, env.orgID, other.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter(`userName eq "a b@acme.test"`)
+	require.NoError(t, err)
+	require.Equal(t, "username", f.Attribute)
+	require.Equal(t, "a b@acme.test", f.Value)
+
+	f, err = parseFilter("")
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	for _, raw := range []string{"userName", `userName sw "a"`, `userName eq unquoted`} {
+		_, err := parseFilter(raw)
+		require.ErrorIs(t, err, ErrInvalidFilter, raw)
+	}
+}
+
+func TestService_AuthenticateThrottlesLastUsed(t *testing.T) {
+	env := newTestEnv(t, "scimlastused")
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	env.svc.withNow(func() time.Time { return now })
+
+	issued, err := env.svc.IssueToken(ctx, env.orgID, "Okta")
+	require.NoError(t, err)
+	_, err = env.svc.Authenticate(ctx, issued.Token)
+	require.NoError(t, err)
+
+	now = now.Add(10 * time.Second)
+	_, err = env.svc.Authenticate(ctx, issued.Token)
+	require.NoError(t, err)
+	token, err := env.client.ScimToken.Get(ctx, issued.Entity.ID)
+	require.NoError(t, err)
+	require.True(t, token.LastUsedAt.Equal(now.Add(-10*time.Second)))
+}
@@ -0,0 +1,334 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/user"
+)
+
+const (
+	statusActive   = "active"
+	statusInactive = "inactive"
+	// metadataKey regroupe dans User.metadata les attributs SCIM sans équivalent natif.
+	metadataKey = "scim"
+)
+
+// ListUsers recherche les utilisateurs (filtres userName, emails.value, externalId, id).
+func (s *Service) ListUsers(ctx context.Context, orgID uuid.UUID, query ListQuery) (ListResponse[User], error) {
+	offset, limit := pagination(query)
+	resp := ListResponse[User]{
+		Schemas:    []string{SchemaListResponse},
+		StartIndex: offset + 1,
+		Resources:  []User{},
+	}
+
+	f, err := parseFilter(query.Filter)
+	if err != nil {
+		return resp, err
+	}
+	userFilter := user.Filter{}
+	if f != nil {
+		if f.Value == "" {
+			return resp, nil
+		}
+		switch f.Attribute {
+		case "username", "emails", "emails.value":
+			userFilter.Email = f.Value
+		case "externalid":
+			userFilter.ExternalID = f.Value
+		case "id":
+			found, err := s.GetUser(ctx, orgID, f.Value)
+			if errors.Is(err, ErrNotFound) {
+				return resp, nil
+			}
+			if err != nil {
+				return resp, err
+			}
+			resp.TotalResults = 1
+			if offset == 0 && limit > 0 {
+				resp.Resources = append(resp.Resources, found)
+				resp.ItemsPerPage = 1
+			}
+			return resp, nil
+		default:
+			return resp, ErrInvalidFilter
+		}
+	}
+
+	total, err := s.users.Count(ctx, orgID, userFilter)
+	if err != nil {
+		return resp, err
+	}
+	resp.TotalResults = total
+	if limit == 0 {
+		return resp, nil
+	}
+
+	userFilter.Offset = offset
+	userFilter.Limit = limit
+	users, err := s.users.List(ctx, orgID, userFilter)
+	if err != nil {
+		return resp, err
+	}
+	for _, u := range users {
+		resp.Resources = append(resp.Resources, toUser(u))
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp, nil
+}
+
+// GetUser renvoie un utilisateur par son identifiant LMS.
+func (s *Service) GetUser(ctx context.Context, orgID uuid.UUID, id string) (User, error) {
+	existing, err := s.getUser(ctx, orgID, id)
+	if err != nil {
+		return User{}, err
+	}
+	return toUser(existing), nil
+}
+
+// CreateUser provisionne un utilisateur. Si un compte porte déjà l'externalId, ou le même email
+// sans externalId divergent, il est rattaché et mis à jour : created vaut alors false.
+func (s *Service) CreateUser(ctx context.Context, orgID uuid.UUID, in User) (User, bool, error) {
+	email, err := resourceEmail(in)
+	if err != nil {
+		return User{}, false, err
+	}
+	externalID := strings.TrimSpace(in.ExternalID)
+
+	var existing *ent.User
+	if externalID != "" {
+		existing, err = s.users.GetByExternalID(ctx, orgID, externalID)
+		if err != nil && !errors.Is(err, user.ErrNotFound) {
+			return User{}, false, err
+		}
+	}
+	if existing == nil {
+		existing, err = s.users.GetByEmail(ctx, orgID, email)
+		if err != nil && !errors.Is(err, user.ErrNotFound) {
+			return User{}, false, err
+		}
+		if existing != nil && existing.ExternalID != nil && externalID != "" && *existing.ExternalID != externalID {
+			return User{}, false, ErrUniqueness
+		}
+	}
+	if existing != nil {
+		updated, err := s.applyUser(ctx, orgID, existing, in, false)
+		if err != nil {
+			return User{}, false, err
+		}
+		return toUser(updated), false, nil
+	}
+
+	password, err := randomPassword()
+	if err != nil {
+		return User{}, false, err
+	}
+	status := statusActive
+	if in.Active != nil && !*in.Active {
+		status = statusInactive
+	}
+	created, err := s.users.Create(ctx, user.CreateInput{
+		OrganizationID: orgID,
+		Email:          email,
+		Password:       password,
+		Status:         status,
+		ExternalID:     externalID,
+		Metadata:       userMetadata(nil, in),
+	})
+	if err != nil {
+		return User{}, false, userError(err)
+	}
+	return toUser(created), true, nil
+}
+
+// ReplaceUser remplace les attributs modifiables d'un utilisateur (PUT).
+func (s *Service) ReplaceUser(ctx context.Context, orgID uuid.UUID, id string, in User) (User, error) {
+	existing, err := s.getUser(ctx, orgID, id)
+	if err != nil {
+		return User{}, err
+	}
+	updated, err := s.applyUser(ctx, orgID, existing, in, true)
+	if err != nil {
+		return User{}, err
+	}
+	return toUser(updated), nil
+}
+
+// PatchUser applique les opérations PATCH à la représentation courante puis la persiste.
+func (s *Service) PatchUser(ctx context.Context, orgID uuid.UUID, id string, req PatchRequest) (User, error) {
+	existing, err := s.getUser(ctx, orgID, id)
+	if err != nil {
+		return User{}, err
+	}
+	if len(req.Operations) == 0 {
+		return User{}, ErrInvalidSyntax
+	}
+	resource := toUser(existing)
+	for _, op := range req.Operations {
+		if err := applyUserOperation(&resource, op); err != nil {
+			return User{}, err
+		}
+	}
+	updated, err := s.applyUser(ctx, orgID, existing, resource, true)
+	if err != nil {
+		return User{}, err
+	}
+	return toUser(updated), nil
+}
+
+// DeleteUser désactive l'utilisateur : l'historique (inscriptions, progression) est conservé.
+func (s *Service) DeleteUser(ctx context.Context, orgID uuid.UUID, id string) error {
+	existing, err := s.getUser(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.users.Deactivate(ctx, orgID, existing.ID); err != nil {
+		return userError(err)
+	}
+	return nil
+}
+
+func (s *Service) getUser(ctx context.Context, orgID uuid.UUID, id string) (*ent.User, error) {
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	existing, err := s.users.Get(ctx, orgID, userID)
+	if err != nil {
+		return nil, userError(err)
+	}
+	return existing, nil
+}
+
+// applyUser persiste la représentation SCIM sur l'utilisateur existant. replace efface
+// l'externalId absent de la représentation ; un rattachement (POST) le conserve.
+func (s *Service) applyUser(ctx context.Context, orgID uuid.UUID, existing *ent.User, in User, replace bool) (*ent.User, error) {
+	email, err := resourceEmail(in)
+	if err != nil {
+		return nil, err
+	}
+	input := user.UpdateInput{
+		Email:    &email,
+		Metadata: userMetadata(existing.Metadata, in),
+	}
+	if externalID := strings.TrimSpace(in.ExternalID); externalID != "" || replace {
+		input.ExternalID = &externalID
+	}
+	if in.Active != nil {
+		status := statusInactive
+		if *in.Active {
+			status = statusActive
+		}
+		input.Status = &status
+	}
+	updated, err := s.users.Update(ctx, orgID, existing.ID, input)
+	if err != nil {
+		return nil, userError(err)
+	}
+	return updated, nil
+}
+
+func toUser(u *ent.User) User {
+	active := u.Status == statusActive
+	resource := User{
+		Schemas:  []string{SchemaUser},
+		ID:       u.ID.String(),
+		UserName: u.Email,
+		Emails:   []Email{{Value: u.Email, Type: "work", Primary: true}},
+		Active:   &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      u.CreatedAt,
+			LastModified: u.UpdatedAt,
+		},
+	}
+	if u.ExternalID != nil {
+		resource.ExternalID = *u.ExternalID
+	}
+	if attrs, ok := u.Metadata[metadataKey].(map[string]any); ok {
+		name := Name{
+			Formatted:  stringAttr(attrs, "formatted"),
+			GivenName:  stringAttr(attrs, "given_name"),
+			FamilyName: stringAttr(attrs, "family_name"),
+		}
+		if name != (Name{}) {
+			resource.Name = &name
+		}
+		resource.DisplayName = stringAttr(attrs, "display_name")
+	}
+	return resource
+}
+
+// userMetadata copie les métadonnées existantes et y range le nom transmis par l'annuaire.
+func userMetadata(current map[string]any, in User) map[string]any {
+	out := make(map[string]any, len(current)+1)
+	for key, value := range current {
+		out[key] = value
+	}
+	attrs := map[string]any{}
+	if in.Name != nil {
+		setAttr(attrs, "formatted", in.Name.Formatted)
+		setAttr(attrs, "given_name", in.Name.GivenName)
+		setAttr(attrs, "family_name", in.Name.FamilyName)
+	}
+	setAttr(attrs, "display_name", in.DisplayName)
+	if len(attrs) == 0 {
+		delete(out, metadataKey)
+	} else {
+		out[metadataKey] = attrs
+	}
+	return out
+}
+
+// resourceEmail déduit l'email LMS : userName s'il en a la forme, sinon l'email principal.
+func resourceEmail(in User) (string, error) {
+	if userName := strings.TrimSpace(in.UserName); strings.Contains(userName, "@") {
+		return strings.ToLower(userName), nil
+	}
+	var fallback string
+	for _, email := range in.Emails {
+		value := strings.TrimSpace(email.Value)
+		if value == "" {
+			continue
+		}
+		if email.Primary {
+			return strings.ToLower(value), nil
+		}
+		if fallback == "" {
+			fallback = value
+		}
+	}
+	if fallback == "" {
+		return "", ErrInvalidValue
+	}
+	return strings.ToLower(fallback), nil
+}
+
+func userError(err error) error {
+	switch {
+	case errors.Is(err, user.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, user.ErrEmailAlreadyUsed):
+		return ErrUniqueness
+	case errors.Is(err, user.ErrInvalidInput):
+		return ErrInvalidValue
+	default:
+		return err
+	}
+}
+
+func stringAttr(attrs map[string]any, key string) string {
+	value, _ := attrs[key].(string)
+	return value
+}
+
+func setAttr(attrs map[string]any, key, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		attrs[key] = value
+	}
+}
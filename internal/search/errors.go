@@ -0,0 +1,5 @@
+package search
+
+import "errors"
+
+var ErrInvalidInput = errors.New("search: invalid input")
@@ -0,0 +1,255 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"golang.org/x/text/unicode/norm"
+
+	"lms-go/internal/ent"
+)
+
+// Pondération du repli : un terme trouvé dans le titre pèse plus que dans le corps.
+const (
+	titleWeight   = 1.0
+	bodyWeight    = 0.4
+	snippetRadius = 12
+)
+
+// fallbackEngine sert les bases sans recherche plein texte (SQLite en développement et en
+// test) : la base présélectionne les documents contenant chaque terme (LIKE), puis le
+// classement et les extraits sont calculés en Go, sans tenir compte des accents ni de la
+// casse. Il n'y a pas de racinisation ; un terme trouve les mots qui le contiennent.
+type fallbackEngine struct{}
+
+func (fallbackEngine) search(ctx context.Context, client *ent.Client, selector *entsql.Selector, doc document, q Query, limit int) ([]Result, error) {
+	words, terms := tokenize(q.Text)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	selectColumns(selector, doc)
+	selector.AppendSelect(selector.C(doc.body.column))
+	// La présélection compare les mots tels que saisis : LIKE ne sait pas ignorer les accents.
+	for _, word := range words {
+		selector.Where(entsql.Or(
+			entsql.ContainsFold(selector.C(doc.title), word),
+			entsql.ContainsFold(selector.C(doc.body.column), word),
+		))
+	}
+	selector.OrderBy(entsql.Desc(selector.C("updated_at"))).Limit(fallbackCandidatesLimit)
+
+	query, args := selector.Query()
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search: %s: %w", doc.kind, err)
+	}
+	defer rows.Close()
+	var results []Result
+	for rows.Next() {
+		var raw sql.NullString
+		result, err := scanRow(rows, doc, &raw)
+		if err != nil {
+			return nil, err
+		}
+		body := extractBody(doc.body, raw.String)
+		rank, ok := score(terms, result.Title, body)
+		if !ok {
+			continue
+		}
+		result.Rank = rank
+		result.Snippet = snippet(terms, body)
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Rank > results[j].Rank })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// tokenize découpe la saisie en mots (en minuscules) et en termes repliés, sans doublon ; les
+// mots d'une lettre sont ignorés.
+func tokenize(text string) (words, terms []string) {
+	seen := map[string]bool{}
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		term := string(fold([]rune(word)))
+		if utf8.RuneCountInString(term) < 2 || seen[term] {
+			continue
+		}
+		seen[term] = true
+		words = append(words, strings.ToLower(word))
+		terms = append(terms, term)
+	}
+	return words, terms
+}
+
+// fold met chaque rune en minuscule et retire ses accents, rune à rune, afin que les positions
+// du texte replié correspondent à celles du texte d'origine.
+func fold(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		r = unicode.ToLower(r)
+		if r >= utf8.RuneSelf {
+			if base := []rune(norm.NFD.String(string(r))); len(base) > 0 {
+				r = base[0]
+			}
+		}
+		folded[i] = r
+	}
+	return folded
+}
+
+// extractBody renvoie le texte secondaire : la clé du JSON, toutes ses valeurs texte, ou la colonne telle quelle.
+func extractBody(body bodyColumn, raw string) string {
+	if !body.json {
+		return raw
+	}
+	var data any
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return ""
+	}
+	if body.key != "" {
+		object, _ := data.(map[string]any)
+		text, _ := object[body.key].(string)
+		return text
+	}
+	var values []string
+	collectStrings(data, &values)
+	return strings.Join(values, " ")
+}
+
+func collectStrings(value any, out *[]string) {
+	switch v := value.(type) {
+	case string:
+		*out = append(*out, v)
+	case []any:
+		for _, item := range v {
+			collectStrings(item, out)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			collectStrings(v[key], out)
+		}
+	}
+}
+
+// score exige que chaque terme apparaisse dans le titre ou le corps et renvoie un rang dans ]0, 1[.
+func score(terms []string, title, body string) (float64, bool) {
+	foldedTitle := string(fold([]rune(title)))
+	foldedBody := string(fold([]rune(body)))
+	var total float64
+	for _, term := range terms {
+		inTitle := strings.Count(foldedTitle, term)
+		inBody := strings.Count(foldedBody, term)
+		if inTitle == 0 && inBody == 0 {
+			return 0, false
+		}
+		total += titleWeight*float64(inTitle) + bodyWeight*float64(inBody)
+	}
+	return total / (total + 1), true
+}
+
+// snippet renvoie les mots entourant la première occurrence d'un terme, échappés, avec les
+// occurrences surlignées.
+func snippet(terms []string, body string) string {
+	runes := []rune(body)
+	folded := fold(runes)
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		needle := []rune(term)
+		for i := 0; i+len(needle) <= len(folded); i++ {
+			if string(folded[i:i+len(needle)]) != term {
+				continue
+			}
+			for j := i; j < i+len(needle); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	words := wordSpans(runes)
+	if len(words) == 0 {
+		return ""
+	}
+	center := 0
+	for i, w := range words {
+		if first >= w[0] && first < w[1] {
+			center = i
+			break
+		}
+	}
+	from, to := max(center-snippetRadius, 0), min(center+snippetRadius+1, len(words))
+	start, end := words[from][0], words[to-1][1]
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("… ")
+	}
+	open := false
+	for i := start; i < end; i++ {
+		if marked[i] != open {
+			if open {
+				b.WriteString(markStop)
+			} else {
+				b.WriteString(markStart)
+			}
+			open = marked[i]
+		}
+		b.WriteRune(runes[i])
+	}
+	if open {
+		b.WriteString(markStop)
+	}
+	if to < len(words) {
+		b.WriteString(" …")
+	}
+	return highlight(b.String())
+}
+
+// wordSpans renvoie les bornes [début, fin) des mots du texte.
+func wordSpans(runes []rune) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(runes)})
+	}
+	return spans
+}
+
+// highlight échappe l'extrait et remplace les délimiteurs par des balises <mark>.
+func highlight(text string) string {
+	text = html.EscapeString(strings.TrimSpace(text))
+	return strings.NewReplacer(markStart, "<mark>", markStop, "</mark>").Replace(text)
+}
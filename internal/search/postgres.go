@@ -0,0 +1,156 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+)
+
+// Délimiteurs des termes surlignés par ts_headline, convertis en <mark> après échappement.
+const (
+	markStart = "\x02"
+	markStop  = "\x03"
+)
+
+var headlineOptions = "StartSel=" + markStart + ", StopSel=" + markStop +
+	`, MaxWords=35, MinWords=15, ShortWord=2, MaxFragments=2, FragmentDelimiter=" … "`
+
+// postgresEngine classe les documents avec ts_rank sur un tsvector pondéré (titre A, corps B),
+// calculé pour chaque langue. Les expressions sont identiques à celles des index GIN de la
+// migration search_indexes, afin que le planificateur les utilise.
+type postgresEngine struct{}
+
+func (postgresEngine) search(ctx context.Context, client *ent.Client, selector *entsql.Selector, doc document, q Query, limit int) ([]Result, error) {
+	languages := []string{LanguageFrench, LanguageEnglish}
+	if q.Language != "" {
+		languages = []string{q.Language}
+	}
+	column := selector.C
+
+	selectColumns(selector, doc)
+	selector.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+		if len(languages) > 1 {
+			b.WriteString("GREATEST(")
+		}
+		for i, lang := range languages {
+			if i > 0 {
+				b.Comma()
+			}
+			b.WriteString("ts_rank(").WriteString(vectorExpr(doc, lang, column)).Comma()
+			tsquery(b, lang, q.Text)
+			b.WriteString(")")
+		}
+		if len(languages) > 1 {
+			b.WriteString(")")
+		}
+	}), "rank")
+	// Seules les lignes trouvées sont renvoyées : la dernière langue sert de cas par défaut.
+	selector.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+		b.WriteString("CASE")
+		for i, lang := range languages {
+			if i < len(languages)-1 {
+				b.WriteString(" WHEN ")
+				match(b, doc, lang, q.Text, column)
+				b.WriteString(" THEN ")
+			} else {
+				b.WriteString(" ELSE ")
+			}
+			b.WriteString(fmt.Sprintf("ts_headline('%s'::regconfig, %s, ", lang, bodyText(doc, column)))
+			tsquery(b, lang, q.Text)
+			b.Comma().Arg(headlineOptions).WriteString(")")
+		}
+		b.WriteString(" END")
+	}), "snippet")
+
+	selector.Where(entsql.P(func(b *entsql.Builder) {
+		b.WriteString("(")
+		for i, lang := range languages {
+			if i > 0 {
+				b.WriteString(" OR ")
+			}
+			match(b, doc, lang, q.Text, column)
+		}
+		b.WriteString(")")
+	}))
+	selector.OrderBy(entsql.Desc("rank")).Limit(limit)
+
+	query, args := selector.Query()
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search: %s: %w", doc.kind, err)
+	}
+	defer rows.Close()
+	var results []Result
+	for rows.Next() {
+		var (
+			rank    float64
+			snippet string
+		)
+		result, err := scanRow(rows, doc, &rank, &snippet)
+		if err != nil {
+			return nil, err
+		}
+		result.Rank = rank
+		result.Snippet = highlight(snippet)
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// indexStatements renvoie la création des index GIN des expressions tsvector. Ils sont livrés
+// par la migration search_indexes ; le test vérifie que les deux restent identiques.
+func indexStatements() []string {
+	ident := func(column string) string { return `"` + column + `"` }
+	var stmts []string
+	for _, doc := range documents {
+		for _, lang := range []string{LanguageFrench, LanguageEnglish} {
+			stmts = append(stmts, fmt.Sprintf(
+				`CREATE INDEX IF NOT EXISTS "search_%s_%s" ON "%s" USING GIN ((%s))`,
+				doc.table, lang, doc.table, vectorExpr(doc, lang, ident),
+			))
+		}
+	}
+	return stmts
+}
+
+// vectorExpr renvoie le tsvector pondéré d'un document ; column qualifie les noms de colonnes.
+func vectorExpr(doc document, lang string, column func(string) string) string {
+	title := fmt.Sprintf("setweight(to_tsvector('%s'::regconfig, COALESCE(%s, '')), 'A')", lang, column(doc.title))
+	var body string
+	switch {
+	case doc.body.json && doc.body.key != "":
+		body = fmt.Sprintf("to_tsvector('%s'::regconfig, COALESCE(%s ->> '%s', ''))", lang, column(doc.body.column), doc.body.key)
+	case doc.body.json:
+		body = fmt.Sprintf(`jsonb_to_tsvector('%s'::regconfig, COALESCE(%s, '{}'::jsonb), '["string"]')`, lang, column(doc.body.column))
+	default:
+		body = fmt.Sprintf("to_tsvector('%s'::regconfig, COALESCE(%s, ''))", lang, column(doc.body.column))
+	}
+	return fmt.Sprintf("(%s || setweight(%s, 'B'))", title, body)
+}
+
+// bodyText renvoie le texte dont est extrait l'extrait surligné.
+func bodyText(doc document, column func(string) string) string {
+	col := column(doc.body.column)
+	switch {
+	case doc.body.json && doc.body.key != "":
+		return fmt.Sprintf("COALESCE(%s ->> '%s', '')", col, doc.body.key)
+	case doc.body.json:
+		return fmt.Sprintf("CASE WHEN jsonb_typeof(%s) = 'object' THEN COALESCE((SELECT string_agg(value, ' ') FROM jsonb_each_text(%s)), '') ELSE '' END", col, col)
+	default:
+		return fmt.Sprintf("COALESCE(%s, '')", col)
+	}
+}
+
+func match(b *entsql.Builder, doc document, lang, text string, column func(string) string) {
+	b.WriteString(vectorExpr(doc, lang, column)).WriteString(" @@ ")
+	tsquery(b, lang, text)
+}
+
+// tsquery interprète la saisie avec la syntaxe websearch (guillemets, OR, exclusion par -).
+func tsquery(b *entsql.Builder, lang, text string) {
+	b.WriteString(fmt.Sprintf("websearch_to_tsquery('%s'::regconfig, ", lang))
+	b.Arg(text).WriteString(")")
+}
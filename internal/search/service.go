@@ -0,0 +1,300 @@
+// Package search fournit la recherche plein texte sur les cours, modules (titre et corps des
+// articles) et contenus d'une organisation. Sous PostgreSQL, elle s'appuie sur tsvector avec la
+// racinisation française et anglaise ; les autres bases utilisent une implémentation de repli.
+package search
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entcontent "lms-go/internal/ent/content"
+	entcourse "lms-go/internal/ent/course"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entmodule "lms-go/internal/ent/module"
+)
+
+// Types de documents indexés.
+const (
+	KindCourse  = "course"
+	KindModule  = "module"
+	KindContent = "content"
+)
+
+// Langues de racinisation (configurations text search PostgreSQL).
+const (
+	LanguageFrench  = "french"
+	LanguageEnglish = "english"
+)
+
+// Statuts visibles des utilisateurs restreints (voir les packages course, content et enrollment).
+const (
+	courseStatusPublished   = "published"
+	moduleStatusActive      = "active"
+	contentStatusAvailable  = "available"
+	enrollmentStatusActive  = "active"
+	defaultLimit            = 20
+	maxLimit                = 100
+	maxQueryLength          = 200
+	fallbackCandidatesLimit = 500
+)
+
+// Kinds liste les types de documents dans l'ordre de présentation.
+var Kinds = []string{KindCourse, KindModule, KindContent}
+
+// Config paramètre le moteur ; Dialect vaut dialect.Postgres pour activer tsvector.
+type Config struct {
+	Dialect string
+}
+
+type Service struct {
+	client  *ent.Client
+	dialect string
+	engine  engine
+}
+
+func NewService(client *ent.Client, cfg Config) *Service {
+	s := &Service{client: client, dialect: cfg.Dialect}
+	if s.dialect == "" {
+		s.dialect = dialect.SQLite
+	}
+	if s.dialect == dialect.Postgres {
+		s.engine = postgresEngine{}
+	} else {
+		s.engine = fallbackEngine{}
+	}
+	return s
+}
+
+// Query décrit une recherche. Kinds et Status filtrent les résultats ; Language restreint la
+// racinisation à une langue (les deux par défaut).
+type Query struct {
+	OrganizationID uuid.UUID
+	Text           string
+	Kinds          []string
+	Status         string
+	Language       string
+	Limit          int
+	Offset         int
+	// Restricted limite les résultats aux cours publiés, et aux modules actifs et contenus
+	// disponibles des cours où UserID a une inscription active.
+	Restricted bool
+	UserID     uuid.UUID
+}
+
+// Result est un document trouvé. Snippet est un extrait HTML échappé où les termes trouvés
+// sont entourés de <mark>.
+type Result struct {
+	Kind string
+	ID   uuid.UUID
+	// CourseID est le cours parent d'un module.
+	CourseID  *uuid.UUID
+	Title     string
+	Status    string
+	Snippet   string
+	Rank      float64
+	UpdatedAt time.Time
+}
+
+// Page regroupe une page de résultats triés par pertinence décroissante ; Limit et Offset sont
+// ceux appliqués après normalisation.
+type Page struct {
+	Results []Result
+	Limit   int
+	Offset  int
+	HasMore bool
+}
+
+// Search renvoie les documents de l'organisation qui correspondent à la requête.
+func (s *Service) Search(ctx context.Context, q Query) (*Page, error) {
+	q, err := normalize(q)
+	if err != nil {
+		return nil, err
+	}
+	if q.Restricted && q.UserID == uuid.Nil {
+		return &Page{Limit: q.Limit, Offset: q.Offset}, nil
+	}
+	// Chaque type fournit ses meilleurs résultats jusqu'à la fin de la page demandée, plus un
+	// pour savoir s'il en reste.
+	window := q.Offset + q.Limit + 1
+	var results []Result
+	for _, doc := range documents {
+		if !slices.Contains(q.Kinds, doc.kind) {
+			continue
+		}
+		selector := entsql.Dialect(s.dialect).Select().From(entsql.Table(doc.table))
+		s.scope(selector, doc, q)
+		found, err := s.engine.search(ctx, s.client, selector, doc, q, window)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, found...)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].UpdatedAt.After(results[j].UpdatedAt)
+	})
+
+	page := &Page{Limit: q.Limit, Offset: q.Offset, HasMore: len(results) > q.Offset+q.Limit}
+	if q.Offset < len(results) {
+		results = results[q.Offset:]
+		if len(results) > q.Limit {
+			results = results[:q.Limit]
+		}
+		page.Results = results
+	}
+	return page, nil
+}
+
+func normalize(q Query) (Query, error) {
+	q.Text = strings.TrimSpace(q.Text)
+	if q.OrganizationID == uuid.Nil || q.Text == "" || utf8.RuneCountInString(q.Text) > maxQueryLength {
+		return q, ErrInvalidInput
+	}
+	if len(q.Kinds) == 0 {
+		q.Kinds = Kinds
+	}
+	for _, kind := range q.Kinds {
+		if !slices.Contains(Kinds, kind) {
+			return q, ErrInvalidInput
+		}
+	}
+	switch q.Language {
+	case "", LanguageFrench, LanguageEnglish:
+	default:
+		return q, ErrInvalidInput
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultLimit
+	}
+	if q.Limit > maxLimit {
+		q.Limit = maxLimit
+	}
+	if q.Offset < 0 {
+		return q, ErrInvalidInput
+	}
+	q.Status = strings.TrimSpace(q.Status)
+	return q, nil
+}
+
+// scope applique au sélecteur l'organisation, le filtre de statut et les droits de l'appelant.
+func (s *Service) scope(selector *entsql.Selector, doc document, q Query) {
+	if q.Status != "" {
+		selector.Where(entsql.EQ(selector.C(doc.status), q.Status))
+	}
+	enrolled := entcourse.HasEnrollmentsWith(
+		entenrollment.UserIDEQ(q.UserID),
+		entenrollment.StatusEQ(enrollmentStatusActive),
+	)
+	switch doc.kind {
+	case KindCourse:
+		entcourse.OrganizationIDEQ(q.OrganizationID)(selector)
+		if q.Restricted {
+			entcourse.StatusEQ(courseStatusPublished)(selector)
+		}
+	case KindModule:
+		if q.Restricted {
+			entmodule.StatusEQ(moduleStatusActive)(selector)
+			entmodule.HasCourseWith(
+				entcourse.OrganizationIDEQ(q.OrganizationID),
+				entcourse.StatusEQ(courseStatusPublished),
+				enrolled,
+			)(selector)
+		} else {
+			entmodule.HasCourseWith(entcourse.OrganizationIDEQ(q.OrganizationID))(selector)
+		}
+	case KindContent:
+		entcontent.OrganizationIDEQ(q.OrganizationID)(selector)
+		if q.Restricted {
+			entcontent.StatusEQ(contentStatusAvailable)(selector)
+			entcontent.HasModulesWith(
+				entmodule.StatusEQ(moduleStatusActive),
+				entmodule.HasCourseWith(entcourse.StatusEQ(courseStatusPublished), enrolled),
+			)(selector)
+		}
+	}
+}
+
+// document décrit les colonnes indexées d'un type : le titre pèse plus que le corps.
+type document struct {
+	kind   string
+	table  string
+	title  string
+	status string
+	// course est la colonne portant le cours parent, vide hors modules.
+	course string
+	body   bodyColumn
+}
+
+// bodyColumn décrit le texte secondaire : une colonne texte, la clé body d'une colonne JSON,
+// ou toutes les valeurs texte d'une colonne JSON.
+type bodyColumn struct {
+	column string
+	key    string
+	json   bool
+}
+
+var documents = []document{
+	{
+		kind: KindCourse, table: entcourse.Table, title: entcourse.FieldTitle, status: entcourse.FieldStatus,
+		body: bodyColumn{column: entcourse.FieldDescription},
+	},
+	{
+		kind: KindModule, table: entmodule.Table, title: entmodule.FieldTitle, status: entmodule.FieldStatus,
+		course: entmodule.FieldCourseID, body: bodyColumn{column: entmodule.FieldData, key: "body", json: true},
+	},
+	{
+		kind: KindContent, table: entcontent.Table, title: entcontent.FieldName, status: entcontent.FieldStatus,
+		body: bodyColumn{column: entcontent.FieldMetadata, json: true},
+	},
+}
+
+// engine exécute la recherche d'un type de document sur un sélecteur déjà restreint aux droits.
+type engine interface {
+	search(ctx context.Context, client *ent.Client, selector *entsql.Selector, doc document, q Query, limit int) ([]Result, error)
+}
+
+// scanRow lit les colonnes communes sélectionnées par les moteurs : id, titre, statut, cours
+// parent (NULL hors modules) et date de mise à jour, suivies de extra.
+func scanRow(rows *sql.Rows, doc document, extra ...any) (Result, error) {
+	var (
+		id       uuid.UUID
+		courseID uuid.NullUUID
+		result   = Result{Kind: doc.kind}
+	)
+	dest := append([]any{&id, &result.Title, &result.Status, &courseID, &result.UpdatedAt}, extra...)
+	if err := rows.Scan(dest...); err != nil {
+		return result, err
+	}
+	result.ID = id
+	if courseID.Valid {
+		result.CourseID = &courseID.UUID
+	}
+	return result, nil
+}
+
+// selectColumns ajoute au sélecteur les colonnes lues par scanRow.
+func selectColumns(selector *entsql.Selector, doc document) {
+	selector.Select(
+		selector.C("id"),
+		selector.C(doc.title),
+		selector.C(doc.status),
+	)
+	if doc.course != "" {
+		selector.AppendSelect(selector.C(doc.course))
+	} else {
+		selector.AppendSelectExpr(entsql.Expr("NULL"))
+	}
+	selector.AppendSelect(selector.C("updated_at"))
+}
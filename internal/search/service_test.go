@@ -0,0 +1,148 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/platform/database"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func newSearchService(t *testing.T) (*Service, *ent.Client, uuid.UUID) {
+	db, err := sql.Open("sqlite", "file:searchsvc?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	org, err := client.Organization.Create().SetName("Org").SetSlug("org").Save(ctx)
+	require.NoError(t, err)
+	return NewService(client, Config{}), client, org.ID
+}
+
+func TestService_Search(t *testing.T) {
+	svc, client, orgID := newSearchService(t)
+	ctx := context.Background()
+
+	other, err := client.Organization.Create().SetName("Other").SetSlug("other").Save(ctx)
+	require.NoError(t, err)
+	published, err := client.Course.Create().SetOrganizationID(orgID).SetTitle("Sécurité informatique").
+		SetSlug("securite").SetDescription("Les bases de la sécurité pour tous les élèves.").SetStatus("published").Save(ctx)
+	require.NoError(t, err)
+	draft, err := client.Course.Create().SetOrganizationID(orgID).SetTitle("Brouillon").
+		SetSlug("brouillon").SetDescription("Notions de sécurité avancées").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Course.Create().SetOrganizationID(other.ID).SetTitle("Sécurité").SetSlug("securite").SetStatus("published").Save(ctx)
+	require.NoError(t, err)
+	guide, err := client.Content.Create().SetOrganizationID(orgID).SetName("guide.pdf").SetMimeType("application/pdf").
+		SetStorageKey("org/guide.pdf").SetStatus("available").SetMetadata(map[string]any{"tags": []any{"securite", "réseau"}}).Save(ctx)
+	require.NoError(t, err)
+	article, err := client.Module.Create().SetCourseID(published.ID).SetTitle("Mots de passe").SetModuleType("article").
+		SetData(map[string]any{"body": "Choisir un mot de passe robuste est la première règle de <b>sécurité</b>."}).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Module.Create().SetCourseID(published.ID).SetContentID(guide.ID).SetTitle("Guide").SetModuleType("pdf").SetPosition(1).Save(ctx)
+	require.NoError(t, err)
+	learner, err := client.User.Create().SetOrganizationID(orgID).SetEmail("learner@example.com").SetPasswordHash("x").Save(ctx)
+	require.NoError(t, err)
+
+	_, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "  "})
+	require.ErrorIs(t, err, ErrInvalidInput)
+	_, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "x", Kinds: []string{"user"}})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	page, err := svc.Search(ctx, Query{OrganizationID: orgID, Text: "sécurité"})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 3)
+	require.Equal(t, published.ID, page.Results[0].ID, "le titre pèse plus que le corps")
+	ids := []uuid.UUID{page.Results[1].ID, page.Results[2].ID}
+	require.ElementsMatch(t, []uuid.UUID{draft.ID, article.ID}, ids)
+	for _, r := range page.Results {
+		if r.ID == article.ID {
+			require.Equal(t, KindModule, r.Kind)
+			require.Equal(t, &published.ID, r.CourseID)
+			require.Contains(t, r.Snippet, "&lt;b&gt;<mark>sécurité</mark>&lt;/b&gt;")
+		}
+	}
+
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "eleves securite", Kinds: []string{KindCourse}})
+	require.NoError(t, err)
+	require.Empty(t, page.Results, "LIKE ne replie pas les accents en présélection")
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "Élèves sécurité", Kinds: []string{KindCourse}})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.True(t, strings.Contains(page.Results[0].Snippet, "<mark>élèves</mark>"))
+
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "réseau"})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.Equal(t, guide.ID, page.Results[0].ID)
+	require.Equal(t, "securite <mark>réseau</mark>", page.Results[0].Snippet)
+
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "sécurité", Status: "draft"})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.Equal(t, draft.ID, page.Results[0].ID)
+
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "sécurité", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 2)
+	require.True(t, page.HasMore)
+	page, err = svc.Search(ctx, Query{OrganizationID: orgID, Text: "sécurité", Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.False(t, page.HasMore)
+
+	// Un apprenant ne voit que le catalogue publié, puis les modules et contenus de ses inscriptions.
+	restricted := Query{OrganizationID: orgID, Text: "sécurité", Restricted: true, UserID: learner.ID}
+	page, err = svc.Search(ctx, restricted)
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.Equal(t, published.ID, page.Results[0].ID)
+	restricted.Text = "réseau"
+	page, err = svc.Search(ctx, restricted)
+	require.NoError(t, err)
+	require.Empty(t, page.Results)
+
+	_, err = client.Enrollment.Create().SetOrganizationID(orgID).SetCourseID(published.ID).SetUserID(learner.ID).SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	restricted.Text = "sécurité"
+	page, err = svc.Search(ctx, restricted)
+	require.NoError(t, err)
+	require.Len(t, page.Results, 2)
+	restricted.Text = "réseau"
+	page, err = svc.Search(ctx, restricted)
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	require.Equal(t, KindContent, page.Results[0].Kind)
+}
+
+func TestIndexStatements(t *testing.T) {
+	stmts := indexStatements()
+	require.Len(t, stmts, len(documents)*2)
+	migration, err := fs.ReadFile(database.Migrations(), "20261018180500_search_indexes.up.sql")
+	require.NoError(t, err)
+	for _, stmt := range stmts {
+		require.Contains(t, string(migration), stmt+";\n", "la migration doit reprendre les expressions de recherche")
+	}
+	require.Equal(t,
+		`CREATE INDEX IF NOT EXISTS "search_modules_french" ON "modules" USING GIN ((`+
+			`(setweight(to_tsvector('french'::regconfig, COALESCE("title", '')), 'A') || `+
+			`setweight(to_tsvector('french'::regconfig, COALESCE("data" ->> 'body', '')), 'B'))))`,
+		stmts[2])
+}
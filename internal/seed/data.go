@@ -0,0 +1,174 @@
+package seed
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+var companies = []string{
+	"Atelier Lumière", "Boulangeries Martin", "Clinique des Alpes", "Transports Vega",
+	"Horizon Assurances", "Librairie du Port", "Nova Énergie", "Groupe Océane",
+	"Pharmacie Centrale", "Studio Pixel", "Banque Régionale de l'Ouest", "Hôtels Azur",
+}
+
+var firstNames = []string{
+	"Camille", "Louis", "Léa", "Hugo", "Chloé", "Lucas", "Manon", "Nathan", "Inès", "Jules",
+	"Sarah", "Adam", "Emma", "Gabriel", "Zoé", "Raphaël", "Alice", "Arthur", "Jade", "Théo",
+	"Yasmine", "Karim", "Mei", "Tomás", "Aïcha", "Noah", "Élise", "Samuel", "Nora", "Malik",
+}
+
+var lastNames = []string{
+	"Martin", "Bernard", "Dubois", "Thomas", "Robert", "Richard", "Petit", "Durand", "Leroy",
+	"Moreau", "Simon", "Laurent", "Lefebvre", "Michel", "Garcia", "David", "Bertrand", "Roux",
+	"Vincent", "Fournier", "Nguyen", "Benali", "Morel", "Girard", "Diallo", "Mercier",
+}
+
+var topics = []string{
+	"Sécurité au travail", "Cybersécurité : les bons réflexes", "Accueil des nouveaux arrivants",
+	"Management d'équipe", "Prise de parole en public", "Protection des données (RGPD)",
+	"Gestion de projet agile", "Relation client", "Excel avancé", "Prévention du harcèlement",
+	"Éco-conduite", "Premiers secours", "Négociation commerciale", "Qualité et amélioration continue",
+	"Télétravail efficace", "Lutte contre la corruption",
+}
+
+var moduleTitles = map[string][]string{
+	"article": {"Introduction", "Les fondamentaux", "Cas pratiques", "À retenir", "Pour aller plus loin"},
+	"video":   {"Présentation en vidéo", "Démonstration", "Témoignages", "Mise en situation"},
+	"quiz":    {"Quiz de validation", "Évaluation intermédiaire", "Testez vos connaissances"},
+	"pdf":     {"Guide de référence", "Fiche mémo", "Procédure détaillée"},
+	"scorm":   {"Module interactif", "Simulation", "Parcours e-learning"},
+}
+
+var sentences = []string{
+	"Ce module présente les notions essentielles à connaître avant de commencer.",
+	"Chaque situation est illustrée par un exemple tiré du terrain.",
+	"Les erreurs les plus fréquentes sont détaillées, avec la bonne pratique correspondante.",
+	"Prenez le temps de relire les points clés avant de passer à la suite.",
+	"Votre responsable reste votre premier interlocuteur en cas de doute.",
+	"Les procédures internes complètent ce contenu et sont disponibles sur l'intranet.",
+	"Un récapitulatif téléchargeable accompagne cette partie.",
+	"Les échanges avec vos collègues sont le meilleur moyen d'ancrer ces réflexes.",
+}
+
+// pick renvoie un élément de values.
+func pick[T any](r *rand.Rand, values []T) T {
+	return values[r.IntN(len(values))]
+}
+
+// between renvoie un entier de [lo, hi].
+func between(r *rand.Rand, lo, hi int) int {
+	return lo + r.IntN(hi-lo+1)
+}
+
+// chance renvoie vrai avec la probabilité p.
+func chance(r *rand.Rand, p float64) bool {
+	return r.Float64() < p
+}
+
+// ascii retire les accents et les caractères hors [a-z0-9] pour composer une adresse e-mail.
+func ascii(s string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(ascii7(s)) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func paragraph(r *rand.Rand, n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = pick(r, sentences)
+	}
+	return strings.Join(parts, " ")
+}
+
+func quizData(r *rand.Rand, topic string) map[string]any {
+	questions := make([]any, between(r, 3, 6))
+	for i := range questions {
+		questions[i] = map[string]any{
+			"prompt":  fmt.Sprintf("%s — question %d", topic, i+1),
+			"choices": []any{"Vrai", "Faux", "Je ne sais pas"},
+			"answer":  r.IntN(2),
+		}
+	}
+	return map[string]any{"questions": questions, "pass_score": 70}
+}
+
+// placeholderPDF produit un PDF minimal mais valide d'une page.
+func placeholderPDF(title string) []byte {
+	text := strings.NewReplacer("(", "", ")", "", "\\", "").Replace(ascii7(title))
+	stream := fmt.Sprintf("BT /F1 18 Tf 72 720 Td (%s) Tj ET", text)
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// placeholderSCORM produit un paquet SCORM 1.2 d'une seule page.
+func placeholderSCORM(title string) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct{ name, body string }{
+		{"imsmanifest.xml", fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<manifest identifier="seed" version="1.2" xmlns="http://www.imsproject.org/xsd/imscp_rootv1p1p2" xmlns:adlcp="http://www.adlnet.org/xsd/adlcp_rootv1p2">
+  <organizations default="org"><organization identifier="org"><title>%[1]s</title>
+    <item identifier="item" identifierref="res"><title>%[1]s</title></item>
+  </organization></organizations>
+  <resources><resource identifier="res" type="webcontent" adlcp:scormtype="sco" href="index.html"><file href="index.html"/></resource></resources>
+</manifest>
+`, xmlEscape(title))},
+		{"index.html", fmt.Sprintf("<!doctype html><html><head><meta charset=\"utf-8\"><title>%[1]s</title></head><body><h1>%[1]s</h1></body></html>\n", xmlEscape(title))},
+	}
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(f.body)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func xmlEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
+
+// ascii7 remplace les lettres accentuées, que la police standard du PDF n'encode pas en UTF-8.
+func ascii7(s string) string {
+	replacer := strings.NewReplacer("é", "e", "è", "e", "ê", "e", "ë", "e", "É", "E", "à", "a", "á", "a", "ï", "i", "î", "i", "ô", "o", "ç", "c", "’", "'")
+	s = replacer.Replace(s)
+	var b strings.Builder
+	for _, c := range s {
+		if c < 128 {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,525 @@
+// Package seed génère des données de démonstration et de test de charge. Tout passe par les
+// services métier pour que les invariants tiennent ; seules les dates de progression sont
+// ensuite antidatées pour étaler l'activité dans le temps. À graine égale, le contenu généré
+// (noms, rôles, cours, groupes, statuts, scores, dates relatives à Now) est identique ; seuls
+// les identifiants et clés de stockage diffèrent.
+package seed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/content"
+	"lms-go/internal/course"
+	"lms-go/internal/enrollment"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/progress"
+	"lms-go/internal/user"
+)
+
+const (
+	defaultOrganizations = 1
+	defaultUsers         = 40
+	defaultCourses       = 6
+	defaultPrefix        = "demo"
+	defaultPassword      = "demo-password"
+	// history est la période sur laquelle s'étalent inscriptions et progression.
+	history = 90 * 24 * time.Hour
+)
+
+var ErrInvalidOptions = errors.New("seed: invalid options")
+
+// Roles liste les rôles générés ; chaque organisation a au moins un compte de chacun.
+var Roles = []string{"admin", "designer", "tutor", "learner"}
+
+// Storage reçoit les contenus factices ; Write dépose l'objet comme le ferait le navigateur.
+type Storage interface {
+	content.Storage
+	Write(ctx context.Context, object string, r io.Reader, size int64) error
+}
+
+type Options struct {
+	Seed uint64
+	// Organizations, Users (par organisation) et Courses (par organisation).
+	Organizations int
+	Users         int
+	Courses       int
+	// Prefix préfixe les slugs d'organisation : <prefix>-<graine>-<n>.
+	Prefix string
+	// Password est le mot de passe commun à tous les comptes générés.
+	Password string
+	// Upload dépose des contenus factices (PDF, paquets SCORM) liés aux modules correspondants.
+	Upload bool
+	// Now sert de référence aux dates générées (heure courante par défaut).
+	Now time.Time
+}
+
+func (o Options) withDefaults() Options {
+	if o.Organizations <= 0 {
+		o.Organizations = defaultOrganizations
+	}
+	if o.Users <= 0 {
+		o.Users = defaultUsers
+	}
+	if o.Courses <= 0 {
+		o.Courses = defaultCourses
+	}
+	if o.Prefix == "" {
+		o.Prefix = defaultPrefix
+	}
+	if o.Password == "" {
+		o.Password = defaultPassword
+	}
+	if o.Now.IsZero() {
+		o.Now = time.Now()
+	}
+	return o
+}
+
+type OrganizationReport struct {
+	ID         uuid.UUID
+	Slug       string
+	Name       string
+	AdminEmail string
+}
+
+type Report struct {
+	Organizations  []OrganizationReport
+	Users          map[string]int
+	Courses        map[string]int
+	Modules        int
+	Contents       int
+	Groups         int
+	Enrollments    map[string]int
+	ModuleProgress int
+}
+
+type Generator struct {
+	client      *ent.Client
+	store       Storage
+	orgs        *organization.Service
+	users       *user.Service
+	courses     *course.Service
+	enrollments *enrollment.Service
+	progress    *progress.Service
+	contents    *content.Service
+}
+
+// NewGenerator prépare le générateur ; store peut être nil si Upload n'est jamais demandé.
+func NewGenerator(client *ent.Client, store Storage) *Generator {
+	g := &Generator{
+		client:      client,
+		store:       store,
+		orgs:        organization.NewService(client),
+		users:       user.NewService(client),
+		courses:     course.NewService(client),
+		enrollments: enrollment.NewService(client),
+		progress:    progress.NewService(client),
+	}
+	if store != nil {
+		g.contents = content.NewService(client, store, content.Config{})
+	}
+	return g
+}
+
+// Run génère les organisations demandées. Chaque organisation tire ses valeurs d'un flux
+// aléatoire propre, dérivé de la graine et de son rang.
+func (g *Generator) Run(ctx context.Context, opts Options) (*Report, error) {
+	opts = opts.withDefaults()
+	if opts.Upload && g.store == nil {
+		return nil, fmt.Errorf("%w: upload requires a storage", ErrInvalidOptions)
+	}
+	report := &Report{
+		Users:       map[string]int{},
+		Courses:     map[string]int{},
+		Enrollments: map[string]int{},
+	}
+	for i := 0; i < opts.Organizations; i++ {
+		r := rand.New(rand.NewPCG(opts.Seed, uint64(i)))
+		run := &orgRun{g: g, r: r, opts: opts, report: report}
+		if err := run.generate(ctx, i); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// orgRun porte l'état de la génération d'une organisation.
+type orgRun struct {
+	g      *Generator
+	r      *rand.Rand
+	opts   Options
+	report *Report
+
+	org      *ent.Organization
+	learners []*ent.User
+}
+
+type seededCourse struct {
+	course  *ent.Course
+	modules []*ent.Module
+	groups  []*ent.Group
+}
+
+func (o *orgRun) generate(ctx context.Context, index int) error {
+	slug := fmt.Sprintf("%s-%d-%d", o.opts.Prefix, o.opts.Seed, index+1)
+	// Le nom est unique comme le slug : il le reprend pour que plusieurs graines cohabitent.
+	name := fmt.Sprintf("%s (%s)", pick(o.r, companies), slug)
+	org, err := o.g.orgs.Create(ctx, organization.CreateInput{
+		Name:     name,
+		Slug:     slug,
+		Settings: map[string]any{"seed": map[string]any{"value": o.opts.Seed, "generated_at": o.opts.Now.UTC().Format(time.RFC3339)}},
+	})
+	if err != nil {
+		return fmt.Errorf("seed: organization %s: %w", slug, err)
+	}
+	o.org = org
+
+	admin, err := o.createUsers(ctx)
+	if err != nil {
+		return err
+	}
+	o.report.Organizations = append(o.report.Organizations, OrganizationReport{ID: org.ID, Slug: org.Slug, Name: org.Name, AdminEmail: admin})
+
+	for _, title := range o.courseTitles() {
+		sc, err := o.createCourse(ctx, title)
+		if err != nil {
+			return err
+		}
+		if sc.course.Status != course.StatusPublished {
+			continue
+		}
+		if err := o.createGroups(ctx, sc); err != nil {
+			return err
+		}
+		if err := o.enroll(ctx, sc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createUsers crée un administrateur, un concepteur et un tuteur, puis une répartition réaliste
+// des rôles ; quelques apprenants sont désactivés. Il renvoie l'e-mail de l'administrateur.
+func (o *orgRun) createUsers(ctx context.Context) (string, error) {
+	domain := o.org.Slug + ".example.com"
+	var adminEmail string
+	for i := 0; i < o.opts.Users; i++ {
+		role := "learner"
+		switch {
+		case i < len(Roles)-1:
+			role = Roles[i]
+		case chance(o.r, 0.04):
+			role = "designer"
+		case chance(o.r, 0.08):
+			role = "tutor"
+		}
+		first, last := pick(o.r, firstNames), pick(o.r, lastNames)
+		email := fmt.Sprintf("%s.%s%d@%s", ascii(first), ascii(last), i+1, domain)
+		if i == 0 {
+			email = "admin@" + domain
+			adminEmail = email
+		}
+		u, err := o.g.users.Create(ctx, user.CreateInput{
+			OrganizationID: o.org.ID,
+			Email:          email,
+			Password:       o.opts.Password,
+			Role:           role,
+			Metadata:       map[string]any{"first_name": first, "last_name": last},
+		})
+		if err != nil {
+			return "", fmt.Errorf("seed: user %s: %w", email, err)
+		}
+		o.report.Users[role]++
+		if role != "learner" {
+			continue
+		}
+		if chance(o.r, 0.05) {
+			if err := o.g.users.Deactivate(ctx, o.org.ID, u.ID); err != nil {
+				return "", err
+			}
+			continue
+		}
+		o.learners = append(o.learners, u)
+	}
+	return adminEmail, nil
+}
+
+func (o *orgRun) courseTitles() []string {
+	perm := o.r.Perm(len(topics))
+	titles := make([]string, o.opts.Courses)
+	for i := range titles {
+		titles[i] = topics[perm[i%len(perm)]]
+		if i >= len(perm) {
+			titles[i] = fmt.Sprintf("%s (session %d)", titles[i], i/len(perm)+1)
+		}
+	}
+	return titles
+}
+
+// createCourse crée un cours de 3 à 7 modules de types variés, qui commence par un article.
+// Un cours sur six reste en brouillon et un sur huit est archivé ; les autres sont publiés.
+func (o *orgRun) createCourse(ctx context.Context, title string) (*seededCourse, error) {
+	crs, err := o.g.courses.Create(ctx, course.CreateCourseInput{
+		OrganizationID: o.org.ID,
+		Title:          title,
+		Description:    paragraph(o.r, 2),
+		Metadata:       map[string]any{"level": pick(o.r, []string{"débutant", "intermédiaire", "avancé"}), "language": "fr"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("seed: course %q: %w", title, err)
+	}
+	sc := &seededCourse{course: crs}
+	types := []string{"video", "quiz", "pdf", "scorm", "article"}
+	count := between(o.r, 3, 7)
+	for i := 0; i < count; i++ {
+		moduleType := "article"
+		if i > 0 {
+			moduleType = pick(o.r, types)
+		}
+		input := course.ModuleInput{
+			Title:      fmt.Sprintf("%d. %s", i+1, pick(o.r, moduleTitles[moduleType])),
+			ModuleType: moduleType,
+		}
+		switch moduleType {
+		case "article":
+			input.Data = map[string]any{"body": paragraph(o.r, between(o.r, 3, 6))}
+			input.DurationSecs = between(o.r, 3, 10) * 60
+		case "video":
+			input.Data = map[string]any{"video_url": fmt.Sprintf("https://videos.example.com/%s/%d.mp4", crs.Slug, i+1)}
+			input.DurationSecs = between(o.r, 2, 20) * 60
+		case "quiz":
+			input.Data = quizData(o.r, title)
+			input.DurationSecs = between(o.r, 5, 15) * 60
+		case "pdf", "scorm":
+			input.DurationSecs = between(o.r, 10, 30) * 60
+			if o.opts.Upload {
+				id, err := o.upload(ctx, crs.Slug, i+1, moduleType, title)
+				if err != nil {
+					return nil, err
+				}
+				input.ContentID = &id
+			}
+		}
+		module, err := o.g.courses.AddModule(ctx, o.org.ID, crs.ID, input)
+		if err != nil {
+			return nil, fmt.Errorf("seed: module of %q: %w", title, err)
+		}
+		sc.modules = append(sc.modules, module)
+		o.report.Modules++
+	}
+
+	switch {
+	case chance(o.r, 1.0/6):
+	case chance(o.r, 1.0/8):
+		if _, err := o.g.courses.Publish(ctx, o.org.ID, crs.ID); err != nil {
+			return nil, err
+		}
+		crs, err = o.g.courses.Archive(ctx, o.org.ID, crs.ID)
+	default:
+		crs, err = o.g.courses.Publish(ctx, o.org.ID, crs.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sc.course = crs
+	o.report.Courses[crs.Status]++
+	return sc, nil
+}
+
+// upload dépose un contenu factice par le même chemin qu'un navigateur : réservation, dépôt
+// de l'objet puis finalisation (vérification du type réel et de la taille).
+func (o *orgRun) upload(ctx context.Context, courseSlug string, position int, moduleType, title string) (uuid.UUID, error) {
+	name := fmt.Sprintf("%s-%d.pdf", courseSlug, position)
+	mimeType := "application/pdf"
+	data := placeholderPDF(title)
+	if moduleType == "scorm" {
+		name = fmt.Sprintf("%s-%d.zip", courseSlug, position)
+		mimeType = "application/zip"
+		var err error
+		if data, err = placeholderSCORM(title); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	link, err := o.g.contents.CreateUpload(ctx, content.CreateUploadInput{
+		OrganizationID: o.org.ID,
+		Name:           name,
+		MimeType:       mimeType,
+		SizeBytes:      int64(len(data)),
+		Metadata:       map[string]any{"seed": true},
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("seed: content %s: %w", name, err)
+	}
+	if err := o.g.store.Write(ctx, link.Content.StorageKey, bytes.NewReader(data), int64(len(data))); err != nil {
+		return uuid.Nil, fmt.Errorf("seed: content %s: %w", name, err)
+	}
+	if _, err := o.g.contents.Finalize(ctx, o.org.ID, link.Content.ID, content.FinalizeInput{}); err != nil {
+		return uuid.Nil, fmt.Errorf("seed: content %s: %w", name, err)
+	}
+	o.report.Contents++
+	return link.Content.ID, nil
+}
+
+// createGroups ouvre une ou deux sessions de petite capacité, pour que des listes d'attente
+// apparaissent.
+func (o *orgRun) createGroups(ctx context.Context, sc *seededCourse) error {
+	months := []string{"janvier", "mars", "mai", "septembre", "novembre"}
+	for i, n := 0, between(o.r, 1, 2); i < n; i++ {
+		capacity := between(o.r, 3, 12)
+		group, err := o.g.enrollments.CreateGroup(ctx, enrollment.CreateGroupInput{
+			OrganizationID: o.org.ID,
+			CourseID:       &sc.course.ID,
+			Name:           fmt.Sprintf("%s — session de %s", sc.course.Title, pick(o.r, months)),
+			Capacity:       &capacity,
+		})
+		if err != nil {
+			return fmt.Errorf("seed: group of %q: %w", sc.course.Title, err)
+		}
+		sc.groups = append(sc.groups, group)
+		o.report.Groups++
+	}
+	return nil
+}
+
+// enroll inscrit une partie des apprenants. Le sort de chaque inscription est tiré :
+// en attente de validation, annulée, terminée, en cours à différents stades ou pas commencée ;
+// les inscriptions dans un groupe complet restent en liste d'attente.
+func (o *orgRun) enroll(ctx context.Context, sc *seededCourse) error {
+	perm := o.r.Perm(len(o.learners))
+	n := len(perm) * between(o.r, 40, 80) / 100
+	for _, idx := range perm[:n] {
+		learner := o.learners[idx]
+		input := enrollment.EnrollInput{OrganizationID: o.org.ID, CourseID: sc.course.ID, UserID: learner.ID}
+		if len(sc.groups) > 0 && chance(o.r, 0.5) {
+			group := pick(o.r, sc.groups)
+			input.GroupID = &group.ID
+		}
+		enr, err := o.g.enrollments.Enroll(ctx, input)
+		if err != nil {
+			return fmt.Errorf("seed: enrollment of %s: %w", learner.Email, err)
+		}
+		startedAt := o.opts.Now.Add(-time.Duration(o.r.Int64N(int64(history))))
+		roll := o.r.Float64()
+		if enr.Status == enrollment.StatusWaitlisted {
+			roll = -1
+		}
+		switch {
+		case roll < 0:
+		case roll < 0.08:
+			status := enrollment.StatusPending
+			enr, err = o.g.enrollments.Update(ctx, o.org.ID, enr.ID, enrollment.UpdateInput{Status: &status, StartedAt: &startedAt})
+		case roll < 0.16:
+			if enr, err = o.g.enrollments.Update(ctx, o.org.ID, enr.ID, enrollment.UpdateInput{StartedAt: &startedAt}); err == nil {
+				err = o.g.enrollments.Cancel(ctx, o.org.ID, enr.ID)
+				enr.Status = enrollment.StatusCancelled
+			}
+		case roll < 0.46:
+			enr, err = o.advance(ctx, sc, enr, startedAt, len(sc.modules))
+		case roll < 0.92:
+			enr, err = o.advance(ctx, sc, enr, startedAt, o.r.IntN(len(sc.modules)))
+		default:
+			enr, err = o.g.enrollments.Update(ctx, o.org.ID, enr.ID, enrollment.UpdateInput{StartedAt: &startedAt})
+		}
+		if err != nil {
+			return fmt.Errorf("seed: enrollment of %s: %w", learner.Email, err)
+		}
+		o.report.Enrollments[enr.Status]++
+	}
+	return nil
+}
+
+// advance termine les completed premiers modules (et démarre le suivant) via le service de
+// progression, puis antidate les étapes entre startedAt et Now.
+func (o *orgRun) advance(ctx context.Context, sc *seededCourse, enr *ent.Enrollment, startedAt time.Time, completed int) (*ent.Enrollment, error) {
+	if _, err := o.g.enrollments.Update(ctx, o.org.ID, enr.ID, enrollment.UpdateInput{StartedAt: &startedAt}); err != nil {
+		return nil, err
+	}
+	// Les étapes se répartissent sur le temps écoulé depuis l'inscription.
+	step := o.opts.Now.Sub(startedAt) / time.Duration(len(sc.modules)+1)
+	at := startedAt
+	var last time.Time
+	for i, module := range sc.modules {
+		if i > completed {
+			break
+		}
+		moduleStart := at.Add(time.Duration(o.r.Int64N(int64(step/2) + 1)))
+		var (
+			mp  *ent.ModuleProgress
+			err error
+		)
+		update := func(mp *ent.ModuleProgress) *ent.ModuleProgressUpdateOne {
+			return o.g.client.ModuleProgress.UpdateOne(mp).SetStartedAt(moduleStart).SetUpdatedAt(moduleStart)
+		}
+		if i == completed {
+			if mp, err = o.g.progress.Start(ctx, o.org.ID, enr.ID, module.ID); err != nil {
+				return nil, err
+			}
+			if _, err = update(mp).Save(ctx); err != nil {
+				return nil, err
+			}
+			o.report.ModuleProgress++
+			break
+		}
+		var score *float32
+		if module.ModuleType == "quiz" {
+			s := float32(between(o.r, 55, 100))
+			score = &s
+		}
+		if mp, err = o.g.progress.Complete(ctx, o.org.ID, enr.ID, module.ID, score); err != nil {
+			return nil, err
+		}
+		duration := time.Duration(module.DurationSeconds) * time.Second
+		last = moduleStart.Add(duration + time.Duration(o.r.Int64N(int64(duration)+1)))
+		if last.After(o.opts.Now) {
+			last = o.opts.Now
+		}
+		if _, err = update(mp).SetCompletedAt(last).SetUpdatedAt(last).Save(ctx); err != nil {
+			return nil, err
+		}
+		o.report.ModuleProgress++
+		at = at.Add(step)
+	}
+	// Le service date la fin du parcours au moment de l'appel ; elle suit le dernier module.
+	input := enrollment.UpdateInput{StartedAt: &startedAt}
+	if completed >= len(sc.modules) {
+		input.CompletedAt = &last
+	}
+	return o.g.enrollments.Update(ctx, o.org.ID, enr.ID, input)
+}
+
+// Count est un compteur nommé du rapport (users.learner, enrollments.completed…).
+type Count struct {
+	Name  string
+	Value int
+}
+
+// Summary renvoie les compteurs du rapport dans un ordre stable, pour l'affichage.
+func (r *Report) Summary() []Count {
+	var counts []Count
+	add := func(prefix string, values map[string]int) {
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			counts = append(counts, Count{prefix + "." + k, values[k]})
+		}
+	}
+	counts = append(counts, Count{"organizations", len(r.Organizations)})
+	add("users", r.Users)
+	add("courses", r.Courses)
+	counts = append(counts, Count{"modules", r.Modules}, Count{"contents", r.Contents}, Count{"groups", r.Groups})
+	add("enrollments", r.Enrollments)
+	return append(counts, Count{"module_progress", r.ModuleProgress})
+}
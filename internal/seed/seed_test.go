@@ -0,0 +1,143 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/content"
+	"lms-go/internal/ent"
+	entenrollment "lms-go/internal/ent/enrollment"
+	entmodule "lms-go/internal/ent/module"
+	"lms-go/internal/platform/storage"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func newClient(t *testing.T, name string) *ent.Client {
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	require.NoError(t, client.Schema.Create(context.Background()))
+	return client
+}
+
+// fingerprint résume les données générées sans les identifiants, propres à chaque exécution.
+func fingerprint(t *testing.T, client *ent.Client) []string {
+	ctx := context.Background()
+	var out []string
+	users, err := client.User.Query().All(ctx)
+	require.NoError(t, err)
+	emails := map[string]string{}
+	for _, u := range users {
+		emails[u.ID.String()] = u.Email
+		out = append(out, fmt.Sprintf("user %s %s %s", u.Email, u.Role, u.Status))
+	}
+	courses, err := client.Course.Query().WithModules(func(q *ent.ModuleQuery) { q.Order(entmodule.ByPosition()) }).All(ctx)
+	require.NoError(t, err)
+	titles := map[string]string{}
+	for _, c := range courses {
+		titles[c.ID.String()] = c.Slug
+		line := fmt.Sprintf("course %s %s", c.Slug, c.Status)
+		for _, m := range c.Edges.Modules {
+			line += fmt.Sprintf(" %s:%d:%t", m.ModuleType, m.DurationSeconds, m.ContentID != nil)
+		}
+		out = append(out, line)
+	}
+	groups, err := client.Group.Query().All(ctx)
+	require.NoError(t, err)
+	for _, g := range groups {
+		out = append(out, fmt.Sprintf("group %s %d", g.Name, *g.Capacity))
+	}
+	enrollments, err := client.Enrollment.Query().WithProgressEntries().All(ctx)
+	require.NoError(t, err)
+	for _, e := range enrollments {
+		line := fmt.Sprintf("enrollment %s %s %s %.0f", emails[e.UserID.String()], titles[e.CourseID.String()], e.Status, e.Progress)
+		if e.StartedAt != nil {
+			line += " " + e.StartedAt.UTC().Format(time.RFC3339)
+		}
+		var steps []string
+		for _, p := range e.Edges.ProgressEntries {
+			steps = append(steps, fmt.Sprintf("%s:%.0f", p.Status, p.Score))
+		}
+		sort.Strings(steps)
+		line += fmt.Sprint(steps)
+		out = append(out, line)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestGenerator_Deterministic(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	opts := Options{Seed: 7, Organizations: 2, Users: 10, Courses: 4, Upload: true, Now: now}
+
+	first := newClient(t, "seed_a")
+	store := storage.NewMemory()
+	report, err := NewGenerator(first, store).Run(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, report.Organizations, 2)
+	require.Equal(t, "demo-7-1", report.Organizations[0].Slug)
+	require.Equal(t, "admin@demo-7-1.example.com", report.Organizations[0].AdminEmail)
+	for _, role := range Roles {
+		require.GreaterOrEqual(t, report.Users[role], 2, role)
+	}
+	require.Positive(t, report.Courses["published"])
+	require.Positive(t, report.Groups)
+	require.Positive(t, report.ModuleProgress)
+
+	second := newClient(t, "seed_b")
+	again, err := NewGenerator(second, storage.NewMemory()).Run(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, report.Summary(), again.Summary())
+	require.Equal(t, fingerprint(t, first), fingerprint(t, second))
+
+	// Une autre graine produit d'autres données, sans conflit avec les organisations existantes.
+	opts.Seed = 8
+	_, err = NewGenerator(first, store).Run(ctx, opts)
+	require.NoError(t, err)
+
+	// Les invariants tiennent : contenus vérifiés et disponibles, dates dans la période,
+	// groupes jamais au-delà de leur capacité.
+	contents, err := first.Content.Query().All(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(contents), report.Contents)
+	for _, c := range contents {
+		require.Equal(t, content.StatusAvailable, c.Status, c.Name)
+		require.NotEmpty(t, c.ChecksumSha256)
+	}
+	progress, err := first.ModuleProgress.Query().All(ctx)
+	require.NoError(t, err)
+	for _, p := range progress {
+		require.NotNil(t, p.StartedAt)
+		require.False(t, p.StartedAt.After(now))
+		require.False(t, p.StartedAt.Before(now.Add(-history)))
+		if p.CompletedAt != nil {
+			require.False(t, p.CompletedAt.Before(*p.StartedAt))
+			require.False(t, p.CompletedAt.After(now))
+		}
+	}
+	groups, err := first.Group.Query().All(ctx)
+	require.NoError(t, err)
+	for _, g := range groups {
+		seated, err := first.Enrollment.Query().
+			Where(entenrollment.GroupIDEQ(g.ID), entenrollment.StatusNEQ("waitlisted"), entenrollment.StatusNEQ("cancelled")).
+			Count(ctx)
+		require.NoError(t, err)
+		require.LessOrEqual(t, seated, *g.Capacity, g.Name)
+	}
+}
@@ -0,0 +1,12 @@
+package serviceaccount
+
+import "errors"
+
+var (
+	ErrInvalidInput = errors.New("serviceaccount: invalid input")
+	ErrInvalidScope = errors.New("serviceaccount: unknown scope")
+	ErrNotFound     = errors.New("serviceaccount: not found")
+	ErrConflict     = errors.New("serviceaccount: name already used")
+	ErrDisabled     = errors.New("serviceaccount: account disabled")
+	ErrInvalidKey   = errors.New("serviceaccount: invalid api key")
+)
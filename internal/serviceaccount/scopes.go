@@ -0,0 +1,55 @@
+package serviceaccount
+
+import (
+	"slices"
+	"strings"
+)
+
+// Scopes accordables aux clés d'API. Un scope ":write" n'implique pas le scope ":read" correspondant.
+const (
+	ScopeUsersRead        = "users:read"
+	ScopeUsersWrite       = "users:write"
+	ScopeCoursesRead      = "courses:read"
+	ScopeCoursesWrite     = "courses:write"
+	ScopeContentsRead     = "contents:read"
+	ScopeContentsWrite    = "contents:write"
+	ScopeEnrollmentsRead  = "enrollments:read"
+	ScopeEnrollmentsWrite = "enrollments:write"
+	ScopeReportsRead      = "reports:read"
+)
+
+var knownScopes = []string{
+	ScopeContentsRead,
+	ScopeContentsWrite,
+	ScopeCoursesRead,
+	ScopeCoursesWrite,
+	ScopeEnrollmentsRead,
+	ScopeEnrollmentsWrite,
+	ScopeReportsRead,
+	ScopeUsersRead,
+	ScopeUsersWrite,
+}
+
+// Scopes renvoie la liste triée des scopes reconnus.
+func Scopes() []string {
+	return slices.Clone(knownScopes)
+}
+
+// normalizeScopes valide, déduplique et trie les scopes demandés.
+func normalizeScopes(scopes []string) ([]string, error) {
+	out := make([]string, 0, len(scopes))
+	for _, raw := range scopes {
+		scope := strings.ToLower(strings.TrimSpace(raw))
+		if !slices.Contains(knownScopes, scope) {
+			return nil, ErrInvalidScope
+		}
+		if !slices.Contains(out, scope) {
+			out = append(out, scope)
+		}
+	}
+	if len(out) == 0 {
+		return nil, ErrInvalidScope
+	}
+	slices.Sort(out)
+	return out, nil
+}
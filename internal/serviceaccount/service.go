@@ -0,0 +1,292 @@
+// Package serviceaccount gère les comptes de service d'une organisation et leurs clés d'API
+// à scopes, utilisées par les intégrations machine à la place d'un compte utilisateur.
+package serviceaccount
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lms-go/internal/ent"
+	entapikey "lms-go/internal/ent/apikey"
+	entorg "lms-go/internal/ent/organization"
+	entserviceaccount "lms-go/internal/ent/serviceaccount"
+	"lms-go/internal/principal"
+)
+
+const (
+	// KeyPrefix distingue les clés d'API des access tokens JWT dans l'entête Authorization.
+	KeyPrefix = "lms_"
+	// publicPrefixLen est la longueur (hexadécimale) de la partie publique d'une clé.
+	publicPrefixLen = 12
+	// lastUsedResolution limite les écritures lors de la mise à jour de last_used_at.
+	lastUsedResolution = time.Minute
+
+	StatusActive   = "active"
+	StatusDisabled = "disabled"
+)
+
+// Service gère les comptes de service et l'authentification par clé d'API.
+type Service struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// NewService crée une instance de Service.
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client, now: time.Now}
+}
+
+func (s *Service) withNow(now func() time.Time) {
+	if now != nil {
+		s.now = now
+	}
+}
+
+// CreateInput décrit un nouveau compte de service.
+type CreateInput struct {
+	Name        string
+	Description string
+}
+
+// Create crée un compte de service actif dans l'organisation.
+func (s *Service) Create(ctx context.Context, orgID uuid.UUID, input CreateInput) (*ent.ServiceAccount, error) {
+	name := strings.TrimSpace(input.Name)
+	if orgID == uuid.Nil || name == "" {
+		return nil, ErrInvalidInput
+	}
+	exists, err := s.client.Organization.Query().Where(entorg.IDEQ(orgID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	account, err := s.client.ServiceAccount.Create().
+		SetOrganizationID(orgID).
+		SetName(name).
+		SetDescription(strings.TrimSpace(input.Description)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// List renvoie les comptes de service de l'organisation.
+func (s *Service) List(ctx context.Context, orgID uuid.UUID) ([]*ent.ServiceAccount, error) {
+	return s.client.ServiceAccount.Query().
+		Where(entserviceaccount.OrganizationIDEQ(orgID)).
+		Order(entserviceaccount.ByName()).
+		All(ctx)
+}
+
+// Get renvoie un compte de service de l'organisation.
+func (s *Service) Get(ctx context.Context, orgID, accountID uuid.UUID) (*ent.ServiceAccount, error) {
+	account, err := s.client.ServiceAccount.Query().
+		Where(
+			entserviceaccount.IDEQ(accountID),
+			entserviceaccount.OrganizationIDEQ(orgID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// Disable désactive un compte de service : ses clés sont refusées immédiatement.
+func (s *Service) Disable(ctx context.Context, orgID, accountID uuid.UUID) (*ent.ServiceAccount, error) {
+	return s.setStatus(ctx, orgID, accountID, StatusDisabled)
+}
+
+// Enable réactive un compte de service ; les clés non révoquées et non expirées redeviennent valides.
+func (s *Service) Enable(ctx context.Context, orgID, accountID uuid.UUID) (*ent.ServiceAccount, error) {
+	return s.setStatus(ctx, orgID, accountID, StatusActive)
+}
+
+func (s *Service) setStatus(ctx context.Context, orgID, accountID uuid.UUID, status string) (*ent.ServiceAccount, error) {
+	account, err := s.Get(ctx, orgID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return account.Update().SetStatus(status).Save(ctx)
+}
+
+// IssueKeyInput décrit une clé d'API à émettre.
+type IssueKeyInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// IssuedKey contient la clé en clair, communiquée une seule fois à l'émission.
+type IssuedKey struct {
+	Key    string
+	Entity *ent.APIKey
+}
+
+// IssueKey émet une clé d'API pour un compte de service actif.
+func (s *Service) IssueKey(ctx context.Context, orgID, accountID uuid.UUID, input IssueKeyInput) (IssuedKey, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return IssuedKey{}, ErrInvalidInput
+	}
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return IssuedKey{}, err
+	}
+	now := s.now()
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+		return IssuedKey{}, ErrInvalidInput
+	}
+	account, err := s.Get(ctx, orgID, accountID)
+	if err != nil {
+		return IssuedKey{}, err
+	}
+	if account.Status != StatusActive {
+		return IssuedKey{}, ErrDisabled
+	}
+
+	public := make([]byte, publicPrefixLen/2)
+	if _, err := rand.Read(public); err != nil {
+		return IssuedKey{}, err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return IssuedKey{}, err
+	}
+	prefix := hex.EncodeToString(public)
+	raw := KeyPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	entity, err := s.client.APIKey.Create().
+		SetServiceAccountID(account.ID).
+		SetName(name).
+		SetPrefix(prefix).
+		SetSecretHash(hashKey(raw)).
+		SetScopes(scopes).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return IssuedKey{}, err
+	}
+	return IssuedKey{Key: raw, Entity: entity}, nil
+}
+
+// ListKeys liste les clés d'un compte de service (sans leur secret), révoquées comprises.
+func (s *Service) ListKeys(ctx context.Context, orgID, accountID uuid.UUID) ([]*ent.APIKey, error) {
+	if _, err := s.Get(ctx, orgID, accountID); err != nil {
+		return nil, err
+	}
+	return s.client.APIKey.Query().
+		Where(entapikey.ServiceAccountIDEQ(accountID)).
+		Order(entapikey.ByCreatedAt()).
+		All(ctx)
+}
+
+// RevokeKey révoque une clé ; elle reste listée pour l'historique mais est refusée immédiatement.
+func (s *Service) RevokeKey(ctx context.Context, orgID, accountID, keyID uuid.UUID) (*ent.APIKey, error) {
+	if _, err := s.Get(ctx, orgID, accountID); err != nil {
+		return nil, err
+	}
+	key, err := s.client.APIKey.Query().
+		Where(
+			entapikey.IDEQ(keyID),
+			entapikey.ServiceAccountIDEQ(accountID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return key, nil
+	}
+	return key.Update().SetRevokedAt(s.now()).Save(ctx)
+}
+
+// IsAPIKey indique si un jeton bearer a la forme d'une clé d'API plutôt que d'un JWT.
+func IsAPIKey(raw string) bool {
+	return strings.HasPrefix(strings.TrimSpace(raw), KeyPrefix)
+}
+
+// Authenticate vérifie une clé d'API et renvoie le compte de service qu'elle représente.
+func (s *Service) Authenticate(ctx context.Context, raw string) (principal.Principal, error) {
+	raw = strings.TrimSpace(raw)
+	prefix, ok := parseKey(raw)
+	if !ok {
+		return principal.Principal{}, ErrInvalidKey
+	}
+	key, err := s.client.APIKey.Query().
+		Where(entapikey.PrefixEQ(prefix)).
+		WithServiceAccount(func(q *ent.ServiceAccountQuery) {
+			q.WithOrganization()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return principal.Principal{}, ErrInvalidKey
+		}
+		return principal.Principal{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashKey(raw)), []byte(key.SecretHash)) != 1 {
+		return principal.Principal{}, ErrInvalidKey
+	}
+
+	now := s.now()
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		return principal.Principal{}, ErrInvalidKey
+	}
+	account := key.Edges.ServiceAccount
+	if account == nil || account.Status != StatusActive {
+		return principal.Principal{}, ErrInvalidKey
+	}
+	if org := account.Edges.Organization; org == nil || org.Status != "active" {
+		return principal.Principal{}, ErrInvalidKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		if err := s.client.APIKey.UpdateOneID(key.ID).SetLastUsedAt(now).Exec(ctx); err != nil {
+			return principal.Principal{}, err
+		}
+	}
+	return principal.Principal{
+		Kind:           principal.KindServiceAccount,
+		ID:             account.ID,
+		OrganizationID: account.OrganizationID,
+		Scopes:         key.Scopes,
+		KeyID:          key.ID,
+	}, nil
+}
+
+// parseKey extrait la partie publique d'une clé au format lms_<préfixe>_<secret>.
+func parseKey(raw string) (string, bool) {
+	rest, ok := strings.CutPrefix(raw, KeyPrefix)
+	if !ok || len(rest) <= publicPrefixLen+1 || rest[publicPrefixLen] != '_' {
+		return "", false
+	}
+	return rest[:publicPrefixLen], true
+}
+
+// hashKey renvoie l'empreinte stockée ; le secret étant aléatoire (256 bits), SHA-256 suffit.
+func hashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,137 @@
+package serviceaccount
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/principal"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func newTestService(t *testing.T, name string) (*Service, *ent.Client, uuid.UUID, *time.Time) {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	org, err := client.Organization.Create().SetName("Acme").SetSlug("acme").Save(ctx)
+	require.NoError(t, err)
+
+	svc := NewService(client)
+	now := time.Date(2024, 10, 6, 12, 0, 0, 0, time.UTC)
+	svc.withNow(func() time.Time { return now })
+	return svc, client, org.ID, &now
+}
+
+func TestService_IssueAndAuthenticate(t *testing.T) {
+	svc, client, orgID, now := newTestService(t, "serviceaccount_auth")
+	ctx := context.Background()
+
+	account, err := svc.Create(ctx, orgID, CreateInput{Name: "hris-sync", Description: "Synchronisation SIRH"})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, orgID, CreateInput{Name: "hris-sync"})
+	require.ErrorIs(t, err, ErrConflict)
+
+	_, err = svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{Name: "prod", Scopes: []string{"admin:all"}})
+	require.ErrorIs(t, err, ErrInvalidScope)
+	past := now.Add(-time.Hour)
+	_, err = svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{Name: "prod", Scopes: []string{ScopeReportsRead}, ExpiresAt: &past})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	expiresAt := now.Add(24 * time.Hour)
+	issued, err := svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{
+		Name:      "prod",
+		Scopes:    []string{" Enrollments:Write", ScopeReportsRead, ScopeEnrollmentsWrite},
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(issued.Key, KeyPrefix+issued.Entity.Prefix+"_"))
+	require.True(t, IsAPIKey(issued.Key))
+	require.Equal(t, []string{ScopeEnrollmentsWrite, ScopeReportsRead}, issued.Entity.Scopes)
+	require.NotContains(t, issued.Entity.SecretHash, issued.Key)
+
+	p, err := svc.Authenticate(ctx, issued.Key)
+	require.NoError(t, err)
+	require.Equal(t, principal.KindServiceAccount, p.Kind)
+	require.Equal(t, account.ID, p.ID)
+	require.Equal(t, orgID, p.OrganizationID)
+	require.Equal(t, issued.Entity.ID, p.KeyID)
+	require.True(t, p.HasScope(ScopeEnrollmentsWrite))
+	require.False(t, p.HasScope(ScopeEnrollmentsRead))
+
+	stored, err := client.APIKey.Get(ctx, issued.Entity.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.LastUsedAt)
+	require.True(t, now.Equal(*stored.LastUsedAt))
+
+	// Un secret altéré sur un préfixe existant est refusé.
+	_, err = svc.Authenticate(ctx, issued.Key[:len(issued.Key)-2]+"xx")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = svc.Authenticate(ctx, "lms_short")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	// Expiration.
+	*now = expiresAt
+	_, err = svc.Authenticate(ctx, issued.Key)
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestService_RevocationAndDisable(t *testing.T) {
+	svc, _, orgID, _ := newTestService(t, "serviceaccount_revoke")
+	ctx := context.Background()
+
+	account, err := svc.Create(ctx, orgID, CreateInput{Name: "reporting"})
+	require.NoError(t, err)
+	first, err := svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{Name: "a", Scopes: []string{ScopeReportsRead}})
+	require.NoError(t, err)
+	second, err := svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{Name: "b", Scopes: []string{ScopeReportsRead}})
+	require.NoError(t, err)
+
+	revoked, err := svc.RevokeKey(ctx, orgID, account.ID, first.Entity.ID)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+	_, err = svc.Authenticate(ctx, first.Key)
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = svc.Authenticate(ctx, second.Key)
+	require.NoError(t, err)
+
+	keys, err := svc.ListKeys(ctx, orgID, account.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	_, err = svc.Disable(ctx, orgID, account.ID)
+	require.NoError(t, err)
+	_, err = svc.Authenticate(ctx, second.Key)
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = svc.IssueKey(ctx, orgID, account.ID, IssueKeyInput{Name: "c", Scopes: []string{ScopeReportsRead}})
+	require.ErrorIs(t, err, ErrDisabled)
+
+	_, err = svc.Enable(ctx, orgID, account.ID)
+	require.NoError(t, err)
+	_, err = svc.Authenticate(ctx, second.Key)
+	require.NoError(t, err)
+
+	// Un compte d'une autre organisation est introuvable.
+	_, err = svc.RevokeKey(ctx, uuid.New(), account.ID, second.Entity.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,13 @@
+package sso
+
+import "errors"
+
+var (
+	ErrNotConfigured    = errors.New("sso: provider not configured")
+	ErrInvalidState     = errors.New("sso: invalid state")
+	ErrInvalidResponse  = errors.New("sso: invalid identity provider response")
+	ErrDomainNotAllowed = errors.New("sso: email domain not allowed")
+	ErrEmailUnverified  = errors.New("sso: email not verified")
+	ErrInvalidMetadata  = errors.New("sso: invalid identity provider metadata")
+	ErrAccountExists    = errors.New("sso: an account not linked to this identity already uses the email")
+)
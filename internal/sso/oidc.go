@@ -0,0 +1,222 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+const protocolOIDC = "oidc"
+
+// OIDCLoginInput décrit le démarrage d'une connexion OIDC.
+type OIDCLoginInput struct {
+	OrgSlug string
+	// RedirectURL est l'URL absolue du callback, déclarée auprès du fournisseur.
+	RedirectURL string
+	ReturnTo    string
+	UseCookies  bool
+}
+
+// OIDCCallbackInput regroupe les paramètres reçus sur le callback.
+type OIDCCallbackInput struct {
+	OrgSlug     string
+	RedirectURL string
+	Code        string
+	State       string
+	StateCookie string
+	// Error est le paramètre error renvoyé par le fournisseur en cas de refus.
+	Error string
+}
+
+// BeginOIDC prépare la redirection vers le fournisseur (authorization code + PKCE).
+func (s *Service) BeginOIDC(ctx context.Context, input OIDCLoginInput) (Redirect, error) {
+	org, err := s.activeOrganization(ctx, input.OrgSlug)
+	if err != nil {
+		return Redirect{}, err
+	}
+	settings, err := OIDCSettingsFrom(org.Settings)
+	if err != nil {
+		return Redirect{}, err
+	}
+	provider, err := s.provider(ctx, settings.Issuer)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	state, err := randomString(24)
+	if err != nil {
+		return Redirect{}, err
+	}
+	nonce, err := randomString(24)
+	if err != nil {
+		return Redirect{}, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	now := s.now()
+	cookie, err := signState(s.secret, flowState{
+		Protocol:   protocolOIDC,
+		OrgSlug:    org.Slug,
+		State:      state,
+		Nonce:      nonce,
+		Verifier:   verifier,
+		ReturnTo:   sanitizeReturnTo(input.ReturnTo),
+		UseCookies: input.UseCookies,
+	}, now, s.stateTTL)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	authURL := oauthConfig(provider, settings, input.RedirectURL).AuthCodeURL(state,
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(verifier),
+	)
+	return Redirect{URL: authURL, State: cookie, ExpiresAt: now.Add(s.stateTTL)}, nil
+}
+
+// CompleteOIDC échange le code, valide l'ID token, provisionne l'utilisateur et émet les tokens LMS.
+func (s *Service) CompleteOIDC(ctx context.Context, input OIDCCallbackInput) (Result, error) {
+	st, err := s.checkState(protocolOIDC, input.OrgSlug, input.StateCookie, input.State)
+	if err != nil {
+		return Result{}, err
+	}
+	if input.Error != "" {
+		return Result{}, fmt.Errorf("%w: %s", ErrInvalidResponse, input.Error)
+	}
+	if input.Code == "" {
+		return Result{}, ErrInvalidResponse
+	}
+
+	org, err := s.activeOrganization(ctx, st.OrgSlug)
+	if err != nil {
+		return Result{}, err
+	}
+	settings, err := OIDCSettingsFrom(org.Settings)
+	if err != nil {
+		return Result{}, err
+	}
+	provider, err := s.provider(ctx, settings.Issuer)
+	if err != nil {
+		return Result{}, err
+	}
+
+	ctx = s.clientContext(ctx)
+	oauthCfg := oauthConfig(provider, settings, input.RedirectURL)
+	token, err := oauthCfg.Exchange(ctx, input.Code, oauth2.VerifierOption(st.Verifier))
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: token exchange: %v", ErrInvalidResponse, err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return Result{}, fmt.Errorf("%w: missing id_token", ErrInvalidResponse)
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: settings.ClientID, Now: s.now}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: id_token: %v", ErrInvalidResponse, err)
+	}
+	if idToken.Nonce != st.Nonce {
+		return Result{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidResponse)
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return Result{}, fmt.Errorf("%w: claims: %v", ErrInvalidResponse, err)
+	}
+	if _, ok := claims["email"]; !ok {
+		// Certains fournisseurs ne placent l'email que dans l'endpoint userinfo.
+		info, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return Result{}, fmt.Errorf("%w: userinfo: %v", ErrInvalidResponse, err)
+		}
+		extra := map[string]any{}
+		if err := info.Claims(&extra); err != nil {
+			return Result{}, fmt.Errorf("%w: userinfo claims: %v", ErrInvalidResponse, err)
+		}
+		for key, value := range extra {
+			if _, exists := claims[key]; !exists {
+				claims[key] = value
+			}
+		}
+	}
+
+	email, _ := claims["email"].(string)
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return Result{}, fmt.Errorf("%w: missing email claim", ErrInvalidResponse)
+	}
+	// Sans email_verified explicite, l'email pourrait avoir été saisi librement chez le fournisseur.
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return Result{}, ErrEmailUnverified
+	}
+	if !settings.DomainAllowed(email) {
+		return Result{}, ErrDomainNotAllowed
+	}
+
+	role, mapped := settings.MapRole(claims)
+	account, created, err := s.provision(ctx, org, identity{
+		Protocol:   protocolOIDC,
+		Issuer:     idToken.Issuer,
+		Subject:    idToken.Subject,
+		Email:      email,
+		Role:       role,
+		RoleMapped: mapped,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	tokens, err := s.auth.StartSession(ctx, account.ID)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		User:       account,
+		Tokens:     tokens,
+		Created:    created,
+		ReturnTo:   st.ReturnTo,
+		UseCookies: st.UseCookies,
+	}, nil
+}
+
+// provider renvoie le fournisseur découvert pour l'issuer, mis en cache après la première découverte.
+func (s *Service) provider(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	s.mu.Lock()
+	cached, ok := s.providers[issuer]
+	s.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	// Le fournisseur conserve le client HTTP pour rafraîchir les JWKS : on le détache de l'annulation de la requête.
+	provider, err := oidc.NewProvider(s.clientContext(context.WithoutCancel(ctx)), issuer)
+	if err != nil {
+		return nil, fmt.Errorf("%w: discovery: %v", ErrInvalidResponse, err)
+	}
+
+	s.mu.Lock()
+	s.providers[issuer] = provider
+	s.mu.Unlock()
+	return provider, nil
+}
+
+func (s *Service) clientContext(ctx context.Context) context.Context {
+	if s.httpClient == nil {
+		return ctx
+	}
+	ctx = oidc.ClientContext(ctx, s.httpClient)
+	return context.WithValue(ctx, oauth2.HTTPClient, s.httpClient)
+}
+
+func oauthConfig(provider *oidc.Provider, settings OIDCSettings, redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     settings.ClientID,
+		ClientSecret: settings.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       settings.Scopes,
+	}
+}
@@ -0,0 +1,279 @@
+package sso
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/sso/ssotest"
+	"lms-go/internal/user"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+const testRedirectURL = "http://lms.test/auth/oidc/acme/callback"
+
+type testEnv struct {
+	client *ent.Client
+	svc    *Service
+	orgs   *organization.Service
+	users  *user.Service
+	auth   *auth.Service
+	org    *ent.Organization
+}
+
+func newTestEnv(t *testing.T, name string, settings map[string]any) testEnv {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	driver := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(driver))
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = db.Close()
+	})
+
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	orgs := organization.NewService(client)
+	users := user.NewService(client)
+	authService := auth.NewService(client, auth.Config{
+		JWTSecret:       "secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+	org, err := orgs.Create(ctx, organization.CreateInput{
+		Name:     "Acme",
+		Slug:     "acme",
+		Settings: settings,
+	})
+	require.NoError(t, err)
+
+	return testEnv{
+		client: client,
+		svc:    NewService(client, orgs, users, authService, Config{StateSecret: "state-secret"}),
+		orgs:   orgs,
+		users:  users,
+		auth:   authService,
+		org:    org,
+	}
+}
+
+// runFlow enchaîne BeginOIDC, le passage chez le fournisseur puis CompleteOIDC.
+func runFlow(t *testing.T, env testEnv, provider *ssotest.OIDCProvider) (Result, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	redirect, err := env.svc.BeginOIDC(ctx, OIDCLoginInput{
+		OrgSlug:     "acme",
+		RedirectURL: testRedirectURL,
+		ReturnTo:    "/dashboard",
+	})
+	require.NoError(t, err)
+
+	callback := provider.Authorize(t, redirect.URL)
+	return env.svc.CompleteOIDC(ctx, OIDCCallbackInput{
+		OrgSlug:     "acme",
+		RedirectURL: testRedirectURL,
+		Code:        callback.Query().Get("code"),
+		State:       callback.Query().Get("state"),
+		StateCookie: redirect.State,
+	})
+}
+
+func TestService_OIDCProvisionsUserJustInTime(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	settings := provider.Settings()
+	settings["role_mapping"] = map[string]any{"lms-designers": "designer"}
+	settings["allowed_domains"] = []any{"acme.test"}
+	env := newTestEnv(t, "ssooidcjit", map[string]any{"oidc": settings})
+
+	provider.SetClaims(map[string]any{
+		"sub":            "user-1",
+		"email":          "Jane@Acme.test",
+		"email_verified": true,
+		"groups":         []any{"staff", "lms-designers"},
+	})
+
+	result, err := runFlow(t, env, provider)
+	require.NoError(t, err)
+	require.True(t, result.Created)
+	require.Equal(t, "/dashboard", result.ReturnTo)
+	require.Equal(t, "jane@acme.test", result.User.Email)
+	require.Equal(t, "designer", result.User.Role)
+	require.NotEmpty(t, result.Tokens.AccessToken)
+
+	userID, orgID, err := env.auth.Identify(result.Tokens.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, result.User.ID, userID)
+	require.Equal(t, env.org.ID, orgID)
+
+	ssoMeta, ok := result.User.Metadata["sso"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "user-1", ssoMeta["subject"])
+
+	// Deuxième connexion : compte réutilisé, rôle resynchronisé depuis le mapping.
+	// L'identité est retrouvée par (issuer, sub), même si le fournisseur change l'email.
+	provider.SetClaims(map[string]any{
+		"sub":            "user-1",
+		"email":          "jane.doe@acme.test",
+		"email_verified": true,
+		"groups":         []any{"staff"},
+	})
+	second, err := runFlow(t, env, provider)
+	require.NoError(t, err)
+	require.False(t, second.Created)
+	require.Equal(t, result.User.ID, second.User.ID)
+	require.Equal(t, "designer", second.User.Role)
+
+	list, err := env.users.List(context.Background(), env.org.ID, user.Filter{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func TestService_OIDCDoesNotTakeOverExistingAccount(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	env := newTestEnv(t, "ssooidctakeover", map[string]any{"oidc": provider.Settings()})
+	ctx := context.Background()
+
+	existing, err := env.users.Create(ctx, user.CreateInput{
+		OrganizationID: env.org.ID,
+		Email:          "ada@acme.test",
+		Password:       "Secret123!",
+		Role:           "admin",
+	})
+	require.NoError(t, err)
+
+	provider.SetClaims(map[string]any{"sub": "intruder", "email": "ada@acme.test", "email_verified": true})
+	_, err = runFlow(t, env, provider)
+	require.ErrorIs(t, err, ErrAccountExists)
+
+	// Un second sub avec l'email d'un compte déjà lié est refusé de la même façon.
+	provider.SetClaims(map[string]any{"sub": "bob-1", "email": "bob@acme.test", "email_verified": true})
+	first, err := runFlow(t, env, provider)
+	require.NoError(t, err)
+	provider.SetClaims(map[string]any{"sub": "bob-2", "email": "bob@acme.test", "email_verified": true})
+	_, err = runFlow(t, env, provider)
+	require.ErrorIs(t, err, ErrAccountExists)
+
+	unchanged, err := env.users.Get(ctx, env.org.ID, existing.ID)
+	require.NoError(t, err)
+	require.NotContains(t, unchanged.Metadata, "sso")
+	require.NotEqual(t, existing.ID, first.User.ID)
+}
+
+// TestService_OIDCAppliesSessionPolicy vérifie que la connexion SSO respecte le verrouillage et
+// la politique de second facteur de l'organisation, comme la connexion par mot de passe.
+func TestService_OIDCAppliesSessionPolicy(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	settings := provider.Settings()
+	settings["role_mapping"] = map[string]any{"lms-admins": "admin"}
+	env := newTestEnv(t, "ssooidcpolicy", map[string]any{
+		"oidc": settings,
+		"mfa":  map[string]any{"required_roles": []any{"admin"}},
+	})
+
+	provider.SetClaims(map[string]any{"sub": "admin-1", "email": "ada@acme.test", "email_verified": true, "groups": []any{"lms-admins"}})
+	_, err := runFlow(t, env, provider)
+	var challenge *auth.MFAChallengeError
+	require.ErrorAs(t, err, &challenge)
+	require.True(t, challenge.EnrollmentRequired)
+	require.NotEmpty(t, challenge.Token)
+
+	provider.SetClaims(map[string]any{"sub": "learner-1", "email": "lea@acme.test", "email_verified": true})
+	result, err := runFlow(t, env, provider)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Tokens.AccessToken)
+
+	require.NoError(t, env.client.User.UpdateOneID(result.User.ID).
+		SetLockedUntil(time.Now().Add(time.Hour)).
+		Exec(context.Background()))
+	_, err = runFlow(t, env, provider)
+	require.ErrorIs(t, err, auth.ErrAccountLocked)
+}
+
+func TestService_OIDCRejectsDisallowedDomainAndUnverifiedEmail(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	settings := provider.Settings()
+	settings["allowed_domains"] = []any{"acme.test"}
+	env := newTestEnv(t, "ssooidcdomain", map[string]any{"oidc": settings})
+
+	provider.SetClaims(map[string]any{"sub": "x", "email": "eve@evil.test", "email_verified": true})
+	_, err := runFlow(t, env, provider)
+	require.ErrorIs(t, err, ErrDomainNotAllowed)
+
+	provider.SetClaims(map[string]any{"sub": "y", "email": "bob@acme.test", "email_verified": false})
+	_, err = runFlow(t, env, provider)
+	require.ErrorIs(t, err, ErrEmailUnverified)
+
+	provider.SetClaims(map[string]any{"sub": "z", "email": "bob@acme.test"})
+	_, err = runFlow(t, env, provider)
+	require.ErrorIs(t, err, ErrEmailUnverified)
+}
+
+func TestService_OIDCStateValidation(t *testing.T) {
+	provider := ssotest.NewOIDCProvider(t)
+	env := newTestEnv(t, "ssooidcstate", map[string]any{"oidc": provider.Settings()})
+	provider.SetClaims(map[string]any{"sub": "u", "email": "u@acme.test", "email_verified": true})
+	ctx := context.Background()
+
+	redirect, err := env.svc.BeginOIDC(ctx, OIDCLoginInput{OrgSlug: "acme", RedirectURL: testRedirectURL})
+	require.NoError(t, err)
+	callback := provider.Authorize(t, redirect.URL)
+
+	_, err = env.svc.CompleteOIDC(ctx, OIDCCallbackInput{
+		OrgSlug:     "acme",
+		RedirectURL: testRedirectURL,
+		Code:        callback.Query().Get("code"),
+		State:       "forged",
+		StateCookie: redirect.State,
+	})
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	env.svc.withNow(func() time.Time { return time.Now().Add(time.Hour) })
+	_, err = env.svc.CompleteOIDC(ctx, OIDCCallbackInput{
+		OrgSlug:     "acme",
+		RedirectURL: testRedirectURL,
+		Code:        callback.Query().Get("code"),
+		State:       callback.Query().Get("state"),
+		StateCookie: redirect.State,
+	})
+	require.ErrorIs(t, err, ErrInvalidState)
+}
+
+func TestService_OIDCNotConfigured(t *testing.T) {
+	env := newTestEnv(t, "ssooidcdisabled", map[string]any{"oidc": map[string]any{"enabled": false}})
+
+	_, err := env.svc.BeginOIDC(context.Background(), OIDCLoginInput{OrgSlug: "acme", RedirectURL: testRedirectURL})
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	_, err = env.svc.BeginOIDC(context.Background(), OIDCLoginInput{OrgSlug: "unknown", RedirectURL: testRedirectURL})
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
+
+func TestMaskAndPreserveSecrets(t *testing.T) {
+	existing := map[string]any{"oidc": map[string]any{"client_id": "a", "client_secret": "s3cret"}}
+
+	masked := MaskSecrets(existing)
+	require.Equal(t, redactedSecret, masked["oidc"].(map[string]any)["client_secret"])
+	require.Equal(t, "s3cret", existing["oidc"].(map[string]any)["client_secret"])
+
+	incoming := map[string]any{"oidc": map[string]any{"client_id": "b", "client_secret": redactedSecret}}
+	merged := PreserveSecrets(incoming, existing)
+	require.Equal(t, "s3cret", merged["oidc"].(map[string]any)["client_secret"])
+	require.Equal(t, "b", merged["oidc"].(map[string]any)["client_id"])
+}
@@ -0,0 +1,131 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/ent/ssoidentity"
+	"lms-go/internal/user"
+)
+
+// identity est l'identité normalisée renvoyée par un fournisseur, quel que soit le protocole.
+type identity struct {
+	Protocol   string
+	Issuer     string
+	Subject    string
+	Email      string
+	Role       string
+	RoleMapped bool
+	// Attributes est fusionné dans User.metadata.
+	Attributes map[string]any
+}
+
+// provision retrouve l'utilisateur lié au couple (issuer, subject) ou le crée (just-in-time) à la
+// première connexion. Un compte existant n'est jamais rattaché par son seul email : ErrAccountExists.
+// Pour un utilisateur lié, le rôle est resynchronisé lorsqu'il est issu du mapping.
+func (s *Service) provision(ctx context.Context, org *ent.Organization, id identity) (*ent.User, bool, error) {
+	email := strings.TrimSpace(strings.ToLower(id.Email))
+	if email == "" || id.Issuer == "" || id.Subject == "" {
+		return nil, false, ErrInvalidResponse
+	}
+
+	linked, err := s.linkedUser(ctx, org, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if linked != nil {
+		updated, err := s.sync(ctx, org, linked, id)
+		return updated, false, err
+	}
+
+	if _, err := s.users.GetByEmail(ctx, org.ID, email); err == nil {
+		return nil, false, ErrAccountExists
+	} else if !errors.Is(err, user.ErrNotFound) {
+		return nil, false, err
+	}
+
+	// Mot de passe aléatoire jamais communiqué : le compte ne peut se connecter que via SSO
+	// tant qu'un administrateur ne le réinitialise pas.
+	password, err := randomString(32)
+	if err != nil {
+		return nil, false, err
+	}
+	created, err := s.users.Create(ctx, user.CreateInput{
+		OrganizationID: org.ID,
+		Email:          email,
+		Password:       password,
+		Role:           id.Role,
+		Metadata:       mergeMetadata(nil, id),
+	})
+	if err != nil {
+		if !errors.Is(err, user.ErrEmailAlreadyUsed) {
+			return nil, false, err
+		}
+		// Première connexion concurrente : le compte n'est repris que s'il a été lié à cette identité.
+		if linked, err = s.linkedUser(ctx, org, id); err != nil {
+			return nil, false, err
+		}
+		if linked == nil {
+			return nil, false, ErrAccountExists
+		}
+		updated, err := s.sync(ctx, org, linked, id)
+		return updated, false, err
+	}
+
+	err = s.client.SSOIdentity.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(created.ID).
+		SetProtocol(id.Protocol).
+		SetIssuer(id.Issuer).
+		SetSubject(id.Subject).
+		Exec(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return created, true, nil
+}
+
+// linkedUser renvoie l'utilisateur lié à l'identité, nil s'il n'y en a pas encore.
+func (s *Service) linkedUser(ctx context.Context, org *ent.Organization, id identity) (*ent.User, error) {
+	linked, err := s.client.SSOIdentity.Query().
+		Where(
+			ssoidentity.OrganizationIDEQ(org.ID),
+			ssoidentity.IssuerEQ(id.Issuer),
+			ssoidentity.SubjectEQ(id.Subject),
+		).
+		QueryUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return linked, err
+}
+
+// sync met à jour les métadonnées et, si le mapping l'impose, le rôle d'un utilisateur déjà lié.
+func (s *Service) sync(ctx context.Context, org *ent.Organization, account *ent.User, id identity) (*ent.User, error) {
+	update := user.UpdateInput{Metadata: mergeMetadata(account.Metadata, id)}
+	if id.RoleMapped && id.Role != account.Role {
+		role := id.Role
+		update.Role = &role
+	}
+	return s.users.Update(ctx, org.ID, account.ID, update)
+}
+
+// mergeMetadata copie les métadonnées existantes et y ajoute les attributs et la référence SSO.
+func mergeMetadata(current map[string]any, id identity) map[string]any {
+	out := make(map[string]any, len(current)+len(id.Attributes)+1)
+	for key, value := range current {
+		out[key] = value
+	}
+	for key, value := range id.Attributes {
+		out[key] = value
+	}
+	out["sso"] = map[string]any{
+		"protocol": id.Protocol,
+		"issuer":   id.Issuer,
+		"subject":  id.Subject,
+	}
+	return out
+}
@@ -0,0 +1,361 @@
+package sso
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/google/uuid"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"lms-go/internal/ent"
+	"lms-go/internal/ent/samlassertion"
+	"lms-go/internal/organization"
+)
+
+const protocolSAML = "saml"
+
+// emailAttributes sont les noms d'attributs usuels portant l'email, essayés dans l'ordre.
+var emailAttributes = []string{
+	"email",
+	"mail",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+}
+
+// SAMLEndpoints regroupe les URLs absolues du fournisseur de service pour une organisation.
+type SAMLEndpoints struct {
+	MetadataURL string
+	ACSURL      string
+}
+
+// SAMLLoginInput décrit le démarrage d'une connexion SAML initiée par le LMS.
+type SAMLLoginInput struct {
+	OrgSlug    string
+	Endpoints  SAMLEndpoints
+	ReturnTo   string
+	UseCookies bool
+}
+
+// SAMLACSInput regroupe les données postées sur l'Assertion Consumer Service.
+type SAMLACSInput struct {
+	OrgSlug      string
+	Endpoints    SAMLEndpoints
+	SAMLResponse string
+	RelayState   string
+	StateCookie  string
+}
+
+// SAMLMetadata renvoie les métadonnées SP de l'organisation, disponibles avant même l'import
+// des métadonnées du fournisseur pour permettre sa configuration.
+func (s *Service) SAMLMetadata(ctx context.Context, orgSlug string, endpoints SAMLEndpoints) (*saml.EntityDescriptor, error) {
+	org, err := s.activeOrganization(ctx, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := s.serviceProvider(org, SAMLSettings{}, endpoints, nil)
+	if err != nil {
+		return nil, err
+	}
+	return sp.Metadata(), nil
+}
+
+// SetSAMLMetadata valide puis enregistre les métadonnées du fournisseur d'identité dans les settings.
+func (s *Service) SetSAMLMetadata(ctx context.Context, orgID uuid.UUID, metadata []byte) (*ent.Organization, error) {
+	idp, err := parseIDPMetadata(string(metadata))
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.orgs.Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, organization.ErrNotFound) {
+			return nil, ErrNotConfigured
+		}
+		return nil, err
+	}
+
+	settings := make(map[string]any, len(org.Settings)+1)
+	for key, value := range org.Settings {
+		settings[key] = value
+	}
+	current, _ := settings[settingsKeySAML].(map[string]any)
+	samlSettings := make(map[string]any, len(current)+2)
+	for key, value := range current {
+		samlSettings[key] = value
+	}
+	if _, ok := samlSettings["enabled"]; !ok {
+		samlSettings["enabled"] = true
+	}
+	samlSettings["idp_metadata"] = string(metadata)
+	samlSettings["idp_entity_id"] = idp.EntityID
+	settings[settingsKeySAML] = samlSettings
+
+	return s.orgs.Update(ctx, org.ID, organization.UpdateInput{Settings: settings})
+}
+
+// BeginSAML construit la redirection (binding HTTP-Redirect) portant l'AuthnRequest.
+func (s *Service) BeginSAML(ctx context.Context, input SAMLLoginInput) (Redirect, error) {
+	org, err := s.activeOrganization(ctx, input.OrgSlug)
+	if err != nil {
+		return Redirect{}, err
+	}
+	settings, err := SAMLSettingsFrom(org.Settings)
+	if err != nil {
+		return Redirect{}, err
+	}
+	idp, err := parseIDPMetadata(settings.IDPMetadata)
+	if err != nil {
+		return Redirect{}, err
+	}
+	sp, err := s.serviceProvider(org, settings, input.Endpoints, idp)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	ssoURL := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		return Redirect{}, fmt.Errorf("%w: no HTTP-Redirect SSO endpoint in metadata", ErrNotConfigured)
+	}
+	authnRequest, err := sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	relayState, err := randomString(24)
+	if err != nil {
+		return Redirect{}, err
+	}
+	now := s.now()
+	cookie, err := signState(s.secret, flowState{
+		Protocol:   protocolSAML,
+		OrgSlug:    org.Slug,
+		State:      relayState,
+		RequestID:  authnRequest.ID,
+		ReturnTo:   sanitizeReturnTo(input.ReturnTo),
+		UseCookies: input.UseCookies,
+	}, now, s.stateTTL)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	redirectURL, err := authnRequest.Redirect(relayState, sp)
+	if err != nil {
+		return Redirect{}, err
+	}
+	return Redirect{URL: redirectURL.String(), State: cookie, ExpiresAt: now.Add(s.stateTTL)}, nil
+}
+
+// CompleteSAML valide la réponse signée, provisionne l'utilisateur et émet les tokens LMS.
+// Sans état correspondant, la réponse n'est acceptée que si le mode IdP-initiated est autorisé.
+func (s *Service) CompleteSAML(ctx context.Context, input SAMLACSInput) (Result, error) {
+	org, err := s.activeOrganization(ctx, input.OrgSlug)
+	if err != nil {
+		return Result{}, err
+	}
+	settings, err := SAMLSettingsFrom(org.Settings)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var requestIDs []string
+	st, err := s.checkState(protocolSAML, input.OrgSlug, input.StateCookie, input.RelayState)
+	switch {
+	case err == nil:
+		requestIDs = []string{st.RequestID}
+	case settings.AllowIDPInitiated:
+		st = &flowState{}
+	default:
+		return Result{}, err
+	}
+
+	idp, err := parseIDPMetadata(settings.IDPMetadata)
+	if err != nil {
+		return Result{}, err
+	}
+	sp, err := s.serviceProvider(org, settings, input.Endpoints, idp)
+	if err != nil {
+		return Result{}, err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(input.SAMLResponse)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: SAMLResponse encoding", ErrInvalidResponse)
+	}
+	assertion, err := sp.ParseXMLResponse(raw, requestIDs, sp.AcsURL)
+	if err != nil {
+		var invalid *saml.InvalidResponseError
+		if errors.As(err, &invalid) && invalid.PrivateErr != nil {
+			err = invalid.PrivateErr
+		}
+		return Result{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if err := s.markAssertionUsed(ctx, assertion.ID); err != nil {
+		return Result{}, err
+	}
+
+	attrs := assertionAttributes(assertion)
+	email := samlEmail(settings, attrs, assertion)
+	if email == "" {
+		return Result{}, fmt.Errorf("%w: missing email attribute", ErrInvalidResponse)
+	}
+	if !settings.DomainAllowed(email) {
+		return Result{}, ErrDomainNotAllowed
+	}
+
+	role, mapped := mapRole(settings.RoleMapping, settings.DefaultRole, attrs[settings.RoleAttribute])
+	metadata := map[string]any{}
+	for attribute, key := range settings.MetadataAttributes {
+		values := attrs[attribute]
+		switch len(values) {
+		case 0:
+		case 1:
+			metadata[key] = values[0]
+		default:
+			list := make([]any, 0, len(values))
+			for _, v := range values {
+				list = append(list, v)
+			}
+			metadata[key] = list
+		}
+	}
+
+	var subject string
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		subject = assertion.Subject.NameID.Value
+	}
+	account, created, err := s.provision(ctx, org, identity{
+		Protocol:   protocolSAML,
+		Issuer:     assertion.Issuer.Value,
+		Subject:    subject,
+		Email:      email,
+		Role:       role,
+		RoleMapped: mapped,
+		Attributes: metadata,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	tokens, err := s.auth.StartSession(ctx, account.ID)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		User:       account,
+		Tokens:     tokens,
+		Created:    created,
+		ReturnTo:   st.ReturnTo,
+		UseCookies: st.UseCookies,
+	}, nil
+}
+
+// serviceProvider construit le SP de l'organisation ; idp peut être nil pour la seule génération des métadonnées.
+func (s *Service) serviceProvider(org *ent.Organization, settings SAMLSettings, endpoints SAMLEndpoints, idp *saml.EntityDescriptor) (*saml.ServiceProvider, error) {
+	metadataURL, err := url.Parse(endpoints.MetadataURL)
+	if err != nil {
+		return nil, err
+	}
+	acsURL, err := url.Parse(endpoints.ACSURL)
+	if err != nil {
+		return nil, err
+	}
+	sp := &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		Key:               s.samlKey,
+		Certificate:       s.samlCert,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idp,
+		AllowIDPInitiated: settings.AllowIDPInitiated,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+	}
+	if _, ok := s.samlKey.(*rsa.PrivateKey); ok && s.samlCert != nil {
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	}
+	return sp, nil
+}
+
+// markAssertionUsed refuse le rejeu d'une assertion déjà consommée pendant sa fenêtre de validité.
+// Les identifiants sont conservés en base : la contrainte d'unicité vaut pour toutes les instances.
+func (s *Service) markAssertionUsed(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: missing assertion ID", ErrInvalidResponse)
+	}
+	now := s.now()
+	if _, err := s.client.SamlAssertion.Delete().
+		Where(samlassertion.ExpiresAtLT(now)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	err := s.client.SamlAssertion.Create().
+		SetAssertionID(id).
+		SetExpiresAt(now.Add(saml.MaxIssueDelay + saml.MaxClockSkew + time.Minute)).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("%w: assertion replayed", ErrInvalidResponse)
+	}
+	return err
+}
+
+func parseIDPMetadata(raw string) (*saml.EntityDescriptor, error) {
+	idp, err := samlsp.ParseMetadata([]byte(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: idp metadata: %v", ErrInvalidMetadata, err)
+	}
+	if len(idp.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("%w: no IDPSSODescriptor", ErrInvalidMetadata)
+	}
+	for _, descriptor := range idp.IDPSSODescriptors {
+		for _, key := range descriptor.KeyDescriptors {
+			if key.Use != "encryption" && len(key.KeyInfo.X509Data.X509Certificates) > 0 {
+				return idp, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: no signing certificate", ErrInvalidMetadata)
+}
+
+// assertionAttributes indexe les valeurs des attributs par nom et par nom convivial.
+func assertionAttributes(assertion *saml.Assertion) map[string][]string {
+	attrs := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := make([]string, 0, len(attribute.Values))
+			for _, v := range attribute.Values {
+				if value := strings.TrimSpace(v.Value); value != "" {
+					values = append(values, value)
+				}
+			}
+			if attribute.Name != "" {
+				attrs[attribute.Name] = append(attrs[attribute.Name], values...)
+			}
+			if attribute.FriendlyName != "" && attribute.FriendlyName != attribute.Name {
+				attrs[attribute.FriendlyName] = append(attrs[attribute.FriendlyName], values...)
+			}
+		}
+	}
+	return attrs
+}
+
+func samlEmail(settings SAMLSettings, attrs map[string][]string, assertion *saml.Assertion) string {
+	candidates := emailAttributes
+	if settings.EmailAttribute != "" {
+		candidates = []string{settings.EmailAttribute}
+	}
+	for _, name := range candidates {
+		if values := attrs[name]; len(values) > 0 {
+			return strings.ToLower(values[0])
+		}
+	}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil && strings.Contains(assertion.Subject.NameID.Value, "@") {
+		return strings.ToLower(strings.TrimSpace(assertion.Subject.NameID.Value))
+	}
+	return ""
+}
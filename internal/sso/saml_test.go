@@ -0,0 +1,173 @@
+package sso
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"lms-go/internal/organization"
+	"lms-go/internal/sso/ssotest"
+)
+
+var testSAMLEndpoints = SAMLEndpoints{
+	MetadataURL: "http://lms.test/auth/saml/acme/metadata",
+	ACSURL:      "http://lms.test/auth/saml/acme/acs",
+}
+
+func newSAMLTestEnv(t *testing.T, name string, samlSettings map[string]any) (testEnv, *ssotest.SAMLProvider) {
+	t.Helper()
+	idp := ssotest.NewSAMLProvider(t, "https://idp.test")
+	env := newTestEnv(t, name, map[string]any{})
+
+	_, err := env.svc.SetSAMLMetadata(context.Background(), env.org.ID, idp.Metadata(t))
+	require.NoError(t, err)
+	if samlSettings != nil {
+		org, err := env.orgs.Get(context.Background(), env.org.ID)
+		require.NoError(t, err)
+		current := org.Settings["saml"].(map[string]any)
+		for key, value := range samlSettings {
+			current[key] = value
+		}
+		_, err = env.orgs.Update(context.Background(), env.org.ID, organization.UpdateInput{Settings: org.Settings})
+		require.NoError(t, err)
+	}
+	return env, idp
+}
+
+func TestService_SAMLServiceProviderInitiated(t *testing.T) {
+	env, idp := newSAMLTestEnv(t, "ssosamlsp", map[string]any{
+		"role_attribute":      "memberOf",
+		"role_mapping":        map[string]any{"lms-admins": "admin"},
+		"metadata_attributes": map[string]any{"department": "department"},
+	})
+	ctx := context.Background()
+
+	spMetadata, err := env.svc.SAMLMetadata(ctx, "acme", testSAMLEndpoints)
+	require.NoError(t, err)
+	require.Equal(t, testSAMLEndpoints.MetadataURL, spMetadata.EntityID)
+
+	redirect, err := env.svc.BeginSAML(ctx, SAMLLoginInput{OrgSlug: "acme", Endpoints: testSAMLEndpoints, ReturnTo: "/courses"})
+	require.NoError(t, err)
+	authnRequest, relayState := idp.ParseAuthnRequest(t, redirect.URL)
+	require.Equal(t, testSAMLEndpoints.ACSURL, authnRequest.AssertionConsumerServiceURL)
+
+	response := idp.Respond(t, spMetadata, authnRequest.ID, "grace@acme.test", map[string][]string{
+		"email":      {"Grace@Acme.test"},
+		"memberOf":   {"lms-admins"},
+		"department": {"R&D"},
+	})
+
+	result, err := env.svc.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: response,
+		RelayState:   relayState,
+		StateCookie:  redirect.State,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Created)
+	require.Equal(t, "/courses", result.ReturnTo)
+	require.Equal(t, "grace@acme.test", result.User.Email)
+	require.Equal(t, "admin", result.User.Role)
+	require.Equal(t, "R&D", result.User.Metadata["department"])
+	require.NotEmpty(t, result.Tokens.AccessToken)
+
+	// Rejeu de la même réponse refusé, y compris par une autre instance partageant la base.
+	replica := NewService(env.client, env.orgs, env.users, env.auth, Config{StateSecret: "state-secret"})
+	_, err = replica.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: response,
+		RelayState:   relayState,
+		StateCookie:  redirect.State,
+	})
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
+
+func TestService_SAMLIdentityProviderInitiated(t *testing.T) {
+	ctx := context.Background()
+
+	env, idp := newSAMLTestEnv(t, "ssosamlidpdenied", nil)
+	spMetadata, err := env.svc.SAMLMetadata(ctx, "acme", testSAMLEndpoints)
+	require.NoError(t, err)
+	_, err = env.svc.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: idp.Respond(t, spMetadata, "", "alan@acme.test", nil),
+	})
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	env, idp = newSAMLTestEnv(t, "ssosamlidpallowed", map[string]any{"allow_idp_initiated": true})
+	spMetadata, err = env.svc.SAMLMetadata(ctx, "acme", testSAMLEndpoints)
+	require.NoError(t, err)
+	result, err := env.svc.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: idp.Respond(t, spMetadata, "", "alan@acme.test", nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "alan@acme.test", result.User.Email)
+	require.Equal(t, "learner", result.User.Role)
+}
+
+func TestService_SAMLRejectsUntrustedSignature(t *testing.T) {
+	env, _ := newSAMLTestEnv(t, "ssosamlforged", map[string]any{"allow_idp_initiated": true})
+	ctx := context.Background()
+	spMetadata, err := env.svc.SAMLMetadata(ctx, "acme", testSAMLEndpoints)
+	require.NoError(t, err)
+
+	// Même entityID, mais clé différente de celle publiée dans les métadonnées importées.
+	forger := ssotest.NewSAMLProvider(t, "https://idp.test")
+	_, err = env.svc.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: forger.Respond(t, spMetadata, "", "mallory@acme.test", nil),
+	})
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
+
+func TestService_SetSAMLMetadataValidates(t *testing.T) {
+	env := newTestEnv(t, "ssosamlmeta", map[string]any{})
+
+	_, err := env.svc.SetSAMLMetadata(context.Background(), env.org.ID, []byte("<not-metadata/>"))
+	require.ErrorIs(t, err, ErrInvalidMetadata)
+
+	idp := ssotest.NewSAMLProvider(t, "https://idp.test")
+	org, err := env.svc.SetSAMLMetadata(context.Background(), env.org.ID, idp.Metadata(t))
+	require.NoError(t, err)
+	samlSettings := org.Settings["saml"].(map[string]any)
+	require.Equal(t, true, samlSettings["enabled"])
+	require.Equal(t, "https://idp.test/metadata", samlSettings["idp_entity_id"])
+}
+
+func TestService_SAMLWithServiceProviderCertificate(t *testing.T) {
+	env, idp := newSAMLTestEnv(t, "ssosamlspcert", nil)
+	key, cert := ssotest.GenerateCertificate(t, "lms-sp")
+	env.svc = NewService(env.client, env.orgs, env.users, env.auth, Config{
+		StateSecret:     "state-secret",
+		SAMLKey:         key,
+		SAMLCertificate: cert,
+	})
+	ctx := context.Background()
+
+	spMetadata, err := env.svc.SAMLMetadata(ctx, "acme", testSAMLEndpoints)
+	require.NoError(t, err)
+	require.NotEmpty(t, spMetadata.SPSSODescriptors[0].KeyDescriptors)
+
+	redirect, err := env.svc.BeginSAML(ctx, SAMLLoginInput{OrgSlug: "acme", Endpoints: testSAMLEndpoints})
+	require.NoError(t, err)
+	require.Contains(t, redirect.URL, "Signature=")
+	authnRequest, relayState := idp.ParseAuthnRequest(t, redirect.URL)
+
+	// Le certificat SP étant publié, le fournisseur chiffre l'assertion.
+	result, err := env.svc.CompleteSAML(ctx, SAMLACSInput{
+		OrgSlug:      "acme",
+		Endpoints:    testSAMLEndpoints,
+		SAMLResponse: idp.Respond(t, spMetadata, authnRequest.ID, "ken@acme.test", nil),
+		RelayState:   relayState,
+		StateCookie:  redirect.State,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ken@acme.test", result.User.Email)
+}
@@ -0,0 +1,138 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"lms-go/internal/auth"
+	"lms-go/internal/ent"
+	"lms-go/internal/organization"
+	"lms-go/internal/user"
+)
+
+const defaultStateTTL = 10 * time.Minute
+
+// Service orchestre les flux SSO : redirection vers le fournisseur d'identité,
+// validation de la réponse, provisionnement just-in-time puis émission des tokens LMS.
+type Service struct {
+	client     *ent.Client
+	orgs       *organization.Service
+	users      *user.Service
+	auth       *auth.Service
+	secret     []byte
+	stateTTL   time.Duration
+	httpClient *http.Client
+	samlKey    crypto.Signer
+	samlCert   *x509.Certificate
+	now        func() time.Time
+
+	mu        sync.Mutex
+	providers map[string]*oidc.Provider
+}
+
+// Config configure le service SSO.
+type Config struct {
+	// StateSecret signe l'état du flux transporté dans le cookie (réutilise en général JWT_SECRET).
+	StateSecret string
+	// StateTTL borne la durée entre la redirection et le callback.
+	StateTTL time.Duration
+	// HTTPClient est utilisé pour dialoguer avec les fournisseurs (découverte, échange de code, JWKS).
+	HTTPClient *http.Client
+	// SAMLKey et SAMLCertificate (optionnels) signent les AuthnRequest et sont publiés dans les métadonnées SP.
+	SAMLKey         crypto.Signer
+	SAMLCertificate *x509.Certificate
+}
+
+// NewService crée une instance de Service.
+func NewService(client *ent.Client, orgs *organization.Service, users *user.Service, authService *auth.Service, cfg Config) *Service {
+	ttl := cfg.StateTTL
+	if ttl <= 0 {
+		ttl = defaultStateTTL
+	}
+	return &Service{
+		client:     client,
+		orgs:       orgs,
+		users:      users,
+		auth:       authService,
+		secret:     []byte(cfg.StateSecret),
+		stateTTL:   ttl,
+		httpClient: cfg.HTTPClient,
+		samlKey:    cfg.SAMLKey,
+		samlCert:   cfg.SAMLCertificate,
+		now:        time.Now,
+		providers:  map[string]*oidc.Provider{},
+	}
+}
+
+func (s *Service) withNow(now func() time.Time) {
+	if now != nil {
+		s.now = now
+	}
+}
+
+// Redirect décrit la redirection vers le fournisseur et l'état à conserver dans un cookie.
+type Redirect struct {
+	URL       string
+	State     string
+	ExpiresAt time.Time
+}
+
+// Result est le résultat d'une authentification SSO réussie.
+type Result struct {
+	User       *ent.User
+	Tokens     auth.TokenPair
+	Created    bool
+	ReturnTo   string
+	UseCookies bool
+}
+
+// activeOrganization charge l'organisation par slug et refuse les organisations archivées.
+func (s *Service) activeOrganization(ctx context.Context, slug string) (*ent.Organization, error) {
+	org, err := s.orgs.GetBySlug(ctx, strings.TrimSpace(strings.ToLower(slug)))
+	if err != nil {
+		if errors.Is(err, organization.ErrNotFound) {
+			return nil, ErrNotConfigured
+		}
+		return nil, err
+	}
+	if org.Status != "active" {
+		return nil, ErrNotConfigured
+	}
+	return org, nil
+}
+
+// checkState valide le cookie d'état et sa correspondance avec le paramètre renvoyé par le fournisseur.
+func (s *Service) checkState(protocol, orgSlug, cookie, state string) (*flowState, error) {
+	if cookie == "" || state == "" {
+		return nil, ErrInvalidState
+	}
+	st, err := parseState(s.secret, cookie, s.now)
+	if err != nil {
+		return nil, err
+	}
+	if st.Protocol != protocol || !strings.EqualFold(st.OrgSlug, orgSlug) {
+		return nil, ErrInvalidState
+	}
+	if subtle.ConstantTimeCompare([]byte(st.State), []byte(state)) != 1 {
+		return nil, ErrInvalidState
+	}
+	return st, nil
+}
+
+// sanitizeReturnTo n'accepte que des chemins relatifs pour éviter les redirections ouvertes.
+func sanitizeReturnTo(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, "//") || strings.Contains(raw, `\`) {
+		return ""
+	}
+	return raw
+}
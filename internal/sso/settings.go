@@ -0,0 +1,207 @@
+// Package sso gère l'authentification unique (SSO) configurée par organisation.
+package sso
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	settingsKeyOIDC = "oidc"
+	settingsKeySAML = "saml"
+	// redactedSecret remplace les secrets dans les réponses de l'API organisations.
+	redactedSecret   = "[REDACTED]"
+	defaultRoleClaim = "groups"
+	defaultRole      = "learner"
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// OIDCSettings est la configuration OpenID Connect stockée dans Organization.settings["oidc"].
+type OIDCSettings struct {
+	Enabled        bool              `json:"enabled"`
+	Issuer         string            `json:"issuer"`
+	ClientID       string            `json:"client_id"`
+	ClientSecret   string            `json:"client_secret"`
+	Scopes         []string          `json:"scopes,omitempty"`
+	RoleClaim      string            `json:"role_claim,omitempty"`
+	RoleMapping    map[string]string `json:"role_mapping,omitempty"`
+	DefaultRole    string            `json:"default_role,omitempty"`
+	AllowedDomains []string          `json:"allowed_domains,omitempty"`
+}
+
+// OIDCSettingsFrom extrait la configuration OIDC des settings d'une organisation.
+func OIDCSettingsFrom(settings map[string]any) (OIDCSettings, error) {
+	var cfg OIDCSettings
+	raw, ok := settings[settingsKeyOIDC]
+	if !ok || raw == nil {
+		return cfg, ErrNotConfigured
+	}
+	if err := decodeSettings(raw, &cfg); err != nil {
+		return cfg, err
+	}
+	if !cfg.Enabled || cfg.Issuer == "" || cfg.ClientID == "" {
+		return cfg, ErrNotConfigured
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaultScopes
+	}
+	if !contains(cfg.Scopes, "openid") {
+		cfg.Scopes = append([]string{"openid"}, cfg.Scopes...)
+	}
+	if cfg.RoleClaim == "" {
+		cfg.RoleClaim = defaultRoleClaim
+	}
+	if cfg.DefaultRole == "" {
+		cfg.DefaultRole = defaultRole
+	}
+	return cfg, nil
+}
+
+// SAMLSettings est la configuration SAML 2.0 stockée dans Organization.settings["saml"].
+type SAMLSettings struct {
+	Enabled bool `json:"enabled"`
+	// IDPMetadata est le document XML de métadonnées du fournisseur (certificat de signature, URL SSO).
+	IDPMetadata string `json:"idp_metadata"`
+	// EmailAttribute force l'attribut portant l'email ; à défaut mail/email puis le NameID.
+	EmailAttribute string            `json:"email_attribute,omitempty"`
+	RoleAttribute  string            `json:"role_attribute,omitempty"`
+	RoleMapping    map[string]string `json:"role_mapping,omitempty"`
+	DefaultRole    string            `json:"default_role,omitempty"`
+	// MetadataAttributes associe un attribut SAML à une clé de User.metadata.
+	MetadataAttributes map[string]string `json:"metadata_attributes,omitempty"`
+	AllowedDomains     []string          `json:"allowed_domains,omitempty"`
+	AllowIDPInitiated  bool              `json:"allow_idp_initiated,omitempty"`
+}
+
+// SAMLSettingsFrom extrait la configuration SAML des settings d'une organisation.
+func SAMLSettingsFrom(settings map[string]any) (SAMLSettings, error) {
+	var cfg SAMLSettings
+	raw, ok := settings[settingsKeySAML]
+	if !ok || raw == nil {
+		return cfg, ErrNotConfigured
+	}
+	if err := decodeSettings(raw, &cfg); err != nil {
+		return cfg, err
+	}
+	if !cfg.Enabled || strings.TrimSpace(cfg.IDPMetadata) == "" {
+		return cfg, ErrNotConfigured
+	}
+	if cfg.RoleAttribute == "" {
+		cfg.RoleAttribute = defaultRoleClaim
+	}
+	if cfg.DefaultRole == "" {
+		cfg.DefaultRole = defaultRole
+	}
+	return cfg, nil
+}
+
+// DomainAllowed indique si l'email appartient à un domaine autorisé (tous si la liste est vide).
+func (c SAMLSettings) DomainAllowed(email string) bool {
+	return domainAllowed(c.AllowedDomains, email)
+}
+
+// DomainAllowed indique si l'email appartient à un domaine autorisé (tous si la liste est vide).
+func (c OIDCSettings) DomainAllowed(email string) bool {
+	return domainAllowed(c.AllowedDomains, email)
+}
+
+// MapRole renvoie le rôle LMS correspondant aux valeurs du claim configuré.
+// Le booléen est faux si aucune valeur n'est mappée (rôle par défaut renvoyé).
+func (c OIDCSettings) MapRole(claims map[string]any) (string, bool) {
+	return mapRole(c.RoleMapping, c.DefaultRole, claims[c.RoleClaim])
+}
+
+// MaskSecrets renvoie une copie des settings où les secrets SSO sont masqués.
+func MaskSecrets(settings map[string]any) map[string]any {
+	if settings == nil {
+		return nil
+	}
+	out := make(map[string]any, len(settings))
+	for key, value := range settings {
+		out[key] = value
+	}
+	if oidc, ok := settings[settingsKeyOIDC].(map[string]any); ok {
+		if secret, _ := oidc["client_secret"].(string); secret != "" {
+			masked := make(map[string]any, len(oidc))
+			for key, value := range oidc {
+				masked[key] = value
+			}
+			masked["client_secret"] = redactedSecret
+			out[settingsKeyOIDC] = masked
+		}
+	}
+	return out
+}
+
+// PreserveSecrets réinjecte dans des settings entrants les secrets masqués par MaskSecrets,
+// afin qu'un client puisse renvoyer les settings lus sans écraser les secrets existants.
+func PreserveSecrets(incoming, existing map[string]any) map[string]any {
+	oidc, ok := incoming[settingsKeyOIDC].(map[string]any)
+	if !ok || oidc["client_secret"] != redactedSecret {
+		return incoming
+	}
+	if current, ok := existing[settingsKeyOIDC].(map[string]any); ok {
+		oidc["client_secret"] = current["client_secret"]
+	} else {
+		delete(oidc, "client_secret")
+	}
+	return incoming
+}
+
+func decodeSettings(raw any, out any) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func domainAllowed(domains []string, email string) bool {
+	if len(domains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+	for _, allowed := range domains {
+		if strings.EqualFold(strings.TrimSpace(allowed), domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapRole parcourt les valeurs du claim (chaîne ou liste) et renvoie le premier rôle mappé.
+func mapRole(mapping map[string]string, fallback string, claim any) (string, bool) {
+	var values []string
+	switch v := claim.(type) {
+	case string:
+		values = []string{v}
+	case []string:
+		values = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	for _, value := range values {
+		if role, ok := mapping[value]; ok && role != "" {
+			return role, true
+		}
+	}
+	return fallback, false
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
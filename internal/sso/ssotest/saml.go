@@ -0,0 +1,143 @@
+package ssotest
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+)
+
+// SAMLProvider est un fournisseur d'identité SAML factice : il publie ses métadonnées
+// et signe des réponses avec une clé et un certificat générés localement.
+type SAMLProvider struct {
+	IDP *saml.IdentityProvider
+}
+
+// NewSAMLProvider crée un fournisseur dont l'entityID dérive de baseURL (ex. https://idp.test).
+func NewSAMLProvider(t testing.TB, baseURL string) *SAMLProvider {
+	t.Helper()
+	key, cert := GenerateCertificate(t, "ssotest-idp")
+	metadataURL, _ := url.Parse(baseURL + "/metadata")
+	ssoURL, _ := url.Parse(baseURL + "/sso")
+	return &SAMLProvider{IDP: &saml.IdentityProvider{
+		Key:         key,
+		Certificate: cert,
+		MetadataURL: *metadataURL,
+		SSOURL:      *ssoURL,
+	}}
+}
+
+// GenerateCertificate génère une clé RSA et un certificat auto-signé valables une heure.
+func GenerateCertificate(t testing.TB, commonName string) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("ssotest: generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("ssotest: create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ssotest: parse certificate: %v", err)
+	}
+	return key, cert
+}
+
+// Metadata renvoie le document XML de métadonnées du fournisseur.
+func (p *SAMLProvider) Metadata(t testing.TB) []byte {
+	t.Helper()
+	data, err := xml.MarshalIndent(p.IDP.Metadata(), "", "  ")
+	if err != nil {
+		t.Fatalf("ssotest: marshal metadata: %v", err)
+	}
+	return data
+}
+
+// ParseAuthnRequest décode l'AuthnRequest d'une URL de redirection (binding HTTP-Redirect).
+func (p *SAMLProvider) ParseAuthnRequest(t testing.TB, redirectURL string) (saml.AuthnRequest, string) {
+	t.Helper()
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		t.Fatalf("ssotest: parse redirect: %v", err)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	if err != nil {
+		t.Fatalf("ssotest: decode SAMLRequest: %v", err)
+	}
+	raw, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatalf("ssotest: inflate SAMLRequest: %v", err)
+	}
+	var req saml.AuthnRequest
+	if err := xml.Unmarshal(raw, &req); err != nil {
+		t.Fatalf("ssotest: unmarshal AuthnRequest: %v", err)
+	}
+	return req, u.Query().Get("RelayState")
+}
+
+// Respond produit une SAMLResponse signée (base64, binding HTTP-POST) destinée au SP.
+// requestID vide simule une connexion initiée par le fournisseur.
+func (p *SAMLProvider) Respond(t testing.TB, spMetadata *saml.EntityDescriptor, requestID, nameID string, attributes map[string][]string) string {
+	t.Helper()
+	if len(spMetadata.SPSSODescriptors) == 0 || len(spMetadata.SPSSODescriptors[0].AssertionConsumerServices) == 0 {
+		t.Fatalf("ssotest: SP metadata without ACS")
+	}
+	descriptor := spMetadata.SPSSODescriptors[0]
+	acs := descriptor.AssertionConsumerServices[0]
+
+	session := &saml.Session{
+		ID:           randomID(),
+		CreateTime:   time.Now(),
+		ExpireTime:   time.Now().Add(time.Hour),
+		Index:        randomID(),
+		NameID:       nameID,
+		NameIDFormat: string(saml.EmailAddressNameIDFormat),
+	}
+	for name, values := range attributes {
+		attribute := saml.Attribute{Name: name, NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"}
+		for _, v := range values {
+			attribute.Values = append(attribute.Values, saml.AttributeValue{Type: "xs:string", Value: v})
+		}
+		session.CustomAttributes = append(session.CustomAttributes, attribute)
+	}
+
+	req := &saml.IdpAuthnRequest{
+		IDP:                     p.IDP,
+		HTTPRequest:             &http.Request{RemoteAddr: "127.0.0.1:0"},
+		Request:                 saml.AuthnRequest{ID: requestID},
+		ServiceProviderMetadata: spMetadata,
+		SPSSODescriptor:         &descriptor,
+		ACSEndpoint:             &acs,
+		Now:                     saml.TimeNow(),
+	}
+	if err := (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		t.Fatalf("ssotest: make assertion: %v", err)
+	}
+	form, err := req.PostBinding()
+	if err != nil {
+		t.Fatalf("ssotest: post binding: %v", err)
+	}
+	return form.SAMLResponse
+}
@@ -0,0 +1,54 @@
+package sso
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const stateAudience = "sso-flow"
+
+// flowState conserve, entre la redirection et le callback, ce qui lie la réponse du fournisseur
+// à la requête initiale. Signé avec le secret du service et transporté dans un cookie httpOnly.
+type flowState struct {
+	Protocol   string `json:"proto"`
+	OrgSlug    string `json:"org"`
+	State      string `json:"state"`
+	Nonce      string `json:"nonce,omitempty"`
+	Verifier   string `json:"verifier,omitempty"`
+	RequestID  string `json:"rid,omitempty"`
+	ReturnTo   string `json:"return_to,omitempty"`
+	UseCookies bool   `json:"use_cookies,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func signState(secret []byte, st flowState, now time.Time, ttl time.Duration) (string, error) {
+	st.RegisteredClaims = jwt.RegisteredClaims{
+		Audience:  []string{stateAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, &st).SignedString(secret)
+}
+
+func parseState(secret []byte, raw string, now func() time.Time) (*flowState, error) {
+	st := &flowState{}
+	token, err := jwt.ParseWithClaims(raw, st, func(t *jwt.Token) (any, error) {
+		return secret, nil
+	}, jwt.WithAudience(stateAudience), jwt.WithTimeFunc(now), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidState
+	}
+	return st, nil
+}
+
+// randomString renvoie une chaîne aléatoire encodée en base64 URL (n octets d'entropie).
+func randomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
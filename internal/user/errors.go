@@ -6,4 +6,6 @@ var (
 	ErrInvalidInput     = errors.New("user: invalid input")
 	ErrEmailAlreadyUsed = errors.New("user: email already used")
 	ErrNotFound         = errors.New("user: not found")
+	// ErrVersionMismatch signale que l'utilisateur a changé depuis la version attendue.
+	ErrVersionMismatch = errors.New("user: version modifiée")
 )
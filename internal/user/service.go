@@ -28,6 +28,7 @@ type CreateInput struct {
 	Password       string
 	Role           string
 	Status         string
+	ExternalID     string
 	Metadata       map[string]any
 }
 
@@ -36,12 +37,21 @@ type UpdateInput struct {
 	Password *string
 	Role     *string
 	Status   *string
-	Metadata map[string]any
+	// ExternalID vide efface l'identifiant externe.
+	ExternalID *string
+	Metadata   map[string]any
+	// IfMatch liste les versions attendues de l'utilisateur ; vide, aucune n'est exigée.
+	IfMatch []int
 }
 
 type Filter struct {
-	Role   string
-	Status string
+	Role       string
+	Status     string
+	Email      string
+	ExternalID string
+	// Offset et Limit paginent le résultat ; Limit à 0 renvoie tout.
+	Offset int
+	Limit  int
 }
 
 func normalizeEmail(email string) string {
@@ -56,6 +66,14 @@ func normalizeRole(role string) string {
 	return role
 }
 
+func normalizeExternalID(externalID string) *string {
+	externalID = strings.TrimSpace(externalID)
+	if externalID == "" {
+		return nil
+	}
+	return &externalID
+}
+
 func normalizeStatus(status string) string {
 	status = strings.TrimSpace(strings.ToLower(status))
 	if status == "" {
@@ -97,6 +115,7 @@ func (s *Service) Create(ctx context.Context, input CreateInput) (*ent.User, err
 		SetRole(role).
 		SetStatus(status).
 		SetMetadata(metadata).
+		SetNillableExternalID(normalizeExternalID(input.ExternalID)).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -137,10 +156,46 @@ func (s *Service) GetByEmail(ctx context.Context, orgID uuid.UUID, email string)
 	return user, nil
 }
 
+// GetByExternalID retrouve un utilisateur par l'identifiant attribué par l'annuaire source.
+func (s *Service) GetByExternalID(ctx context.Context, orgID uuid.UUID, externalID string) (*ent.User, error) {
+	externalID = strings.TrimSpace(externalID)
+	if externalID == "" {
+		return nil, ErrInvalidInput
+	}
+	user, err := s.client.User.Query().
+		Where(entuser.OrganizationIDEQ(orgID), entuser.ExternalIDEQ(externalID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
 func (s *Service) List(ctx context.Context, orgID uuid.UUID, filter Filter) ([]*ent.User, error) {
+	query := s.filtered(orgID, filter).
+		Order(ent.Asc(entuser.FieldCreatedAt), ent.Asc(entuser.FieldID))
+
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	return query.All(ctx)
+}
+
+// Count renvoie le nombre d'utilisateurs correspondant au filtre, sans pagination.
+func (s *Service) Count(ctx context.Context, orgID uuid.UUID, filter Filter) (int, error) {
+	return s.filtered(orgID, filter).Count(ctx)
+}
+
+func (s *Service) filtered(orgID uuid.UUID, filter Filter) *ent.UserQuery {
 	query := s.client.User.Query().
-		Where(entuser.OrganizationIDEQ(orgID)).
-		Order(ent.Asc(entuser.FieldCreatedAt))
+		Where(entuser.OrganizationIDEQ(orgID))
 
 	if role := strings.TrimSpace(filter.Role); role != "" {
 		query = query.Where(entuser.RoleEQ(role))
@@ -148,13 +203,22 @@ func (s *Service) List(ctx context.Context, orgID uuid.UUID, filter Filter) ([]*
 	if status := strings.TrimSpace(filter.Status); status != "" {
 		query = query.Where(entuser.StatusEQ(status))
 	}
-
-	return query.All(ctx)
+	if email := normalizeEmail(filter.Email); email != "" {
+		query = query.Where(entuser.EmailEQ(email))
+	}
+	if externalID := strings.TrimSpace(filter.ExternalID); externalID != "" {
+		query = query.Where(entuser.ExternalIDEQ(externalID))
+	}
+	return query
 }
 
 func (s *Service) Update(ctx context.Context, orgID, userID uuid.UUID, input UpdateInput) (*ent.User, error) {
 	update := s.client.User.UpdateOneID(userID).
-		Where(entuser.OrganizationIDEQ(orgID))
+		Where(entuser.OrganizationIDEQ(orgID)).
+		AddVersion(1)
+	if len(input.IfMatch) > 0 {
+		update.Where(entuser.VersionIn(input.IfMatch...))
+	}
 
 	if input.Email != nil {
 		email := normalizeEmail(*input.Email)
@@ -185,6 +249,14 @@ func (s *Service) Update(ctx context.Context, orgID, userID uuid.UUID, input Upd
 		update.SetStatus(status)
 	}
 
+	if input.ExternalID != nil {
+		if externalID := normalizeExternalID(*input.ExternalID); externalID != nil {
+			update.SetExternalID(*externalID)
+		} else {
+			update.ClearExternalID()
+		}
+	}
+
 	if input.Metadata != nil {
 		update.SetMetadata(input.Metadata)
 	}
@@ -197,36 +269,59 @@ func (s *Service) Update(ctx context.Context, orgID, userID uuid.UUID, input Upd
 			return nil, ErrEmailAlreadyUsed
 		}
 		if ent.IsNotFound(err) {
-			return nil, ErrNotFound
+			return nil, s.updateFailed(ctx, orgID, userID, input.IfMatch)
 		}
 		return nil, err
 	}
 	return user, nil
 }
 
-func (s *Service) Deactivate(ctx context.Context, orgID, userID uuid.UUID) error {
-	return s.setStatus(ctx, orgID, userID, "inactive")
+// Deactivate désactive l'utilisateur. Comme Activate, il n'agit que si la version de
+// l'utilisateur fait partie de ifMatch, lorsqu'il est fourni.
+func (s *Service) Deactivate(ctx context.Context, orgID, userID uuid.UUID, ifMatch ...int) error {
+	return s.setStatus(ctx, orgID, userID, "inactive", ifMatch)
 }
 
-func (s *Service) Activate(ctx context.Context, orgID, userID uuid.UUID) error {
-	return s.setStatus(ctx, orgID, userID, "active")
+func (s *Service) Activate(ctx context.Context, orgID, userID uuid.UUID, ifMatch ...int) error {
+	return s.setStatus(ctx, orgID, userID, "active", ifMatch)
 }
 
-func (s *Service) setStatus(ctx context.Context, orgID, userID uuid.UUID, status string) error {
-	_, err := s.client.User.UpdateOneID(userID).
+func (s *Service) setStatus(ctx context.Context, orgID, userID uuid.UUID, status string, ifMatch []int) error {
+	update := s.client.User.UpdateOneID(userID).
 		Where(entuser.OrganizationIDEQ(orgID)).
 		SetStatus(status).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
-	if err != nil {
+		AddVersion(1).
+		SetUpdatedAt(time.Now())
+	if len(ifMatch) > 0 {
+		update.Where(entuser.VersionIn(ifMatch...))
+	}
+	if err := update.Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
-			return ErrNotFound
+			return s.updateFailed(ctx, orgID, userID, ifMatch)
 		}
 		return err
 	}
 	return nil
 }
 
+// updateFailed qualifie l'échec d'une mise à jour conditionnelle : ErrVersionMismatch si
+// l'utilisateur existe toujours mais a changé de version, ErrNotFound sinon.
+func (s *Service) updateFailed(ctx context.Context, orgID, userID uuid.UUID, ifMatch []int) error {
+	if len(ifMatch) == 0 {
+		return ErrNotFound
+	}
+	exists, err := s.client.User.Query().
+		Where(entuser.IDEQ(userID), entuser.OrganizationIDEQ(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
+
 func (s *Service) ensureOrganization(ctx context.Context, orgID uuid.UUID) error {
 	exists, err := s.client.Organization.Query().
 		Where(entorg.IDEQ(orgID)).